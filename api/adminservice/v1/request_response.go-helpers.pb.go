@@ -3519,3 +3519,1594 @@ func (this *MigrateScheduleResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type GetDynamicConfigOverridesRequest to the protobuf v3 wire format
+func (val *GetDynamicConfigOverridesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigOverridesRequest from the protobuf v3 wire format
+func (val *GetDynamicConfigOverridesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigOverridesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigOverridesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigOverridesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigOverridesRequest
+	switch t := that.(type) {
+	case *GetDynamicConfigOverridesRequest:
+		that1 = t
+	case GetDynamicConfigOverridesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDynamicConfigOverridesResponse to the protobuf v3 wire format
+func (val *GetDynamicConfigOverridesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigOverridesResponse from the protobuf v3 wire format
+func (val *GetDynamicConfigOverridesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigOverridesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigOverridesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigOverridesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigOverridesResponse
+	switch t := that.(type) {
+	case *GetDynamicConfigOverridesResponse:
+		that1 = t
+	case GetDynamicConfigOverridesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetDynamicConfigOverrideRequest to the protobuf v3 wire format
+func (val *SetDynamicConfigOverrideRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetDynamicConfigOverrideRequest from the protobuf v3 wire format
+func (val *SetDynamicConfigOverrideRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetDynamicConfigOverrideRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetDynamicConfigOverrideRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetDynamicConfigOverrideRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetDynamicConfigOverrideRequest
+	switch t := that.(type) {
+	case *SetDynamicConfigOverrideRequest:
+		that1 = t
+	case SetDynamicConfigOverrideRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetDynamicConfigOverrideResponse to the protobuf v3 wire format
+func (val *SetDynamicConfigOverrideResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetDynamicConfigOverrideResponse from the protobuf v3 wire format
+func (val *SetDynamicConfigOverrideResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetDynamicConfigOverrideResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetDynamicConfigOverrideResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetDynamicConfigOverrideResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetDynamicConfigOverrideResponse
+	switch t := that.(type) {
+	case *SetDynamicConfigOverrideResponse:
+		that1 = t
+	case SetDynamicConfigOverrideResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteDynamicConfigOverrideRequest to the protobuf v3 wire format
+func (val *DeleteDynamicConfigOverrideRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteDynamicConfigOverrideRequest from the protobuf v3 wire format
+func (val *DeleteDynamicConfigOverrideRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteDynamicConfigOverrideRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteDynamicConfigOverrideRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteDynamicConfigOverrideRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteDynamicConfigOverrideRequest
+	switch t := that.(type) {
+	case *DeleteDynamicConfigOverrideRequest:
+		that1 = t
+	case DeleteDynamicConfigOverrideRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteDynamicConfigOverrideResponse to the protobuf v3 wire format
+func (val *DeleteDynamicConfigOverrideResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteDynamicConfigOverrideResponse from the protobuf v3 wire format
+func (val *DeleteDynamicConfigOverrideResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteDynamicConfigOverrideResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteDynamicConfigOverrideResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteDynamicConfigOverrideResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteDynamicConfigOverrideResponse
+	switch t := that.(type) {
+	case *DeleteDynamicConfigOverrideResponse:
+		that1 = t
+	case DeleteDynamicConfigOverrideResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDynamicConfigHistoryRequest to the protobuf v3 wire format
+func (val *GetDynamicConfigHistoryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigHistoryRequest from the protobuf v3 wire format
+func (val *GetDynamicConfigHistoryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigHistoryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigHistoryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigHistoryRequest
+	switch t := that.(type) {
+	case *GetDynamicConfigHistoryRequest:
+		that1 = t
+	case GetDynamicConfigHistoryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDynamicConfigHistoryResponse to the protobuf v3 wire format
+func (val *GetDynamicConfigHistoryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigHistoryResponse from the protobuf v3 wire format
+func (val *GetDynamicConfigHistoryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigHistoryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigHistoryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigHistoryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigHistoryResponse
+	switch t := that.(type) {
+	case *GetDynamicConfigHistoryResponse:
+		that1 = t
+	case GetDynamicConfigHistoryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainDynamicConfigRequest to the protobuf v3 wire format
+func (val *ExplainDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainDynamicConfigRequest from the protobuf v3 wire format
+func (val *ExplainDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainDynamicConfigRequest
+	switch t := that.(type) {
+	case *ExplainDynamicConfigRequest:
+		that1 = t
+	case ExplainDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainDynamicConfigResponse to the protobuf v3 wire format
+func (val *ExplainDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainDynamicConfigResponse from the protobuf v3 wire format
+func (val *ExplainDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainDynamicConfigResponse
+	switch t := that.(type) {
+	case *ExplainDynamicConfigResponse:
+		that1 = t
+	case ExplainDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartVisibilityDriftCheckRequest to the protobuf v3 wire format
+func (val *StartVisibilityDriftCheckRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartVisibilityDriftCheckRequest from the protobuf v3 wire format
+func (val *StartVisibilityDriftCheckRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartVisibilityDriftCheckRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartVisibilityDriftCheckRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartVisibilityDriftCheckRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartVisibilityDriftCheckRequest
+	switch t := that.(type) {
+	case *StartVisibilityDriftCheckRequest:
+		that1 = t
+	case StartVisibilityDriftCheckRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartVisibilityDriftCheckResponse to the protobuf v3 wire format
+func (val *StartVisibilityDriftCheckResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartVisibilityDriftCheckResponse from the protobuf v3 wire format
+func (val *StartVisibilityDriftCheckResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartVisibilityDriftCheckResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartVisibilityDriftCheckResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartVisibilityDriftCheckResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartVisibilityDriftCheckResponse
+	switch t := that.(type) {
+	case *StartVisibilityDriftCheckResponse:
+		that1 = t
+	case StartVisibilityDriftCheckResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityDriftCheckRequest to the protobuf v3 wire format
+func (val *DescribeVisibilityDriftCheckRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityDriftCheckRequest from the protobuf v3 wire format
+func (val *DescribeVisibilityDriftCheckRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityDriftCheckRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityDriftCheckRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityDriftCheckRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityDriftCheckRequest
+	switch t := that.(type) {
+	case *DescribeVisibilityDriftCheckRequest:
+		that1 = t
+	case DescribeVisibilityDriftCheckRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityDriftCheckResponse to the protobuf v3 wire format
+func (val *DescribeVisibilityDriftCheckResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityDriftCheckResponse from the protobuf v3 wire format
+func (val *DescribeVisibilityDriftCheckResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityDriftCheckResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityDriftCheckResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityDriftCheckResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityDriftCheckResponse
+	switch t := that.(type) {
+	case *DescribeVisibilityDriftCheckResponse:
+		that1 = t
+	case DescribeVisibilityDriftCheckResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type VisibilityDriftRecord to the protobuf v3 wire format
+func (val *VisibilityDriftRecord) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityDriftRecord from the protobuf v3 wire format
+func (val *VisibilityDriftRecord) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityDriftRecord) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityDriftRecord values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityDriftRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityDriftRecord
+	switch t := that.(type) {
+	case *VisibilityDriftRecord:
+		that1 = t
+	case VisibilityDriftRecord:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelVisibilityDriftCheckRequest to the protobuf v3 wire format
+func (val *CancelVisibilityDriftCheckRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelVisibilityDriftCheckRequest from the protobuf v3 wire format
+func (val *CancelVisibilityDriftCheckRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelVisibilityDriftCheckRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelVisibilityDriftCheckRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelVisibilityDriftCheckRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelVisibilityDriftCheckRequest
+	switch t := that.(type) {
+	case *CancelVisibilityDriftCheckRequest:
+		that1 = t
+	case CancelVisibilityDriftCheckRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelVisibilityDriftCheckResponse to the protobuf v3 wire format
+func (val *CancelVisibilityDriftCheckResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelVisibilityDriftCheckResponse from the protobuf v3 wire format
+func (val *CancelVisibilityDriftCheckResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelVisibilityDriftCheckResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelVisibilityDriftCheckResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelVisibilityDriftCheckResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelVisibilityDriftCheckResponse
+	switch t := that.(type) {
+	case *CancelVisibilityDriftCheckResponse:
+		that1 = t
+	case CancelVisibilityDriftCheckResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartVisibilityRebuildRequest to the protobuf v3 wire format
+func (val *StartVisibilityRebuildRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartVisibilityRebuildRequest from the protobuf v3 wire format
+func (val *StartVisibilityRebuildRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartVisibilityRebuildRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartVisibilityRebuildRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartVisibilityRebuildRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartVisibilityRebuildRequest
+	switch t := that.(type) {
+	case *StartVisibilityRebuildRequest:
+		that1 = t
+	case StartVisibilityRebuildRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartVisibilityRebuildResponse to the protobuf v3 wire format
+func (val *StartVisibilityRebuildResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartVisibilityRebuildResponse from the protobuf v3 wire format
+func (val *StartVisibilityRebuildResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartVisibilityRebuildResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartVisibilityRebuildResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartVisibilityRebuildResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartVisibilityRebuildResponse
+	switch t := that.(type) {
+	case *StartVisibilityRebuildResponse:
+		that1 = t
+	case StartVisibilityRebuildResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityRebuildRequest to the protobuf v3 wire format
+func (val *DescribeVisibilityRebuildRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityRebuildRequest from the protobuf v3 wire format
+func (val *DescribeVisibilityRebuildRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityRebuildRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityRebuildRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityRebuildRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityRebuildRequest
+	switch t := that.(type) {
+	case *DescribeVisibilityRebuildRequest:
+		that1 = t
+	case DescribeVisibilityRebuildRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityRebuildResponse to the protobuf v3 wire format
+func (val *DescribeVisibilityRebuildResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityRebuildResponse from the protobuf v3 wire format
+func (val *DescribeVisibilityRebuildResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityRebuildResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityRebuildResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityRebuildResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityRebuildResponse
+	switch t := that.(type) {
+	case *DescribeVisibilityRebuildResponse:
+		that1 = t
+	case DescribeVisibilityRebuildResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelVisibilityRebuildRequest to the protobuf v3 wire format
+func (val *CancelVisibilityRebuildRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelVisibilityRebuildRequest from the protobuf v3 wire format
+func (val *CancelVisibilityRebuildRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelVisibilityRebuildRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelVisibilityRebuildRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelVisibilityRebuildRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelVisibilityRebuildRequest
+	switch t := that.(type) {
+	case *CancelVisibilityRebuildRequest:
+		that1 = t
+	case CancelVisibilityRebuildRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelVisibilityRebuildResponse to the protobuf v3 wire format
+func (val *CancelVisibilityRebuildResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelVisibilityRebuildResponse from the protobuf v3 wire format
+func (val *CancelVisibilityRebuildResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelVisibilityRebuildResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelVisibilityRebuildResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelVisibilityRebuildResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelVisibilityRebuildResponse
+	switch t := that.(type) {
+	case *CancelVisibilityRebuildResponse:
+		that1 = t
+	case CancelVisibilityRebuildResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WatchWorkflowExecutionsRequest to the protobuf v3 wire format
+func (val *WatchWorkflowExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WatchWorkflowExecutionsRequest from the protobuf v3 wire format
+func (val *WatchWorkflowExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WatchWorkflowExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WatchWorkflowExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WatchWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WatchWorkflowExecutionsRequest
+	switch t := that.(type) {
+	case *WatchWorkflowExecutionsRequest:
+		that1 = t
+	case WatchWorkflowExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WatchWorkflowExecutionsResponse to the protobuf v3 wire format
+func (val *WatchWorkflowExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WatchWorkflowExecutionsResponse from the protobuf v3 wire format
+func (val *WatchWorkflowExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WatchWorkflowExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WatchWorkflowExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WatchWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WatchWorkflowExecutionsResponse
+	switch t := that.(type) {
+	case *WatchWorkflowExecutionsResponse:
+		that1 = t
+	case WatchWorkflowExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SaveVisibilityViewRequest to the protobuf v3 wire format
+func (val *SaveVisibilityViewRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SaveVisibilityViewRequest from the protobuf v3 wire format
+func (val *SaveVisibilityViewRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SaveVisibilityViewRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SaveVisibilityViewRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SaveVisibilityViewRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SaveVisibilityViewRequest
+	switch t := that.(type) {
+	case *SaveVisibilityViewRequest:
+		that1 = t
+	case SaveVisibilityViewRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SaveVisibilityViewResponse to the protobuf v3 wire format
+func (val *SaveVisibilityViewResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SaveVisibilityViewResponse from the protobuf v3 wire format
+func (val *SaveVisibilityViewResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SaveVisibilityViewResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SaveVisibilityViewResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SaveVisibilityViewResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SaveVisibilityViewResponse
+	switch t := that.(type) {
+	case *SaveVisibilityViewResponse:
+		that1 = t
+	case SaveVisibilityViewResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityViewRequest to the protobuf v3 wire format
+func (val *DescribeVisibilityViewRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityViewRequest from the protobuf v3 wire format
+func (val *DescribeVisibilityViewRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityViewRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityViewRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityViewRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityViewRequest
+	switch t := that.(type) {
+	case *DescribeVisibilityViewRequest:
+		that1 = t
+	case DescribeVisibilityViewRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityViewResponse to the protobuf v3 wire format
+func (val *DescribeVisibilityViewResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityViewResponse from the protobuf v3 wire format
+func (val *DescribeVisibilityViewResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityViewResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityViewResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityViewResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityViewResponse
+	switch t := that.(type) {
+	case *DescribeVisibilityViewResponse:
+		that1 = t
+	case DescribeVisibilityViewResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListVisibilityViewsRequest to the protobuf v3 wire format
+func (val *ListVisibilityViewsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListVisibilityViewsRequest from the protobuf v3 wire format
+func (val *ListVisibilityViewsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListVisibilityViewsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListVisibilityViewsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListVisibilityViewsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListVisibilityViewsRequest
+	switch t := that.(type) {
+	case *ListVisibilityViewsRequest:
+		that1 = t
+	case ListVisibilityViewsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListVisibilityViewsResponse to the protobuf v3 wire format
+func (val *ListVisibilityViewsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListVisibilityViewsResponse from the protobuf v3 wire format
+func (val *ListVisibilityViewsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListVisibilityViewsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListVisibilityViewsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListVisibilityViewsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListVisibilityViewsResponse
+	switch t := that.(type) {
+	case *ListVisibilityViewsResponse:
+		that1 = t
+	case ListVisibilityViewsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteVisibilityViewRequest to the protobuf v3 wire format
+func (val *DeleteVisibilityViewRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteVisibilityViewRequest from the protobuf v3 wire format
+func (val *DeleteVisibilityViewRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteVisibilityViewRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteVisibilityViewRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteVisibilityViewRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteVisibilityViewRequest
+	switch t := that.(type) {
+	case *DeleteVisibilityViewRequest:
+		that1 = t
+	case DeleteVisibilityViewRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteVisibilityViewResponse to the protobuf v3 wire format
+func (val *DeleteVisibilityViewResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteVisibilityViewResponse from the protobuf v3 wire format
+func (val *DeleteVisibilityViewResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteVisibilityViewResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteVisibilityViewResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteVisibilityViewResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteVisibilityViewResponse
+	switch t := that.(type) {
+	case *DeleteVisibilityViewResponse:
+		that1 = t
+	case DeleteVisibilityViewResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RestoreArchivedWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RestoreArchivedWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RestoreArchivedWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RestoreArchivedWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RestoreArchivedWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RestoreArchivedWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *RestoreArchivedWorkflowExecutionRequest:
+		that1 = t
+	case RestoreArchivedWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RestoreArchivedWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RestoreArchivedWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RestoreArchivedWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RestoreArchivedWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RestoreArchivedWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RestoreArchivedWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *RestoreArchivedWorkflowExecutionResponse:
+		that1 = t
+	case RestoreArchivedWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseTaskQueueDispatchRequest to the protobuf v3 wire format
+func (val *PauseTaskQueueDispatchRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseTaskQueueDispatchRequest from the protobuf v3 wire format
+func (val *PauseTaskQueueDispatchRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseTaskQueueDispatchRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseTaskQueueDispatchRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseTaskQueueDispatchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseTaskQueueDispatchRequest
+	switch t := that.(type) {
+	case *PauseTaskQueueDispatchRequest:
+		that1 = t
+	case PauseTaskQueueDispatchRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseTaskQueueDispatchResponse to the protobuf v3 wire format
+func (val *PauseTaskQueueDispatchResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseTaskQueueDispatchResponse from the protobuf v3 wire format
+func (val *PauseTaskQueueDispatchResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseTaskQueueDispatchResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseTaskQueueDispatchResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseTaskQueueDispatchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseTaskQueueDispatchResponse
+	switch t := that.(type) {
+	case *PauseTaskQueueDispatchResponse:
+		that1 = t
+	case PauseTaskQueueDispatchResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResumeTaskQueueDispatchRequest to the protobuf v3 wire format
+func (val *ResumeTaskQueueDispatchRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResumeTaskQueueDispatchRequest from the protobuf v3 wire format
+func (val *ResumeTaskQueueDispatchRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResumeTaskQueueDispatchRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResumeTaskQueueDispatchRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResumeTaskQueueDispatchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResumeTaskQueueDispatchRequest
+	switch t := that.(type) {
+	case *ResumeTaskQueueDispatchRequest:
+		that1 = t
+	case ResumeTaskQueueDispatchRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResumeTaskQueueDispatchResponse to the protobuf v3 wire format
+func (val *ResumeTaskQueueDispatchResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResumeTaskQueueDispatchResponse from the protobuf v3 wire format
+func (val *ResumeTaskQueueDispatchResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResumeTaskQueueDispatchResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResumeTaskQueueDispatchResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResumeTaskQueueDispatchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResumeTaskQueueDispatchResponse
+	switch t := that.(type) {
+	case *ResumeTaskQueueDispatchResponse:
+		that1 = t
+	case ResumeTaskQueueDispatchResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueDLQTasksRequest to the protobuf v3 wire format
+func (val *ListTaskQueueDLQTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueDLQTasksRequest from the protobuf v3 wire format
+func (val *ListTaskQueueDLQTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueDLQTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueDLQTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueDLQTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueDLQTasksRequest
+	switch t := that.(type) {
+	case *ListTaskQueueDLQTasksRequest:
+		that1 = t
+	case ListTaskQueueDLQTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueDLQTasksResponse to the protobuf v3 wire format
+func (val *ListTaskQueueDLQTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueDLQTasksResponse from the protobuf v3 wire format
+func (val *ListTaskQueueDLQTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueDLQTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueDLQTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueDLQTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueDLQTasksResponse
+	switch t := that.(type) {
+	case *ListTaskQueueDLQTasksResponse:
+		that1 = t
+	case ListTaskQueueDLQTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReenqueueTaskQueueDLQTasksRequest to the protobuf v3 wire format
+func (val *ReenqueueTaskQueueDLQTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReenqueueTaskQueueDLQTasksRequest from the protobuf v3 wire format
+func (val *ReenqueueTaskQueueDLQTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReenqueueTaskQueueDLQTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReenqueueTaskQueueDLQTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReenqueueTaskQueueDLQTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReenqueueTaskQueueDLQTasksRequest
+	switch t := that.(type) {
+	case *ReenqueueTaskQueueDLQTasksRequest:
+		that1 = t
+	case ReenqueueTaskQueueDLQTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReenqueueTaskQueueDLQTasksResponse to the protobuf v3 wire format
+func (val *ReenqueueTaskQueueDLQTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReenqueueTaskQueueDLQTasksResponse from the protobuf v3 wire format
+func (val *ReenqueueTaskQueueDLQTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReenqueueTaskQueueDLQTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReenqueueTaskQueueDLQTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReenqueueTaskQueueDLQTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReenqueueTaskQueueDLQTasksResponse
+	switch t := that.(type) {
+	case *ReenqueueTaskQueueDLQTasksResponse:
+		that1 = t
+	case ReenqueueTaskQueueDLQTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
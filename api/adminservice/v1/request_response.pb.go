@@ -21,6 +21,7 @@ import (
 	v17 "go.temporal.io/api/workflow/v1"
 	v18 "go.temporal.io/server/api/cluster/v1"
 	v112 "go.temporal.io/server/api/common/v1"
+	v116 "go.temporal.io/server/api/dynamicconfig/v1"
 	v14 "go.temporal.io/server/api/enums/v1"
 	v113 "go.temporal.io/server/api/health/v1"
 	v11 "go.temporal.io/server/api/history/v1"
@@ -3896,8 +3897,10 @@ type GetNamespaceResponse struct {
 	FailoverVersion   int64                            `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
 	FailoverHistory   []*v111.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
 	IsGlobalNamespace bool                             `protobuf:"varint,9,opt,name=is_global_namespace,json=isGlobalNamespace,proto3" json:"is_global_namespace,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Saved visibility queries of the namespace, keyed by name.
+	VisibilityViews map[string]*v12.VisibilityView `protobuf:"bytes,10,rep,name=visibility_views,json=visibilityViews,proto3" json:"visibility_views,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetNamespaceResponse) Reset() {
@@ -3979,6 +3982,13 @@ func (x *GetNamespaceResponse) GetIsGlobalNamespace() bool {
 	return false
 }
 
+func (x *GetNamespaceResponse) GetVisibilityViews() map[string]*v12.VisibilityView {
+	if x != nil {
+		return x.VisibilityViews
+	}
+	return nil
+}
+
 type GetDLQTasksRequest struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	DlqKey *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
@@ -5220,8 +5230,13 @@ type DescribeTaskQueuePartitionResponse struct {
 	// contains k-v pairs of the type: buildID -> TaskQueueVersionInfoInternal
 	VersionsInfoInternal map[string]*v114.TaskQueueVersionInfoInternal `protobuf:"bytes,1,rep,name=versions_info_internal,json=versionsInfoInternal,proto3" json:"versions_info_internal,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	ScaleInfo            *v114.PartitionScaleInfo                      `protobuf:"bytes,2,opt,name=scale_info,json=scaleInfo,proto3" json:"scale_info,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Dispatch pause as seen by this partition. Absent if dispatch is not paused.
+	DispatchPause *v12.TaskQueueDispatchPause `protobuf:"bytes,3,opt,name=dispatch_pause,json=dispatchPause,proto3" json:"dispatch_pause,omitempty"`
+	// Number of activity tasks dispatched by this partition and not yet completed, per fairness
+	// key. Only reported when a fairness key concurrency limit is configured.
+	OutstandingTasksByFairnessKey map[string]int64 `protobuf:"bytes,4,rep,name=outstanding_tasks_by_fairness_key,json=outstandingTasksByFairnessKey,proto3" json:"outstanding_tasks_by_fairness_key,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
 }
 
 func (x *DescribeTaskQueuePartitionResponse) Reset() {
@@ -5268,6 +5283,20 @@ func (x *DescribeTaskQueuePartitionResponse) GetScaleInfo() *v114.PartitionScale
 	return nil
 }
 
+func (x *DescribeTaskQueuePartitionResponse) GetDispatchPause() *v12.TaskQueueDispatchPause {
+	if x != nil {
+		return x.DispatchPause
+	}
+	return nil
+}
+
+func (x *DescribeTaskQueuePartitionResponse) GetOutstandingTasksByFairnessKey() map[string]int64 {
+	if x != nil {
+		return x.OutstandingTasksByFairnessKey
+	}
+	return nil
+}
+
 type ForceUnloadTaskQueuePartitionRequest struct {
 	state              protoimpl.MessageState   `protogen:"open.v1"`
 	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -5803,29 +5832,29 @@ func (*MigrateScheduleResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{94}
 }
 
-type AddTasksRequest_Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Blob          *v1.DataBlob           `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+type GetDynamicConfigOverridesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return overrides for this key. Returns all overrides if empty.
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTasksRequest_Task) Reset() {
-	*x = AddTasksRequest_Task{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+func (x *GetDynamicConfigOverridesRequest) Reset() {
+	*x = GetDynamicConfigOverridesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTasksRequest_Task) String() string {
+func (x *GetDynamicConfigOverridesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTasksRequest_Task) ProtoMessage() {}
+func (*GetDynamicConfigOverridesRequest) ProtoMessage() {}
 
-func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+func (x *GetDynamicConfigOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5836,49 +5865,162 @@ func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTasksRequest_Task.ProtoReflect.Descriptor instead.
-func (*AddTasksRequest_Task) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74, 0}
+// Deprecated: Use GetDynamicConfigOverridesRequest.ProtoReflect.Descriptor instead.
+func (*GetDynamicConfigOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *AddTasksRequest_Task) GetCategoryId() int32 {
+func (x *GetDynamicConfigOverridesRequest) GetKey() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *AddTasksRequest_Task) GetBlob() *v1.DataBlob {
+type GetDynamicConfigOverridesResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Overrides     []*v12.DynamicConfigOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDynamicConfigOverridesResponse) Reset() {
+	*x = GetDynamicConfigOverridesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDynamicConfigOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDynamicConfigOverridesResponse) ProtoMessage() {}
+
+func (x *GetDynamicConfigOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
 	if x != nil {
-		return x.Blob
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDynamicConfigOverridesResponse.ProtoReflect.Descriptor instead.
+func (*GetDynamicConfigOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetDynamicConfigOverridesResponse) GetOverrides() []*v12.DynamicConfigOverride {
+	if x != nil {
+		return x.Overrides
 	}
 	return nil
 }
 
-type ListQueuesResponse_QueueInfo struct {
+type SetDynamicConfigOverrideRequest struct {
+	state       protoimpl.MessageState        `protogen:"open.v1"`
+	Key         string                        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Constraints *v12.DynamicConfigConstraints `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// Value encoded as YAML, using the same syntax as a value in the dynamic config file.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Identity of the caller, recorded as the author of the change.
+	Identity string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Reason for the change.
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDynamicConfigOverrideRequest) Reset() {
+	*x = SetDynamicConfigOverrideRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDynamicConfigOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDynamicConfigOverrideRequest) ProtoMessage() {}
+
+func (x *SetDynamicConfigOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDynamicConfigOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetDynamicConfigOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SetDynamicConfigOverrideRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetDynamicConfigOverrideRequest) GetConstraints() *v12.DynamicConfigConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *SetDynamicConfigOverrideRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SetDynamicConfigOverrideRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *SetDynamicConfigOverrideRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetDynamicConfigOverrideResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueueName     string                 `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queue_name,omitempty"`
-	MessageCount  int64                  `protobuf:"varint,2,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
-	LastMessageId int64                  `protobuf:"varint,3,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListQueuesResponse_QueueInfo) Reset() {
-	*x = ListQueuesResponse_QueueInfo{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+func (x *SetDynamicConfigOverrideResponse) Reset() {
+	*x = SetDynamicConfigOverrideResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesResponse_QueueInfo) String() string {
+func (x *SetDynamicConfigOverrideResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesResponse_QueueInfo) ProtoMessage() {}
+func (*SetDynamicConfigOverrideResponse) ProtoMessage() {}
 
-func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+func (x *SetDynamicConfigOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5889,120 +6031,2524 @@ func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesResponse_QueueInfo.ProtoReflect.Descriptor instead.
-func (*ListQueuesResponse_QueueInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77, 0}
+// Deprecated: Use SetDynamicConfigOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetDynamicConfigOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *ListQueuesResponse_QueueInfo) GetQueueName() string {
+type DeleteDynamicConfigOverrideRequest struct {
+	state       protoimpl.MessageState        `protogen:"open.v1"`
+	Key         string                        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Constraints *v12.DynamicConfigConstraints `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// Identity of the caller, recorded as the author of the change.
+	Identity string `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Reason for the change.
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDynamicConfigOverrideRequest) Reset() {
+	*x = DeleteDynamicConfigOverrideRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDynamicConfigOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDynamicConfigOverrideRequest) ProtoMessage() {}
+
+func (x *DeleteDynamicConfigOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
 	if x != nil {
-		return x.QueueName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDynamicConfigOverrideRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDynamicConfigOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DeleteDynamicConfigOverrideRequest) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
 	return ""
 }
 
-func (x *ListQueuesResponse_QueueInfo) GetMessageCount() int64 {
+func (x *DeleteDynamicConfigOverrideRequest) GetConstraints() *v12.DynamicConfigConstraints {
 	if x != nil {
-		return x.MessageCount
+		return x.Constraints
 	}
-	return 0
+	return nil
 }
 
-func (x *ListQueuesResponse_QueueInfo) GetLastMessageId() int64 {
+func (x *DeleteDynamicConfigOverrideRequest) GetIdentity() string {
 	if x != nil {
-		return x.LastMessageId
+		return x.Identity
 	}
-	return 0
+	return ""
 }
 
-var File_temporal_server_api_adminservice_v1_request_response_proto protoreflect.FileDescriptor
+func (x *DeleteDynamicConfigOverrideRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
 
-const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc = "" +
-	"\n" +
-	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a+temporal/server/api/health/v1/message.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a4temporal/server/api/persistence/v1/task_queues.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
-	"\x1aRebuildMutableStateRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
-	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x1d\n" +
-	"\x1bRebuildMutableStateResponse\"\xc1\x02\n" +
-	"\x1eImportWorkflowExecutionRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
-	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12I\n" +
-	"\x0fhistory_batches\x18\x03 \x03(\v2 .temporal.api.common.v1.DataBlobR\x0ehistoryBatches\x12W\n" +
-	"\x0fversion_history\x18\x04 \x01(\v2..temporal.server.api.history.v1.VersionHistoryR\x0eversionHistory\x12\x14\n" +
-	"\x05token\x18\x05 \x01(\fR\x05token\"7\n" +
-	"\x1fImportWorkflowExecutionResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\fR\x05token\"\xf1\x01\n" +
-	"\x1bDescribeMutableStateRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
-	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12*\n" +
-	"\x11skip_force_reload\x18\x03 \x01(\bR\x0fskipForceReload\x12\x1c\n" +
-	"\tarchetype\x18\x04 \x01(\tR\tarchetype\x12!\n" +
-	"\farchetype_id\x18\x05 \x01(\rR\varchetypeId\"\xb6\x02\n" +
-	"\x1cDescribeMutableStateResponse\x12\x19\n" +
-	"\bshard_id\x18\x01 \x01(\tR\ashardId\x12!\n" +
-	"\fhistory_addr\x18\x02 \x01(\tR\vhistoryAddr\x12h\n" +
-	"\x13cache_mutable_state\x18\x03 \x01(\v28.temporal.server.api.persistence.v1.WorkflowMutableStateR\x11cacheMutableState\x12n\n" +
-	"\x16database_mutable_state\x18\x04 \x01(\v28.temporal.server.api.persistence.v1.WorkflowMutableStateR\x14databaseMutableState\"\xd2\x01\n" +
-	"\x1aDescribeHistoryHostRequest\x12!\n" +
-	"\fhost_address\x18\x01 \x01(\tR\vhostAddress\x12\x19\n" +
-	"\bshard_id\x18\x02 \x01(\x05R\ashardId\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12X\n" +
-	"\x12workflow_execution\x18\x04 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\x11workflowExecution\"\xde\x01\n" +
-	"\x1bDescribeHistoryHostResponse\x12#\n" +
-	"\rshards_number\x18\x01 \x01(\x05R\fshardsNumber\x12\x1b\n" +
-	"\tshard_ids\x18\x02 \x03(\x05R\bshardIds\x12]\n" +
-	"\x0fnamespace_cache\x18\x03 \x01(\v24.temporal.server.api.namespace.v1.NamespaceCacheInfoR\x0enamespaceCache\x12\x18\n" +
-	"\aaddress\x18\x05 \x01(\tR\aaddressJ\x04\b\x04\x10\x05\".\n" +
-	"\x11CloseShardRequest\x12\x19\n" +
-	"\bshard_id\x18\x01 \x01(\x05R\ashardId\"\x14\n" +
-	"\x12CloseShardResponse\",\n" +
-	"\x0fGetShardRequest\x12\x19\n" +
-	"\bshard_id\x18\x01 \x01(\x05R\ashardId\"`\n" +
-	"\x10GetShardResponse\x12L\n" +
-	"\n" +
-	"shard_info\x18\x01 \x01(\v2-.temporal.server.api.persistence.v1.ShardInfoR\tshardInfo\"\xe1\x01\n" +
-	"\x17ListHistoryTasksRequest\x12\x19\n" +
-	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x12\x1a\n" +
-	"\bcategory\x18\x02 \x01(\x05R\bcategory\x12H\n" +
-	"\n" +
-	"task_range\x18\x03 \x01(\v2).temporal.server.api.history.v1.TaskRangeR\ttaskRange\x12\x1d\n" +
-	"\n" +
-	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x12&\n" +
-	"\x0fnext_page_token\x18\x05 \x01(\fR\rnextPageToken\"\x83\x01\n" +
-	"\x18ListHistoryTasksResponse\x12?\n" +
-	"\x05tasks\x18\x01 \x03(\v2).temporal.server.api.adminservice.v1.TaskR\x05tasks\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"\x92\x02\n" +
-	"\x04Task\x12!\n" +
-	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
-	"\vworkflow_id\x18\x02 \x01(\tR\n" +
-	"workflowId\x12\x15\n" +
-	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x17\n" +
-	"\atask_id\x18\x04 \x01(\x03R\x06taskId\x12C\n" +
-	"\ttask_type\x18\x05 \x01(\x0e2&.temporal.server.api.enums.v1.TaskTypeR\btaskType\x127\n" +
-	"\tfire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfireTime\x12\x18\n" +
-	"\aversion\x18\a \x01(\x03R\aversion\"\xa8\x01\n" +
-	"\x11RemoveTaskRequest\x12\x19\n" +
-	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x12\x1a\n" +
-	"\bcategory\x18\x02 \x01(\x05R\bcategory\x12\x17\n" +
-	"\atask_id\x18\x03 \x01(\x03R\x06taskId\x12C\n" +
-	"\x0fvisibility_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0evisibilityTime\"\x14\n" +
-	"\x12RemoveTaskResponse\"\x93\x03\n" +
-	"'GetWorkflowExecutionRawHistoryV2Request\x12!\n" +
-	"\fnamespace_id\x18\t \x01(\tR\vnamespaceId\x12G\n" +
-	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12$\n" +
-	"\x0estart_event_id\x18\x03 \x01(\x03R\fstartEventId\x12.\n" +
-	"\x13start_event_version\x18\x04 \x01(\x03R\x11startEventVersion\x12 \n" +
-	"\fend_event_id\x18\x05 \x01(\x03R\n" +
-	"endEventId\x12*\n" +
-	"\x11end_event_version\x18\x06 \x01(\x03R\x0fendEventVersion\x12*\n" +
-	"\x11maximum_page_size\x18\a \x01(\x05R\x0fmaximumPageSize\x12&\n" +
-	"\x0fnext_page_token\x18\b \x01(\fR\rnextPageTokenJ\x04\b\x01\x10\x02\"\xa0\x02\n" +
-	"(GetWorkflowExecutionRawHistoryV2Response\x12&\n" +
-	"\x0fnext_page_token\x18\x01 \x01(\fR\rnextPageToken\x12I\n" +
-	"\x0fhistory_batches\x18\x02 \x03(\v2 .temporal.api.common.v1.DataBlobR\x0ehistoryBatches\x12W\n" +
-	"\x0fversion_history\x18\x03 \x01(\v2..temporal.server.api.history.v1.VersionHistoryR\x0eversionHistory\x12(\n" +
-	"\x10history_node_ids\x18\x04 \x03(\x03R\x0ehistoryNodeIds\"\x8b\x03\n" +
-	"%GetWorkflowExecutionRawHistoryRequest\x12!\n" +
+type DeleteDynamicConfigOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDynamicConfigOverrideResponse) Reset() {
+	*x = DeleteDynamicConfigOverrideResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDynamicConfigOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDynamicConfigOverrideResponse) ProtoMessage() {}
+
+func (x *DeleteDynamicConfigOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDynamicConfigOverrideResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDynamicConfigOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{100}
+}
+
+type GetDynamicConfigHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return changes for this key. Returns changes for all keys if empty.
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDynamicConfigHistoryRequest) Reset() {
+	*x = GetDynamicConfigHistoryRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDynamicConfigHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDynamicConfigHistoryRequest) ProtoMessage() {}
+
+func (x *GetDynamicConfigHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDynamicConfigHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDynamicConfigHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetDynamicConfigHistoryRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetDynamicConfigHistoryResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Changes       []*v12.DynamicConfigChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDynamicConfigHistoryResponse) Reset() {
+	*x = GetDynamicConfigHistoryResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDynamicConfigHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDynamicConfigHistoryResponse) ProtoMessage() {}
+
+func (x *GetDynamicConfigHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDynamicConfigHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDynamicConfigHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetDynamicConfigHistoryResponse) GetChanges() []*v12.DynamicConfigChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ExplainDynamicConfigRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Key         string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Constraints *v116.Constraints      `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// Optional key used by gradual changes and percentage rollouts to decide whether the new value
+	// applies, e.g. "<namespace>\x00<schedule id>". See the callers of dynamicconfig.RolloutAccepts.
+	RolloutKey    []byte `protobuf:"bytes,3,opt,name=rollout_key,json=rolloutKey,proto3" json:"rollout_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainDynamicConfigRequest) Reset() {
+	*x = ExplainDynamicConfigRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainDynamicConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainDynamicConfigRequest) ProtoMessage() {}
+
+func (x *ExplainDynamicConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainDynamicConfigRequest.ProtoReflect.Descriptor instead.
+func (*ExplainDynamicConfigRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ExplainDynamicConfigRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExplainDynamicConfigRequest) GetConstraints() *v116.Constraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *ExplainDynamicConfigRequest) GetRolloutKey() []byte {
+	if x != nil {
+		return x.RolloutKey
+	}
+	return nil
+}
+
+type ExplainDynamicConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Explanations from the frontend host that handled the request and from every history and
+	// matching host.
+	Hosts         []*v116.HostExplanation `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainDynamicConfigResponse) Reset() {
+	*x = ExplainDynamicConfigResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainDynamicConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainDynamicConfigResponse) ProtoMessage() {}
+
+func (x *ExplainDynamicConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainDynamicConfigResponse.ProtoReflect.Descriptor instead.
+func (*ExplainDynamicConfigResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ExplainDynamicConfigResponse) GetHosts() []*v116.HostExplanation {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+type StartVisibilityDriftCheckRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Fraction of executions to compare, in (0, 1]. 0 compares every execution.
+	SampleRate float64 `protobuf:"fixed64,2,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	// Stop after comparing this many executions. 0 means no limit.
+	MaxExecutions int64 `protobuf:"varint,3,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	// Optional visibility query to limit the executions that are scanned.
+	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// Re-emit visibility tasks for executions that are missing from or differ in the secondary store.
+	Repair bool `protobuf:"varint,5,opt,name=repair,proto3" json:"repair,omitempty"`
+	// Maximum number of executions compared per second. 0 uses the default.
+	Rps           int32 `protobuf:"varint,6,opt,name=rps,proto3" json:"rps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartVisibilityDriftCheckRequest) Reset() {
+	*x = StartVisibilityDriftCheckRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVisibilityDriftCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVisibilityDriftCheckRequest) ProtoMessage() {}
+
+func (x *StartVisibilityDriftCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVisibilityDriftCheckRequest.ProtoReflect.Descriptor instead.
+func (*StartVisibilityDriftCheckRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *StartVisibilityDriftCheckRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StartVisibilityDriftCheckRequest) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *StartVisibilityDriftCheckRequest) GetMaxExecutions() int64 {
+	if x != nil {
+		return x.MaxExecutions
+	}
+	return 0
+}
+
+func (x *StartVisibilityDriftCheckRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *StartVisibilityDriftCheckRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+func (x *StartVisibilityDriftCheckRequest) GetRps() int32 {
+	if x != nil {
+		return x.Rps
+	}
+	return 0
+}
+
+type StartVisibilityDriftCheckResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job_id identifies the drift check in DescribeVisibilityDriftCheck and CancelVisibilityDriftCheck.
+	JobId         string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartVisibilityDriftCheckResponse) Reset() {
+	*x = StartVisibilityDriftCheckResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVisibilityDriftCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVisibilityDriftCheckResponse) ProtoMessage() {}
+
+func (x *StartVisibilityDriftCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVisibilityDriftCheckResponse.ProtoReflect.Descriptor instead.
+func (*StartVisibilityDriftCheckResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *StartVisibilityDriftCheckResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DescribeVisibilityDriftCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeVisibilityDriftCheckRequest) Reset() {
+	*x = DescribeVisibilityDriftCheckRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeVisibilityDriftCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeVisibilityDriftCheckRequest) ProtoMessage() {}
+
+func (x *DescribeVisibilityDriftCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeVisibilityDriftCheckRequest.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityDriftCheckRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *DescribeVisibilityDriftCheckRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DescribeVisibilityDriftCheckResponse struct {
+	state     protoimpl.MessageState      `protogen:"open.v1"`
+	Namespace string                      `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Status    v16.WorkflowExecutionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	StartTime *timestamppb.Timestamp      `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Repair    bool                        `protobuf:"varint,5,opt,name=repair,proto3" json:"repair,omitempty"`
+	// Number of executions read from the primary store.
+	ExecutionsScanned int64 `protobuf:"varint,6,opt,name=executions_scanned,json=executionsScanned,proto3" json:"executions_scanned,omitempty"`
+	// Number of scanned executions that were compared with the secondary store.
+	ExecutionsCompared int64 `protobuf:"varint,7,opt,name=executions_compared,json=executionsCompared,proto3" json:"executions_compared,omitempty"`
+	// Number of compared executions that don't exist in the secondary store.
+	MissingCount int64 `protobuf:"varint,8,opt,name=missing_count,json=missingCount,proto3" json:"missing_count,omitempty"`
+	// Number of compared executions that exist in the secondary store but differ.
+	MismatchCount int64 `protobuf:"varint,9,opt,name=mismatch_count,json=mismatchCount,proto3" json:"mismatch_count,omitempty"`
+	// Number of executions for which visibility tasks were re-emitted.
+	RepairedCount int64 `protobuf:"varint,10,opt,name=repaired_count,json=repairedCount,proto3" json:"repaired_count,omitempty"`
+	// Number of mismatches by field: status, close_time, search_attributes or memo.
+	FieldMismatchCounts map[string]int64 `protobuf:"bytes,11,rep,name=field_mismatch_counts,json=fieldMismatchCounts,proto3" json:"field_mismatch_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The first drifted executions found. The number kept is capped by the worker.
+	DriftedExecutions []*VisibilityDriftRecord `protobuf:"bytes,12,rep,name=drifted_executions,json=driftedExecutions,proto3" json:"drifted_executions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) Reset() {
+	*x = DescribeVisibilityDriftCheckResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeVisibilityDriftCheckResponse) ProtoMessage() {}
+
+func (x *DescribeVisibilityDriftCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeVisibilityDriftCheckResponse.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityDriftCheckResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetStatus() v16.WorkflowExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v16.WorkflowExecutionStatus(0)
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetExecutionsScanned() int64 {
+	if x != nil {
+		return x.ExecutionsScanned
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetExecutionsCompared() int64 {
+	if x != nil {
+		return x.ExecutionsCompared
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetMissingCount() int64 {
+	if x != nil {
+		return x.MissingCount
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetMismatchCount() int64 {
+	if x != nil {
+		return x.MismatchCount
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetRepairedCount() int64 {
+	if x != nil {
+		return x.RepairedCount
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetFieldMismatchCounts() map[string]int64 {
+	if x != nil {
+		return x.FieldMismatchCounts
+	}
+	return nil
+}
+
+func (x *DescribeVisibilityDriftCheckResponse) GetDriftedExecutions() []*VisibilityDriftRecord {
+	if x != nil {
+		return x.DriftedExecutions
+	}
+	return nil
+}
+
+type VisibilityDriftRecord struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Execution *v1.WorkflowExecution  `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	// The execution doesn't exist in the secondary store.
+	Missing          bool     `protobuf:"varint,2,opt,name=missing,proto3" json:"missing,omitempty"`
+	MismatchedFields []string `protobuf:"bytes,3,rep,name=mismatched_fields,json=mismatchedFields,proto3" json:"mismatched_fields,omitempty"`
+	Repaired         bool     `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VisibilityDriftRecord) Reset() {
+	*x = VisibilityDriftRecord{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisibilityDriftRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisibilityDriftRecord) ProtoMessage() {}
+
+func (x *VisibilityDriftRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisibilityDriftRecord.ProtoReflect.Descriptor instead.
+func (*VisibilityDriftRecord) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *VisibilityDriftRecord) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *VisibilityDriftRecord) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+func (x *VisibilityDriftRecord) GetMismatchedFields() []string {
+	if x != nil {
+		return x.MismatchedFields
+	}
+	return nil
+}
+
+func (x *VisibilityDriftRecord) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type CancelVisibilityDriftCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelVisibilityDriftCheckRequest) Reset() {
+	*x = CancelVisibilityDriftCheckRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelVisibilityDriftCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelVisibilityDriftCheckRequest) ProtoMessage() {}
+
+func (x *CancelVisibilityDriftCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelVisibilityDriftCheckRequest.ProtoReflect.Descriptor instead.
+func (*CancelVisibilityDriftCheckRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CancelVisibilityDriftCheckRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *CancelVisibilityDriftCheckRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelVisibilityDriftCheckResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// This is true if the drift check was terminated by this request and false if it had already finished.
+	Canceled      bool `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelVisibilityDriftCheckResponse) Reset() {
+	*x = CancelVisibilityDriftCheckResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelVisibilityDriftCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelVisibilityDriftCheckResponse) ProtoMessage() {}
+
+func (x *CancelVisibilityDriftCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelVisibilityDriftCheckResponse.ProtoReflect.Descriptor instead.
+func (*CancelVisibilityDriftCheckResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CancelVisibilityDriftCheckResponse) GetCanceled() bool {
+	if x != nil {
+		return x.Canceled
+	}
+	return false
+}
+
+type StartVisibilityRebuildRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The store to write records to. Unspecified writes to every configured store.
+	VisibilityStore v14.VisibilityStoreTarget `protobuf:"varint,2,opt,name=visibility_store,json=visibilityStore,proto3,enum=temporal.server.api.enums.v1.VisibilityStoreTarget" json:"visibility_store,omitempty"`
+	// Maximum number of executions rebuilt per second. 0 uses the default.
+	Rps           int32 `protobuf:"varint,3,opt,name=rps,proto3" json:"rps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartVisibilityRebuildRequest) Reset() {
+	*x = StartVisibilityRebuildRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVisibilityRebuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVisibilityRebuildRequest) ProtoMessage() {}
+
+func (x *StartVisibilityRebuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVisibilityRebuildRequest.ProtoReflect.Descriptor instead.
+func (*StartVisibilityRebuildRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *StartVisibilityRebuildRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StartVisibilityRebuildRequest) GetVisibilityStore() v14.VisibilityStoreTarget {
+	if x != nil {
+		return x.VisibilityStore
+	}
+	return v14.VisibilityStoreTarget(0)
+}
+
+func (x *StartVisibilityRebuildRequest) GetRps() int32 {
+	if x != nil {
+		return x.Rps
+	}
+	return 0
+}
+
+type StartVisibilityRebuildResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job_id identifies the rebuild in DescribeVisibilityRebuild and CancelVisibilityRebuild.
+	JobId         string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartVisibilityRebuildResponse) Reset() {
+	*x = StartVisibilityRebuildResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVisibilityRebuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVisibilityRebuildResponse) ProtoMessage() {}
+
+func (x *StartVisibilityRebuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVisibilityRebuildResponse.ProtoReflect.Descriptor instead.
+func (*StartVisibilityRebuildResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *StartVisibilityRebuildResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DescribeVisibilityRebuildRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeVisibilityRebuildRequest) Reset() {
+	*x = DescribeVisibilityRebuildRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeVisibilityRebuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeVisibilityRebuildRequest) ProtoMessage() {}
+
+func (x *DescribeVisibilityRebuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeVisibilityRebuildRequest.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityRebuildRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *DescribeVisibilityRebuildRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DescribeVisibilityRebuildResponse struct {
+	state           protoimpl.MessageState      `protogen:"open.v1"`
+	Namespace       string                      `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	VisibilityStore v14.VisibilityStoreTarget   `protobuf:"varint,2,opt,name=visibility_store,json=visibilityStore,proto3,enum=temporal.server.api.enums.v1.VisibilityStoreTarget" json:"visibility_store,omitempty"`
+	Status          v16.WorkflowExecutionStatus `protobuf:"varint,3,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	StartTime       *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         *timestamppb.Timestamp      `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ShardCount      int32                       `protobuf:"varint,6,opt,name=shard_count,json=shardCount,proto3" json:"shard_count,omitempty"`
+	// Number of shards whose executions have all been rebuilt.
+	ShardsCompleted int32 `protobuf:"varint,7,opt,name=shards_completed,json=shardsCompleted,proto3" json:"shards_completed,omitempty"`
+	// Number of executions whose visibility record was written.
+	ExecutionsRebuilt int64 `protobuf:"varint,8,opt,name=executions_rebuilt,json=executionsRebuilt,proto3" json:"executions_rebuilt,omitempty"`
+	// Number of rebuilt executions that are closed.
+	ClosedExecutionsRebuilt int64 `protobuf:"varint,9,opt,name=closed_executions_rebuilt,json=closedExecutionsRebuilt,proto3" json:"closed_executions_rebuilt,omitempty"`
+	// Number of executions that were deleted or aren't workflows.
+	ExecutionsSkipped int64 `protobuf:"varint,10,opt,name=executions_skipped,json=executionsSkipped,proto3" json:"executions_skipped,omitempty"`
+	// Number of closed executions that were written without memo and search attributes because they were no longer
+	// available.
+	RelocatableAttributesMissingCount int64 `protobuf:"varint,11,opt,name=relocatable_attributes_missing_count,json=relocatableAttributesMissingCount,proto3" json:"relocatable_attributes_missing_count,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *DescribeVisibilityRebuildResponse) Reset() {
+	*x = DescribeVisibilityRebuildResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeVisibilityRebuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeVisibilityRebuildResponse) ProtoMessage() {}
+
+func (x *DescribeVisibilityRebuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeVisibilityRebuildResponse.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityRebuildResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DescribeVisibilityRebuildResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeVisibilityRebuildResponse) GetVisibilityStore() v14.VisibilityStoreTarget {
+	if x != nil {
+		return x.VisibilityStore
+	}
+	return v14.VisibilityStoreTarget(0)
+}
+
+func (x *DescribeVisibilityRebuildResponse) GetStatus() v16.WorkflowExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v16.WorkflowExecutionStatus(0)
+}
+
+func (x *DescribeVisibilityRebuildResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DescribeVisibilityRebuildResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *DescribeVisibilityRebuildResponse) GetShardCount() int32 {
+	if x != nil {
+		return x.ShardCount
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityRebuildResponse) GetShardsCompleted() int32 {
+	if x != nil {
+		return x.ShardsCompleted
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityRebuildResponse) GetExecutionsRebuilt() int64 {
+	if x != nil {
+		return x.ExecutionsRebuilt
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityRebuildResponse) GetClosedExecutionsRebuilt() int64 {
+	if x != nil {
+		return x.ClosedExecutionsRebuilt
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityRebuildResponse) GetExecutionsSkipped() int64 {
+	if x != nil {
+		return x.ExecutionsSkipped
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityRebuildResponse) GetRelocatableAttributesMissingCount() int64 {
+	if x != nil {
+		return x.RelocatableAttributesMissingCount
+	}
+	return 0
+}
+
+type CancelVisibilityRebuildRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelVisibilityRebuildRequest) Reset() {
+	*x = CancelVisibilityRebuildRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelVisibilityRebuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelVisibilityRebuildRequest) ProtoMessage() {}
+
+func (x *CancelVisibilityRebuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelVisibilityRebuildRequest.ProtoReflect.Descriptor instead.
+func (*CancelVisibilityRebuildRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CancelVisibilityRebuildRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *CancelVisibilityRebuildRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelVisibilityRebuildResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// This is true if the rebuild was terminated by this request and false if it had already finished.
+	Canceled      bool `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelVisibilityRebuildResponse) Reset() {
+	*x = CancelVisibilityRebuildResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelVisibilityRebuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelVisibilityRebuildResponse) ProtoMessage() {}
+
+func (x *CancelVisibilityRebuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelVisibilityRebuildResponse.ProtoReflect.Descriptor instead.
+func (*CancelVisibilityRebuildResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CancelVisibilityRebuildResponse) GetCanceled() bool {
+	if x != nil {
+		return x.Canceled
+	}
+	return false
+}
+
+type WatchWorkflowExecutionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query that executions must match, with the same syntax as ListWorkflowExecutions. ORDER BY and
+	// GROUP BY are not supported.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// resume_token from the last response received on a previous stream. Empty starts at the latest change.
+	ResumeToken   []byte `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchWorkflowExecutionsRequest) Reset() {
+	*x = WatchWorkflowExecutionsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchWorkflowExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWorkflowExecutionsRequest) ProtoMessage() {}
+
+func (x *WatchWorkflowExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWorkflowExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*WatchWorkflowExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *WatchWorkflowExecutionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WatchWorkflowExecutionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *WatchWorkflowExecutionsRequest) GetResumeToken() []byte {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return nil
+}
+
+type WatchWorkflowExecutionsResponse struct {
+	state     protoimpl.MessageState       `protogen:"open.v1"`
+	EventType v14.VisibilityWatchEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=temporal.server.api.enums.v1.VisibilityWatchEventType" json:"event_type,omitempty"`
+	// Visibility record of the execution after the change. Not set for resync events.
+	ExecutionInfo *v17.WorkflowExecutionInfo `protobuf:"bytes,2,opt,name=execution_info,json=executionInfo,proto3" json:"execution_info,omitempty"`
+	// False if the execution matched the query before the change but doesn't anymore.
+	MatchesQuery bool `protobuf:"varint,3,opt,name=matches_query,json=matchesQuery,proto3" json:"matches_query,omitempty"`
+	// Opaque token to resume the stream after this response.
+	ResumeToken   []byte `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchWorkflowExecutionsResponse) Reset() {
+	*x = WatchWorkflowExecutionsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchWorkflowExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWorkflowExecutionsResponse) ProtoMessage() {}
+
+func (x *WatchWorkflowExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWorkflowExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*WatchWorkflowExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *WatchWorkflowExecutionsResponse) GetEventType() v14.VisibilityWatchEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return v14.VisibilityWatchEventType(0)
+}
+
+func (x *WatchWorkflowExecutionsResponse) GetExecutionInfo() *v17.WorkflowExecutionInfo {
+	if x != nil {
+		return x.ExecutionInfo
+	}
+	return nil
+}
+
+func (x *WatchWorkflowExecutionsResponse) GetMatchesQuery() bool {
+	if x != nil {
+		return x.MatchesQuery
+	}
+	return false
+}
+
+func (x *WatchWorkflowExecutionsResponse) GetResumeToken() []byte {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return nil
+}
+
+type SaveVisibilityViewRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Namespace   string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Query       string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Owner       string                 `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// If set, the view is only saved if its current version is this one, and 0 is not a valid version. Not set
+	// creates the view or overwrites it.
+	ExpectedVersion int64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SaveVisibilityViewRequest) Reset() {
+	*x = SaveVisibilityViewRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveVisibilityViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveVisibilityViewRequest) ProtoMessage() {}
+
+func (x *SaveVisibilityViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveVisibilityViewRequest.ProtoReflect.Descriptor instead.
+func (*SaveVisibilityViewRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *SaveVisibilityViewRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SaveVisibilityViewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveVisibilityViewRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SaveVisibilityViewRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SaveVisibilityViewRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SaveVisibilityViewRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type SaveVisibilityViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	View          *v12.VisibilityView    `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveVisibilityViewResponse) Reset() {
+	*x = SaveVisibilityViewResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveVisibilityViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveVisibilityViewResponse) ProtoMessage() {}
+
+func (x *SaveVisibilityViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveVisibilityViewResponse.ProtoReflect.Descriptor instead.
+func (*SaveVisibilityViewResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *SaveVisibilityViewResponse) GetView() *v12.VisibilityView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+type DescribeVisibilityViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeVisibilityViewRequest) Reset() {
+	*x = DescribeVisibilityViewRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeVisibilityViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeVisibilityViewRequest) ProtoMessage() {}
+
+func (x *DescribeVisibilityViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeVisibilityViewRequest.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityViewRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *DescribeVisibilityViewRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeVisibilityViewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DescribeVisibilityViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	View          *v12.VisibilityView    `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeVisibilityViewResponse) Reset() {
+	*x = DescribeVisibilityViewResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeVisibilityViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeVisibilityViewResponse) ProtoMessage() {}
+
+func (x *DescribeVisibilityViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeVisibilityViewResponse.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityViewResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *DescribeVisibilityViewResponse) GetView() *v12.VisibilityView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+type ListVisibilityViewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVisibilityViewsRequest) Reset() {
+	*x = ListVisibilityViewsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVisibilityViewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVisibilityViewsRequest) ProtoMessage() {}
+
+func (x *ListVisibilityViewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVisibilityViewsRequest.ProtoReflect.Descriptor instead.
+func (*ListVisibilityViewsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ListVisibilityViewsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListVisibilityViewsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sorted by name.
+	Views         []*v12.VisibilityView `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVisibilityViewsResponse) Reset() {
+	*x = ListVisibilityViewsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVisibilityViewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVisibilityViewsResponse) ProtoMessage() {}
+
+func (x *ListVisibilityViewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVisibilityViewsResponse.ProtoReflect.Descriptor instead.
+func (*ListVisibilityViewsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListVisibilityViewsResponse) GetViews() []*v12.VisibilityView {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+type DeleteVisibilityViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVisibilityViewRequest) Reset() {
+	*x = DeleteVisibilityViewRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVisibilityViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVisibilityViewRequest) ProtoMessage() {}
+
+func (x *DeleteVisibilityViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVisibilityViewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVisibilityViewRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *DeleteVisibilityViewRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteVisibilityViewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteVisibilityViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVisibilityViewResponse) Reset() {
+	*x = DeleteVisibilityViewResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVisibilityViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVisibilityViewResponse) ProtoMessage() {}
+
+func (x *DeleteVisibilityViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVisibilityViewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVisibilityViewResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{127}
+}
+
+type RestoreArchivedWorkflowExecutionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace the execution was archived from. Its history archival URI is used to read the history.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Both workflow_id and run_id are required.
+	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// Namespace to restore the execution into. Defaults to namespace.
+	TargetNamespace string `protobuf:"bytes,3,opt,name=target_namespace,json=targetNamespace,proto3" json:"target_namespace,omitempty"`
+	// If set, the restored execution is reset to this WorkflowTaskCompleted, WorkflowTaskFailed or WorkflowTaskTimedOut
+	// event, so that it continues in a new run.
+	ResetEventId int64  `protobuf:"varint,4,opt,name=reset_event_id,json=resetEventId,proto3" json:"reset_event_id,omitempty"`
+	ResetReason  string `protobuf:"bytes,5,opt,name=reset_reason,json=resetReason,proto3" json:"reset_reason,omitempty"`
+	// Used to dedup the reset.
+	RequestId     string `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreArchivedWorkflowExecutionRequest) Reset() {
+	*x = RestoreArchivedWorkflowExecutionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreArchivedWorkflowExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreArchivedWorkflowExecutionRequest) ProtoMessage() {}
+
+func (x *RestoreArchivedWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreArchivedWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreArchivedWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *RestoreArchivedWorkflowExecutionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RestoreArchivedWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *RestoreArchivedWorkflowExecutionRequest) GetTargetNamespace() string {
+	if x != nil {
+		return x.TargetNamespace
+	}
+	return ""
+}
+
+func (x *RestoreArchivedWorkflowExecutionRequest) GetResetEventId() int64 {
+	if x != nil {
+		return x.ResetEventId
+	}
+	return 0
+}
+
+func (x *RestoreArchivedWorkflowExecutionRequest) GetResetReason() string {
+	if x != nil {
+		return x.ResetReason
+	}
+	return ""
+}
+
+func (x *RestoreArchivedWorkflowExecutionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RestoreArchivedWorkflowExecutionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Run ID of the execution created by the reset. Not set if the execution isn't reset.
+	ResetRunId    string `protobuf:"bytes,1,opt,name=reset_run_id,json=resetRunId,proto3" json:"reset_run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreArchivedWorkflowExecutionResponse) Reset() {
+	*x = RestoreArchivedWorkflowExecutionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreArchivedWorkflowExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreArchivedWorkflowExecutionResponse) ProtoMessage() {}
+
+func (x *RestoreArchivedWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreArchivedWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreArchivedWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *RestoreArchivedWorkflowExecutionResponse) GetResetRunId() string {
+	if x != nil {
+		return x.ResetRunId
+	}
+	return ""
+}
+
+type PauseTaskQueueDispatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity      string                 `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseTaskQueueDispatchRequest) Reset() {
+	*x = PauseTaskQueueDispatchRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseTaskQueueDispatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseTaskQueueDispatchRequest) ProtoMessage() {}
+
+func (x *PauseTaskQueueDispatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseTaskQueueDispatchRequest.ProtoReflect.Descriptor instead.
+func (*PauseTaskQueueDispatchRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *PauseTaskQueueDispatchRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PauseTaskQueueDispatchRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *PauseTaskQueueDispatchRequest) GetTaskQueueType() v16.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v16.TaskQueueType(0)
+}
+
+func (x *PauseTaskQueueDispatchRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PauseTaskQueueDispatchRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type PauseTaskQueueDispatchResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	DispatchPause *v12.TaskQueueDispatchPause `protobuf:"bytes,1,opt,name=dispatch_pause,json=dispatchPause,proto3" json:"dispatch_pause,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseTaskQueueDispatchResponse) Reset() {
+	*x = PauseTaskQueueDispatchResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseTaskQueueDispatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseTaskQueueDispatchResponse) ProtoMessage() {}
+
+func (x *PauseTaskQueueDispatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseTaskQueueDispatchResponse.ProtoReflect.Descriptor instead.
+func (*PauseTaskQueueDispatchResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *PauseTaskQueueDispatchResponse) GetDispatchPause() *v12.TaskQueueDispatchPause {
+	if x != nil {
+		return x.DispatchPause
+	}
+	return nil
+}
+
+type ResumeTaskQueueDispatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	Identity      string                 `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTaskQueueDispatchRequest) Reset() {
+	*x = ResumeTaskQueueDispatchRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTaskQueueDispatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTaskQueueDispatchRequest) ProtoMessage() {}
+
+func (x *ResumeTaskQueueDispatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTaskQueueDispatchRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTaskQueueDispatchRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ResumeTaskQueueDispatchRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ResumeTaskQueueDispatchRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *ResumeTaskQueueDispatchRequest) GetTaskQueueType() v16.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v16.TaskQueueType(0)
+}
+
+func (x *ResumeTaskQueueDispatchRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type ResumeTaskQueueDispatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTaskQueueDispatchResponse) Reset() {
+	*x = ResumeTaskQueueDispatchResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTaskQueueDispatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTaskQueueDispatchResponse) ProtoMessage() {}
+
+func (x *ResumeTaskQueueDispatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTaskQueueDispatchResponse.ProtoReflect.Descriptor instead.
+func (*ResumeTaskQueueDispatchResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{133}
+}
+
+type ListTaskQueueDLQTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	// page_size must be positive. Up to this many tasks will be returned.
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskQueueDLQTasksRequest) Reset() {
+	*x = ListTaskQueueDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskQueueDLQTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskQueueDLQTasksRequest) ProtoMessage() {}
+
+func (x *ListTaskQueueDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskQueueDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskQueueDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListTaskQueueDLQTasksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListTaskQueueDLQTasksRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *ListTaskQueueDLQTasksRequest) GetTaskQueueType() v16.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v16.TaskQueueType(0)
+}
+
+func (x *ListTaskQueueDLQTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTaskQueueDLQTasksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListTaskQueueDLQTasksResponse struct {
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Tasks []*v112.MatchingDLQTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// next_page_token is empty if there are no more results. However, the converse is not true.
+	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskQueueDLQTasksResponse) Reset() {
+	*x = ListTaskQueueDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskQueueDLQTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskQueueDLQTasksResponse) ProtoMessage() {}
+
+func (x *ListTaskQueueDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskQueueDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskQueueDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ListTaskQueueDLQTasksResponse) GetTasks() []*v112.MatchingDLQTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTaskQueueDLQTasksResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ReenqueueTaskQueueDLQTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	// Only tasks with a message ID up to and including this one are re-enqueued.
+	InclusiveMaxMessageId int64 `protobuf:"varint,4,opt,name=inclusive_max_message_id,json=inclusiveMaxMessageId,proto3" json:"inclusive_max_message_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ReenqueueTaskQueueDLQTasksRequest) Reset() {
+	*x = ReenqueueTaskQueueDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReenqueueTaskQueueDLQTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReenqueueTaskQueueDLQTasksRequest) ProtoMessage() {}
+
+func (x *ReenqueueTaskQueueDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReenqueueTaskQueueDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*ReenqueueTaskQueueDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ReenqueueTaskQueueDLQTasksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ReenqueueTaskQueueDLQTasksRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *ReenqueueTaskQueueDLQTasksRequest) GetTaskQueueType() v16.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v16.TaskQueueType(0)
+}
+
+func (x *ReenqueueTaskQueueDLQTasksRequest) GetInclusiveMaxMessageId() int64 {
+	if x != nil {
+		return x.InclusiveMaxMessageId
+	}
+	return 0
+}
+
+type ReenqueueTaskQueueDLQTasksResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TasksReenqueued int64                  `protobuf:"varint,1,opt,name=tasks_reenqueued,json=tasksReenqueued,proto3" json:"tasks_reenqueued,omitempty"`
+	// True if a limit on the number of tasks per request was reached before all requested tasks were re-enqueued.
+	// Callers should repeat the request to continue.
+	HasMore       bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReenqueueTaskQueueDLQTasksResponse) Reset() {
+	*x = ReenqueueTaskQueueDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReenqueueTaskQueueDLQTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReenqueueTaskQueueDLQTasksResponse) ProtoMessage() {}
+
+func (x *ReenqueueTaskQueueDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReenqueueTaskQueueDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*ReenqueueTaskQueueDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ReenqueueTaskQueueDLQTasksResponse) GetTasksReenqueued() int64 {
+	if x != nil {
+		return x.TasksReenqueued
+	}
+	return 0
+}
+
+func (x *ReenqueueTaskQueueDLQTasksResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type AddTasksRequest_Task struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Blob          *v1.DataBlob           `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTasksRequest_Task) Reset() {
+	*x = AddTasksRequest_Task{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTasksRequest_Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTasksRequest_Task) ProtoMessage() {}
+
+func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTasksRequest_Task.ProtoReflect.Descriptor instead.
+func (*AddTasksRequest_Task) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74, 0}
+}
+
+func (x *AddTasksRequest_Task) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *AddTasksRequest_Task) GetBlob() *v1.DataBlob {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+type ListQueuesResponse_QueueInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueName     string                 `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queue_name,omitempty"`
+	MessageCount  int64                  `protobuf:"varint,2,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
+	LastMessageId int64                  `protobuf:"varint,3,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesResponse_QueueInfo) Reset() {
+	*x = ListQueuesResponse_QueueInfo{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesResponse_QueueInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesResponse_QueueInfo) ProtoMessage() {}
+
+func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesResponse_QueueInfo.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse_QueueInfo) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77, 0}
+}
+
+func (x *ListQueuesResponse_QueueInfo) GetQueueName() string {
+	if x != nil {
+		return x.QueueName
+	}
+	return ""
+}
+
+func (x *ListQueuesResponse_QueueInfo) GetMessageCount() int64 {
+	if x != nil {
+		return x.MessageCount
+	}
+	return 0
+}
+
+func (x *ListQueuesResponse_QueueInfo) GetLastMessageId() int64 {
+	if x != nil {
+		return x.LastMessageId
+	}
+	return 0
+}
+
+var File_temporal_server_api_adminservice_v1_request_response_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc = "" +
+	"\n" +
+	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a2temporal/server/api/dynamicconfig/v1/message.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a+temporal/server/api/health/v1/message.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a3temporal/server/api/persistence/v1/namespaces.proto\x1a4temporal/server/api/persistence/v1/task_queues.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
+	"\x1aRebuildMutableStateRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x1d\n" +
+	"\x1bRebuildMutableStateResponse\"\xc1\x02\n" +
+	"\x1eImportWorkflowExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12I\n" +
+	"\x0fhistory_batches\x18\x03 \x03(\v2 .temporal.api.common.v1.DataBlobR\x0ehistoryBatches\x12W\n" +
+	"\x0fversion_history\x18\x04 \x01(\v2..temporal.server.api.history.v1.VersionHistoryR\x0eversionHistory\x12\x14\n" +
+	"\x05token\x18\x05 \x01(\fR\x05token\"7\n" +
+	"\x1fImportWorkflowExecutionResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\fR\x05token\"\xf1\x01\n" +
+	"\x1bDescribeMutableStateRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12*\n" +
+	"\x11skip_force_reload\x18\x03 \x01(\bR\x0fskipForceReload\x12\x1c\n" +
+	"\tarchetype\x18\x04 \x01(\tR\tarchetype\x12!\n" +
+	"\farchetype_id\x18\x05 \x01(\rR\varchetypeId\"\xb6\x02\n" +
+	"\x1cDescribeMutableStateResponse\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\tR\ashardId\x12!\n" +
+	"\fhistory_addr\x18\x02 \x01(\tR\vhistoryAddr\x12h\n" +
+	"\x13cache_mutable_state\x18\x03 \x01(\v28.temporal.server.api.persistence.v1.WorkflowMutableStateR\x11cacheMutableState\x12n\n" +
+	"\x16database_mutable_state\x18\x04 \x01(\v28.temporal.server.api.persistence.v1.WorkflowMutableStateR\x14databaseMutableState\"\xd2\x01\n" +
+	"\x1aDescribeHistoryHostRequest\x12!\n" +
+	"\fhost_address\x18\x01 \x01(\tR\vhostAddress\x12\x19\n" +
+	"\bshard_id\x18\x02 \x01(\x05R\ashardId\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12X\n" +
+	"\x12workflow_execution\x18\x04 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\x11workflowExecution\"\xde\x01\n" +
+	"\x1bDescribeHistoryHostResponse\x12#\n" +
+	"\rshards_number\x18\x01 \x01(\x05R\fshardsNumber\x12\x1b\n" +
+	"\tshard_ids\x18\x02 \x03(\x05R\bshardIds\x12]\n" +
+	"\x0fnamespace_cache\x18\x03 \x01(\v24.temporal.server.api.namespace.v1.NamespaceCacheInfoR\x0enamespaceCache\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddressJ\x04\b\x04\x10\x05\".\n" +
+	"\x11CloseShardRequest\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\x05R\ashardId\"\x14\n" +
+	"\x12CloseShardResponse\",\n" +
+	"\x0fGetShardRequest\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\x05R\ashardId\"`\n" +
+	"\x10GetShardResponse\x12L\n" +
+	"\n" +
+	"shard_info\x18\x01 \x01(\v2-.temporal.server.api.persistence.v1.ShardInfoR\tshardInfo\"\xe1\x01\n" +
+	"\x17ListHistoryTasksRequest\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\x05R\bcategory\x12H\n" +
+	"\n" +
+	"task_range\x18\x03 \x01(\v2).temporal.server.api.history.v1.TaskRangeR\ttaskRange\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\fR\rnextPageToken\"\x83\x01\n" +
+	"\x18ListHistoryTasksResponse\x12?\n" +
+	"\x05tasks\x18\x01 \x03(\v2).temporal.server.api.adminservice.v1.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"\x92\x02\n" +
+	"\x04Task\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x17\n" +
+	"\atask_id\x18\x04 \x01(\x03R\x06taskId\x12C\n" +
+	"\ttask_type\x18\x05 \x01(\x0e2&.temporal.server.api.enums.v1.TaskTypeR\btaskType\x127\n" +
+	"\tfire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfireTime\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\"\xa8\x01\n" +
+	"\x11RemoveTaskRequest\x12\x19\n" +
+	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\x05R\bcategory\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\x03R\x06taskId\x12C\n" +
+	"\x0fvisibility_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0evisibilityTime\"\x14\n" +
+	"\x12RemoveTaskResponse\"\x93\x03\n" +
+	"'GetWorkflowExecutionRawHistoryV2Request\x12!\n" +
+	"\fnamespace_id\x18\t \x01(\tR\vnamespaceId\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12$\n" +
+	"\x0estart_event_id\x18\x03 \x01(\x03R\fstartEventId\x12.\n" +
+	"\x13start_event_version\x18\x04 \x01(\x03R\x11startEventVersion\x12 \n" +
+	"\fend_event_id\x18\x05 \x01(\x03R\n" +
+	"endEventId\x12*\n" +
+	"\x11end_event_version\x18\x06 \x01(\x03R\x0fendEventVersion\x12*\n" +
+	"\x11maximum_page_size\x18\a \x01(\x05R\x0fmaximumPageSize\x12&\n" +
+	"\x0fnext_page_token\x18\b \x01(\fR\rnextPageTokenJ\x04\b\x01\x10\x02\"\xa0\x02\n" +
+	"(GetWorkflowExecutionRawHistoryV2Response\x12&\n" +
+	"\x0fnext_page_token\x18\x01 \x01(\fR\rnextPageToken\x12I\n" +
+	"\x0fhistory_batches\x18\x02 \x03(\v2 .temporal.api.common.v1.DataBlobR\x0ehistoryBatches\x12W\n" +
+	"\x0fversion_history\x18\x03 \x01(\v2..temporal.server.api.history.v1.VersionHistoryR\x0eversionHistory\x12(\n" +
+	"\x10history_node_ids\x18\x04 \x03(\x03R\x0ehistoryNodeIds\"\x8b\x03\n" +
+	"%GetWorkflowExecutionRawHistoryRequest\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12G\n" +
 	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12$\n" +
 	"\x0estart_event_id\x18\x03 \x01(\x03R\fstartEventId\x12.\n" +
@@ -6207,7 +8753,7 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\tnamespace\x18\x01 \x01(\tH\x00R\tnamespace\x12\x10\n" +
 	"\x02id\x18\x02 \x01(\tH\x00R\x02idB\f\n" +
 	"\n" +
-	"attributes\"\xda\x03\n" +
+	"attributes\"\xcd\x05\n" +
 	"\x14GetNamespaceResponse\x12<\n" +
 	"\x04info\x18\x03 \x01(\v2(.temporal.api.namespace.v1.NamespaceInfoR\x04info\x12B\n" +
 	"\x06config\x18\x04 \x01(\v2*.temporal.api.namespace.v1.NamespaceConfigR\x06config\x12f\n" +
@@ -6215,7 +8761,12 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x0econfig_version\x18\x06 \x01(\x03R\rconfigVersion\x12)\n" +
 	"\x10failover_version\x18\a \x01(\x03R\x0ffailoverVersion\x12V\n" +
 	"\x10failover_history\x18\b \x03(\v2+.temporal.api.replication.v1.FailoverStatusR\x0ffailoverHistory\x12.\n" +
-	"\x13is_global_namespace\x18\t \x01(\bR\x11isGlobalNamespace\"\xa0\x01\n" +
+	"\x13is_global_namespace\x18\t \x01(\bR\x11isGlobalNamespace\x12y\n" +
+	"\x10visibility_views\x18\n" +
+	" \x03(\v2N.temporal.server.api.adminservice.v1.GetNamespaceResponse.VisibilityViewsEntryR\x0fvisibilityViews\x1av\n" +
+	"\x14VisibilityViewsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12H\n" +
+	"\x05value\x18\x02 \x01(\v22.temporal.server.api.persistence.v1.VisibilityViewR\x05value:\x028\x01\"\xa0\x01\n" +
 	"\x12GetDLQTasksRequest\x12E\n" +
 	"\adlq_key\x18\x01 \x01(\v2,.temporal.server.api.common.v1.HistoryDLQKeyR\x06dlqKey\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12&\n" +
@@ -6302,14 +8853,19 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"!DescribeTaskQueuePartitionRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12f\n" +
 	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\x12Q\n" +
-	"\tbuild_ids\x18\x03 \x01(\v24.temporal.api.taskqueue.v1.TaskQueueVersionSelectionR\bbuildIds\"\x9d\x03\n" +
+	"\tbuild_ids\x18\x03 \x01(\v24.temporal.api.taskqueue.v1.TaskQueueVersionSelectionR\bbuildIds\"\x89\x06\n" +
 	"\"DescribeTaskQueuePartitionResponse\x12\x97\x01\n" +
 	"\x16versions_info_internal\x18\x01 \x03(\v2a.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntryR\x14versionsInfoInternal\x12S\n" +
 	"\n" +
-	"scale_info\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.PartitionScaleInfoR\tscaleInfo\x1a\x87\x01\n" +
+	"scale_info\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.PartitionScaleInfoR\tscaleInfo\x12a\n" +
+	"\x0edispatch_pause\x18\x03 \x01(\v2:.temporal.server.api.persistence.v1.TaskQueueDispatchPauseR\rdispatchPause\x12\xb4\x01\n" +
+	"!outstanding_tasks_by_fairness_key\x18\x04 \x03(\v2j.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.OutstandingTasksByFairnessKeyEntryR\x1doutstandingTasksByFairnessKey\x1a\x87\x01\n" +
 	"\x19VersionsInfoInternalEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12T\n" +
-	"\x05value\x18\x02 \x01(\v2>.temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternalR\x05value:\x028\x01\"\xac\x01\n" +
+	"\x05value\x18\x02 \x01(\v2>.temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternalR\x05value:\x028\x01\x1aP\n" +
+	"\"OutstandingTasksByFairnessKeyEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xac\x01\n" +
 	"$ForceUnloadTaskQueuePartitionRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12f\n" +
 	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\"F\n" +
@@ -6351,7 +8907,181 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x1cSCHEDULER_TARGET_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16SCHEDULER_TARGET_CHASM\x10\x01\x12\x1d\n" +
 	"\x19SCHEDULER_TARGET_WORKFLOW\x10\x02\"\x19\n" +
-	"\x17MigrateScheduleResponseB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"\x17MigrateScheduleResponse\"4\n" +
+	" GetDynamicConfigOverridesRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"|\n" +
+	"!GetDynamicConfigOverridesResponse\x12W\n" +
+	"\toverrides\x18\x01 \x03(\v29.temporal.server.api.persistence.v1.DynamicConfigOverrideR\toverrides\"\xdd\x01\n" +
+	"\x1fSetDynamicConfigOverrideRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12^\n" +
+	"\vconstraints\x18\x02 \x01(\v2<.temporal.server.api.persistence.v1.DynamicConfigConstraintsR\vconstraints\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\"\n" +
+	" SetDynamicConfigOverrideResponse\"\xca\x01\n" +
+	"\"DeleteDynamicConfigOverrideRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12^\n" +
+	"\vconstraints\x18\x02 \x01(\v2<.temporal.server.api.persistence.v1.DynamicConfigConstraintsR\vconstraints\x12\x1a\n" +
+	"\bidentity\x18\x03 \x01(\tR\bidentity\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"%\n" +
+	"#DeleteDynamicConfigOverrideResponse\"2\n" +
+	"\x1eGetDynamicConfigHistoryRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"t\n" +
+	"\x1fGetDynamicConfigHistoryResponse\x12Q\n" +
+	"\achanges\x18\x01 \x03(\v27.temporal.server.api.persistence.v1.DynamicConfigChangeR\achanges\"\xa5\x01\n" +
+	"\x1bExplainDynamicConfigRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12S\n" +
+	"\vconstraints\x18\x02 \x01(\v21.temporal.server.api.dynamicconfig.v1.ConstraintsR\vconstraints\x12\x1f\n" +
+	"\vrollout_key\x18\x03 \x01(\fR\n" +
+	"rolloutKey\"k\n" +
+	"\x1cExplainDynamicConfigResponse\x12K\n" +
+	"\x05hosts\x18\x01 \x03(\v25.temporal.server.api.dynamicconfig.v1.HostExplanationR\x05hosts\"\xc8\x01\n" +
+	" StartVisibilityDriftCheckRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vsample_rate\x18\x02 \x01(\x01R\n" +
+	"sampleRate\x12%\n" +
+	"\x0emax_executions\x18\x03 \x01(\x03R\rmaxExecutions\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12\x16\n" +
+	"\x06repair\x18\x05 \x01(\bR\x06repair\x12\x10\n" +
+	"\x03rps\x18\x06 \x01(\x05R\x03rps\":\n" +
+	"!StartVisibilityDriftCheckResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"<\n" +
+	"#DescribeVisibilityDriftCheckRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xb5\x06\n" +
+	"$DescribeVisibilityDriftCheckResponse\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12F\n" +
+	"\x06status\x18\x02 \x01(\x0e2..temporal.api.enums.v1.WorkflowExecutionStatusR\x06status\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x16\n" +
+	"\x06repair\x18\x05 \x01(\bR\x06repair\x12-\n" +
+	"\x12executions_scanned\x18\x06 \x01(\x03R\x11executionsScanned\x12/\n" +
+	"\x13executions_compared\x18\a \x01(\x03R\x12executionsCompared\x12#\n" +
+	"\rmissing_count\x18\b \x01(\x03R\fmissingCount\x12%\n" +
+	"\x0emismatch_count\x18\t \x01(\x03R\rmismatchCount\x12%\n" +
+	"\x0erepaired_count\x18\n" +
+	" \x01(\x03R\rrepairedCount\x12\x96\x01\n" +
+	"\x15field_mismatch_counts\x18\v \x03(\v2b.temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse.FieldMismatchCountsEntryR\x13fieldMismatchCounts\x12i\n" +
+	"\x12drifted_executions\x18\f \x03(\v2:.temporal.server.api.adminservice.v1.VisibilityDriftRecordR\x11driftedExecutions\x1aF\n" +
+	"\x18FieldMismatchCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xc3\x01\n" +
+	"\x15VisibilityDriftRecord\x12G\n" +
+	"\texecution\x18\x01 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12\x18\n" +
+	"\amissing\x18\x02 \x01(\bR\amissing\x12+\n" +
+	"\x11mismatched_fields\x18\x03 \x03(\tR\x10mismatchedFields\x12\x1a\n" +
+	"\brepaired\x18\x04 \x01(\bR\brepaired\"R\n" +
+	"!CancelVisibilityDriftCheckRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"@\n" +
+	"\"CancelVisibilityDriftCheckResponse\x12\x1a\n" +
+	"\bcanceled\x18\x01 \x01(\bR\bcanceled\"\xaf\x01\n" +
+	"\x1dStartVisibilityRebuildRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12^\n" +
+	"\x10visibility_store\x18\x02 \x01(\x0e23.temporal.server.api.enums.v1.VisibilityStoreTargetR\x0fvisibilityStore\x12\x10\n" +
+	"\x03rps\x18\x03 \x01(\x05R\x03rps\"7\n" +
+	"\x1eStartVisibilityRebuildResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"9\n" +
+	" DescribeVisibilityRebuildRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x92\x05\n" +
+	"!DescribeVisibilityRebuildResponse\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12^\n" +
+	"\x10visibility_store\x18\x02 \x01(\x0e23.temporal.server.api.enums.v1.VisibilityStoreTargetR\x0fvisibilityStore\x12F\n" +
+	"\x06status\x18\x03 \x01(\x0e2..temporal.api.enums.v1.WorkflowExecutionStatusR\x06status\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1f\n" +
+	"\vshard_count\x18\x06 \x01(\x05R\n" +
+	"shardCount\x12)\n" +
+	"\x10shards_completed\x18\a \x01(\x05R\x0fshardsCompleted\x12-\n" +
+	"\x12executions_rebuilt\x18\b \x01(\x03R\x11executionsRebuilt\x12:\n" +
+	"\x19closed_executions_rebuilt\x18\t \x01(\x03R\x17closedExecutionsRebuilt\x12-\n" +
+	"\x12executions_skipped\x18\n" +
+	" \x01(\x03R\x11executionsSkipped\x12O\n" +
+	"$relocatable_attributes_missing_count\x18\v \x01(\x03R!relocatableAttributesMissingCount\"O\n" +
+	"\x1eCancelVisibilityRebuildRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"=\n" +
+	"\x1fCancelVisibilityRebuildResponse\x12\x1a\n" +
+	"\bcanceled\x18\x01 \x01(\bR\bcanceled\"w\n" +
+	"\x1eWatchWorkflowExecutionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\fR\vresumeToken\"\x98\x02\n" +
+	"\x1fWatchWorkflowExecutionsResponse\x12U\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\x0e26.temporal.server.api.enums.v1.VisibilityWatchEventTypeR\teventType\x12V\n" +
+	"\x0eexecution_info\x18\x02 \x01(\v2/.temporal.api.workflow.v1.WorkflowExecutionInfoR\rexecutionInfo\x12#\n" +
+	"\rmatches_query\x18\x03 \x01(\bR\fmatchesQuery\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\fR\vresumeToken\"\xc6\x01\n" +
+	"\x19SaveVisibilityViewRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05owner\x18\x05 \x01(\tR\x05owner\x12)\n" +
+	"\x10expected_version\x18\x06 \x01(\x03R\x0fexpectedVersion\"d\n" +
+	"\x1aSaveVisibilityViewResponse\x12F\n" +
+	"\x04view\x18\x01 \x01(\v22.temporal.server.api.persistence.v1.VisibilityViewR\x04view\"Q\n" +
+	"\x1dDescribeVisibilityViewRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"h\n" +
+	"\x1eDescribeVisibilityViewResponse\x12F\n" +
+	"\x04view\x18\x01 \x01(\v22.temporal.server.api.persistence.v1.VisibilityViewR\x04view\":\n" +
+	"\x1aListVisibilityViewsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"g\n" +
+	"\x1bListVisibilityViewsResponse\x12H\n" +
+	"\x05views\x18\x01 \x03(\v22.temporal.server.api.persistence.v1.VisibilityViewR\x05views\"O\n" +
+	"\x1bDeleteVisibilityViewRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x1e\n" +
+	"\x1cDeleteVisibilityViewResponse\"\xa3\x02\n" +
+	"'RestoreArchivedWorkflowExecutionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12)\n" +
+	"\x10target_namespace\x18\x03 \x01(\tR\x0ftargetNamespace\x12$\n" +
+	"\x0ereset_event_id\x18\x04 \x01(\x03R\fresetEventId\x12!\n" +
+	"\freset_reason\x18\x05 \x01(\tR\vresetReason\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x06 \x01(\tR\trequestId\"L\n" +
+	"(RestoreArchivedWorkflowExecutionResponse\x12 \n" +
+	"\freset_run_id\x18\x01 \x01(\tR\n" +
+	"resetRunId\"\xde\x01\n" +
+	"\x1dPauseTaskQueueDispatchRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12L\n" +
+	"\x0ftask_queue_type\x18\x03 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bidentity\x18\x05 \x01(\tR\bidentity\"\x83\x01\n" +
+	"\x1ePauseTaskQueueDispatchResponse\x12a\n" +
+	"\x0edispatch_pause\x18\x01 \x01(\v2:.temporal.server.api.persistence.v1.TaskQueueDispatchPauseR\rdispatchPause\"\xc7\x01\n" +
+	"\x1eResumeTaskQueueDispatchRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12L\n" +
+	"\x0ftask_queue_type\x18\x03 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\"!\n" +
+	"\x1fResumeTaskQueueDispatchResponse\"\xee\x01\n" +
+	"\x1cListTaskQueueDLQTasksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12L\n" +
+	"\x0ftask_queue_type\x18\x03 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\fR\rnextPageToken\"\x8d\x01\n" +
+	"\x1dListTaskQueueDLQTasksResponse\x12D\n" +
+	"\x05tasks\x18\x01 \x03(\v2..temporal.server.api.common.v1.MatchingDLQTaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"\xe7\x01\n" +
+	"!ReenqueueTaskQueueDLQTasksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12L\n" +
+	"\x0ftask_queue_type\x18\x03 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x127\n" +
+	"\x18inclusive_max_message_id\x18\x04 \x01(\x03R\x15inclusiveMaxMessageId\"j\n" +
+	"\"ReenqueueTaskQueueDLQTasksResponse\x12)\n" +
+	"\x10tasks_reenqueued\x18\x01 \x01(\x03R\x0ftasksReenqueued\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMoreB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var (
 	file_temporal_server_api_adminservice_v1_request_response_proto_rawDescOnce sync.Once
@@ -6366,7 +9096,7 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP
 }
 
 var file_temporal_server_api_adminservice_v1_request_response_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 105)
+var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 151)
 var file_temporal_server_api_adminservice_v1_request_response_proto_goTypes = []any{
 	(MigrateScheduleRequest_SchedulerTarget)(0),         // 0: temporal.server.api.adminservice.v1.MigrateScheduleRequest.SchedulerTarget
 	(*RebuildMutableStateRequest)(nil),                  // 1: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
@@ -6464,162 +9194,252 @@ var file_temporal_server_api_adminservice_v1_request_response_proto_goTypes = []
 	(*BatchOperationRefreshTasks)(nil),                  // 93: temporal.server.api.adminservice.v1.BatchOperationRefreshTasks
 	(*MigrateScheduleRequest)(nil),                      // 94: temporal.server.api.adminservice.v1.MigrateScheduleRequest
 	(*MigrateScheduleResponse)(nil),                     // 95: temporal.server.api.adminservice.v1.MigrateScheduleResponse
-	nil,                                                 // 96: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	nil,                                                 // 97: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	nil,                                                 // 98: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	nil,                                                 // 99: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	nil,                                                 // 100: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	nil,                                                 // 101: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	nil,                                                 // 102: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	(*AddTasksRequest_Task)(nil),                        // 103: temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	(*ListQueuesResponse_QueueInfo)(nil),                // 104: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	nil,                                                 // 105: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	(*v1.WorkflowExecution)(nil),                        // 106: temporal.api.common.v1.WorkflowExecution
-	(*v1.DataBlob)(nil),                                 // 107: temporal.api.common.v1.DataBlob
-	(*v11.VersionHistory)(nil),                          // 108: temporal.server.api.history.v1.VersionHistory
-	(*v12.WorkflowMutableState)(nil),                    // 109: temporal.server.api.persistence.v1.WorkflowMutableState
-	(*v13.NamespaceCacheInfo)(nil),                      // 110: temporal.server.api.namespace.v1.NamespaceCacheInfo
-	(*v12.ShardInfo)(nil),                               // 111: temporal.server.api.persistence.v1.ShardInfo
-	(*v11.TaskRange)(nil),                               // 112: temporal.server.api.history.v1.TaskRange
-	(v14.TaskType)(0),                                   // 113: temporal.server.api.enums.v1.TaskType
-	(*timestamppb.Timestamp)(nil),                       // 114: google.protobuf.Timestamp
-	(*v15.ReplicationToken)(nil),                        // 115: temporal.server.api.replication.v1.ReplicationToken
-	(*v15.ReplicationMessages)(nil),                     // 116: temporal.server.api.replication.v1.ReplicationMessages
-	(*v15.ReplicationTaskInfo)(nil),                     // 117: temporal.server.api.replication.v1.ReplicationTaskInfo
-	(*v15.ReplicationTask)(nil),                         // 118: temporal.server.api.replication.v1.ReplicationTask
-	(*v17.WorkflowExecutionInfo)(nil),                   // 119: temporal.api.workflow.v1.WorkflowExecutionInfo
-	(*v18.MembershipInfo)(nil),                          // 120: temporal.server.api.cluster.v1.MembershipInfo
-	(*v19.VersionInfo)(nil),                             // 121: temporal.api.version.v1.VersionInfo
-	(*v12.ClusterMetadata)(nil),                         // 122: temporal.server.api.persistence.v1.ClusterMetadata
-	(*durationpb.Duration)(nil),                         // 123: google.protobuf.Duration
-	(v14.ClusterMemberRole)(0),                          // 124: temporal.server.api.enums.v1.ClusterMemberRole
-	(*v18.ClusterMember)(nil),                           // 125: temporal.server.api.cluster.v1.ClusterMember
-	(v14.DeadLetterQueueType)(0),                        // 126: temporal.server.api.enums.v1.DeadLetterQueueType
-	(v16.TaskQueueType)(0),                              // 127: temporal.api.enums.v1.TaskQueueType
-	(*v12.AllocatedTaskInfo)(nil),                       // 128: temporal.server.api.persistence.v1.AllocatedTaskInfo
-	(*v15.SyncReplicationState)(nil),                    // 129: temporal.server.api.replication.v1.SyncReplicationState
-	(*v15.WorkflowReplicationMessages)(nil),             // 130: temporal.server.api.replication.v1.WorkflowReplicationMessages
-	(*v110.NamespaceInfo)(nil),                          // 131: temporal.api.namespace.v1.NamespaceInfo
-	(*v110.NamespaceConfig)(nil),                        // 132: temporal.api.namespace.v1.NamespaceConfig
-	(*v111.NamespaceReplicationConfig)(nil),             // 133: temporal.api.replication.v1.NamespaceReplicationConfig
-	(*v111.FailoverStatus)(nil),                         // 134: temporal.api.replication.v1.FailoverStatus
-	(*v112.HistoryDLQKey)(nil),                          // 135: temporal.server.api.common.v1.HistoryDLQKey
-	(*v112.HistoryDLQTask)(nil),                         // 136: temporal.server.api.common.v1.HistoryDLQTask
-	(*v112.HistoryDLQTaskMetadata)(nil),                 // 137: temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	(v14.DLQOperationType)(0),                           // 138: temporal.server.api.enums.v1.DLQOperationType
-	(v14.DLQOperationState)(0),                          // 139: temporal.server.api.enums.v1.DLQOperationState
-	(v14.HealthState)(0),                                // 140: temporal.server.api.enums.v1.HealthState
-	(*v113.ServiceHealthDetail)(nil),                    // 141: temporal.server.api.health.v1.ServiceHealthDetail
-	(*v12.VersionedTransition)(nil),                     // 142: temporal.server.api.persistence.v1.VersionedTransition
-	(*v11.VersionHistories)(nil),                        // 143: temporal.server.api.history.v1.VersionHistories
-	(*v15.VersionedTransitionArtifact)(nil),             // 144: temporal.server.api.replication.v1.VersionedTransitionArtifact
-	(*v114.TaskQueuePartition)(nil),                     // 145: temporal.server.api.taskqueue.v1.TaskQueuePartition
-	(*v115.TaskQueueVersionSelection)(nil),              // 146: temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	(*v114.PartitionScaleInfo)(nil),                     // 147: temporal.server.api.taskqueue.v1.PartitionScaleInfo
-	(*v12.TaskQueueTypeUserData)(nil),                   // 148: temporal.server.api.persistence.v1.TaskQueueTypeUserData
-	(v16.IndexedValueType)(0),                           // 149: temporal.api.enums.v1.IndexedValueType
-	(*v114.TaskQueueVersionInfoInternal)(nil),           // 150: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	(*GetDynamicConfigOverridesRequest)(nil),            // 96: temporal.server.api.adminservice.v1.GetDynamicConfigOverridesRequest
+	(*GetDynamicConfigOverridesResponse)(nil),           // 97: temporal.server.api.adminservice.v1.GetDynamicConfigOverridesResponse
+	(*SetDynamicConfigOverrideRequest)(nil),             // 98: temporal.server.api.adminservice.v1.SetDynamicConfigOverrideRequest
+	(*SetDynamicConfigOverrideResponse)(nil),            // 99: temporal.server.api.adminservice.v1.SetDynamicConfigOverrideResponse
+	(*DeleteDynamicConfigOverrideRequest)(nil),          // 100: temporal.server.api.adminservice.v1.DeleteDynamicConfigOverrideRequest
+	(*DeleteDynamicConfigOverrideResponse)(nil),         // 101: temporal.server.api.adminservice.v1.DeleteDynamicConfigOverrideResponse
+	(*GetDynamicConfigHistoryRequest)(nil),              // 102: temporal.server.api.adminservice.v1.GetDynamicConfigHistoryRequest
+	(*GetDynamicConfigHistoryResponse)(nil),             // 103: temporal.server.api.adminservice.v1.GetDynamicConfigHistoryResponse
+	(*ExplainDynamicConfigRequest)(nil),                 // 104: temporal.server.api.adminservice.v1.ExplainDynamicConfigRequest
+	(*ExplainDynamicConfigResponse)(nil),                // 105: temporal.server.api.adminservice.v1.ExplainDynamicConfigResponse
+	(*StartVisibilityDriftCheckRequest)(nil),            // 106: temporal.server.api.adminservice.v1.StartVisibilityDriftCheckRequest
+	(*StartVisibilityDriftCheckResponse)(nil),           // 107: temporal.server.api.adminservice.v1.StartVisibilityDriftCheckResponse
+	(*DescribeVisibilityDriftCheckRequest)(nil),         // 108: temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckRequest
+	(*DescribeVisibilityDriftCheckResponse)(nil),        // 109: temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse
+	(*VisibilityDriftRecord)(nil),                       // 110: temporal.server.api.adminservice.v1.VisibilityDriftRecord
+	(*CancelVisibilityDriftCheckRequest)(nil),           // 111: temporal.server.api.adminservice.v1.CancelVisibilityDriftCheckRequest
+	(*CancelVisibilityDriftCheckResponse)(nil),          // 112: temporal.server.api.adminservice.v1.CancelVisibilityDriftCheckResponse
+	(*StartVisibilityRebuildRequest)(nil),               // 113: temporal.server.api.adminservice.v1.StartVisibilityRebuildRequest
+	(*StartVisibilityRebuildResponse)(nil),              // 114: temporal.server.api.adminservice.v1.StartVisibilityRebuildResponse
+	(*DescribeVisibilityRebuildRequest)(nil),            // 115: temporal.server.api.adminservice.v1.DescribeVisibilityRebuildRequest
+	(*DescribeVisibilityRebuildResponse)(nil),           // 116: temporal.server.api.adminservice.v1.DescribeVisibilityRebuildResponse
+	(*CancelVisibilityRebuildRequest)(nil),              // 117: temporal.server.api.adminservice.v1.CancelVisibilityRebuildRequest
+	(*CancelVisibilityRebuildResponse)(nil),             // 118: temporal.server.api.adminservice.v1.CancelVisibilityRebuildResponse
+	(*WatchWorkflowExecutionsRequest)(nil),              // 119: temporal.server.api.adminservice.v1.WatchWorkflowExecutionsRequest
+	(*WatchWorkflowExecutionsResponse)(nil),             // 120: temporal.server.api.adminservice.v1.WatchWorkflowExecutionsResponse
+	(*SaveVisibilityViewRequest)(nil),                   // 121: temporal.server.api.adminservice.v1.SaveVisibilityViewRequest
+	(*SaveVisibilityViewResponse)(nil),                  // 122: temporal.server.api.adminservice.v1.SaveVisibilityViewResponse
+	(*DescribeVisibilityViewRequest)(nil),               // 123: temporal.server.api.adminservice.v1.DescribeVisibilityViewRequest
+	(*DescribeVisibilityViewResponse)(nil),              // 124: temporal.server.api.adminservice.v1.DescribeVisibilityViewResponse
+	(*ListVisibilityViewsRequest)(nil),                  // 125: temporal.server.api.adminservice.v1.ListVisibilityViewsRequest
+	(*ListVisibilityViewsResponse)(nil),                 // 126: temporal.server.api.adminservice.v1.ListVisibilityViewsResponse
+	(*DeleteVisibilityViewRequest)(nil),                 // 127: temporal.server.api.adminservice.v1.DeleteVisibilityViewRequest
+	(*DeleteVisibilityViewResponse)(nil),                // 128: temporal.server.api.adminservice.v1.DeleteVisibilityViewResponse
+	(*RestoreArchivedWorkflowExecutionRequest)(nil),     // 129: temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionRequest
+	(*RestoreArchivedWorkflowExecutionResponse)(nil),    // 130: temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionResponse
+	(*PauseTaskQueueDispatchRequest)(nil),               // 131: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchRequest
+	(*PauseTaskQueueDispatchResponse)(nil),              // 132: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse
+	(*ResumeTaskQueueDispatchRequest)(nil),              // 133: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchRequest
+	(*ResumeTaskQueueDispatchResponse)(nil),             // 134: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse
+	(*ListTaskQueueDLQTasksRequest)(nil),                // 135: temporal.server.api.adminservice.v1.ListTaskQueueDLQTasksRequest
+	(*ListTaskQueueDLQTasksResponse)(nil),               // 136: temporal.server.api.adminservice.v1.ListTaskQueueDLQTasksResponse
+	(*ReenqueueTaskQueueDLQTasksRequest)(nil),           // 137: temporal.server.api.adminservice.v1.ReenqueueTaskQueueDLQTasksRequest
+	(*ReenqueueTaskQueueDLQTasksResponse)(nil),          // 138: temporal.server.api.adminservice.v1.ReenqueueTaskQueueDLQTasksResponse
+	nil,                                       // 139: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	nil,                                       // 140: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	nil,                                       // 141: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	nil,                                       // 142: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	nil,                                       // 143: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	nil,                                       // 144: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	nil,                                       // 145: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	nil,                                       // 146: temporal.server.api.adminservice.v1.GetNamespaceResponse.VisibilityViewsEntry
+	(*AddTasksRequest_Task)(nil),              // 147: temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	(*ListQueuesResponse_QueueInfo)(nil),      // 148: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	nil,                                       // 149: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	nil,                                       // 150: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.OutstandingTasksByFairnessKeyEntry
+	nil,                                       // 151: temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse.FieldMismatchCountsEntry
+	(*v1.WorkflowExecution)(nil),              // 152: temporal.api.common.v1.WorkflowExecution
+	(*v1.DataBlob)(nil),                       // 153: temporal.api.common.v1.DataBlob
+	(*v11.VersionHistory)(nil),                // 154: temporal.server.api.history.v1.VersionHistory
+	(*v12.WorkflowMutableState)(nil),          // 155: temporal.server.api.persistence.v1.WorkflowMutableState
+	(*v13.NamespaceCacheInfo)(nil),            // 156: temporal.server.api.namespace.v1.NamespaceCacheInfo
+	(*v12.ShardInfo)(nil),                     // 157: temporal.server.api.persistence.v1.ShardInfo
+	(*v11.TaskRange)(nil),                     // 158: temporal.server.api.history.v1.TaskRange
+	(v14.TaskType)(0),                         // 159: temporal.server.api.enums.v1.TaskType
+	(*timestamppb.Timestamp)(nil),             // 160: google.protobuf.Timestamp
+	(*v15.ReplicationToken)(nil),              // 161: temporal.server.api.replication.v1.ReplicationToken
+	(*v15.ReplicationMessages)(nil),           // 162: temporal.server.api.replication.v1.ReplicationMessages
+	(*v15.ReplicationTaskInfo)(nil),           // 163: temporal.server.api.replication.v1.ReplicationTaskInfo
+	(*v15.ReplicationTask)(nil),               // 164: temporal.server.api.replication.v1.ReplicationTask
+	(*v17.WorkflowExecutionInfo)(nil),         // 165: temporal.api.workflow.v1.WorkflowExecutionInfo
+	(*v18.MembershipInfo)(nil),                // 166: temporal.server.api.cluster.v1.MembershipInfo
+	(*v19.VersionInfo)(nil),                   // 167: temporal.api.version.v1.VersionInfo
+	(*v12.ClusterMetadata)(nil),               // 168: temporal.server.api.persistence.v1.ClusterMetadata
+	(*durationpb.Duration)(nil),               // 169: google.protobuf.Duration
+	(v14.ClusterMemberRole)(0),                // 170: temporal.server.api.enums.v1.ClusterMemberRole
+	(*v18.ClusterMember)(nil),                 // 171: temporal.server.api.cluster.v1.ClusterMember
+	(v14.DeadLetterQueueType)(0),              // 172: temporal.server.api.enums.v1.DeadLetterQueueType
+	(v16.TaskQueueType)(0),                    // 173: temporal.api.enums.v1.TaskQueueType
+	(*v12.AllocatedTaskInfo)(nil),             // 174: temporal.server.api.persistence.v1.AllocatedTaskInfo
+	(*v15.SyncReplicationState)(nil),          // 175: temporal.server.api.replication.v1.SyncReplicationState
+	(*v15.WorkflowReplicationMessages)(nil),   // 176: temporal.server.api.replication.v1.WorkflowReplicationMessages
+	(*v110.NamespaceInfo)(nil),                // 177: temporal.api.namespace.v1.NamespaceInfo
+	(*v110.NamespaceConfig)(nil),              // 178: temporal.api.namespace.v1.NamespaceConfig
+	(*v111.NamespaceReplicationConfig)(nil),   // 179: temporal.api.replication.v1.NamespaceReplicationConfig
+	(*v111.FailoverStatus)(nil),               // 180: temporal.api.replication.v1.FailoverStatus
+	(*v112.HistoryDLQKey)(nil),                // 181: temporal.server.api.common.v1.HistoryDLQKey
+	(*v112.HistoryDLQTask)(nil),               // 182: temporal.server.api.common.v1.HistoryDLQTask
+	(*v112.HistoryDLQTaskMetadata)(nil),       // 183: temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	(v14.DLQOperationType)(0),                 // 184: temporal.server.api.enums.v1.DLQOperationType
+	(v14.DLQOperationState)(0),                // 185: temporal.server.api.enums.v1.DLQOperationState
+	(v14.HealthState)(0),                      // 186: temporal.server.api.enums.v1.HealthState
+	(*v113.ServiceHealthDetail)(nil),          // 187: temporal.server.api.health.v1.ServiceHealthDetail
+	(*v12.VersionedTransition)(nil),           // 188: temporal.server.api.persistence.v1.VersionedTransition
+	(*v11.VersionHistories)(nil),              // 189: temporal.server.api.history.v1.VersionHistories
+	(*v15.VersionedTransitionArtifact)(nil),   // 190: temporal.server.api.replication.v1.VersionedTransitionArtifact
+	(*v114.TaskQueuePartition)(nil),           // 191: temporal.server.api.taskqueue.v1.TaskQueuePartition
+	(*v115.TaskQueueVersionSelection)(nil),    // 192: temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	(*v114.PartitionScaleInfo)(nil),           // 193: temporal.server.api.taskqueue.v1.PartitionScaleInfo
+	(*v12.TaskQueueDispatchPause)(nil),        // 194: temporal.server.api.persistence.v1.TaskQueueDispatchPause
+	(*v12.TaskQueueTypeUserData)(nil),         // 195: temporal.server.api.persistence.v1.TaskQueueTypeUserData
+	(*v12.DynamicConfigOverride)(nil),         // 196: temporal.server.api.persistence.v1.DynamicConfigOverride
+	(*v12.DynamicConfigConstraints)(nil),      // 197: temporal.server.api.persistence.v1.DynamicConfigConstraints
+	(*v12.DynamicConfigChange)(nil),           // 198: temporal.server.api.persistence.v1.DynamicConfigChange
+	(*v116.Constraints)(nil),                  // 199: temporal.server.api.dynamicconfig.v1.Constraints
+	(*v116.HostExplanation)(nil),              // 200: temporal.server.api.dynamicconfig.v1.HostExplanation
+	(v16.WorkflowExecutionStatus)(0),          // 201: temporal.api.enums.v1.WorkflowExecutionStatus
+	(v14.VisibilityStoreTarget)(0),            // 202: temporal.server.api.enums.v1.VisibilityStoreTarget
+	(v14.VisibilityWatchEventType)(0),         // 203: temporal.server.api.enums.v1.VisibilityWatchEventType
+	(*v12.VisibilityView)(nil),                // 204: temporal.server.api.persistence.v1.VisibilityView
+	(*v112.MatchingDLQTask)(nil),              // 205: temporal.server.api.common.v1.MatchingDLQTask
+	(v16.IndexedValueType)(0),                 // 206: temporal.api.enums.v1.IndexedValueType
+	(*v114.TaskQueueVersionInfoInternal)(nil), // 207: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
 }
 var file_temporal_server_api_adminservice_v1_request_response_proto_depIdxs = []int32{
-	106, // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	106, // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	108, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	106, // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	109, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	109, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	106, // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	110, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
-	111, // 9: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
-	112, // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
+	152, // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	152, // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	153, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	154, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	152, // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	155, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	155, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	152, // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	156, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
+	157, // 9: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
+	158, // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
 	15,  // 11: temporal.server.api.adminservice.v1.ListHistoryTasksResponse.tasks:type_name -> temporal.server.api.adminservice.v1.Task
-	113, // 12: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
-	114, // 13: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
-	114, // 14: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
-	106, // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 16: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	108, // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	106, // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	108, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	115, // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
-	96,  // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	116, // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	117, // 24: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	118, // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	106, // 26: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
-	97,  // 28: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	98,  // 29: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	99,  // 30: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	100, // 31: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	119, // 32: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
-	101, // 33: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	120, // 34: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
-	121, // 35: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
-	102, // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	122, // 37: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
-	123, // 38: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
-	124, // 39: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
-	114, // 40: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
-	125, // 41: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
-	126, // 42: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	126, // 43: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	118, // 44: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	117, // 45: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	126, // 46: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	126, // 47: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	106, // 48: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	127, // 49: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	128, // 50: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
-	106, // 51: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	129, // 52: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
-	130, // 53: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
-	131, // 54: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
-	132, // 55: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
-	133, // 56: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
-	134, // 57: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
-	135, // 58: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	136, // 59: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
-	135, // 60: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	137, // 61: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	135, // 62: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	137, // 63: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	135, // 64: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	138, // 65: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
-	139, // 66: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
-	114, // 67: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
-	114, // 68: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
-	103, // 69: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	104, // 70: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	140, // 71: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
-	141, // 72: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.services:type_name -> temporal.server.api.health.v1.ServiceHealthDetail
-	106, // 73: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	142, // 74: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	143, // 75: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
-	144, // 76: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
-	106, // 77: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	145, // 78: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	146, // 79: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	105, // 80: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	147, // 81: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.scale_info:type_name -> temporal.server.api.taskqueue.v1.PartitionScaleInfo
-	145, // 82: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	127, // 83: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	148, // 84: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse.user_data:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
-	106, // 85: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.executions:type_name -> temporal.api.common.v1.WorkflowExecution
-	93,  // 86: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.refresh_tasks_operation:type_name -> temporal.server.api.adminservice.v1.BatchOperationRefreshTasks
-	0,   // 87: temporal.server.api.adminservice.v1.MigrateScheduleRequest.target:type_name -> temporal.server.api.adminservice.v1.MigrateScheduleRequest.SchedulerTarget
-	116, // 88: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	149, // 89: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	149, // 90: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	149, // 91: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	107, // 92: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
-	150, // 93: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
-	94,  // [94:94] is the sub-list for method output_type
-	94,  // [94:94] is the sub-list for method input_type
-	94,  // [94:94] is the sub-list for extension type_name
-	94,  // [94:94] is the sub-list for extension extendee
-	0,   // [0:94] is the sub-list for field type_name
+	159, // 12: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	160, // 13: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
+	160, // 14: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
+	152, // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	153, // 16: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	154, // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	152, // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	153, // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	154, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	161, // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
+	139, // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	162, // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	163, // 24: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	164, // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	152, // 26: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	153, // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
+	140, // 28: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	141, // 29: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	142, // 30: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	143, // 31: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	165, // 32: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
+	144, // 33: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	166, // 34: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
+	167, // 35: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
+	145, // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	168, // 37: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
+	169, // 38: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
+	170, // 39: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
+	160, // 40: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
+	171, // 41: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
+	172, // 42: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	172, // 43: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	164, // 44: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	163, // 45: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	172, // 46: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	172, // 47: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	152, // 48: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	173, // 49: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	174, // 50: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
+	152, // 51: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	175, // 52: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
+	176, // 53: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
+	177, // 54: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
+	178, // 55: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
+	179, // 56: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
+	180, // 57: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
+	146, // 58: temporal.server.api.adminservice.v1.GetNamespaceResponse.visibility_views:type_name -> temporal.server.api.adminservice.v1.GetNamespaceResponse.VisibilityViewsEntry
+	181, // 59: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	182, // 60: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
+	181, // 61: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	183, // 62: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	181, // 63: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	183, // 64: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	181, // 65: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	184, // 66: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
+	185, // 67: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
+	160, // 68: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
+	160, // 69: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
+	147, // 70: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	148, // 71: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	186, // 72: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
+	187, // 73: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.services:type_name -> temporal.server.api.health.v1.ServiceHealthDetail
+	152, // 74: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	188, // 75: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	189, // 76: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
+	190, // 77: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
+	152, // 78: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	191, // 79: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	192, // 80: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	149, // 81: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	193, // 82: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.scale_info:type_name -> temporal.server.api.taskqueue.v1.PartitionScaleInfo
+	194, // 83: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.dispatch_pause:type_name -> temporal.server.api.persistence.v1.TaskQueueDispatchPause
+	150, // 84: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.outstanding_tasks_by_fairness_key:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.OutstandingTasksByFairnessKeyEntry
+	191, // 85: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	173, // 86: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	195, // 87: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse.user_data:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
+	152, // 88: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.executions:type_name -> temporal.api.common.v1.WorkflowExecution
+	93,  // 89: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.refresh_tasks_operation:type_name -> temporal.server.api.adminservice.v1.BatchOperationRefreshTasks
+	0,   // 90: temporal.server.api.adminservice.v1.MigrateScheduleRequest.target:type_name -> temporal.server.api.adminservice.v1.MigrateScheduleRequest.SchedulerTarget
+	196, // 91: temporal.server.api.adminservice.v1.GetDynamicConfigOverridesResponse.overrides:type_name -> temporal.server.api.persistence.v1.DynamicConfigOverride
+	197, // 92: temporal.server.api.adminservice.v1.SetDynamicConfigOverrideRequest.constraints:type_name -> temporal.server.api.persistence.v1.DynamicConfigConstraints
+	197, // 93: temporal.server.api.adminservice.v1.DeleteDynamicConfigOverrideRequest.constraints:type_name -> temporal.server.api.persistence.v1.DynamicConfigConstraints
+	198, // 94: temporal.server.api.adminservice.v1.GetDynamicConfigHistoryResponse.changes:type_name -> temporal.server.api.persistence.v1.DynamicConfigChange
+	199, // 95: temporal.server.api.adminservice.v1.ExplainDynamicConfigRequest.constraints:type_name -> temporal.server.api.dynamicconfig.v1.Constraints
+	200, // 96: temporal.server.api.adminservice.v1.ExplainDynamicConfigResponse.hosts:type_name -> temporal.server.api.dynamicconfig.v1.HostExplanation
+	201, // 97: temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	160, // 98: temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse.start_time:type_name -> google.protobuf.Timestamp
+	160, // 99: temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse.end_time:type_name -> google.protobuf.Timestamp
+	151, // 100: temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse.field_mismatch_counts:type_name -> temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse.FieldMismatchCountsEntry
+	110, // 101: temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse.drifted_executions:type_name -> temporal.server.api.adminservice.v1.VisibilityDriftRecord
+	152, // 102: temporal.server.api.adminservice.v1.VisibilityDriftRecord.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	202, // 103: temporal.server.api.adminservice.v1.StartVisibilityRebuildRequest.visibility_store:type_name -> temporal.server.api.enums.v1.VisibilityStoreTarget
+	202, // 104: temporal.server.api.adminservice.v1.DescribeVisibilityRebuildResponse.visibility_store:type_name -> temporal.server.api.enums.v1.VisibilityStoreTarget
+	201, // 105: temporal.server.api.adminservice.v1.DescribeVisibilityRebuildResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	160, // 106: temporal.server.api.adminservice.v1.DescribeVisibilityRebuildResponse.start_time:type_name -> google.protobuf.Timestamp
+	160, // 107: temporal.server.api.adminservice.v1.DescribeVisibilityRebuildResponse.end_time:type_name -> google.protobuf.Timestamp
+	203, // 108: temporal.server.api.adminservice.v1.WatchWorkflowExecutionsResponse.event_type:type_name -> temporal.server.api.enums.v1.VisibilityWatchEventType
+	165, // 109: temporal.server.api.adminservice.v1.WatchWorkflowExecutionsResponse.execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
+	204, // 110: temporal.server.api.adminservice.v1.SaveVisibilityViewResponse.view:type_name -> temporal.server.api.persistence.v1.VisibilityView
+	204, // 111: temporal.server.api.adminservice.v1.DescribeVisibilityViewResponse.view:type_name -> temporal.server.api.persistence.v1.VisibilityView
+	204, // 112: temporal.server.api.adminservice.v1.ListVisibilityViewsResponse.views:type_name -> temporal.server.api.persistence.v1.VisibilityView
+	152, // 113: temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	173, // 114: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	194, // 115: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse.dispatch_pause:type_name -> temporal.server.api.persistence.v1.TaskQueueDispatchPause
+	173, // 116: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	173, // 117: temporal.server.api.adminservice.v1.ListTaskQueueDLQTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	205, // 118: temporal.server.api.adminservice.v1.ListTaskQueueDLQTasksResponse.tasks:type_name -> temporal.server.api.common.v1.MatchingDLQTask
+	173, // 119: temporal.server.api.adminservice.v1.ReenqueueTaskQueueDLQTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	162, // 120: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	206, // 121: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	206, // 122: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	206, // 123: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	204, // 124: temporal.server.api.adminservice.v1.GetNamespaceResponse.VisibilityViewsEntry.value:type_name -> temporal.server.api.persistence.v1.VisibilityView
+	153, // 125: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
+	207, // 126: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	127, // [127:127] is the sub-list for method output_type
+	127, // [127:127] is the sub-list for method input_type
+	127, // [127:127] is the sub-list for extension type_name
+	127, // [127:127] is the sub-list for extension extendee
+	0,   // [0:127] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_request_response_proto_init() }
@@ -6646,7 +9466,7 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc), len(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   105,
+			NumMessages:   151,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
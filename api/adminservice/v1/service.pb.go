@@ -26,7 +26,7 @@ var File_temporal_server_api_adminservice_v1_service_proto protoreflect.FileDesc
 
 const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto\x1a0temporal/server/api/common/v1/api_category.proto2\xb3:\n" +
+	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto\x1a0temporal/server/api/common/v1/api_category.proto2\xb1W\n" +
 	"\fAdminService\x12\xa0\x01\n" +
 	"\x13RebuildMutableState\x12?.temporal.server.api.adminservice.v1.RebuildMutableStateRequest\x1a@.temporal.server.api.adminservice.v1.RebuildMutableStateResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xac\x01\n" +
 	"\x17ImportWorkflowExecution\x12C.temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest\x1aD.temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa3\x01\n" +
@@ -76,7 +76,28 @@ const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\x1aDescribeTaskQueuePartition\x12F.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest\x1aG.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xbe\x01\n" +
 	"\x1dForceUnloadTaskQueuePartition\x12I.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest\x1aJ.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa3\x01\n" +
 	"\x14GetTaskQueueUserData\x12@.temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest\x1aA.temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x94\x01\n" +
-	"\x0fMigrateSchedule\x12;.temporal.server.api.adminservice.v1.MigrateScheduleRequest\x1a<.temporal.server.api.adminservice.v1.MigrateScheduleResponse\"\x06\x8a\xb5\x18\x02\b\x03B8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"\x0fMigrateSchedule\x12;.temporal.server.api.adminservice.v1.MigrateScheduleRequest\x1a<.temporal.server.api.adminservice.v1.MigrateScheduleResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb2\x01\n" +
+	"\x19GetDynamicConfigOverrides\x12E.temporal.server.api.adminservice.v1.GetDynamicConfigOverridesRequest\x1aF.temporal.server.api.adminservice.v1.GetDynamicConfigOverridesResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xaf\x01\n" +
+	"\x18SetDynamicConfigOverride\x12D.temporal.server.api.adminservice.v1.SetDynamicConfigOverrideRequest\x1aE.temporal.server.api.adminservice.v1.SetDynamicConfigOverrideResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb8\x01\n" +
+	"\x1bDeleteDynamicConfigOverride\x12G.temporal.server.api.adminservice.v1.DeleteDynamicConfigOverrideRequest\x1aH.temporal.server.api.adminservice.v1.DeleteDynamicConfigOverrideResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xac\x01\n" +
+	"\x17GetDynamicConfigHistory\x12C.temporal.server.api.adminservice.v1.GetDynamicConfigHistoryRequest\x1aD.temporal.server.api.adminservice.v1.GetDynamicConfigHistoryResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa3\x01\n" +
+	"\x14ExplainDynamicConfig\x12@.temporal.server.api.adminservice.v1.ExplainDynamicConfigRequest\x1aA.temporal.server.api.adminservice.v1.ExplainDynamicConfigResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb2\x01\n" +
+	"\x19StartVisibilityDriftCheck\x12E.temporal.server.api.adminservice.v1.StartVisibilityDriftCheckRequest\x1aF.temporal.server.api.adminservice.v1.StartVisibilityDriftCheckResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xbb\x01\n" +
+	"\x1cDescribeVisibilityDriftCheck\x12H.temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckRequest\x1aI.temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb5\x01\n" +
+	"\x1aCancelVisibilityDriftCheck\x12F.temporal.server.api.adminservice.v1.CancelVisibilityDriftCheckRequest\x1aG.temporal.server.api.adminservice.v1.CancelVisibilityDriftCheckResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa9\x01\n" +
+	"\x16StartVisibilityRebuild\x12B.temporal.server.api.adminservice.v1.StartVisibilityRebuildRequest\x1aC.temporal.server.api.adminservice.v1.StartVisibilityRebuildResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb2\x01\n" +
+	"\x19DescribeVisibilityRebuild\x12E.temporal.server.api.adminservice.v1.DescribeVisibilityRebuildRequest\x1aF.temporal.server.api.adminservice.v1.DescribeVisibilityRebuildResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xac\x01\n" +
+	"\x17CancelVisibilityRebuild\x12C.temporal.server.api.adminservice.v1.CancelVisibilityRebuildRequest\x1aD.temporal.server.api.adminservice.v1.CancelVisibilityRebuildResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x9d\x01\n" +
+	"\x12SaveVisibilityView\x12>.temporal.server.api.adminservice.v1.SaveVisibilityViewRequest\x1a?.temporal.server.api.adminservice.v1.SaveVisibilityViewResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa9\x01\n" +
+	"\x16DescribeVisibilityView\x12B.temporal.server.api.adminservice.v1.DescribeVisibilityViewRequest\x1aC.temporal.server.api.adminservice.v1.DescribeVisibilityViewResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa0\x01\n" +
+	"\x13ListVisibilityViews\x12?.temporal.server.api.adminservice.v1.ListVisibilityViewsRequest\x1a@.temporal.server.api.adminservice.v1.ListVisibilityViewsResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa3\x01\n" +
+	"\x14DeleteVisibilityView\x12@.temporal.server.api.adminservice.v1.DeleteVisibilityViewRequest\x1aA.temporal.server.api.adminservice.v1.DeleteVisibilityViewResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xc7\x01\n" +
+	" RestoreArchivedWorkflowExecution\x12L.temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionRequest\x1aM.temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xae\x01\n" +
+	"\x17WatchWorkflowExecutions\x12C.temporal.server.api.adminservice.v1.WatchWorkflowExecutionsRequest\x1aD.temporal.server.api.adminservice.v1.WatchWorkflowExecutionsResponse\"\x06\x8a\xb5\x18\x02\b\x020\x01\x12\xa9\x01\n" +
+	"\x16PauseTaskQueueDispatch\x12B.temporal.server.api.adminservice.v1.PauseTaskQueueDispatchRequest\x1aC.temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xac\x01\n" +
+	"\x17ResumeTaskQueueDispatch\x12C.temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchRequest\x1aD.temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa6\x01\n" +
+	"\x15ListTaskQueueDLQTasks\x12A.temporal.server.api.adminservice.v1.ListTaskQueueDLQTasksRequest\x1aB.temporal.server.api.adminservice.v1.ListTaskQueueDLQTasksResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb5\x01\n" +
+	"\x1aReenqueueTaskQueueDLQTasks\x12F.temporal.server.api.adminservice.v1.ReenqueueTaskQueueDLQTasksRequest\x1aG.temporal.server.api.adminservice.v1.ReenqueueTaskQueueDLQTasksResponse\"\x06\x8a\xb5\x18\x02\b\x03B8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var file_temporal_server_api_adminservice_v1_service_proto_goTypes = []any{
 	(*RebuildMutableStateRequest)(nil),                  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
@@ -125,151 +146,235 @@ var file_temporal_server_api_adminservice_v1_service_proto_goTypes = []any{
 	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 43: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
 	(*GetTaskQueueUserDataRequest)(nil),                 // 44: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
 	(*MigrateScheduleRequest)(nil),                      // 45: temporal.server.api.adminservice.v1.MigrateScheduleRequest
-	(*RebuildMutableStateResponse)(nil),                 // 46: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	(*ImportWorkflowExecutionResponse)(nil),             // 47: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	(*DescribeMutableStateResponse)(nil),                // 48: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	(*DescribeHistoryHostResponse)(nil),                 // 49: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	(*GetShardResponse)(nil),                            // 50: temporal.server.api.adminservice.v1.GetShardResponse
-	(*CloseShardResponse)(nil),                          // 51: temporal.server.api.adminservice.v1.CloseShardResponse
-	(*ListHistoryTasksResponse)(nil),                    // 52: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	(*RemoveTaskResponse)(nil),                          // 53: temporal.server.api.adminservice.v1.RemoveTaskResponse
-	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 54: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 55: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	(*GetReplicationMessagesResponse)(nil),              // 56: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	(*GetNamespaceReplicationMessagesResponse)(nil),     // 57: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	(*GetDLQReplicationMessagesResponse)(nil),           // 58: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	(*ReapplyEventsResponse)(nil),                       // 59: temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	(*AddSearchAttributesResponse)(nil),                 // 60: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	(*RemoveSearchAttributesResponse)(nil),              // 61: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	(*GetSearchAttributesResponse)(nil),                 // 62: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	(*DescribeClusterResponse)(nil),                     // 63: temporal.server.api.adminservice.v1.DescribeClusterResponse
-	(*ListClustersResponse)(nil),                        // 64: temporal.server.api.adminservice.v1.ListClustersResponse
-	(*ListClusterMembersResponse)(nil),                  // 65: temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	(*AddOrUpdateRemoteClusterResponse)(nil),            // 66: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	(*RemoveRemoteClusterResponse)(nil),                 // 67: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	(*GetDLQMessagesResponse)(nil),                      // 68: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	(*PurgeDLQMessagesResponse)(nil),                    // 69: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	(*MergeDLQMessagesResponse)(nil),                    // 70: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	(*RefreshWorkflowTasksResponse)(nil),                // 71: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	(*StartAdminBatchOperationResponse)(nil),            // 72: temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
-	(*ResendReplicationTasksResponse)(nil),              // 73: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	(*GetTaskQueueTasksResponse)(nil),                   // 74: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionResponse)(nil),             // 75: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 76: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceResponse)(nil),                        // 77: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksResponse)(nil),                         // 78: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksResponse)(nil),                       // 79: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*MergeDLQTasksResponse)(nil),                       // 80: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobResponse)(nil),                      // 81: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobResponse)(nil),                        // 82: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksResponse)(nil),                            // 83: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesResponse)(nil),                          // 84: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckResponse)(nil),                     // 85: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateResponse)(nil),                   // 86: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 87: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 88: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 89: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	(*GetTaskQueueUserDataResponse)(nil),                // 90: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
-	(*MigrateScheduleResponse)(nil),                     // 91: temporal.server.api.adminservice.v1.MigrateScheduleResponse
+	(*GetDynamicConfigOverridesRequest)(nil),            // 46: temporal.server.api.adminservice.v1.GetDynamicConfigOverridesRequest
+	(*SetDynamicConfigOverrideRequest)(nil),             // 47: temporal.server.api.adminservice.v1.SetDynamicConfigOverrideRequest
+	(*DeleteDynamicConfigOverrideRequest)(nil),          // 48: temporal.server.api.adminservice.v1.DeleteDynamicConfigOverrideRequest
+	(*GetDynamicConfigHistoryRequest)(nil),              // 49: temporal.server.api.adminservice.v1.GetDynamicConfigHistoryRequest
+	(*ExplainDynamicConfigRequest)(nil),                 // 50: temporal.server.api.adminservice.v1.ExplainDynamicConfigRequest
+	(*StartVisibilityDriftCheckRequest)(nil),            // 51: temporal.server.api.adminservice.v1.StartVisibilityDriftCheckRequest
+	(*DescribeVisibilityDriftCheckRequest)(nil),         // 52: temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckRequest
+	(*CancelVisibilityDriftCheckRequest)(nil),           // 53: temporal.server.api.adminservice.v1.CancelVisibilityDriftCheckRequest
+	(*StartVisibilityRebuildRequest)(nil),               // 54: temporal.server.api.adminservice.v1.StartVisibilityRebuildRequest
+	(*DescribeVisibilityRebuildRequest)(nil),            // 55: temporal.server.api.adminservice.v1.DescribeVisibilityRebuildRequest
+	(*CancelVisibilityRebuildRequest)(nil),              // 56: temporal.server.api.adminservice.v1.CancelVisibilityRebuildRequest
+	(*SaveVisibilityViewRequest)(nil),                   // 57: temporal.server.api.adminservice.v1.SaveVisibilityViewRequest
+	(*DescribeVisibilityViewRequest)(nil),               // 58: temporal.server.api.adminservice.v1.DescribeVisibilityViewRequest
+	(*ListVisibilityViewsRequest)(nil),                  // 59: temporal.server.api.adminservice.v1.ListVisibilityViewsRequest
+	(*DeleteVisibilityViewRequest)(nil),                 // 60: temporal.server.api.adminservice.v1.DeleteVisibilityViewRequest
+	(*RestoreArchivedWorkflowExecutionRequest)(nil),     // 61: temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionRequest
+	(*WatchWorkflowExecutionsRequest)(nil),              // 62: temporal.server.api.adminservice.v1.WatchWorkflowExecutionsRequest
+	(*PauseTaskQueueDispatchRequest)(nil),               // 63: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchRequest
+	(*ResumeTaskQueueDispatchRequest)(nil),              // 64: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchRequest
+	(*ListTaskQueueDLQTasksRequest)(nil),                // 65: temporal.server.api.adminservice.v1.ListTaskQueueDLQTasksRequest
+	(*ReenqueueTaskQueueDLQTasksRequest)(nil),           // 66: temporal.server.api.adminservice.v1.ReenqueueTaskQueueDLQTasksRequest
+	(*RebuildMutableStateResponse)(nil),                 // 67: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	(*ImportWorkflowExecutionResponse)(nil),             // 68: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	(*DescribeMutableStateResponse)(nil),                // 69: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	(*DescribeHistoryHostResponse)(nil),                 // 70: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	(*GetShardResponse)(nil),                            // 71: temporal.server.api.adminservice.v1.GetShardResponse
+	(*CloseShardResponse)(nil),                          // 72: temporal.server.api.adminservice.v1.CloseShardResponse
+	(*ListHistoryTasksResponse)(nil),                    // 73: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	(*RemoveTaskResponse)(nil),                          // 74: temporal.server.api.adminservice.v1.RemoveTaskResponse
+	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 75: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 76: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	(*GetReplicationMessagesResponse)(nil),              // 77: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	(*GetNamespaceReplicationMessagesResponse)(nil),     // 78: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	(*GetDLQReplicationMessagesResponse)(nil),           // 79: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	(*ReapplyEventsResponse)(nil),                       // 80: temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	(*AddSearchAttributesResponse)(nil),                 // 81: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributesResponse)(nil),              // 82: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	(*GetSearchAttributesResponse)(nil),                 // 83: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	(*DescribeClusterResponse)(nil),                     // 84: temporal.server.api.adminservice.v1.DescribeClusterResponse
+	(*ListClustersResponse)(nil),                        // 85: temporal.server.api.adminservice.v1.ListClustersResponse
+	(*ListClusterMembersResponse)(nil),                  // 86: temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	(*AddOrUpdateRemoteClusterResponse)(nil),            // 87: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	(*RemoveRemoteClusterResponse)(nil),                 // 88: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	(*GetDLQMessagesResponse)(nil),                      // 89: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	(*PurgeDLQMessagesResponse)(nil),                    // 90: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	(*MergeDLQMessagesResponse)(nil),                    // 91: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	(*RefreshWorkflowTasksResponse)(nil),                // 92: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	(*StartAdminBatchOperationResponse)(nil),            // 93: temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
+	(*ResendReplicationTasksResponse)(nil),              // 94: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	(*GetTaskQueueTasksResponse)(nil),                   // 95: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	(*DeleteWorkflowExecutionResponse)(nil),             // 96: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 97: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceResponse)(nil),                        // 98: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*GetDLQTasksResponse)(nil),                         // 99: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksResponse)(nil),                       // 100: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*MergeDLQTasksResponse)(nil),                       // 101: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobResponse)(nil),                      // 102: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*CancelDLQJobResponse)(nil),                        // 103: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksResponse)(nil),                            // 104: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesResponse)(nil),                          // 105: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckResponse)(nil),                     // 106: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateResponse)(nil),                   // 107: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 108: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionResponse)(nil),          // 109: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 110: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*GetTaskQueueUserDataResponse)(nil),                // 111: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
+	(*MigrateScheduleResponse)(nil),                     // 112: temporal.server.api.adminservice.v1.MigrateScheduleResponse
+	(*GetDynamicConfigOverridesResponse)(nil),           // 113: temporal.server.api.adminservice.v1.GetDynamicConfigOverridesResponse
+	(*SetDynamicConfigOverrideResponse)(nil),            // 114: temporal.server.api.adminservice.v1.SetDynamicConfigOverrideResponse
+	(*DeleteDynamicConfigOverrideResponse)(nil),         // 115: temporal.server.api.adminservice.v1.DeleteDynamicConfigOverrideResponse
+	(*GetDynamicConfigHistoryResponse)(nil),             // 116: temporal.server.api.adminservice.v1.GetDynamicConfigHistoryResponse
+	(*ExplainDynamicConfigResponse)(nil),                // 117: temporal.server.api.adminservice.v1.ExplainDynamicConfigResponse
+	(*StartVisibilityDriftCheckResponse)(nil),           // 118: temporal.server.api.adminservice.v1.StartVisibilityDriftCheckResponse
+	(*DescribeVisibilityDriftCheckResponse)(nil),        // 119: temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse
+	(*CancelVisibilityDriftCheckResponse)(nil),          // 120: temporal.server.api.adminservice.v1.CancelVisibilityDriftCheckResponse
+	(*StartVisibilityRebuildResponse)(nil),              // 121: temporal.server.api.adminservice.v1.StartVisibilityRebuildResponse
+	(*DescribeVisibilityRebuildResponse)(nil),           // 122: temporal.server.api.adminservice.v1.DescribeVisibilityRebuildResponse
+	(*CancelVisibilityRebuildResponse)(nil),             // 123: temporal.server.api.adminservice.v1.CancelVisibilityRebuildResponse
+	(*SaveVisibilityViewResponse)(nil),                  // 124: temporal.server.api.adminservice.v1.SaveVisibilityViewResponse
+	(*DescribeVisibilityViewResponse)(nil),              // 125: temporal.server.api.adminservice.v1.DescribeVisibilityViewResponse
+	(*ListVisibilityViewsResponse)(nil),                 // 126: temporal.server.api.adminservice.v1.ListVisibilityViewsResponse
+	(*DeleteVisibilityViewResponse)(nil),                // 127: temporal.server.api.adminservice.v1.DeleteVisibilityViewResponse
+	(*RestoreArchivedWorkflowExecutionResponse)(nil),    // 128: temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionResponse
+	(*WatchWorkflowExecutionsResponse)(nil),             // 129: temporal.server.api.adminservice.v1.WatchWorkflowExecutionsResponse
+	(*PauseTaskQueueDispatchResponse)(nil),              // 130: temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse
+	(*ResumeTaskQueueDispatchResponse)(nil),             // 131: temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse
+	(*ListTaskQueueDLQTasksResponse)(nil),               // 132: temporal.server.api.adminservice.v1.ListTaskQueueDLQTasksResponse
+	(*ReenqueueTaskQueueDLQTasksResponse)(nil),          // 133: temporal.server.api.adminservice.v1.ReenqueueTaskQueueDLQTasksResponse
 }
 var file_temporal_server_api_adminservice_v1_service_proto_depIdxs = []int32{
-	0,  // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
-	1,  // 1: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	2,  // 2: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	3,  // 3: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	4,  // 4: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
-	5,  // 5: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
-	6,  // 6: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	7,  // 7: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
-	8,  // 8: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	9,  // 9: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	10, // 10: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	11, // 11: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	12, // 12: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	13, // 13: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	14, // 14: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	15, // 15: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	16, // 16: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	17, // 17: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
-	18, // 18: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
-	19, // 19: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	20, // 20: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	21, // 21: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	22, // 22: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	23, // 23: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	24, // 24: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	25, // 25: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	26, // 26: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:input_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
-	27, // 27: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	28, // 28: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	29, // 29: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	30, // 30: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	31, // 31: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
-	32, // 32: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	33, // 33: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	34, // 34: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	35, // 35: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	36, // 36: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	37, // 37: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
-	38, // 38: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
-	39, // 39: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	40, // 40: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	41, // 41: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	42, // 42: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	43, // 43: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	44, // 44: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
-	45, // 45: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:input_type -> temporal.server.api.adminservice.v1.MigrateScheduleRequest
-	46, // 46: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	47, // 47: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	48, // 48: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	49, // 49: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	50, // 50: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
-	51, // 51: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
-	52, // 52: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	53, // 53: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
-	54, // 54: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	55, // 55: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	56, // 56: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	57, // 57: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	58, // 58: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	59, // 59: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	60, // 60: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	61, // 61: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	62, // 62: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	63, // 63: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
-	64, // 64: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
-	65, // 65: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	66, // 66: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	67, // 67: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	68, // 68: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	69, // 69: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	70, // 70: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	71, // 71: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	72, // 72: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:output_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
-	73, // 73: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	74, // 74: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	75, // 75: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	76, // 76: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	77, // 77: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
-	78, // 78: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	79, // 79: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	80, // 80: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	81, // 81: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	82, // 82: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	83, // 83: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
-	84, // 84: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
-	85, // 85: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	86, // 86: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	87, // 87: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	88, // 88: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	89, // 89: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	90, // 90: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
-	91, // 91: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:output_type -> temporal.server.api.adminservice.v1.MigrateScheduleResponse
-	46, // [46:92] is the sub-list for method output_type
-	0,  // [0:46] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
+	1,   // 1: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	2,   // 2: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	3,   // 3: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	4,   // 4: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
+	5,   // 5: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
+	6,   // 6: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	7,   // 7: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
+	8,   // 8: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	9,   // 9: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	10,  // 10: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	11,  // 11: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	12,  // 12: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	13,  // 13: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	14,  // 14: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	15,  // 15: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	16,  // 16: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	17,  // 17: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
+	18,  // 18: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
+	19,  // 19: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	20,  // 20: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	21,  // 21: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	22,  // 22: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	23,  // 23: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	24,  // 24: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	25,  // 25: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	26,  // 26: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:input_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
+	27,  // 27: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	28,  // 28: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	29,  // 29: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	30,  // 30: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	31,  // 31: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
+	32,  // 32: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	33,  // 33: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	34,  // 34: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	35,  // 35: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	36,  // 36: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	37,  // 37: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
+	38,  // 38: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
+	39,  // 39: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	40,  // 40: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	41,  // 41: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	42,  // 42: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	43,  // 43: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	44,  // 44: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
+	45,  // 45: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:input_type -> temporal.server.api.adminservice.v1.MigrateScheduleRequest
+	46,  // 46: temporal.server.api.adminservice.v1.AdminService.GetDynamicConfigOverrides:input_type -> temporal.server.api.adminservice.v1.GetDynamicConfigOverridesRequest
+	47,  // 47: temporal.server.api.adminservice.v1.AdminService.SetDynamicConfigOverride:input_type -> temporal.server.api.adminservice.v1.SetDynamicConfigOverrideRequest
+	48,  // 48: temporal.server.api.adminservice.v1.AdminService.DeleteDynamicConfigOverride:input_type -> temporal.server.api.adminservice.v1.DeleteDynamicConfigOverrideRequest
+	49,  // 49: temporal.server.api.adminservice.v1.AdminService.GetDynamicConfigHistory:input_type -> temporal.server.api.adminservice.v1.GetDynamicConfigHistoryRequest
+	50,  // 50: temporal.server.api.adminservice.v1.AdminService.ExplainDynamicConfig:input_type -> temporal.server.api.adminservice.v1.ExplainDynamicConfigRequest
+	51,  // 51: temporal.server.api.adminservice.v1.AdminService.StartVisibilityDriftCheck:input_type -> temporal.server.api.adminservice.v1.StartVisibilityDriftCheckRequest
+	52,  // 52: temporal.server.api.adminservice.v1.AdminService.DescribeVisibilityDriftCheck:input_type -> temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckRequest
+	53,  // 53: temporal.server.api.adminservice.v1.AdminService.CancelVisibilityDriftCheck:input_type -> temporal.server.api.adminservice.v1.CancelVisibilityDriftCheckRequest
+	54,  // 54: temporal.server.api.adminservice.v1.AdminService.StartVisibilityRebuild:input_type -> temporal.server.api.adminservice.v1.StartVisibilityRebuildRequest
+	55,  // 55: temporal.server.api.adminservice.v1.AdminService.DescribeVisibilityRebuild:input_type -> temporal.server.api.adminservice.v1.DescribeVisibilityRebuildRequest
+	56,  // 56: temporal.server.api.adminservice.v1.AdminService.CancelVisibilityRebuild:input_type -> temporal.server.api.adminservice.v1.CancelVisibilityRebuildRequest
+	57,  // 57: temporal.server.api.adminservice.v1.AdminService.SaveVisibilityView:input_type -> temporal.server.api.adminservice.v1.SaveVisibilityViewRequest
+	58,  // 58: temporal.server.api.adminservice.v1.AdminService.DescribeVisibilityView:input_type -> temporal.server.api.adminservice.v1.DescribeVisibilityViewRequest
+	59,  // 59: temporal.server.api.adminservice.v1.AdminService.ListVisibilityViews:input_type -> temporal.server.api.adminservice.v1.ListVisibilityViewsRequest
+	60,  // 60: temporal.server.api.adminservice.v1.AdminService.DeleteVisibilityView:input_type -> temporal.server.api.adminservice.v1.DeleteVisibilityViewRequest
+	61,  // 61: temporal.server.api.adminservice.v1.AdminService.RestoreArchivedWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionRequest
+	62,  // 62: temporal.server.api.adminservice.v1.AdminService.WatchWorkflowExecutions:input_type -> temporal.server.api.adminservice.v1.WatchWorkflowExecutionsRequest
+	63,  // 63: temporal.server.api.adminservice.v1.AdminService.PauseTaskQueueDispatch:input_type -> temporal.server.api.adminservice.v1.PauseTaskQueueDispatchRequest
+	64,  // 64: temporal.server.api.adminservice.v1.AdminService.ResumeTaskQueueDispatch:input_type -> temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchRequest
+	65,  // 65: temporal.server.api.adminservice.v1.AdminService.ListTaskQueueDLQTasks:input_type -> temporal.server.api.adminservice.v1.ListTaskQueueDLQTasksRequest
+	66,  // 66: temporal.server.api.adminservice.v1.AdminService.ReenqueueTaskQueueDLQTasks:input_type -> temporal.server.api.adminservice.v1.ReenqueueTaskQueueDLQTasksRequest
+	67,  // 67: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	68,  // 68: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	69,  // 69: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	70,  // 70: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	71,  // 71: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
+	72,  // 72: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
+	73,  // 73: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	74,  // 74: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
+	75,  // 75: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	76,  // 76: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	77,  // 77: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	78,  // 78: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	79,  // 79: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	80,  // 80: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	81,  // 81: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	82,  // 82: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	83,  // 83: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	84,  // 84: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
+	85,  // 85: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
+	86,  // 86: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	87,  // 87: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	88,  // 88: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	89,  // 89: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	90,  // 90: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	91,  // 91: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	92,  // 92: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	93,  // 93: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:output_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
+	94,  // 94: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	95,  // 95: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	96,  // 96: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	97,  // 97: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	98,  // 98: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
+	99,  // 99: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	100, // 100: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	101, // 101: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	102, // 102: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	103, // 103: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	104, // 104: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
+	105, // 105: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
+	106, // 106: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	107, // 107: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	108, // 108: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	109, // 109: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	110, // 110: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	111, // 111: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
+	112, // 112: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:output_type -> temporal.server.api.adminservice.v1.MigrateScheduleResponse
+	113, // 113: temporal.server.api.adminservice.v1.AdminService.GetDynamicConfigOverrides:output_type -> temporal.server.api.adminservice.v1.GetDynamicConfigOverridesResponse
+	114, // 114: temporal.server.api.adminservice.v1.AdminService.SetDynamicConfigOverride:output_type -> temporal.server.api.adminservice.v1.SetDynamicConfigOverrideResponse
+	115, // 115: temporal.server.api.adminservice.v1.AdminService.DeleteDynamicConfigOverride:output_type -> temporal.server.api.adminservice.v1.DeleteDynamicConfigOverrideResponse
+	116, // 116: temporal.server.api.adminservice.v1.AdminService.GetDynamicConfigHistory:output_type -> temporal.server.api.adminservice.v1.GetDynamicConfigHistoryResponse
+	117, // 117: temporal.server.api.adminservice.v1.AdminService.ExplainDynamicConfig:output_type -> temporal.server.api.adminservice.v1.ExplainDynamicConfigResponse
+	118, // 118: temporal.server.api.adminservice.v1.AdminService.StartVisibilityDriftCheck:output_type -> temporal.server.api.adminservice.v1.StartVisibilityDriftCheckResponse
+	119, // 119: temporal.server.api.adminservice.v1.AdminService.DescribeVisibilityDriftCheck:output_type -> temporal.server.api.adminservice.v1.DescribeVisibilityDriftCheckResponse
+	120, // 120: temporal.server.api.adminservice.v1.AdminService.CancelVisibilityDriftCheck:output_type -> temporal.server.api.adminservice.v1.CancelVisibilityDriftCheckResponse
+	121, // 121: temporal.server.api.adminservice.v1.AdminService.StartVisibilityRebuild:output_type -> temporal.server.api.adminservice.v1.StartVisibilityRebuildResponse
+	122, // 122: temporal.server.api.adminservice.v1.AdminService.DescribeVisibilityRebuild:output_type -> temporal.server.api.adminservice.v1.DescribeVisibilityRebuildResponse
+	123, // 123: temporal.server.api.adminservice.v1.AdminService.CancelVisibilityRebuild:output_type -> temporal.server.api.adminservice.v1.CancelVisibilityRebuildResponse
+	124, // 124: temporal.server.api.adminservice.v1.AdminService.SaveVisibilityView:output_type -> temporal.server.api.adminservice.v1.SaveVisibilityViewResponse
+	125, // 125: temporal.server.api.adminservice.v1.AdminService.DescribeVisibilityView:output_type -> temporal.server.api.adminservice.v1.DescribeVisibilityViewResponse
+	126, // 126: temporal.server.api.adminservice.v1.AdminService.ListVisibilityViews:output_type -> temporal.server.api.adminservice.v1.ListVisibilityViewsResponse
+	127, // 127: temporal.server.api.adminservice.v1.AdminService.DeleteVisibilityView:output_type -> temporal.server.api.adminservice.v1.DeleteVisibilityViewResponse
+	128, // 128: temporal.server.api.adminservice.v1.AdminService.RestoreArchivedWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.RestoreArchivedWorkflowExecutionResponse
+	129, // 129: temporal.server.api.adminservice.v1.AdminService.WatchWorkflowExecutions:output_type -> temporal.server.api.adminservice.v1.WatchWorkflowExecutionsResponse
+	130, // 130: temporal.server.api.adminservice.v1.AdminService.PauseTaskQueueDispatch:output_type -> temporal.server.api.adminservice.v1.PauseTaskQueueDispatchResponse
+	131, // 131: temporal.server.api.adminservice.v1.AdminService.ResumeTaskQueueDispatch:output_type -> temporal.server.api.adminservice.v1.ResumeTaskQueueDispatchResponse
+	132, // 132: temporal.server.api.adminservice.v1.AdminService.ListTaskQueueDLQTasks:output_type -> temporal.server.api.adminservice.v1.ListTaskQueueDLQTasksResponse
+	133, // 133: temporal.server.api.adminservice.v1.AdminService.ReenqueueTaskQueueDLQTasks:output_type -> temporal.server.api.adminservice.v1.ReenqueueTaskQueueDLQTasksResponse
+	67,  // [67:134] is the sub-list for method output_type
+	0,   // [0:67] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_service_proto_init() }
@@ -66,6 +66,27 @@ const (
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
 	AdminService_GetTaskQueueUserData_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueUserData"
 	AdminService_MigrateSchedule_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/MigrateSchedule"
+	AdminService_GetDynamicConfigOverrides_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfigOverrides"
+	AdminService_SetDynamicConfigOverride_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfigOverride"
+	AdminService_DeleteDynamicConfigOverride_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/DeleteDynamicConfigOverride"
+	AdminService_GetDynamicConfigHistory_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfigHistory"
+	AdminService_ExplainDynamicConfig_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/ExplainDynamicConfig"
+	AdminService_StartVisibilityDriftCheck_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/StartVisibilityDriftCheck"
+	AdminService_DescribeVisibilityDriftCheck_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/DescribeVisibilityDriftCheck"
+	AdminService_CancelVisibilityDriftCheck_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/CancelVisibilityDriftCheck"
+	AdminService_StartVisibilityRebuild_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/StartVisibilityRebuild"
+	AdminService_DescribeVisibilityRebuild_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/DescribeVisibilityRebuild"
+	AdminService_CancelVisibilityRebuild_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/CancelVisibilityRebuild"
+	AdminService_SaveVisibilityView_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/SaveVisibilityView"
+	AdminService_DescribeVisibilityView_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/DescribeVisibilityView"
+	AdminService_ListVisibilityViews_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/ListVisibilityViews"
+	AdminService_DeleteVisibilityView_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DeleteVisibilityView"
+	AdminService_RestoreArchivedWorkflowExecution_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/RestoreArchivedWorkflowExecution"
+	AdminService_WatchWorkflowExecutions_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/WatchWorkflowExecutions"
+	AdminService_PauseTaskQueueDispatch_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/PauseTaskQueueDispatch"
+	AdminService_ResumeTaskQueueDispatch_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ResumeTaskQueueDispatch"
+	AdminService_ListTaskQueueDLQTasks_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/ListTaskQueueDLQTasks"
+	AdminService_ReenqueueTaskQueueDLQTasks_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/ReenqueueTaskQueueDLQTasks"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -161,6 +182,56 @@ type AdminServiceClient interface {
 	GetTaskQueueUserData(ctx context.Context, in *GetTaskQueueUserDataRequest, opts ...grpc.CallOption) (*GetTaskQueueUserDataResponse, error)
 	// MigrateSchedule migrates a schedule between V1 (workflow-backed) and V2 (CHASM-backed) implementations.
 	MigrateSchedule(ctx context.Context, in *MigrateScheduleRequest, opts ...grpc.CallOption) (*MigrateScheduleResponse, error)
+	// GetDynamicConfigOverrides returns the dynamic config overrides stored in the cluster metadata.
+	GetDynamicConfigOverrides(ctx context.Context, in *GetDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*GetDynamicConfigOverridesResponse, error)
+	// SetDynamicConfigOverride creates or replaces a dynamic config override for a key and set of constraints.
+	// Overrides take precedence over values from the dynamic config file.
+	SetDynamicConfigOverride(ctx context.Context, in *SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*SetDynamicConfigOverrideResponse, error)
+	// DeleteDynamicConfigOverride removes a dynamic config override, restoring the value from the dynamic config file.
+	DeleteDynamicConfigOverride(ctx context.Context, in *DeleteDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigOverrideResponse, error)
+	// GetDynamicConfigHistory returns recent changes to dynamic config overrides, newest first.
+	GetDynamicConfigHistory(ctx context.Context, in *GetDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*GetDynamicConfigHistoryResponse, error)
+	// ExplainDynamicConfig returns the effective value of a dynamic config setting for a set of
+	// constraints on each host, along with the values and defaults it was resolved from.
+	ExplainDynamicConfig(ctx context.Context, in *ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*ExplainDynamicConfigResponse, error)
+	// StartVisibilityDriftCheck starts a worker workflow that compares the executions of a namespace in the primary
+	// and secondary visibility stores, and optionally repairs the secondary store.
+	StartVisibilityDriftCheck(ctx context.Context, in *StartVisibilityDriftCheckRequest, opts ...grpc.CallOption) (*StartVisibilityDriftCheckResponse, error)
+	// DescribeVisibilityDriftCheck returns the progress and results of a visibility drift check.
+	DescribeVisibilityDriftCheck(ctx context.Context, in *DescribeVisibilityDriftCheckRequest, opts ...grpc.CallOption) (*DescribeVisibilityDriftCheckResponse, error)
+	// CancelVisibilityDriftCheck stops a running visibility drift check.
+	CancelVisibilityDriftCheck(ctx context.Context, in *CancelVisibilityDriftCheckRequest, opts ...grpc.CallOption) (*CancelVisibilityDriftCheckResponse, error)
+	// StartVisibilityRebuild starts a worker workflow that regenerates the visibility records of every execution of a
+	// namespace from mutable state and writes them to a visibility store.
+	StartVisibilityRebuild(ctx context.Context, in *StartVisibilityRebuildRequest, opts ...grpc.CallOption) (*StartVisibilityRebuildResponse, error)
+	// DescribeVisibilityRebuild returns the progress of a visibility rebuild.
+	DescribeVisibilityRebuild(ctx context.Context, in *DescribeVisibilityRebuildRequest, opts ...grpc.CallOption) (*DescribeVisibilityRebuildResponse, error)
+	// CancelVisibilityRebuild stops a running visibility rebuild.
+	CancelVisibilityRebuild(ctx context.Context, in *CancelVisibilityRebuildRequest, opts ...grpc.CallOption) (*CancelVisibilityRebuildResponse, error)
+	// SaveVisibilityView creates or updates a saved visibility query of a namespace. The query is validated against
+	// the search attributes of the namespace.
+	SaveVisibilityView(ctx context.Context, in *SaveVisibilityViewRequest, opts ...grpc.CallOption) (*SaveVisibilityViewResponse, error)
+	DescribeVisibilityView(ctx context.Context, in *DescribeVisibilityViewRequest, opts ...grpc.CallOption) (*DescribeVisibilityViewResponse, error)
+	ListVisibilityViews(ctx context.Context, in *ListVisibilityViewsRequest, opts ...grpc.CallOption) (*ListVisibilityViewsResponse, error)
+	DeleteVisibilityView(ctx context.Context, in *DeleteVisibilityViewRequest, opts ...grpc.CallOption) (*DeleteVisibilityViewResponse, error)
+	// RestoreArchivedWorkflowExecution reads the archived history of a workflow execution and imports it as a closed
+	// execution, possibly into another namespace. The restored execution can be reset to continue from one of its events.
+	RestoreArchivedWorkflowExecution(ctx context.Context, in *RestoreArchivedWorkflowExecutionRequest, opts ...grpc.CallOption) (*RestoreArchivedWorkflowExecutionResponse, error)
+	// WatchWorkflowExecutions streams changes to the visibility records of the workflow executions of a namespace that
+	// match a visibility query, as history hosts process visibility tasks.
+	WatchWorkflowExecutions(ctx context.Context, in *WatchWorkflowExecutionsRequest, opts ...grpc.CallOption) (AdminService_WatchWorkflowExecutionsClient, error)
+	// PauseTaskQueueDispatch stops handing tasks of one type of a task queue to pollers, on all of its partitions.
+	// New tasks are still added to the backlog, and queries are still dispatched. The pause is kept in the task queue
+	// user data, so it survives reloads and replicates with it.
+	PauseTaskQueueDispatch(ctx context.Context, in *PauseTaskQueueDispatchRequest, opts ...grpc.CallOption) (*PauseTaskQueueDispatchResponse, error)
+	// ResumeTaskQueueDispatch undoes PauseTaskQueueDispatch. Resuming a task queue that isn't paused is a no-op.
+	ResumeTaskQueueDispatch(ctx context.Context, in *ResumeTaskQueueDispatchRequest, opts ...grpc.CallOption) (*ResumeTaskQueueDispatchResponse, error)
+	// ListTaskQueueDLQTasks returns a page of the tasks in the DLQ of one type of a task queue. Backlog tasks are written
+	// to the DLQ when they expire or can't be delivered, if matching.taskDLQEnabled is set.
+	ListTaskQueueDLQTasks(ctx context.Context, in *ListTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*ListTaskQueueDLQTasksResponse, error)
+	// ReenqueueTaskQueueDLQTasks adds tasks from the DLQ of one type of a task queue back to the task queue, without
+	// a schedule-to-start timeout, and removes them from the DLQ. Each request handles a limited number of tasks.
+	ReenqueueTaskQueueDLQTasks(ctx context.Context, in *ReenqueueTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*ReenqueueTaskQueueDLQTasksResponse, error)
 }
 
 type adminServiceClient struct {
@@ -607,6 +678,218 @@ func (c *adminServiceClient) MigrateSchedule(ctx context.Context, in *MigrateSch
 	return out, nil
 }
 
+func (c *adminServiceClient) GetDynamicConfigOverrides(ctx context.Context, in *GetDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*GetDynamicConfigOverridesResponse, error) {
+	out := new(GetDynamicConfigOverridesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDynamicConfigOverrides_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDynamicConfigOverride(ctx context.Context, in *SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*SetDynamicConfigOverrideResponse, error) {
+	out := new(SetDynamicConfigOverrideResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetDynamicConfigOverride_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteDynamicConfigOverride(ctx context.Context, in *DeleteDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigOverrideResponse, error) {
+	out := new(DeleteDynamicConfigOverrideResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteDynamicConfigOverride_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDynamicConfigHistory(ctx context.Context, in *GetDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*GetDynamicConfigHistoryResponse, error) {
+	out := new(GetDynamicConfigHistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDynamicConfigHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExplainDynamicConfig(ctx context.Context, in *ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*ExplainDynamicConfigResponse, error) {
+	out := new(ExplainDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExplainDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartVisibilityDriftCheck(ctx context.Context, in *StartVisibilityDriftCheckRequest, opts ...grpc.CallOption) (*StartVisibilityDriftCheckResponse, error) {
+	out := new(StartVisibilityDriftCheckResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartVisibilityDriftCheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeVisibilityDriftCheck(ctx context.Context, in *DescribeVisibilityDriftCheckRequest, opts ...grpc.CallOption) (*DescribeVisibilityDriftCheckResponse, error) {
+	out := new(DescribeVisibilityDriftCheckResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeVisibilityDriftCheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CancelVisibilityDriftCheck(ctx context.Context, in *CancelVisibilityDriftCheckRequest, opts ...grpc.CallOption) (*CancelVisibilityDriftCheckResponse, error) {
+	out := new(CancelVisibilityDriftCheckResponse)
+	err := c.cc.Invoke(ctx, AdminService_CancelVisibilityDriftCheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartVisibilityRebuild(ctx context.Context, in *StartVisibilityRebuildRequest, opts ...grpc.CallOption) (*StartVisibilityRebuildResponse, error) {
+	out := new(StartVisibilityRebuildResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartVisibilityRebuild_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeVisibilityRebuild(ctx context.Context, in *DescribeVisibilityRebuildRequest, opts ...grpc.CallOption) (*DescribeVisibilityRebuildResponse, error) {
+	out := new(DescribeVisibilityRebuildResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeVisibilityRebuild_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CancelVisibilityRebuild(ctx context.Context, in *CancelVisibilityRebuildRequest, opts ...grpc.CallOption) (*CancelVisibilityRebuildResponse, error) {
+	out := new(CancelVisibilityRebuildResponse)
+	err := c.cc.Invoke(ctx, AdminService_CancelVisibilityRebuild_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SaveVisibilityView(ctx context.Context, in *SaveVisibilityViewRequest, opts ...grpc.CallOption) (*SaveVisibilityViewResponse, error) {
+	out := new(SaveVisibilityViewResponse)
+	err := c.cc.Invoke(ctx, AdminService_SaveVisibilityView_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeVisibilityView(ctx context.Context, in *DescribeVisibilityViewRequest, opts ...grpc.CallOption) (*DescribeVisibilityViewResponse, error) {
+	out := new(DescribeVisibilityViewResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeVisibilityView_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListVisibilityViews(ctx context.Context, in *ListVisibilityViewsRequest, opts ...grpc.CallOption) (*ListVisibilityViewsResponse, error) {
+	out := new(ListVisibilityViewsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListVisibilityViews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteVisibilityView(ctx context.Context, in *DeleteVisibilityViewRequest, opts ...grpc.CallOption) (*DeleteVisibilityViewResponse, error) {
+	out := new(DeleteVisibilityViewResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteVisibilityView_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreArchivedWorkflowExecution(ctx context.Context, in *RestoreArchivedWorkflowExecutionRequest, opts ...grpc.CallOption) (*RestoreArchivedWorkflowExecutionResponse, error) {
+	out := new(RestoreArchivedWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreArchivedWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) WatchWorkflowExecutions(ctx context.Context, in *WatchWorkflowExecutionsRequest, opts ...grpc.CallOption) (AdminService_WatchWorkflowExecutionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[1], AdminService_WatchWorkflowExecutions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceWatchWorkflowExecutionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_WatchWorkflowExecutionsClient interface {
+	Recv() (*WatchWorkflowExecutionsResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceWatchWorkflowExecutionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceWatchWorkflowExecutionsClient) Recv() (*WatchWorkflowExecutionsResponse, error) {
+	m := new(WatchWorkflowExecutionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminServiceClient) PauseTaskQueueDispatch(ctx context.Context, in *PauseTaskQueueDispatchRequest, opts ...grpc.CallOption) (*PauseTaskQueueDispatchResponse, error) {
+	out := new(PauseTaskQueueDispatchResponse)
+	err := c.cc.Invoke(ctx, AdminService_PauseTaskQueueDispatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResumeTaskQueueDispatch(ctx context.Context, in *ResumeTaskQueueDispatchRequest, opts ...grpc.CallOption) (*ResumeTaskQueueDispatchResponse, error) {
+	out := new(ResumeTaskQueueDispatchResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResumeTaskQueueDispatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListTaskQueueDLQTasks(ctx context.Context, in *ListTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*ListTaskQueueDLQTasksResponse, error) {
+	out := new(ListTaskQueueDLQTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListTaskQueueDLQTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReenqueueTaskQueueDLQTasks(ctx context.Context, in *ReenqueueTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*ReenqueueTaskQueueDLQTasksResponse, error) {
+	out := new(ReenqueueTaskQueueDLQTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReenqueueTaskQueueDLQTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -700,6 +983,56 @@ type AdminServiceServer interface {
 	GetTaskQueueUserData(context.Context, *GetTaskQueueUserDataRequest) (*GetTaskQueueUserDataResponse, error)
 	// MigrateSchedule migrates a schedule between V1 (workflow-backed) and V2 (CHASM-backed) implementations.
 	MigrateSchedule(context.Context, *MigrateScheduleRequest) (*MigrateScheduleResponse, error)
+	// GetDynamicConfigOverrides returns the dynamic config overrides stored in the cluster metadata.
+	GetDynamicConfigOverrides(context.Context, *GetDynamicConfigOverridesRequest) (*GetDynamicConfigOverridesResponse, error)
+	// SetDynamicConfigOverride creates or replaces a dynamic config override for a key and set of constraints.
+	// Overrides take precedence over values from the dynamic config file.
+	SetDynamicConfigOverride(context.Context, *SetDynamicConfigOverrideRequest) (*SetDynamicConfigOverrideResponse, error)
+	// DeleteDynamicConfigOverride removes a dynamic config override, restoring the value from the dynamic config file.
+	DeleteDynamicConfigOverride(context.Context, *DeleteDynamicConfigOverrideRequest) (*DeleteDynamicConfigOverrideResponse, error)
+	// GetDynamicConfigHistory returns recent changes to dynamic config overrides, newest first.
+	GetDynamicConfigHistory(context.Context, *GetDynamicConfigHistoryRequest) (*GetDynamicConfigHistoryResponse, error)
+	// ExplainDynamicConfig returns the effective value of a dynamic config setting for a set of
+	// constraints on each host, along with the values and defaults it was resolved from.
+	ExplainDynamicConfig(context.Context, *ExplainDynamicConfigRequest) (*ExplainDynamicConfigResponse, error)
+	// StartVisibilityDriftCheck starts a worker workflow that compares the executions of a namespace in the primary
+	// and secondary visibility stores, and optionally repairs the secondary store.
+	StartVisibilityDriftCheck(context.Context, *StartVisibilityDriftCheckRequest) (*StartVisibilityDriftCheckResponse, error)
+	// DescribeVisibilityDriftCheck returns the progress and results of a visibility drift check.
+	DescribeVisibilityDriftCheck(context.Context, *DescribeVisibilityDriftCheckRequest) (*DescribeVisibilityDriftCheckResponse, error)
+	// CancelVisibilityDriftCheck stops a running visibility drift check.
+	CancelVisibilityDriftCheck(context.Context, *CancelVisibilityDriftCheckRequest) (*CancelVisibilityDriftCheckResponse, error)
+	// StartVisibilityRebuild starts a worker workflow that regenerates the visibility records of every execution of a
+	// namespace from mutable state and writes them to a visibility store.
+	StartVisibilityRebuild(context.Context, *StartVisibilityRebuildRequest) (*StartVisibilityRebuildResponse, error)
+	// DescribeVisibilityRebuild returns the progress of a visibility rebuild.
+	DescribeVisibilityRebuild(context.Context, *DescribeVisibilityRebuildRequest) (*DescribeVisibilityRebuildResponse, error)
+	// CancelVisibilityRebuild stops a running visibility rebuild.
+	CancelVisibilityRebuild(context.Context, *CancelVisibilityRebuildRequest) (*CancelVisibilityRebuildResponse, error)
+	// SaveVisibilityView creates or updates a saved visibility query of a namespace. The query is validated against
+	// the search attributes of the namespace.
+	SaveVisibilityView(context.Context, *SaveVisibilityViewRequest) (*SaveVisibilityViewResponse, error)
+	DescribeVisibilityView(context.Context, *DescribeVisibilityViewRequest) (*DescribeVisibilityViewResponse, error)
+	ListVisibilityViews(context.Context, *ListVisibilityViewsRequest) (*ListVisibilityViewsResponse, error)
+	DeleteVisibilityView(context.Context, *DeleteVisibilityViewRequest) (*DeleteVisibilityViewResponse, error)
+	// RestoreArchivedWorkflowExecution reads the archived history of a workflow execution and imports it as a closed
+	// execution, possibly into another namespace. The restored execution can be reset to continue from one of its events.
+	RestoreArchivedWorkflowExecution(context.Context, *RestoreArchivedWorkflowExecutionRequest) (*RestoreArchivedWorkflowExecutionResponse, error)
+	// WatchWorkflowExecutions streams changes to the visibility records of the workflow executions of a namespace that
+	// match a visibility query, as history hosts process visibility tasks.
+	WatchWorkflowExecutions(*WatchWorkflowExecutionsRequest, AdminService_WatchWorkflowExecutionsServer) error
+	// PauseTaskQueueDispatch stops handing tasks of one type of a task queue to pollers, on all of its partitions.
+	// New tasks are still added to the backlog, and queries are still dispatched. The pause is kept in the task queue
+	// user data, so it survives reloads and replicates with it.
+	PauseTaskQueueDispatch(context.Context, *PauseTaskQueueDispatchRequest) (*PauseTaskQueueDispatchResponse, error)
+	// ResumeTaskQueueDispatch undoes PauseTaskQueueDispatch. Resuming a task queue that isn't paused is a no-op.
+	ResumeTaskQueueDispatch(context.Context, *ResumeTaskQueueDispatchRequest) (*ResumeTaskQueueDispatchResponse, error)
+	// ListTaskQueueDLQTasks returns a page of the tasks in the DLQ of one type of a task queue. Backlog tasks are written
+	// to the DLQ when they expire or can't be delivered, if matching.taskDLQEnabled is set.
+	ListTaskQueueDLQTasks(context.Context, *ListTaskQueueDLQTasksRequest) (*ListTaskQueueDLQTasksResponse, error)
+	// ReenqueueTaskQueueDLQTasks adds tasks from the DLQ of one type of a task queue back to the task queue, without
+	// a schedule-to-start timeout, and removes them from the DLQ. Each request handles a limited number of tasks.
+	ReenqueueTaskQueueDLQTasks(context.Context, *ReenqueueTaskQueueDLQTasksRequest) (*ReenqueueTaskQueueDLQTasksResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -845,6 +1178,69 @@ func (UnimplementedAdminServiceServer) GetTaskQueueUserData(context.Context, *Ge
 func (UnimplementedAdminServiceServer) MigrateSchedule(context.Context, *MigrateScheduleRequest) (*MigrateScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MigrateSchedule not implemented")
 }
+func (UnimplementedAdminServiceServer) GetDynamicConfigOverrides(context.Context, *GetDynamicConfigOverridesRequest) (*GetDynamicConfigOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfigOverrides not implemented")
+}
+func (UnimplementedAdminServiceServer) SetDynamicConfigOverride(context.Context, *SetDynamicConfigOverrideRequest) (*SetDynamicConfigOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicConfigOverride not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteDynamicConfigOverride(context.Context, *DeleteDynamicConfigOverrideRequest) (*DeleteDynamicConfigOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDynamicConfigOverride not implemented")
+}
+func (UnimplementedAdminServiceServer) GetDynamicConfigHistory(context.Context, *GetDynamicConfigHistoryRequest) (*GetDynamicConfigHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfigHistory not implemented")
+}
+func (UnimplementedAdminServiceServer) ExplainDynamicConfig(context.Context, *ExplainDynamicConfigRequest) (*ExplainDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) StartVisibilityDriftCheck(context.Context, *StartVisibilityDriftCheckRequest) (*StartVisibilityDriftCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartVisibilityDriftCheck not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeVisibilityDriftCheck(context.Context, *DescribeVisibilityDriftCheckRequest) (*DescribeVisibilityDriftCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVisibilityDriftCheck not implemented")
+}
+func (UnimplementedAdminServiceServer) CancelVisibilityDriftCheck(context.Context, *CancelVisibilityDriftCheckRequest) (*CancelVisibilityDriftCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelVisibilityDriftCheck not implemented")
+}
+func (UnimplementedAdminServiceServer) StartVisibilityRebuild(context.Context, *StartVisibilityRebuildRequest) (*StartVisibilityRebuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartVisibilityRebuild not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeVisibilityRebuild(context.Context, *DescribeVisibilityRebuildRequest) (*DescribeVisibilityRebuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVisibilityRebuild not implemented")
+}
+func (UnimplementedAdminServiceServer) CancelVisibilityRebuild(context.Context, *CancelVisibilityRebuildRequest) (*CancelVisibilityRebuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelVisibilityRebuild not implemented")
+}
+func (UnimplementedAdminServiceServer) SaveVisibilityView(context.Context, *SaveVisibilityViewRequest) (*SaveVisibilityViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveVisibilityView not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeVisibilityView(context.Context, *DescribeVisibilityViewRequest) (*DescribeVisibilityViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVisibilityView not implemented")
+}
+func (UnimplementedAdminServiceServer) ListVisibilityViews(context.Context, *ListVisibilityViewsRequest) (*ListVisibilityViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVisibilityViews not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteVisibilityView(context.Context, *DeleteVisibilityViewRequest) (*DeleteVisibilityViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVisibilityView not implemented")
+}
+func (UnimplementedAdminServiceServer) RestoreArchivedWorkflowExecution(context.Context, *RestoreArchivedWorkflowExecutionRequest) (*RestoreArchivedWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArchivedWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) WatchWorkflowExecutions(*WatchWorkflowExecutionsRequest, AdminService_WatchWorkflowExecutionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWorkflowExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) PauseTaskQueueDispatch(context.Context, *PauseTaskQueueDispatchRequest) (*PauseTaskQueueDispatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTaskQueueDispatch not implemented")
+}
+func (UnimplementedAdminServiceServer) ResumeTaskQueueDispatch(context.Context, *ResumeTaskQueueDispatchRequest) (*ResumeTaskQueueDispatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTaskQueueDispatch not implemented")
+}
+func (UnimplementedAdminServiceServer) ListTaskQueueDLQTasks(context.Context, *ListTaskQueueDLQTasksRequest) (*ListTaskQueueDLQTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueueDLQTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) ReenqueueTaskQueueDLQTasks(context.Context, *ReenqueueTaskQueueDLQTasksRequest) (*ReenqueueTaskQueueDLQTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReenqueueTaskQueueDLQTasks not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1694,6 +2090,387 @@ func _AdminService_MigrateSchedule_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetDynamicConfigOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfigOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDynamicConfigOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfigOverrides(ctx, req.(*GetDynamicConfigOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetDynamicConfigOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicConfigOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetDynamicConfigOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetDynamicConfigOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetDynamicConfigOverride(ctx, req.(*SetDynamicConfigOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteDynamicConfigOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDynamicConfigOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteDynamicConfigOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteDynamicConfigOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteDynamicConfigOverride(ctx, req.(*DeleteDynamicConfigOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDynamicConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfigHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDynamicConfigHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfigHistory(ctx, req.(*GetDynamicConfigHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExplainDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExplainDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExplainDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExplainDynamicConfig(ctx, req.(*ExplainDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartVisibilityDriftCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartVisibilityDriftCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartVisibilityDriftCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartVisibilityDriftCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartVisibilityDriftCheck(ctx, req.(*StartVisibilityDriftCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeVisibilityDriftCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVisibilityDriftCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeVisibilityDriftCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeVisibilityDriftCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeVisibilityDriftCheck(ctx, req.(*DescribeVisibilityDriftCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CancelVisibilityDriftCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelVisibilityDriftCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelVisibilityDriftCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CancelVisibilityDriftCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelVisibilityDriftCheck(ctx, req.(*CancelVisibilityDriftCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartVisibilityRebuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartVisibilityRebuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartVisibilityRebuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartVisibilityRebuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartVisibilityRebuild(ctx, req.(*StartVisibilityRebuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeVisibilityRebuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVisibilityRebuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeVisibilityRebuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeVisibilityRebuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeVisibilityRebuild(ctx, req.(*DescribeVisibilityRebuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CancelVisibilityRebuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelVisibilityRebuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelVisibilityRebuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CancelVisibilityRebuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelVisibilityRebuild(ctx, req.(*CancelVisibilityRebuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SaveVisibilityView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveVisibilityViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SaveVisibilityView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SaveVisibilityView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SaveVisibilityView(ctx, req.(*SaveVisibilityViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeVisibilityView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVisibilityViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeVisibilityView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeVisibilityView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeVisibilityView(ctx, req.(*DescribeVisibilityViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListVisibilityViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVisibilityViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListVisibilityViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListVisibilityViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListVisibilityViews(ctx, req.(*ListVisibilityViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteVisibilityView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVisibilityViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteVisibilityView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteVisibilityView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteVisibilityView(ctx, req.(*DeleteVisibilityViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreArchivedWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreArchivedWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreArchivedWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreArchivedWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreArchivedWorkflowExecution(ctx, req.(*RestoreArchivedWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_WatchWorkflowExecutions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWorkflowExecutionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).WatchWorkflowExecutions(m, &adminServiceWatchWorkflowExecutionsServer{stream})
+}
+
+type AdminService_WatchWorkflowExecutionsServer interface {
+	Send(*WatchWorkflowExecutionsResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceWatchWorkflowExecutionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceWatchWorkflowExecutionsServer) Send(m *WatchWorkflowExecutionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminService_PauseTaskQueueDispatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTaskQueueDispatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseTaskQueueDispatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PauseTaskQueueDispatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseTaskQueueDispatch(ctx, req.(*PauseTaskQueueDispatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResumeTaskQueueDispatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTaskQueueDispatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResumeTaskQueueDispatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResumeTaskQueueDispatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResumeTaskQueueDispatch(ctx, req.(*ResumeTaskQueueDispatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListTaskQueueDLQTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueueDLQTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTaskQueueDLQTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListTaskQueueDLQTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTaskQueueDLQTasks(ctx, req.(*ListTaskQueueDLQTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReenqueueTaskQueueDLQTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReenqueueTaskQueueDLQTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReenqueueTaskQueueDLQTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReenqueueTaskQueueDLQTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReenqueueTaskQueueDLQTasks(ctx, req.(*ReenqueueTaskQueueDLQTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1881,6 +2658,86 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MigrateSchedule",
 			Handler:    _AdminService_MigrateSchedule_Handler,
 		},
+		{
+			MethodName: "GetDynamicConfigOverrides",
+			Handler:    _AdminService_GetDynamicConfigOverrides_Handler,
+		},
+		{
+			MethodName: "SetDynamicConfigOverride",
+			Handler:    _AdminService_SetDynamicConfigOverride_Handler,
+		},
+		{
+			MethodName: "DeleteDynamicConfigOverride",
+			Handler:    _AdminService_DeleteDynamicConfigOverride_Handler,
+		},
+		{
+			MethodName: "GetDynamicConfigHistory",
+			Handler:    _AdminService_GetDynamicConfigHistory_Handler,
+		},
+		{
+			MethodName: "ExplainDynamicConfig",
+			Handler:    _AdminService_ExplainDynamicConfig_Handler,
+		},
+		{
+			MethodName: "StartVisibilityDriftCheck",
+			Handler:    _AdminService_StartVisibilityDriftCheck_Handler,
+		},
+		{
+			MethodName: "DescribeVisibilityDriftCheck",
+			Handler:    _AdminService_DescribeVisibilityDriftCheck_Handler,
+		},
+		{
+			MethodName: "CancelVisibilityDriftCheck",
+			Handler:    _AdminService_CancelVisibilityDriftCheck_Handler,
+		},
+		{
+			MethodName: "StartVisibilityRebuild",
+			Handler:    _AdminService_StartVisibilityRebuild_Handler,
+		},
+		{
+			MethodName: "DescribeVisibilityRebuild",
+			Handler:    _AdminService_DescribeVisibilityRebuild_Handler,
+		},
+		{
+			MethodName: "CancelVisibilityRebuild",
+			Handler:    _AdminService_CancelVisibilityRebuild_Handler,
+		},
+		{
+			MethodName: "SaveVisibilityView",
+			Handler:    _AdminService_SaveVisibilityView_Handler,
+		},
+		{
+			MethodName: "DescribeVisibilityView",
+			Handler:    _AdminService_DescribeVisibilityView_Handler,
+		},
+		{
+			MethodName: "ListVisibilityViews",
+			Handler:    _AdminService_ListVisibilityViews_Handler,
+		},
+		{
+			MethodName: "DeleteVisibilityView",
+			Handler:    _AdminService_DeleteVisibilityView_Handler,
+		},
+		{
+			MethodName: "RestoreArchivedWorkflowExecution",
+			Handler:    _AdminService_RestoreArchivedWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "PauseTaskQueueDispatch",
+			Handler:    _AdminService_PauseTaskQueueDispatch_Handler,
+		},
+		{
+			MethodName: "ResumeTaskQueueDispatch",
+			Handler:    _AdminService_ResumeTaskQueueDispatch_Handler,
+		},
+		{
+			MethodName: "ListTaskQueueDLQTasks",
+			Handler:    _AdminService_ListTaskQueueDLQTasks_Handler,
+		},
+		{
+			MethodName: "ReenqueueTaskQueueDLQTasks",
+			Handler:    _AdminService_ReenqueueTaskQueueDLQTasks_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1889,6 +2746,11 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchWorkflowExecutions",
+			Handler:       _AdminService_WatchWorkflowExecutions_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
 }
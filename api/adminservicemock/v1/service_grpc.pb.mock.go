@@ -123,6 +123,46 @@ func (mr *MockAdminServiceClientMockRecorder) CancelDLQJob(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelDLQJob), varargs...)
 }
 
+// CancelVisibilityDriftCheck mocks base method.
+func (m *MockAdminServiceClient) CancelVisibilityDriftCheck(ctx context.Context, in *adminservice.CancelVisibilityDriftCheckRequest, opts ...grpc.CallOption) (*adminservice.CancelVisibilityDriftCheckResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelVisibilityDriftCheck", varargs...)
+	ret0, _ := ret[0].(*adminservice.CancelVisibilityDriftCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelVisibilityDriftCheck indicates an expected call of CancelVisibilityDriftCheck.
+func (mr *MockAdminServiceClientMockRecorder) CancelVisibilityDriftCheck(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVisibilityDriftCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelVisibilityDriftCheck), varargs...)
+}
+
+// CancelVisibilityRebuild mocks base method.
+func (m *MockAdminServiceClient) CancelVisibilityRebuild(ctx context.Context, in *adminservice.CancelVisibilityRebuildRequest, opts ...grpc.CallOption) (*adminservice.CancelVisibilityRebuildResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelVisibilityRebuild", varargs...)
+	ret0, _ := ret[0].(*adminservice.CancelVisibilityRebuildResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelVisibilityRebuild indicates an expected call of CancelVisibilityRebuild.
+func (mr *MockAdminServiceClientMockRecorder) CancelVisibilityRebuild(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVisibilityRebuild", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelVisibilityRebuild), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +203,46 @@ func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
 }
 
+// DeleteDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceClient) DeleteDynamicConfigOverride(ctx context.Context, in *adminservice.DeleteDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDynamicConfigOverride", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDynamicConfigOverride indicates an expected call of DeleteDynamicConfigOverride.
+func (mr *MockAdminServiceClientMockRecorder) DeleteDynamicConfigOverride(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteDynamicConfigOverride), varargs...)
+}
+
+// DeleteVisibilityView mocks base method.
+func (m *MockAdminServiceClient) DeleteVisibilityView(ctx context.Context, in *adminservice.DeleteVisibilityViewRequest, opts ...grpc.CallOption) (*adminservice.DeleteVisibilityViewResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVisibilityView", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteVisibilityViewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVisibilityView indicates an expected call of DeleteVisibilityView.
+func (mr *MockAdminServiceClientMockRecorder) DeleteVisibilityView(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVisibilityView", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteVisibilityView), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +363,86 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
 }
 
+// DescribeVisibilityDriftCheck mocks base method.
+func (m *MockAdminServiceClient) DescribeVisibilityDriftCheck(ctx context.Context, in *adminservice.DescribeVisibilityDriftCheckRequest, opts ...grpc.CallOption) (*adminservice.DescribeVisibilityDriftCheckResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVisibilityDriftCheck", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityDriftCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityDriftCheck indicates an expected call of DescribeVisibilityDriftCheck.
+func (mr *MockAdminServiceClientMockRecorder) DescribeVisibilityDriftCheck(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityDriftCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeVisibilityDriftCheck), varargs...)
+}
+
+// DescribeVisibilityRebuild mocks base method.
+func (m *MockAdminServiceClient) DescribeVisibilityRebuild(ctx context.Context, in *adminservice.DescribeVisibilityRebuildRequest, opts ...grpc.CallOption) (*adminservice.DescribeVisibilityRebuildResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVisibilityRebuild", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityRebuildResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityRebuild indicates an expected call of DescribeVisibilityRebuild.
+func (mr *MockAdminServiceClientMockRecorder) DescribeVisibilityRebuild(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityRebuild", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeVisibilityRebuild), varargs...)
+}
+
+// DescribeVisibilityView mocks base method.
+func (m *MockAdminServiceClient) DescribeVisibilityView(ctx context.Context, in *adminservice.DescribeVisibilityViewRequest, opts ...grpc.CallOption) (*adminservice.DescribeVisibilityViewResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVisibilityView", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityViewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityView indicates an expected call of DescribeVisibilityView.
+func (mr *MockAdminServiceClientMockRecorder) DescribeVisibilityView(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityView", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeVisibilityView), varargs...)
+}
+
+// ExplainDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) ExplainDynamicConfig(ctx context.Context, in *adminservice.ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.ExplainDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExplainDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExplainDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainDynamicConfig indicates an expected call of ExplainDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) ExplainDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).ExplainDynamicConfig), varargs...)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context, in *adminservice.ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -383,6 +543,46 @@ func (mr *MockAdminServiceClientMockRecorder) GetDLQTasks(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQTasks), varargs...)
 }
 
+// GetDynamicConfigHistory mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfigHistory(ctx context.Context, in *adminservice.GetDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfigHistory", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfigHistory indicates an expected call of GetDynamicConfigHistory.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfigHistory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfigHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfigHistory), varargs...)
+}
+
+// GetDynamicConfigOverrides mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfigOverrides(ctx context.Context, in *adminservice.GetDynamicConfigOverridesRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigOverridesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfigOverrides", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigOverridesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfigOverrides indicates an expected call of GetDynamicConfigOverrides.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfigOverrides(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfigOverrides", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfigOverrides), varargs...)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceClient) GetNamespace(ctx context.Context, in *adminservice.GetNamespaceRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -663,6 +863,46 @@ func (mr *MockAdminServiceClientMockRecorder) ListQueues(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueues), varargs...)
 }
 
+// ListTaskQueueDLQTasks mocks base method.
+func (m *MockAdminServiceClient) ListTaskQueueDLQTasks(ctx context.Context, in *adminservice.ListTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*adminservice.ListTaskQueueDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskQueueDLQTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueueDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueDLQTasks indicates an expected call of ListTaskQueueDLQTasks.
+func (mr *MockAdminServiceClientMockRecorder) ListTaskQueueDLQTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListTaskQueueDLQTasks), varargs...)
+}
+
+// ListVisibilityViews mocks base method.
+func (m *MockAdminServiceClient) ListVisibilityViews(ctx context.Context, in *adminservice.ListVisibilityViewsRequest, opts ...grpc.CallOption) (*adminservice.ListVisibilityViewsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVisibilityViews", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListVisibilityViewsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVisibilityViews indicates an expected call of ListVisibilityViews.
+func (mr *MockAdminServiceClientMockRecorder) ListVisibilityViews(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVisibilityViews", reflect.TypeOf((*MockAdminServiceClient)(nil).ListVisibilityViews), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -723,6 +963,26 @@ func (mr *MockAdminServiceClientMockRecorder) MigrateSchedule(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).MigrateSchedule), varargs...)
 }
 
+// PauseTaskQueueDispatch mocks base method.
+func (m *MockAdminServiceClient) PauseTaskQueueDispatch(ctx context.Context, in *adminservice.PauseTaskQueueDispatchRequest, opts ...grpc.CallOption) (*adminservice.PauseTaskQueueDispatchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseTaskQueueDispatch", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseTaskQueueDispatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseTaskQueueDispatch indicates an expected call of PauseTaskQueueDispatch.
+func (mr *MockAdminServiceClientMockRecorder) PauseTaskQueueDispatch(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskQueueDispatch", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseTaskQueueDispatch), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -803,6 +1063,26 @@ func (mr *MockAdminServiceClientMockRecorder) RebuildMutableState(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).RebuildMutableState), varargs...)
 }
 
+// ReenqueueTaskQueueDLQTasks mocks base method.
+func (m *MockAdminServiceClient) ReenqueueTaskQueueDLQTasks(ctx context.Context, in *adminservice.ReenqueueTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*adminservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReenqueueTaskQueueDLQTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ReenqueueTaskQueueDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReenqueueTaskQueueDLQTasks indicates an expected call of ReenqueueTaskQueueDLQTasks.
+func (mr *MockAdminServiceClientMockRecorder) ReenqueueTaskQueueDLQTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueTaskQueueDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ReenqueueTaskQueueDLQTasks), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -903,6 +1183,86 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// RestoreArchivedWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) RestoreArchivedWorkflowExecution(ctx context.Context, in *adminservice.RestoreArchivedWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.RestoreArchivedWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreArchivedWorkflowExecution indicates an expected call of RestoreArchivedWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) RestoreArchivedWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArchivedWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).RestoreArchivedWorkflowExecution), varargs...)
+}
+
+// ResumeTaskQueueDispatch mocks base method.
+func (m *MockAdminServiceClient) ResumeTaskQueueDispatch(ctx context.Context, in *adminservice.ResumeTaskQueueDispatchRequest, opts ...grpc.CallOption) (*adminservice.ResumeTaskQueueDispatchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeTaskQueueDispatch", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResumeTaskQueueDispatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeTaskQueueDispatch indicates an expected call of ResumeTaskQueueDispatch.
+func (mr *MockAdminServiceClientMockRecorder) ResumeTaskQueueDispatch(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskQueueDispatch", reflect.TypeOf((*MockAdminServiceClient)(nil).ResumeTaskQueueDispatch), varargs...)
+}
+
+// SaveVisibilityView mocks base method.
+func (m *MockAdminServiceClient) SaveVisibilityView(ctx context.Context, in *adminservice.SaveVisibilityViewRequest, opts ...grpc.CallOption) (*adminservice.SaveVisibilityViewResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveVisibilityView", varargs...)
+	ret0, _ := ret[0].(*adminservice.SaveVisibilityViewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveVisibilityView indicates an expected call of SaveVisibilityView.
+func (mr *MockAdminServiceClientMockRecorder) SaveVisibilityView(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveVisibilityView", reflect.TypeOf((*MockAdminServiceClient)(nil).SaveVisibilityView), varargs...)
+}
+
+// SetDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceClient) SetDynamicConfigOverride(ctx context.Context, in *adminservice.SetDynamicConfigOverrideRequest, opts ...grpc.CallOption) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDynamicConfigOverride", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfigOverride indicates an expected call of SetDynamicConfigOverride.
+func (mr *MockAdminServiceClientMockRecorder) SetDynamicConfigOverride(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceClient)(nil).SetDynamicConfigOverride), varargs...)
+}
+
 // StartAdminBatchOperation mocks base method.
 func (m *MockAdminServiceClient) StartAdminBatchOperation(ctx context.Context, in *adminservice.StartAdminBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartAdminBatchOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -923,6 +1283,46 @@ func (mr *MockAdminServiceClientMockRecorder) StartAdminBatchOperation(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAdminBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartAdminBatchOperation), varargs...)
 }
 
+// StartVisibilityDriftCheck mocks base method.
+func (m *MockAdminServiceClient) StartVisibilityDriftCheck(ctx context.Context, in *adminservice.StartVisibilityDriftCheckRequest, opts ...grpc.CallOption) (*adminservice.StartVisibilityDriftCheckResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartVisibilityDriftCheck", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityDriftCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityDriftCheck indicates an expected call of StartVisibilityDriftCheck.
+func (mr *MockAdminServiceClientMockRecorder) StartVisibilityDriftCheck(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityDriftCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).StartVisibilityDriftCheck), varargs...)
+}
+
+// StartVisibilityRebuild mocks base method.
+func (m *MockAdminServiceClient) StartVisibilityRebuild(ctx context.Context, in *adminservice.StartVisibilityRebuildRequest, opts ...grpc.CallOption) (*adminservice.StartVisibilityRebuildResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartVisibilityRebuild", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityRebuildResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityRebuild indicates an expected call of StartVisibilityRebuild.
+func (mr *MockAdminServiceClientMockRecorder) StartVisibilityRebuild(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityRebuild", reflect.TypeOf((*MockAdminServiceClient)(nil).StartVisibilityRebuild), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -963,6 +1363,26 @@ func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
+// WatchWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) WatchWorkflowExecutions(ctx context.Context, in *adminservice.WatchWorkflowExecutionsRequest, opts ...grpc.CallOption) (adminservice.AdminService_WatchWorkflowExecutionsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_WatchWorkflowExecutionsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchWorkflowExecutions indicates an expected call of WatchWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) WatchWorkflowExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).WatchWorkflowExecutions), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1101,6 +1521,130 @@ func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Trailer))
 }
 
+// MockAdminService_WatchWorkflowExecutionsClient is a mock of AdminService_WatchWorkflowExecutionsClient interface.
+type MockAdminService_WatchWorkflowExecutionsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_WatchWorkflowExecutionsClientMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminService_WatchWorkflowExecutionsClientMockRecorder is the mock recorder for MockAdminService_WatchWorkflowExecutionsClient.
+type MockAdminService_WatchWorkflowExecutionsClientMockRecorder struct {
+	mock *MockAdminService_WatchWorkflowExecutionsClient
+}
+
+// NewMockAdminService_WatchWorkflowExecutionsClient creates a new mock instance.
+func NewMockAdminService_WatchWorkflowExecutionsClient(ctrl *gomock.Controller) *MockAdminService_WatchWorkflowExecutionsClient {
+	mock := &MockAdminService_WatchWorkflowExecutionsClient{ctrl: ctrl}
+	mock.recorder = &MockAdminService_WatchWorkflowExecutionsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_WatchWorkflowExecutionsClient) EXPECT() *MockAdminService_WatchWorkflowExecutionsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockAdminService_WatchWorkflowExecutionsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAdminService_WatchWorkflowExecutionsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockAdminService_WatchWorkflowExecutionsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_WatchWorkflowExecutionsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockAdminService_WatchWorkflowExecutionsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAdminService_WatchWorkflowExecutionsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_WatchWorkflowExecutionsClient) Recv() (*adminservice.WatchWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.WatchWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_WatchWorkflowExecutionsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_WatchWorkflowExecutionsClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_WatchWorkflowExecutionsClientMockRecorder) RecvMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_WatchWorkflowExecutionsClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_WatchWorkflowExecutionsClientMockRecorder) SendMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockAdminService_WatchWorkflowExecutionsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAdminService_WatchWorkflowExecutionsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsClient)(nil).Trailer))
+}
+
 // MockAdminServiceServer is a mock of AdminServiceServer interface.
 type MockAdminServiceServer struct {
 	ctrl     *gomock.Controller
@@ -1185,6 +1729,36 @@ func (mr *MockAdminServiceServerMockRecorder) CancelDLQJob(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelDLQJob), arg0, arg1)
 }
 
+// CancelVisibilityDriftCheck mocks base method.
+func (m *MockAdminServiceServer) CancelVisibilityDriftCheck(arg0 context.Context, arg1 *adminservice.CancelVisibilityDriftCheckRequest) (*adminservice.CancelVisibilityDriftCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelVisibilityDriftCheck", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CancelVisibilityDriftCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelVisibilityDriftCheck indicates an expected call of CancelVisibilityDriftCheck.
+func (mr *MockAdminServiceServerMockRecorder) CancelVisibilityDriftCheck(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVisibilityDriftCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelVisibilityDriftCheck), arg0, arg1)
+}
+
+// CancelVisibilityRebuild mocks base method.
+func (m *MockAdminServiceServer) CancelVisibilityRebuild(arg0 context.Context, arg1 *adminservice.CancelVisibilityRebuildRequest) (*adminservice.CancelVisibilityRebuildResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelVisibilityRebuild", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CancelVisibilityRebuildResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelVisibilityRebuild indicates an expected call of CancelVisibilityRebuild.
+func (mr *MockAdminServiceServerMockRecorder) CancelVisibilityRebuild(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVisibilityRebuild", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelVisibilityRebuild), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -1215,6 +1789,36 @@ func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
 }
 
+// DeleteDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceServer) DeleteDynamicConfigOverride(arg0 context.Context, arg1 *adminservice.DeleteDynamicConfigOverrideRequest) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDynamicConfigOverride", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDynamicConfigOverride indicates an expected call of DeleteDynamicConfigOverride.
+func (mr *MockAdminServiceServerMockRecorder) DeleteDynamicConfigOverride(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteDynamicConfigOverride), arg0, arg1)
+}
+
+// DeleteVisibilityView mocks base method.
+func (m *MockAdminServiceServer) DeleteVisibilityView(arg0 context.Context, arg1 *adminservice.DeleteVisibilityViewRequest) (*adminservice.DeleteVisibilityViewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVisibilityView", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteVisibilityViewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVisibilityView indicates an expected call of DeleteVisibilityView.
+func (mr *MockAdminServiceServerMockRecorder) DeleteVisibilityView(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVisibilityView", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteVisibilityView), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1305,6 +1909,66 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
 }
 
+// DescribeVisibilityDriftCheck mocks base method.
+func (m *MockAdminServiceServer) DescribeVisibilityDriftCheck(arg0 context.Context, arg1 *adminservice.DescribeVisibilityDriftCheckRequest) (*adminservice.DescribeVisibilityDriftCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVisibilityDriftCheck", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityDriftCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityDriftCheck indicates an expected call of DescribeVisibilityDriftCheck.
+func (mr *MockAdminServiceServerMockRecorder) DescribeVisibilityDriftCheck(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityDriftCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeVisibilityDriftCheck), arg0, arg1)
+}
+
+// DescribeVisibilityRebuild mocks base method.
+func (m *MockAdminServiceServer) DescribeVisibilityRebuild(arg0 context.Context, arg1 *adminservice.DescribeVisibilityRebuildRequest) (*adminservice.DescribeVisibilityRebuildResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVisibilityRebuild", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityRebuildResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityRebuild indicates an expected call of DescribeVisibilityRebuild.
+func (mr *MockAdminServiceServerMockRecorder) DescribeVisibilityRebuild(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityRebuild", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeVisibilityRebuild), arg0, arg1)
+}
+
+// DescribeVisibilityView mocks base method.
+func (m *MockAdminServiceServer) DescribeVisibilityView(arg0 context.Context, arg1 *adminservice.DescribeVisibilityViewRequest) (*adminservice.DescribeVisibilityViewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVisibilityView", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityViewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityView indicates an expected call of DescribeVisibilityView.
+func (mr *MockAdminServiceServerMockRecorder) DescribeVisibilityView(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityView", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeVisibilityView), arg0, arg1)
+}
+
+// ExplainDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) ExplainDynamicConfig(arg0 context.Context, arg1 *adminservice.ExplainDynamicConfigRequest) (*adminservice.ExplainDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExplainDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainDynamicConfig indicates an expected call of ExplainDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) ExplainDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).ExplainDynamicConfig), arg0, arg1)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) ForceUnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1380,6 +2044,36 @@ func (mr *MockAdminServiceServerMockRecorder) GetDLQTasks(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQTasks), arg0, arg1)
 }
 
+// GetDynamicConfigHistory mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfigHistory(arg0 context.Context, arg1 *adminservice.GetDynamicConfigHistoryRequest) (*adminservice.GetDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfigHistory", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfigHistory indicates an expected call of GetDynamicConfigHistory.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfigHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfigHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfigHistory), arg0, arg1)
+}
+
+// GetDynamicConfigOverrides mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfigOverrides(arg0 context.Context, arg1 *adminservice.GetDynamicConfigOverridesRequest) (*adminservice.GetDynamicConfigOverridesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfigOverrides", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigOverridesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfigOverrides indicates an expected call of GetDynamicConfigOverrides.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfigOverrides(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfigOverrides", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfigOverrides), arg0, arg1)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceServer) GetNamespace(arg0 context.Context, arg1 *adminservice.GetNamespaceRequest) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -1590,6 +2284,36 @@ func (mr *MockAdminServiceServerMockRecorder) ListQueues(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueues), arg0, arg1)
 }
 
+// ListTaskQueueDLQTasks mocks base method.
+func (m *MockAdminServiceServer) ListTaskQueueDLQTasks(arg0 context.Context, arg1 *adminservice.ListTaskQueueDLQTasksRequest) (*adminservice.ListTaskQueueDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskQueueDLQTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueueDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueDLQTasks indicates an expected call of ListTaskQueueDLQTasks.
+func (mr *MockAdminServiceServerMockRecorder) ListTaskQueueDLQTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListTaskQueueDLQTasks), arg0, arg1)
+}
+
+// ListVisibilityViews mocks base method.
+func (m *MockAdminServiceServer) ListVisibilityViews(arg0 context.Context, arg1 *adminservice.ListVisibilityViewsRequest) (*adminservice.ListVisibilityViewsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVisibilityViews", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListVisibilityViewsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVisibilityViews indicates an expected call of ListVisibilityViews.
+func (mr *MockAdminServiceServerMockRecorder) ListVisibilityViews(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVisibilityViews", reflect.TypeOf((*MockAdminServiceServer)(nil).ListVisibilityViews), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1635,6 +2359,21 @@ func (mr *MockAdminServiceServerMockRecorder) MigrateSchedule(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).MigrateSchedule), arg0, arg1)
 }
 
+// PauseTaskQueueDispatch mocks base method.
+func (m *MockAdminServiceServer) PauseTaskQueueDispatch(arg0 context.Context, arg1 *adminservice.PauseTaskQueueDispatchRequest) (*adminservice.PauseTaskQueueDispatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseTaskQueueDispatch", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseTaskQueueDispatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseTaskQueueDispatch indicates an expected call of PauseTaskQueueDispatch.
+func (mr *MockAdminServiceServerMockRecorder) PauseTaskQueueDispatch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskQueueDispatch", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseTaskQueueDispatch), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1695,6 +2434,21 @@ func (mr *MockAdminServiceServerMockRecorder) RebuildMutableState(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).RebuildMutableState), arg0, arg1)
 }
 
+// ReenqueueTaskQueueDLQTasks mocks base method.
+func (m *MockAdminServiceServer) ReenqueueTaskQueueDLQTasks(arg0 context.Context, arg1 *adminservice.ReenqueueTaskQueueDLQTasksRequest) (*adminservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReenqueueTaskQueueDLQTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ReenqueueTaskQueueDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReenqueueTaskQueueDLQTasks indicates an expected call of ReenqueueTaskQueueDLQTasks.
+func (mr *MockAdminServiceServerMockRecorder) ReenqueueTaskQueueDLQTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueTaskQueueDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ReenqueueTaskQueueDLQTasks), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *adminservice.RefreshWorkflowTasksRequest) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1770,6 +2524,66 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// RestoreArchivedWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) RestoreArchivedWorkflowExecution(arg0 context.Context, arg1 *adminservice.RestoreArchivedWorkflowExecutionRequest) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RestoreArchivedWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreArchivedWorkflowExecution indicates an expected call of RestoreArchivedWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) RestoreArchivedWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArchivedWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).RestoreArchivedWorkflowExecution), arg0, arg1)
+}
+
+// ResumeTaskQueueDispatch mocks base method.
+func (m *MockAdminServiceServer) ResumeTaskQueueDispatch(arg0 context.Context, arg1 *adminservice.ResumeTaskQueueDispatchRequest) (*adminservice.ResumeTaskQueueDispatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTaskQueueDispatch", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResumeTaskQueueDispatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeTaskQueueDispatch indicates an expected call of ResumeTaskQueueDispatch.
+func (mr *MockAdminServiceServerMockRecorder) ResumeTaskQueueDispatch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskQueueDispatch", reflect.TypeOf((*MockAdminServiceServer)(nil).ResumeTaskQueueDispatch), arg0, arg1)
+}
+
+// SaveVisibilityView mocks base method.
+func (m *MockAdminServiceServer) SaveVisibilityView(arg0 context.Context, arg1 *adminservice.SaveVisibilityViewRequest) (*adminservice.SaveVisibilityViewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveVisibilityView", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SaveVisibilityViewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveVisibilityView indicates an expected call of SaveVisibilityView.
+func (mr *MockAdminServiceServerMockRecorder) SaveVisibilityView(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveVisibilityView", reflect.TypeOf((*MockAdminServiceServer)(nil).SaveVisibilityView), arg0, arg1)
+}
+
+// SetDynamicConfigOverride mocks base method.
+func (m *MockAdminServiceServer) SetDynamicConfigOverride(arg0 context.Context, arg1 *adminservice.SetDynamicConfigOverrideRequest) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDynamicConfigOverride", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigOverrideResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfigOverride indicates an expected call of SetDynamicConfigOverride.
+func (mr *MockAdminServiceServerMockRecorder) SetDynamicConfigOverride(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfigOverride", reflect.TypeOf((*MockAdminServiceServer)(nil).SetDynamicConfigOverride), arg0, arg1)
+}
+
 // StartAdminBatchOperation mocks base method.
 func (m *MockAdminServiceServer) StartAdminBatchOperation(arg0 context.Context, arg1 *adminservice.StartAdminBatchOperationRequest) (*adminservice.StartAdminBatchOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1785,6 +2599,36 @@ func (mr *MockAdminServiceServerMockRecorder) StartAdminBatchOperation(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAdminBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartAdminBatchOperation), arg0, arg1)
 }
 
+// StartVisibilityDriftCheck mocks base method.
+func (m *MockAdminServiceServer) StartVisibilityDriftCheck(arg0 context.Context, arg1 *adminservice.StartVisibilityDriftCheckRequest) (*adminservice.StartVisibilityDriftCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVisibilityDriftCheck", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityDriftCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityDriftCheck indicates an expected call of StartVisibilityDriftCheck.
+func (mr *MockAdminServiceServerMockRecorder) StartVisibilityDriftCheck(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityDriftCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).StartVisibilityDriftCheck), arg0, arg1)
+}
+
+// StartVisibilityRebuild mocks base method.
+func (m *MockAdminServiceServer) StartVisibilityRebuild(arg0 context.Context, arg1 *adminservice.StartVisibilityRebuildRequest) (*adminservice.StartVisibilityRebuildResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVisibilityRebuild", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityRebuildResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityRebuild indicates an expected call of StartVisibilityRebuild.
+func (mr *MockAdminServiceServerMockRecorder) StartVisibilityRebuild(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityRebuild", reflect.TypeOf((*MockAdminServiceServer)(nil).StartVisibilityRebuild), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1814,6 +2658,20 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// WatchWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) WatchWorkflowExecutions(arg0 *adminservice.WatchWorkflowExecutionsRequest, arg1 adminservice.AdminService_WatchWorkflowExecutionsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchWorkflowExecutions indicates an expected call of WatchWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) WatchWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).WatchWorkflowExecutions), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
@@ -1996,3 +2854,123 @@ func (mr *MockAdminService_StreamWorkflowReplicationMessagesServerMockRecorder)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesServer)(nil).SetTrailer), arg0)
 }
+
+// MockAdminService_WatchWorkflowExecutionsServer is a mock of AdminService_WatchWorkflowExecutionsServer interface.
+type MockAdminService_WatchWorkflowExecutionsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_WatchWorkflowExecutionsServerMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminService_WatchWorkflowExecutionsServerMockRecorder is the mock recorder for MockAdminService_WatchWorkflowExecutionsServer.
+type MockAdminService_WatchWorkflowExecutionsServerMockRecorder struct {
+	mock *MockAdminService_WatchWorkflowExecutionsServer
+}
+
+// NewMockAdminService_WatchWorkflowExecutionsServer creates a new mock instance.
+func NewMockAdminService_WatchWorkflowExecutionsServer(ctrl *gomock.Controller) *MockAdminService_WatchWorkflowExecutionsServer {
+	mock := &MockAdminService_WatchWorkflowExecutionsServer{ctrl: ctrl}
+	mock.recorder = &MockAdminService_WatchWorkflowExecutionsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_WatchWorkflowExecutionsServer) EXPECT() *MockAdminService_WatchWorkflowExecutionsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockAdminService_WatchWorkflowExecutionsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_WatchWorkflowExecutionsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_WatchWorkflowExecutionsServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_WatchWorkflowExecutionsServerMockRecorder) RecvMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_WatchWorkflowExecutionsServer) Send(arg0 *adminservice.WatchWorkflowExecutionsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_WatchWorkflowExecutionsServerMockRecorder) Send(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockAdminService_WatchWorkflowExecutionsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockAdminService_WatchWorkflowExecutionsServerMockRecorder) SendHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_WatchWorkflowExecutionsServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_WatchWorkflowExecutionsServerMockRecorder) SendMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockAdminService_WatchWorkflowExecutionsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockAdminService_WatchWorkflowExecutionsServerMockRecorder) SetHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockAdminService_WatchWorkflowExecutionsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockAdminService_WatchWorkflowExecutionsServerMockRecorder) SetTrailer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_WatchWorkflowExecutionsServer)(nil).SetTrailer), arg0)
+}
@@ -12,6 +12,7 @@ import (
 	unsafe "unsafe"
 
 	v1 "go.temporal.io/api/common/v1"
+	v11 "go.temporal.io/server/api/persistence/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 )
@@ -244,11 +245,66 @@ func (x *HistoryDLQKey) GetTargetCluster() string {
 	return ""
 }
 
+// MatchingDLQTask is a matching backlog task that has been moved to the DLQ of its task queue, so it also has a message
+// ID (index within that queue).
+type MatchingDLQTask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// message_id is the zero-indexed sequence number of the message in the DLQ of the task queue.
+	MessageId     int64                `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Payload       *v11.MatchingDLQTask `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchingDLQTask) Reset() {
+	*x = MatchingDLQTask{}
+	mi := &file_temporal_server_api_common_v1_dlq_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchingDLQTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchingDLQTask) ProtoMessage() {}
+
+func (x *MatchingDLQTask) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_common_v1_dlq_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchingDLQTask.ProtoReflect.Descriptor instead.
+func (*MatchingDLQTask) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_common_v1_dlq_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MatchingDLQTask) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *MatchingDLQTask) GetPayload() *v11.MatchingDLQTask {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
 var File_temporal_server_api_common_v1_dlq_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_common_v1_dlq_proto_rawDesc = "" +
 	"\n" +
-	"'temporal/server/api/common/v1/dlq.proto\x12\x1dtemporal.server.api.common.v1\x1a$temporal/api/common/v1/message.proto\"^\n" +
+	"'temporal/server/api/common/v1/dlq.proto\x12\x1dtemporal.server.api.common.v1\x1a$temporal/api/common/v1/message.proto\x1a/temporal/server/api/persistence/v1/queues.proto\"^\n" +
 	"\vHistoryTask\x12\x19\n" +
 	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x124\n" +
 	"\x04blob\x18\x02 \x01(\v2 .temporal.api.common.v1.DataBlobR\x04blob\"7\n" +
@@ -261,7 +317,11 @@ const file_temporal_server_api_common_v1_dlq_proto_rawDesc = "" +
 	"\rHistoryDLQKey\x12#\n" +
 	"\rtask_category\x18\x01 \x01(\x05R\ftaskCategory\x12%\n" +
 	"\x0esource_cluster\x18\x02 \x01(\tR\rsourceCluster\x12%\n" +
-	"\x0etarget_cluster\x18\x03 \x01(\tR\rtargetClusterB/Z-go.temporal.io/server/api/common/v1;commonspbb\x06proto3"
+	"\x0etarget_cluster\x18\x03 \x01(\tR\rtargetCluster\"\x7f\n" +
+	"\x0fMatchingDLQTask\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12M\n" +
+	"\apayload\x18\x02 \x01(\v23.temporal.server.api.persistence.v1.MatchingDLQTaskR\apayloadB/Z-go.temporal.io/server/api/common/v1;commonspbb\x06proto3"
 
 var (
 	file_temporal_server_api_common_v1_dlq_proto_rawDescOnce sync.Once
@@ -275,23 +335,26 @@ func file_temporal_server_api_common_v1_dlq_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_common_v1_dlq_proto_rawDescData
 }
 
-var file_temporal_server_api_common_v1_dlq_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_temporal_server_api_common_v1_dlq_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_temporal_server_api_common_v1_dlq_proto_goTypes = []any{
 	(*HistoryTask)(nil),            // 0: temporal.server.api.common.v1.HistoryTask
 	(*HistoryDLQTaskMetadata)(nil), // 1: temporal.server.api.common.v1.HistoryDLQTaskMetadata
 	(*HistoryDLQTask)(nil),         // 2: temporal.server.api.common.v1.HistoryDLQTask
 	(*HistoryDLQKey)(nil),          // 3: temporal.server.api.common.v1.HistoryDLQKey
-	(*v1.DataBlob)(nil),            // 4: temporal.api.common.v1.DataBlob
+	(*MatchingDLQTask)(nil),        // 4: temporal.server.api.common.v1.MatchingDLQTask
+	(*v1.DataBlob)(nil),            // 5: temporal.api.common.v1.DataBlob
+	(*v11.MatchingDLQTask)(nil),    // 6: temporal.server.api.persistence.v1.MatchingDLQTask
 }
 var file_temporal_server_api_common_v1_dlq_proto_depIdxs = []int32{
-	4, // 0: temporal.server.api.common.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
+	5, // 0: temporal.server.api.common.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
 	1, // 1: temporal.server.api.common.v1.HistoryDLQTask.metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
 	0, // 2: temporal.server.api.common.v1.HistoryDLQTask.payload:type_name -> temporal.server.api.common.v1.HistoryTask
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	6, // 3: temporal.server.api.common.v1.MatchingDLQTask.payload:type_name -> temporal.server.api.persistence.v1.MatchingDLQTask
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_common_v1_dlq_proto_init() }
@@ -305,7 +368,7 @@ func file_temporal_server_api_common_v1_dlq_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_common_v1_dlq_proto_rawDesc), len(file_temporal_server_api_common_v1_dlq_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package dynamicconfig
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal an object of type Constraints to the protobuf v3 wire format
+func (val *Constraints) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type Constraints from the protobuf v3 wire format
+func (val *Constraints) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *Constraints) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two Constraints values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *Constraints) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *Constraints
+	switch t := that.(type) {
+	case *Constraints:
+		that1 = t
+	case Constraints:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ConstrainedValue to the protobuf v3 wire format
+func (val *ConstrainedValue) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ConstrainedValue from the protobuf v3 wire format
+func (val *ConstrainedValue) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ConstrainedValue) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ConstrainedValue values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ConstrainedValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ConstrainedValue
+	switch t := that.(type) {
+	case *ConstrainedValue:
+		that1 = t
+	case ConstrainedValue:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GradualChange to the protobuf v3 wire format
+func (val *GradualChange) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GradualChange from the protobuf v3 wire format
+func (val *GradualChange) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GradualChange) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GradualChange values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GradualChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GradualChange
+	switch t := that.(type) {
+	case *GradualChange:
+		that1 = t
+	case GradualChange:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type Explanation to the protobuf v3 wire format
+func (val *Explanation) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type Explanation from the protobuf v3 wire format
+func (val *Explanation) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *Explanation) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two Explanation values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *Explanation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *Explanation
+	switch t := that.(type) {
+	case *Explanation:
+		that1 = t
+	case Explanation:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HostExplanation to the protobuf v3 wire format
+func (val *HostExplanation) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HostExplanation from the protobuf v3 wire format
+func (val *HostExplanation) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HostExplanation) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HostExplanation values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HostExplanation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HostExplanation
+	switch t := that.(type) {
+	case *HostExplanation:
+		that1 = t
+	case HostExplanation:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -0,0 +1,560 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/dynamicconfig/v1/message.proto
+
+package dynamicconfig
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "go.temporal.io/api/enums/v1"
+	v11 "go.temporal.io/server/api/enums/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Constraints that select a dynamic config value. See dynamicconfig.Constraints for how they
+// are matched. Only the fields used by the precedence of a setting are considered.
+type Constraints struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NamespaceId   string                 `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueueName string                 `protobuf:"bytes,3,opt,name=task_queue_name,json=taskQueueName,proto3" json:"task_queue_name,omitempty"`
+	TaskQueueType v1.TaskQueueType       `protobuf:"varint,4,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	Destination   string                 `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+	ChasmTaskType string                 `protobuf:"bytes,6,opt,name=chasm_task_type,json=chasmTaskType,proto3" json:"chasm_task_type,omitempty"`
+	ShardId       int32                  `protobuf:"varint,7,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	TaskType      v11.TaskType           `protobuf:"varint,8,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Constraints) Reset() {
+	*x = Constraints{}
+	mi := &file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Constraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Constraints) ProtoMessage() {}
+
+func (x *Constraints) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Constraints.ProtoReflect.Descriptor instead.
+func (*Constraints) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_dynamicconfig_v1_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Constraints) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Constraints) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *Constraints) GetTaskQueueName() string {
+	if x != nil {
+		return x.TaskQueueName
+	}
+	return ""
+}
+
+func (x *Constraints) GetTaskQueueType() v1.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v1.TaskQueueType(0)
+}
+
+func (x *Constraints) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Constraints) GetChasmTaskType() string {
+	if x != nil {
+		return x.ChasmTaskType
+	}
+	return ""
+}
+
+func (x *Constraints) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *Constraints) GetTaskType() v11.TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return v11.TaskType(0)
+}
+
+// A value configured for a key.
+type ConstrainedValue struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Constraints *Constraints           `protobuf:"bytes,1,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// JSON-encoded value.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Where the value came from (e.g. the dynamic config file, or a persisted override), if
+	// known.
+	Source        string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConstrainedValue) Reset() {
+	*x = ConstrainedValue{}
+	mi := &file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConstrainedValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConstrainedValue) ProtoMessage() {}
+
+func (x *ConstrainedValue) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConstrainedValue.ProtoReflect.Descriptor instead.
+func (*ConstrainedValue) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_dynamicconfig_v1_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConstrainedValue) GetConstraints() *Constraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *ConstrainedValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ConstrainedValue) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// State of a setting whose value is a gradual change (see dynamicconfig.GradualChange).
+type GradualChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JSON-encoded old and new values.
+	OldValue  string                 `protobuf:"bytes,1,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue  string                 `protobuf:"bytes,2,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Only set if a rollout key was given: when the value for that key switches from old to new.
+	RolloutKeyChangeTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=rollout_key_change_time,json=rolloutKeyChangeTime,proto3" json:"rollout_key_change_time,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GradualChange) Reset() {
+	*x = GradualChange{}
+	mi := &file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradualChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradualChange) ProtoMessage() {}
+
+func (x *GradualChange) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradualChange.ProtoReflect.Descriptor instead.
+func (*GradualChange) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_dynamicconfig_v1_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GradualChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *GradualChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *GradualChange) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GradualChange) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GradualChange) GetRolloutKeyChangeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RolloutKeyChangeTime
+	}
+	return nil
+}
+
+// Explanation of how a host resolves a dynamic config setting for a set of constraints.
+type Explanation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Constraints that were searched for a value, in order.
+	Precedence []*Constraints `protobuf:"bytes,1,rep,name=precedence,proto3" json:"precedence,omitempty"`
+	// All values configured for the key, matching or not.
+	Values []*ConstrainedValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// The configured value in effect. Not set if the default is used.
+	MatchedValue *ConstrainedValue `protobuf:"bytes,3,opt,name=matched_value,json=matchedValue,proto3" json:"matched_value,omitempty"`
+	// JSON-encoded effective value and default value for the constraints.
+	EffectiveValue string `protobuf:"bytes,4,opt,name=effective_value,json=effectiveValue,proto3" json:"effective_value,omitempty"`
+	DefaultValue   string `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// Set if the matching value couldn't be converted to the type of the setting, in which case
+	// the default is used.
+	ConversionError string `protobuf:"bytes,6,opt,name=conversion_error,json=conversionError,proto3" json:"conversion_error,omitempty"`
+	// Set if the effective value is a gradual change.
+	GradualChange *GradualChange `protobuf:"bytes,7,opt,name=gradual_change,json=gradualChange,proto3" json:"gradual_change,omitempty"`
+	// Only set if a rollout key was given: the JSON-encoded value for that key right now. For a
+	// gradual change, this is the old or new value. For an integer setting, this is whether the key
+	// is accepted when the value is used as a rollout percentage (see dynamicconfig.RolloutAccepts).
+	RolloutKeyValue string `protobuf:"bytes,8,opt,name=rollout_key_value,json=rolloutKeyValue,proto3" json:"rollout_key_value,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Explanation) Reset() {
+	*x = Explanation{}
+	mi := &file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Explanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Explanation) ProtoMessage() {}
+
+func (x *Explanation) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Explanation.ProtoReflect.Descriptor instead.
+func (*Explanation) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_dynamicconfig_v1_message_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Explanation) GetPrecedence() []*Constraints {
+	if x != nil {
+		return x.Precedence
+	}
+	return nil
+}
+
+func (x *Explanation) GetValues() []*ConstrainedValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Explanation) GetMatchedValue() *ConstrainedValue {
+	if x != nil {
+		return x.MatchedValue
+	}
+	return nil
+}
+
+func (x *Explanation) GetEffectiveValue() string {
+	if x != nil {
+		return x.EffectiveValue
+	}
+	return ""
+}
+
+func (x *Explanation) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *Explanation) GetConversionError() string {
+	if x != nil {
+		return x.ConversionError
+	}
+	return ""
+}
+
+func (x *Explanation) GetGradualChange() *GradualChange {
+	if x != nil {
+		return x.GradualChange
+	}
+	return nil
+}
+
+func (x *Explanation) GetRolloutKeyValue() string {
+	if x != nil {
+		return x.RolloutKeyValue
+	}
+	return ""
+}
+
+// Explanation of a dynamic config setting on one host.
+type HostExplanation struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Service     string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Address     string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Explanation *Explanation           `protobuf:"bytes,3,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// Set if the host couldn't be reached.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostExplanation) Reset() {
+	*x = HostExplanation{}
+	mi := &file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostExplanation) ProtoMessage() {}
+
+func (x *HostExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostExplanation.ProtoReflect.Descriptor instead.
+func (*HostExplanation) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_dynamicconfig_v1_message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HostExplanation) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *HostExplanation) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *HostExplanation) GetExplanation() *Explanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+func (x *HostExplanation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_temporal_server_api_dynamicconfig_v1_message_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_dynamicconfig_v1_message_proto_rawDesc = "" +
+	"\n" +
+	"2temporal/server/api/dynamicconfig/v1/message.proto\x12$temporal.server.api.dynamicconfig.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a'temporal/server/api/enums/v1/task.proto\"\xee\x02\n" +
+	"\vConstraints\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\fnamespace_id\x18\x02 \x01(\tR\vnamespaceId\x12&\n" +
+	"\x0ftask_queue_name\x18\x03 \x01(\tR\rtaskQueueName\x12L\n" +
+	"\x0ftask_queue_type\x18\x04 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12 \n" +
+	"\vdestination\x18\x05 \x01(\tR\vdestination\x12&\n" +
+	"\x0fchasm_task_type\x18\x06 \x01(\tR\rchasmTaskType\x12\x19\n" +
+	"\bshard_id\x18\a \x01(\x05R\ashardId\x12C\n" +
+	"\ttask_type\x18\b \x01(\x0e2&.temporal.server.api.enums.v1.TaskTypeR\btaskType\"\x95\x01\n" +
+	"\x10ConstrainedValue\x12S\n" +
+	"\vconstraints\x18\x01 \x01(\v21.temporal.server.api.dynamicconfig.v1.ConstraintsR\vconstraints\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\"\x8e\x02\n" +
+	"\rGradualChange\x12\x1b\n" +
+	"\told_value\x18\x01 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x02 \x01(\tR\bnewValue\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12Q\n" +
+	"\x17rollout_key_change_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x14rolloutKeyChangeTime\"\x8e\x04\n" +
+	"\vExplanation\x12Q\n" +
+	"\n" +
+	"precedence\x18\x01 \x03(\v21.temporal.server.api.dynamicconfig.v1.ConstraintsR\n" +
+	"precedence\x12N\n" +
+	"\x06values\x18\x02 \x03(\v26.temporal.server.api.dynamicconfig.v1.ConstrainedValueR\x06values\x12[\n" +
+	"\rmatched_value\x18\x03 \x01(\v26.temporal.server.api.dynamicconfig.v1.ConstrainedValueR\fmatchedValue\x12'\n" +
+	"\x0feffective_value\x18\x04 \x01(\tR\x0eeffectiveValue\x12#\n" +
+	"\rdefault_value\x18\x05 \x01(\tR\fdefaultValue\x12)\n" +
+	"\x10conversion_error\x18\x06 \x01(\tR\x0fconversionError\x12Z\n" +
+	"\x0egradual_change\x18\a \x01(\v23.temporal.server.api.dynamicconfig.v1.GradualChangeR\rgradualChange\x12*\n" +
+	"\x11rollout_key_value\x18\b \x01(\tR\x0frolloutKeyValue\"\xb0\x01\n" +
+	"\x0fHostExplanation\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12S\n" +
+	"\vexplanation\x18\x03 \x01(\v21.temporal.server.api.dynamicconfig.v1.ExplanationR\vexplanation\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05errorB:Z8go.temporal.io/server/api/dynamicconfig/v1;dynamicconfigb\x06proto3"
+
+var (
+	file_temporal_server_api_dynamicconfig_v1_message_proto_rawDescOnce sync.Once
+	file_temporal_server_api_dynamicconfig_v1_message_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_dynamicconfig_v1_message_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_dynamicconfig_v1_message_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_dynamicconfig_v1_message_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_dynamicconfig_v1_message_proto_rawDesc), len(file_temporal_server_api_dynamicconfig_v1_message_proto_rawDesc)))
+	})
+	return file_temporal_server_api_dynamicconfig_v1_message_proto_rawDescData
+}
+
+var file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_temporal_server_api_dynamicconfig_v1_message_proto_goTypes = []any{
+	(*Constraints)(nil),           // 0: temporal.server.api.dynamicconfig.v1.Constraints
+	(*ConstrainedValue)(nil),      // 1: temporal.server.api.dynamicconfig.v1.ConstrainedValue
+	(*GradualChange)(nil),         // 2: temporal.server.api.dynamicconfig.v1.GradualChange
+	(*Explanation)(nil),           // 3: temporal.server.api.dynamicconfig.v1.Explanation
+	(*HostExplanation)(nil),       // 4: temporal.server.api.dynamicconfig.v1.HostExplanation
+	(v1.TaskQueueType)(0),         // 5: temporal.api.enums.v1.TaskQueueType
+	(v11.TaskType)(0),             // 6: temporal.server.api.enums.v1.TaskType
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_temporal_server_api_dynamicconfig_v1_message_proto_depIdxs = []int32{
+	5,  // 0: temporal.server.api.dynamicconfig.v1.Constraints.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	6,  // 1: temporal.server.api.dynamicconfig.v1.Constraints.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	0,  // 2: temporal.server.api.dynamicconfig.v1.ConstrainedValue.constraints:type_name -> temporal.server.api.dynamicconfig.v1.Constraints
+	7,  // 3: temporal.server.api.dynamicconfig.v1.GradualChange.start_time:type_name -> google.protobuf.Timestamp
+	7,  // 4: temporal.server.api.dynamicconfig.v1.GradualChange.end_time:type_name -> google.protobuf.Timestamp
+	7,  // 5: temporal.server.api.dynamicconfig.v1.GradualChange.rollout_key_change_time:type_name -> google.protobuf.Timestamp
+	0,  // 6: temporal.server.api.dynamicconfig.v1.Explanation.precedence:type_name -> temporal.server.api.dynamicconfig.v1.Constraints
+	1,  // 7: temporal.server.api.dynamicconfig.v1.Explanation.values:type_name -> temporal.server.api.dynamicconfig.v1.ConstrainedValue
+	1,  // 8: temporal.server.api.dynamicconfig.v1.Explanation.matched_value:type_name -> temporal.server.api.dynamicconfig.v1.ConstrainedValue
+	2,  // 9: temporal.server.api.dynamicconfig.v1.Explanation.gradual_change:type_name -> temporal.server.api.dynamicconfig.v1.GradualChange
+	3,  // 10: temporal.server.api.dynamicconfig.v1.HostExplanation.explanation:type_name -> temporal.server.api.dynamicconfig.v1.Explanation
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_dynamicconfig_v1_message_proto_init() }
+func file_temporal_server_api_dynamicconfig_v1_message_proto_init() {
+	if File_temporal_server_api_dynamicconfig_v1_message_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_dynamicconfig_v1_message_proto_rawDesc), len(file_temporal_server_api_dynamicconfig_v1_message_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_dynamicconfig_v1_message_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_dynamicconfig_v1_message_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_dynamicconfig_v1_message_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_dynamicconfig_v1_message_proto = out.File
+	file_temporal_server_api_dynamicconfig_v1_message_proto_goTypes = nil
+	file_temporal_server_api_dynamicconfig_v1_message_proto_depIdxs = nil
+}
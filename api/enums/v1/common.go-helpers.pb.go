@@ -63,3 +63,43 @@ func CallbackStateFromString(s string) (CallbackState, error) {
 	}
 	return CallbackState(0), fmt.Errorf("%s is not a valid CallbackState", s)
 }
+
+var (
+	VisibilityStoreTarget_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Primary":     1,
+		"Secondary":   2,
+	}
+)
+
+// VisibilityStoreTargetFromString parses a VisibilityStoreTarget value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to VisibilityStoreTarget
+func VisibilityStoreTargetFromString(s string) (VisibilityStoreTarget, error) {
+	if v, ok := VisibilityStoreTarget_value[s]; ok {
+		return VisibilityStoreTarget(v), nil
+	} else if v, ok := VisibilityStoreTarget_shorthandValue[s]; ok {
+		return VisibilityStoreTarget(v), nil
+	}
+	return VisibilityStoreTarget(0), fmt.Errorf("%s is not a valid VisibilityStoreTarget", s)
+}
+
+var (
+	VisibilityWatchEventType_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Started":     1,
+		"Updated":     2,
+		"Closed":      3,
+		"Resync":      4,
+	}
+)
+
+// VisibilityWatchEventTypeFromString parses a VisibilityWatchEventType value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to VisibilityWatchEventType
+func VisibilityWatchEventTypeFromString(s string) (VisibilityWatchEventType, error) {
+	if v, ok := VisibilityWatchEventType_value[s]; ok {
+		return VisibilityWatchEventType(v), nil
+	} else if v, ok := VisibilityWatchEventType_shorthandValue[s]; ok {
+		return VisibilityWatchEventType(v), nil
+	}
+	return VisibilityWatchEventType(0), fmt.Errorf("%s is not a valid VisibilityWatchEventType", s)
+}
@@ -217,6 +217,142 @@ func (CallbackState) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{2}
 }
 
+// Visibility store that a visibility rebuild writes records to.
+type VisibilityStoreTarget int32
+
+const (
+	// Write to every configured store, like the visibility queue does.
+	VISIBILITY_STORE_TARGET_UNSPECIFIED VisibilityStoreTarget = 0
+	VISIBILITY_STORE_TARGET_PRIMARY     VisibilityStoreTarget = 1
+	VISIBILITY_STORE_TARGET_SECONDARY   VisibilityStoreTarget = 2
+)
+
+// Enum value maps for VisibilityStoreTarget.
+var (
+	VisibilityStoreTarget_name = map[int32]string{
+		0: "VISIBILITY_STORE_TARGET_UNSPECIFIED",
+		1: "VISIBILITY_STORE_TARGET_PRIMARY",
+		2: "VISIBILITY_STORE_TARGET_SECONDARY",
+	}
+	VisibilityStoreTarget_value = map[string]int32{
+		"VISIBILITY_STORE_TARGET_UNSPECIFIED": 0,
+		"VISIBILITY_STORE_TARGET_PRIMARY":     1,
+		"VISIBILITY_STORE_TARGET_SECONDARY":   2,
+	}
+)
+
+func (x VisibilityStoreTarget) Enum() *VisibilityStoreTarget {
+	p := new(VisibilityStoreTarget)
+	*p = x
+	return p
+}
+
+func (x VisibilityStoreTarget) String() string {
+	switch x {
+	case VISIBILITY_STORE_TARGET_UNSPECIFIED:
+		return "Unspecified"
+	case VISIBILITY_STORE_TARGET_PRIMARY:
+		return "Primary"
+	case VISIBILITY_STORE_TARGET_SECONDARY:
+		return "Secondary"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (VisibilityStoreTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_common_proto_enumTypes[3].Descriptor()
+}
+
+func (VisibilityStoreTarget) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_common_proto_enumTypes[3]
+}
+
+func (x VisibilityStoreTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VisibilityStoreTarget.Descriptor instead.
+func (VisibilityStoreTarget) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+// Change to a visibility record that is pushed to watchers of a visibility query.
+type VisibilityWatchEventType int32
+
+const (
+	VISIBILITY_WATCH_EVENT_TYPE_UNSPECIFIED VisibilityWatchEventType = 0
+	// The execution was started.
+	VISIBILITY_WATCH_EVENT_TYPE_STARTED VisibilityWatchEventType = 1
+	// The search attributes or memo of a running execution were updated.
+	VISIBILITY_WATCH_EVENT_TYPE_UPDATED VisibilityWatchEventType = 2
+	// The execution was closed.
+	VISIBILITY_WATCH_EVENT_TYPE_CLOSED VisibilityWatchEventType = 3
+	// Changes were dropped because the watcher fell behind or a history host restarted. The watcher must list the
+	// executions again; changes after the resync are pushed as usual.
+	VISIBILITY_WATCH_EVENT_TYPE_RESYNC VisibilityWatchEventType = 4
+)
+
+// Enum value maps for VisibilityWatchEventType.
+var (
+	VisibilityWatchEventType_name = map[int32]string{
+		0: "VISIBILITY_WATCH_EVENT_TYPE_UNSPECIFIED",
+		1: "VISIBILITY_WATCH_EVENT_TYPE_STARTED",
+		2: "VISIBILITY_WATCH_EVENT_TYPE_UPDATED",
+		3: "VISIBILITY_WATCH_EVENT_TYPE_CLOSED",
+		4: "VISIBILITY_WATCH_EVENT_TYPE_RESYNC",
+	}
+	VisibilityWatchEventType_value = map[string]int32{
+		"VISIBILITY_WATCH_EVENT_TYPE_UNSPECIFIED": 0,
+		"VISIBILITY_WATCH_EVENT_TYPE_STARTED":     1,
+		"VISIBILITY_WATCH_EVENT_TYPE_UPDATED":     2,
+		"VISIBILITY_WATCH_EVENT_TYPE_CLOSED":      3,
+		"VISIBILITY_WATCH_EVENT_TYPE_RESYNC":      4,
+	}
+)
+
+func (x VisibilityWatchEventType) Enum() *VisibilityWatchEventType {
+	p := new(VisibilityWatchEventType)
+	*p = x
+	return p
+}
+
+func (x VisibilityWatchEventType) String() string {
+	switch x {
+	case VISIBILITY_WATCH_EVENT_TYPE_UNSPECIFIED:
+		return "Unspecified"
+	case VISIBILITY_WATCH_EVENT_TYPE_STARTED:
+		return "Started"
+	case VISIBILITY_WATCH_EVENT_TYPE_UPDATED:
+		return "Updated"
+	case VISIBILITY_WATCH_EVENT_TYPE_CLOSED:
+		return "Closed"
+	case VISIBILITY_WATCH_EVENT_TYPE_RESYNC:
+		return "Resync"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (VisibilityWatchEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_common_proto_enumTypes[4].Descriptor()
+}
+
+func (VisibilityWatchEventType) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_common_proto_enumTypes[4]
+}
+
+func (x VisibilityWatchEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VisibilityWatchEventType.Descriptor instead.
+func (VisibilityWatchEventType) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
 var File_temporal_server_api_enums_v1_common_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_enums_v1_common_proto_rawDesc = "" +
@@ -235,7 +371,17 @@ const file_temporal_server_api_enums_v1_common_proto_rawDesc = "" +
 	"\x18CALLBACK_STATE_SCHEDULED\x10\x02\x12\x1e\n" +
 	"\x1aCALLBACK_STATE_BACKING_OFF\x10\x03\x12\x19\n" +
 	"\x15CALLBACK_STATE_FAILED\x10\x04\x12\x1c\n" +
-	"\x18CALLBACK_STATE_SUCCEEDED\x10\x05B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
+	"\x18CALLBACK_STATE_SUCCEEDED\x10\x05*\x8c\x01\n" +
+	"\x15VisibilityStoreTarget\x12'\n" +
+	"#VISIBILITY_STORE_TARGET_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fVISIBILITY_STORE_TARGET_PRIMARY\x10\x01\x12%\n" +
+	"!VISIBILITY_STORE_TARGET_SECONDARY\x10\x02*\xe9\x01\n" +
+	"\x18VisibilityWatchEventType\x12+\n" +
+	"'VISIBILITY_WATCH_EVENT_TYPE_UNSPECIFIED\x10\x00\x12'\n" +
+	"#VISIBILITY_WATCH_EVENT_TYPE_STARTED\x10\x01\x12'\n" +
+	"#VISIBILITY_WATCH_EVENT_TYPE_UPDATED\x10\x02\x12&\n" +
+	"\"VISIBILITY_WATCH_EVENT_TYPE_CLOSED\x10\x03\x12&\n" +
+	"\"VISIBILITY_WATCH_EVENT_TYPE_RESYNC\x10\x04B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
 
 var (
 	file_temporal_server_api_enums_v1_common_proto_rawDescOnce sync.Once
@@ -249,11 +395,13 @@ func file_temporal_server_api_enums_v1_common_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_common_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_temporal_server_api_enums_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_temporal_server_api_enums_v1_common_proto_goTypes = []any{
-	(DeadLetterQueueType)(0), // 0: temporal.server.api.enums.v1.DeadLetterQueueType
-	(ChecksumFlavor)(0),      // 1: temporal.server.api.enums.v1.ChecksumFlavor
-	(CallbackState)(0),       // 2: temporal.server.api.enums.v1.CallbackState
+	(DeadLetterQueueType)(0),      // 0: temporal.server.api.enums.v1.DeadLetterQueueType
+	(ChecksumFlavor)(0),           // 1: temporal.server.api.enums.v1.ChecksumFlavor
+	(CallbackState)(0),            // 2: temporal.server.api.enums.v1.CallbackState
+	(VisibilityStoreTarget)(0),    // 3: temporal.server.api.enums.v1.VisibilityStoreTarget
+	(VisibilityWatchEventType)(0), // 4: temporal.server.api.enums.v1.VisibilityWatchEventType
 }
 var file_temporal_server_api_enums_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -273,7 +421,7 @@ func file_temporal_server_api_enums_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_common_proto_rawDesc), len(file_temporal_server_api_enums_v1_common_proto_rawDesc)),
-			NumEnums:      3,
+			NumEnums:      5,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
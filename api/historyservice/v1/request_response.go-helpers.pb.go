@@ -5998,3 +5998,262 @@ func (this *CancelNexusOperationResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type RebuildWorkflowVisibilityRequest to the protobuf v3 wire format
+func (val *RebuildWorkflowVisibilityRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RebuildWorkflowVisibilityRequest from the protobuf v3 wire format
+func (val *RebuildWorkflowVisibilityRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RebuildWorkflowVisibilityRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RebuildWorkflowVisibilityRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RebuildWorkflowVisibilityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RebuildWorkflowVisibilityRequest
+	switch t := that.(type) {
+	case *RebuildWorkflowVisibilityRequest:
+		that1 = t
+	case RebuildWorkflowVisibilityRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RebuildWorkflowVisibilityResponse to the protobuf v3 wire format
+func (val *RebuildWorkflowVisibilityResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RebuildWorkflowVisibilityResponse from the protobuf v3 wire format
+func (val *RebuildWorkflowVisibilityResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RebuildWorkflowVisibilityResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RebuildWorkflowVisibilityResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RebuildWorkflowVisibilityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RebuildWorkflowVisibilityResponse
+	switch t := that.(type) {
+	case *RebuildWorkflowVisibilityResponse:
+		that1 = t
+	case RebuildWorkflowVisibilityResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PollVisibilityWatchEventsRequest to the protobuf v3 wire format
+func (val *PollVisibilityWatchEventsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PollVisibilityWatchEventsRequest from the protobuf v3 wire format
+func (val *PollVisibilityWatchEventsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PollVisibilityWatchEventsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PollVisibilityWatchEventsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PollVisibilityWatchEventsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PollVisibilityWatchEventsRequest
+	switch t := that.(type) {
+	case *PollVisibilityWatchEventsRequest:
+		that1 = t
+	case PollVisibilityWatchEventsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PollVisibilityWatchEventsResponse to the protobuf v3 wire format
+func (val *PollVisibilityWatchEventsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PollVisibilityWatchEventsResponse from the protobuf v3 wire format
+func (val *PollVisibilityWatchEventsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PollVisibilityWatchEventsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PollVisibilityWatchEventsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PollVisibilityWatchEventsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PollVisibilityWatchEventsResponse
+	switch t := that.(type) {
+	case *PollVisibilityWatchEventsResponse:
+		that1 = t
+	case PollVisibilityWatchEventsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type VisibilityWatchEvent to the protobuf v3 wire format
+func (val *VisibilityWatchEvent) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityWatchEvent from the protobuf v3 wire format
+func (val *VisibilityWatchEvent) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityWatchEvent) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityWatchEvent values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityWatchEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityWatchEvent
+	switch t := that.(type) {
+	case *VisibilityWatchEvent:
+		that1 = t
+	case VisibilityWatchEvent:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainDynamicConfigRequest to the protobuf v3 wire format
+func (val *ExplainDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainDynamicConfigRequest from the protobuf v3 wire format
+func (val *ExplainDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainDynamicConfigRequest
+	switch t := that.(type) {
+	case *ExplainDynamicConfigRequest:
+		that1 = t
+	case ExplainDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainDynamicConfigResponse to the protobuf v3 wire format
+func (val *ExplainDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainDynamicConfigResponse from the protobuf v3 wire format
+func (val *ExplainDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainDynamicConfigResponse
+	switch t := that.(type) {
+	case *ExplainDynamicConfigResponse:
+		that1 = t
+	case ExplainDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -97,6 +97,9 @@ const (
 	HistoryService_UnpauseWorkflowExecution_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution"
 	HistoryService_StartNexusOperation_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/StartNexusOperation"
 	HistoryService_CancelNexusOperation_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/CancelNexusOperation"
+	HistoryService_RebuildWorkflowVisibility_FullMethodName              = "/temporal.server.api.historyservice.v1.HistoryService/RebuildWorkflowVisibility"
+	HistoryService_PollVisibilityWatchEvents_FullMethodName              = "/temporal.server.api.historyservice.v1.HistoryService/PollVisibilityWatchEvents"
+	HistoryService_ExplainDynamicConfig_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/ExplainDynamicConfig"
 )
 
 // HistoryServiceClient is the client API for HistoryService service.
@@ -389,6 +392,14 @@ type HistoryServiceClient interface {
 	StartNexusOperation(ctx context.Context, in *StartNexusOperationRequest, opts ...grpc.CallOption) (*StartNexusOperationResponse, error)
 	// CancelNexusOperation cancels a Nexus operation on the __temporal_system endpoint.
 	CancelNexusOperation(ctx context.Context, in *CancelNexusOperationRequest, opts ...grpc.CallOption) (*CancelNexusOperationResponse, error)
+	// RebuildWorkflowVisibility regenerates the visibility record of a workflow execution from its mutable state and
+	// writes it to the requested visibility store.
+	RebuildWorkflowVisibility(ctx context.Context, in *RebuildWorkflowVisibilityRequest, opts ...grpc.CallOption) (*RebuildWorkflowVisibilityResponse, error)
+	// PollVisibilityWatchEvents long-polls the changes to visibility records made by the visibility queues of the
+	// history host at host_address.
+	PollVisibilityWatchEvents(ctx context.Context, in *PollVisibilityWatchEventsRequest, opts ...grpc.CallOption) (*PollVisibilityWatchEventsResponse, error)
+	// ExplainDynamicConfig explains how the history host at host_address resolves a dynamic config setting.
+	ExplainDynamicConfig(ctx context.Context, in *ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*ExplainDynamicConfigResponse, error)
 }
 
 type historyServiceClient struct {
@@ -1114,6 +1125,33 @@ func (c *historyServiceClient) CancelNexusOperation(ctx context.Context, in *Can
 	return out, nil
 }
 
+func (c *historyServiceClient) RebuildWorkflowVisibility(ctx context.Context, in *RebuildWorkflowVisibilityRequest, opts ...grpc.CallOption) (*RebuildWorkflowVisibilityResponse, error) {
+	out := new(RebuildWorkflowVisibilityResponse)
+	err := c.cc.Invoke(ctx, HistoryService_RebuildWorkflowVisibility_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) PollVisibilityWatchEvents(ctx context.Context, in *PollVisibilityWatchEventsRequest, opts ...grpc.CallOption) (*PollVisibilityWatchEventsResponse, error) {
+	out := new(PollVisibilityWatchEventsResponse)
+	err := c.cc.Invoke(ctx, HistoryService_PollVisibilityWatchEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ExplainDynamicConfig(ctx context.Context, in *ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*ExplainDynamicConfigResponse, error) {
+	out := new(ExplainDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ExplainDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility
@@ -1404,6 +1442,14 @@ type HistoryServiceServer interface {
 	StartNexusOperation(context.Context, *StartNexusOperationRequest) (*StartNexusOperationResponse, error)
 	// CancelNexusOperation cancels a Nexus operation on the __temporal_system endpoint.
 	CancelNexusOperation(context.Context, *CancelNexusOperationRequest) (*CancelNexusOperationResponse, error)
+	// RebuildWorkflowVisibility regenerates the visibility record of a workflow execution from its mutable state and
+	// writes it to the requested visibility store.
+	RebuildWorkflowVisibility(context.Context, *RebuildWorkflowVisibilityRequest) (*RebuildWorkflowVisibilityResponse, error)
+	// PollVisibilityWatchEvents long-polls the changes to visibility records made by the visibility queues of the
+	// history host at host_address.
+	PollVisibilityWatchEvents(context.Context, *PollVisibilityWatchEventsRequest) (*PollVisibilityWatchEventsResponse, error)
+	// ExplainDynamicConfig explains how the history host at host_address resolves a dynamic config setting.
+	ExplainDynamicConfig(context.Context, *ExplainDynamicConfigRequest) (*ExplainDynamicConfigResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -1642,6 +1688,15 @@ func (UnimplementedHistoryServiceServer) StartNexusOperation(context.Context, *S
 func (UnimplementedHistoryServiceServer) CancelNexusOperation(context.Context, *CancelNexusOperationRequest) (*CancelNexusOperationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelNexusOperation not implemented")
 }
+func (UnimplementedHistoryServiceServer) RebuildWorkflowVisibility(context.Context, *RebuildWorkflowVisibilityRequest) (*RebuildWorkflowVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildWorkflowVisibility not implemented")
+}
+func (UnimplementedHistoryServiceServer) PollVisibilityWatchEvents(context.Context, *PollVisibilityWatchEventsRequest) (*PollVisibilityWatchEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollVisibilityWatchEvents not implemented")
+}
+func (UnimplementedHistoryServiceServer) ExplainDynamicConfig(context.Context, *ExplainDynamicConfigRequest) (*ExplainDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainDynamicConfig not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 
 // UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -3049,6 +3104,60 @@ func _HistoryService_CancelNexusOperation_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_RebuildWorkflowVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildWorkflowVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RebuildWorkflowVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_RebuildWorkflowVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RebuildWorkflowVisibility(ctx, req.(*RebuildWorkflowVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_PollVisibilityWatchEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollVisibilityWatchEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PollVisibilityWatchEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_PollVisibilityWatchEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PollVisibilityWatchEvents(ctx, req.(*PollVisibilityWatchEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ExplainDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ExplainDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ExplainDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ExplainDynamicConfig(ctx, req.(*ExplainDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3360,6 +3469,18 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelNexusOperation",
 			Handler:    _HistoryService_CancelNexusOperation_Handler,
 		},
+		{
+			MethodName: "RebuildWorkflowVisibility",
+			Handler:    _HistoryService_RebuildWorkflowVisibility_Handler,
+		},
+		{
+			MethodName: "PollVisibilityWatchEvents",
+			Handler:    _HistoryService_PollVisibilityWatchEvents_Handler,
+		},
+		{
+			MethodName: "ExplainDynamicConfig",
+			Handler:    _HistoryService_ExplainDynamicConfig_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
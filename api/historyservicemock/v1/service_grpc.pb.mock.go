@@ -323,6 +323,26 @@ func (mr *MockHistoryServiceClientMockRecorder) ExecuteMultiOperation(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteMultiOperation", reflect.TypeOf((*MockHistoryServiceClient)(nil).ExecuteMultiOperation), varargs...)
 }
 
+// ExplainDynamicConfig mocks base method.
+func (m *MockHistoryServiceClient) ExplainDynamicConfig(ctx context.Context, in *historyservice.ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*historyservice.ExplainDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExplainDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*historyservice.ExplainDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainDynamicConfig indicates an expected call of ExplainDynamicConfig.
+func (mr *MockHistoryServiceClientMockRecorder) ExplainDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainDynamicConfig", reflect.TypeOf((*MockHistoryServiceClient)(nil).ExplainDynamicConfig), varargs...)
+}
+
 // ForceDeleteWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) ForceDeleteWorkflowExecution(ctx context.Context, in *historyservice.ForceDeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.ForceDeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -783,6 +803,26 @@ func (mr *MockHistoryServiceClientMockRecorder) PollMutableState(ctx, in any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).PollMutableState), varargs...)
 }
 
+// PollVisibilityWatchEvents mocks base method.
+func (m *MockHistoryServiceClient) PollVisibilityWatchEvents(ctx context.Context, in *historyservice.PollVisibilityWatchEventsRequest, opts ...grpc.CallOption) (*historyservice.PollVisibilityWatchEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PollVisibilityWatchEvents", varargs...)
+	ret0, _ := ret[0].(*historyservice.PollVisibilityWatchEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PollVisibilityWatchEvents indicates an expected call of PollVisibilityWatchEvents.
+func (mr *MockHistoryServiceClientMockRecorder) PollVisibilityWatchEvents(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollVisibilityWatchEvents", reflect.TypeOf((*MockHistoryServiceClient)(nil).PollVisibilityWatchEvents), varargs...)
+}
+
 // PollWorkflowExecutionUpdate mocks base method.
 func (m *MockHistoryServiceClient) PollWorkflowExecutionUpdate(ctx context.Context, in *historyservice.PollWorkflowExecutionUpdateRequest, opts ...grpc.CallOption) (*historyservice.PollWorkflowExecutionUpdateResponse, error) {
 	m.ctrl.T.Helper()
@@ -883,6 +923,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RebuildMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).RebuildMutableState), varargs...)
 }
 
+// RebuildWorkflowVisibility mocks base method.
+func (m *MockHistoryServiceClient) RebuildWorkflowVisibility(ctx context.Context, in *historyservice.RebuildWorkflowVisibilityRequest, opts ...grpc.CallOption) (*historyservice.RebuildWorkflowVisibilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebuildWorkflowVisibility", varargs...)
+	ret0, _ := ret[0].(*historyservice.RebuildWorkflowVisibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildWorkflowVisibility indicates an expected call of RebuildWorkflowVisibility.
+func (mr *MockHistoryServiceClientMockRecorder) RebuildWorkflowVisibility(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildWorkflowVisibility", reflect.TypeOf((*MockHistoryServiceClient)(nil).RebuildWorkflowVisibility), varargs...)
+}
+
 // RecordActivityTaskHeartbeat mocks base method.
 func (m *MockHistoryServiceClient) RecordActivityTaskHeartbeat(ctx context.Context, in *historyservice.RecordActivityTaskHeartbeatRequest, opts ...grpc.CallOption) (*historyservice.RecordActivityTaskHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -1955,6 +2015,21 @@ func (mr *MockHistoryServiceServerMockRecorder) ExecuteMultiOperation(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteMultiOperation", reflect.TypeOf((*MockHistoryServiceServer)(nil).ExecuteMultiOperation), arg0, arg1)
 }
 
+// ExplainDynamicConfig mocks base method.
+func (m *MockHistoryServiceServer) ExplainDynamicConfig(arg0 context.Context, arg1 *historyservice.ExplainDynamicConfigRequest) (*historyservice.ExplainDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ExplainDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainDynamicConfig indicates an expected call of ExplainDynamicConfig.
+func (mr *MockHistoryServiceServerMockRecorder) ExplainDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainDynamicConfig", reflect.TypeOf((*MockHistoryServiceServer)(nil).ExplainDynamicConfig), arg0, arg1)
+}
+
 // ForceDeleteWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) ForceDeleteWorkflowExecution(arg0 context.Context, arg1 *historyservice.ForceDeleteWorkflowExecutionRequest) (*historyservice.ForceDeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -2300,6 +2375,21 @@ func (mr *MockHistoryServiceServerMockRecorder) PollMutableState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).PollMutableState), arg0, arg1)
 }
 
+// PollVisibilityWatchEvents mocks base method.
+func (m *MockHistoryServiceServer) PollVisibilityWatchEvents(arg0 context.Context, arg1 *historyservice.PollVisibilityWatchEventsRequest) (*historyservice.PollVisibilityWatchEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PollVisibilityWatchEvents", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.PollVisibilityWatchEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PollVisibilityWatchEvents indicates an expected call of PollVisibilityWatchEvents.
+func (mr *MockHistoryServiceServerMockRecorder) PollVisibilityWatchEvents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollVisibilityWatchEvents", reflect.TypeOf((*MockHistoryServiceServer)(nil).PollVisibilityWatchEvents), arg0, arg1)
+}
+
 // PollWorkflowExecutionUpdate mocks base method.
 func (m *MockHistoryServiceServer) PollWorkflowExecutionUpdate(arg0 context.Context, arg1 *historyservice.PollWorkflowExecutionUpdateRequest) (*historyservice.PollWorkflowExecutionUpdateResponse, error) {
 	m.ctrl.T.Helper()
@@ -2375,6 +2465,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RebuildMutableState(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).RebuildMutableState), arg0, arg1)
 }
 
+// RebuildWorkflowVisibility mocks base method.
+func (m *MockHistoryServiceServer) RebuildWorkflowVisibility(arg0 context.Context, arg1 *historyservice.RebuildWorkflowVisibilityRequest) (*historyservice.RebuildWorkflowVisibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildWorkflowVisibility", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RebuildWorkflowVisibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildWorkflowVisibility indicates an expected call of RebuildWorkflowVisibility.
+func (mr *MockHistoryServiceServerMockRecorder) RebuildWorkflowVisibility(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildWorkflowVisibility", reflect.TypeOf((*MockHistoryServiceServer)(nil).RebuildWorkflowVisibility), arg0, arg1)
+}
+
 // RecordActivityTaskHeartbeat mocks base method.
 func (m *MockHistoryServiceServer) RecordActivityTaskHeartbeat(arg0 context.Context, arg1 *historyservice.RecordActivityTaskHeartbeatRequest) (*historyservice.RecordActivityTaskHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
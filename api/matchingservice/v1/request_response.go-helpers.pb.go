@@ -2854,6 +2854,302 @@ func (this *UpdateTaskQueueConfigResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type UpdateTaskQueueDispatchPauseRequest to the protobuf v3 wire format
+func (val *UpdateTaskQueueDispatchPauseRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueDispatchPauseRequest from the protobuf v3 wire format
+func (val *UpdateTaskQueueDispatchPauseRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueDispatchPauseRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueDispatchPauseRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueDispatchPauseRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueDispatchPauseRequest
+	switch t := that.(type) {
+	case *UpdateTaskQueueDispatchPauseRequest:
+		that1 = t
+	case UpdateTaskQueueDispatchPauseRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueueDispatchPauseResponse to the protobuf v3 wire format
+func (val *UpdateTaskQueueDispatchPauseResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueDispatchPauseResponse from the protobuf v3 wire format
+func (val *UpdateTaskQueueDispatchPauseResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueDispatchPauseResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueDispatchPauseResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueDispatchPauseResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueDispatchPauseResponse
+	switch t := that.(type) {
+	case *UpdateTaskQueueDispatchPauseResponse:
+		that1 = t
+	case UpdateTaskQueueDispatchPauseResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReleaseConcurrencySlotRequest to the protobuf v3 wire format
+func (val *ReleaseConcurrencySlotRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReleaseConcurrencySlotRequest from the protobuf v3 wire format
+func (val *ReleaseConcurrencySlotRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReleaseConcurrencySlotRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReleaseConcurrencySlotRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReleaseConcurrencySlotRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReleaseConcurrencySlotRequest
+	switch t := that.(type) {
+	case *ReleaseConcurrencySlotRequest:
+		that1 = t
+	case ReleaseConcurrencySlotRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReleaseConcurrencySlotResponse to the protobuf v3 wire format
+func (val *ReleaseConcurrencySlotResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReleaseConcurrencySlotResponse from the protobuf v3 wire format
+func (val *ReleaseConcurrencySlotResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReleaseConcurrencySlotResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReleaseConcurrencySlotResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReleaseConcurrencySlotResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReleaseConcurrencySlotResponse
+	switch t := that.(type) {
+	case *ReleaseConcurrencySlotResponse:
+		that1 = t
+	case ReleaseConcurrencySlotResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueDLQTasksRequest to the protobuf v3 wire format
+func (val *ListTaskQueueDLQTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueDLQTasksRequest from the protobuf v3 wire format
+func (val *ListTaskQueueDLQTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueDLQTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueDLQTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueDLQTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueDLQTasksRequest
+	switch t := that.(type) {
+	case *ListTaskQueueDLQTasksRequest:
+		that1 = t
+	case ListTaskQueueDLQTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueDLQTasksResponse to the protobuf v3 wire format
+func (val *ListTaskQueueDLQTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueDLQTasksResponse from the protobuf v3 wire format
+func (val *ListTaskQueueDLQTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueDLQTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueDLQTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueDLQTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueDLQTasksResponse
+	switch t := that.(type) {
+	case *ListTaskQueueDLQTasksResponse:
+		that1 = t
+	case ListTaskQueueDLQTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReenqueueTaskQueueDLQTasksRequest to the protobuf v3 wire format
+func (val *ReenqueueTaskQueueDLQTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReenqueueTaskQueueDLQTasksRequest from the protobuf v3 wire format
+func (val *ReenqueueTaskQueueDLQTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReenqueueTaskQueueDLQTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReenqueueTaskQueueDLQTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReenqueueTaskQueueDLQTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReenqueueTaskQueueDLQTasksRequest
+	switch t := that.(type) {
+	case *ReenqueueTaskQueueDLQTasksRequest:
+		that1 = t
+	case ReenqueueTaskQueueDLQTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReenqueueTaskQueueDLQTasksResponse to the protobuf v3 wire format
+func (val *ReenqueueTaskQueueDLQTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReenqueueTaskQueueDLQTasksResponse from the protobuf v3 wire format
+func (val *ReenqueueTaskQueueDLQTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReenqueueTaskQueueDLQTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReenqueueTaskQueueDLQTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReenqueueTaskQueueDLQTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReenqueueTaskQueueDLQTasksResponse
+	switch t := that.(type) {
+	case *ReenqueueTaskQueueDLQTasksResponse:
+		that1 = t
+	case ReenqueueTaskQueueDLQTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DescribeWorkerRequest to the protobuf v3 wire format
 func (val *DescribeWorkerRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -3112,3 +3408,77 @@ func (this *PollConditions) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ExplainDynamicConfigRequest to the protobuf v3 wire format
+func (val *ExplainDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainDynamicConfigRequest from the protobuf v3 wire format
+func (val *ExplainDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainDynamicConfigRequest
+	switch t := that.(type) {
+	case *ExplainDynamicConfigRequest:
+		that1 = t
+	case ExplainDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainDynamicConfigResponse to the protobuf v3 wire format
+func (val *ExplainDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainDynamicConfigResponse from the protobuf v3 wire format
+func (val *ExplainDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainDynamicConfigResponse
+	switch t := that.(type) {
+	case *ExplainDynamicConfigResponse:
+		that1 = t
+	case ExplainDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
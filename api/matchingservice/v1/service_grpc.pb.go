@@ -58,9 +58,14 @@ const (
 	MatchingService_ListWorkers_FullMethodName                            = "/temporal.server.api.matchingservice.v1.MatchingService/ListWorkers"
 	MatchingService_CountWorkers_FullMethodName                           = "/temporal.server.api.matchingservice.v1.MatchingService/CountWorkers"
 	MatchingService_UpdateTaskQueueConfig_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueConfig"
+	MatchingService_UpdateTaskQueueDispatchPause_FullMethodName           = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueDispatchPause"
+	MatchingService_ListTaskQueueDLQTasks_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueueDLQTasks"
+	MatchingService_ReenqueueTaskQueueDLQTasks_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/ReenqueueTaskQueueDLQTasks"
+	MatchingService_ReleaseConcurrencySlot_FullMethodName                 = "/temporal.server.api.matchingservice.v1.MatchingService/ReleaseConcurrencySlot"
 	MatchingService_DescribeWorker_FullMethodName                         = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeWorker"
 	MatchingService_UpdateFairnessState_FullMethodName                    = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateFairnessState"
 	MatchingService_CheckTaskQueueVersionMembership_FullMethodName        = "/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueVersionMembership"
+	MatchingService_ExplainDynamicConfig_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/ExplainDynamicConfig"
 )
 
 // MatchingServiceClient is the client API for MatchingService service.
@@ -230,6 +235,40 @@ type MatchingServiceClient interface {
 	//
 	//	aip.dev/not-precedent: UpdateTaskQueueConfig RPC doesn't follow Google API format. --)
 	UpdateTaskQueueConfig(ctx context.Context, in *UpdateTaskQueueConfigRequest, opts ...grpc.CallOption) (*UpdateTaskQueueConfigResponse, error)
+	// UpdateTaskQueueDispatchPause pauses or resumes dispatch of tasks of one type of a task queue.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueueDispatchPause RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueueDispatchPause RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueueDispatchPause RPC doesn't follow Google API format. --)
+	UpdateTaskQueueDispatchPause(ctx context.Context, in *UpdateTaskQueueDispatchPauseRequest, opts ...grpc.CallOption) (*UpdateTaskQueueDispatchPauseResponse, error)
+	// ListTaskQueueDLQTasks returns a page of the tasks in the DLQ of one type of a task queue.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: ListTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: ListTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//     aip.dev/not-precedent: ListTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	ListTaskQueueDLQTasks(ctx context.Context, in *ListTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*ListTaskQueueDLQTasksResponse, error)
+	// ReenqueueTaskQueueDLQTasks adds tasks from the DLQ of one type of a task queue back to the task queue, and
+	// removes them from the DLQ.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: ReenqueueTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: ReenqueueTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//     aip.dev/not-precedent: ReenqueueTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	ReenqueueTaskQueueDLQTasks(ctx context.Context, in *ReenqueueTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*ReenqueueTaskQueueDLQTasksResponse, error)
+	// ReleaseConcurrencySlot releases the fairness key concurrency slot held by an activity task that a
+	// worker has responded to.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: ReleaseConcurrencySlot RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: ReleaseConcurrencySlot RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//     aip.dev/not-precedent: ReleaseConcurrencySlot RPC doesn't follow Google API format. --)
+	ReleaseConcurrencySlot(ctx context.Context, in *ReleaseConcurrencySlotRequest, opts ...grpc.CallOption) (*ReleaseConcurrencySlotResponse, error)
 	// DescribeWorker retrieves a worker information in the specified namespace that match the provided instance key.
 	// Returns an error if the namespace or worker doesn't exist.
 	DescribeWorker(ctx context.Context, in *DescribeWorkerRequest, opts ...grpc.CallOption) (*DescribeWorkerResponse, error)
@@ -249,6 +288,8 @@ type MatchingServiceClient interface {
 	UpdateFairnessState(ctx context.Context, in *UpdateFairnessStateRequest, opts ...grpc.CallOption) (*UpdateFairnessStateResponse, error)
 	// CheckTaskQueueVersionMembership checks if a task queue is part of a specific deployment version.
 	CheckTaskQueueVersionMembership(ctx context.Context, in *CheckTaskQueueVersionMembershipRequest, opts ...grpc.CallOption) (*CheckTaskQueueVersionMembershipResponse, error)
+	// ExplainDynamicConfig explains how the matching host at host_address resolves a dynamic config setting.
+	ExplainDynamicConfig(ctx context.Context, in *ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*ExplainDynamicConfigResponse, error)
 }
 
 type matchingServiceClient struct {
@@ -601,6 +642,42 @@ func (c *matchingServiceClient) UpdateTaskQueueConfig(ctx context.Context, in *U
 	return out, nil
 }
 
+func (c *matchingServiceClient) UpdateTaskQueueDispatchPause(ctx context.Context, in *UpdateTaskQueueDispatchPauseRequest, opts ...grpc.CallOption) (*UpdateTaskQueueDispatchPauseResponse, error) {
+	out := new(UpdateTaskQueueDispatchPauseResponse)
+	err := c.cc.Invoke(ctx, MatchingService_UpdateTaskQueueDispatchPause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ListTaskQueueDLQTasks(ctx context.Context, in *ListTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*ListTaskQueueDLQTasksResponse, error) {
+	out := new(ListTaskQueueDLQTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ListTaskQueueDLQTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ReenqueueTaskQueueDLQTasks(ctx context.Context, in *ReenqueueTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*ReenqueueTaskQueueDLQTasksResponse, error) {
+	out := new(ReenqueueTaskQueueDLQTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ReenqueueTaskQueueDLQTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ReleaseConcurrencySlot(ctx context.Context, in *ReleaseConcurrencySlotRequest, opts ...grpc.CallOption) (*ReleaseConcurrencySlotResponse, error) {
+	out := new(ReleaseConcurrencySlotResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ReleaseConcurrencySlot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) DescribeWorker(ctx context.Context, in *DescribeWorkerRequest, opts ...grpc.CallOption) (*DescribeWorkerResponse, error) {
 	out := new(DescribeWorkerResponse)
 	err := c.cc.Invoke(ctx, MatchingService_DescribeWorker_FullMethodName, in, out, opts...)
@@ -628,6 +705,15 @@ func (c *matchingServiceClient) CheckTaskQueueVersionMembership(ctx context.Cont
 	return out, nil
 }
 
+func (c *matchingServiceClient) ExplainDynamicConfig(ctx context.Context, in *ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*ExplainDynamicConfigResponse, error) {
+	out := new(ExplainDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ExplainDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchingServiceServer is the server API for MatchingService service.
 // All implementations must embed UnimplementedMatchingServiceServer
 // for forward compatibility
@@ -795,6 +881,40 @@ type MatchingServiceServer interface {
 	//
 	//	aip.dev/not-precedent: UpdateTaskQueueConfig RPC doesn't follow Google API format. --)
 	UpdateTaskQueueConfig(context.Context, *UpdateTaskQueueConfigRequest) (*UpdateTaskQueueConfigResponse, error)
+	// UpdateTaskQueueDispatchPause pauses or resumes dispatch of tasks of one type of a task queue.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueueDispatchPause RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueueDispatchPause RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueueDispatchPause RPC doesn't follow Google API format. --)
+	UpdateTaskQueueDispatchPause(context.Context, *UpdateTaskQueueDispatchPauseRequest) (*UpdateTaskQueueDispatchPauseResponse, error)
+	// ListTaskQueueDLQTasks returns a page of the tasks in the DLQ of one type of a task queue.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: ListTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: ListTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//     aip.dev/not-precedent: ListTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	ListTaskQueueDLQTasks(context.Context, *ListTaskQueueDLQTasksRequest) (*ListTaskQueueDLQTasksResponse, error)
+	// ReenqueueTaskQueueDLQTasks adds tasks from the DLQ of one type of a task queue back to the task queue, and
+	// removes them from the DLQ.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: ReenqueueTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: ReenqueueTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//     aip.dev/not-precedent: ReenqueueTaskQueueDLQTasks RPC doesn't follow Google API format. --)
+	ReenqueueTaskQueueDLQTasks(context.Context, *ReenqueueTaskQueueDLQTasksRequest) (*ReenqueueTaskQueueDLQTasksResponse, error)
+	// ReleaseConcurrencySlot releases the fairness key concurrency slot held by an activity task that a
+	// worker has responded to.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: ReleaseConcurrencySlot RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: ReleaseConcurrencySlot RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//     aip.dev/not-precedent: ReleaseConcurrencySlot RPC doesn't follow Google API format. --)
+	ReleaseConcurrencySlot(context.Context, *ReleaseConcurrencySlotRequest) (*ReleaseConcurrencySlotResponse, error)
 	// DescribeWorker retrieves a worker information in the specified namespace that match the provided instance key.
 	// Returns an error if the namespace or worker doesn't exist.
 	DescribeWorker(context.Context, *DescribeWorkerRequest) (*DescribeWorkerResponse, error)
@@ -814,6 +934,8 @@ type MatchingServiceServer interface {
 	UpdateFairnessState(context.Context, *UpdateFairnessStateRequest) (*UpdateFairnessStateResponse, error)
 	// CheckTaskQueueVersionMembership checks if a task queue is part of a specific deployment version.
 	CheckTaskQueueVersionMembership(context.Context, *CheckTaskQueueVersionMembershipRequest) (*CheckTaskQueueVersionMembershipResponse, error)
+	// ExplainDynamicConfig explains how the matching host at host_address resolves a dynamic config setting.
+	ExplainDynamicConfig(context.Context, *ExplainDynamicConfigRequest) (*ExplainDynamicConfigResponse, error)
 	mustEmbedUnimplementedMatchingServiceServer()
 }
 
@@ -935,6 +1057,18 @@ func (UnimplementedMatchingServiceServer) CountWorkers(context.Context, *CountWo
 func (UnimplementedMatchingServiceServer) UpdateTaskQueueConfig(context.Context, *UpdateTaskQueueConfigRequest) (*UpdateTaskQueueConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueConfig not implemented")
 }
+func (UnimplementedMatchingServiceServer) UpdateTaskQueueDispatchPause(context.Context, *UpdateTaskQueueDispatchPauseRequest) (*UpdateTaskQueueDispatchPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueDispatchPause not implemented")
+}
+func (UnimplementedMatchingServiceServer) ListTaskQueueDLQTasks(context.Context, *ListTaskQueueDLQTasksRequest) (*ListTaskQueueDLQTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueueDLQTasks not implemented")
+}
+func (UnimplementedMatchingServiceServer) ReenqueueTaskQueueDLQTasks(context.Context, *ReenqueueTaskQueueDLQTasksRequest) (*ReenqueueTaskQueueDLQTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReenqueueTaskQueueDLQTasks not implemented")
+}
+func (UnimplementedMatchingServiceServer) ReleaseConcurrencySlot(context.Context, *ReleaseConcurrencySlotRequest) (*ReleaseConcurrencySlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseConcurrencySlot not implemented")
+}
 func (UnimplementedMatchingServiceServer) DescribeWorker(context.Context, *DescribeWorkerRequest) (*DescribeWorkerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorker not implemented")
 }
@@ -944,6 +1078,9 @@ func (UnimplementedMatchingServiceServer) UpdateFairnessState(context.Context, *
 func (UnimplementedMatchingServiceServer) CheckTaskQueueVersionMembership(context.Context, *CheckTaskQueueVersionMembershipRequest) (*CheckTaskQueueVersionMembershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckTaskQueueVersionMembership not implemented")
 }
+func (UnimplementedMatchingServiceServer) ExplainDynamicConfig(context.Context, *ExplainDynamicConfigRequest) (*ExplainDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainDynamicConfig not implemented")
+}
 func (UnimplementedMatchingServiceServer) mustEmbedUnimplementedMatchingServiceServer() {}
 
 // UnsafeMatchingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1641,6 +1778,78 @@ func _MatchingService_UpdateTaskQueueConfig_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_UpdateTaskQueueDispatchPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueueDispatchPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateTaskQueueDispatchPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_UpdateTaskQueueDispatchPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateTaskQueueDispatchPause(ctx, req.(*UpdateTaskQueueDispatchPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ListTaskQueueDLQTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueueDLQTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ListTaskQueueDLQTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ListTaskQueueDLQTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ListTaskQueueDLQTasks(ctx, req.(*ListTaskQueueDLQTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ReenqueueTaskQueueDLQTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReenqueueTaskQueueDLQTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ReenqueueTaskQueueDLQTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ReenqueueTaskQueueDLQTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ReenqueueTaskQueueDLQTasks(ctx, req.(*ReenqueueTaskQueueDLQTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ReleaseConcurrencySlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseConcurrencySlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ReleaseConcurrencySlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ReleaseConcurrencySlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ReleaseConcurrencySlot(ctx, req.(*ReleaseConcurrencySlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_DescribeWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeWorkerRequest)
 	if err := dec(in); err != nil {
@@ -1695,6 +1904,24 @@ func _MatchingService_CheckTaskQueueVersionMembership_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_ExplainDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ExplainDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ExplainDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ExplainDynamicConfig(ctx, req.(*ExplainDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MatchingService_ServiceDesc is the grpc.ServiceDesc for MatchingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1854,6 +2081,22 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTaskQueueConfig",
 			Handler:    _MatchingService_UpdateTaskQueueConfig_Handler,
 		},
+		{
+			MethodName: "UpdateTaskQueueDispatchPause",
+			Handler:    _MatchingService_UpdateTaskQueueDispatchPause_Handler,
+		},
+		{
+			MethodName: "ListTaskQueueDLQTasks",
+			Handler:    _MatchingService_ListTaskQueueDLQTasks_Handler,
+		},
+		{
+			MethodName: "ReenqueueTaskQueueDLQTasks",
+			Handler:    _MatchingService_ReenqueueTaskQueueDLQTasks_Handler,
+		},
+		{
+			MethodName: "ReleaseConcurrencySlot",
+			Handler:    _MatchingService_ReleaseConcurrencySlot_Handler,
+		},
 		{
 			MethodName: "DescribeWorker",
 			Handler:    _MatchingService_DescribeWorker_Handler,
@@ -1866,6 +2109,10 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckTaskQueueVersionMembership",
 			Handler:    _MatchingService_CheckTaskQueueVersionMembership_Handler,
 		},
+		{
+			MethodName: "ExplainDynamicConfig",
+			Handler:    _MatchingService_ExplainDynamicConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/matchingservice/v1/service.proto",
@@ -342,6 +342,26 @@ func (mr *MockMatchingServiceClientMockRecorder) DispatchNexusTask(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchNexusTask", reflect.TypeOf((*MockMatchingServiceClient)(nil).DispatchNexusTask), varargs...)
 }
 
+// ExplainDynamicConfig mocks base method.
+func (m *MockMatchingServiceClient) ExplainDynamicConfig(ctx context.Context, in *matchingservice.ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*matchingservice.ExplainDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExplainDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ExplainDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainDynamicConfig indicates an expected call of ExplainDynamicConfig.
+func (mr *MockMatchingServiceClientMockRecorder) ExplainDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainDynamicConfig", reflect.TypeOf((*MockMatchingServiceClient)(nil).ExplainDynamicConfig), varargs...)
+}
+
 // ForceLoadTaskQueuePartition mocks base method.
 func (m *MockMatchingServiceClient) ForceLoadTaskQueuePartition(ctx context.Context, in *matchingservice.ForceLoadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*matchingservice.ForceLoadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -502,6 +522,26 @@ func (mr *MockMatchingServiceClientMockRecorder) ListNexusEndpoints(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpoints", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListNexusEndpoints), varargs...)
 }
 
+// ListTaskQueueDLQTasks mocks base method.
+func (m *MockMatchingServiceClient) ListTaskQueueDLQTasks(ctx context.Context, in *matchingservice.ListTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*matchingservice.ListTaskQueueDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskQueueDLQTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ListTaskQueueDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueDLQTasks indicates an expected call of ListTaskQueueDLQTasks.
+func (mr *MockMatchingServiceClientMockRecorder) ListTaskQueueDLQTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueDLQTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListTaskQueueDLQTasks), varargs...)
+}
+
 // ListTaskQueuePartitions mocks base method.
 func (m *MockMatchingServiceClient) ListTaskQueuePartitions(ctx context.Context, in *matchingservice.ListTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*matchingservice.ListTaskQueuePartitionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -642,6 +682,46 @@ func (mr *MockMatchingServiceClientMockRecorder) RecordWorkerHeartbeat(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkerHeartbeat", reflect.TypeOf((*MockMatchingServiceClient)(nil).RecordWorkerHeartbeat), varargs...)
 }
 
+// ReenqueueTaskQueueDLQTasks mocks base method.
+func (m *MockMatchingServiceClient) ReenqueueTaskQueueDLQTasks(ctx context.Context, in *matchingservice.ReenqueueTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*matchingservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReenqueueTaskQueueDLQTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ReenqueueTaskQueueDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReenqueueTaskQueueDLQTasks indicates an expected call of ReenqueueTaskQueueDLQTasks.
+func (mr *MockMatchingServiceClientMockRecorder) ReenqueueTaskQueueDLQTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueTaskQueueDLQTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).ReenqueueTaskQueueDLQTasks), varargs...)
+}
+
+// ReleaseConcurrencySlot mocks base method.
+func (m *MockMatchingServiceClient) ReleaseConcurrencySlot(ctx context.Context, in *matchingservice.ReleaseConcurrencySlotRequest, opts ...grpc.CallOption) (*matchingservice.ReleaseConcurrencySlotResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseConcurrencySlot", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ReleaseConcurrencySlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseConcurrencySlot indicates an expected call of ReleaseConcurrencySlot.
+func (mr *MockMatchingServiceClientMockRecorder) ReleaseConcurrencySlot(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseConcurrencySlot", reflect.TypeOf((*MockMatchingServiceClient)(nil).ReleaseConcurrencySlot), varargs...)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) ReplicateTaskQueueUserData(ctx context.Context, in *matchingservice.ReplicateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -802,6 +882,26 @@ func (mr *MockMatchingServiceClientMockRecorder) UpdateTaskQueueConfig(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateTaskQueueConfig), varargs...)
 }
 
+// UpdateTaskQueueDispatchPause mocks base method.
+func (m *MockMatchingServiceClient) UpdateTaskQueueDispatchPause(ctx context.Context, in *matchingservice.UpdateTaskQueueDispatchPauseRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskQueueDispatchPause", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueueDispatchPauseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueDispatchPause indicates an expected call of UpdateTaskQueueDispatchPause.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateTaskQueueDispatchPause(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueDispatchPause", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateTaskQueueDispatchPause), varargs...)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *matchingservice.UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1111,6 +1211,21 @@ func (mr *MockMatchingServiceServerMockRecorder) DispatchNexusTask(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchNexusTask", reflect.TypeOf((*MockMatchingServiceServer)(nil).DispatchNexusTask), arg0, arg1)
 }
 
+// ExplainDynamicConfig mocks base method.
+func (m *MockMatchingServiceServer) ExplainDynamicConfig(arg0 context.Context, arg1 *matchingservice.ExplainDynamicConfigRequest) (*matchingservice.ExplainDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ExplainDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainDynamicConfig indicates an expected call of ExplainDynamicConfig.
+func (mr *MockMatchingServiceServerMockRecorder) ExplainDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainDynamicConfig", reflect.TypeOf((*MockMatchingServiceServer)(nil).ExplainDynamicConfig), arg0, arg1)
+}
+
 // ForceLoadTaskQueuePartition mocks base method.
 func (m *MockMatchingServiceServer) ForceLoadTaskQueuePartition(arg0 context.Context, arg1 *matchingservice.ForceLoadTaskQueuePartitionRequest) (*matchingservice.ForceLoadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1231,6 +1346,21 @@ func (mr *MockMatchingServiceServerMockRecorder) ListNexusEndpoints(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpoints", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListNexusEndpoints), arg0, arg1)
 }
 
+// ListTaskQueueDLQTasks mocks base method.
+func (m *MockMatchingServiceServer) ListTaskQueueDLQTasks(arg0 context.Context, arg1 *matchingservice.ListTaskQueueDLQTasksRequest) (*matchingservice.ListTaskQueueDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskQueueDLQTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ListTaskQueueDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueDLQTasks indicates an expected call of ListTaskQueueDLQTasks.
+func (mr *MockMatchingServiceServerMockRecorder) ListTaskQueueDLQTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueDLQTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListTaskQueueDLQTasks), arg0, arg1)
+}
+
 // ListTaskQueuePartitions mocks base method.
 func (m *MockMatchingServiceServer) ListTaskQueuePartitions(arg0 context.Context, arg1 *matchingservice.ListTaskQueuePartitionsRequest) (*matchingservice.ListTaskQueuePartitionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1336,6 +1466,36 @@ func (mr *MockMatchingServiceServerMockRecorder) RecordWorkerHeartbeat(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkerHeartbeat", reflect.TypeOf((*MockMatchingServiceServer)(nil).RecordWorkerHeartbeat), arg0, arg1)
 }
 
+// ReenqueueTaskQueueDLQTasks mocks base method.
+func (m *MockMatchingServiceServer) ReenqueueTaskQueueDLQTasks(arg0 context.Context, arg1 *matchingservice.ReenqueueTaskQueueDLQTasksRequest) (*matchingservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReenqueueTaskQueueDLQTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ReenqueueTaskQueueDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReenqueueTaskQueueDLQTasks indicates an expected call of ReenqueueTaskQueueDLQTasks.
+func (mr *MockMatchingServiceServerMockRecorder) ReenqueueTaskQueueDLQTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReenqueueTaskQueueDLQTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).ReenqueueTaskQueueDLQTasks), arg0, arg1)
+}
+
+// ReleaseConcurrencySlot mocks base method.
+func (m *MockMatchingServiceServer) ReleaseConcurrencySlot(arg0 context.Context, arg1 *matchingservice.ReleaseConcurrencySlotRequest) (*matchingservice.ReleaseConcurrencySlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseConcurrencySlot", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ReleaseConcurrencySlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseConcurrencySlot indicates an expected call of ReleaseConcurrencySlot.
+func (mr *MockMatchingServiceServerMockRecorder) ReleaseConcurrencySlot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseConcurrencySlot", reflect.TypeOf((*MockMatchingServiceServer)(nil).ReleaseConcurrencySlot), arg0, arg1)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) ReplicateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.ReplicateTaskQueueUserDataRequest) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1456,6 +1616,21 @@ func (mr *MockMatchingServiceServerMockRecorder) UpdateTaskQueueConfig(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateTaskQueueConfig), arg0, arg1)
 }
 
+// UpdateTaskQueueDispatchPause mocks base method.
+func (m *MockMatchingServiceServer) UpdateTaskQueueDispatchPause(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueueDispatchPauseRequest) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskQueueDispatchPause", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueueDispatchPauseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueDispatchPause indicates an expected call of UpdateTaskQueueDispatchPause.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateTaskQueueDispatchPause(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueDispatchPause", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateTaskQueueDispatchPause), arg0, arg1)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) UpdateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +78,114 @@ func (this *IndexSearchAttributes) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type DynamicConfigConstraints to the protobuf v3 wire format
+func (val *DynamicConfigConstraints) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigConstraints from the protobuf v3 wire format
+func (val *DynamicConfigConstraints) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigConstraints) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigConstraints values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigConstraints) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigConstraints
+	switch t := that.(type) {
+	case *DynamicConfigConstraints:
+		that1 = t
+	case DynamicConfigConstraints:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigOverride to the protobuf v3 wire format
+func (val *DynamicConfigOverride) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigOverride from the protobuf v3 wire format
+func (val *DynamicConfigOverride) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigOverride) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigOverride values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigOverride
+	switch t := that.(type) {
+	case *DynamicConfigOverride:
+		that1 = t
+	case DynamicConfigOverride:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigChange to the protobuf v3 wire format
+func (val *DynamicConfigChange) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigChange from the protobuf v3 wire format
+func (val *DynamicConfigChange) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigChange) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigChange values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigChange
+	switch t := that.(type) {
+	case *DynamicConfigChange:
+		that1 = t
+	case DynamicConfigChange:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -15,6 +15,7 @@ import (
 	v1 "go.temporal.io/api/version/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -42,6 +43,11 @@ type ClusterMetadata struct {
 	Tags                     map[string]string                 `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// is_replication_enabled controls whether replication streams are active.
 	IsReplicationEnabled bool `protobuf:"varint,14,opt,name=is_replication_enabled,json=isReplicationEnabled,proto3" json:"is_replication_enabled,omitempty"`
+	// Dynamic config overrides managed through the admin API. They are layered on top of the
+	// values provided by the configured dynamic config client.
+	DynamicConfigOverrides []*DynamicConfigOverride `protobuf:"bytes,15,rep,name=dynamic_config_overrides,json=dynamicConfigOverrides,proto3" json:"dynamic_config_overrides,omitempty"`
+	// Most recent changes to dynamic_config_overrides, oldest first.
+	DynamicConfigHistory []*DynamicConfigChange `protobuf:"bytes,16,rep,name=dynamic_config_history,json=dynamicConfigHistory,proto3" json:"dynamic_config_history,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -174,6 +180,20 @@ func (x *ClusterMetadata) GetIsReplicationEnabled() bool {
 	return false
 }
 
+func (x *ClusterMetadata) GetDynamicConfigOverrides() []*DynamicConfigOverride {
+	if x != nil {
+		return x.DynamicConfigOverrides
+	}
+	return nil
+}
+
+func (x *ClusterMetadata) GetDynamicConfigHistory() []*DynamicConfigChange {
+	if x != nil {
+		return x.DynamicConfigHistory
+	}
+	return nil
+}
+
 type IndexSearchAttributes struct {
 	state                  protoimpl.MessageState          `protogen:"open.v1"`
 	CustomSearchAttributes map[string]v11.IndexedValueType `protobuf:"bytes,1,rep,name=custom_search_attributes,json=customSearchAttributes,proto3" json:"custom_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
@@ -218,11 +238,221 @@ func (x *IndexSearchAttributes) GetCustomSearchAttributes() map[string]v11.Index
 	return nil
 }
 
+// Constraints that a dynamic config override applies to. Unset fields match only callers that
+// don't use the corresponding constraint, the same as in the file-based dynamic config client.
+type DynamicConfigConstraints struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueueName string                 `protobuf:"bytes,2,opt,name=task_queue_name,json=taskQueueName,proto3" json:"task_queue_name,omitempty"`
+	TaskQueueType v11.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfigConstraints) Reset() {
+	*x = DynamicConfigConstraints{}
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfigConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfigConstraints) ProtoMessage() {}
+
+func (x *DynamicConfigConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfigConstraints.ProtoReflect.Descriptor instead.
+func (*DynamicConfigConstraints) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DynamicConfigConstraints) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DynamicConfigConstraints) GetTaskQueueName() string {
+	if x != nil {
+		return x.TaskQueueName
+	}
+	return ""
+}
+
+func (x *DynamicConfigConstraints) GetTaskQueueType() v11.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v11.TaskQueueType(0)
+}
+
+type DynamicConfigOverride struct {
+	state       protoimpl.MessageState    `protogen:"open.v1"`
+	Key         string                    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Constraints *DynamicConfigConstraints `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// Value encoded as YAML, using the same syntax as a value in the file-based dynamic config client.
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Author        string                 `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfigOverride) Reset() {
+	*x = DynamicConfigOverride{}
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfigOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfigOverride) ProtoMessage() {}
+
+func (x *DynamicConfigOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfigOverride.ProtoReflect.Descriptor instead.
+func (*DynamicConfigOverride) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DynamicConfigOverride) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DynamicConfigOverride) GetConstraints() *DynamicConfigConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *DynamicConfigOverride) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DynamicConfigOverride) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *DynamicConfigOverride) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *DynamicConfigOverride) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DynamicConfigChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The override after the change. For deletions, value is empty and update_time, author and
+	// reason describe the deletion.
+	Override *DynamicConfigOverride `protobuf:"bytes,1,opt,name=override,proto3" json:"override,omitempty"`
+	// The value before the change, empty if there was no override.
+	PreviousValue string `protobuf:"bytes,2,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	Deleted       bool   `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfigChange) Reset() {
+	*x = DynamicConfigChange{}
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfigChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfigChange) ProtoMessage() {}
+
+func (x *DynamicConfigChange) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfigChange.ProtoReflect.Descriptor instead.
+func (*DynamicConfigChange) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DynamicConfigChange) GetOverride() *DynamicConfigOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+func (x *DynamicConfigChange) GetPreviousValue() string {
+	if x != nil {
+		return x.PreviousValue
+	}
+	return ""
+}
+
+func (x *DynamicConfigChange) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
 var File_temporal_server_api_persistence_v1_cluster_metadata_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc = "" +
 	"\n" +
-	"9temporal/server/api/persistence/v1/cluster_metadata.proto\x12\"temporal.server.api.persistence.v1\x1a\"temporal/api/enums/v1/common.proto\x1a%temporal/api/version/v1/message.proto\"\x8f\b\n" +
+	"9temporal/server/api/persistence/v1/cluster_metadata.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a%temporal/api/version/v1/message.proto\"\xf3\t\n" +
 	"\x0fClusterMetadata\x12!\n" +
 	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12.\n" +
 	"\x13history_shard_count\x18\x02 \x01(\x05R\x11historyShardCount\x12\x1d\n" +
@@ -239,7 +469,9 @@ const file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc = "
 	" \x01(\bR\x13isConnectionEnabled\x129\n" +
 	"\x19use_cluster_id_membership\x18\v \x01(\bR\x16useClusterIdMembership\x12Q\n" +
 	"\x04tags\x18\f \x03(\v2=.temporal.server.api.persistence.v1.ClusterMetadata.TagsEntryR\x04tags\x124\n" +
-	"\x16is_replication_enabled\x18\x0e \x01(\bR\x14isReplicationEnabled\x1a\x83\x01\n" +
+	"\x16is_replication_enabled\x18\x0e \x01(\bR\x14isReplicationEnabled\x12s\n" +
+	"\x18dynamic_config_overrides\x18\x0f \x03(\v29.temporal.server.api.persistence.v1.DynamicConfigOverrideR\x16dynamicConfigOverrides\x12m\n" +
+	"\x16dynamic_config_history\x18\x10 \x03(\v27.temporal.server.api.persistence.v1.DynamicConfigChangeR\x14dynamicConfigHistory\x1a\x83\x01\n" +
 	"\x1aIndexSearchAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12O\n" +
 	"\x05value\x18\x02 \x01(\v29.temporal.server.api.persistence.v1.IndexSearchAttributesR\x05value:\x028\x01\x1a7\n" +
@@ -250,7 +482,23 @@ const file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc = "
 	"\x18custom_search_attributes\x18\x01 \x03(\v2U.temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntryR\x16customSearchAttributes\x1ar\n" +
 	"\x1bCustomSearchAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12=\n" +
-	"\x05value\x18\x02 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\x05value:\x028\x01B6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
+	"\x05value\x18\x02 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\x05value:\x028\x01\"\xae\x01\n" +
+	"\x18DynamicConfigConstraints\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12&\n" +
+	"\x0ftask_queue_name\x18\x02 \x01(\tR\rtaskQueueName\x12L\n" +
+	"\x0ftask_queue_type\x18\x03 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\"\x8c\x02\n" +
+	"\x15DynamicConfigOverride\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12^\n" +
+	"\vconstraints\x18\x02 \x01(\v2<.temporal.server.api.persistence.v1.DynamicConfigConstraintsR\vconstraints\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12;\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x16\n" +
+	"\x06author\x18\x05 \x01(\tR\x06author\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\xad\x01\n" +
+	"\x13DynamicConfigChange\x12U\n" +
+	"\boverride\x18\x01 \x01(\v29.temporal.server.api.persistence.v1.DynamicConfigOverrideR\boverride\x12%\n" +
+	"\x0eprevious_value\x18\x02 \x01(\tR\rpreviousValue\x12\x18\n" +
+	"\adeleted\x18\x03 \x01(\bR\adeletedB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
 
 var (
 	file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescOnce sync.Once
@@ -264,28 +512,39 @@ func file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(
 	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_temporal_server_api_persistence_v1_cluster_metadata_proto_goTypes = []any{
-	(*ClusterMetadata)(nil),       // 0: temporal.server.api.persistence.v1.ClusterMetadata
-	(*IndexSearchAttributes)(nil), // 1: temporal.server.api.persistence.v1.IndexSearchAttributes
-	nil,                           // 2: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
-	nil,                           // 3: temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
-	nil,                           // 4: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
-	(*v1.VersionInfo)(nil),        // 5: temporal.api.version.v1.VersionInfo
-	(v11.IndexedValueType)(0),     // 6: temporal.api.enums.v1.IndexedValueType
+	(*ClusterMetadata)(nil),          // 0: temporal.server.api.persistence.v1.ClusterMetadata
+	(*IndexSearchAttributes)(nil),    // 1: temporal.server.api.persistence.v1.IndexSearchAttributes
+	(*DynamicConfigConstraints)(nil), // 2: temporal.server.api.persistence.v1.DynamicConfigConstraints
+	(*DynamicConfigOverride)(nil),    // 3: temporal.server.api.persistence.v1.DynamicConfigOverride
+	(*DynamicConfigChange)(nil),      // 4: temporal.server.api.persistence.v1.DynamicConfigChange
+	nil,                              // 5: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
+	nil,                              // 6: temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
+	nil,                              // 7: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
+	(*v1.VersionInfo)(nil),           // 8: temporal.api.version.v1.VersionInfo
+	(v11.TaskQueueType)(0),           // 9: temporal.api.enums.v1.TaskQueueType
+	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
+	(v11.IndexedValueType)(0),        // 11: temporal.api.enums.v1.IndexedValueType
 }
 var file_temporal_server_api_persistence_v1_cluster_metadata_proto_depIdxs = []int32{
-	5, // 0: temporal.server.api.persistence.v1.ClusterMetadata.version_info:type_name -> temporal.api.version.v1.VersionInfo
-	2, // 1: temporal.server.api.persistence.v1.ClusterMetadata.index_search_attributes:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
-	3, // 2: temporal.server.api.persistence.v1.ClusterMetadata.tags:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
-	4, // 3: temporal.server.api.persistence.v1.IndexSearchAttributes.custom_search_attributes:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
-	1, // 4: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry.value:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes
-	6, // 5: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	8,  // 0: temporal.server.api.persistence.v1.ClusterMetadata.version_info:type_name -> temporal.api.version.v1.VersionInfo
+	5,  // 1: temporal.server.api.persistence.v1.ClusterMetadata.index_search_attributes:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
+	6,  // 2: temporal.server.api.persistence.v1.ClusterMetadata.tags:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
+	3,  // 3: temporal.server.api.persistence.v1.ClusterMetadata.dynamic_config_overrides:type_name -> temporal.server.api.persistence.v1.DynamicConfigOverride
+	4,  // 4: temporal.server.api.persistence.v1.ClusterMetadata.dynamic_config_history:type_name -> temporal.server.api.persistence.v1.DynamicConfigChange
+	7,  // 5: temporal.server.api.persistence.v1.IndexSearchAttributes.custom_search_attributes:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
+	9,  // 6: temporal.server.api.persistence.v1.DynamicConfigConstraints.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	2,  // 7: temporal.server.api.persistence.v1.DynamicConfigOverride.constraints:type_name -> temporal.server.api.persistence.v1.DynamicConfigConstraints
+	10, // 8: temporal.server.api.persistence.v1.DynamicConfigOverride.update_time:type_name -> google.protobuf.Timestamp
+	3,  // 9: temporal.server.api.persistence.v1.DynamicConfigChange.override:type_name -> temporal.server.api.persistence.v1.DynamicConfigOverride
+	1,  // 10: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry.value:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes
+	11, // 11: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_cluster_metadata_proto_init() }
@@ -299,7 +558,7 @@ func file_temporal_server_api_persistence_v1_cluster_metadata_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc), len(file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -301,6 +301,43 @@ func (this *HistoryTask) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type MatchingDLQTask to the protobuf v3 wire format
+func (val *MatchingDLQTask) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MatchingDLQTask from the protobuf v3 wire format
+func (val *MatchingDLQTask) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MatchingDLQTask) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MatchingDLQTask values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MatchingDLQTask) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MatchingDLQTask
+	switch t := that.(type) {
+	case *MatchingDLQTask:
+		that1 = t
+	case MatchingDLQTask:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type QueuePartition to the protobuf v3 wire format
 func (val *QueuePartition) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -12,6 +12,7 @@ import (
 	unsafe "unsafe"
 
 	v1 "go.temporal.io/api/common/v1"
+	v11 "go.temporal.io/api/enums/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -425,6 +426,95 @@ func (x *HistoryTask) GetBlob() *v1.DataBlob {
 	return nil
 }
 
+// MatchingDLQTask is a matching backlog task that expired or could not be delivered, stored in the DLQ of its task
+// queue. There is one DLQ per task queue name and type, shared by all partitions.
+type MatchingDLQTask struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Name of the task queue, without the partition.
+	TaskQueue     string            `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v11.TaskQueueType `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	// The task as it was read from the backlog.
+	Task *AllocatedTaskInfo `protobuf:"bytes,4,opt,name=task,proto3" json:"task,omitempty"`
+	// Why the task was dead-lettered, e.g. "expired_read". Uses the values of the reason tag of the tasks_dropped metric.
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	DeadLetterTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=dead_letter_time,json=deadLetterTime,proto3" json:"dead_letter_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MatchingDLQTask) Reset() {
+	*x = MatchingDLQTask{}
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchingDLQTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchingDLQTask) ProtoMessage() {}
+
+func (x *MatchingDLQTask) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchingDLQTask.ProtoReflect.Descriptor instead.
+func (*MatchingDLQTask) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MatchingDLQTask) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *MatchingDLQTask) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *MatchingDLQTask) GetTaskQueueType() v11.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v11.TaskQueueType(0)
+}
+
+func (x *MatchingDLQTask) GetTask() *AllocatedTaskInfo {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *MatchingDLQTask) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MatchingDLQTask) GetDeadLetterTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadLetterTime
+	}
+	return nil
+}
+
 type QueuePartition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// min_message_id is less than or equal to the id of every message in the queue. The min_message_id is mainly used to
@@ -445,7 +535,7 @@ type QueuePartition struct {
 
 func (x *QueuePartition) Reset() {
 	*x = QueuePartition{}
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +547,7 @@ func (x *QueuePartition) String() string {
 func (*QueuePartition) ProtoMessage() {}
 
 func (x *QueuePartition) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +560,7 @@ func (x *QueuePartition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueuePartition.ProtoReflect.Descriptor instead.
 func (*QueuePartition) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{8}
+	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueuePartition) GetMinMessageId() int64 {
@@ -490,7 +580,7 @@ type Queue struct {
 
 func (x *Queue) Reset() {
 	*x = Queue{}
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[9]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +592,7 @@ func (x *Queue) String() string {
 func (*Queue) ProtoMessage() {}
 
 func (x *Queue) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[9]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +605,7 @@ func (x *Queue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Queue.ProtoReflect.Descriptor instead.
 func (*Queue) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{9}
+	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Queue) GetPartitions() map[int32]*QueuePartition {
@@ -529,7 +619,7 @@ var File_temporal_server_api_persistence_v1_queues_proto protoreflect.FileDescri
 
 const file_temporal_server_api_persistence_v1_queues_proto_rawDesc = "" +
 	"\n" +
-	"/temporal/server/api/persistence/v1/queues.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a3temporal/server/api/persistence/v1/predicates.proto\"[\n" +
+	"/temporal/server/api/persistence/v1/queues.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a3temporal/server/api/persistence/v1/predicates.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\"[\n" +
 	"\aTaskKey\x127\n" +
 	"\tfire_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bfireTime\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"\xde\x02\n" +
@@ -554,7 +644,15 @@ const file_temporal_server_api_persistence_v1_queues_proto_rawDesc = "" +
 	"\x16last_read_queue_number\x18\x01 \x01(\x03R\x13lastReadQueueNumber\"^\n" +
 	"\vHistoryTask\x12\x19\n" +
 	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x124\n" +
-	"\x04blob\x18\x02 \x01(\v2 .temporal.api.common.v1.DataBlobR\x04blob\"6\n" +
+	"\x04blob\x18\x02 \x01(\v2 .temporal.api.common.v1.DataBlobR\x04blob\"\xca\x02\n" +
+	"\x0fMatchingDLQTask\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12L\n" +
+	"\x0ftask_queue_type\x18\x03 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12I\n" +
+	"\x04task\x18\x04 \x01(\v25.temporal.server.api.persistence.v1.AllocatedTaskInfoR\x04task\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12D\n" +
+	"\x10dead_letter_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0edeadLetterTime\"6\n" +
 	"\x0eQueuePartition\x12$\n" +
 	"\x0emin_message_id\x18\x01 \x01(\x03R\fminMessageId\"\xd5\x01\n" +
 	"\x05Queue\x12Y\n" +
@@ -577,7 +675,7 @@ func file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_persistence_v1_queues_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_temporal_server_api_persistence_v1_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_temporal_server_api_persistence_v1_queues_proto_goTypes = []any{
 	(*TaskKey)(nil),                        // 0: temporal.server.api.persistence.v1.TaskKey
 	(*QueueState)(nil),                     // 1: temporal.server.api.persistence.v1.QueueState
@@ -587,32 +685,38 @@ var file_temporal_server_api_persistence_v1_queues_proto_goTypes = []any{
 	(*ReadQueueMessagesNextPageToken)(nil), // 5: temporal.server.api.persistence.v1.ReadQueueMessagesNextPageToken
 	(*ListQueuesNextPageToken)(nil),        // 6: temporal.server.api.persistence.v1.ListQueuesNextPageToken
 	(*HistoryTask)(nil),                    // 7: temporal.server.api.persistence.v1.HistoryTask
-	(*QueuePartition)(nil),                 // 8: temporal.server.api.persistence.v1.QueuePartition
-	(*Queue)(nil),                          // 9: temporal.server.api.persistence.v1.Queue
-	nil,                                    // 10: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
-	nil,                                    // 11: temporal.server.api.persistence.v1.Queue.PartitionsEntry
-	(*timestamppb.Timestamp)(nil),          // 12: google.protobuf.Timestamp
-	(*Predicate)(nil),                      // 13: temporal.server.api.persistence.v1.Predicate
-	(*v1.DataBlob)(nil),                    // 14: temporal.api.common.v1.DataBlob
+	(*MatchingDLQTask)(nil),                // 8: temporal.server.api.persistence.v1.MatchingDLQTask
+	(*QueuePartition)(nil),                 // 9: temporal.server.api.persistence.v1.QueuePartition
+	(*Queue)(nil),                          // 10: temporal.server.api.persistence.v1.Queue
+	nil,                                    // 11: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
+	nil,                                    // 12: temporal.server.api.persistence.v1.Queue.PartitionsEntry
+	(*timestamppb.Timestamp)(nil),          // 13: google.protobuf.Timestamp
+	(*Predicate)(nil),                      // 14: temporal.server.api.persistence.v1.Predicate
+	(*v1.DataBlob)(nil),                    // 15: temporal.api.common.v1.DataBlob
+	(v11.TaskQueueType)(0),                 // 16: temporal.api.enums.v1.TaskQueueType
+	(*AllocatedTaskInfo)(nil),              // 17: temporal.server.api.persistence.v1.AllocatedTaskInfo
 }
 var file_temporal_server_api_persistence_v1_queues_proto_depIdxs = []int32{
-	12, // 0: temporal.server.api.persistence.v1.TaskKey.fire_time:type_name -> google.protobuf.Timestamp
-	10, // 1: temporal.server.api.persistence.v1.QueueState.reader_states:type_name -> temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
+	13, // 0: temporal.server.api.persistence.v1.TaskKey.fire_time:type_name -> google.protobuf.Timestamp
+	11, // 1: temporal.server.api.persistence.v1.QueueState.reader_states:type_name -> temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
 	0,  // 2: temporal.server.api.persistence.v1.QueueState.exclusive_reader_high_watermark:type_name -> temporal.server.api.persistence.v1.TaskKey
 	3,  // 3: temporal.server.api.persistence.v1.QueueReaderState.scopes:type_name -> temporal.server.api.persistence.v1.QueueSliceScope
 	4,  // 4: temporal.server.api.persistence.v1.QueueSliceScope.range:type_name -> temporal.server.api.persistence.v1.QueueSliceRange
-	13, // 5: temporal.server.api.persistence.v1.QueueSliceScope.predicate:type_name -> temporal.server.api.persistence.v1.Predicate
+	14, // 5: temporal.server.api.persistence.v1.QueueSliceScope.predicate:type_name -> temporal.server.api.persistence.v1.Predicate
 	0,  // 6: temporal.server.api.persistence.v1.QueueSliceRange.inclusive_min:type_name -> temporal.server.api.persistence.v1.TaskKey
 	0,  // 7: temporal.server.api.persistence.v1.QueueSliceRange.exclusive_max:type_name -> temporal.server.api.persistence.v1.TaskKey
-	14, // 8: temporal.server.api.persistence.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
-	11, // 9: temporal.server.api.persistence.v1.Queue.partitions:type_name -> temporal.server.api.persistence.v1.Queue.PartitionsEntry
-	2,  // 10: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry.value:type_name -> temporal.server.api.persistence.v1.QueueReaderState
-	8,  // 11: temporal.server.api.persistence.v1.Queue.PartitionsEntry.value:type_name -> temporal.server.api.persistence.v1.QueuePartition
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	15, // 8: temporal.server.api.persistence.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
+	16, // 9: temporal.server.api.persistence.v1.MatchingDLQTask.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	17, // 10: temporal.server.api.persistence.v1.MatchingDLQTask.task:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
+	13, // 11: temporal.server.api.persistence.v1.MatchingDLQTask.dead_letter_time:type_name -> google.protobuf.Timestamp
+	12, // 12: temporal.server.api.persistence.v1.Queue.partitions:type_name -> temporal.server.api.persistence.v1.Queue.PartitionsEntry
+	2,  // 13: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry.value:type_name -> temporal.server.api.persistence.v1.QueueReaderState
+	9,  // 14: temporal.server.api.persistence.v1.Queue.PartitionsEntry.value:type_name -> temporal.server.api.persistence.v1.QueuePartition
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_queues_proto_init() }
@@ -621,13 +725,14 @@ func file_temporal_server_api_persistence_v1_queues_proto_init() {
 		return
 	}
 	file_temporal_server_api_persistence_v1_predicates_proto_init()
+	file_temporal_server_api_persistence_v1_tasks_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_queues_proto_rawDesc), len(file_temporal_server_api_persistence_v1_queues_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
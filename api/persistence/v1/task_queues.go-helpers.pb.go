@@ -301,6 +301,43 @@ func (this *TaskQueueTypeUserData) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type TaskQueueDispatchPause to the protobuf v3 wire format
+func (val *TaskQueueDispatchPause) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueueDispatchPause from the protobuf v3 wire format
+func (val *TaskQueueDispatchPause) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueueDispatchPause) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueueDispatchPause values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueueDispatchPause) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueueDispatchPause
+	switch t := that.(type) {
+	case *TaskQueueDispatchPause:
+		that1 = t
+	case TaskQueueDispatchPause:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TaskQueueUserData to the protobuf v3 wire format
 func (val *TaskQueueUserData) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
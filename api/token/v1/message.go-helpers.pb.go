@@ -263,3 +263,77 @@ func (this *NexusOperationCompletion) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type VisibilityWatch to the protobuf v3 wire format
+func (val *VisibilityWatch) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityWatch from the protobuf v3 wire format
+func (val *VisibilityWatch) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityWatch) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityWatch values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityWatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityWatch
+	switch t := that.(type) {
+	case *VisibilityWatch:
+		that1 = t
+	case VisibilityWatch:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type VisibilityWatchHostPosition to the protobuf v3 wire format
+func (val *VisibilityWatchHostPosition) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityWatchHostPosition from the protobuf v3 wire format
+func (val *VisibilityWatchHostPosition) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityWatchHostPosition) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityWatchHostPosition values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityWatchHostPosition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityWatchHostPosition
+	switch t := that.(type) {
+	case *VisibilityWatchHostPosition:
+		that1 = t
+	case VisibilityWatchHostPosition:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
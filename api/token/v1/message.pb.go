@@ -14,6 +14,7 @@ import (
 	v12 "go.temporal.io/server/api/clock/v1"
 	v1 "go.temporal.io/server/api/history/v1"
 	v11 "go.temporal.io/server/api/persistence/v1"
+	v13 "go.temporal.io/server/api/taskqueue/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -250,9 +251,12 @@ type Task struct {
 	StartedTime      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
 	StartVersion     int64                  `protobuf:"varint,13,opt,name=start_version,json=startVersion,proto3" json:"start_version,omitempty"`
 	// Reference to the associated Chasm component, if provided.
-	ComponentRef  []byte `protobuf:"bytes,14,opt,name=component_ref,json=componentRef,proto3" json:"component_ref,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ComponentRef []byte `protobuf:"bytes,14,opt,name=component_ref,json=componentRef,proto3" json:"component_ref,omitempty"`
+	// Task queue partition that holds a fairness key concurrency slot for this activity task, if
+	// any. The slot is released on that partition when the worker responds to the task.
+	ConcurrencySlotPartition *v13.TaskQueuePartition `protobuf:"bytes,15,opt,name=concurrency_slot_partition,json=concurrencySlotPartition,proto3" json:"concurrency_slot_partition,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *Task) Reset() {
@@ -383,6 +387,13 @@ func (x *Task) GetComponentRef() []byte {
 	return nil
 }
 
+func (x *Task) GetConcurrencySlotPartition() *v13.TaskQueuePartition {
+	if x != nil {
+		return x.ConcurrencySlotPartition
+	}
+	return nil
+}
+
 type QueryTask struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -651,11 +662,117 @@ func (x *NexusOperationCompletion) GetComponentRef() []byte {
 	return nil
 }
 
+// Resume token of a stream of visibility watch events.
+type VisibilityWatch struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Hosts         []*VisibilityWatchHostPosition `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisibilityWatch) Reset() {
+	*x = VisibilityWatch{}
+	mi := &file_temporal_server_api_token_v1_message_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisibilityWatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisibilityWatch) ProtoMessage() {}
+
+func (x *VisibilityWatch) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_token_v1_message_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisibilityWatch.ProtoReflect.Descriptor instead.
+func (*VisibilityWatch) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_token_v1_message_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VisibilityWatch) GetHosts() []*VisibilityWatchHostPosition {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+// Position of a visibility watch in the event log of a history host.
+type VisibilityWatchHostPosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostAddress   string                 `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	Epoch         int64                  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	LastSequence  int64                  `protobuf:"varint,3,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisibilityWatchHostPosition) Reset() {
+	*x = VisibilityWatchHostPosition{}
+	mi := &file_temporal_server_api_token_v1_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisibilityWatchHostPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisibilityWatchHostPosition) ProtoMessage() {}
+
+func (x *VisibilityWatchHostPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_token_v1_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisibilityWatchHostPosition.ProtoReflect.Descriptor instead.
+func (*VisibilityWatchHostPosition) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_token_v1_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VisibilityWatchHostPosition) GetHostAddress() string {
+	if x != nil {
+		return x.HostAddress
+	}
+	return ""
+}
+
+func (x *VisibilityWatchHostPosition) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *VisibilityWatchHostPosition) GetLastSequence() int64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
 var File_temporal_server_api_token_v1_message_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_token_v1_message_proto_rawDesc = "" +
 	"\n" +
-	"*temporal/server/api/token/v1/message.proto\x12\x1ctemporal.server.api.token.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a*temporal/server/api/clock/v1/message.proto\x1a,temporal/server/api/history/v1/message.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\"\xd4\x03\n" +
+	"*temporal/server/api/token/v1/message.proto\x12\x1ctemporal.server.api.token.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a*temporal/server/api/clock/v1/message.proto\x1a,temporal/server/api/history/v1/message.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\xd4\x03\n" +
 	"\x13HistoryContinuation\x12\x15\n" +
 	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12$\n" +
 	"\x0efirst_event_id\x18\x02 \x01(\x03R\ffirstEventId\x12\"\n" +
@@ -679,7 +796,7 @@ const file_temporal_server_api_token_v1_message_proto_rawDesc = "" +
 	"endEventId\x12*\n" +
 	"\x11end_event_version\x18\a \x01(\x03R\x0fendEventVersion\x12+\n" +
 	"\x11persistence_token\x18\b \x01(\fR\x10persistenceToken\x12]\n" +
-	"\x11version_histories\x18\t \x01(\v20.temporal.server.api.history.v1.VersionHistoriesR\x10versionHistoriesJ\x04\b\x01\x10\x02\"\xa2\x04\n" +
+	"\x11version_histories\x18\t \x01(\v20.temporal.server.api.history.v1.VersionHistoriesR\x10versionHistoriesJ\x04\b\x01\x10\x02\"\x96\x05\n" +
 	"\x04Task\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vworkflow_id\x18\x02 \x01(\tR\n" +
@@ -697,7 +814,8 @@ const file_temporal_server_api_token_v1_message_proto_rawDesc = "" +
 	"\aversion\x18\v \x01(\x03R\aversion\x12=\n" +
 	"\fstarted_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vstartedTime\x12#\n" +
 	"\rstart_version\x18\r \x01(\x03R\fstartVersion\x12#\n" +
-	"\rcomponent_ref\x18\x0e \x01(\fR\fcomponentRef\"f\n" +
+	"\rcomponent_ref\x18\x0e \x01(\fR\fcomponentRef\x12r\n" +
+	"\x1aconcurrency_slot_partition\x18\x0f \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x18concurrencySlotPartition\"f\n" +
 	"\tQueryTask\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1d\n" +
 	"\n" +
@@ -719,7 +837,13 @@ const file_temporal_server_api_token_v1_message_proto_rawDesc = "" +
 	"\x03ref\x18\x04 \x01(\v23.temporal.server.api.persistence.v1.StateMachineRefR\x03ref\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x05 \x01(\tR\trequestId\x12#\n" +
-	"\rcomponent_ref\x18\x06 \x01(\fR\fcomponentRefB*Z(go.temporal.io/server/api/token/v1;tokenb\x06proto3"
+	"\rcomponent_ref\x18\x06 \x01(\fR\fcomponentRef\"b\n" +
+	"\x0fVisibilityWatch\x12O\n" +
+	"\x05hosts\x18\x01 \x03(\v29.temporal.server.api.token.v1.VisibilityWatchHostPositionR\x05hosts\"{\n" +
+	"\x1bVisibilityWatchHostPosition\x12!\n" +
+	"\fhost_address\x18\x01 \x01(\tR\vhostAddress\x12\x14\n" +
+	"\x05epoch\x18\x02 \x01(\x03R\x05epoch\x12#\n" +
+	"\rlast_sequence\x18\x03 \x01(\x03R\flastSequenceB*Z(go.temporal.io/server/api/token/v1;tokenb\x06proto3"
 
 var (
 	file_temporal_server_api_token_v1_message_proto_rawDescOnce sync.Once
@@ -733,34 +857,39 @@ func file_temporal_server_api_token_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_token_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_token_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_temporal_server_api_token_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_temporal_server_api_token_v1_message_proto_goTypes = []any{
-	(*HistoryContinuation)(nil),      // 0: temporal.server.api.token.v1.HistoryContinuation
-	(*RawHistoryContinuation)(nil),   // 1: temporal.server.api.token.v1.RawHistoryContinuation
-	(*Task)(nil),                     // 2: temporal.server.api.token.v1.Task
-	(*QueryTask)(nil),                // 3: temporal.server.api.token.v1.QueryTask
-	(*NexusTask)(nil),                // 4: temporal.server.api.token.v1.NexusTask
-	(*HistoryEventRef)(nil),          // 5: temporal.server.api.token.v1.HistoryEventRef
-	(*NexusOperationCompletion)(nil), // 6: temporal.server.api.token.v1.NexusOperationCompletion
-	(*v1.VersionHistoryItem)(nil),    // 7: temporal.server.api.history.v1.VersionHistoryItem
-	(*v11.VersionedTransition)(nil),  // 8: temporal.server.api.persistence.v1.VersionedTransition
-	(*v1.VersionHistories)(nil),      // 9: temporal.server.api.history.v1.VersionHistories
-	(*v12.VectorClock)(nil),          // 10: temporal.server.api.clock.v1.VectorClock
-	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
-	(*v11.StateMachineRef)(nil),      // 12: temporal.server.api.persistence.v1.StateMachineRef
+	(*HistoryContinuation)(nil),         // 0: temporal.server.api.token.v1.HistoryContinuation
+	(*RawHistoryContinuation)(nil),      // 1: temporal.server.api.token.v1.RawHistoryContinuation
+	(*Task)(nil),                        // 2: temporal.server.api.token.v1.Task
+	(*QueryTask)(nil),                   // 3: temporal.server.api.token.v1.QueryTask
+	(*NexusTask)(nil),                   // 4: temporal.server.api.token.v1.NexusTask
+	(*HistoryEventRef)(nil),             // 5: temporal.server.api.token.v1.HistoryEventRef
+	(*NexusOperationCompletion)(nil),    // 6: temporal.server.api.token.v1.NexusOperationCompletion
+	(*VisibilityWatch)(nil),             // 7: temporal.server.api.token.v1.VisibilityWatch
+	(*VisibilityWatchHostPosition)(nil), // 8: temporal.server.api.token.v1.VisibilityWatchHostPosition
+	(*v1.VersionHistoryItem)(nil),       // 9: temporal.server.api.history.v1.VersionHistoryItem
+	(*v11.VersionedTransition)(nil),     // 10: temporal.server.api.persistence.v1.VersionedTransition
+	(*v1.VersionHistories)(nil),         // 11: temporal.server.api.history.v1.VersionHistories
+	(*v12.VectorClock)(nil),             // 12: temporal.server.api.clock.v1.VectorClock
+	(*timestamppb.Timestamp)(nil),       // 13: google.protobuf.Timestamp
+	(*v13.TaskQueuePartition)(nil),      // 14: temporal.server.api.taskqueue.v1.TaskQueuePartition
+	(*v11.StateMachineRef)(nil),         // 15: temporal.server.api.persistence.v1.StateMachineRef
 }
 var file_temporal_server_api_token_v1_message_proto_depIdxs = []int32{
-	7,  // 0: temporal.server.api.token.v1.HistoryContinuation.version_history_item:type_name -> temporal.server.api.history.v1.VersionHistoryItem
-	8,  // 1: temporal.server.api.token.v1.HistoryContinuation.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	9,  // 2: temporal.server.api.token.v1.RawHistoryContinuation.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
-	10, // 3: temporal.server.api.token.v1.Task.clock:type_name -> temporal.server.api.clock.v1.VectorClock
-	11, // 4: temporal.server.api.token.v1.Task.started_time:type_name -> google.protobuf.Timestamp
-	12, // 5: temporal.server.api.token.v1.NexusOperationCompletion.ref:type_name -> temporal.server.api.persistence.v1.StateMachineRef
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	9,  // 0: temporal.server.api.token.v1.HistoryContinuation.version_history_item:type_name -> temporal.server.api.history.v1.VersionHistoryItem
+	10, // 1: temporal.server.api.token.v1.HistoryContinuation.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	11, // 2: temporal.server.api.token.v1.RawHistoryContinuation.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
+	12, // 3: temporal.server.api.token.v1.Task.clock:type_name -> temporal.server.api.clock.v1.VectorClock
+	13, // 4: temporal.server.api.token.v1.Task.started_time:type_name -> google.protobuf.Timestamp
+	14, // 5: temporal.server.api.token.v1.Task.concurrency_slot_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	15, // 6: temporal.server.api.token.v1.NexusOperationCompletion.ref:type_name -> temporal.server.api.persistence.v1.StateMachineRef
+	8,  // 7: temporal.server.api.token.v1.VisibilityWatch.hosts:type_name -> temporal.server.api.token.v1.VisibilityWatchHostPosition
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_token_v1_message_proto_init() }
@@ -774,7 +903,7 @@ func file_temporal_server_api_token_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_token_v1_message_proto_rawDesc), len(file_temporal_server_api_token_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -49,6 +49,26 @@ func (c *clientImpl) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *clientImpl) CancelVisibilityDriftCheck(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityDriftCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelVisibilityDriftCheckResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CancelVisibilityDriftCheck(ctx, request, opts...)
+}
+
+func (c *clientImpl) CancelVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelVisibilityRebuildResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CancelVisibilityRebuild(ctx, request, opts...)
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -69,6 +89,26 @@ func (c *clientImpl) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteDynamicConfigOverride(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteVisibilityView(
+	ctx context.Context,
+	request *adminservice.DeleteVisibilityViewRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteVisibilityViewResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteVisibilityView(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -129,6 +169,46 @@ func (c *clientImpl) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeVisibilityDriftCheck(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityDriftCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityDriftCheckResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeVisibilityDriftCheck(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityRebuildResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeVisibilityRebuild(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeVisibilityView(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityViewRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityViewResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeVisibilityView(ctx, request, opts...)
+}
+
+func (c *clientImpl) ExplainDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ExplainDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExplainDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ExplainDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -179,6 +259,26 @@ func (c *clientImpl) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigHistoryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDynamicConfigHistory(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigOverridesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDynamicConfigOverrides(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -319,6 +419,26 @@ func (c *clientImpl) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListTaskQueueDLQTasks(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueueDLQTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListTaskQueueDLQTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListVisibilityViews(
+	ctx context.Context,
+	request *adminservice.ListVisibilityViewsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListVisibilityViewsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListVisibilityViews(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -349,6 +469,16 @@ func (c *clientImpl) MigrateSchedule(
 	return c.client.MigrateSchedule(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseTaskQueueDispatchResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PauseTaskQueueDispatch(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -389,6 +519,16 @@ func (c *clientImpl) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) ReenqueueTaskQueueDLQTasks(
+	ctx context.Context,
+	request *adminservice.ReenqueueTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ReenqueueTaskQueueDLQTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -439,6 +579,46 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) RestoreArchivedWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreArchivedWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RestoreArchivedWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResumeTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResumeTaskQueueDispatchResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResumeTaskQueueDispatch(ctx, request, opts...)
+}
+
+func (c *clientImpl) SaveVisibilityView(
+	ctx context.Context,
+	request *adminservice.SaveVisibilityViewRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SaveVisibilityViewResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SaveVisibilityView(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetDynamicConfigOverride(ctx, request, opts...)
+}
+
 func (c *clientImpl) StartAdminBatchOperation(
 	ctx context.Context,
 	request *adminservice.StartAdminBatchOperationRequest,
@@ -449,6 +629,26 @@ func (c *clientImpl) StartAdminBatchOperation(
 	return c.client.StartAdminBatchOperation(ctx, request, opts...)
 }
 
+func (c *clientImpl) StartVisibilityDriftCheck(
+	ctx context.Context,
+	request *adminservice.StartVisibilityDriftCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityDriftCheckResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartVisibilityDriftCheck(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.StartVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityRebuildResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartVisibilityRebuild(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
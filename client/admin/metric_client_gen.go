@@ -65,6 +65,34 @@ func (c *metricClient) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *metricClient) CancelVisibilityDriftCheck(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityDriftCheckRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CancelVisibilityDriftCheckResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCancelVisibilityDriftCheck")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CancelVisibilityDriftCheck(ctx, request, opts...)
+}
+
+func (c *metricClient) CancelVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CancelVisibilityRebuildResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCancelVisibilityRebuild")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CancelVisibilityRebuild(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -93,6 +121,34 @@ func (c *metricClient) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteDynamicConfigOverrideResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteDynamicConfigOverride")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteDynamicConfigOverride(ctx, request, opts...)
+}
+
+func (c *metricClient) DeleteVisibilityView(
+	ctx context.Context,
+	request *adminservice.DeleteVisibilityViewRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteVisibilityViewResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteVisibilityView")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteVisibilityView(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -177,6 +233,62 @@ func (c *metricClient) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeVisibilityDriftCheck(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityDriftCheckRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeVisibilityDriftCheckResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeVisibilityDriftCheck")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeVisibilityDriftCheck(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeVisibilityRebuildResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeVisibilityRebuild")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeVisibilityRebuild(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeVisibilityView(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityViewRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeVisibilityViewResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeVisibilityView")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeVisibilityView(ctx, request, opts...)
+}
+
+func (c *metricClient) ExplainDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ExplainDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ExplainDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientExplainDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExplainDynamicConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -247,6 +359,34 @@ func (c *metricClient) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) GetDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigHistoryRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetDynamicConfigHistoryResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetDynamicConfigHistory")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetDynamicConfigHistory(ctx, request, opts...)
+}
+
+func (c *metricClient) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetDynamicConfigOverridesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetDynamicConfigOverrides")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetDynamicConfigOverrides(ctx, request, opts...)
+}
+
 func (c *metricClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -443,6 +583,34 @@ func (c *metricClient) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *metricClient) ListTaskQueueDLQTasks(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListTaskQueueDLQTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListTaskQueueDLQTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListTaskQueueDLQTasks(ctx, request, opts...)
+}
+
+func (c *metricClient) ListVisibilityViews(
+	ctx context.Context,
+	request *adminservice.ListVisibilityViewsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListVisibilityViewsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListVisibilityViews")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListVisibilityViews(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -485,6 +653,20 @@ func (c *metricClient) MigrateSchedule(
 	return c.client.MigrateSchedule(ctx, request, opts...)
 }
 
+func (c *metricClient) PauseTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PauseTaskQueueDispatchResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientPauseTaskQueueDispatch")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseTaskQueueDispatch(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -541,6 +723,20 @@ func (c *metricClient) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) ReenqueueTaskQueueDLQTasks(
+	ctx context.Context,
+	request *adminservice.ReenqueueTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ReenqueueTaskQueueDLQTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientReenqueueTaskQueueDLQTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReenqueueTaskQueueDLQTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -611,6 +807,62 @@ func (c *metricClient) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) RestoreArchivedWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreArchivedWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RestoreArchivedWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRestoreArchivedWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RestoreArchivedWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) ResumeTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ResumeTaskQueueDispatchResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientResumeTaskQueueDispatch")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ResumeTaskQueueDispatch(ctx, request, opts...)
+}
+
+func (c *metricClient) SaveVisibilityView(
+	ctx context.Context,
+	request *adminservice.SaveVisibilityViewRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SaveVisibilityViewResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSaveVisibilityView")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SaveVisibilityView(ctx, request, opts...)
+}
+
+func (c *metricClient) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetDynamicConfigOverrideResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetDynamicConfigOverride")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetDynamicConfigOverride(ctx, request, opts...)
+}
+
 func (c *metricClient) StartAdminBatchOperation(
 	ctx context.Context,
 	request *adminservice.StartAdminBatchOperationRequest,
@@ -625,6 +877,34 @@ func (c *metricClient) StartAdminBatchOperation(
 	return c.client.StartAdminBatchOperation(ctx, request, opts...)
 }
 
+func (c *metricClient) StartVisibilityDriftCheck(
+	ctx context.Context,
+	request *adminservice.StartVisibilityDriftCheckRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartVisibilityDriftCheckResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartVisibilityDriftCheck")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartVisibilityDriftCheck(ctx, request, opts...)
+}
+
+func (c *metricClient) StartVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.StartVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartVisibilityRebuildResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartVisibilityRebuild")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartVisibilityRebuild(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -71,6 +71,36 @@ func (c *retryableClient) CancelDLQJob(
 	return resp, err
 }
 
+func (c *retryableClient) CancelVisibilityDriftCheck(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityDriftCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelVisibilityDriftCheckResponse, error) {
+	var resp *adminservice.CancelVisibilityDriftCheckResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CancelVisibilityDriftCheck(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) CancelVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelVisibilityRebuildResponse, error) {
+	var resp *adminservice.CancelVisibilityRebuildResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CancelVisibilityRebuild(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -101,6 +131,36 @@ func (c *retryableClient) DeepHealthCheck(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigOverrideResponse, error) {
+	var resp *adminservice.DeleteDynamicConfigOverrideResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteDynamicConfigOverride(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteVisibilityView(
+	ctx context.Context,
+	request *adminservice.DeleteVisibilityViewRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteVisibilityViewResponse, error) {
+	var resp *adminservice.DeleteVisibilityViewResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteVisibilityView(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -191,6 +251,66 @@ func (c *retryableClient) DescribeTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeVisibilityDriftCheck(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityDriftCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityDriftCheckResponse, error) {
+	var resp *adminservice.DescribeVisibilityDriftCheckResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeVisibilityDriftCheck(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityRebuildResponse, error) {
+	var resp *adminservice.DescribeVisibilityRebuildResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeVisibilityRebuild(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeVisibilityView(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityViewRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityViewResponse, error) {
+	var resp *adminservice.DescribeVisibilityViewResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeVisibilityView(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ExplainDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ExplainDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExplainDynamicConfigResponse, error) {
+	var resp *adminservice.ExplainDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExplainDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -266,6 +386,36 @@ func (c *retryableClient) GetDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) GetDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigHistoryResponse, error) {
+	var resp *adminservice.GetDynamicConfigHistoryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDynamicConfigHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigOverridesResponse, error) {
+	var resp *adminservice.GetDynamicConfigOverridesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDynamicConfigOverrides(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -476,6 +626,36 @@ func (c *retryableClient) ListQueues(
 	return resp, err
 }
 
+func (c *retryableClient) ListTaskQueueDLQTasks(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueueDLQTasksResponse, error) {
+	var resp *adminservice.ListTaskQueueDLQTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskQueueDLQTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListVisibilityViews(
+	ctx context.Context,
+	request *adminservice.ListVisibilityViewsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListVisibilityViewsResponse, error) {
+	var resp *adminservice.ListVisibilityViewsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListVisibilityViews(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -521,6 +701,21 @@ func (c *retryableClient) MigrateSchedule(
 	return resp, err
 }
 
+func (c *retryableClient) PauseTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseTaskQueueDispatchResponse, error) {
+	var resp *adminservice.PauseTaskQueueDispatchResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseTaskQueueDispatch(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -581,6 +776,21 @@ func (c *retryableClient) RebuildMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) ReenqueueTaskQueueDLQTasks(
+	ctx context.Context,
+	request *adminservice.ReenqueueTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+	var resp *adminservice.ReenqueueTaskQueueDLQTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReenqueueTaskQueueDLQTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -656,6 +866,66 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) RestoreArchivedWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreArchivedWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	var resp *adminservice.RestoreArchivedWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RestoreArchivedWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ResumeTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueDispatchRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResumeTaskQueueDispatchResponse, error) {
+	var resp *adminservice.ResumeTaskQueueDispatchResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResumeTaskQueueDispatch(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SaveVisibilityView(
+	ctx context.Context,
+	request *adminservice.SaveVisibilityViewRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SaveVisibilityViewResponse, error) {
+	var resp *adminservice.SaveVisibilityViewResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SaveVisibilityView(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigOverrideResponse, error) {
+	var resp *adminservice.SetDynamicConfigOverrideResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetDynamicConfigOverride(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) StartAdminBatchOperation(
 	ctx context.Context,
 	request *adminservice.StartAdminBatchOperationRequest,
@@ -671,6 +941,36 @@ func (c *retryableClient) StartAdminBatchOperation(
 	return resp, err
 }
 
+func (c *retryableClient) StartVisibilityDriftCheck(
+	ctx context.Context,
+	request *adminservice.StartVisibilityDriftCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityDriftCheckResponse, error) {
+	var resp *adminservice.StartVisibilityDriftCheckResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartVisibilityDriftCheck(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.StartVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityRebuildResponse, error) {
+	var resp *adminservice.StartVisibilityRebuildResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartVisibilityRebuild(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -82,6 +82,18 @@ func (c *clientImpl) DeepHealthCheck(ctx context.Context, request *historyservic
 	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) ExplainDynamicConfig(ctx context.Context, request *historyservice.ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*historyservice.ExplainDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.ExplainDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) PollVisibilityWatchEvents(ctx context.Context, request *historyservice.PollVisibilityWatchEventsRequest, opts ...grpc.CallOption) (*historyservice.PollVisibilityWatchEventsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.PollVisibilityWatchEvents(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *historyservice.DescribeHistoryHostRequest,
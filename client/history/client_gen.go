@@ -779,6 +779,26 @@ func (c *clientImpl) RebuildMutableState(
 	return response, nil
 }
 
+func (c *clientImpl) RebuildWorkflowVisibility(
+	ctx context.Context,
+	request *historyservice.RebuildWorkflowVisibilityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RebuildWorkflowVisibilityResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	var response *historyservice.RebuildWorkflowVisibilityResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RebuildWorkflowVisibility(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *historyservice.RecordActivityTaskHeartbeatRequest,
@@ -191,6 +191,20 @@ func (c *metricClient) ExecuteMultiOperation(
 	return c.client.ExecuteMultiOperation(ctx, request, opts...)
 }
 
+func (c *metricClient) ExplainDynamicConfig(
+	ctx context.Context,
+	request *historyservice.ExplainDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ExplainDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientExplainDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExplainDynamicConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceDeleteWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.ForceDeleteWorkflowExecutionRequest,
@@ -513,6 +527,20 @@ func (c *metricClient) PollMutableState(
 	return c.client.PollMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) PollVisibilityWatchEvents(
+	ctx context.Context,
+	request *historyservice.PollVisibilityWatchEventsRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.PollVisibilityWatchEventsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientPollVisibilityWatchEvents")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PollVisibilityWatchEvents(ctx, request, opts...)
+}
+
 func (c *metricClient) PollWorkflowExecutionUpdate(
 	ctx context.Context,
 	request *historyservice.PollWorkflowExecutionUpdateRequest,
@@ -583,6 +611,20 @@ func (c *metricClient) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) RebuildWorkflowVisibility(
+	ctx context.Context,
+	request *historyservice.RebuildWorkflowVisibilityRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.RebuildWorkflowVisibilityResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientRebuildWorkflowVisibility")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RebuildWorkflowVisibility(ctx, request, opts...)
+}
+
 func (c *metricClient) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *historyservice.RecordActivityTaskHeartbeatRequest,
@@ -221,6 +221,21 @@ func (c *retryableClient) ExecuteMultiOperation(
 	return resp, err
 }
 
+func (c *retryableClient) ExplainDynamicConfig(
+	ctx context.Context,
+	request *historyservice.ExplainDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ExplainDynamicConfigResponse, error) {
+	var resp *historyservice.ExplainDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExplainDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceDeleteWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.ForceDeleteWorkflowExecutionRequest,
@@ -566,6 +581,21 @@ func (c *retryableClient) PollMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) PollVisibilityWatchEvents(
+	ctx context.Context,
+	request *historyservice.PollVisibilityWatchEventsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PollVisibilityWatchEventsResponse, error) {
+	var resp *historyservice.PollVisibilityWatchEventsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PollVisibilityWatchEvents(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollWorkflowExecutionUpdate(
 	ctx context.Context,
 	request *historyservice.PollWorkflowExecutionUpdateRequest,
@@ -641,6 +671,21 @@ func (c *retryableClient) RebuildMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) RebuildWorkflowVisibility(
+	ctx context.Context,
+	request *historyservice.RebuildWorkflowVisibilityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RebuildWorkflowVisibilityResponse, error) {
+	var resp *historyservice.RebuildWorkflowVisibilityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RebuildWorkflowVisibility(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *historyservice.RecordActivityTaskHeartbeatRequest,
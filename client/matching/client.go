@@ -494,6 +494,20 @@ func (c *clientImpl) pickClientForRead(
 	return client, release, err
 }
 
+func (c *clientImpl) ExplainDynamicConfig(
+	ctx context.Context,
+	request *matchingservice.ExplainDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ExplainDynamicConfigResponse, error) {
+	client, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.(matchingservice.MatchingServiceClient).ExplainDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -404,6 +404,26 @@ func (c *clientImpl) ListNexusEndpoints(
 	return client.ListNexusEndpoints(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListTaskQueueDLQTasks(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListTaskQueueDLQTasksResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListTaskQueueDLQTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListTaskQueuePartitions(
 	ctx context.Context,
 	request *matchingservice.ListTaskQueuePartitionsRequest,
@@ -464,6 +484,43 @@ func (c *clientImpl) RecordWorkerHeartbeat(
 	return client.RecordWorkerHeartbeat(ctx, request, opts...)
 }
 
+func (c *clientImpl) ReenqueueTaskQueueDLQTasks(
+	ctx context.Context,
+	request *matchingservice.ReenqueueTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReenqueueTaskQueueDLQTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) ReleaseConcurrencySlot(
+	ctx context.Context,
+	request *matchingservice.ReleaseConcurrencySlotRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ReleaseConcurrencySlotResponse, error) {
+
+	p := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReleaseConcurrencySlot(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -624,6 +681,26 @@ func (c *clientImpl) UpdateTaskQueueConfig(
 	return client.UpdateTaskQueueConfig(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateTaskQueueDispatchPause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueDispatchPauseRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateTaskQueueDispatchPause(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
@@ -177,6 +177,20 @@ func (c *metricClient) DescribeWorker(
 	return c.client.DescribeWorker(ctx, request, opts...)
 }
 
+func (c *metricClient) ExplainDynamicConfig(
+	ctx context.Context,
+	request *matchingservice.ExplainDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ExplainDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientExplainDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExplainDynamicConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceLoadTaskQueuePartition(
 	ctx context.Context,
 	request *matchingservice.ForceLoadTaskQueuePartitionRequest,
@@ -289,6 +303,20 @@ func (c *metricClient) ListNexusEndpoints(
 	return c.client.ListNexusEndpoints(ctx, request, opts...)
 }
 
+func (c *metricClient) ListTaskQueueDLQTasks(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ListTaskQueueDLQTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientListTaskQueueDLQTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListTaskQueueDLQTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) ListTaskQueuePartitions(
 	ctx context.Context,
 	request *matchingservice.ListTaskQueuePartitionsRequest,
@@ -331,6 +359,34 @@ func (c *metricClient) RecordWorkerHeartbeat(
 	return c.client.RecordWorkerHeartbeat(ctx, request, opts...)
 }
 
+func (c *metricClient) ReenqueueTaskQueueDLQTasks(
+	ctx context.Context,
+	request *matchingservice.ReenqueueTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ReenqueueTaskQueueDLQTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientReenqueueTaskQueueDLQTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReenqueueTaskQueueDLQTasks(ctx, request, opts...)
+}
+
+func (c *metricClient) ReleaseConcurrencySlot(
+	ctx context.Context,
+	request *matchingservice.ReleaseConcurrencySlotRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ReleaseConcurrencySlotResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientReleaseConcurrencySlot")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReleaseConcurrencySlot(ctx, request, opts...)
+}
+
 func (c *metricClient) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -443,6 +499,20 @@ func (c *metricClient) UpdateTaskQueueConfig(
 	return c.client.UpdateTaskQueueConfig(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateTaskQueueDispatchPause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueDispatchPauseRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateTaskQueueDispatchPauseResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientUpdateTaskQueueDispatchPause")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskQueueDispatchPause(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
@@ -236,6 +236,21 @@ func (c *retryableClient) DispatchNexusTask(
 	return resp, err
 }
 
+func (c *retryableClient) ExplainDynamicConfig(
+	ctx context.Context,
+	request *matchingservice.ExplainDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ExplainDynamicConfigResponse, error) {
+	var resp *matchingservice.ExplainDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExplainDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceLoadTaskQueuePartition(
 	ctx context.Context,
 	request *matchingservice.ForceLoadTaskQueuePartitionRequest,
@@ -356,6 +371,21 @@ func (c *retryableClient) ListNexusEndpoints(
 	return resp, err
 }
 
+func (c *retryableClient) ListTaskQueueDLQTasks(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListTaskQueueDLQTasksResponse, error) {
+	var resp *matchingservice.ListTaskQueueDLQTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskQueueDLQTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListTaskQueuePartitions(
 	ctx context.Context,
 	request *matchingservice.ListTaskQueuePartitionsRequest,
@@ -461,6 +491,36 @@ func (c *retryableClient) RecordWorkerHeartbeat(
 	return resp, err
 }
 
+func (c *retryableClient) ReenqueueTaskQueueDLQTasks(
+	ctx context.Context,
+	request *matchingservice.ReenqueueTaskQueueDLQTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+	var resp *matchingservice.ReenqueueTaskQueueDLQTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReenqueueTaskQueueDLQTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ReleaseConcurrencySlot(
+	ctx context.Context,
+	request *matchingservice.ReleaseConcurrencySlotRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ReleaseConcurrencySlotResponse, error) {
+	var resp *matchingservice.ReleaseConcurrencySlotResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReleaseConcurrencySlot(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -581,6 +641,21 @@ func (c *retryableClient) UpdateTaskQueueConfig(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateTaskQueueDispatchPause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueDispatchPauseRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+	var resp *matchingservice.UpdateTaskQueueDispatchPauseResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskQueueDispatchPause(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
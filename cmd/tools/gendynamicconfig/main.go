@@ -16,6 +16,9 @@ type (
 		Name   string
 		GoArgs string
 		Expr   string
+		// FromConstraints declares the variables named in GoArgs from a Constraints value "cs",
+		// so that Expr can be evaluated for an arbitrary set of constraints.
+		FromConstraints string
 	}
 
 	dynamicConfigData struct {
@@ -67,18 +70,21 @@ var (
 				Expr:   "[]Constraints{{}}",
 			},
 			{
-				Name:   "Namespace",
-				GoArgs: "namespace string",
-				Expr:   "[]Constraints{{Namespace: namespace}, {}}",
+				Name:            "Namespace",
+				GoArgs:          "namespace string",
+				Expr:            "[]Constraints{{Namespace: namespace}, {}}",
+				FromConstraints: "namespace := cs.Namespace",
 			},
 			{
-				Name:   "NamespaceID",
-				GoArgs: "namespaceID namespace.ID",
-				Expr:   "[]Constraints{{NamespaceID: namespaceID.String()}, {}}",
+				Name:            "NamespaceID",
+				GoArgs:          "namespaceID namespace.ID",
+				Expr:            "[]Constraints{{NamespaceID: namespaceID.String()}, {}}",
+				FromConstraints: "namespaceID := namespace.ID(cs.NamespaceID)",
 			},
 			{
-				Name:   "TaskQueue",
-				GoArgs: "namespace string, taskQueue string, taskQueueType enumspb.TaskQueueType",
+				Name:            "TaskQueue",
+				GoArgs:          "namespace string, taskQueue string, taskQueueType enumspb.TaskQueueType",
+				FromConstraints: "namespace, taskQueue, taskQueueType := cs.Namespace, cs.TaskQueueName, cs.TaskQueueType",
 				// A task-queue-name-only filter applies to a single task queue name across all
 				// namespaces, with higher precedence than a namespace-only filter. This is intended to
 				// be used by the default partition count and is probably not useful otherwise.
@@ -91,18 +97,21 @@ var (
 		}`,
 			},
 			{
-				Name:   "ShardID",
-				GoArgs: "shardID int32",
-				Expr:   "[]Constraints{{ShardID: shardID}, {}}",
+				Name:            "ShardID",
+				GoArgs:          "shardID int32",
+				Expr:            "[]Constraints{{ShardID: shardID}, {}}",
+				FromConstraints: "shardID := cs.ShardID",
 			},
 			{
-				Name:   "TaskType",
-				GoArgs: "taskType enumsspb.TaskType",
-				Expr:   "[]Constraints{{TaskType: taskType}, {}}",
+				Name:            "TaskType",
+				GoArgs:          "taskType enumsspb.TaskType",
+				Expr:            "[]Constraints{{TaskType: taskType}, {}}",
+				FromConstraints: "taskType := cs.TaskType",
 			},
 			{
-				Name:   "Destination",
-				GoArgs: "namespace string, destination string",
+				Name:            "Destination",
+				GoArgs:          "namespace string, destination string",
+				FromConstraints: "namespace, destination := cs.Namespace, cs.Destination",
 				Expr: `[]Constraints{
 			{Namespace: namespace, Destination: destination},
 			{Destination: destination},
@@ -111,9 +120,10 @@ var (
 		}`,
 			},
 			{
-				Name:   "ChasmTaskType",
-				GoArgs: "chasmTaskType string",
-				Expr:   "[]Constraints{{ChasmTaskType: chasmTaskType}, {}}",
+				Name:            "ChasmTaskType",
+				GoArgs:          "chasmTaskType string",
+				Expr:            "[]Constraints{{ChasmTaskType: chasmTaskType}, {}}",
+				FromConstraints: "chasmTaskType := cs.ChasmTaskType",
 			},
 		}}
 )
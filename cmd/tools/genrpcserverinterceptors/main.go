@@ -96,7 +96,8 @@ func getGrpcServerData(grpcServerT reflect.Type) grpcServerData {
 
 	for method := range grpcServerT.Methods() {
 		rpcT := method.Type
-		if rpcT.NumIn() < 2 {
+		// Skip streaming APIs, they don't go through unary interceptors.
+		if rpcT.NumIn() < 2 || rpcT.NumOut() < 2 {
 			continue
 		}
 
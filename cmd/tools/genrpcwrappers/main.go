@@ -83,6 +83,9 @@ var (
 		"client.admin.StreamWorkflowReplicationMessages":          true,
 		"metricsClient.admin.StreamWorkflowReplicationMessages":   true,
 		"retryableClient.admin.StreamWorkflowReplicationMessages": true,
+		"client.admin.WatchWorkflowExecutions":                    true,
+		"metricsClient.admin.WatchWorkflowExecutions":             true,
+		"retryableClient.admin.WatchWorkflowExecutions":           true,
 		// TODO(bergundy): Allow specifying custom routing for streaming messages.
 		"client.history.StreamWorkflowReplicationMessages":          true,
 		"metricsClient.history.StreamWorkflowReplicationMessages":   true,
@@ -100,6 +103,8 @@ var (
 		"client.matching.QueryWorkflow":         true,
 		"client.matching.DispatchNexusTask":     true,
 		"client.matching.PollNexusTaskQueue":    true,
+		// this is routed to a specific host.
+		"client.matching.ExplainDynamicConfig": true,
 
 		// these do forwarding stats. too complicated.
 		"metricsClient.matching.AddActivityTask":       true,
@@ -344,7 +349,10 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 		"GetWorkerVersioningRulesRequest",
 		"UpdateWorkerVersioningRulesRequest",
 		"UpdateFairnessStateRequest",
-		"UpdateTaskQueueConfigRequest":
+		"UpdateTaskQueueConfigRequest",
+		"UpdateTaskQueueDispatchPauseRequest",
+		"ListTaskQueueDLQTasksRequest",
+		"ReenqueueTaskQueueDLQTasksRequest":
 		tq = findOneNestedField(t, "TaskQueue", "request", 2)
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_WORKFLOW"}
 		nsID = findOneNestedField(t, "NamespaceId", "request", 1)
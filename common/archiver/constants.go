@@ -37,4 +37,8 @@ var (
 	ErrNextPageTokenCorrupted = errors.New("next page token is corrupted")
 	// ErrHistoryNotExist is the error for non-exist history
 	ErrHistoryNotExist = errors.New("requested workflow history does not exist")
+	// ErrHistoryCorrupted is the error for archived history that doesn't match its manifest
+	ErrHistoryCorrupted = errors.New("archived workflow history is corrupted")
+	// ErrInvalidCompression is the error for unknown history compression
+	ErrInvalidCompression = errors.New("invalid history compression")
 )
@@ -0,0 +1,73 @@
+package filestore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+type blobStore struct {
+	fileMode os.FileMode
+	dirMode  os.FileMode
+}
+
+// NewBlobStore creates a new archiver.BlobStore based on filestore
+func NewBlobStore(config *config.FilestoreArchiver) (archiver.BlobStore, error) {
+	fileMode, err := strconv.ParseUint(config.FileMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidFileMode
+	}
+	dirMode, err := strconv.ParseUint(config.DirMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidDirMode
+	}
+	return &blobStore{
+		fileMode: os.FileMode(fileMode),
+		dirMode:  os.FileMode(dirMode),
+	}, nil
+}
+
+func (b *blobStore) Put(_ context.Context, URI archiver.URI, key string, data []byte) error {
+	if err := b.ValidateURI(URI); err != nil {
+		return err
+	}
+	path := filepath.Join(URI.Path(), filepath.FromSlash(key))
+	if err := mkdirAll(filepath.Dir(path), b.dirMode); err != nil {
+		return err
+	}
+	return writeFile(path, data, b.fileMode)
+}
+
+func (b *blobStore) Get(_ context.Context, URI archiver.URI, key string) ([]byte, error) {
+	if err := b.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	data, err := readFile(filepath.Join(URI.Path(), filepath.FromSlash(key)))
+	if os.IsNotExist(err) {
+		return nil, serviceerror.NewNotFoundf("blob %s not found", key)
+	}
+	return data, err
+}
+
+func (b *blobStore) Delete(_ context.Context, URI archiver.URI, key string) error {
+	if err := b.ValidateURI(URI); err != nil {
+		return err
+	}
+	err := os.Remove(filepath.Join(URI.Path(), filepath.FromSlash(key)))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (b *blobStore) ValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+	return validateDirPath(URI.Path())
+}
@@ -0,0 +1,38 @@
+package filestore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+func TestBlobStore(t *testing.T) {
+	store, err := NewBlobStore(&config.FilestoreArchiver{
+		FileMode: testFileModeStr,
+		DirMode:  testDirModeStr,
+	})
+	require.NoError(t, err)
+	URI, err := archiver.NewURI("file://" + t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, store.ValidateURI(URI))
+
+	ctx := context.Background()
+	require.NoError(t, store.Put(ctx, URI, "tree/branch/1-100", []byte("blob")))
+	data, err := store.Get(ctx, URI, "tree/branch/1-100")
+	require.NoError(t, err)
+	require.Equal(t, []byte("blob"), data)
+
+	require.NoError(t, store.Delete(ctx, URI, "tree/branch/1-100"))
+	require.NoError(t, store.Delete(ctx, URI, "tree/branch/1-100"))
+	_, err = store.Get(ctx, URI, "tree/branch/1-100")
+	var notFoundErr *serviceerror.NotFound
+	require.ErrorAs(t, err, &notFoundErr)
+
+	invalidURI, err := archiver.NewURI("s3://bucket/path")
+	require.NoError(t, err)
+	require.ErrorIs(t, store.Put(ctx, invalidURI, "key", nil), archiver.ErrURISchemeMismatch)
+}
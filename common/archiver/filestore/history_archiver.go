@@ -2,7 +2,9 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(namespaceID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory. Workflow histories stored in that file are encoded in JSON format, and optionally
+// compressed. A manifest with the checksum of the file is written next to it, in a file with
+// the same name and a .manifest suffix.
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
@@ -17,9 +19,11 @@ package filestore
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"strconv"
+	"strings"
 
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
@@ -37,9 +41,14 @@ const (
 	// URIScheme is the scheme for the filestore implementation
 	URIScheme = "file"
 
-	errEncodeHistory = "failed to encode history batches"
-	errMakeDirectory = "failed to make directory"
-	errWriteFile     = "failed to write history to file"
+	errEncodeHistory   = "failed to encode history batches"
+	errCompressHistory = "failed to compress history batches"
+	errMakeDirectory   = "failed to make directory"
+	errWriteFile       = "failed to write history to file"
+	errWriteManifest   = "failed to write history manifest to file"
+
+	historyFileSuffix         = ".history"
+	historyManifestFileSuffix = ".manifest"
 
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 )
@@ -56,6 +65,7 @@ type (
 		metricsHandler   metrics.Handler
 		fileMode         os.FileMode
 		dirMode          os.FileMode
+		compression      string
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -92,12 +102,16 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	if err := archiver.ValidateHistoryCompression(config.HistoryCompression); err != nil {
+		return nil, err
+	}
 	return &historyArchiver{
 		executionManager: executionManager,
 		logger:           logger,
 		metricsHandler:   metricsHandler,
 		fileMode:         os.FileMode(fileMode),
 		dirMode:          os.FileMode(dirMode),
+		compression:      config.HistoryCompression,
 		historyIterator:  historyIterator,
 	}, nil
 }
@@ -167,6 +181,11 @@ func (h *historyArchiver) Archive(
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 		return err
 	}
+	compressedHistoryBatches, err := archiver.CompressHistoryBlob(encodedHistoryBatches, h.compression)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errCompressHistory), tag.Error(err))
+		return err
+	}
 
 	dirPath := URI.Path()
 	if err = mkdirAll(dirPath, h.dirMode); err != nil {
@@ -175,11 +194,23 @@ func (h *historyArchiver) Archive(
 	}
 
 	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
-	if err := writeFile(path.Join(dirPath, filename), encodedHistoryBatches, h.fileMode); err != nil {
+	if err := writeFile(path.Join(dirPath, filename), compressedHistoryBatches, h.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
 	}
 
+	manifest := &archiver.HistoryManifest{}
+	manifest.AddBlob(filename, compressedHistoryBatches)
+	encodedManifest, err := archiver.EncodeHistoryManifest(manifest)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
+	if err := writeFile(path.Join(dirPath, constructHistoryManifestFilename(filename)), encodedManifest, h.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteManifest), tag.Error(err))
+		return err
+	}
+
 	return nil
 }
 
@@ -237,8 +268,12 @@ func (h *historyArchiver) Get(
 		return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
 	}
 
-	encodedHistoryBatches, err := readFile(filepath)
+	encodedHistoryBatches, err := h.readHistoryFile(dirPath, filename)
 	if err != nil {
+		if errors.Is(err, archiver.ErrHistoryCorrupted) {
+			h.recordCorruptedHistory()
+			return nil, serviceerror.NewDataLoss(err.Error())
+		}
 		return nil, serviceerror.NewInternal(err.Error())
 	}
 
@@ -273,6 +308,106 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+// VerifyHistories verifies every history file under the URI against its manifest, and checks that it can be decoded.
+func (h *historyArchiver) VerifyHistories(
+	ctx context.Context,
+	URI archiver.URI,
+	report func(key string, err error) error,
+) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return err
+	}
+
+	dirPath := URI.Path()
+	filenames, err := listFiles(dirPath)
+	if err != nil {
+		return err
+	}
+	for _, filename := range filenames {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var verifyErr error
+		switch {
+		case strings.HasSuffix(filename, historyFileSuffix):
+			verifyErr = h.verifyHistoryFile(dirPath, filename)
+		case strings.HasSuffix(filename, historyFileSuffix+historyManifestFileSuffix):
+			// Manifests are verified with their history file, unless it's missing.
+			historyFilename := strings.TrimSuffix(filename, historyManifestFileSuffix)
+			exists, err := fileExists(path.Join(dirPath, historyFilename))
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+			verifyErr = fmt.Errorf("%w: %s is missing", archiver.ErrHistoryCorrupted, historyFilename)
+		default:
+			continue
+		}
+		if errors.Is(verifyErr, archiver.ErrHistoryCorrupted) {
+			h.recordCorruptedHistory()
+		}
+		if err := report(filename, verifyErr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *historyArchiver) verifyHistoryFile(dirPath string, filename string) error {
+	encodedHistoryBatches, err := h.readHistoryFile(dirPath, filename)
+	if err != nil {
+		return err
+	}
+	encoder := codec.NewJSONPBEncoder()
+	if _, err := encoder.DecodeHistories(encodedHistoryBatches); err != nil {
+		return fmt.Errorf("%w: %v", archiver.ErrHistoryCorrupted, err)
+	}
+	return nil
+}
+
+// readHistoryFile reads a history file, verifies it against its manifest, and decompresses it. Histories archived
+// before manifests were written don't have one, and aren't verified.
+func (h *historyArchiver) readHistoryFile(dirPath string, filename string) ([]byte, error) {
+	data, err := readFile(path.Join(dirPath, filename))
+	if err != nil {
+		return nil, err
+	}
+
+	manifestPath := path.Join(dirPath, constructHistoryManifestFilename(filename))
+	exists, err := fileExists(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		encodedManifest, err := readFile(manifestPath)
+		if err != nil {
+			return nil, err
+		}
+		manifest, err := archiver.DecodeHistoryManifest(encodedManifest)
+		if err != nil {
+			return nil, err
+		}
+		if err := manifest.VerifyBlob(filename, data); err != nil {
+			return nil, err
+		}
+	}
+
+	decompressed, err := archiver.DecompressHistoryBlob(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", archiver.ErrHistoryCorrupted, err)
+	}
+	return decompressed, nil
+}
+
+func (h *historyArchiver) recordCorruptedHistory() {
+	metrics.HistoryArchiverCorruptedHistoryCount.With(
+		h.metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryArchiverScope)),
+	).Record(1)
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -554,6 +554,78 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndGet_CompressedWithManifest() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir := testutils.MkdirTemp(s.T(), "", "TestArchiveAndGet_CompressedWithManifest")
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	historyArchiver.compression = archiver.HistoryCompressionZstd
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	s.NoError(historyArchiver.Archive(context.Background(), URI, archiveRequest))
+
+	expectedFilename := constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.assertFileExists(path.Join(dir, constructHistoryManifestFilename(expectedFilename)))
+
+	getRequest := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+	reported := map[string]error{}
+	report := func(key string, err error) error {
+		reported[key] = err
+		return nil
+	}
+	s.NoError(historyArchiver.VerifyHistories(context.Background(), URI, report))
+	s.Equal(map[string]error{expectedFilename: nil}, reported)
+
+	data, err := readFile(path.Join(dir, expectedFilename))
+	s.NoError(err)
+	data[len(data)-1] ^= 0xff
+	s.NoError(writeFile(path.Join(dir, expectedFilename), data, testFileMode))
+
+	_, err = historyArchiver.Get(context.Background(), URI, getRequest)
+	s.IsType(&serviceerror.DataLoss{}, err)
+
+	s.NoError(historyArchiver.VerifyHistories(context.Background(), URI, report))
+	s.ErrorIs(reported[expectedFilename], archiver.ErrHistoryCorrupted)
+
+	s.NoError(os.Remove(path.Join(dir, expectedFilename)))
+	reported = map[string]error{}
+	s.NoError(historyArchiver.VerifyHistories(context.Background(), URI, report))
+	s.Len(reported, 1)
+	s.ErrorIs(reported[constructHistoryManifestFilename(expectedFilename)], archiver.ErrHistoryCorrupted)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -162,7 +162,11 @@ func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error
 
 func constructHistoryFilename(namespaceID, workflowID, runID string, version int64) string {
 	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
-	return fmt.Sprintf("%s_%v.history", combinedHash, version)
+	return fmt.Sprintf("%s_%v%s", combinedHash, version, historyFileSuffix)
+}
+
+func constructHistoryManifestFilename(historyFilename string) string {
+	return historyFilename + historyManifestFileSuffix
 }
 
 func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) string {
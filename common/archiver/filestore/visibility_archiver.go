@@ -2,6 +2,7 @@ package filestore
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -24,9 +25,18 @@ import (
 )
 
 const (
+	// VisibilityFormatJSON stores each visibility record in its own JSON file.
+	VisibilityFormatJSON = "json"
+	// VisibilityFormatParquet batches visibility records into Parquet files partitioned by namespace and close date.
+	VisibilityFormatParquet = "parquet"
+
 	errEncodeVisibilityRecord = "failed to encode visibility record"
 )
 
+var (
+	errInvalidVisibilityFormat = errors.New("invalid visibility format")
+)
+
 type (
 	visibilityArchiver struct {
 		logger         log.Logger
@@ -63,13 +73,26 @@ func NewVisibilityArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
-	return &visibilityArchiver{
-		logger:         logger,
-		metricsHandler: metricsHandler,
-		fileMode:       os.FileMode(fileMode),
-		dirMode:        os.FileMode(dirMode),
-		queryParser:    NewQueryParser(),
-	}, nil
+	switch config.VisibilityFormat {
+	case "", VisibilityFormatJSON:
+		return &visibilityArchiver{
+			logger:         logger,
+			metricsHandler: metricsHandler,
+			fileMode:       os.FileMode(fileMode),
+			dirMode:        os.FileMode(dirMode),
+			queryParser:    NewQueryParser(),
+		}, nil
+	case VisibilityFormatParquet:
+		return newParquetVisibilityArchiver(
+			logger,
+			metricsHandler,
+			os.FileMode(fileMode),
+			os.FileMode(dirMode),
+			config.ParquetBatchSize,
+		), nil
+	default:
+		return nil, errInvalidVisibilityFormat
+	}
 }
 
 func (v *visibilityArchiver) Archive(
@@ -0,0 +1,467 @@
+package filestore
+
+import (
+	"context"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+// Archived visibility records in the parquet format are stored under the URI path in the partitions described by
+// archiver.ParquetPartitionPath.
+//
+// Archive can't batch records in memory without losing them on restarts, so it first stages each record in its own
+// file under the _staging directory of the partition. Once a partition has enough staged records, or once its close
+// date is over, the staged records are moved under a _compacting directory, written to a new Parquet file and
+// deleted. Query engines ignore directories and files starting with '_' or '.', so only complete Parquet files are
+// visible to them, while Query also reads the staged records.
+const (
+	parquetStagingDir     = "_staging"
+	parquetCompactingDir  = "_compacting"
+	parquetTempFilePrefix = "."
+
+	// parquetStaleClaimTimeout is how long records can stay in the compacting directory before another compaction
+	// takes them over, in case the compaction that moved them there failed.
+	parquetStaleClaimTimeout = 10 * time.Minute
+
+	errStageVisibilityRecord    = "failed to stage visibility record"
+	errCompactVisibilityRecords = "failed to compact visibility records"
+)
+
+type (
+	parquetVisibilityArchiver struct {
+		logger         log.Logger
+		metricsHandler metrics.Handler
+		fileMode       os.FileMode
+		dirMode        os.FileMode
+		batchSize      int
+		queryParser    QueryParser
+		timeSource     clock.TimeSource
+	}
+)
+
+func newParquetVisibilityArchiver(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	fileMode os.FileMode,
+	dirMode os.FileMode,
+	batchSize int,
+) *parquetVisibilityArchiver {
+	if batchSize <= 0 {
+		batchSize = archiver.DefaultParquetBatchSize
+	}
+	return &parquetVisibilityArchiver{
+		logger:         logger,
+		metricsHandler: metricsHandler,
+		fileMode:       fileMode,
+		dirMode:        dirMode,
+		batchSize:      batchSize,
+		queryParser:    NewQueryParser(),
+		timeSource:     clock.NewRealTimeSource(),
+	}
+}
+
+func (v *parquetVisibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverspb.VisibilityRecord,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && featureCatalog.NonRetryableError != nil {
+			err = featureCatalog.NonRetryableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.logger, request, URI.String())
+
+	if err := v.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	closeTime := request.CloseTime.AsTime()
+	partitionPath := parquetPartitionPath(URI, request.GetNamespaceId(), closeTime)
+	stagingPath := path.Join(partitionPath, parquetStagingDir)
+	if err = mkdirAll(stagingPath, v.dirMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+		return err
+	}
+
+	encodedVisibilityRecord, err := encode(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	filename := constructVisibilityFilename(closeTime, request.GetRunId())
+	if err := v.writeFileAtomically(stagingPath, filename, encodedVisibilityRecord); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errStageVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	// The record is archived once it's staged. Compaction failures are retried by the next Archive of the partition.
+	today := v.timeSource.Now().UTC().Truncate(24 * time.Hour)
+	if err := v.compactIfNeeded(partitionPath, closeTime.UTC().Before(today)); err != nil {
+		logger.Warn(errCompactVisibilityRecords, tag.Error(err))
+	}
+	if !closeTime.UTC().Before(today) {
+		// Compact what's left of the previous day, which won't get new records in the common case.
+		previousPartitionPath := parquetPartitionPath(URI, request.GetNamespaceId(), today.AddDate(0, 0, -1))
+		if err := v.compactIfNeeded(previousPartitionPath, true); err != nil {
+			logger.Warn(errCompactVisibilityRecords, tag.Error(err))
+		}
+	}
+	return nil
+}
+
+func (v *parquetVisibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	if parsedQuery.emptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	var token *queryVisibilityToken
+	if request.NextPageToken != nil {
+		token, err = deserializeQueryVisibilityToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	partitionPaths, err := v.listPartitions(URI, request.NamespaceID, parsedQuery, token)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, partitionPath := range partitionPaths {
+		records, err := v.readPartition(partitionPath)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		for _, record := range records {
+			if !matchQuery(record, parsedQuery) || !beforeQueryVisibilityToken(record, token) {
+				continue
+			}
+			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+
+			response.Executions = append(response.Executions, executionInfo)
+			if len(response.Executions) == request.PageSize {
+				encodedToken, err := serializeToken(&queryVisibilityToken{
+					LastCloseTime: record.CloseTime.AsTime(),
+					LastRunID:     record.GetRunId(),
+				})
+				if err != nil {
+					return nil, serviceerror.NewInternal(err.Error())
+				}
+				response.NextPageToken = encodedToken
+				return response, nil
+			}
+		}
+	}
+	return response, nil
+}
+
+func (v *parquetVisibilityArchiver) ValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	return validateDirPath(URI.Path())
+}
+
+// compactIfNeeded compacts the staged records of the partition once there are enough of them, or if the partition
+// is sealed, which means it shouldn't get new records.
+func (v *parquetVisibilityArchiver) compactIfNeeded(partitionPath string, sealed bool) error {
+	filenames, err := listVisibilityRecordFiles(path.Join(partitionPath, parquetStagingDir))
+	if err != nil {
+		return err
+	}
+	if len(filenames) == 0 || (!sealed && len(filenames) < v.batchSize) {
+		return nil
+	}
+	return v.compact(partitionPath)
+}
+
+// compact writes the staged records of the partition to a new Parquet file. Concurrent compactions of the same
+// partition are safe: each record is claimed by exactly one compaction by moving it to the claim directory of the
+// compaction. If a compaction fails after the Parquet file is written, its records are written again by the
+// compaction that takes them over, and Query skips the duplicates.
+func (v *parquetVisibilityArchiver) compact(partitionPath string) error {
+	compactionID := uuid.NewString()
+	claimPath := path.Join(partitionPath, parquetCompactingDir, compactionID)
+	if err := mkdirAll(claimPath, v.dirMode); err != nil {
+		return err
+	}
+	if err := v.claimRecords(partitionPath, claimPath); err != nil {
+		return err
+	}
+
+	records, err := readVisibilityRecordFiles(claimPath)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return os.Remove(claimPath)
+	}
+	rows := make([]archiver.ParquetVisibilityRow, len(records))
+	for i, record := range records {
+		if rows[i], err = archiver.NewParquetVisibilityRow(record); err != nil {
+			return err
+		}
+	}
+	data, err := archiver.EncodeParquetVisibilityRows(rows)
+	if err != nil {
+		return err
+	}
+	if err := v.writeFileAtomically(partitionPath, archiver.ParquetFilePrefix+compactionID+archiver.ParquetFileSuffix, data); err != nil {
+		return err
+	}
+	return os.RemoveAll(claimPath)
+}
+
+// claimRecords moves the staged records of the partition, and the records of stale claims, to claimPath.
+func (v *parquetVisibilityArchiver) claimRecords(partitionPath string, claimPath string) error {
+	sourcePaths := []string{path.Join(partitionPath, parquetStagingDir)}
+	compactingPath := path.Join(partitionPath, parquetCompactingDir)
+	claimIDs, err := listFiles(compactingPath)
+	if err != nil {
+		return err
+	}
+	for _, claimID := range claimIDs {
+		stalePath := path.Join(compactingPath, claimID)
+		if stalePath == claimPath {
+			continue
+		}
+		info, err := os.Stat(stalePath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err == nil && v.timeSource.Since(info.ModTime()) > parquetStaleClaimTimeout {
+			sourcePaths = append(sourcePaths, stalePath)
+		}
+	}
+
+	for _, sourcePath := range sourcePaths {
+		filenames, err := listVisibilityRecordFiles(sourcePath)
+		if err != nil {
+			return err
+		}
+		for _, filename := range filenames {
+			// Another compaction may claim the same record first.
+			if err := os.Rename(path.Join(sourcePath, filename), path.Join(claimPath, filename)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		if sourcePath != sourcePaths[0] {
+			if err := os.RemoveAll(sourcePath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// listPartitions returns the paths of the partitions of the namespace that may have records matching the query and
+// the page token, latest first.
+func (v *parquetVisibilityArchiver) listPartitions(
+	URI archiver.URI,
+	namespaceID string,
+	parsedQuery *parsedQuery,
+	token *queryVisibilityToken,
+) ([]string, error) {
+	namespacePath := path.Join(URI.Path(), archiver.ParquetNamespacePartitionPrefix+namespaceID)
+	exists, err := directoryExists(namespacePath)
+	if err != nil || !exists {
+		return nil, err
+	}
+	partitions, err := listFiles(namespacePath)
+	if err != nil {
+		return nil, err
+	}
+
+	earliestDate := parsedQuery.earliestCloseTime.UTC().Truncate(24 * time.Hour)
+	latestDate := parsedQuery.latestCloseTime.UTC()
+	if token != nil && token.LastCloseTime.Before(latestDate) {
+		latestDate = token.LastCloseTime.UTC()
+	}
+	var dates []time.Time
+	for _, partition := range partitions {
+		date, ok := archiver.ParseParquetCloseDatePartition(partition)
+		if !ok {
+			continue
+		}
+		if date.Before(earliestDate) || date.After(latestDate) {
+			continue
+		}
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].After(dates[j])
+	})
+
+	partitionPaths := make([]string, len(dates))
+	for i, date := range dates {
+		partitionPaths[i] = archiver.ParquetPartitionPath(URI.Path(), namespaceID, date)
+	}
+	return partitionPaths, nil
+}
+
+// readPartition returns all records of the partition, including the ones not compacted yet, sorted by close time
+// (desc) and hashed run ID (desc) like the records of the json format. Duplicate records are skipped.
+func (v *parquetVisibilityArchiver) readPartition(partitionPath string) ([]*archiverspb.VisibilityRecord, error) {
+	// Records move from the staging directory to a claim directory and then to a Parquet file. Reading the
+	// locations in the same order guarantees that records moved by a concurrent compaction are read at least once.
+	var rows []archiver.ParquetVisibilityRow
+	recordPaths := []string{path.Join(partitionPath, parquetStagingDir)}
+	compactingPath := path.Join(partitionPath, parquetCompactingDir)
+	claimIDs, err := listVisibilityRecordFiles(compactingPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, claimID := range claimIDs {
+		recordPaths = append(recordPaths, path.Join(compactingPath, claimID))
+	}
+	for _, recordPath := range recordPaths {
+		records, err := readVisibilityRecordFiles(recordPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			// Records that are not compacted yet go through the row conversion, so that they are identical to the
+			// compacted ones.
+			row, err := archiver.NewParquetVisibilityRow(record)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	filenames, err := listFiles(partitionPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, filename := range filenames {
+		if !strings.HasPrefix(filename, archiver.ParquetFilePrefix) || !strings.HasSuffix(filename, archiver.ParquetFileSuffix) {
+			continue
+		}
+		data, err := readFile(path.Join(partitionPath, filename))
+		if err != nil {
+			return nil, err
+		}
+		fileRows, err := archiver.DecodeParquetVisibilityRows(data)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, fileRows...)
+	}
+	return archiver.ParquetVisibilityRecords(rows)
+}
+
+// writeFileAtomically writes the file under a temporary name first, so that readers never see a partial file.
+func (v *parquetVisibilityArchiver) writeFileAtomically(dirPath string, filename string, data []byte) error {
+	tempPath := path.Join(dirPath, parquetTempFilePrefix+filename)
+	if err := writeFile(tempPath, data, v.fileMode); err != nil {
+		return err
+	}
+	return os.Rename(tempPath, path.Join(dirPath, filename))
+}
+
+func parquetPartitionPath(URI archiver.URI, namespaceID string, closeTime time.Time) string {
+	return archiver.ParquetPartitionPath(URI.Path(), namespaceID, closeTime)
+}
+
+// listVisibilityRecordFiles returns the names of the files in the directory, if it exists, except temporary files.
+func listVisibilityRecordFiles(dirPath string) ([]string, error) {
+	filenames, err := listFiles(dirPath)
+	if os.IsNotExist(err) {
+		// Never created, or a claim directory removed by its compaction.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	recordFilenames := filenames[:0]
+	for _, filename := range filenames {
+		if !strings.HasPrefix(filename, parquetTempFilePrefix) {
+			recordFilenames = append(recordFilenames, filename)
+		}
+	}
+	return recordFilenames, nil
+}
+
+func readVisibilityRecordFiles(dirPath string) ([]*archiverspb.VisibilityRecord, error) {
+	filenames, err := listVisibilityRecordFiles(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*archiverspb.VisibilityRecord, 0, len(filenames))
+	for _, filename := range filenames {
+		encodedRecord, err := readFile(path.Join(dirPath, filename))
+		if os.IsNotExist(err) {
+			// Claimed by a compaction since the directory was listed.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func beforeQueryVisibilityToken(record *archiverspb.VisibilityRecord, token *queryVisibilityToken) bool {
+	if token == nil {
+		return true
+	}
+	closeTime := record.CloseTime.AsTime()
+	if closeTime.Equal(token.LastCloseTime) {
+		return hash(record.GetRunId()) < hash(token.LastRunID)
+	}
+	return closeTime.Before(token.LastCloseTime)
+}
@@ -0,0 +1,242 @@
+package filestore
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var testParquetNow = time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+
+func newTestParquetVisibilityArchiver(t *testing.T, batchSize int) *parquetVisibilityArchiver {
+	a, err := NewVisibilityArchiver(log.NewNoopLogger(), metrics.NoopMetricsHandler, &config.FilestoreArchiver{
+		FileMode:         testFileModeStr,
+		DirMode:          testDirModeStr,
+		VisibilityFormat: VisibilityFormatParquet,
+		ParquetBatchSize: batchSize,
+	})
+	require.NoError(t, err)
+	v := a.(*parquetVisibilityArchiver)
+	v.timeSource = clock.NewEventTimeSource().Update(testParquetNow)
+	return v
+}
+
+func newTestParquetVisibilityRecord(runID string, closeTime time.Time) *archiverspb.VisibilityRecord {
+	return &archiverspb.VisibilityRecord{
+		NamespaceId:       testNamespaceID,
+		Namespace:         testNamespace,
+		WorkflowId:        "workflow-" + runID,
+		RunId:             runID,
+		WorkflowTypeName:  testWorkflowTypeName,
+		StartTime:         timestamppb.New(closeTime.Add(-time.Minute)),
+		CloseTime:         timestamppb.New(closeTime),
+		Status:            enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		HistoryLength:     42,
+		ExecutionDuration: durationpb.New(time.Minute),
+		Memo: &commonpb.Memo{
+			Fields: map[string]*commonpb.Payload{"memo": payload.EncodeString("value")},
+		},
+		SearchAttributes: map[string]string{"CustomKeywordField": `"keyword"`},
+	}
+}
+
+func queryAllParquetVisibilityRecords(
+	t *testing.T,
+	v *parquetVisibilityArchiver,
+	URI archiver.URI,
+	pageSize int,
+) []*workflowpb.WorkflowExecutionInfo {
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    pageSize,
+	}
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for {
+		response, err := v.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap())
+		require.NoError(t, err)
+		executions = append(executions, response.Executions...)
+		if response.NextPageToken == nil {
+			return executions
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+func TestNewVisibilityArchiver_InvalidFormat(t *testing.T) {
+	_, err := NewVisibilityArchiver(log.NewNoopLogger(), metrics.NoopMetricsHandler, &config.FilestoreArchiver{
+		FileMode:         testFileModeStr,
+		DirMode:          testDirModeStr,
+		VisibilityFormat: "csv",
+	})
+	require.ErrorIs(t, err, errInvalidVisibilityFormat)
+}
+
+func TestParquetVisibilityArchiver_ArchiveAndQuery(t *testing.T) {
+	dir := t.TempDir()
+	URI, err := archiver.NewURI("file://" + dir)
+	require.NoError(t, err)
+	v := newTestParquetVisibilityArchiver(t, 2)
+
+	yesterday := testParquetNow.AddDate(0, 0, -1)
+	records := []*archiverspb.VisibilityRecord{
+		newTestParquetVisibilityRecord("run-1", yesterday.Add(time.Hour)),
+		newTestParquetVisibilityRecord("run-2", testParquetNow.Add(-3*time.Hour)),
+		newTestParquetVisibilityRecord("run-3", testParquetNow.Add(-2*time.Hour)),
+		newTestParquetVisibilityRecord("run-4", testParquetNow.Add(-time.Hour)),
+	}
+	for _, record := range records {
+		require.NoError(t, v.Archive(context.Background(), URI, record))
+	}
+
+	// The partition of yesterday is sealed and compacted right away. The partition of today is compacted once it
+	// has 2 staged records.
+	yesterdayPath := parquetPartitionPath(URI, testNamespaceID, yesterday)
+	todayPath := parquetPartitionPath(URI, testNamespaceID, testParquetNow)
+	require.Equal(t, path.Join(dir, "namespace_id="+testNamespaceID, "close_date=2024-05-01"), yesterdayPath)
+	for partitionPath, expected := range map[string]struct{ parquetFiles, stagedRecords int }{
+		yesterdayPath: {parquetFiles: 1, stagedRecords: 0},
+		todayPath:     {parquetFiles: 1, stagedRecords: 1},
+	} {
+		filenames, err := listFilesByPrefix(partitionPath, archiver.ParquetFilePrefix)
+		require.NoError(t, err)
+		require.Len(t, filenames, expected.parquetFiles)
+		staged, err := listVisibilityRecordFiles(path.Join(partitionPath, parquetStagingDir))
+		require.NoError(t, err)
+		require.Len(t, staged, expected.stagedRecords)
+	}
+
+	executions := queryAllParquetVisibilityRecords(t, v, URI, 1)
+	require.Len(t, executions, len(records))
+	for i, execution := range executions {
+		expected, err := convertToExecutionInfo(records[len(records)-1-i], searchattribute.TestNameTypeMap())
+		require.NoError(t, err)
+		protorequire.ProtoEqual(t, expected, execution)
+	}
+}
+
+func TestParquetVisibilityArchiver_Query_PrunesPartitions(t *testing.T) {
+	dir := t.TempDir()
+	URI, err := archiver.NewURI("file://" + dir)
+	require.NoError(t, err)
+	v := newTestParquetVisibilityArchiver(t, 100)
+
+	for _, record := range []*archiverspb.VisibilityRecord{
+		newTestParquetVisibilityRecord("run-1", testParquetNow.AddDate(0, 0, -1)),
+		newTestParquetVisibilityRecord("run-2", testParquetNow.Add(-time.Hour)),
+	} {
+		require.NoError(t, v.Archive(context.Background(), URI, record))
+	}
+	// A partition that can't be read fails the queries that don't prune it.
+	corruptedPath := parquetPartitionPath(URI, testNamespaceID, testParquetNow.AddDate(0, 0, -10))
+	require.NoError(t, os.MkdirAll(corruptedPath, testDirMode))
+	require.NoError(t, os.WriteFile(path.Join(corruptedPath, "part-corrupted.parquet"), []byte("corrupted"), testFileMode))
+
+	ctrl := gomock.NewController(t)
+	mockParser := NewMockQueryParser(ctrl)
+	v.queryParser = mockParser
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       "parsed by mockParser",
+	}
+
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: testParquetNow.AddDate(0, 0, -2),
+		latestCloseTime:   testParquetNow,
+	}, nil)
+	response, err := v.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap())
+	require.NoError(t, err)
+	require.Len(t, response.Executions, 2)
+
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: testParquetNow.Add(-2 * time.Hour),
+		latestCloseTime:   testParquetNow,
+	}, nil)
+	response, err = v.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap())
+	require.NoError(t, err)
+	require.Len(t, response.Executions, 1)
+	require.Equal(t, "run-2", response.Executions[0].GetExecution().GetRunId())
+
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: time.Time{},
+		latestCloseTime:   testParquetNow,
+	}, nil)
+	_, err = v.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap())
+	require.Error(t, err)
+}
+
+func TestParquetVisibilityArchiver_Query_SkipsDuplicates(t *testing.T) {
+	URI, err := archiver.NewURI("file://" + t.TempDir())
+	require.NoError(t, err)
+	v := newTestParquetVisibilityArchiver(t, 1)
+
+	// Archive may be called more than once for the same workflow: the second record is compacted to another file.
+	record := newTestParquetVisibilityRecord("run-1", testParquetNow.Add(-time.Hour))
+	require.NoError(t, v.Archive(context.Background(), URI, record))
+	require.NoError(t, v.Archive(context.Background(), URI, record))
+	filenames, err := listFilesByPrefix(parquetPartitionPath(URI, testNamespaceID, testParquetNow), archiver.ParquetFilePrefix)
+	require.NoError(t, err)
+	require.Len(t, filenames, 2)
+
+	executions := queryAllParquetVisibilityRecords(t, v, URI, 10)
+	require.Len(t, executions, 1)
+}
+
+func TestParquetVisibilityArchiver_Compact_TakesOverStaleClaims(t *testing.T) {
+	URI, err := archiver.NewURI("file://" + t.TempDir())
+	require.NoError(t, err)
+	v := newTestParquetVisibilityArchiver(t, 100)
+	partitionPath := parquetPartitionPath(URI, testNamespaceID, testParquetNow)
+
+	// A compaction failed after claiming run-1, and another one is still compacting run-2.
+	staleClaimPath := path.Join(partitionPath, parquetCompactingDir, "stale")
+	activeClaimPath := path.Join(partitionPath, parquetCompactingDir, "active")
+	for claimPath, record := range map[string]*archiverspb.VisibilityRecord{
+		staleClaimPath:  newTestParquetVisibilityRecord("run-1", testParquetNow.Add(-2*time.Hour)),
+		activeClaimPath: newTestParquetVisibilityRecord("run-2", testParquetNow.Add(-time.Hour)),
+	} {
+		require.NoError(t, os.MkdirAll(claimPath, testDirMode))
+		data, err := encode(record)
+		require.NoError(t, err)
+		filename := constructVisibilityFilename(record.CloseTime.AsTime(), record.GetRunId())
+		require.NoError(t, writeFile(path.Join(claimPath, filename), data, testFileMode))
+	}
+	staleTime := testParquetNow.Add(-parquetStaleClaimTimeout - time.Minute)
+	require.NoError(t, os.Chtimes(staleClaimPath, staleTime, staleTime))
+	activeTime := testParquetNow.Add(-time.Minute)
+	require.NoError(t, os.Chtimes(activeClaimPath, activeTime, activeTime))
+
+	// Claimed records are visible to queries before they are compacted.
+	require.Len(t, queryAllParquetVisibilityRecords(t, v, URI, 10), 2)
+
+	require.NoError(t, v.compact(partitionPath))
+	exists, err := directoryExists(staleClaimPath)
+	require.NoError(t, err)
+	require.False(t, exists)
+	exists, err = directoryExists(activeClaimPath)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	rows, err := v.readPartition(partitionPath)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+}
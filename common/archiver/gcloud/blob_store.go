@@ -0,0 +1,68 @@
+package gcloud
+
+import (
+	"context"
+	"errors"
+
+	"cloud.google.com/go/storage"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/gcloud/connector"
+	"go.temporal.io/server/common/config"
+)
+
+type blobStore struct {
+	gcloudStorage connector.Client
+}
+
+// NewBlobStore creates a new archiver.BlobStore based on gcloud storage
+func NewBlobStore(config *config.GstorageArchiver) (archiver.BlobStore, error) {
+	storage, err := connector.NewClient(context.Background(), config)
+	if err != nil {
+		return nil, err
+	}
+	return &blobStore{gcloudStorage: storage}, nil
+}
+
+func (b *blobStore) Put(ctx context.Context, URI archiver.URI, key string, data []byte) error {
+	if err := b.validateURI(URI); err != nil {
+		return err
+	}
+	return b.gcloudStorage.Upload(ctx, URI, key, data)
+}
+
+func (b *blobStore) Get(ctx context.Context, URI archiver.URI, key string) ([]byte, error) {
+	if err := b.validateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	data, err := b.gcloudStorage.Get(ctx, URI, key)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, serviceerror.NewNotFoundf("blob %s not found", key)
+	}
+	return data, err
+}
+
+func (b *blobStore) Delete(ctx context.Context, URI archiver.URI, key string) error {
+	if err := b.validateURI(URI); err != nil {
+		return err
+	}
+	return b.gcloudStorage.Delete(ctx, URI, key)
+}
+
+func (b *blobStore) ValidateURI(URI archiver.URI) error {
+	if err := b.validateURI(URI); err != nil {
+		return err
+	}
+	_, err := b.gcloudStorage.Exist(context.Background(), URI, "")
+	return err
+}
+
+func (b *blobStore) validateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+	if URI.Path() == "" || URI.Hostname() == "" {
+		return archiver.ErrInvalidURI
+	}
+	return nil
+}
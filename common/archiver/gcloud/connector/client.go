@@ -34,6 +34,7 @@ type (
 		Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) ([]string, error)
 		QueryWithFilters(ctx context.Context, URI archiver.URI, fileNamePrefix string, pageSize, offset int, filters []Precondition) ([]string, bool, int, error)
 		Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error)
+		Delete(ctx context.Context, URI archiver.URI, fileName string) error
 	}
 
 	storageWrapper struct {
@@ -112,6 +113,16 @@ func (s *storageWrapper) Get(ctx context.Context, URI archiver.URI, fileName str
 	return io.ReadAll(reader)
 }
 
+// Delete removes a file, deleting a file that does not exist is not an error
+func (s *storageWrapper) Delete(ctx context.Context, URI archiver.URI, fileName string) error {
+	bucket := s.client.Bucket(URI.Hostname())
+	err := bucket.Object(formatSinkPath(URI.Path()) + "/" + fileName).Delete(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil
+	}
+	return err
+}
+
 // Query, retieves file names by provided storage query
 func (s *storageWrapper) Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) (fileNames []string, err error) {
 	fileNames = make([]string, 0)
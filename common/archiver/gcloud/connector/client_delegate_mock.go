@@ -161,6 +161,20 @@ func (mr *MockObjectHandleWrapperMockRecorder) Attrs(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attrs", reflect.TypeOf((*MockObjectHandleWrapper)(nil).Attrs), ctx)
 }
 
+// Delete mocks base method.
+func (m *MockObjectHandleWrapper) Delete(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockObjectHandleWrapperMockRecorder) Delete(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockObjectHandleWrapper)(nil).Delete), ctx)
+}
+
 // NewReader mocks base method.
 func (m *MockObjectHandleWrapper) NewReader(ctx context.Context) (ReaderWrapper, error) {
 	m.ctrl.T.Helper()
@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	historypb "go.temporal.io/api/history/v1"
@@ -30,8 +33,10 @@ const (
 
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 	errEncodeHistory      = "failed to encode history batches"
+	errCompressHistory    = "failed to compress history batches"
 	errBucketHistory      = "failed to get google storage bucket handle"
 	errWriteFile          = "failed to write history to google storage"
+	errWriteManifest      = "failed to write history manifest to google storage"
 )
 
 type historyArchiver struct {
@@ -39,6 +44,7 @@ type historyArchiver struct {
 	logger           log.Logger
 	metricsHandler   metrics.Handler
 	gcloudStorage    connector.Client
+	compression      string
 
 	// only set in test code
 	historyIterator archiver.HistoryIterator
@@ -47,6 +53,7 @@ type historyArchiver struct {
 type progress struct {
 	CurrentPageNumber int
 	IteratorState     []byte
+	Manifest          *archiver.HistoryManifest
 }
 
 type getHistoryToken struct {
@@ -54,6 +61,7 @@ type getHistoryToken struct {
 	HighestPart          int
 	CurrentPart          int
 	BatchIdxOffset       int
+	HasManifest          bool
 }
 
 // NewHistoryArchiver creates a new gcloud storage HistoryArchiver
@@ -63,14 +71,19 @@ func NewHistoryArchiver(
 	metricsHandler metrics.Handler,
 	config *config.GstorageArchiver,
 ) (archiver.HistoryArchiver, error) {
+	if err := archiver.ValidateHistoryCompression(config.HistoryCompression); err != nil {
+		return nil, err
+	}
 	storage, err := connector.NewClient(context.Background(), config)
 	if err == nil {
-		return newHistoryArchiver(executionManager, logger, metricsHandler, nil, storage), nil
+		historyArchiver := newHistoryArchiver(executionManager, logger, metricsHandler, nil, storage)
+		historyArchiver.compression = config.HistoryCompression
+		return historyArchiver, nil
 	}
 	return nil, err
 }
 
-func newHistoryArchiver(executionManager persistence.ExecutionManager, logger log.Logger, metricsHandler metrics.Handler, historyIterator archiver.HistoryIterator, storage connector.Client) archiver.HistoryArchiver {
+func newHistoryArchiver(executionManager persistence.ExecutionManager, logger log.Logger, metricsHandler metrics.Handler, historyIterator archiver.HistoryIterator, storage connector.Client) *historyArchiver {
 	return &historyArchiver{
 		executionManager: executionManager,
 		logger:           logger,
@@ -126,6 +139,9 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 	if historyIterator == nil { // will only be set by testing code
 		historyIterator, _ = loadHistoryIterator(ctx, request, h.executionManager, featureCatalog, &progress)
 	}
+	if progress.Manifest == nil {
+		progress.Manifest = &archiver.HistoryManifest{}
+	}
 
 	encoder := codec.NewJSONPBEncoder()
 
@@ -161,16 +177,30 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 			return errUploadNonRetryable
 		}
+		compressedHistoryPart, err := archiver.CompressHistoryBlob(encodedHistoryPart, h.compression)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errCompressHistory), tag.Error(err))
+			return errUploadNonRetryable
+		}
 
 		filename := constructHistoryFilenameMultipart(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, part)
 		if exist, _ := h.gcloudStorage.Exist(ctx, URI, filename); !exist {
-			if err := h.gcloudStorage.Upload(ctx, URI, filename, encodedHistoryPart); err != nil {
+			if err := h.gcloudStorage.Upload(ctx, URI, filename, compressedHistoryPart); err != nil {
 				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 				metrics.HistoryArchiverArchiveTransientErrorCount.With(handler).Record(1)
 				return err
 			}
+			progress.Manifest.AddBlob(filename, compressedHistoryPart)
 
-			totalUploadSize = totalUploadSize + int64(binary.Size(encodedHistoryPart))
+			totalUploadSize = totalUploadSize + int64(binary.Size(compressedHistoryPart))
+		} else if !progress.Manifest.HasBlob(filename) {
+			// The part was uploaded by an attempt that didn't record its progress, maybe with another compression.
+			existingHistoryPart, err := h.gcloudStorage.Get(ctx, URI, filename)
+			if err != nil {
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+				return err
+			}
+			progress.Manifest.AddBlob(filename, existingHistoryPart)
 		}
 
 		if err := saveHistoryIteratorState(ctx, featureCatalog, historyIterator, part, &progress); err != nil {
@@ -178,6 +208,17 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 		}
 	}
 
+	encodedManifest, err := archiver.EncodeHistoryManifest(progress.Manifest)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return errUploadNonRetryable
+	}
+	manifestFilename := constructHistoryManifestFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	if err := h.gcloudStorage.Upload(ctx, URI, manifestFilename, encodedManifest); err != nil {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteManifest), tag.Error(err))
+		return err
+	}
+
 	metrics.HistoryArchiverTotalUploadSize.With(handler).Record(totalUploadSize)
 	metrics.HistoryArchiverHistorySize.With(handler).Record(totalUploadSize)
 	metrics.HistoryArchiverArchiveSuccessCount.With(handler).Record(1)
@@ -205,7 +246,7 @@ func (h *historyArchiver) Get(ctx context.Context, URI archiver.URI, request *ar
 			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
 		}
 	} else {
-		highestVersion, historyhighestPart, historyCurrentPart, err := h.getHighestVersion(ctx, URI, request)
+		highestVersion, historyhighestPart, historyCurrentPart, hasManifest, err := h.getHighestVersion(ctx, URI, request)
 		if err != nil {
 			return nil, serviceerror.NewUnavailable(err.Error())
 		}
@@ -217,13 +258,27 @@ func (h *historyArchiver) Get(ctx context.Context, URI archiver.URI, request *ar
 			HighestPart:          *historyhighestPart,
 			CurrentPart:          *historyCurrentPart,
 			BatchIdxOffset:       0,
+			HasManifest:          hasManifest,
+		}
+	}
+
+	// Histories archived before manifests were written don't have one, and aren't verified.
+	var manifest *archiver.HistoryManifest
+	if token.HasManifest {
+		manifestFilename := constructHistoryManifestFilename(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
+		encodedManifest, err := h.gcloudStorage.Get(ctx, URI, manifestFilename)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(err.Error())
+		}
+		if manifest, err = archiver.DecodeHistoryManifest(encodedManifest); err != nil {
+			h.recordCorruptedHistory()
+			return nil, serviceerror.NewDataLoss(err.Error())
 		}
 	}
 
 	response := &archiver.GetHistoryResponse{}
 	response.HistoryBatches = []*historypb.History{}
 	numOfEvents := 0
-	encoder := codec.NewJSONPBEncoder()
 
 outer:
 	for token.CurrentPart <= token.HighestPart {
@@ -237,8 +292,12 @@ outer:
 			return nil, serviceerror.NewInternal("Fail retrieving history file: " + URI.String() + "/" + filename)
 		}
 
-		batches, err := encoder.DecodeHistories(encodedHistoryBatches)
+		batches, err := h.decodeHistoryPart(manifest, filename, encodedHistoryBatches)
 		if err != nil {
+			if errors.Is(err, archiver.ErrHistoryCorrupted) {
+				h.recordCorruptedHistory()
+				return nil, serviceerror.NewDataLoss(err.Error())
+			}
 			return nil, serviceerror.NewInternal(err.Error())
 		}
 		// trim the batches in the beginning based on token.BatchIdxOffset
@@ -276,6 +335,117 @@ outer:
 	return response, nil
 }
 
+// VerifyHistories verifies every history under the URI against its manifest, and checks that it can be decoded.
+// Histories are reported by the prefix of their file names.
+func (h *historyArchiver) VerifyHistories(ctx context.Context, URI archiver.URI, report func(key string, err error) error) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return err
+	}
+
+	filenames, err := h.gcloudStorage.Query(ctx, URI, "")
+	if err != nil {
+		return err
+	}
+	// History files are named <hash>_<version>_<part>.history, and manifests <hash>_<version>.manifest.
+	histories := make(map[string][]string)
+	for _, filename := range filenames {
+		filename = filepath.Base(filename)
+		var prefix string
+		switch {
+		case strings.HasSuffix(filename, ".history"):
+			idx := strings.LastIndex(filename, "_")
+			if idx == -1 {
+				continue
+			}
+			prefix = filename[:idx]
+		case strings.HasSuffix(filename, ".manifest"):
+			prefix = strings.TrimSuffix(filename, ".manifest")
+		default:
+			continue
+		}
+		histories[prefix] = append(histories[prefix], filename)
+	}
+	prefixes := make([]string, 0, len(histories))
+	for prefix := range histories {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	for _, prefix := range prefixes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		verifyErr := h.verifyHistory(ctx, URI, histories[prefix])
+		if errors.Is(verifyErr, archiver.ErrHistoryCorrupted) {
+			h.recordCorruptedHistory()
+		}
+		if err := report(prefix, verifyErr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *historyArchiver) verifyHistory(ctx context.Context, URI archiver.URI, filenames []string) error {
+	var manifest *archiver.HistoryManifest
+	partFilenames := make(map[string]struct{}, len(filenames))
+	for _, filename := range filenames {
+		if !strings.HasSuffix(filename, ".manifest") {
+			partFilenames[filename] = struct{}{}
+			continue
+		}
+		encodedManifest, err := h.gcloudStorage.Get(ctx, URI, filename)
+		if err != nil {
+			return err
+		}
+		if manifest, err = archiver.DecodeHistoryManifest(encodedManifest); err != nil {
+			return err
+		}
+	}
+	if manifest != nil {
+		for _, blob := range manifest.Blobs {
+			if _, ok := partFilenames[blob.Name]; !ok {
+				return fmt.Errorf("%w: %s is missing", archiver.ErrHistoryCorrupted, blob.Name)
+			}
+		}
+	}
+
+	for filename := range partFilenames {
+		encodedHistoryBatches, err := h.gcloudStorage.Get(ctx, URI, filename)
+		if err != nil {
+			return err
+		}
+		if _, err := h.decodeHistoryPart(manifest, filename, encodedHistoryBatches); err != nil {
+			if errors.Is(err, archiver.ErrHistoryCorrupted) {
+				return err
+			}
+			return fmt.Errorf("%w: %v", archiver.ErrHistoryCorrupted, err)
+		}
+	}
+	return nil
+}
+
+// decodeHistoryPart verifies a stored history part against the manifest, if any, and decodes it.
+func (h *historyArchiver) decodeHistoryPart(manifest *archiver.HistoryManifest, filename string, data []byte) ([]*historypb.History, error) {
+	if manifest != nil {
+		if err := manifest.VerifyBlob(filename, data); err != nil {
+			return nil, err
+		}
+	}
+	encodedHistoryBatches, err := archiver.DecompressHistoryBlob(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", archiver.ErrHistoryCorrupted, err)
+	}
+	encoder := codec.NewJSONPBEncoder()
+	return encoder.DecodeHistories(encodedHistoryBatches)
+}
+
+func (h *historyArchiver) recordCorruptedHistory() {
+	metrics.HistoryArchiverCorruptedHistoryCount.With(
+		h.metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryArchiverScope)),
+	).Record(1)
+}
+
 // ValidateURI is used to define what a valid URI for an implementation is.
 func (h *historyArchiver) ValidateURI(URI archiver.URI) (err error) {
 
@@ -313,12 +483,12 @@ func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*h
 	return lastFailoverVersion != request.CloseFailoverVersion || lastEventID+1 != request.NextEventID
 }
 
-func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.URI, request *archiver.GetHistoryRequest) (*int64, *int, *int, error) {
+func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.URI, request *archiver.GetHistoryRequest) (*int64, *int, *int, bool, error) {
 
 	filenames, err := h.gcloudStorage.Query(ctx, URI, constructHistoryFilenamePrefix(request.NamespaceID, request.WorkflowID, request.RunID))
 
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, false, err
 	}
 
 	var highestVersion *int64
@@ -349,7 +519,18 @@ func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.UR
 
 	}
 
-	return highestVersion, highestVersionPart, lowestVersionPart, nil
+	hasManifest := false
+	if highestVersion != nil {
+		manifestFilename := constructHistoryManifestFilename(request.NamespaceID, request.WorkflowID, request.RunID, *highestVersion)
+		for _, filename := range filenames {
+			if filepath.Base(filename) == manifestFilename {
+				hasManifest = true
+				break
+			}
+		}
+	}
+
+	return highestVersion, highestVersionPart, lowestVersionPart, hasManifest, nil
 }
 
 func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *progress) (historyIterator archiver.HistoryIterator, err error) {
@@ -1,8 +1,10 @@
 package gcloud
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -338,7 +340,8 @@ func (h *historyArchiverSuite) TestArchive_Success() {
 
 	storageWrapper := connector.NewMockClient(h.controller)
 	storageWrapper.EXPECT().Exist(ctx, h.testArchivalURI, gomock.Any()).Return(false, nil).Times(2)
-	storageWrapper.EXPECT().Upload(ctx, h.testArchivalURI, gomock.Any(), gomock.Any()).Return(nil)
+	storageWrapper.EXPECT().Upload(ctx, h.testArchivalURI, "141323698701063509081739672280485489488911532452831150339470_100_0.history", gomock.Any()).Return(nil)
+	storageWrapper.EXPECT().Upload(ctx, h.testArchivalURI, "141323698701063509081739672280485489488911532452831150339470_100.manifest", gomock.Any()).Return(nil)
 
 	historyIterator := archiver.NewMockHistoryIterator(h.controller)
 	historyBatches := []*historypb.History{
@@ -586,3 +589,120 @@ func (h *historyArchiverSuite) TestGet_NoHistory() {
 	_, err := historyArchiver.Get(ctx, h.testArchivalURI, request)
 	h.Assert().IsType(&serviceerror.NotFound{}, err)
 }
+
+func (h *historyArchiverSuite) TestArchiveAndGet_CompressedWithManifest() {
+	ctx := context.Background()
+	storageWrapper := connector.NewMockClient(h.controller)
+	files := setupStorageEmulation(storageWrapper)
+
+	historyIterator := archiver.NewMockHistoryIterator(h.controller)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: []*historypb.History{
+			{
+				Events: []*historypb.HistoryEvent{
+					{
+						EventId:   testNextEventID - 1,
+						EventTime: timestamppb.New(time.Now().UTC()),
+						Version:   testCloseFailoverVersion,
+					},
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := newHistoryArchiver(h.executionManager, h.logger, h.metricsHandler, historyIterator, storageWrapper)
+	historyArchiver.compression = archiver.HistoryCompressionZstd
+	request := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	h.NoError(historyArchiver.Archive(ctx, h.testArchivalURI, request))
+
+	partFilename := constructHistoryFilenameMultipart(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion, 0)
+	h.Contains(files, partFilename)
+	h.Contains(files, constructHistoryManifestFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion))
+
+	getRequest := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	response, err := historyArchiver.Get(ctx, h.testArchivalURI, getRequest)
+	h.NoError(err)
+	h.Len(response.HistoryBatches, 1)
+	h.Equal(historyBlob.Body[0].Events[0].EventId, response.HistoryBatches[0].Events[0].EventId)
+
+	reported := map[string]error{}
+	h.NoError(historyArchiver.VerifyHistories(ctx, h.testArchivalURI, func(key string, err error) error {
+		reported[key] = err
+		return nil
+	}))
+	h.Len(reported, 1)
+	for _, err := range reported {
+		h.NoError(err)
+	}
+
+	files[partFilename][len(files[partFilename])-1] ^= 0xff
+	_, err = historyArchiver.Get(ctx, h.testArchivalURI, getRequest)
+	h.IsType(&serviceerror.DataLoss{}, err)
+
+	reported = map[string]error{}
+	h.NoError(historyArchiver.VerifyHistories(ctx, h.testArchivalURI, func(key string, err error) error {
+		reported[key] = err
+		return nil
+	}))
+	h.Len(reported, 1)
+	for _, err := range reported {
+		h.ErrorIs(err, archiver.ErrHistoryCorrupted)
+	}
+}
+
+func setupStorageEmulation(storageWrapper *connector.MockClient) map[string][]byte {
+	files := make(map[string][]byte)
+	storageWrapper.EXPECT().Exist(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, filename string) (bool, error) {
+			if filename == "" {
+				return true, nil
+			}
+			_, ok := files[filename]
+			return ok, nil
+		}).AnyTimes()
+	storageWrapper.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, filename string, data []byte) error {
+			files[filename] = bytes.Clone(data)
+			return nil
+		}).AnyTimes()
+	storageWrapper.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, filename string) ([]byte, error) {
+			data, ok := files[filename]
+			if !ok {
+				return nil, errors.New("object doesn't exist")
+			}
+			return data, nil
+		}).AnyTimes()
+	storageWrapper.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, prefix string) ([]string, error) {
+			var filenames []string
+			for filename := range files {
+				if strings.HasPrefix(filename, prefix) {
+					filenames = append(filenames, filename)
+				}
+			}
+			return filenames, nil
+		}).AnyTimes()
+	return files
+}
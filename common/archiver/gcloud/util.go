@@ -29,6 +29,11 @@ func constructHistoryFilenameMultipart(namespaceID, workflowID, runID string, ve
 	return fmt.Sprintf("%s_%v_%v.history", combinedHash, version, partNumber)
 }
 
+func constructHistoryManifestFilename(namespaceID, workflowID, runID string, version int64) string {
+	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
+	return fmt.Sprintf("%s_%v.manifest", combinedHash, version)
+}
+
 func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) string {
 	return strings.Join([]string{hash(namespaceID), hash(workflowID), hash(runID)}, "")
 }
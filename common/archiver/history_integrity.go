@@ -0,0 +1,163 @@
+package archiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// HistoryCompressionNone stores archived history blobs as they are encoded.
+	HistoryCompressionNone = "none"
+	// HistoryCompressionGzip compresses archived history blobs with gzip.
+	HistoryCompressionGzip = "gzip"
+	// HistoryCompressionZstd compresses archived history blobs with zstd.
+	HistoryCompressionZstd = "zstd"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+type (
+	// HistoryManifest is written with each archived history. It has the checksums of the blobs of the history, which
+	// are verified when the history is read.
+	HistoryManifest struct {
+		Blobs []*HistoryManifestBlob `json:"blobs"`
+	}
+
+	// HistoryManifestBlob is the checksum of one blob of an archived history, as it's stored.
+	HistoryManifestBlob struct {
+		Name   string `json:"name"`
+		Size   int    `json:"size"`
+		SHA256 string `json:"sha256"`
+	}
+)
+
+// ValidateHistoryCompression validates the history compression of an archiver config. Empty means none.
+func ValidateHistoryCompression(compression string) error {
+	switch compression {
+	case "", HistoryCompressionNone, HistoryCompressionGzip, HistoryCompressionZstd:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidCompression, compression)
+	}
+}
+
+// CompressHistoryBlob compresses an encoded history blob. The result is readable by DecompressHistoryBlob whatever
+// the compression.
+func CompressHistoryBlob(data []byte, compression string) ([]byte, error) {
+	switch compression {
+	case "", HistoryCompressionNone:
+		return data, nil
+	case HistoryCompressionGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case HistoryCompressionZstd:
+		w, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = w.Close() }()
+		return w.EncodeAll(data, nil), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidCompression, compression)
+	}
+}
+
+// DecompressHistoryBlob returns the encoded history of a blob written by CompressHistoryBlob. The compression is
+// detected from the blob, so that blobs archived without compression or before compression was configured are
+// returned as they are.
+func DecompressHistoryBlob(data []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, gzipMagic):
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = r.Close() }()
+		return io.ReadAll(r)
+	case bytes.HasPrefix(data, zstdMagic):
+		r, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return r.DecodeAll(data, nil)
+	default:
+		return data, nil
+	}
+}
+
+// AddBlob records the checksum of a stored blob, replacing the checksum already recorded for it, if any.
+func (m *HistoryManifest) AddBlob(name string, data []byte) {
+	blob := &HistoryManifestBlob{
+		Name:   name,
+		Size:   len(data),
+		SHA256: historyBlobChecksum(data),
+	}
+	for i, existing := range m.Blobs {
+		if existing.Name == name {
+			m.Blobs[i] = blob
+			return
+		}
+	}
+	m.Blobs = append(m.Blobs, blob)
+}
+
+// HasBlob returns whether the checksum of a blob is recorded.
+func (m *HistoryManifest) HasBlob(name string) bool {
+	for _, blob := range m.Blobs {
+		if blob.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyBlob returns ErrHistoryCorrupted if a stored blob is missing from the manifest or doesn't match its checksum.
+func (m *HistoryManifest) VerifyBlob(name string, data []byte) error {
+	for _, blob := range m.Blobs {
+		if blob.Name != name {
+			continue
+		}
+		if blob.Size != len(data) || blob.SHA256 != historyBlobChecksum(data) {
+			return fmt.Errorf("%w: checksum mismatch for %s", ErrHistoryCorrupted, name)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: %s is not in the manifest", ErrHistoryCorrupted, name)
+}
+
+// EncodeHistoryManifest encodes a manifest to be stored next to the history blobs.
+func EncodeHistoryManifest(manifest *HistoryManifest) ([]byte, error) {
+	return json.Marshal(manifest)
+}
+
+// DecodeHistoryManifest decodes a stored manifest. A manifest that can't be decoded is corrupted.
+func DecodeHistoryManifest(data []byte) (*HistoryManifest, error) {
+	manifest := &HistoryManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("%w: invalid manifest: %v", ErrHistoryCorrupted, err)
+	}
+	return manifest, nil
+}
+
+func historyBlobChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,49 @@
+package archiver
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryBlobCompression(t *testing.T) {
+	data := bytes.Repeat([]byte(`{"events":[{"eventId":1}]}`), 100)
+	for _, compression := range []string{"", HistoryCompressionNone, HistoryCompressionGzip, HistoryCompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			require.NoError(t, ValidateHistoryCompression(compression))
+			compressed, err := CompressHistoryBlob(data, compression)
+			require.NoError(t, err)
+			decompressed, err := DecompressHistoryBlob(compressed)
+			require.NoError(t, err)
+			require.Equal(t, data, decompressed)
+		})
+	}
+
+	require.ErrorIs(t, ValidateHistoryCompression("lz4"), ErrInvalidCompression)
+	_, err := CompressHistoryBlob(data, "lz4")
+	require.ErrorIs(t, err, ErrInvalidCompression)
+}
+
+func TestHistoryManifest(t *testing.T) {
+	manifest := &HistoryManifest{}
+	manifest.AddBlob("0", []byte("first"))
+	manifest.AddBlob("1", []byte("second"))
+	manifest.AddBlob("1", []byte("second, reuploaded"))
+	require.True(t, manifest.HasBlob("1"))
+	require.False(t, manifest.HasBlob("2"))
+
+	encoded, err := EncodeHistoryManifest(manifest)
+	require.NoError(t, err)
+	decoded, err := DecodeHistoryManifest(encoded)
+	require.NoError(t, err)
+	require.Len(t, decoded.Blobs, 2)
+
+	require.NoError(t, decoded.VerifyBlob("0", []byte("first")))
+	require.NoError(t, decoded.VerifyBlob("1", []byte("second, reuploaded")))
+	require.ErrorIs(t, decoded.VerifyBlob("1", []byte("second")), ErrHistoryCorrupted)
+	require.ErrorIs(t, decoded.VerifyBlob("2", []byte("third")), ErrHistoryCorrupted)
+
+	_, err = DecodeHistoryManifest([]byte("{"))
+	require.ErrorIs(t, err, ErrHistoryCorrupted)
+}
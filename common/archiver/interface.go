@@ -58,6 +58,14 @@ type (
 		ValidateURI(uri URI) error
 	}
 
+	// HistoryVerifier is implemented by history archivers that can check the integrity of every history archived
+	// under a URI.
+	HistoryVerifier interface {
+		// VerifyHistories calls report for each archived history under the URI, with the key identifying the archive
+		// in the store, and a nil error if the archive is intact. It stops and returns the error of report if any.
+		VerifyHistories(ctx context.Context, uri URI, report func(key string, err error) error) error
+	}
+
 	// QueryVisibilityRequest is the request to query archived visibility records
 	QueryVisibilityRequest struct {
 		NamespaceID   string
@@ -92,4 +100,18 @@ type (
 		// ValidateURI is used to define what a valid URI for an implementation is.
 		ValidateURI(uri URI) error
 	}
+
+	// BlobStore stores opaque blobs under keys relative to a URI. It shares the URI schemes and provider
+	// config of the history archivers and is used to offload cold history out of the primary database.
+	// Keys are relative paths made of the characters [A-Za-z0-9_./-].
+	BlobStore interface {
+		// Put stores the blob under the key, replacing any existing blob.
+		Put(ctx context.Context, uri URI, key string, data []byte) error
+		// Get returns the blob stored under the key, or a serviceerror.NotFound if there is none.
+		Get(ctx context.Context, uri URI, key string) ([]byte, error)
+		// Delete removes the blob stored under the key. Deleting a key that does not exist is not an error.
+		Delete(ctx context.Context, uri URI, key string) error
+		// ValidateURI is used to define what a valid URI for an implementation is.
+		ValidateURI(uri URI) error
+	}
 )
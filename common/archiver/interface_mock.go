@@ -90,6 +90,44 @@ func (mr *MockHistoryArchiverMockRecorder) ValidateURI(uri any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateURI", reflect.TypeOf((*MockHistoryArchiver)(nil).ValidateURI), uri)
 }
 
+// MockHistoryVerifier is a mock of HistoryVerifier interface.
+type MockHistoryVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryVerifierMockRecorder
+	isgomock struct{}
+}
+
+// MockHistoryVerifierMockRecorder is the mock recorder for MockHistoryVerifier.
+type MockHistoryVerifierMockRecorder struct {
+	mock *MockHistoryVerifier
+}
+
+// NewMockHistoryVerifier creates a new mock instance.
+func NewMockHistoryVerifier(ctrl *gomock.Controller) *MockHistoryVerifier {
+	mock := &MockHistoryVerifier{ctrl: ctrl}
+	mock.recorder = &MockHistoryVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryVerifier) EXPECT() *MockHistoryVerifierMockRecorder {
+	return m.recorder
+}
+
+// VerifyHistories mocks base method.
+func (m *MockHistoryVerifier) VerifyHistories(ctx context.Context, uri URI, report func(string, error) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyHistories", ctx, uri, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyHistories indicates an expected call of VerifyHistories.
+func (mr *MockHistoryVerifierMockRecorder) VerifyHistories(ctx, uri, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyHistories", reflect.TypeOf((*MockHistoryVerifier)(nil).VerifyHistories), ctx, uri, report)
+}
+
 // MockVisibilityArchiver is a mock of VisibilityArchiver interface.
 type MockVisibilityArchiver struct {
 	ctrl     *gomock.Controller
@@ -161,3 +199,84 @@ func (mr *MockVisibilityArchiverMockRecorder) ValidateURI(uri any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateURI", reflect.TypeOf((*MockVisibilityArchiver)(nil).ValidateURI), uri)
 }
+
+// MockBlobStore is a mock of BlobStore interface.
+type MockBlobStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockBlobStoreMockRecorder is the mock recorder for MockBlobStore.
+type MockBlobStoreMockRecorder struct {
+	mock *MockBlobStore
+}
+
+// NewMockBlobStore creates a new mock instance.
+func NewMockBlobStore(ctrl *gomock.Controller) *MockBlobStore {
+	mock := &MockBlobStore{ctrl: ctrl}
+	mock.recorder = &MockBlobStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobStore) EXPECT() *MockBlobStoreMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBlobStore) Delete(ctx context.Context, uri URI, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uri, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBlobStoreMockRecorder) Delete(ctx, uri, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBlobStore)(nil).Delete), ctx, uri, key)
+}
+
+// Get mocks base method.
+func (m *MockBlobStore) Get(ctx context.Context, uri URI, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, uri, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBlobStoreMockRecorder) Get(ctx, uri, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBlobStore)(nil).Get), ctx, uri, key)
+}
+
+// Put mocks base method.
+func (m *MockBlobStore) Put(ctx context.Context, uri URI, key string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, uri, key, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockBlobStoreMockRecorder) Put(ctx, uri, key, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockBlobStore)(nil).Put), ctx, uri, key, data)
+}
+
+// ValidateURI mocks base method.
+func (m *MockBlobStore) ValidateURI(uri URI) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateURI", uri)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateURI indicates an expected call of ValidateURI.
+func (mr *MockBlobStoreMockRecorder) ValidateURI(uri any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateURI", reflect.TypeOf((*MockBlobStore)(nil).ValidateURI), uri)
+}
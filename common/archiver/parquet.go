@@ -0,0 +1,193 @@
+package archiver
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/parquet-go/parquet-go"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/codec"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Visibility archivers that support the parquet format store archived visibility records under the URI path in Hive
+// style partitions:
+//
+//	namespace_id=<namespace ID>/close_date=<YYYY-MM-DD>/part-<id>.parquet
+//
+// Query engines ignore directories and files starting with '_' or '.', which archivers can use for records that
+// are not compacted into Parquet files yet.
+const (
+	ParquetNamespacePartitionPrefix = "namespace_id="
+	ParquetCloseDatePartitionPrefix = "close_date="
+	ParquetCloseDateLayout          = "2006-01-02"
+	ParquetFilePrefix               = "part-"
+	ParquetFileSuffix               = ".parquet"
+
+	DefaultParquetBatchSize = 1000
+)
+
+// ParquetVisibilityRow is the row of an archived visibility record in Parquet files. Timestamps and durations have
+// microsecond precision, which is the finest precision supported by most query engines.
+type ParquetVisibilityRow struct {
+	NamespaceID             string            `parquet:"namespace_id,dict"`
+	Namespace               string            `parquet:"namespace,dict"`
+	WorkflowID              string            `parquet:"workflow_id"`
+	RunID                   string            `parquet:"run_id"`
+	WorkflowTypeName        string            `parquet:"workflow_type_name,dict"`
+	StartTime               *time.Time        `parquet:"start_time,timestamp(microsecond),optional"`
+	ExecutionTime           *time.Time        `parquet:"execution_time,timestamp(microsecond),optional"`
+	CloseTime               time.Time         `parquet:"close_time,timestamp(microsecond)"`
+	Status                  string            `parquet:"status,dict"`
+	HistoryLength           int64             `parquet:"history_length"`
+	ExecutionDurationMicros *int64            `parquet:"execution_duration_micros,optional"`
+	Memo                    *string           `parquet:"memo,optional"`
+	SearchAttributes        map[string]string `parquet:"search_attributes"`
+	HistoryArchivalURI      string            `parquet:"history_archival_uri"`
+}
+
+// ParquetPartitionPath returns the path of the partition of the records of the namespace closed on the day of
+// closeTime.
+func ParquetPartitionPath(dirPath string, namespaceID string, closeTime time.Time) string {
+	return path.Join(
+		dirPath,
+		ParquetNamespacePartitionPrefix+namespaceID,
+		ParquetCloseDatePartitionPrefix+closeTime.UTC().Format(ParquetCloseDateLayout),
+	)
+}
+
+// ParseParquetCloseDatePartition returns the close date of a partition name. ok is false if the name is not a close
+// date partition.
+func ParseParquetCloseDatePartition(partition string) (closeDate time.Time, ok bool) {
+	dateString, found := strings.CutPrefix(partition, ParquetCloseDatePartitionPrefix)
+	if !found {
+		return time.Time{}, false
+	}
+	closeDate, err := time.Parse(ParquetCloseDateLayout, dateString)
+	return closeDate, err == nil
+}
+
+// EncodeParquetVisibilityRows writes the rows to a zstd compressed Parquet file.
+func EncodeParquetVisibilityRows(rows []ParquetVisibilityRow) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := parquet.Write(&buf, rows, parquet.Compression(&parquet.Zstd)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeParquetVisibilityRows reads the rows of a Parquet file written by EncodeParquetVisibilityRows.
+func DecodeParquetVisibilityRows(data []byte) ([]ParquetVisibilityRow, error) {
+	return parquet.Read[ParquetVisibilityRow](bytes.NewReader(data), int64(len(data)))
+}
+
+// ParquetVisibilityRecords sorts the rows by close time (desc) and hashed run ID (desc), and returns their records.
+// Duplicate rows, which are written again when a compaction fails after writing its Parquet file, are skipped.
+func ParquetVisibilityRecords(rows []ParquetVisibilityRow) ([]*archiverspb.VisibilityRecord, error) {
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].CloseTime.Equal(rows[j].CloseTime) {
+			return HashRunID(rows[i].RunID) > HashRunID(rows[j].RunID)
+		}
+		return rows[i].CloseTime.After(rows[j].CloseTime)
+	})
+	records := make([]*archiverspb.VisibilityRecord, 0, len(rows))
+	for i, row := range rows {
+		if i > 0 && row.RunID == rows[i-1].RunID && row.CloseTime.Equal(rows[i-1].CloseTime) {
+			continue
+		}
+		record, err := row.ToVisibilityRecord()
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// HashRunID returns the hash used to order records that closed at the same time.
+func HashRunID(runID string) string {
+	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(runID)))
+}
+
+// NewParquetVisibilityRow converts an archived visibility record to a Parquet row.
+func NewParquetVisibilityRow(record *archiverspb.VisibilityRecord) (ParquetVisibilityRow, error) {
+	row := ParquetVisibilityRow{
+		NamespaceID:        record.GetNamespaceId(),
+		Namespace:          record.GetNamespace(),
+		WorkflowID:         record.GetWorkflowId(),
+		RunID:              record.GetRunId(),
+		WorkflowTypeName:   record.GetWorkflowTypeName(),
+		StartTime:          parquetTimestamp(record.GetStartTime()),
+		ExecutionTime:      parquetTimestamp(record.GetExecutionTime()),
+		CloseTime:          record.GetCloseTime().AsTime().Truncate(time.Microsecond),
+		Status:             record.GetStatus().String(),
+		HistoryLength:      record.GetHistoryLength(),
+		SearchAttributes:   record.GetSearchAttributes(),
+		HistoryArchivalURI: record.GetHistoryArchivalUri(),
+	}
+	if record.ExecutionDuration != nil {
+		micros := record.GetExecutionDuration().AsDuration().Microseconds()
+		row.ExecutionDurationMicros = &micros
+	}
+	if record.Memo != nil {
+		memo, err := codec.NewJSONPBEncoder().Encode(record.GetMemo())
+		if err != nil {
+			return ParquetVisibilityRow{}, err
+		}
+		memoString := string(memo)
+		row.Memo = &memoString
+	}
+	return row, nil
+}
+
+// ToVisibilityRecord converts the row back to an archived visibility record.
+func (r *ParquetVisibilityRow) ToVisibilityRecord() (*archiverspb.VisibilityRecord, error) {
+	status, err := enumspb.WorkflowExecutionStatusFromString(r.Status)
+	if err != nil {
+		return nil, err
+	}
+	record := &archiverspb.VisibilityRecord{
+		NamespaceId:        r.NamespaceID,
+		Namespace:          r.Namespace,
+		WorkflowId:         r.WorkflowID,
+		RunId:              r.RunID,
+		WorkflowTypeName:   r.WorkflowTypeName,
+		CloseTime:          timestamppb.New(r.CloseTime),
+		Status:             status,
+		HistoryLength:      r.HistoryLength,
+		SearchAttributes:   r.SearchAttributes,
+		HistoryArchivalUri: r.HistoryArchivalURI,
+	}
+	if r.StartTime != nil {
+		record.StartTime = timestamppb.New(*r.StartTime)
+	}
+	if r.ExecutionTime != nil {
+		record.ExecutionTime = timestamppb.New(*r.ExecutionTime)
+	}
+	if r.ExecutionDurationMicros != nil {
+		record.ExecutionDuration = durationpb.New(time.Duration(*r.ExecutionDurationMicros) * time.Microsecond)
+	}
+	if r.Memo != nil {
+		record.Memo = &commonpb.Memo{}
+		if err := codec.NewJSONPBEncoder().Decode([]byte(*r.Memo), record.Memo); err != nil {
+			return nil, err
+		}
+	}
+	return record, nil
+}
+
+func parquetTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime().Truncate(time.Microsecond)
+	return &t
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/archiver/gcloud"
+	"go.temporal.io/server/common/archiver/s3store"
+	"go.temporal.io/server/common/archiver/webdav"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence"
+)
+
+type historyBlobStore struct {
+	store archiver.BlobStore
+	uri   archiver.URI
+}
+
+// NewBlobStore creates the archiver.BlobStore for the scheme using the history archiver provider configs.
+// Custom archivers do not support blob stores.
+func NewBlobStore(scheme string, configs *config.HistoryArchiverProvider) (archiver.BlobStore, error) {
+	if configs == nil {
+		return nil, ErrArchiverConfigNotFound
+	}
+	switch scheme {
+	case filestore.URIScheme:
+		if configs.Filestore == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return filestore.NewBlobStore(configs.Filestore)
+	case gcloud.URIScheme:
+		if configs.Gstorage == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return gcloud.NewBlobStore(configs.Gstorage)
+	case s3store.URIScheme:
+		if configs.S3store == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return s3store.NewBlobStore(configs.S3store)
+	case webdav.URIScheme:
+		if configs.Webdav == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		return webdav.NewBlobStore(configs.Webdav)
+	default:
+		return nil, ErrUnknownScheme
+	}
+}
+
+// NewHistoryBlobStore creates the persistence.HistoryBlobStore that stores offloaded history under the URI.
+func NewHistoryBlobStore(uri string, configs *config.HistoryArchiverProvider) (persistence.HistoryBlobStore, error) {
+	URI, err := archiver.NewURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	store, err := NewBlobStore(URI.Scheme(), configs)
+	if err != nil {
+		return nil, err
+	}
+	if err := store.ValidateURI(URI); err != nil {
+		return nil, err
+	}
+	return &historyBlobStore{store: store, uri: URI}, nil
+}
+
+func (s *historyBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	return s.store.Put(ctx, s.uri, key, data)
+}
+
+func (s *historyBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	return s.store.Get(ctx, s.uri, key)
+}
+
+func (s *historyBlobStore) Delete(ctx context.Context, key string) error {
+	return s.store.Delete(ctx, s.uri, key)
+}
@@ -10,6 +10,7 @@ import (
 	"go.temporal.io/server/common/archiver/filestore"
 	"go.temporal.io/server/common/archiver/gcloud"
 	"go.temporal.io/server/common/archiver/s3store"
+	"go.temporal.io/server/common/archiver/webdav"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -165,6 +166,11 @@ func (p *archiverProvider) GetHistoryArchiver(scheme string) (historyArchiver ar
 				return nil, ErrArchiverConfigNotFound
 			}
 			historyArchiver, err = s3store.NewHistoryArchiver(p.executionManager, p.logger, p.metricsHandler, p.historyArchiverConfigs.S3store)
+		case webdav.URIScheme:
+			if p.historyArchiverConfigs.Webdav == nil {
+				return nil, ErrArchiverConfigNotFound
+			}
+			historyArchiver, err = webdav.NewHistoryArchiver(p.executionManager, p.logger, p.metricsHandler, p.historyArchiverConfigs.Webdav)
 		default:
 			return nil, ErrUnknownScheme
 		}
@@ -227,6 +233,11 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme string) (archiver.Visibi
 				return nil, ErrArchiverConfigNotFound
 			}
 			visibilityArchiver, err = gcloud.NewVisibilityArchiver(p.logger, p.metricsHandler, p.visibilityArchiverConfigs.Gstorage)
+		case webdav.URIScheme:
+			if p.visibilityArchiverConfigs.Webdav == nil {
+				return nil, ErrArchiverConfigNotFound
+			}
+			visibilityArchiver, err = webdav.NewVisibilityArchiver(p.logger, p.metricsHandler, p.visibilityArchiverConfigs.Webdav)
 
 		default:
 			return nil, ErrUnknownScheme
@@ -0,0 +1,80 @@
+package s3store
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+type blobStore struct {
+	s3cli S3API
+}
+
+// NewBlobStore creates a new archiver.BlobStore based on s3
+func NewBlobStore(s3config *config.S3Archiver) (archiver.BlobStore, error) {
+	if len(s3config.Region) == 0 {
+		return nil, errEmptyAwsRegion
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(context.Background(),
+		awsconfig.WithRegion(s3config.Region),
+		awsconfig.WithClientLogMode(aws.ClientLogMode(s3config.LogLevel)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &blobStore{
+		s3cli: s3.NewFromConfig(cfg, func(o *s3.Options) {
+			o.BaseEndpoint = s3config.Endpoint
+			o.UsePathStyle = s3config.S3ForcePathStyle
+		}),
+	}, nil
+}
+
+func (b *blobStore) Put(ctx context.Context, URI archiver.URI, key string, data []byte) error {
+	if err := SoftValidateURI(URI); err != nil {
+		return err
+	}
+	return Upload(ctx, b.s3cli, URI, constructBlobKey(URI.Path(), key), data)
+}
+
+func (b *blobStore) Get(ctx context.Context, URI archiver.URI, key string) ([]byte, error) {
+	if err := SoftValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	data, err := Download(ctx, b.s3cli, URI, constructBlobKey(URI.Path(), key))
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return nil, serviceerror.NewNotFoundf("blob %s not found", key)
+	}
+	return data, err
+}
+
+func (b *blobStore) Delete(ctx context.Context, URI archiver.URI, key string) error {
+	if err := SoftValidateURI(URI); err != nil {
+		return err
+	}
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	// S3 does not return an error when deleting a key that does not exist.
+	_, err := b.s3cli.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(URI.Hostname()),
+		Key:    aws.String(constructBlobKey(URI.Path(), key)),
+	})
+	return err
+}
+
+func (b *blobStore) ValidateURI(URI archiver.URI) error {
+	if err := SoftValidateURI(URI); err != nil {
+		return err
+	}
+	return BucketExists(context.TODO(), b.s3cli, URI)
+}
+
+func constructBlobKey(path, key string) string {
+	return strings.TrimLeft(path+"/"+key, "/")
+}
@@ -6,6 +6,8 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -31,15 +33,19 @@ const (
 	// URIScheme is the scheme for the s3 implementation
 	URIScheme               = "s3"
 	errEncodeHistory        = "failed to encode history batches"
+	errCompressHistory      = "failed to compress history batches"
 	errWriteKey             = "failed to write history to s3"
+	errWriteManifest        = "failed to write history manifest to s3"
 	defaultBlobstoreTimeout = time.Minute
 	targetHistoryBlobSize   = 2 * 1024 * 1024 // 2MB
+	historyManifestKeyName  = "manifest"
 )
 
 var (
-	errNoBucketSpecified = errors.New("no bucket specified")
-	errBucketNotExists   = errors.New("requested bucket does not exist")
-	errEmptyAwsRegion    = errors.New("empty aws region")
+	errNoBucketSpecified       = errors.New("no bucket specified")
+	errBucketNotExists         = errors.New("requested bucket does not exist")
+	errEmptyAwsRegion          = errors.New("empty aws region")
+	errInvalidVisibilityFormat = errors.New("invalid visibility format")
 
 	// the retryer is used to check if the error is retryable
 	awsRetryer aws.Retryer = retry.NewStandard()
@@ -51,6 +57,7 @@ type (
 		logger           log.Logger
 		metricsHandler   metrics.Handler
 		s3cli            S3API
+		compression      string
 		// only set in test code
 		historyIterator archiver.HistoryIterator
 	}
@@ -63,6 +70,7 @@ type (
 	uploadProgress struct {
 		BatchIdx      int
 		IteratorState []byte
+		Manifest      *archiver.HistoryManifest
 		uploadedSize  int64
 		historySize   int64
 	}
@@ -88,6 +96,9 @@ func newHistoryArchiver(
 	if len(s3config.Region) == 0 {
 		return nil, errEmptyAwsRegion
 	}
+	if err := archiver.ValidateHistoryCompression(s3config.HistoryCompression); err != nil {
+		return nil, err
+	}
 	cfg, err := awsconfig.LoadDefaultConfig(context.Background(),
 		awsconfig.WithRegion(s3config.Region),
 		awsconfig.WithClientLogMode(aws.ClientLogMode(s3config.LogLevel)),
@@ -104,6 +115,7 @@ func newHistoryArchiver(
 			o.BaseEndpoint = s3config.Endpoint
 			o.UsePathStyle = s3config.S3ForcePathStyle
 		}),
+		compression:     s3config.HistoryCompression,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -148,6 +160,9 @@ func (h *historyArchiver) Archive(
 	if historyIterator == nil { // will only be set by testing code
 		historyIterator = loadHistoryIterator(ctx, request, h.executionManager, featureCatalog, &progress)
 	}
+	if progress.Manifest == nil {
+		progress.Manifest = &archiver.HistoryManifest{}
+	}
 	for historyIterator.HasNext() {
 		historyBlob, err := historyIterator.Next(ctx)
 		if err != nil {
@@ -180,6 +195,12 @@ func (h *historyArchiver) Archive(
 			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 			return err
 		}
+		compressedHistoryBlob, err := archiver.CompressHistoryBlob(encodedHistoryBlob, h.compression)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errCompressHistory), tag.Error(err))
+			return err
+		}
+		blobName := strconv.Itoa(progress.BatchIdx)
 		key := constructHistoryKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, progress.BatchIdx)
 
 		exists, err := KeyExists(ctx, h.s3cli, URI, key)
@@ -191,11 +212,24 @@ func (h *historyArchiver) Archive(
 			}
 			return err
 		}
-		blobSize := int64(binary.Size(encodedHistoryBlob))
+		blobSize := int64(binary.Size(compressedHistoryBlob))
 		if exists {
 			metrics.HistoryArchiverBlobExistsCount.With(handler).Record(1)
+			if !progress.Manifest.HasBlob(blobName) {
+				// The blob was uploaded by an attempt that didn't record its progress, maybe with another compression.
+				existingHistoryBlob, err := Download(ctx, h.s3cli, URI, key)
+				if err != nil {
+					if isRetryableError(err) {
+						logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
+					} else {
+						logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
+					}
+					return err
+				}
+				progress.Manifest.AddBlob(blobName, existingHistoryBlob)
+			}
 		} else {
-			if err := Upload(ctx, h.s3cli, URI, key, encodedHistoryBlob); err != nil {
+			if err := Upload(ctx, h.s3cli, URI, key, compressedHistoryBlob); err != nil {
 				if isRetryableError(err) {
 					logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
 				} else {
@@ -203,6 +237,7 @@ func (h *historyArchiver) Archive(
 				}
 				return err
 			}
+			progress.Manifest.AddBlob(blobName, compressedHistoryBlob)
 			progress.uploadedSize += blobSize
 			handler.Histogram(metrics.HistoryArchiverBlobSize.Name(), metrics.HistoryArchiverBlobSize.Unit()).Record(blobSize)
 		}
@@ -212,6 +247,21 @@ func (h *historyArchiver) Archive(
 		saveHistoryIteratorState(ctx, featureCatalog, historyIterator, &progress)
 	}
 
+	encodedManifest, err := archiver.EncodeHistoryManifest(progress.Manifest)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
+	manifestKey := constructHistoryManifestKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	if err := Upload(ctx, h.s3cli, URI, manifestKey, encodedManifest); err != nil {
+		if isRetryableError(err) {
+			logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteManifest), tag.Error(err))
+		} else {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteManifest), tag.Error(err))
+		}
+		return err
+	}
+
 	handler.Histogram(metrics.HistoryArchiverTotalUploadSize.Name(), metrics.HistoryArchiverTotalUploadSize.Unit()).Record(progress.uploadedSize)
 	handler.Histogram(metrics.HistoryArchiverHistorySize.Name(), metrics.HistoryArchiverHistorySize.Unit()).Record(progress.historySize)
 	metrics.HistoryArchiverArchiveSuccessCount.With(handler).Record(1)
@@ -230,6 +280,7 @@ func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRe
 			}
 			progress.IteratorState = nil
 			progress.BatchIdx = 0
+			progress.Manifest = nil
 			progress.historySize = 0
 			progress.uploadedSize = 0
 		}
@@ -288,7 +339,10 @@ func (h *historyArchiver) Get(
 			CloseFailoverVersion: *highestVersion,
 		}
 	}
-	encoder := codec.NewJSONPBEncoder()
+	manifest, err := h.getManifest(ctx, URI, request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
+	if err != nil {
+		return nil, h.convertGetError(err)
+	}
 	response := &archiver.GetHistoryResponse{}
 	numOfEvents := 0
 	isTruncated := false
@@ -301,21 +355,12 @@ func (h *historyArchiver) Get(
 
 		encodedRecord, err := Download(ctx, h.s3cli, URI, key)
 		if err != nil {
-			if isRetryableError(err) {
-				return nil, serviceerror.NewUnavailable(err.Error())
-			}
-			switch err.(type) {
-			case *serviceerror.InvalidArgument, *serviceerror.Unavailable, *serviceerror.NotFound:
-				return nil, err
-			default:
-				return nil, serviceerror.NewInternal(err.Error())
-			}
+			return nil, h.convertGetError(err)
 		}
 
-		historyBlob := archiverspb.HistoryBlob{}
-		err = encoder.Decode(encodedRecord, &historyBlob)
+		historyBlob, err := h.decodeHistoryBlob(manifest, strconv.Itoa(token.BatchIdx), encodedRecord)
 		if err != nil {
-			return nil, serviceerror.NewInternal(err.Error())
+			return nil, h.convertGetError(err)
 		}
 
 		for _, batch := range historyBlob.Body {
@@ -340,6 +385,182 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+// VerifyHistories verifies every history under the URI against its manifest, and checks that it can be decoded.
+// Histories are reported by the prefix of their keys.
+func (h *historyArchiver) VerifyHistories(
+	ctx context.Context,
+	URI archiver.URI,
+	report func(key string, err error) error,
+) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return err
+	}
+
+	histories, err := h.listHistories(ctx, URI)
+	if err != nil {
+		return err
+	}
+	prefixes := make([]string, 0, len(histories))
+	for prefix := range histories {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	for _, prefix := range prefixes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		verifyErr := h.verifyHistory(ctx, URI, prefix, histories[prefix])
+		if errors.Is(verifyErr, archiver.ErrHistoryCorrupted) {
+			h.recordCorruptedHistory()
+		}
+		if err := report(prefix, verifyErr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listHistories returns the names of the objects of each history under the URI, by the prefix of their keys.
+func (h *historyArchiver) listHistories(ctx context.Context, URI archiver.URI) (map[string][]string, error) {
+	listPrefix := strings.TrimLeft(URI.Path()+"/", "/")
+	histories := make(map[string][]string)
+	var continuationToken *string
+	for {
+		listCtx, cancel := ensureContextTimeout(ctx)
+		results, err := h.s3cli.ListObjectsV2(listCtx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(URI.Hostname()),
+			Prefix:            aws.String(listPrefix),
+			ContinuationToken: continuationToken,
+		})
+		cancel()
+		if err != nil {
+			if _, ok := errors.AsType[*types.NoSuchBucket](err); ok {
+				return nil, serviceerror.NewInvalidArgument(errBucketNotExists.Error())
+			}
+			return nil, err
+		}
+		for _, object := range results.Contents {
+			key := aws.ToString(object.Key)
+			// History keys are <path>/<namespaceID>/history/<workflowID>/<runID>/<version>/<name>.
+			parts := strings.SplitN(strings.TrimPrefix(key, listPrefix), "/", 3)
+			if len(parts) < 3 || parts[1] != "history" {
+				continue
+			}
+			idx := strings.LastIndex(key, "/")
+			histories[key[:idx+1]] = append(histories[key[:idx+1]], key[idx+1:])
+		}
+		if !aws.ToBool(results.IsTruncated) {
+			return histories, nil
+		}
+		continuationToken = results.NextContinuationToken
+	}
+}
+
+func (h *historyArchiver) verifyHistory(ctx context.Context, URI archiver.URI, prefix string, names []string) error {
+	var manifest *archiver.HistoryManifest
+	blobNames := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if name != historyManifestKeyName {
+			blobNames[name] = struct{}{}
+			continue
+		}
+		encodedManifest, err := Download(ctx, h.s3cli, URI, prefix+name)
+		if err != nil {
+			return err
+		}
+		if manifest, err = archiver.DecodeHistoryManifest(encodedManifest); err != nil {
+			return err
+		}
+	}
+	if manifest != nil {
+		for _, blob := range manifest.Blobs {
+			if _, ok := blobNames[blob.Name]; !ok {
+				return fmt.Errorf("%w: %s is missing", archiver.ErrHistoryCorrupted, prefix+blob.Name)
+			}
+		}
+	}
+
+	for name := range blobNames {
+		encodedRecord, err := Download(ctx, h.s3cli, URI, prefix+name)
+		if err != nil {
+			return err
+		}
+		if _, err := h.decodeHistoryBlob(manifest, name, encodedRecord); err != nil {
+			if errors.Is(err, archiver.ErrHistoryCorrupted) {
+				return err
+			}
+			return fmt.Errorf("%w: %v", archiver.ErrHistoryCorrupted, err)
+		}
+	}
+	return nil
+}
+
+// getManifest returns the manifest of a history, or nil for histories archived before manifests were written.
+func (h *historyArchiver) getManifest(
+	ctx context.Context,
+	URI archiver.URI,
+	namespaceID string,
+	workflowID string,
+	runID string,
+	version int64,
+) (*archiver.HistoryManifest, error) {
+	key := constructHistoryManifestKey(URI.Path(), namespaceID, workflowID, runID, version)
+	encodedManifest, err := Download(ctx, h.s3cli, URI, key)
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return archiver.DecodeHistoryManifest(encodedManifest)
+}
+
+// decodeHistoryBlob verifies a stored history blob against the manifest, if any, and decodes it.
+func (h *historyArchiver) decodeHistoryBlob(
+	manifest *archiver.HistoryManifest,
+	name string,
+	data []byte,
+) (*archiverspb.HistoryBlob, error) {
+	if manifest != nil {
+		if err := manifest.VerifyBlob(name, data); err != nil {
+			return nil, err
+		}
+	}
+	encodedRecord, err := archiver.DecompressHistoryBlob(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", archiver.ErrHistoryCorrupted, err)
+	}
+	historyBlob := &archiverspb.HistoryBlob{}
+	encoder := codec.NewJSONPBEncoder()
+	if err := encoder.Decode(encodedRecord, historyBlob); err != nil {
+		return nil, err
+	}
+	return historyBlob, nil
+}
+
+func (h *historyArchiver) convertGetError(err error) error {
+	if errors.Is(err, archiver.ErrHistoryCorrupted) {
+		h.recordCorruptedHistory()
+		return serviceerror.NewDataLoss(err.Error())
+	}
+	if isRetryableError(err) {
+		return serviceerror.NewUnavailable(err.Error())
+	}
+	switch err.(type) {
+	case *serviceerror.InvalidArgument, *serviceerror.Unavailable, *serviceerror.NotFound:
+		return err
+	default:
+		return serviceerror.NewInternal(err.Error())
+	}
+}
+
+func (h *historyArchiver) recordCorruptedHistory() {
+	metrics.HistoryArchiverCorruptedHistoryCount.With(
+		h.metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryArchiverScope)),
+	).Record(1)
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	err := SoftValidateURI(URI)
 	if err != nil {
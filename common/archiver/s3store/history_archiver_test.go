@@ -197,6 +197,12 @@ func setupFsEmulation(s3cli *mocks.MockS3API) {
 				Body: io.NopCloser(bytes.NewReader(fs[*input.Bucket+*input.Key])),
 			}, nil
 		}).AnyTimes()
+
+	s3cli.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *s3.DeleteObjectInput, options ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+			delete(fs, *input.Bucket+*input.Key)
+			return &s3.DeleteObjectOutput{}, nil
+		}).AnyTimes()
 }
 
 func (s *historyArchiverSuite) TestValidateURI() {
@@ -669,6 +675,70 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndGet_CompressedWithManifest() {
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(s.historyBatchesV100[0], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(s.historyBatchesV100[1], nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+	s.s3cli.EXPECT().HeadBucket(gomock.Any(), gomock.Any()).Return(&s3.HeadBucketOutput{}, nil).AnyTimes()
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	historyArchiver.compression = archiver.HistoryCompressionGzip
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchiveAndGet_CompressedWithManifest")
+	s.NoError(err)
+	s.NoError(historyArchiver.Archive(context.Background(), URI, archiveRequest))
+	s.assertKeyExists(constructHistoryManifestKey(URI.Path(), testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion))
+
+	getRequest := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+
+	reported := map[string]error{}
+	s.NoError(historyArchiver.VerifyHistories(context.Background(), URI, func(key string, err error) error {
+		reported[key] = err
+		return nil
+	}))
+	prefix := constructHistoryKeyPrefixWithVersion(URI.Path(), testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.Equal(map[string]error{prefix: nil}, reported)
+
+	// Overwrite the second part with a valid blob that doesn't match the manifest.
+	encoder := codec.NewJSONPBEncoder()
+	data, err := encoder.Encode(s.historyBatchesV100[1])
+	s.NoError(err)
+	key := constructHistoryKey(URI.Path(), testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion, 1)
+	s.NoError(Upload(context.Background(), s.s3cli, URI, key, data))
+
+	_, err = historyArchiver.Get(context.Background(), URI, getRequest)
+	s.IsType(&serviceerror.DataLoss{}, err)
+
+	reported = map[string]error{}
+	s.NoError(historyArchiver.VerifyHistories(context.Background(), URI, func(key string, err error) error {
+		reported[key] = err
+		return nil
+	}))
+	s.Len(reported, 1)
+	s.ErrorIs(reported[prefix], archiver.ErrHistoryCorrupted)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	archiver := &historyArchiver{
 		executionManager: s.executionManager,
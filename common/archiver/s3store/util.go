@@ -122,11 +122,25 @@ func constructHistoryKeyPrefixWithVersion(path, namespaceID, workflowID, runID s
 	return fmt.Sprintf("%s/%v/", prefix, version)
 }
 
+func constructHistoryManifestKey(path, namespaceID, workflowID, runID string, version int64) string {
+	return constructHistoryKeyPrefixWithVersion(path, namespaceID, workflowID, runID, version) + historyManifestKeyName
+}
+
 func constructHistoryKeyPrefix(path, namespaceID, workflowID, runID string) string {
 	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "history", workflowID, runID}, "/"), "/")
 }
 
 func constructTimeBasedSearchKey(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, t time.Time, precision string) string {
+	return fmt.Sprintf(
+		"%s/%s",
+		constructIndexedVisibilitySearchPrefix(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey),
+		t.Format(searchPrecisionTimeFormat(precision)),
+	)
+}
+
+// searchPrecisionTimeFormat returns the layout of the prefix of RFC3339 timestamps that is searched with the
+// precision.
+func searchPrecisionTimeFormat(precision string) string {
 	var timeFormat = ""
 	switch precision {
 	case PrecisionSecond:
@@ -141,12 +155,7 @@ func constructTimeBasedSearchKey(path, namespaceID, primaryIndexKey, primaryInde
 	case PrecisionDay:
 		timeFormat = "2006-01-02T" + timeFormat
 	}
-
-	return fmt.Sprintf(
-		"%s/%s",
-		constructIndexedVisibilitySearchPrefix(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey),
-		t.Format(timeFormat),
-	)
+	return timeFormat
 }
 
 func constructTimestampIndex(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, secondaryIndexValue time.Time, runID string) string {
@@ -45,6 +45,11 @@ type (
 )
 
 const (
+	// VisibilityFormatJSON stores each visibility record in one object per search index.
+	VisibilityFormatJSON = "json"
+	// VisibilityFormatParquet batches visibility records into Parquet objects partitioned by namespace and close date.
+	VisibilityFormatParquet = "parquet"
+
 	errEncodeVisibilityRecord       = "failed to encode visibility record"
 	secondaryIndexKeyStartTimeout   = "startTimeout"
 	secondaryIndexKeyCloseTimeout   = "closeTimeout"
@@ -58,7 +63,18 @@ func NewVisibilityArchiver(
 	metricsHandler metrics.Handler,
 	config *config.S3Archiver,
 ) (archiver.VisibilityArchiver, error) {
-	return newVisibilityArchiver(logger, metricsHandler, config)
+	switch config.VisibilityFormat {
+	case "", VisibilityFormatJSON:
+		return newVisibilityArchiver(logger, metricsHandler, config)
+	case VisibilityFormatParquet:
+		s3cli, err := newS3Client(config)
+		if err != nil {
+			return nil, err
+		}
+		return newParquetVisibilityArchiver(logger, metricsHandler, s3cli, config.ParquetBatchSize), nil
+	default:
+		return nil, errInvalidVisibilityFormat
+	}
 }
 
 func newVisibilityArchiver(
@@ -66,24 +82,32 @@ func newVisibilityArchiver(
 	metricsHandler metrics.Handler,
 	s3config *config.S3Archiver,
 ) (*visibilityArchiver, error) {
-	cfg, err := awsconfig.LoadDefaultConfig(context.Background(),
-		awsconfig.WithRegion(s3config.Region),
-		awsconfig.WithClientLogMode(aws.ClientLogMode(s3config.LogLevel)),
-	)
+	s3cli, err := newS3Client(s3config)
 	if err != nil {
 		return nil, err
 	}
 	return &visibilityArchiver{
 		logger:         logger,
 		metricsHandler: metricsHandler,
-		s3cli: s3.NewFromConfig(cfg, func(o *s3.Options) {
-			o.BaseEndpoint = s3config.Endpoint
-			o.UsePathStyle = s3config.S3ForcePathStyle
-		}),
-		queryParser: NewQueryParser(),
+		s3cli:          s3cli,
+		queryParser:    NewQueryParser(),
 	}, nil
 }
 
+func newS3Client(s3config *config.S3Archiver) (*s3.Client, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(context.Background(),
+		awsconfig.WithRegion(s3config.Region),
+		awsconfig.WithClientLogMode(aws.ClientLogMode(s3config.LogLevel)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.BaseEndpoint = s3config.Endpoint
+		o.UsePathStyle = s3config.S3ForcePathStyle
+	}), nil
+}
+
 func (v *visibilityArchiver) Archive(
 	ctx context.Context,
 	URI archiver.URI,
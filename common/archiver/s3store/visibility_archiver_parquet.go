@@ -0,0 +1,488 @@
+package s3store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+// Archived visibility records in the parquet format are stored under the URI path in the partitions described by
+// archiver.ParquetPartitionPath.
+//
+// Archive can't batch records in memory without losing them on restarts, so it first stages each record in its own
+// object under the _staging prefix of the partition. Once a partition has enough staged records, or once its close
+// date is over, the staged records are written to a new Parquet object and deleted. S3 has no atomic rename, so
+// records are not claimed by a compaction: concurrent compactions of a partition, or a compaction that fails after
+// writing its Parquet object, write the same records to several Parquet objects. Query skips the duplicates, and
+// other consumers of the Parquet objects should deduplicate the rows by run_id and close_time.
+const (
+	parquetStagingPrefix = "_staging"
+
+	errStageVisibilityRecord    = "failed to stage visibility record"
+	errCompactVisibilityRecords = "failed to compact visibility records"
+)
+
+type (
+	parquetVisibilityArchiver struct {
+		logger         log.Logger
+		metricsHandler metrics.Handler
+		s3cli          S3API
+		batchSize      int
+		queryParser    QueryParser
+		timeSource     clock.TimeSource
+	}
+
+	parquetQueryVisibilityToken struct {
+		LastCloseTime time.Time
+		LastRunID     string
+	}
+)
+
+func newParquetVisibilityArchiver(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	s3cli S3API,
+	batchSize int,
+) *parquetVisibilityArchiver {
+	if batchSize <= 0 {
+		batchSize = archiver.DefaultParquetBatchSize
+	}
+	return &parquetVisibilityArchiver{
+		logger:         logger,
+		metricsHandler: metricsHandler,
+		s3cli:          s3cli,
+		batchSize:      batchSize,
+		queryParser:    NewQueryParser(),
+		timeSource:     clock.NewRealTimeSource(),
+	}
+}
+
+func (v *parquetVisibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverspb.VisibilityRecord,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	handler := v.metricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityArchiverScope), metrics.NamespaceTag(request.Namespace))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	startTime := time.Now().UTC()
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.logger, request, URI.String())
+	archiveFailReason := ""
+	defer func() {
+		metrics.ServiceLatency.With(handler).Record(time.Since(startTime))
+		if err != nil {
+			if isRetryableError(err) {
+				metrics.VisibilityArchiverArchiveTransientErrorCount.With(handler).Record(1)
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+			} else {
+				metrics.VisibilityArchiverArchiveNonRetryableErrorCount.With(handler).Record(1)
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+				if featureCatalog.NonRetryableError != nil {
+					err = featureCatalog.NonRetryableError()
+				}
+			}
+		}
+	}()
+
+	if err := SoftValidateURI(URI); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidURI
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidArchiveRequest
+		return err
+	}
+
+	encodedVisibilityRecord, err := Encode(request)
+	if err != nil {
+		archiveFailReason = errEncodeVisibilityRecord
+		return err
+	}
+
+	closeTime := request.CloseTime.AsTime()
+	partitionKey := parquetPartitionKey(URI, request.GetNamespaceId(), closeTime)
+	stagingKey := path.Join(partitionKey, parquetStagingPrefix, constructParquetStagingName(closeTime, request.GetRunId()))
+	if err := Upload(ctx, v.s3cli, URI, stagingKey, encodedVisibilityRecord); err != nil {
+		archiveFailReason = errStageVisibilityRecord
+		return err
+	}
+	metrics.VisibilityArchiveSuccessCount.With(handler).Record(1)
+
+	// The record is archived once it's staged. Compaction failures are retried by the next Archive of the partition.
+	today := v.timeSource.Now().UTC().Truncate(24 * time.Hour)
+	if err := v.compactIfNeeded(ctx, URI, partitionKey, closeTime.UTC().Before(today)); err != nil {
+		logger.Warn(errCompactVisibilityRecords, tag.Error(err))
+	}
+	if !closeTime.UTC().Before(today) {
+		// Compact what's left of the previous day, which won't get new records in the common case.
+		previousPartitionKey := parquetPartitionKey(URI, request.GetNamespaceId(), today.AddDate(0, 0, -1))
+		if err := v.compactIfNeeded(ctx, URI, previousPartitionKey, true); err != nil {
+			logger.Warn(errCompactVisibilityRecords, tag.Error(err))
+		}
+	}
+	return nil
+}
+
+func (v *parquetVisibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := SoftValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	parsedQuery := &parsedQuery{}
+	if strings.TrimSpace(request.Query) != "" {
+		var err error
+		if parsedQuery, err = v.queryParser.Parse(request.Query); err != nil {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+	}
+
+	var token *parquetQueryVisibilityToken
+	if request.NextPageToken != nil {
+		token = &parquetQueryVisibilityToken{}
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	partitionKeys, err := v.listPartitions(ctx, URI, request.NamespaceID, parsedQuery, token)
+	if err != nil {
+		return nil, toQueryError(err)
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, partitionKey := range partitionKeys {
+		records, err := v.readPartition(ctx, URI, partitionKey)
+		if err != nil {
+			return nil, toQueryError(err)
+		}
+
+		for _, record := range records {
+			if !matchParquetQuery(record, parsedQuery) || !beforeParquetQueryVisibilityToken(record, token) {
+				continue
+			}
+			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+
+			response.Executions = append(response.Executions, executionInfo)
+			if len(response.Executions) == request.PageSize {
+				encodedToken, err := SerializeToken(&parquetQueryVisibilityToken{
+					LastCloseTime: record.CloseTime.AsTime(),
+					LastRunID:     record.GetRunId(),
+				})
+				if err != nil {
+					return nil, serviceerror.NewInternal(err.Error())
+				}
+				response.NextPageToken = encodedToken
+				return response, nil
+			}
+		}
+	}
+	return response, nil
+}
+
+func (v *parquetVisibilityArchiver) ValidateURI(URI archiver.URI) error {
+	if err := SoftValidateURI(URI); err != nil {
+		return err
+	}
+	return BucketExists(context.TODO(), v.s3cli, URI)
+}
+
+// compactIfNeeded compacts up to batchSize staged records of the partition once there are enough of them, or if the
+// partition is sealed, which means it shouldn't get new records.
+func (v *parquetVisibilityArchiver) compactIfNeeded(
+	ctx context.Context,
+	URI archiver.URI,
+	partitionKey string,
+	sealed bool,
+) error {
+	stagingKeys, err := listKeys(ctx, v.s3cli, URI, path.Join(partitionKey, parquetStagingPrefix)+"/", v.batchSize)
+	if err != nil {
+		return err
+	}
+	if len(stagingKeys) == 0 || (!sealed && len(stagingKeys) < v.batchSize) {
+		return nil
+	}
+
+	rows := make([]archiver.ParquetVisibilityRow, 0, len(stagingKeys))
+	for _, key := range stagingKeys {
+		record, err := downloadVisibilityRecord(ctx, v.s3cli, URI, key)
+		if err != nil {
+			return err
+		}
+		if record == nil {
+			// Compacted by a concurrent compaction since the partition was listed.
+			continue
+		}
+		row, err := archiver.NewParquetVisibilityRow(record)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	data, err := archiver.EncodeParquetVisibilityRows(rows)
+	if err != nil {
+		return err
+	}
+	parquetKey := path.Join(partitionKey, archiver.ParquetFilePrefix+uuid.NewString()+archiver.ParquetFileSuffix)
+	if err := Upload(ctx, v.s3cli, URI, parquetKey, data); err != nil {
+		return err
+	}
+	for _, key := range stagingKeys {
+		if err := deleteKey(ctx, v.s3cli, URI, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listPartitions returns the keys of the partitions of the namespace that may have records matching the query and the
+// page token, latest first.
+func (v *parquetVisibilityArchiver) listPartitions(
+	ctx context.Context,
+	URI archiver.URI,
+	namespaceID string,
+	parsedQuery *parsedQuery,
+	token *parquetQueryVisibilityToken,
+) ([]string, error) {
+	namespaceKey := strings.TrimLeft(path.Join(URI.Path(), archiver.ParquetNamespacePartitionPrefix+namespaceID), "/")
+	partitionPrefixes, err := listCommonPrefixes(ctx, v.s3cli, URI, namespaceKey+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	var dates []time.Time
+	for _, partitionPrefix := range partitionPrefixes {
+		date, ok := archiver.ParseParquetCloseDatePartition(path.Base(partitionPrefix))
+		if !ok {
+			continue
+		}
+		if parsedQuery.closeTime != nil && !date.Equal(parsedQuery.closeTime.UTC().Truncate(24*time.Hour)) {
+			continue
+		}
+		if token != nil && date.After(token.LastCloseTime.UTC()) {
+			continue
+		}
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].After(dates[j])
+	})
+
+	partitionKeys := make([]string, len(dates))
+	for i, date := range dates {
+		partitionKeys[i] = parquetPartitionKey(URI, namespaceID, date)
+	}
+	return partitionKeys, nil
+}
+
+// readPartition returns all records of the partition, including the ones not compacted yet, in the order of
+// archiver.ParquetVisibilityRecords.
+func (v *parquetVisibilityArchiver) readPartition(
+	ctx context.Context,
+	URI archiver.URI,
+	partitionKey string,
+) ([]*archiverspb.VisibilityRecord, error) {
+	// Staged records are read before the Parquet objects, so that the records compacted concurrently are read at
+	// least once.
+	var rows []archiver.ParquetVisibilityRow
+	stagingKeys, err := listKeys(ctx, v.s3cli, URI, path.Join(partitionKey, parquetStagingPrefix)+"/", 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range stagingKeys {
+		record, err := downloadVisibilityRecord(ctx, v.s3cli, URI, key)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			continue
+		}
+		// Records that are not compacted yet go through the row conversion, so that they are identical to the
+		// compacted ones.
+		row, err := archiver.NewParquetVisibilityRow(record)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	keys, err := listKeys(ctx, v.s3cli, URI, partitionKey+"/"+archiver.ParquetFilePrefix, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if !strings.HasSuffix(key, archiver.ParquetFileSuffix) {
+			continue
+		}
+		data, err := Download(ctx, v.s3cli, URI, key)
+		if err != nil {
+			return nil, err
+		}
+		fileRows, err := archiver.DecodeParquetVisibilityRows(data)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, fileRows...)
+	}
+	return archiver.ParquetVisibilityRecords(rows)
+}
+
+func parquetPartitionKey(URI archiver.URI, namespaceID string, closeTime time.Time) string {
+	return strings.TrimLeft(archiver.ParquetPartitionPath(URI.Path(), namespaceID, closeTime), "/")
+}
+
+func constructParquetStagingName(closeTime time.Time, runID string) string {
+	return fmt.Sprintf("%v_%s.visibility", closeTime.UnixNano(), runID)
+}
+
+// listKeys returns the keys of the objects under the prefix, up to maxKeys keys if maxKeys is greater than 0.
+func listKeys(ctx context.Context, s3cli S3API, URI archiver.URI, prefix string, maxKeys int) ([]string, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	var keys []string
+	var token *string
+	for {
+		input := &s3.ListObjectsV2Input{
+			Bucket:            aws.String(URI.Hostname()),
+			Prefix:            aws.String(prefix),
+			ContinuationToken: token,
+		}
+		if maxKeys > 0 {
+			input.MaxKeys = aws.Int32(int32(maxKeys - len(keys)))
+		}
+		results, err := s3cli.ListObjectsV2(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range results.Contents {
+			keys = append(keys, *item.Key)
+		}
+		if !aws.ToBool(results.IsTruncated) || (maxKeys > 0 && len(keys) >= maxKeys) {
+			return keys, nil
+		}
+		token = results.NextContinuationToken
+	}
+}
+
+// listCommonPrefixes returns the prefixes of the objects under the prefix up to the next '/'.
+func listCommonPrefixes(ctx context.Context, s3cli S3API, URI archiver.URI, prefix string) ([]string, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	var prefixes []string
+	var token *string
+	for {
+		results, err := s3cli.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(URI.Hostname()),
+			Prefix:            aws.String(prefix),
+			Delimiter:         aws.String("/"),
+			ContinuationToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, commonPrefix := range results.CommonPrefixes {
+			prefixes = append(prefixes, *commonPrefix.Prefix)
+		}
+		if !aws.ToBool(results.IsTruncated) {
+			return prefixes, nil
+		}
+		token = results.NextContinuationToken
+	}
+}
+
+func deleteKey(ctx context.Context, s3cli S3API, URI archiver.URI, key string) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	// S3 does not return an error when deleting a key that does not exist.
+	_, err := s3cli.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(URI.Hostname()),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+// downloadVisibilityRecord returns the visibility record stored under the key, or nil if the key does not exist.
+func downloadVisibilityRecord(ctx context.Context, s3cli S3API, URI archiver.URI, key string) (*archiverspb.VisibilityRecord, error) {
+	encodedRecord, err := Download(ctx, s3cli, URI, key)
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeVisibilityRecord(encodedRecord)
+}
+
+func toQueryError(err error) error {
+	if isRetryableError(err) {
+		return serviceerror.NewUnavailable(err.Error())
+	}
+	return serviceerror.NewInternal(err.Error())
+}
+
+func matchParquetQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
+	if query.workflowID != nil && record.GetWorkflowId() != *query.workflowID {
+		return false
+	}
+	if query.workflowTypeName != nil && record.GetWorkflowTypeName() != *query.workflowTypeName {
+		return false
+	}
+	if query.closeTime != nil && !matchSearchPrecision(record.GetCloseTime().AsTime(), *query.closeTime, *query.searchPrecision) {
+		return false
+	}
+	if query.startTime != nil && !matchSearchPrecision(record.GetStartTime().AsTime(), *query.startTime, *query.searchPrecision) {
+		return false
+	}
+	return true
+}
+
+// matchSearchPrecision returns true if the times are equal up to the search precision, like the time based search
+// keys of the json format.
+func matchSearchPrecision(t time.Time, queryTime time.Time, precision string) bool {
+	timeFormat := searchPrecisionTimeFormat(precision)
+	return t.UTC().Format(timeFormat) == queryTime.UTC().Format(timeFormat)
+}
+
+func beforeParquetQueryVisibilityToken(record *archiverspb.VisibilityRecord, token *parquetQueryVisibilityToken) bool {
+	if token == nil {
+		return true
+	}
+	closeTime := record.CloseTime.AsTime()
+	if closeTime.Equal(token.LastCloseTime) {
+		return archiver.HashRunID(record.GetRunId()) < archiver.HashRunID(token.LastRunID)
+	}
+	return closeTime.Before(token.LastCloseTime)
+}
@@ -0,0 +1,204 @@
+package s3store
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/s3store/mocks"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var testParquetNow = time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+
+func newTestParquetVisibilityArchiver(t *testing.T, batchSize int) (*parquetVisibilityArchiver, archiver.URI) {
+	s3cli := mocks.NewMockS3API(gomock.NewController(t))
+	setupFsEmulation(s3cli)
+	v := newParquetVisibilityArchiver(log.NewNoopLogger(), metrics.NoopMetricsHandler, s3cli, batchSize)
+	v.timeSource = clock.NewEventTimeSource().Update(testParquetNow)
+	URI, err := archiver.NewURI(testBucketURI + "/visibility")
+	require.NoError(t, err)
+	return v, URI
+}
+
+func newTestParquetVisibilityRecord(runID string, closeTime time.Time) *archiverspb.VisibilityRecord {
+	return &archiverspb.VisibilityRecord{
+		NamespaceId:       testNamespaceID,
+		Namespace:         testNamespace,
+		WorkflowId:        "workflow-" + runID,
+		RunId:             runID,
+		WorkflowTypeName:  testWorkflowTypeName,
+		StartTime:         timestamppb.New(closeTime.Add(-time.Minute)),
+		CloseTime:         timestamppb.New(closeTime),
+		Status:            enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		HistoryLength:     42,
+		ExecutionDuration: durationpb.New(time.Minute),
+		Memo: &commonpb.Memo{
+			Fields: map[string]*commonpb.Payload{"memo": payload.EncodeString("value")},
+		},
+		SearchAttributes: map[string]string{"CustomKeywordField": `"keyword"`},
+	}
+}
+
+func queryAllParquetVisibilityRecords(
+	t *testing.T,
+	v *parquetVisibilityArchiver,
+	URI archiver.URI,
+	query string,
+	pageSize int,
+) []*workflowpb.WorkflowExecutionInfo {
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    pageSize,
+		Query:       query,
+	}
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for {
+		response, err := v.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap())
+		require.NoError(t, err)
+		executions = append(executions, response.Executions...)
+		if response.NextPageToken == nil {
+			return executions
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+func countParquetKeys(t *testing.T, v *parquetVisibilityArchiver, URI archiver.URI, prefix string) int {
+	keys, err := listKeys(context.Background(), v.s3cli, URI, prefix, 0)
+	require.NoError(t, err)
+	return len(keys)
+}
+
+func TestNewVisibilityArchiver_InvalidFormat(t *testing.T) {
+	_, err := NewVisibilityArchiver(log.NewNoopLogger(), metrics.NoopMetricsHandler, &config.S3Archiver{
+		Region:           "us-east-1",
+		VisibilityFormat: "csv",
+	})
+	require.ErrorIs(t, err, errInvalidVisibilityFormat)
+}
+
+func TestParquetVisibilityArchiver_ArchiveAndQuery(t *testing.T) {
+	v, URI := newTestParquetVisibilityArchiver(t, 2)
+
+	yesterday := testParquetNow.AddDate(0, 0, -1)
+	records := []*archiverspb.VisibilityRecord{
+		newTestParquetVisibilityRecord("run-1", yesterday.Add(time.Hour)),
+		newTestParquetVisibilityRecord("run-2", testParquetNow.Add(-3*time.Hour)),
+		newTestParquetVisibilityRecord("run-3", testParquetNow.Add(-2*time.Hour)),
+		newTestParquetVisibilityRecord("run-4", testParquetNow.Add(-time.Hour)),
+	}
+	for _, record := range records {
+		require.NoError(t, v.Archive(context.Background(), URI, record))
+	}
+
+	// The partition of yesterday is sealed and compacted right away. The partition of today is compacted once it
+	// has 2 staged records.
+	yesterdayKey := parquetPartitionKey(URI, testNamespaceID, yesterday)
+	todayKey := parquetPartitionKey(URI, testNamespaceID, testParquetNow)
+	require.Equal(t, "visibility/namespace_id="+testNamespaceID+"/close_date=2024-05-01", yesterdayKey)
+	for partitionKey, expected := range map[string]struct{ parquetFiles, stagedRecords int }{
+		yesterdayKey: {parquetFiles: 1, stagedRecords: 0},
+		todayKey:     {parquetFiles: 1, stagedRecords: 1},
+	} {
+		require.Equal(t, expected.parquetFiles, countParquetKeys(t, v, URI, partitionKey+"/"+archiver.ParquetFilePrefix))
+		require.Equal(t, expected.stagedRecords, countParquetKeys(t, v, URI, path.Join(partitionKey, parquetStagingPrefix)+"/"))
+	}
+
+	executions := queryAllParquetVisibilityRecords(t, v, URI, "", 1)
+	require.Len(t, executions, len(records))
+	for i, execution := range executions {
+		expected, err := convertToExecutionInfo(records[len(records)-1-i], searchattribute.TestNameTypeMap())
+		require.NoError(t, err)
+		protorequire.ProtoEqual(t, expected, execution)
+	}
+}
+
+func TestParquetVisibilityArchiver_Query(t *testing.T) {
+	v, URI := newTestParquetVisibilityArchiver(t, 100)
+
+	for _, record := range []*archiverspb.VisibilityRecord{
+		newTestParquetVisibilityRecord("run-1", testParquetNow.AddDate(0, 0, -1)),
+		newTestParquetVisibilityRecord("run-2", testParquetNow.Add(-2*time.Hour)),
+		newTestParquetVisibilityRecord("run-3", testParquetNow.Add(-time.Hour)),
+	} {
+		require.NoError(t, v.Archive(context.Background(), URI, record))
+	}
+
+	executions := queryAllParquetVisibilityRecords(t, v, URI, "WorkflowId = 'workflow-run-2'", 10)
+	require.Len(t, executions, 1)
+	require.Equal(t, "run-2", executions[0].GetExecution().GetRunId())
+
+	// A partition that can't be read fails the queries that don't prune it.
+	corruptedKey := parquetPartitionKey(URI, testNamespaceID, testParquetNow.AddDate(0, 0, -10)) + "/part-corrupted.parquet"
+	require.NoError(t, Upload(context.Background(), v.s3cli, URI, corruptedKey, []byte("corrupted")))
+
+	executions = queryAllParquetVisibilityRecords(
+		t, v, URI,
+		"WorkflowTypeName = '"+testWorkflowTypeName+"' AND CloseTime = '"+testParquetNow.Format(time.RFC3339)+"' AND SearchPrecision = 'Day'",
+		1,
+	)
+	require.Len(t, executions, 2)
+	require.Equal(t, "run-3", executions[0].GetExecution().GetRunId())
+	require.Equal(t, "run-2", executions[1].GetExecution().GetRunId())
+
+	executions = queryAllParquetVisibilityRecords(
+		t, v, URI,
+		"WorkflowTypeName = '"+testWorkflowTypeName+"' AND CloseTime = '"+testParquetNow.Add(-time.Hour).Format(time.RFC3339)+"' AND SearchPrecision = 'Hour'",
+		10,
+	)
+	require.Len(t, executions, 1)
+	require.Equal(t, "run-3", executions[0].GetExecution().GetRunId())
+
+	_, err := v.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       "WorkflowTypeName = '" + testWorkflowTypeName + "'",
+	}, searchattribute.TestNameTypeMap())
+	require.Error(t, err)
+}
+
+func TestParquetVisibilityArchiver_Query_SkipsDuplicates(t *testing.T) {
+	v, URI := newTestParquetVisibilityArchiver(t, 1)
+
+	// Archive may be called more than once for the same workflow: the second record is compacted to another object.
+	record := newTestParquetVisibilityRecord("run-1", testParquetNow.Add(-time.Hour))
+	require.NoError(t, v.Archive(context.Background(), URI, record))
+	require.NoError(t, v.Archive(context.Background(), URI, record))
+	partitionKey := parquetPartitionKey(URI, testNamespaceID, testParquetNow)
+	require.Equal(t, 2, countParquetKeys(t, v, URI, partitionKey+"/"+archiver.ParquetFilePrefix))
+
+	executions := queryAllParquetVisibilityRecords(t, v, URI, "", 10)
+	require.Len(t, executions, 1)
+}
+
+func TestParquetVisibilityArchiver_Query_ReadsStagedRecords(t *testing.T) {
+	v, URI := newTestParquetVisibilityArchiver(t, 100)
+
+	record := newTestParquetVisibilityRecord("run-1", testParquetNow.Add(-time.Hour))
+	require.NoError(t, v.Archive(context.Background(), URI, record))
+	partitionKey := parquetPartitionKey(URI, testNamespaceID, testParquetNow)
+	require.Equal(t, 0, countParquetKeys(t, v, URI, partitionKey+"/"+archiver.ParquetFilePrefix))
+
+	executions := queryAllParquetVisibilityRecords(t, v, URI, "", 10)
+	require.Len(t, executions, 1)
+	expected, err := convertToExecutionInfo(record, searchattribute.TestNameTypeMap())
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, expected, executions[0])
+}
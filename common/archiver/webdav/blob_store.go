@@ -0,0 +1,52 @@
+package webdav
+
+import (
+	"context"
+	"errors"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+type blobStore struct {
+	client *client
+}
+
+// NewBlobStore creates a new archiver.BlobStore based on WebDAV
+func NewBlobStore(config *config.WebdavArchiver) (archiver.BlobStore, error) {
+	client, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &blobStore{client: client}, nil
+}
+
+func (b *blobStore) Put(ctx context.Context, URI archiver.URI, key string, data []byte) error {
+	if err := b.ValidateURI(URI); err != nil {
+		return err
+	}
+	return b.client.Put(ctx, URI, key, data)
+}
+
+func (b *blobStore) Get(ctx context.Context, URI archiver.URI, key string) ([]byte, error) {
+	if err := b.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	data, err := b.client.Get(ctx, URI, key)
+	if errors.Is(err, errObjectNotFound) {
+		return nil, serviceerror.NewNotFoundf("blob %s not found", key)
+	}
+	return data, err
+}
+
+func (b *blobStore) Delete(ctx context.Context, URI archiver.URI, key string) error {
+	if err := b.ValidateURI(URI); err != nil {
+		return err
+	}
+	return b.client.Delete(ctx, URI, key)
+}
+
+func (b *blobStore) ValidateURI(URI archiver.URI) error {
+	return validateURI(URI)
+}
@@ -0,0 +1,282 @@
+package webdav
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	defaultMaxRetries     = 3
+	defaultRequestTimeout = time.Minute
+	retryInitialInterval  = 100 * time.Millisecond
+	retryMaximumInterval  = 5 * time.Second
+
+	methodMkcol    = "MKCOL"
+	methodPropfind = "PROPFIND"
+
+	propfindBody = `<?xml version="1.0" encoding="utf-8"?><D:propfind xmlns:D="DAV:"><D:prop><D:resourcetype/></D:prop></D:propfind>`
+)
+
+var (
+	errObjectNotFound = errors.New("object not found")
+)
+
+type (
+	// client is a minimal WebDAV client: objects are written with PUT, read with GET, removed with DELETE and
+	// listed with PROPFIND. Missing collections are created with MKCOL when a PUT conflicts.
+	client struct {
+		httpClient  *http.Client
+		scheme      string
+		headers     map[string]string
+		retryPolicy backoff.RetryPolicy
+	}
+
+	statusError struct {
+		method     string
+		url        string
+		statusCode int
+	}
+
+	multistatus struct {
+		Responses []struct {
+			Href         string `xml:"href"`
+			ResourceType struct {
+				Collection *struct{} `xml:"collection"`
+			} `xml:"propstat>prop>resourcetype"`
+		} `xml:"response"`
+	}
+)
+
+func newClient(cfg *config.WebdavArchiver) (*client, error) {
+	tlsConfig, err := auth.NewTLSConfig(&cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+	scheme := "http"
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsConfig != nil {
+		scheme = "https"
+		transport.TLSClientConfig = tlsConfig
+	}
+	maxRetries := cfg.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxRetries
+	}
+	requestTimeout := cfg.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+	return &client{
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+		},
+		scheme:  scheme,
+		headers: cfg.Headers,
+		retryPolicy: backoff.NewExponentialRetryPolicy(retryInitialInterval).
+			WithMaximumInterval(retryMaximumInterval).
+			WithMaximumAttempts(maxRetries + 1),
+	}, nil
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%s %s: unexpected status %d %s", e.method, e.url, e.statusCode, http.StatusText(e.statusCode))
+}
+
+// isRetryableError returns whether a request failed with a network error or a status the server may recover from.
+func isRetryableError(err error) bool {
+	var statusErr *statusError
+	if errors.As(err, &statusErr) {
+		return statusErr.statusCode == http.StatusTooManyRequests || statusErr.statusCode >= http.StatusInternalServerError
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// Put writes an object under the URI, creating the collections it's in if they don't exist.
+func (c *client) Put(ctx context.Context, URI archiver.URI, name string, data []byte) error {
+	objectURL := c.url(URI, name)
+	statusCode, _, err := c.do(ctx, http.MethodPut, objectURL, data, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode == http.StatusConflict {
+		if err := c.mkcolAll(ctx, URI, path.Dir(path.Join(URI.Path(), name))); err != nil {
+			return err
+		}
+		statusCode, _, err = c.do(ctx, http.MethodPut, objectURL, data, nil)
+		if err != nil {
+			return err
+		}
+	}
+	if !isSuccess(statusCode) {
+		return &statusError{method: http.MethodPut, url: objectURL, statusCode: statusCode}
+	}
+	return nil
+}
+
+// Get reads an object under the URI. It returns errObjectNotFound if the object doesn't exist.
+func (c *client) Get(ctx context.Context, URI archiver.URI, name string) ([]byte, error) {
+	objectURL := c.url(URI, name)
+	statusCode, body, err := c.do(ctx, http.MethodGet, objectURL, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == http.StatusNotFound {
+		return nil, errObjectNotFound
+	}
+	if !isSuccess(statusCode) {
+		return nil, &statusError{method: http.MethodGet, url: objectURL, statusCode: statusCode}
+	}
+	return body, nil
+}
+
+// Delete removes an object under the URI. Deleting an object that doesn't exist is not an error.
+func (c *client) Delete(ctx context.Context, URI archiver.URI, name string) error {
+	objectURL := c.url(URI, name)
+	statusCode, _, err := c.do(ctx, http.MethodDelete, objectURL, nil, nil)
+	if err != nil {
+		return err
+	}
+	if statusCode != http.StatusNotFound && !isSuccess(statusCode) {
+		return &statusError{method: http.MethodDelete, url: objectURL, statusCode: statusCode}
+	}
+	return nil
+}
+
+// List returns the names of the objects in a collection under the URI, without the collections it contains.
+// It returns errObjectNotFound if the collection doesn't exist.
+func (c *client) List(ctx context.Context, URI archiver.URI, dir string) ([]string, error) {
+	collectionURL := c.url(URI, dir) + "/"
+	statusCode, body, err := c.do(ctx, methodPropfind, collectionURL, []byte(propfindBody), map[string]string{
+		"Depth":        "1",
+		"Content-Type": "application/xml; charset=utf-8",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == http.StatusNotFound {
+		return nil, errObjectNotFound
+	}
+	if statusCode != http.StatusMultiStatus {
+		return nil, &statusError{method: methodPropfind, url: collectionURL, statusCode: statusCode}
+	}
+
+	var result multistatus
+	if err := xml.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("%s %s: invalid multistatus response: %w", methodPropfind, collectionURL, err)
+	}
+	var names []string
+	for _, response := range result.Responses {
+		if response.ResourceType.Collection != nil {
+			continue
+		}
+		// Hrefs are either absolute URLs or absolute paths.
+		href, err := url.Parse(response.Href)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: invalid href %q: %w", methodPropfind, collectionURL, response.Href, err)
+		}
+		names = append(names, path.Base(href.Path))
+	}
+	return names, nil
+}
+
+// mkcolAll creates a collection and its parents under the URI host. Collections that already exist are skipped.
+func (c *client) mkcolAll(ctx context.Context, URI archiver.URI, dir string) error {
+	var collectionPath string
+	for _, segment := range strings.Split(strings.Trim(dir, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		collectionPath += "/" + segment
+		collectionURL := c.hostURL(URI, collectionPath) + "/"
+		statusCode, _, err := c.do(ctx, methodMkcol, collectionURL, nil, nil)
+		if err != nil {
+			return err
+		}
+		// 405 Method Not Allowed is returned for collections that already exist.
+		if statusCode != http.StatusMethodNotAllowed && !isSuccess(statusCode) {
+			return &statusError{method: methodMkcol, url: collectionURL, statusCode: statusCode}
+		}
+	}
+	return nil
+}
+
+// do sends a request, retrying it on network errors and on 429 and 5xx statuses. It returns the status and body of
+// the last response.
+func (c *client) do(
+	ctx context.Context,
+	method string,
+	requestURL string,
+	body []byte,
+	headers map[string]string,
+) (int, []byte, error) {
+	var statusCode int
+	var responseBody []byte
+	op := func(ctx context.Context) error {
+		var bodyReader io.Reader
+		if body != nil {
+			bodyReader = bytes.NewReader(body)
+		}
+		request, err := http.NewRequestWithContext(ctx, method, requestURL, bodyReader)
+		if err != nil {
+			return err
+		}
+		for key, value := range c.headers {
+			request.Header.Set(key, value)
+		}
+		for key, value := range headers {
+			request.Header.Set(key, value)
+		}
+
+		response, err := c.httpClient.Do(request)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = response.Body.Close() }()
+		responseBody, err = io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+		statusCode = response.StatusCode
+		if statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError {
+			return &statusError{method: method, url: requestURL, statusCode: statusCode}
+		}
+		return nil
+	}
+	if err := backoff.ThrottleRetryContext(ctx, op, c.retryPolicy, isRetryableError); err != nil {
+		return 0, nil, err
+	}
+	return statusCode, responseBody, nil
+}
+
+func (c *client) url(URI archiver.URI, name string) string {
+	return c.hostURL(URI, path.Join("/", URI.Path(), name))
+}
+
+func (c *client) hostURL(URI archiver.URI, urlPath string) string {
+	host := URI.Hostname()
+	if URI.Port() != "" {
+		host = net.JoinHostPort(host, URI.Port())
+	}
+	return (&url.URL{Scheme: c.scheme, Host: host, Path: urlPath}).String()
+}
+
+func isSuccess(statusCode int) bool {
+	return statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices
+}
@@ -0,0 +1,149 @@
+package webdav
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/config"
+	"golang.org/x/net/webdav"
+)
+
+// newTestServer starts an in-memory WebDAV server and returns a URI under it. The middleware, if any, wraps the
+// WebDAV handler.
+func newTestServer(t *testing.T, middleware func(http.Handler) http.Handler) archiver.URI {
+	var handler http.Handler = &webdav.Handler{
+		FileSystem: webdav.NewMemFS(),
+		LockSystem: webdav.NewMemLS(),
+	}
+	if middleware != nil {
+		handler = middleware(handler)
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return newTestURI(t, server)
+}
+
+func newTestURI(t *testing.T, server *httptest.Server) archiver.URI {
+	URI, err := archiver.NewURI("webdav://" + server.Listener.Addr().String() + "/temporal/archival")
+	require.NoError(t, err)
+	return URI
+}
+
+func newTestClient(t *testing.T, cfg *config.WebdavArchiver) *client {
+	c, err := newClient(cfg)
+	require.NoError(t, err)
+	return c
+}
+
+func TestClient_PutGetListDelete(t *testing.T) {
+	URI := newTestServer(t, nil)
+	c := newTestClient(t, &config.WebdavArchiver{})
+	ctx := context.Background()
+
+	_, err := c.List(ctx, URI, "namespace")
+	require.ErrorIs(t, err, errObjectNotFound)
+	_, err = c.Get(ctx, URI, "namespace/a.visibility")
+	require.ErrorIs(t, err, errObjectNotFound)
+
+	// The collections of the URI and the namespace are created by the first Put.
+	require.NoError(t, c.Put(ctx, URI, "namespace/a.visibility", []byte("a")))
+	require.NoError(t, c.Put(ctx, URI, "namespace/b.visibility", []byte("b")))
+	require.NoError(t, c.Put(ctx, URI, "namespace/nested/c.visibility", []byte("c")))
+	require.NoError(t, c.Put(ctx, URI, "namespace/a.visibility", []byte("a2")))
+
+	data, err := c.Get(ctx, URI, "namespace/a.visibility")
+	require.NoError(t, err)
+	require.Equal(t, []byte("a2"), data)
+
+	names, err := c.List(ctx, URI, "namespace")
+	require.NoError(t, err)
+	sort.Strings(names)
+	require.Equal(t, []string{"a.visibility", "b.visibility"}, names)
+
+	require.NoError(t, c.Delete(ctx, URI, "namespace/a.visibility"))
+	require.NoError(t, c.Delete(ctx, URI, "namespace/a.visibility"))
+	_, err = c.Get(ctx, URI, "namespace/a.visibility")
+	require.ErrorIs(t, err, errObjectNotFound)
+}
+
+func TestClient_Headers(t *testing.T) {
+	URI := newTestServer(t, func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	ctx := context.Background()
+
+	c := newTestClient(t, &config.WebdavArchiver{})
+	err := c.Put(ctx, URI, "a", []byte("a"))
+	var statusErr *statusError
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, http.StatusUnauthorized, statusErr.statusCode)
+	require.False(t, isRetryableError(err))
+
+	c = newTestClient(t, &config.WebdavArchiver{
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	})
+	require.NoError(t, c.Put(ctx, URI, "a", []byte("a")))
+}
+
+func TestClient_Retries(t *testing.T) {
+	var requests, failures atomic.Int32
+	URI := newTestServer(t, func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			if failures.Add(-1) >= 0 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	ctx := context.Background()
+	c := newTestClient(t, &config.WebdavArchiver{MaxRetries: 2})
+
+	// PUT, then MKCOL of the 2 collections, then PUT once more.
+	failures.Store(2)
+	require.NoError(t, c.Put(ctx, URI, "a", []byte("a")))
+	require.EqualValues(t, 6, requests.Load())
+
+	requests.Store(0)
+	failures.Store(3)
+	_, err := c.Get(ctx, URI, "a")
+	require.Error(t, err)
+	require.True(t, isRetryableError(err))
+	require.EqualValues(t, 3, requests.Load())
+}
+
+func TestClient_TLS(t *testing.T) {
+	server := httptest.NewTLSServer(&webdav.Handler{
+		FileSystem: webdav.NewMemFS(),
+		LockSystem: webdav.NewMemLS(),
+	})
+	t.Cleanup(server.Close)
+	URI := newTestURI(t, server)
+	ctx := context.Background()
+
+	c := newTestClient(t, &config.WebdavArchiver{})
+	require.Error(t, c.Put(ctx, URI, "a", []byte("a")))
+
+	c = newTestClient(t, &config.WebdavArchiver{
+		TLS: auth.TLS{Enabled: true},
+	})
+	require.Equal(t, "https", c.scheme)
+	require.NoError(t, c.Put(ctx, URI, "a", []byte("a")))
+	data, err := c.Get(ctx, URI, "a")
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), data)
+}
@@ -0,0 +1,416 @@
+// WebDAV History Archiver will archive workflow histories to an HTTP/WebDAV server.
+
+// Each Archive() request results in an object named in the format of
+// hash(namespaceID, workflowID, runID)_version.history being written under the path of the URI
+// with an HTTP PUT. Workflow histories stored in that object are encoded in JSON format, and
+// optionally compressed. A manifest with the checksum of the object is written next to it, in an
+// object with the same name and a .manifest suffix.
+
+// The Get() method retrieves the archived histories with an HTTP GET, and lists the path of the
+// URI with a PROPFIND to pick the highest close failover version if none is specified, in the same
+// way as the filestore archiver.
+
+package webdav
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// URIScheme is the scheme for the WebDAV implementation
+	URIScheme = "webdav"
+
+	errEncodeHistory   = "failed to encode history batches"
+	errCompressHistory = "failed to compress history batches"
+	errWriteObject     = "failed to write history to webdav"
+	errWriteManifest   = "failed to write history manifest to webdav"
+
+	historyFileSuffix         = ".history"
+	historyManifestFileSuffix = ".manifest"
+
+	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+)
+
+type (
+	historyArchiver struct {
+		executionManager persistence.ExecutionManager
+		logger           log.Logger
+		metricsHandler   metrics.Handler
+		client           *client
+		compression      string
+
+		// only set in test code
+		historyIterator archiver.HistoryIterator
+	}
+
+	getHistoryToken struct {
+		CloseFailoverVersion int64
+		NextBatchIdx         int
+	}
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver based on WebDAV
+func NewHistoryArchiver(
+	executionManager persistence.ExecutionManager,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	config *config.WebdavArchiver,
+) (archiver.HistoryArchiver, error) {
+	return newHistoryArchiver(executionManager, logger, metricsHandler, config, nil)
+}
+
+func newHistoryArchiver(
+	executionManager persistence.ExecutionManager,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	config *config.WebdavArchiver,
+	historyIterator archiver.HistoryIterator,
+) (*historyArchiver, error) {
+	if err := archiver.ValidateHistoryCompression(config.HistoryCompression); err != nil {
+		return nil, err
+	}
+	client, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &historyArchiver{
+		executionManager: executionManager,
+		logger:           logger,
+		metricsHandler:   metricsHandler,
+		client:           client,
+		compression:      config.HistoryCompression,
+		historyIterator:  historyIterator,
+	}, nil
+}
+
+func (h *historyArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && !common.IsPersistenceTransientError(err) && !isRetryableError(err) && featureCatalog.NonRetryableError != nil {
+			err = featureCatalog.NonRetryableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveHistoryRequestAndURI(h.logger, request, URI.String())
+
+	if err := h.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateHistoryArchiveRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	historyIterator := h.historyIterator
+	if historyIterator == nil { // will only be set by testing code
+		historyIterator = archiver.NewHistoryIterator(request, h.executionManager, targetHistoryBlobSize)
+	}
+
+	var historyBatches []*historypb.History
+	for historyIterator.HasNext() {
+		historyBlob, err := historyIterator.Next(ctx)
+		if err != nil {
+			if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+				// workflow history no longer exists, may due to duplicated archival signal
+				// this may happen even in the middle of iterating history as two archival signals
+				// can be processed concurrently.
+				logger.Info(archiver.ArchiveSkippedInfoMsg)
+				return nil
+			}
+
+			logger = log.With(logger, tag.ArchivalArchiveFailReason(archiver.ErrReasonReadHistory), tag.Error(err))
+			if !common.IsPersistenceTransientError(err) {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg)
+			} else {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+			}
+			return err
+		}
+
+		if historyMutated(request, historyBlob.Body, historyBlob.Header.IsLast) {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonHistoryMutated))
+			return archiver.ErrHistoryMutated
+		}
+
+		historyBatches = append(historyBatches, historyBlob.Body...)
+	}
+
+	encoder := codec.NewJSONPBEncoder()
+	encodedHistoryBatches, err := encoder.EncodeHistories(historyBatches)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
+	compressedHistoryBatches, err := archiver.CompressHistoryBlob(encodedHistoryBatches, h.compression)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errCompressHistory), tag.Error(err))
+		return err
+	}
+
+	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	if err := h.client.Put(ctx, URI, filename, compressedHistoryBatches); err != nil {
+		logArchiveWriteError(logger, errWriteObject, err)
+		return err
+	}
+
+	manifest := &archiver.HistoryManifest{}
+	manifest.AddBlob(filename, compressedHistoryBatches)
+	encodedManifest, err := archiver.EncodeHistoryManifest(manifest)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
+	if err := h.client.Put(ctx, URI, constructHistoryManifestFilename(filename), encodedManifest); err != nil {
+		logArchiveWriteError(logger, errWriteManifest, err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *historyArchiver) Get(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+) (*archiver.GetHistoryResponse, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateGetRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidGetHistoryRequest.Error())
+	}
+
+	var err error
+	var token *getHistoryToken
+	if request.NextPageToken != nil {
+		token, err = deserializeGetHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	} else if request.CloseFailoverVersion != nil {
+		token = &getHistoryToken{
+			CloseFailoverVersion: *request.CloseFailoverVersion,
+			NextBatchIdx:         0,
+		}
+	} else {
+		highestVersion, err := h.getHighestVersion(ctx, URI, request)
+		if err != nil {
+			return nil, h.convertGetError(err)
+		}
+		token = &getHistoryToken{
+			CloseFailoverVersion: highestVersion,
+			NextBatchIdx:         0,
+		}
+	}
+
+	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
+	encodedHistoryBatches, err := h.readHistoryObject(ctx, URI, filename)
+	if err != nil {
+		return nil, h.convertGetError(err)
+	}
+
+	encoder := codec.NewJSONPBEncoder()
+	historyBatches, err := encoder.DecodeHistories(encodedHistoryBatches)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if token.NextBatchIdx > len(historyBatches) {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+	}
+	historyBatches = historyBatches[token.NextBatchIdx:]
+
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	numOfBatches := 0
+	for _, batch := range historyBatches {
+		response.HistoryBatches = append(response.HistoryBatches, batch)
+		numOfBatches++
+		numOfEvents += len(batch.Events)
+		if numOfEvents >= request.PageSize {
+			break
+		}
+	}
+
+	if numOfBatches < len(historyBatches) {
+		token.NextBatchIdx += numOfBatches
+		nextToken, err := serializeToken(token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = nextToken
+	}
+
+	return response, nil
+}
+
+// VerifyHistories verifies every history object under the URI against its manifest, and checks that it can be
+// decoded.
+func (h *historyArchiver) VerifyHistories(
+	ctx context.Context,
+	URI archiver.URI,
+	report func(key string, err error) error,
+) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return err
+	}
+
+	filenames, err := h.client.List(ctx, URI, "")
+	if err != nil {
+		if errors.Is(err, errObjectNotFound) {
+			return nil
+		}
+		return err
+	}
+	listed := make(map[string]struct{}, len(filenames))
+	for _, filename := range filenames {
+		listed[filename] = struct{}{}
+	}
+	for _, filename := range filenames {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var verifyErr error
+		switch {
+		case strings.HasSuffix(filename, historyFileSuffix):
+			verifyErr = h.verifyHistoryObject(ctx, URI, filename)
+		case strings.HasSuffix(filename, historyFileSuffix+historyManifestFileSuffix):
+			// Manifests are verified with their history object, unless it's missing.
+			historyFilename := strings.TrimSuffix(filename, historyManifestFileSuffix)
+			if _, ok := listed[historyFilename]; ok {
+				continue
+			}
+			verifyErr = fmt.Errorf("%w: %s is missing", archiver.ErrHistoryCorrupted, historyFilename)
+		default:
+			continue
+		}
+		if errors.Is(verifyErr, archiver.ErrHistoryCorrupted) {
+			h.recordCorruptedHistory()
+		}
+		if err := report(filename, verifyErr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
+	return validateURI(URI)
+}
+
+func (h *historyArchiver) verifyHistoryObject(ctx context.Context, URI archiver.URI, filename string) error {
+	encodedHistoryBatches, err := h.readHistoryObject(ctx, URI, filename)
+	if err != nil {
+		return err
+	}
+	encoder := codec.NewJSONPBEncoder()
+	if _, err := encoder.DecodeHistories(encodedHistoryBatches); err != nil {
+		return fmt.Errorf("%w: %v", archiver.ErrHistoryCorrupted, err)
+	}
+	return nil
+}
+
+// readHistoryObject reads a history object, verifies it against its manifest, and decompresses it. Histories
+// archived without a manifest aren't verified.
+func (h *historyArchiver) readHistoryObject(ctx context.Context, URI archiver.URI, filename string) ([]byte, error) {
+	data, err := h.client.Get(ctx, URI, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedManifest, err := h.client.Get(ctx, URI, constructHistoryManifestFilename(filename))
+	switch {
+	case errors.Is(err, errObjectNotFound):
+	case err != nil:
+		return nil, err
+	default:
+		manifest, err := archiver.DecodeHistoryManifest(encodedManifest)
+		if err != nil {
+			return nil, err
+		}
+		if err := manifest.VerifyBlob(filename, data); err != nil {
+			return nil, err
+		}
+	}
+
+	decompressed, err := archiver.DecompressHistoryBlob(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", archiver.ErrHistoryCorrupted, err)
+	}
+	return decompressed, nil
+}
+
+func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.URI, request *archiver.GetHistoryRequest) (int64, error) {
+	filenames, err := h.client.List(ctx, URI, "")
+	if err != nil {
+		return 0, err
+	}
+	filenamePrefix := constructHistoryFilenamePrefix(request.NamespaceID, request.WorkflowID, request.RunID)
+	var highestVersion *int64
+	for _, filename := range filenames {
+		if !strings.HasPrefix(filename, filenamePrefix) || !strings.HasSuffix(filename, historyFileSuffix) {
+			continue
+		}
+		version, err := extractCloseFailoverVersion(filename)
+		if err != nil {
+			continue
+		}
+		if highestVersion == nil || version > *highestVersion {
+			highestVersion = &version
+		}
+	}
+	if highestVersion == nil {
+		return 0, errObjectNotFound
+	}
+	return *highestVersion, nil
+}
+
+func (h *historyArchiver) convertGetError(err error) error {
+	switch {
+	case errors.Is(err, errObjectNotFound):
+		return serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+	case errors.Is(err, archiver.ErrHistoryCorrupted):
+		h.recordCorruptedHistory()
+		return serviceerror.NewDataLoss(err.Error())
+	case isRetryableError(err):
+		return serviceerror.NewUnavailable(err.Error())
+	default:
+		return serviceerror.NewInternal(err.Error())
+	}
+}
+
+func (h *historyArchiver) recordCorruptedHistory() {
+	metrics.HistoryArchiverCorruptedHistoryCount.With(
+		h.metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryArchiverScope)),
+	).Record(1)
+}
+
+func logArchiveWriteError(logger log.Logger, reason string, err error) {
+	if isRetryableError(err) {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(reason), tag.Error(err))
+	} else {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(reason), tag.Error(err))
+	}
+}
@@ -0,0 +1,289 @@
+package webdav
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testNamespaceID          = "test-namespace-id"
+	testNamespace            = "test-namespace"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 1800
+	testCloseFailoverVersion = int64(100)
+	testPageSize             = 100
+)
+
+var (
+	testBranchToken = []byte{1, 2, 3}
+)
+
+type historyArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	logger             log.Logger
+	metricsHandler     metrics.Handler
+	testArchivalURI    archiver.URI
+	historyBatchesV1   []*historypb.History
+	historyBatchesV100 []*historypb.History
+}
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (s *historyArchiverSuite) SetupSuite() {
+	now := timestamppb.New(time.Date(2020, 8, 22, 1, 2, 3, 4, time.UTC))
+	s.historyBatchesV1 = []*historypb.History{
+		{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   testNextEventID - 1,
+					EventTime: now,
+					Version:   1,
+				},
+			},
+		},
+	}
+	s.historyBatchesV100 = []*historypb.History{
+		{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   common.FirstEventID + 1,
+					EventTime: now,
+					Version:   testCloseFailoverVersion,
+				},
+			},
+		},
+		{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   testNextEventID - 1,
+					EventTime: now,
+					Version:   testCloseFailoverVersion,
+				},
+			},
+		},
+	}
+}
+
+func (s *historyArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = log.NewNoopLogger()
+	s.metricsHandler = metrics.NoopMetricsHandler
+	s.testArchivalURI = newTestServer(s.T(), nil)
+}
+
+func (s *historyArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "webdav:///a/b/c",
+			expectedErr: errEmptyHostname,
+		},
+		{
+			URI:         "webdav://localhost:8080/a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, historyArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_HistoryMutated() {
+	mockCtrl := gomock.NewController(s.T())
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: []*historypb.History{
+			{
+				Events: []*historypb.HistoryEvent{
+					{
+						EventId: common.FirstEventID + 1,
+						Version: testCloseFailoverVersion + 1,
+					},
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlob, nil),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_NotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	_, err := historyArchiver.Get(context.Background(), s.testArchivalURI, s.newGetRequest(testPageSize))
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	s.archiveHistory(historyArchiver, s.historyBatchesV1, 1)
+	request := s.newGetRequest(testPageSize)
+	request.CloseFailoverVersion = new(testCloseFailoverVersion)
+	_, err = historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidToken() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest(testPageSize)
+	request.NextPageToken = []byte{'r', 'a', 'n', 'd', 'o', 'm'}
+	_, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_PickHighestVersion() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	s.archiveHistory(historyArchiver, s.historyBatchesV1, 1)
+	s.archiveHistory(historyArchiver, s.historyBatchesV100, testCloseFailoverVersion)
+
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, s.newGetRequest(testPageSize))
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+	request := s.newGetRequest(testPageSize)
+	request.CloseFailoverVersion = new(int64(1))
+	response, err = historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Equal(s.historyBatchesV1, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_SmallPageSize() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	historyArchiver.compression = archiver.HistoryCompressionGzip
+	s.archiveHistory(historyArchiver, s.historyBatchesV100, testCloseFailoverVersion)
+
+	var combinedHistory []*historypb.History
+	request := s.newGetRequest(1)
+	for {
+		response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+		s.NoError(err)
+		s.Len(response.HistoryBatches, 1)
+		combinedHistory = append(combinedHistory, response.HistoryBatches...)
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal(s.historyBatchesV100, combinedHistory)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_CompressedWithManifest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	historyArchiver.compression = archiver.HistoryCompressionZstd
+	s.archiveHistory(historyArchiver, s.historyBatchesV100, testCloseFailoverVersion)
+
+	ctx := context.Background()
+	response, err := historyArchiver.Get(ctx, s.testArchivalURI, s.newGetRequest(testPageSize))
+	s.NoError(err)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+	reported := map[string]error{}
+	report := func(key string, err error) error {
+		reported[key] = err
+		return nil
+	}
+	expectedFilename := constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.NoError(historyArchiver.VerifyHistories(ctx, s.testArchivalURI, report))
+	s.Equal(map[string]error{expectedFilename: nil}, reported)
+
+	data, err := historyArchiver.client.Get(ctx, s.testArchivalURI, expectedFilename)
+	s.NoError(err)
+	data[len(data)-1] ^= 0xff
+	s.NoError(historyArchiver.client.Put(ctx, s.testArchivalURI, expectedFilename, data))
+
+	_, err = historyArchiver.Get(ctx, s.testArchivalURI, s.newGetRequest(testPageSize))
+	s.IsType(&serviceerror.DataLoss{}, err)
+
+	s.NoError(historyArchiver.VerifyHistories(ctx, s.testArchivalURI, report))
+	s.ErrorIs(reported[expectedFilename], archiver.ErrHistoryCorrupted)
+
+	s.NoError(historyArchiver.client.Delete(ctx, s.testArchivalURI, expectedFilename))
+	reported = map[string]error{}
+	s.NoError(historyArchiver.VerifyHistories(ctx, s.testArchivalURI, report))
+	s.Len(reported, 1)
+	s.ErrorIs(reported[constructHistoryManifestFilename(expectedFilename)], archiver.ErrHistoryCorrupted)
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
+	a, err := newHistoryArchiver(nil, s.logger, s.metricsHandler, &config.WebdavArchiver{}, historyIterator)
+	s.NoError(err)
+	return a
+}
+
+func (s *historyArchiverSuite) newArchiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func (s *historyArchiverSuite) newGetRequest(pageSize int) *archiver.GetHistoryRequest {
+	return &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    pageSize,
+	}
+}
+
+// archiveHistory archives the history batches as the given version, using the archiver's compression.
+func (s *historyArchiverSuite) archiveHistory(historyArchiver *historyArchiver, historyBatches []*historypb.History, version int64) {
+	mockCtrl := gomock.NewController(s.T())
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(&archiverspb.HistoryBlob{
+			Header: &archiverspb.HistoryBlobHeader{
+				IsLast: true,
+			},
+			Body: historyBatches,
+		}, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+	historyArchiver.historyIterator = historyIterator
+	defer func() { historyArchiver.historyIterator = nil }()
+
+	request := s.newArchiveRequest()
+	request.CloseFailoverVersion = version
+	s.NoError(historyArchiver.Archive(context.Background(), s.testArchivalURI, request))
+}
@@ -0,0 +1,147 @@
+package webdav
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	errEmptyHostname = errors.New("no hostname specified")
+)
+
+// encoding & decoding util
+
+func encode(message proto.Message) ([]byte, error) {
+	encoder := codec.NewJSONPBEncoder()
+	return encoder.Encode(message)
+}
+
+func decodeVisibilityRecord(data []byte) (*archiverspb.VisibilityRecord, error) {
+	record := &archiverspb.VisibilityRecord{}
+	encoder := codec.NewJSONPBEncoder()
+	err := encoder.Decode(data, record)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func serializeToken(token any) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
+	token := &getHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
+	token := &queryVisibilityToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+// Object name construction
+
+func constructHistoryFilename(namespaceID, workflowID, runID string, version int64) string {
+	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
+	return fmt.Sprintf("%s_%v%s", combinedHash, version, historyFileSuffix)
+}
+
+func constructHistoryManifestFilename(historyFilename string) string {
+	return historyFilename + historyManifestFileSuffix
+}
+
+func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) string {
+	return strings.Join([]string{hash(namespaceID), hash(workflowID), hash(runID)}, "")
+}
+
+func constructVisibilityFilename(closeTimestamp time.Time, runID string) string {
+	return fmt.Sprintf("%v_%s%s", closeTimestamp.UnixNano(), hash(runID), visibilityFileSuffix)
+}
+
+func hash(s string) string {
+	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
+}
+
+// Validation
+
+func validateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+	if URI.Hostname() == "" {
+		return errEmptyHostname
+	}
+	return nil
+}
+
+// Misc.
+
+func extractCloseFailoverVersion(filename string) (int64, error) {
+	filenameParts := strings.FieldsFunc(filename, func(r rune) bool {
+		return r == '_' || r == '.'
+	})
+	if len(filenameParts) != 3 {
+		return -1, errors.New("unknown filename structure")
+	}
+	return strconv.ParseInt(filenameParts[1], 10, 64)
+}
+
+func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*historypb.History, isLast bool) bool {
+	lastBatch := historyBatches[len(historyBatches)-1].Events
+	lastEvent := lastBatch[len(lastBatch)-1]
+	lastFailoverVersion := lastEvent.GetVersion()
+	if lastFailoverVersion > request.CloseFailoverVersion {
+		return true
+	}
+
+	if !isLast {
+		return false
+	}
+	lastEventID := lastEvent.GetEventId()
+	return lastFailoverVersion != request.CloseFailoverVersion || lastEventID+1 != request.NextEventID
+}
+
+func convertToExecutionInfo(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) (*workflowpb.WorkflowExecutionInfo, error) {
+	searchAttributes, err := searchattribute.Parse(record.SearchAttributes, &saTypeMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: record.GetWorkflowId(),
+			RunId:      record.GetRunId(),
+		},
+		Type: &commonpb.WorkflowType{
+			Name: record.WorkflowTypeName,
+		},
+		StartTime:         record.StartTime,
+		ExecutionTime:     record.ExecutionTime,
+		CloseTime:         record.CloseTime,
+		ExecutionDuration: record.ExecutionDuration,
+		Status:            record.Status,
+		HistoryLength:     record.HistoryLength,
+		Memo:              record.Memo,
+		SearchAttributes:  searchAttributes,
+	}, nil
+}
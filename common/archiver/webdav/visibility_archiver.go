@@ -0,0 +1,278 @@
+package webdav
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errWriteVisibilityRecord  = "failed to write visibility record to webdav"
+
+	visibilityFileSuffix = ".visibility"
+)
+
+type (
+	visibilityArchiver struct {
+		logger         log.Logger
+		metricsHandler metrics.Handler
+		client         *client
+		queryParser    QueryParser
+	}
+
+	queryVisibilityToken struct {
+		LastCloseTime time.Time
+		LastRunID     string
+	}
+
+	parsedVisFilename struct {
+		name        string
+		closeTime   time.Time
+		hashedRunID string
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on WebDAV. Each visibility record is written
+// to its own object in the collection of its namespace, named closeTimestamp_hash(runID).visibility so that records
+// can be sorted without reading them.
+func NewVisibilityArchiver(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	config *config.WebdavArchiver,
+) (archiver.VisibilityArchiver, error) {
+	client, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityArchiver{
+		logger:         logger,
+		metricsHandler: metricsHandler,
+		client:         client,
+		queryParser:    NewQueryParser(),
+	}, nil
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverspb.VisibilityRecord,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && !isRetryableError(err) && featureCatalog.NonRetryableError != nil {
+			err = featureCatalog.NonRetryableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.logger, request, URI.String())
+
+	if err := v.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	encodedVisibilityRecord, err := encode(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	filename := constructVisibilityFilename(request.CloseTime.AsTime(), request.GetRunId())
+	if err := v.client.Put(ctx, URI, request.GetNamespaceId()+"/"+filename, encodedVisibilityRecord); err != nil {
+		logArchiveWriteError(logger, errWriteVisibilityRecord, err)
+		return err
+	}
+	return nil
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	if parsedQuery.emptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	var token *queryVisibilityToken
+	if request.NextPageToken != nil {
+		token, err = deserializeQueryVisibilityToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	filenames, err := v.client.List(ctx, URI, request.NamespaceID)
+	if err != nil {
+		if errors.Is(err, errObjectNotFound) {
+			return &archiver.QueryVisibilityResponse{}, nil
+		}
+		return nil, convertQueryError(err)
+	}
+	filenames, err = sortAndFilterFiles(filenames, token)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for idx, filename := range filenames {
+		encodedRecord, err := v.client.Get(ctx, URI, request.NamespaceID+"/"+filename)
+		if err != nil {
+			if errors.Is(err, errObjectNotFound) {
+				// removed since it was listed
+				continue
+			}
+			return nil, convertQueryError(err)
+		}
+
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		if record.CloseTime.AsTime().Before(parsedQuery.earliestCloseTime) {
+			break
+		}
+
+		if matchQuery(record, parsedQuery) {
+			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+
+			response.Executions = append(response.Executions, executionInfo)
+			if len(response.Executions) == request.PageSize {
+				if idx != len(filenames)-1 {
+					newToken := &queryVisibilityToken{
+						LastCloseTime: timestamp.TimeValue(record.CloseTime),
+						LastRunID:     record.GetRunId(),
+					}
+					encodedToken, err := serializeToken(newToken)
+					if err != nil {
+						return nil, serviceerror.NewInternal(err.Error())
+					}
+					response.NextPageToken = encodedToken
+				}
+				break
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	return validateURI(URI)
+}
+
+func convertQueryError(err error) error {
+	if isRetryableError(err) {
+		return serviceerror.NewUnavailable(err.Error())
+	}
+	return serviceerror.NewInternal(err.Error())
+}
+
+// sortAndFilterFiles sort visibility record file names based on close timestamp (desc) and use hashed runID to break ties.
+// if a nextPageToken is give, it only returns filenames that have a smaller close timestamp
+func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]string, error) {
+	var parsedFilenames []*parsedVisFilename
+	for _, name := range filenames {
+		if !strings.HasSuffix(name, visibilityFileSuffix) {
+			continue
+		}
+		pieces := strings.FieldsFunc(name, func(r rune) bool {
+			return r == '_' || r == '.'
+		})
+		if len(pieces) != 3 {
+			return nil, fmt.Errorf("failed to parse visibility filename %s", name)
+		}
+
+		closeTime, err := strconv.ParseInt(pieces[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse visibility filename %s", name)
+		}
+		parsedFilenames = append(parsedFilenames, &parsedVisFilename{
+			name:        name,
+			closeTime:   timestamp.UnixOrZeroTime(closeTime),
+			hashedRunID: pieces[1],
+		})
+	}
+
+	sort.Slice(parsedFilenames, func(i, j int) bool {
+		if parsedFilenames[i].closeTime.Equal(parsedFilenames[j].closeTime) {
+			return parsedFilenames[i].hashedRunID > parsedFilenames[j].hashedRunID
+		}
+		return parsedFilenames[i].closeTime.After(parsedFilenames[j].closeTime)
+	})
+
+	startIdx := 0
+	if token != nil {
+		lastHashedRunID := hash(token.LastRunID)
+		startIdx = sort.Search(len(parsedFilenames), func(i int) bool {
+			if parsedFilenames[i].closeTime.Equal(token.LastCloseTime) {
+				return parsedFilenames[i].hashedRunID < lastHashedRunID
+			}
+			return parsedFilenames[i].closeTime.Before(token.LastCloseTime)
+		})
+	}
+
+	var filteredFilenames []string
+	for _, parsedFilename := range parsedFilenames[startIdx:] {
+		filteredFilenames = append(filteredFilenames, parsedFilename.name)
+	}
+	return filteredFilenames, nil
+}
+
+func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
+	closeTime := record.CloseTime.AsTime()
+	if closeTime.Before(query.earliestCloseTime) || closeTime.After(query.latestCloseTime) {
+		return false
+	}
+	if query.workflowID != nil && record.GetWorkflowId() != *query.workflowID {
+		return false
+	}
+	if query.runID != nil && record.GetRunId() != *query.runID {
+		return false
+	}
+	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
+		return false
+	}
+	if query.status != nil && record.Status != *query.status {
+		return false
+	}
+	return true
+}
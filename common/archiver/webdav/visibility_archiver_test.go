@@ -0,0 +1,194 @@
+package webdav
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testWorkflowTypeName = "test-workflow-type"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	logger            log.Logger
+	metricsHandler    metrics.Handler
+	testArchivalURI   archiver.URI
+	visibilityRecords []*archiverspb.VisibilityRecord
+
+	controller *gomock.Controller
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupSuite() {
+	s.visibilityRecords = []*archiverspb.VisibilityRecord{
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            testRunID,
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(1),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(10000),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			HistoryLength:    101,
+		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       "some random workflow ID",
+			RunId:            "some random run ID",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(2),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(1000),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			HistoryLength:    123,
+		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       "another workflow ID",
+			RunId:            "another run ID",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(3),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(10),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
+			HistoryLength:    456,
+		},
+		{
+			NamespaceId:      "some random namespace ID",
+			Namespace:        "some random namespace name",
+			WorkflowId:       "another workflow ID",
+			RunId:            "another run ID",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(3),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(10000),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			HistoryLength:    456,
+		},
+	}
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = log.NewNoopLogger()
+	s.metricsHandler = metrics.NoopMetricsHandler
+	s.controller = gomock.NewController(s.T())
+	s.testArchivalURI = newTestServer(s.T(), nil)
+}
+
+func (s *visibilityArchiverSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = visibilityArchiver.Archive(context.Background(), URI, s.visibilityRecords[0])
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_NamespaceNotExist() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap())
+	s.NoError(err)
+	s.Empty(response.Executions)
+	s.Nil(response.NextPageToken)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID:   testNamespaceID,
+		PageSize:      1,
+		NextPageToken: []byte{1, 2, 3},
+	}
+	_, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap())
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	mockParser := NewMockQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: time.Unix(0, 10),
+		latestCloseTime:   time.Unix(0, 10001),
+		status:            new(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+	}, nil).AnyTimes()
+	visibilityArchiver.queryParser = mockParser
+	for _, record := range s.visibilityRecords {
+		s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, record))
+	}
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "parsed by mockParser",
+	}
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for len(executions) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap())
+		s.NoError(err)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(executions, 2)
+	ei, err := convertToExecutionInfo(s.visibilityRecords[0], searchattribute.TestNameTypeMap())
+	s.NoError(err)
+	s.Equal(ei, executions[0])
+	ei, err = convertToExecutionInfo(s.visibilityRecords[1], searchattribute.TestNameTypeMap())
+	s.NoError(err)
+	s.Equal(ei, executions[1])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_EmptyQuery_Pagination() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, record := range s.visibilityRecords {
+		s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, record))
+	}
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "",
+	}
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for len(executions) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap())
+		s.NoError(err)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(executions, 3)
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
+	a, err := NewVisibilityArchiver(s.logger, s.metricsHandler, &config.WebdavArchiver{})
+	s.NoError(err)
+	return a.(*visibilityArchiver)
+}
@@ -268,6 +268,29 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// RecordingDir is the directory where persistence calls are recorded while it is not empty
+		RecordingDir dynamicconfig.StringPropertyFn `yaml:"-" json:"-"`
+		// BlobEncryption enables encryption at rest of history, mutable state and history task blobs.
+		// Optional, ignored when a key provider is passed to the server with WithPersistenceKeyProvider.
+		BlobEncryption *BlobEncryption `yaml:"blobEncryption"`
+		// HistoryTiering enables offloading of cold history nodes to a blob store. Optional.
+		HistoryTiering *HistoryTiering `yaml:"historyTiering"`
+	}
+
+	// HistoryTiering is the config for offloading cold history nodes to a blob store
+	HistoryTiering struct {
+		// URI is where the offloaded history nodes are stored. It uses the history archival URI schemes,
+		// and the blob store is configured by the matching provider of archival.history.provider.
+		URI string `yaml:"uri"`
+		// CacheSizeBytes is the size of the cache of offloaded history nodes read back from the blob store.
+		// Defaults to 64MB.
+		CacheSizeBytes int `yaml:"cacheSizeBytes"`
+	}
+
+	// BlobEncryption is the config for the local file-based key provider used to encrypt persisted blobs
+	BlobEncryption struct {
+		// KeyFile is the path of the file containing the master keys
+		KeyFile string `yaml:"keyFile"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -302,6 +325,14 @@ type (
 		// See config/development-cass-es-fi.yaml for a more detailed example.
 		Targets FaultInjectionTargets `yaml:"targets"`
 
+		// ScenarioFile is the path to a YAML file describing a FaultInjectionScenario. The scenario is loaded at
+		// startup and its phases are played in order from that point. Scenario faults are evaluated after Targets.
+		// See config/fault-injection-scenario-example.yaml for an example.
+		ScenarioFile string `yaml:"scenarioFile"`
+		// ScenarioFileOverride is set from dynamic config. When it returns a non-empty path, the scenario in that
+		// file replaces the one in ScenarioFile and starts playing from its first phase.
+		ScenarioFileOverride dynamicconfig.StringPropertyFn `yaml:"-" json:"-"`
+
 		// Injector optionally injects faults using runtime code instead of static YAML config.
 		Injector FaultInjector `yaml:"-" json:"-"`
 	}
@@ -356,6 +387,58 @@ type (
 		Seed int64 `yaml:"seed"`
 	}
 
+	// FaultInjectionScenario is a sequence of timed phases, each injecting latency and errors into the data
+	// store calls matched by its rules.
+	FaultInjectionScenario struct {
+		Name string `yaml:"name"`
+		// Seed is the seed for the random number generators of the scenario. If zero, the current time is used.
+		Seed int64 `yaml:"seed"`
+		// Repeat restarts the scenario from its first phase once the last phase has ended.
+		// Otherwise, no faults are injected after the last phase.
+		Repeat bool                          `yaml:"repeat"`
+		Phases []FaultInjectionScenarioPhase `yaml:"phases"`
+	}
+
+	// FaultInjectionScenarioPhase is one phase of a FaultInjectionScenario.
+	FaultInjectionScenarioPhase struct {
+		Name string `yaml:"name"`
+		// Duration of the phase. Only the last phase of a scenario that does not repeat may omit it,
+		// in which case the phase lasts until the scenario is replaced.
+		Duration time.Duration `yaml:"duration"`
+		// Rules are evaluated in order, the first rule matching a call applies to it.
+		Rules []FaultInjectionScenarioRule `yaml:"rules"`
+	}
+
+	// FaultInjectionScenarioRule injects latency and errors into the calls that match all of its targets.
+	// Empty targets match any call.
+	FaultInjectionScenarioRule struct {
+		Stores  []DataStoreName `yaml:"stores"`
+		Methods []string        `yaml:"methods"`
+		// ShardIDs and NamespaceIDs only match requests that carry a shard ID or a namespace ID.
+		ShardIDs     []int32  `yaml:"shardIDs"`
+		NamespaceIDs []string `yaml:"namespaceIDs"`
+
+		// Latency is added to matching calls before they are executed or fail.
+		Latency *FaultInjectionLatency `yaml:"latency"`
+		// Errors is a map of error type to probability of returning that error, see FaultInjectionMethodConfig.
+		Errors map[string]float64 `yaml:"errors"`
+	}
+
+	// FaultInjectionLatency is a latency distribution. Supported distributions are:
+	//   - fixed: every delayed call takes Duration.
+	//   - uniform: delays are uniformly distributed between Min and Max.
+	//   - lognormal: delays follow a log-normal distribution with the given P50 and P99, optionally capped at Max.
+	FaultInjectionLatency struct {
+		Distribution string        `yaml:"distribution"`
+		Duration     time.Duration `yaml:"duration"`
+		Min          time.Duration `yaml:"min"`
+		Max          time.Duration `yaml:"max"`
+		P50          time.Duration `yaml:"p50"`
+		P99          time.Duration `yaml:"p99"`
+		// Rate is the fraction of matching calls that are delayed. Defaults to 1.
+		Rate *float64 `yaml:"rate"`
+	}
+
 	// Cassandra contains configuration to connect to Cassandra cluster
 	Cassandra struct {
 		// Hosts is a csv of cassandra endpoints
@@ -459,6 +542,24 @@ type (
 		TaskScanPartitions int `yaml:"taskScanPartitions"`
 		// TLS is the configuration for TLS connections
 		TLS *auth.TLS `yaml:"tls"`
+		// ReadReplicas optionally configures read replicas of the database. Read-only queries that tolerate
+		// replication lag (visibility listings, namespace and cluster metadata scans) are sent to them.
+		// Supported by the mysql8, postgres12 and postgres12_pgx plugins.
+		ReadReplicas *SQLReadReplicas `yaml:"readReplicas"`
+	}
+
+	// SQLReadReplicas is the configuration for the read replicas of a SQL datastore. Replicas share the
+	// credentials, TLS and database name of the primary.
+	SQLReadReplicas struct {
+		// ConnectAddrs are the remote addrs of the replicas
+		ConnectAddrs []string `yaml:"connectAddrs"`
+		// ConnectAttributes are merged into the ConnectAttributes of the primary for replica connections
+		ConnectAttributes map[string]string `yaml:"connectAttributes"`
+		// MaxReplicationLag is the replication lag above which a replica stops serving queries until it catches up.
+		// Defaults to 5s.
+		MaxReplicationLag time.Duration `yaml:"maxReplicationLag"`
+		// LagCheckInterval is how often the replication lag of the replicas is measured. Defaults to 5s.
+		LagCheckInterval time.Duration `yaml:"lagCheckInterval"`
 	}
 
 	// CustomDatastoreConfig is the configuration for connecting to a custom datastore that is not supported by temporal core
@@ -513,6 +614,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		Webdav    *WebdavArchiver    `yaml:"webdav"`
 		// CustomStores contains the config for all custom history archivers
 		// The structure is a map of archiver name (scheme) to a map of config key-values
 		CustomStores map[string]map[string]any `yaml:"customStores"`
@@ -533,6 +635,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Webdav    *WebdavArchiver    `yaml:"webdav"`
 		// CustomStores contains the config for all custom visibility archivers
 		// The structure is a map of archiver name (scheme) to a map of config key-values
 		CustomStores map[string]map[string]any `yaml:"customStores"`
@@ -542,11 +645,20 @@ type (
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// VisibilityFormat is the format of archived visibility records: "json" (default) for one file per record,
+		// or "parquet" for Parquet files partitioned by namespace and close date. It's ignored by history archival.
+		VisibilityFormat string `yaml:"visibilityFormat"`
+		// ParquetBatchSize is the number of visibility records per Parquet file. Defaults to 1000.
+		ParquetBatchSize int `yaml:"parquetBatchSize"`
+		// HistoryCompression is the compression of archived histories: "none" (default), "gzip" or "zstd".
+		HistoryCompression string `yaml:"historyCompression"`
 	}
 
 	// GstorageArchiver contain the config for google storage archiver
 	GstorageArchiver struct {
 		CredentialsPath string `yaml:"credentialsPath"`
+		// HistoryCompression is the compression of archived histories: "none" (default), "gzip" or "zstd".
+		HistoryCompression string `yaml:"historyCompression"`
 	}
 
 	// S3Archiver contains the config for S3 archiver
@@ -555,6 +667,29 @@ type (
 		Endpoint         *string `yaml:"endpoint"`
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
 		LogLevel         uint    `yaml:"logLevel"`
+		// VisibilityFormat is the format of archived visibility records: "json" (default) for one object per record
+		// and search index, or "parquet" for Parquet objects partitioned by namespace and close date. It's ignored by
+		// history archival.
+		VisibilityFormat string `yaml:"visibilityFormat"`
+		// ParquetBatchSize is the number of visibility records per Parquet object. Defaults to 1000.
+		ParquetBatchSize int `yaml:"parquetBatchSize"`
+		// HistoryCompression is the compression of archived histories: "none" (default), "gzip" or "zstd".
+		HistoryCompression string `yaml:"historyCompression"`
+	}
+
+	// WebdavArchiver contains the config for the HTTP/WebDAV archiver. Its URIs are webdav://host[:port]/path, and
+	// are served over https when TLS is enabled.
+	WebdavArchiver struct {
+		// Headers are added to every request, e.g. an Authorization header.
+		Headers map[string]string `yaml:"headers"`
+		TLS     auth.TLS          `yaml:"tls"`
+		// MaxRetries is the number of times a request that failed with a network error or a 429 or 5xx status is
+		// retried. Defaults to 3.
+		MaxRetries int `yaml:"maxRetries"`
+		// RequestTimeout is the timeout of each request. Defaults to 1 minute.
+		RequestTimeout time.Duration `yaml:"requestTimeout"`
+		// HistoryCompression is the compression of archived histories: "none" (default), "gzip" or "zstd".
+		HistoryCompression string `yaml:"historyCompression"`
 	}
 
 	// PublicClient is the config for internal nodes (history/matching/worker) connecting to
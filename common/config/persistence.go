@@ -92,6 +92,13 @@ func (c *Persistence) Validate() error {
 			return fmt.Errorf("%w: datastore %q: %s", ErrPersistenceConfig, st, err.Error())
 		}
 	}
+
+	if c.BlobEncryption != nil && c.BlobEncryption.KeyFile == "" {
+		return fmt.Errorf("%w: blobEncryption.keyFile must be specified", ErrPersistenceConfig)
+	}
+	if c.HistoryTiering != nil && c.HistoryTiering.URI == "" {
+		return fmt.Errorf("%w: historyTiering.uri must be specified", ErrPersistenceConfig)
+	}
 	return nil
 }
 
@@ -288,6 +295,14 @@ func (c *SQL) validate() error {
 	if c.PasswordCommand != nil && c.PasswordCommand.Command == "" {
 		return errors.New("passwordCommand.command must not be empty")
 	}
+	if c.ReadReplicas != nil {
+		if len(c.ReadReplicas.ConnectAddrs) == 0 {
+			return errors.New("readReplicas.connectAddrs must not be empty")
+		}
+		if c.ReadReplicas.MaxReplicationLag < 0 || c.ReadReplicas.LagCheckInterval < 0 {
+			return errors.New("readReplicas.maxReplicationLag and readReplicas.lagCheckInterval must not be negative")
+		}
+	}
 	return nil
 }
 
@@ -46,6 +46,19 @@ func TestSQLValidate_PasswordCommandEmptyCommand(t *testing.T) {
 	require.ErrorContains(t, err, "passwordCommand.command must not be empty")
 }
 
+func TestSQLValidate_ReadReplicas(t *testing.T) {
+	cfg := &SQL{
+		ReadReplicas: &SQLReadReplicas{ConnectAddrs: []string{"replica:5432"}},
+	}
+	require.NoError(t, cfg.validate())
+
+	cfg.ReadReplicas.MaxReplicationLag = -time.Second
+	require.ErrorContains(t, cfg.validate(), "must not be negative")
+
+	cfg.ReadReplicas = &SQLReadReplicas{}
+	require.ErrorContains(t, cfg.validate(), "readReplicas.connectAddrs must not be empty")
+}
+
 func TestSQLResolvePassword_Static(t *testing.T) {
 	cfg := &SQL{Password: "static-pass"}
 	pw, err := cfg.ResolvePassword()
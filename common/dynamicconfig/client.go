@@ -40,6 +40,14 @@ type (
 		Subscribe(update ClientUpdateFunc) (cancel func())
 	}
 
+	// ValueSourceClient is an optional interface that a Client can also implement, to describe
+	// where its values come from. It's only used to explain settings to operators.
+	ValueSourceClient interface {
+		// GetValueSource returns a human-readable description of where the value for key with
+		// exactly these constraints came from (e.g. a file name), or "" if unknown.
+		GetValueSource(key Key, constraints Constraints) string
+	}
+
 	// Called with modified keys on any change to the current value set.
 	// Deleted keys/constraints will get a nil value.
 	ClientUpdateFunc func(map[Key][]ConstrainedValue)
@@ -20,6 +20,12 @@ var (
 		time.Minute,
 		`Poll interval for emulating subscriptions on non-subscribable Client.`,
 	)
+	DynamicConfigOverridesRefreshInterval = NewGlobalDurationSetting(
+		"dynamicconfig.overridesRefreshInterval",
+		10*time.Second,
+		`How often each service reloads the dynamic config overrides that are set through the admin API and stored
+in the cluster metadata.`,
+	)
 
 	// keys for admin
 
@@ -98,6 +104,14 @@ values in system search attributes.`,
 		`VisibilityEnableUnifiedQueryConverter enables the unified query converter for parsing the
 query.`,
 	)
+	VisibilityCountGroupByMaxGroups = NewNamespaceIntSetting(
+		"system.visibilityCountGroupByMaxGroups",
+		1000,
+		`VisibilityCountGroupByMaxGroups is the maximum number of groups a count query with a GROUP BY
+clause can return. Queries that match more groups are rejected with an InvalidArgument error.
+Grouping by multiple fields and by time buckets (eg: day(StartTime)) requires the unified query
+converter (see system.visibilityEnableUnifiedQueryConverter).`,
+	)
 
 	HistoryArchivalState = NewGlobalStringSetting(
 		"system.historyArchivalState",
@@ -140,6 +154,19 @@ for signal / start / signal with start API if namespace is not active`,
 		primitives.DefaultTransactionSizeLimit,
 		`TransactionSizeLimit is the largest allowed transaction size to persistence`,
 	)
+	HistoryTieringChunkSize = NewGlobalIntSetting(
+		"system.historyTieringChunkSize",
+		0,
+		`HistoryTieringChunkSize is the number of history node IDs offloaded together to the blob store configured by
+persistence.historyTiering. The nodes of a chunk are queued for offloading once their branch grows two chunks
+past them, see HistoryTieringMinAge. 0 disables offloading, history that was already offloaded remains readable.`,
+	)
+	HistoryTieringMinAge = NewGlobalDurationSetting(
+		"system.historyTieringMinAge",
+		time.Hour,
+		`HistoryTieringMinAge is how long a history chunk stays in the database after its branch grew past it before
+a background job offloads it to the blob store configured by persistence.historyTiering.`,
+	)
 	DisallowQuery = NewNamespaceBoolSetting(
 		"system.disallowQuery",
 		false,
@@ -289,6 +316,35 @@ operator API calls (highest priority). Should be >0.0 and <= 1.0 (defaults to 20
 		`PersistenceQPSBurstRatio is the burst ratio for persistence QPS. This flag controls the burst ratio for all services.`,
 	)
 
+	PersistenceBlobCompression = NewNamespaceIDStringSetting(
+		"system.persistenceBlobCompression",
+		"none",
+		`PersistenceBlobCompression is the compression applied to history event batches and mutable state records
+written to persistence. Allowed values are "none", "snappy" and "zstd". It can be set per namespace ID.
+Compressed and uncompressed blobs can always be read, so this can be changed in a running cluster. Only enable
+compression once all hosts in the cluster run a version that can read compressed blobs.`,
+	)
+	PersistenceBlobCompressionMinSize = NewGlobalIntSetting(
+		"system.persistenceBlobCompressionMinSize",
+		1024,
+		`PersistenceBlobCompressionMinSize is the minimum encoded size in bytes of a blob for PersistenceBlobCompression
+to be applied. Smaller blobs are written uncompressed.`,
+	)
+	PersistenceFaultInjectionScenarioFile = NewGlobalStringSetting(
+		"system.persistenceFaultInjectionScenarioFile",
+		"",
+		`PersistenceFaultInjectionScenarioFile is the path to a fault injection scenario file that replaces the
+faultInjection.scenarioFile of the default store while set. The scenario restarts from its first phase whenever
+this value changes. It only takes effect if faultInjection is configured for the default store.`,
+	)
+	PersistenceRecordingDir = NewGlobalStringSetting(
+		"system.persistenceRecordingDir",
+		"",
+		`PersistenceRecordingDir is a directory where the shard, execution and task manager calls of every service
+are recorded while set, one new file per host and change of this value. Workflow, namespace and task queue IDs
+are anonymized. The recordings can be replayed against another store with tdbg persistence replay.`,
+	)
+
 	EnableDataLossMetrics = NewGlobalBoolSetting(
 		"system.enableDataLossMetrics",
 		false,
@@ -669,6 +725,12 @@ See DynamicRateLimitingParams comments for more details.`,
 		1000,
 		`FrontendVisibilityMaxPageSize is default max size for ListWorkflowExecutions in one page`,
 	)
+	FrontendVisibilityWatchMaxStreamsPerNamespace = NewNamespaceIntSetting(
+		"frontend.visibilityWatchMaxStreamsPerNamespace",
+		100,
+		`FrontendVisibilityWatchMaxStreamsPerNamespace is the maximum number of concurrent WatchWorkflowExecutions
+streams of a namespace per frontend instance. Streams over the limit are rejected with a ResourceExhausted error.`,
+	)
 	FrontendHistoryMaxPageSize = NewNamespaceIntSetting(
 		"frontend.historyMaxPageSize",
 		primitives.GetHistoryMaxPageSize,
@@ -1289,6 +1351,18 @@ Note: this should be greater than matching.longPollExpirationInterval and matchi
 		15*time.Second,
 		`MatchingTaskDeleteInterval is the minimum interval between task range deletions`,
 	)
+	MatchingTaskDLQEnabled = NewTaskQueueBoolSetting(
+		"matching.taskDLQEnabled",
+		false,
+		`MatchingTaskDLQEnabled writes backlog tasks that expire or can't be delivered to the DLQ of their task queue,
+instead of dropping them without a record. Tasks of sticky queues are never written to the DLQ.`,
+	)
+	MatchingTaskDLQRetention = NewTaskQueueDurationSetting(
+		"matching.taskDLQRetention",
+		7*24*time.Hour,
+		`MatchingTaskDLQRetention is how long tasks are kept in the DLQ of their task queue. Older tasks are deleted
+when the DLQ is written to or listed, and hourly by the matching host that owns the root partition of the task queue.`,
+	)
 	MatchingThrottledLogRPS = NewGlobalIntSetting(
 		"matching.throttledLogRPS",
 		20,
@@ -1546,6 +1620,22 @@ movement), at the cost of cross-key FIFO ordering for bursts of equal-weight new
 		1000,
 		"Maximum number of fairness key weight overrides that can be configured for a task queue at a time.",
 	)
+	MatchingFairnessKeyConcurrencyLimit = NewTaskQueueIntSetting(
+		"matching.fairnessKeyConcurrencyLimit",
+		0,
+		`Maximum number of activity tasks with the same fairness key that can be outstanding (dispatched to a
+worker but not yet completed) on a task queue. The limit is split evenly among read partitions. Tasks
+without a fairness key are not limited. 0 means no limit. Only supported with the new matcher. Slots of
+activities responded to by ID are only released on response if the limit is also set for the namespace,
+otherwise they're held until the start-to-close timeout passes.`,
+	)
+	MatchingEnableDeadlineOrdering = NewTaskQueueBoolSetting(
+		"matching.enableDeadlineOrdering",
+		false,
+		`When true, tasks of the same priority level are dispatched in order of their schedule-to-start deadline
+(which is capped by the schedule-to-close deadline), instead of in fairness or FIFO order. Tasks without a
+deadline go after tasks with one. Only supported with the new matcher.`,
+	)
 	MatchingEnableWorkerPluginMetrics = NewGlobalBoolSetting(
 		"matching.enableWorkerPluginMetrics",
 		false,
@@ -2367,6 +2457,20 @@ attributes.`,
 		2,
 		`VisibilityQueueMaxReaderCount is the max number of readers in one multi-cursor visibility queue`,
 	)
+	VisibilityWatchBufferSize = NewGlobalIntSetting(
+		"history.visibilityWatchBufferSize",
+		10000,
+		`VisibilityWatchBufferSize is the number of visibility changes per namespace that a history host keeps in
+memory for WatchWorkflowExecutions streams. Streams that fall further behind must resync. Requires service restart
+to take effect.`,
+	)
+	VisibilityWatchTotalBufferSize = NewGlobalIntSetting(
+		"history.visibilityWatchTotalBufferSize",
+		100000,
+		`VisibilityWatchTotalBufferSize is the number of visibility changes across all namespaces that a history host
+keeps in memory for WatchWorkflowExecutions streams. When it's exceeded, the oldest changes of the namespace with the
+most changes are evicted first. Requires service restart to take effect.`,
+	)
 
 	DisableFetchRelocatableAttributesFromVisibility = NewNamespaceBoolSetting(
 		"history.disableFetchRelocatableAttributesFromVisibility",
@@ -3435,6 +3539,11 @@ WorkerActivitiesPerSecond, MaxConcurrentActivityTaskPollers.
 		`WorkflowRulesAPIsEnabled is a "feature enable" flag. `,
 	)
 
+	MaxVisibilityViewsPerNamespace = NewNamespaceIntSetting(
+		"frontend.maxVisibilityViewsPerNamespace",
+		100,
+		`Maximum number of saved visibility views in a given namespace`,
+	)
 	MaxWorkflowRulesPerNamespace = NewNamespaceIntSetting(
 		"frontend.maxWorkflowRulesPerNamespace",
 		10,
@@ -0,0 +1,36 @@
+package dcoverride
+
+import (
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/fx"
+)
+
+type ManagerParams struct {
+	fx.In
+
+	Client                 *dynamicconfig.PersistedClient `optional:"true"`
+	ClusterMetadataManager persistence.ClusterMetadataManager
+	TimeSource             clock.TimeSource
+	Logger                 log.SnTaggedLogger
+	Collection             *dynamicconfig.Collection
+}
+
+var Module = fx.Options(
+	fx.Provide(ManagerProvider),
+	fx.Invoke(func(m *Manager, lc fx.Lifecycle) {
+		lc.Append(fx.StartStopHook(m.Start, m.Stop))
+	}),
+)
+
+func ManagerProvider(params ManagerParams) *Manager {
+	return NewManager(
+		params.Client,
+		params.ClusterMetadataManager,
+		params.TimeSource,
+		params.Logger,
+		dynamicconfig.DynamicConfigOverridesRefreshInterval.Get(params.Collection),
+	)
+}
@@ -0,0 +1,311 @@
+// Package dcoverride stores dynamic config overrides in the cluster metadata and keeps the
+// in-memory dynamicconfig.PersistedClient of each service up to date with them.
+package dcoverride
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/goro"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxHistorySize is the number of changes to overrides kept in the cluster metadata.
+	maxHistorySize = 100
+	// maxSaveAttempts bounds retries of the read-modify-write of the cluster metadata record
+	// when it's updated concurrently.
+	maxSaveAttempts = 5
+	refreshTimeout  = 5 * time.Second
+)
+
+type (
+	// Manager reads and writes dynamic config overrides stored in the cluster metadata of the
+	// current cluster. While started, it also periodically loads the overrides into the
+	// dynamicconfig.PersistedClient (if there is one).
+	Manager struct {
+		status                 int32
+		client                 *dynamicconfig.PersistedClient
+		clusterMetadataManager persistence.ClusterMetadataManager
+		timeSource             clock.TimeSource
+		logger                 log.Logger
+		refreshInterval        dynamicconfig.DurationPropertyFn
+
+		refresher *goro.Handle
+	}
+)
+
+// NewManager returns a new Manager. client may be nil, in which case overrides can be managed
+// but aren't applied by this process.
+func NewManager(
+	client *dynamicconfig.PersistedClient,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+) *Manager {
+	return &Manager{
+		status:                 common.DaemonStatusInitialized,
+		client:                 client,
+		clusterMetadataManager: clusterMetadataManager,
+		timeSource:             timeSource,
+		logger:                 logger,
+		refreshInterval:        refreshInterval,
+	}
+}
+
+func (m *Manager) Start() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	if m.client == nil {
+		return
+	}
+
+	ctx := headers.SetCallerInfo(context.Background(), headers.SystemBackgroundHighCallerInfo)
+	if err := m.refresh(ctx); err != nil {
+		// Keep serving the values of the underlying client until the overrides can be loaded.
+		m.logger.Error("Unable to load dynamic config overrides", tag.Error(err))
+	}
+	m.refresher = goro.NewHandle(ctx).Go(m.refreshLoop)
+}
+
+func (m *Manager) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	if m.refresher != nil {
+		m.refresher.Cancel()
+		<-m.refresher.Done()
+	}
+}
+
+// GetOverrides returns the overrides for key, or all overrides if key is empty.
+func (m *Manager) GetOverrides(ctx context.Context, key string) ([]*persistencespb.DynamicConfigOverride, error) {
+	resp, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var overrides []*persistencespb.DynamicConfigOverride
+	for _, o := range resp.GetDynamicConfigOverrides() {
+		if key == "" || strings.EqualFold(o.GetKey(), key) {
+			overrides = append(overrides, o)
+		}
+	}
+	return overrides, nil
+}
+
+// GetHistory returns the recorded changes for key, or for all keys if key is empty, newest
+// first.
+func (m *Manager) GetHistory(ctx context.Context, key string) ([]*persistencespb.DynamicConfigChange, error) {
+	resp, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var changes []*persistencespb.DynamicConfigChange
+	for _, c := range slices.Backward(resp.GetDynamicConfigHistory()) {
+		if key == "" || strings.EqualFold(c.GetOverride().GetKey(), key) {
+			changes = append(changes, c)
+		}
+	}
+	return changes, nil
+}
+
+// SetOverride creates or replaces the override for key and constraints. The value is
+// validated the same way as values in the dynamic config file.
+func (m *Manager) SetOverride(
+	ctx context.Context,
+	key string,
+	constraints *persistencespb.DynamicConfigConstraints,
+	value string,
+	author string,
+	reason string,
+) error {
+	if key == "" {
+		return serviceerror.NewInvalidArgument("dynamic config key is not set")
+	}
+	if _, err := dynamicconfig.ParseOverride(dynamicconfig.MakeKey(key), ToConstraints(constraints), value); err != nil {
+		return serviceerror.NewInvalidArgumentf("invalid dynamic config override for %q: %v", key, err)
+	}
+
+	override := &persistencespb.DynamicConfigOverride{
+		Key:         strings.ToLower(key),
+		Constraints: normalizeConstraints(constraints),
+		Value:       value,
+		UpdateTime:  timestamppb.New(m.timeSource.Now()),
+		Author:      author,
+		Reason:      reason,
+	}
+	return m.update(ctx, func(metadata *persistencespb.ClusterMetadata) error {
+		change := &persistencespb.DynamicConfigChange{Override: override}
+		idx := findOverride(metadata.DynamicConfigOverrides, override.Key, override.Constraints)
+		if idx >= 0 {
+			change.PreviousValue = metadata.DynamicConfigOverrides[idx].GetValue()
+			metadata.DynamicConfigOverrides[idx] = override
+		} else {
+			metadata.DynamicConfigOverrides = append(metadata.DynamicConfigOverrides, override)
+		}
+		appendHistory(metadata, change)
+		return nil
+	})
+}
+
+// DeleteOverride removes the override for key and constraints. It returns NotFound if there
+// is no such override.
+func (m *Manager) DeleteOverride(
+	ctx context.Context,
+	key string,
+	constraints *persistencespb.DynamicConfigConstraints,
+	author string,
+	reason string,
+) error {
+	key = strings.ToLower(key)
+	constraints = normalizeConstraints(constraints)
+	return m.update(ctx, func(metadata *persistencespb.ClusterMetadata) error {
+		idx := findOverride(metadata.DynamicConfigOverrides, key, constraints)
+		if idx < 0 {
+			return serviceerror.NewNotFoundf("dynamic config override for %q not found", key)
+		}
+		previous := metadata.DynamicConfigOverrides[idx]
+		metadata.DynamicConfigOverrides = slices.Delete(metadata.DynamicConfigOverrides, idx, idx+1)
+		appendHistory(metadata, &persistencespb.DynamicConfigChange{
+			Override: &persistencespb.DynamicConfigOverride{
+				Key:         key,
+				Constraints: constraints,
+				UpdateTime:  timestamppb.New(m.timeSource.Now()),
+				Author:      author,
+				Reason:      reason,
+			},
+			PreviousValue: previous.GetValue(),
+			Deleted:       true,
+		})
+		return nil
+	})
+}
+
+func (m *Manager) update(ctx context.Context, mutate func(*persistencespb.ClusterMetadata) error) error {
+	for attempt := 1; ; attempt++ {
+		resp, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+		if err != nil {
+			return err
+		}
+		metadata := resp.ClusterMetadata
+		if err := mutate(metadata); err != nil {
+			return err
+		}
+		applied, err := m.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+			ClusterMetadata: metadata,
+			Version:         resp.Version,
+		})
+		if err != nil {
+			return err
+		}
+		if applied {
+			break
+		}
+		if attempt == maxSaveAttempts {
+			return serviceerror.NewUnavailable("cluster metadata was updated concurrently, please retry")
+		}
+	}
+
+	// Apply the change in this process right away, the other hosts pick it up on their next refresh.
+	if m.client != nil {
+		if err := m.refresh(ctx); err != nil {
+			m.logger.Warn("Unable to reload dynamic config overrides", tag.Error(err))
+		}
+	}
+	return nil
+}
+
+func (m *Manager) refreshLoop(ctx context.Context) error {
+	timer := time.NewTimer(m.refreshInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			if err := m.refresh(ctx); err != nil {
+				m.logger.Warn("Unable to refresh dynamic config overrides", tag.Error(err))
+			}
+			timer.Reset(m.refreshInterval())
+		}
+	}
+}
+
+func (m *Manager) refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, refreshTimeout)
+	defer cancel()
+
+	resp, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	var notFoundErr *serviceerror.NotFound
+	if errors.As(err, &notFoundErr) {
+		// Cluster metadata was never persisted, so there are no overrides.
+		m.client.UpdateOverrides(0, make(dynamicconfig.ConfigValueMap))
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	values := make(dynamicconfig.ConfigValueMap)
+	for _, o := range resp.GetDynamicConfigOverrides() {
+		key := dynamicconfig.MakeKey(o.GetKey())
+		cv, err := dynamicconfig.ParseOverride(key, ToConstraints(o.GetConstraints()), o.GetValue())
+		if err != nil {
+			// Possible while a key is being removed or renamed in a rolling upgrade.
+			m.logger.Warn("Ignoring invalid dynamic config override", tag.Key(o.GetKey()), tag.Error(err))
+			continue
+		}
+		values[key] = append(values[key], cv)
+	}
+	m.client.UpdateOverrides(resp.Version, values)
+	return nil
+}
+
+// ToConstraints converts persisted constraints to dynamicconfig.Constraints.
+func ToConstraints(c *persistencespb.DynamicConfigConstraints) dynamicconfig.Constraints {
+	return dynamicconfig.Constraints{
+		Namespace:     c.GetNamespace(),
+		TaskQueueName: c.GetTaskQueueName(),
+		TaskQueueType: c.GetTaskQueueType(),
+	}
+}
+
+func normalizeConstraints(c *persistencespb.DynamicConfigConstraints) *persistencespb.DynamicConfigConstraints {
+	if c == nil || proto.Equal(c, &persistencespb.DynamicConfigConstraints{}) {
+		return nil
+	}
+	return c
+}
+
+func findOverride(
+	overrides []*persistencespb.DynamicConfigOverride,
+	key string,
+	constraints *persistencespb.DynamicConfigConstraints,
+) int {
+	return slices.IndexFunc(overrides, func(o *persistencespb.DynamicConfigOverride) bool {
+		return o.GetKey() == key && proto.Equal(normalizeConstraints(o.GetConstraints()), constraints)
+	})
+}
+
+func appendHistory(metadata *persistencespb.ClusterMetadata, change *persistencespb.DynamicConfigChange) {
+	metadata.DynamicConfigHistory = append(metadata.DynamicConfigHistory, change)
+	if extra := len(metadata.DynamicConfigHistory) - maxHistorySize; extra > 0 {
+		metadata.DynamicConfigHistory = slices.Delete(metadata.DynamicConfigHistory, 0, extra)
+	}
+}
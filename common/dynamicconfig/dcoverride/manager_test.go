@@ -0,0 +1,152 @@
+package dcoverride
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+)
+
+type managerSuite struct {
+	suite.Suite
+
+	controller *gomock.Controller
+	cmMgr      *persistence.MockClusterMetadataManager
+	client     *dynamicconfig.PersistedClient
+	manager    *Manager
+
+	metadata *persistencespb.ClusterMetadata
+	version  int64
+}
+
+func TestManagerSuite(t *testing.T) {
+	suite.Run(t, new(managerSuite))
+}
+
+func (s *managerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.cmMgr = persistence.NewMockClusterMetadataManager(s.controller)
+	s.client = dynamicconfig.NewPersistedClient(dynamicconfig.NewMemoryClient(), log.NewNoopLogger())
+	s.manager = NewManager(
+		s.client,
+		s.cmMgr,
+		clock.NewEventTimeSource().Update(time.Unix(1000, 0)),
+		log.NewNoopLogger(),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+	)
+
+	// Simple in-memory cluster metadata store with versioning.
+	s.metadata = &persistencespb.ClusterMetadata{ClusterName: "active"}
+	s.version = 1
+	s.cmMgr.EXPECT().GetCurrentClusterMetadata(gomock.Any()).DoAndReturn(
+		func(context.Context) (*persistence.GetClusterMetadataResponse, error) {
+			return &persistence.GetClusterMetadataResponse{
+				ClusterMetadata: s.metadata,
+				Version:         s.version,
+			}, nil
+		}).AnyTimes()
+	s.cmMgr.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			if request.Version != s.version {
+				return false, nil
+			}
+			s.metadata = request.ClusterMetadata
+			s.version++
+			return true, nil
+		}).AnyTimes()
+}
+
+func (s *managerSuite) TestSetAndDeleteOverride() {
+	ctx := context.Background()
+	key := dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key()
+	constraints := &persistencespb.DynamicConfigConstraints{Namespace: "ns"}
+
+	s.NoError(s.manager.SetOverride(ctx, "Frontend.NamespaceRPS", constraints, "100", "alice", "incident"))
+	s.Equal([]dynamicconfig.ConstrainedValue{
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 100},
+	}, s.client.GetValue(key))
+
+	s.NoError(s.manager.SetOverride(ctx, key.String(), constraints, "200", "bob", "still an incident"))
+	s.Equal(200, s.client.GetValue(key)[0].Value)
+
+	overrides, err := s.manager.GetOverrides(ctx, key.String())
+	s.NoError(err)
+	s.Len(overrides, 1)
+	s.Equal("200", overrides[0].GetValue())
+	s.Equal("bob", overrides[0].GetAuthor())
+
+	s.NoError(s.manager.DeleteOverride(ctx, key.String(), constraints, "carol", "resolved"))
+	s.Nil(s.client.GetValue(key))
+
+	err = s.manager.DeleteOverride(ctx, key.String(), constraints, "carol", "resolved")
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+
+	history, err := s.manager.GetHistory(ctx, key.String())
+	s.NoError(err)
+	s.Len(history, 3)
+	s.True(history[0].GetDeleted())
+	s.Equal("200", history[0].GetPreviousValue())
+	s.Equal("carol", history[0].GetOverride().GetAuthor())
+	s.Equal("100", history[1].GetPreviousValue())
+	s.Equal("", history[2].GetPreviousValue())
+}
+
+func (s *managerSuite) TestSetOverride_Invalid() {
+	ctx := context.Background()
+	var invalidArg *serviceerror.InvalidArgument
+
+	err := s.manager.SetOverride(ctx, "", nil, "1", "alice", "")
+	s.ErrorAs(err, &invalidArg)
+	err = s.manager.SetOverride(ctx, "unknown.key", nil, "1", "alice", "")
+	s.ErrorAs(err, &invalidArg)
+	err = s.manager.SetOverride(ctx, dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key().String(), nil, "abc", "alice", "")
+	s.ErrorAs(err, &invalidArg)
+	s.Empty(s.metadata.GetDynamicConfigOverrides())
+}
+
+func (s *managerSuite) TestSetOverride_ConcurrentUpdate() {
+	ctx := context.Background()
+	key := dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key()
+
+	// Another writer bumps the version between our read and write once.
+	raced := false
+	s.cmMgr = persistence.NewMockClusterMetadataManager(s.controller)
+	s.manager.clusterMetadataManager = s.cmMgr
+	s.cmMgr.EXPECT().GetCurrentClusterMetadata(gomock.Any()).DoAndReturn(
+		func(context.Context) (*persistence.GetClusterMetadataResponse, error) {
+			return &persistence.GetClusterMetadataResponse{ClusterMetadata: s.metadata, Version: s.version}, nil
+		}).AnyTimes()
+	s.cmMgr.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			if !raced {
+				raced = true
+				s.version++
+				return false, nil
+			}
+			s.metadata = request.ClusterMetadata
+			s.version++
+			return true, nil
+		}).Times(2)
+
+	s.NoError(s.manager.SetOverride(ctx, key.String(), nil, "100", "alice", ""))
+	s.Equal(100, s.client.GetValue(key)[0].Value)
+}
+
+func (s *managerSuite) TestRefresh_SkipsInvalidOverrides() {
+	s.metadata.DynamicConfigOverrides = []*persistencespb.DynamicConfigOverride{
+		{Key: "removed.key", Value: "1"},
+		{Key: dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key().String(), Value: "5"},
+	}
+	s.NoError(s.manager.refresh(context.Background()))
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 5}}, s.client.GetValue(dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key()))
+	s.Nil(s.client.GetValue(dynamicconfig.MakeKey("removed.key")))
+}
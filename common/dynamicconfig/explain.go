@@ -0,0 +1,216 @@
+package dynamicconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	dynamicconfigspb "go.temporal.io/server/api/dynamicconfig/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// Explanation describes how a Collection resolves a setting for a set of constraints.
+	Explanation struct {
+		Key Key
+		// Precedence is the list of constraints that were searched for a value, in order.
+		Precedence []Constraints
+		// Values are all values the client has for Key, matching or not.
+		Values []ExplainedValue
+		// Matched is the configured value that's in effect, or nil if the default is used.
+		Matched *ExplainedValue
+		// Value is the effective value, converted to the type of the setting.
+		Value any
+		// Default is the default value of the setting for the constraints.
+		Default any
+		// ConversionError is set if Matched couldn't be converted to the type of the setting,
+		// in which case Value is the default.
+		ConversionError error
+		// GradualChange is set if Value is a GradualChange.
+		GradualChange *GradualChange[any]
+	}
+
+	// ExplainedValue is a configured value along with where it came from.
+	ExplainedValue struct {
+		ConstrainedValue
+		// Source is set if the client implements ValueSourceClient.
+		Source string
+	}
+)
+
+// Explain returns how the setting for key is resolved for the given constraints. Constraints
+// that aren't used by the precedence of the setting are ignored. It returns InvalidArgument if
+// key isn't a registered setting.
+func (c *Collection) Explain(key Key, constraints Constraints) (Explanation, error) {
+	setting := queryRegistry(key)
+	if setting == nil {
+		return Explanation{}, serviceerror.NewInvalidArgumentf("unregistered dynamic config key %q", key)
+	}
+	return setting.explain(c, constraints), nil
+}
+
+func explainSetting[T any](
+	c *Collection,
+	key Key,
+	def T,
+	convert func(value any) (T, error),
+	precedence []Constraints,
+) Explanation {
+	cvs := c.client.GetValue(key)
+	cvp, err := findMatch(c.indexCache, cvs, precedence)
+	if err != nil {
+		cvp = nil
+	}
+	return newExplanation(c, key, cvs, cvp, def, convert, precedence)
+}
+
+func explainSettingWithConstrainedDefault[T any](
+	c *Collection,
+	key Key,
+	cdef []TypedConstrainedValue[T],
+	convert func(value any) (T, error),
+	precedence []Constraints,
+) Explanation {
+	cvs := c.client.GetValue(key)
+	cvp, def, valOrder, defOrder := findMatchWithConstrainedDefaults(cvs, cdef, precedence)
+	if valOrder == 0 || defOrder < valOrder {
+		// no value, or a constrained default took precedence
+		cvp = nil
+	}
+	return newExplanation(c, key, cvs, cvp, def, convert, precedence)
+}
+
+func newExplanation[T any](
+	c *Collection,
+	key Key,
+	cvs []ConstrainedValue,
+	cvp *ConstrainedValue,
+	def T,
+	convert func(value any) (T, error),
+	precedence []Constraints,
+) Explanation {
+	e := Explanation{
+		Key:        key,
+		Precedence: precedence,
+		Values:     make([]ExplainedValue, len(cvs)),
+		Default:    def,
+	}
+	for i, cv := range cvs {
+		e.Values[i] = c.explainValue(key, cv)
+	}
+
+	value := def
+	if cvp != nil {
+		matched := c.explainValue(key, *cvp)
+		e.Matched = &matched
+		if typed, err := convert(cvp.Value); err != nil {
+			e.ConversionError = err
+		} else {
+			value = typed
+		}
+	}
+	e.Value = value
+	if change, ok := any(&value).(interface{ erase() GradualChange[any] }); ok {
+		erased := change.erase()
+		e.GradualChange = &erased
+	}
+	return e
+}
+
+func (c *Collection) explainValue(key Key, cv ConstrainedValue) ExplainedValue {
+	ev := ExplainedValue{ConstrainedValue: cv}
+	if sourceClient, ok := c.client.(ValueSourceClient); ok {
+		ev.Source = sourceClient.GetValueSource(key, cv.Constraints)
+	}
+	return ev
+}
+
+// ToProto converts an Explanation to its proto representation. If rolloutKey is not empty, the
+// value for that key at time now is included (see GradualChange.Value and RolloutAccepts).
+func (e Explanation) ToProto(rolloutKey []byte, now time.Time) *dynamicconfigspb.Explanation {
+	ex := &dynamicconfigspb.Explanation{
+		Precedence:     make([]*dynamicconfigspb.Constraints, len(e.Precedence)),
+		Values:         make([]*dynamicconfigspb.ConstrainedValue, len(e.Values)),
+		EffectiveValue: encodeExplainedValue(e.Value),
+		DefaultValue:   encodeExplainedValue(e.Default),
+	}
+	for i, cs := range e.Precedence {
+		ex.Precedence[i] = ConstraintsToProto(cs)
+	}
+	for i, v := range e.Values {
+		ex.Values[i] = v.toProto()
+	}
+	if e.Matched != nil {
+		ex.MatchedValue = e.Matched.toProto()
+	}
+	if e.ConversionError != nil {
+		ex.ConversionError = e.ConversionError.Error()
+	}
+	if e.GradualChange != nil {
+		ex.GradualChange = &dynamicconfigspb.GradualChange{
+			OldValue:  encodeExplainedValue(e.GradualChange.Old),
+			NewValue:  encodeExplainedValue(e.GradualChange.New),
+			StartTime: timestamppb.New(e.GradualChange.Start),
+			EndTime:   timestamppb.New(e.GradualChange.End),
+		}
+	}
+
+	if len(rolloutKey) > 0 {
+		if e.GradualChange != nil {
+			ex.GradualChange.RolloutKeyChangeTime = timestamppb.New(e.GradualChange.When(rolloutKey))
+			ex.RolloutKeyValue = encodeExplainedValue(e.GradualChange.Value(rolloutKey, now))
+		} else if percent, ok := e.Value.(int); ok {
+			ex.RolloutKeyValue = encodeExplainedValue(RolloutAccepts(rolloutKey, percent))
+		}
+	}
+	return ex
+}
+
+func (v ExplainedValue) toProto() *dynamicconfigspb.ConstrainedValue {
+	return &dynamicconfigspb.ConstrainedValue{
+		Constraints: ConstraintsToProto(v.Constraints),
+		Value:       encodeExplainedValue(v.Value),
+		Source:      v.Source,
+	}
+}
+
+// ConstraintsFromProto converts proto constraints to Constraints.
+func ConstraintsFromProto(cs *dynamicconfigspb.Constraints) Constraints {
+	return Constraints{
+		Namespace:     cs.GetNamespace(),
+		NamespaceID:   cs.GetNamespaceId(),
+		TaskQueueName: cs.GetTaskQueueName(),
+		Destination:   cs.GetDestination(),
+		ChasmTaskType: cs.GetChasmTaskType(),
+		TaskQueueType: cs.GetTaskQueueType(),
+		ShardID:       cs.GetShardId(),
+		TaskType:      cs.GetTaskType(),
+	}
+}
+
+// ConstraintsToProto converts Constraints to proto constraints.
+func ConstraintsToProto(cs Constraints) *dynamicconfigspb.Constraints {
+	return &dynamicconfigspb.Constraints{
+		Namespace:     cs.Namespace,
+		NamespaceId:   cs.NamespaceID,
+		TaskQueueName: cs.TaskQueueName,
+		TaskQueueType: cs.TaskQueueType,
+		Destination:   cs.Destination,
+		ChasmTaskType: cs.ChasmTaskType,
+		ShardId:       cs.ShardID,
+		TaskType:      cs.TaskType,
+	}
+}
+
+func encodeExplainedValue(v any) string {
+	if d, ok := v.(time.Duration); ok {
+		// json would encode this as an integer number of nanoseconds
+		v = d.String()
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
@@ -0,0 +1,171 @@
+package dynamicconfig_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+func TestExplain(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	setting := dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyFilteredByNamespaceKey, 10, "")
+	base := dynamicconfig.NewMemoryClient()
+	client := dynamicconfig.NewPersistedClient(base, log.NewNoopLogger())
+	c := dynamicconfig.NewCollection(client, log.NewNoopLogger())
+
+	// default
+	e, err := c.Explain(setting.Key(), dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "ignored"})
+	require.NoError(t, err)
+	assert.Equal(t, []dynamicconfig.Constraints{{Namespace: "ns"}, {}}, e.Precedence)
+	assert.Nil(t, e.Matched)
+	assert.Equal(t, 10, e.Value)
+	assert.Equal(t, 10, e.Default)
+
+	// global value from the base client, namespace value from an override
+	base.OverrideValue(setting.Key(), []dynamicconfig.ConstrainedValue{{Value: 100}})
+	e, err = c.Explain(setting.Key(), dynamicconfig.Constraints{Namespace: "ns"})
+	require.NoError(t, err)
+	require.NotNil(t, e.Matched)
+	assert.Equal(t, dynamicconfig.Constraints{}, e.Matched.Constraints)
+	assert.Equal(t, 100, e.Value)
+
+	client.UpdateOverrides(1, dynamicconfig.ConfigValueMap{
+		setting.Key(): {{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 50}},
+	})
+	e, err = c.Explain(setting.Key(), dynamicconfig.Constraints{Namespace: "ns"})
+	require.NoError(t, err)
+	require.NotNil(t, e.Matched)
+	assert.Equal(t, dynamicconfig.Constraints{Namespace: "ns"}, e.Matched.Constraints)
+	assert.Equal(t, dynamicconfig.PersistedOverrideSource, e.Matched.Source)
+	assert.Equal(t, 50, e.Value)
+	assert.Len(t, e.Values, 2)
+
+	// value that can't be converted
+	base.OverrideValue(setting.Key(), []dynamicconfig.ConstrainedValue{{Value: "abc"}})
+	e, err = c.Explain(setting.Key(), dynamicconfig.Constraints{Namespace: "other"})
+	require.NoError(t, err)
+	assert.Error(t, e.ConversionError)
+	assert.Equal(t, 10, e.Value)
+
+	_, err = c.Explain(dynamicconfig.MakeKey(unknownKey), dynamicconfig.Constraints{})
+	var invalidArgument *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestExplain_ConstrainedDefault(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	setting := dynamicconfig.NewTaskQueueIntSettingWithConstrainedDefault(
+		testGetIntPropertyFilteredByTaskQueueInfoKey,
+		[]dynamicconfig.TypedConstrainedValue[int]{
+			{Constraints: dynamicconfig.Constraints{TaskQueueName: "tq"}, Value: 4},
+			{Value: 1},
+		},
+		"",
+	)
+	client := dynamicconfig.NewMemoryClient()
+	c := dynamicconfig.NewCollection(client, log.NewNoopLogger())
+	cs := dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "tq", TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW}
+
+	e, err := c.Explain(setting.Key(), cs)
+	require.NoError(t, err)
+	assert.Len(t, e.Precedence, 5)
+	assert.Nil(t, e.Matched)
+	assert.Equal(t, 4, e.Value)
+	assert.Equal(t, 4, e.Default)
+
+	// namespace value has lower precedence than the task queue default
+	client.OverrideValue(setting.Key(), []dynamicconfig.ConstrainedValue{{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 8}})
+	e, err = c.Explain(setting.Key(), cs)
+	require.NoError(t, err)
+	assert.Nil(t, e.Matched)
+	assert.Equal(t, 4, e.Value)
+
+	client.OverrideValue(setting.Key(), []dynamicconfig.ConstrainedValue{{Constraints: dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "tq"}, Value: 8}})
+	e, err = c.Explain(setting.Key(), cs)
+	require.NoError(t, err)
+	require.NotNil(t, e.Matched)
+	assert.Equal(t, 8, e.Value)
+}
+
+func TestExplain_GradualChange(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	setting := dynamicconfig.NewGlobalTypedSettingWithConverter(
+		testGetTypedPropertyKey,
+		dynamicconfig.ConvertGradualChange(false),
+		dynamicconfig.StaticGradualChange(false),
+		"",
+	)
+	client := dynamicconfig.NewMemoryClient()
+	c := dynamicconfig.NewCollection(client, log.NewNoopLogger())
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	end := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	client.OverrideValue(setting.Key(), map[string]any{
+		"new":   true,
+		"start": start.Format(time.RFC3339),
+		"end":   end.Format(time.RFC3339),
+	})
+	e, err := c.Explain(setting.Key(), dynamicconfig.Constraints{})
+	require.NoError(t, err)
+	require.NotNil(t, e.GradualChange)
+	assert.Equal(t, false, e.GradualChange.Old)
+	assert.Equal(t, true, e.GradualChange.New)
+	assert.True(t, start.Equal(e.GradualChange.Start))
+	assert.True(t, end.Equal(e.GradualChange.End))
+
+	key := []byte("some-key")
+	now := time.Now()
+	p := e.ToProto(key, now)
+	assert.Equal(t, "false", p.GetGradualChange().GetOldValue())
+	assert.Equal(t, "true", p.GetGradualChange().GetNewValue())
+	when := p.GetGradualChange().GetRolloutKeyChangeTime().AsTime()
+	assert.False(t, when.Before(start))
+	assert.False(t, when.After(end))
+	if when.After(now) {
+		assert.Equal(t, "false", p.GetRolloutKeyValue())
+	} else {
+		assert.Equal(t, "true", p.GetRolloutKeyValue())
+	}
+}
+
+func TestExplain_Rollout(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	setting := dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyFilteredByNamespaceKey, 0, "")
+	client := dynamicconfig.NewMemoryClient()
+	c := dynamicconfig.NewCollection(client, log.NewNoopLogger())
+	rolloutKey := []byte("ns\x00id")
+
+	e, err := c.Explain(setting.Key(), dynamicconfig.Constraints{Namespace: "ns"})
+	require.NoError(t, err)
+	assert.Equal(t, "false", e.ToProto(rolloutKey, time.Now()).GetRolloutKeyValue())
+	assert.Empty(t, e.ToProto(nil, time.Now()).GetRolloutKeyValue())
+
+	client.OverrideValue(setting.Key(), 100)
+	e, err = c.Explain(setting.Key(), dynamicconfig.Constraints{Namespace: "ns"})
+	require.NoError(t, err)
+	assert.Equal(t, "true", e.ToProto(rolloutKey, time.Now()).GetRolloutKeyValue())
+}
+
+func TestExplain_ToProto(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	setting := dynamicconfig.NewTaskQueueDurationSetting(testGetDurationPropertyFilteredByTaskQueueInfoKey, time.Minute, "")
+	client := dynamicconfig.NewMemoryClient()
+	c := dynamicconfig.NewCollection(client, log.NewNoopLogger())
+
+	client.OverrideValue(setting.Key(), []dynamicconfig.ConstrainedValue{{Constraints: dynamicconfig.Constraints{TaskQueueName: "tq"}, Value: "5s"}})
+	e, err := c.Explain(setting.Key(), dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "tq"})
+	require.NoError(t, err)
+	p := e.ToProto(nil, time.Now())
+	assert.Equal(t, `"5s"`, p.GetEffectiveValue())
+	assert.Equal(t, `"1m0s"`, p.GetDefaultValue())
+	assert.Equal(t, `"5s"`, p.GetMatchedValue().GetValue())
+	assert.Len(t, p.GetValues(), 1)
+	assert.Len(t, p.GetPrecedence(), 5)
+	assert.Equal(t, dynamicconfig.Constraints{TaskQueueName: "tq"}, dynamicconfig.ConstraintsFromProto(p.GetMatchedValue().GetConstraints()))
+}
@@ -121,6 +121,16 @@ func (fc *FileBasedClient) GetValue(key Key) []ConstrainedValue {
 	return values[key]
 }
 
+// GetValueSource implements ValueSourceClient.
+func (fc *FileBasedClient) GetValueSource(key Key, constraints Constraints) string {
+	for _, cv := range fc.GetValue(key) {
+		if cv.Constraints == constraints {
+			return fc.config.Filepath
+		}
+	}
+	return ""
+}
+
 func (fc *FileBasedClient) init() error {
 	if err := fc.validateStaticConfig(fc.config); err != nil {
 		return fmt.Errorf("unable to validate dynamic config: %w", err)
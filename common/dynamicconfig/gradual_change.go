@@ -51,6 +51,11 @@ func (c *GradualChange[T]) When(key []byte) time.Time {
 	return c.Start.Add(when)
 }
 
+// erase returns c with values of type any.
+func (c *GradualChange[T]) erase() GradualChange[any] {
+	return GradualChange[any]{Old: c.Old, New: c.New, Start: c.Start, End: c.End}
+}
+
 // ConvertGradualChange is a conversion function that can handle a plain T (which represents a
 // static value) as well as a GradualChange[T]. It can be used to turn settings that were not
 // of type GradualChange into a GradualChange.
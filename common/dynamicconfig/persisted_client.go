@@ -0,0 +1,201 @@
+package dynamicconfig
+
+import (
+	"errors"
+	"maps"
+	"sync"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/log"
+	"gopkg.in/yaml.v3"
+)
+
+type (
+	// PersistedClient layers dynamic config overrides that are persisted in the cluster metadata
+	// store on top of another Client (usually the FileBasedClient). An override replaces the
+	// value with exactly the same constraints from the underlying client; all other values for
+	// the key are still returned.
+	//
+	// PersistedClient doesn't read from persistence itself: overrides are loaded and pushed with
+	// UpdateOverrides by a refresher running in each service.
+	PersistedClient struct {
+		base   Client
+		logger log.Logger
+
+		lock      sync.RWMutex
+		version   int64
+		overrides ConfigValueMap
+		merged    map[Key]mergedValue
+
+		NotifyingClientImpl
+	}
+
+	mergedValue struct {
+		base   []ConstrainedValue
+		values []ConstrainedValue
+	}
+)
+
+// PersistedOverrideSource is the source of values from persisted overrides (see
+// ValueSourceClient).
+const PersistedOverrideSource = "persisted override"
+
+var (
+	_ NotifyingClient   = (*PersistedClient)(nil)
+	_ ValueSourceClient = (*PersistedClient)(nil)
+)
+
+// NewPersistedClient returns a PersistedClient with no overrides on top of base.
+func NewPersistedClient(base Client, logger log.Logger) *PersistedClient {
+	c := &PersistedClient{
+		base:                base,
+		logger:              logger,
+		overrides:           make(ConfigValueMap),
+		merged:              make(map[Key]mergedValue),
+		NotifyingClientImpl: NewNotifyingClientImpl(),
+	}
+	if notifying, ok := base.(NotifyingClient); ok {
+		// The base client lives as long as the server, so the subscription is never canceled.
+		notifying.Subscribe(c.baseUpdated)
+	}
+	return c
+}
+
+func (c *PersistedClient) GetValue(key Key) []ConstrainedValue {
+	base := c.base.GetValue(key)
+
+	c.lock.RLock()
+	overrides, ok := c.overrides[key]
+	merged, cached := c.merged[key]
+	c.lock.RUnlock()
+
+	if !ok {
+		return base
+	}
+	if cached && sameSlice(merged.base, base) {
+		return merged.values
+	}
+
+	values := mergeOverrides(overrides, base)
+	c.lock.Lock()
+	// Only cache if the overrides didn't change while we weren't holding the lock.
+	if current, ok := c.overrides[key]; ok && sameSlice(current, overrides) {
+		c.merged[key] = mergedValue{base: base, values: values}
+	}
+	c.lock.Unlock()
+	return values
+}
+
+// GetValueSource implements ValueSourceClient.
+func (c *PersistedClient) GetValueSource(key Key, constraints Constraints) string {
+	c.lock.RLock()
+	overrides := c.overrides[key]
+	c.lock.RUnlock()
+
+	for _, o := range overrides {
+		if o.Constraints == constraints {
+			return PersistedOverrideSource
+		}
+	}
+	if sourceClient, ok := c.base.(ValueSourceClient); ok {
+		return sourceClient.GetValueSource(key, constraints)
+	}
+	return ""
+}
+
+// UpdateOverrides replaces the current set of overrides. version is the version of the cluster
+// metadata record the overrides were loaded from; updates with the version that is already
+// applied are ignored, so multiple refreshers can share one PersistedClient.
+func (c *PersistedClient) UpdateOverrides(version int64, overrides ConfigValueMap) {
+	c.lock.Lock()
+	if version == c.version {
+		c.lock.Unlock()
+		return
+	}
+	changed := DiffAndLogConfigs(c.logger, c.overrides, overrides)
+	c.version = version
+	c.overrides = overrides
+	for key := range changed {
+		delete(c.merged, key)
+	}
+	c.lock.Unlock()
+
+	if len(changed) == 0 {
+		return
+	}
+	// do not hold lock while notifying subscriptions
+	for key := range changed {
+		changed[key] = c.GetValue(key)
+	}
+	c.PublishUpdates(changed)
+}
+
+func (c *PersistedClient) baseUpdated(changed map[Key][]ConstrainedValue) {
+	c.lock.RLock()
+	var overridden []Key
+	for key := range changed {
+		if _, ok := c.overrides[key]; ok {
+			overridden = append(overridden, key)
+		}
+	}
+	c.lock.RUnlock()
+
+	if len(overridden) > 0 {
+		changed = maps.Clone(changed)
+		for _, key := range overridden {
+			changed[key] = c.GetValue(key)
+		}
+	}
+	c.PublishUpdates(changed)
+}
+
+func mergeOverrides(overrides []ConstrainedValue, base []ConstrainedValue) []ConstrainedValue {
+	values := make([]ConstrainedValue, 0, len(overrides)+len(base))
+	values = append(values, overrides...)
+outer:
+	for _, cv := range base {
+		for _, o := range overrides {
+			if o.Constraints == cv.Constraints {
+				continue outer
+			}
+		}
+		values = append(values, cv)
+	}
+	return values
+}
+
+func sameSlice(a, b []ConstrainedValue) bool {
+	return len(a) == len(b) && (len(a) == 0 || &a[0] == &b[0])
+}
+
+// ParseOverride parses a YAML-encoded value for key, using the same rules as the file-based
+// client. Unlike the file-based client, anything that would be logged as a warning (an
+// unregistered key, a value that fails validation, or a constraint that the key doesn't use) is
+// returned as an error.
+func ParseOverride(key Key, constraints Constraints, value string) (ConstrainedValue, error) {
+	var parsed any
+	if err := yaml.Unmarshal([]byte(value), &parsed); err != nil {
+		return ConstrainedValue{}, err
+	}
+	if parsed == nil {
+		return ConstrainedValue{}, errors.New("value must not be empty")
+	}
+
+	yamlConstraints := make(map[string]any)
+	if constraints.Namespace != "" {
+		yamlConstraints["namespace"] = constraints.Namespace
+	}
+	if constraints.TaskQueueName != "" {
+		yamlConstraints["taskQueueName"] = constraints.TaskQueueName
+	}
+	if constraints.TaskQueueType != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		yamlConstraints["taskType"] = int(constraints.TaskQueueType)
+	}
+
+	lr := YamlLoader{Map: make(ConfigValueMap, 1)}
+	lr.add(key, yamlConstrainedValue{{Constraints: yamlConstraints, Value: parsed}})
+	if err := errors.Join(append(lr.Errors, lr.Warnings...)...); err != nil {
+		return ConstrainedValue{}, err
+	}
+	return lr.Map[key][0], nil
+}
@@ -0,0 +1,110 @@
+package dynamicconfig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+func TestPersistedClient(t *testing.T) {
+	base := dynamicconfig.NewMemoryClient()
+	c := dynamicconfig.NewPersistedClient(base, log.NewNoopLogger())
+	k := dynamicconfig.MakeKey("key")
+	nsConstraints := dynamicconfig.Constraints{Namespace: "ns"}
+
+	// no overrides
+	assert.Nil(t, c.GetValue(k))
+	base.OverrideValue(k, []dynamicconfig.ConstrainedValue{
+		{Constraints: nsConstraints, Value: 1},
+		{Value: 2},
+	})
+	assert.Equal(t, base.GetValue(k), c.GetValue(k))
+
+	// override replaces the value with the same constraints only
+	c.UpdateOverrides(1, dynamicconfig.ConfigValueMap{
+		k: {{Constraints: nsConstraints, Value: 10}},
+	})
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{
+		{Constraints: nsConstraints, Value: 10},
+		{Value: 2},
+	}, c.GetValue(k))
+
+	// same version is ignored
+	c.UpdateOverrides(1, dynamicconfig.ConfigValueMap{})
+	assert.Equal(t, 10, c.GetValue(k)[0].Value)
+
+	// base changes are still visible
+	base.OverrideValue(k, []dynamicconfig.ConstrainedValue{{Value: 3}})
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{
+		{Constraints: nsConstraints, Value: 10},
+		{Value: 3},
+	}, c.GetValue(k))
+
+	// removing the override falls back to base
+	c.UpdateOverrides(2, dynamicconfig.ConfigValueMap{})
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 3}}, c.GetValue(k))
+}
+
+func TestPersistedClientSubscriptions(t *testing.T) {
+	base := dynamicconfig.NewMemoryClient()
+	c := dynamicconfig.NewPersistedClient(base, log.NewNoopLogger())
+	k := dynamicconfig.MakeKey("key")
+
+	var updates []map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue
+	c.Subscribe(func(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+		updates = append(updates, changed)
+	})
+
+	base.OverrideValue(k, 1)
+	require.Len(t, updates, 1)
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 1}}, updates[0][k])
+
+	c.UpdateOverrides(1, dynamicconfig.ConfigValueMap{k: {{Value: 10}}})
+	require.Len(t, updates, 2)
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 10}}, updates[1][k])
+
+	// base change is shadowed by the override
+	base.OverrideValue(k, 2)
+	require.Len(t, updates, 3)
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 10}}, updates[2][k])
+
+	// no change, no notification
+	c.UpdateOverrides(2, dynamicconfig.ConfigValueMap{k: {{Value: 10}}})
+	require.Len(t, updates, 3)
+
+	c.UpdateOverrides(3, dynamicconfig.ConfigValueMap{})
+	require.Len(t, updates, 4)
+	assert.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 2}}, updates[3][k])
+}
+
+func TestParseOverride(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	nsSetting := dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyFilteredByNamespaceKey, 0, "")
+	tqSetting := dynamicconfig.NewTaskQueueIntSetting(testGetIntPropertyFilteredByTaskQueueInfoKey, 0, "")
+
+	cv, err := dynamicconfig.ParseOverride(nsSetting.Key(), dynamicconfig.Constraints{Namespace: "ns"}, "100")
+	require.NoError(t, err)
+	assert.Equal(t, dynamicconfig.ConstrainedValue{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 100}, cv)
+
+	tqConstraints := dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "tq", TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY}
+	cv, err = dynamicconfig.ParseOverride(tqSetting.Key(), tqConstraints, "8")
+	require.NoError(t, err)
+	assert.Equal(t, tqConstraints, cv.Constraints)
+
+	_, err = dynamicconfig.ParseOverride(dynamicconfig.MakeKey(unknownKey), dynamicconfig.Constraints{}, "1")
+	assert.Error(t, err)
+
+	_, err = dynamicconfig.ParseOverride(nsSetting.Key(), dynamicconfig.Constraints{}, "not a number")
+	assert.Error(t, err)
+
+	_, err = dynamicconfig.ParseOverride(nsSetting.Key(), dynamicconfig.Constraints{}, "")
+	assert.Error(t, err)
+
+	// namespace setting can't be constrained by task queue
+	_, err = dynamicconfig.ParseOverride(nsSetting.Key(), dynamicconfig.Constraints{TaskQueueName: "tq"}, "100")
+	assert.Error(t, err)
+}
@@ -37,6 +37,7 @@ type (
 
 		// for internal use:
 		dispatchUpdate(*Collection, any, []ConstrainedValue)
+		explain(*Collection, Constraints) Explanation
 	}
 
 	// GenericParseHook is an interface that may be implemented by a setting type or a field
@@ -22,6 +22,72 @@ const (
 	PrecedenceChasmTaskType
 )
 
+// precedenceGlobal returns the search order for a setting with PrecedenceGlobal given
+// the constraints of a lookup. Constraints that don't apply to this precedence are ignored.
+func precedenceGlobal(cs Constraints) []Constraints {
+	return []Constraints{{}}
+}
+
+// precedenceNamespace returns the search order for a setting with PrecedenceNamespace given
+// the constraints of a lookup. Constraints that don't apply to this precedence are ignored.
+func precedenceNamespace(cs Constraints) []Constraints {
+	namespace := cs.Namespace
+	return []Constraints{{Namespace: namespace}, {}}
+}
+
+// precedenceNamespaceID returns the search order for a setting with PrecedenceNamespaceID given
+// the constraints of a lookup. Constraints that don't apply to this precedence are ignored.
+func precedenceNamespaceID(cs Constraints) []Constraints {
+	namespaceID := namespace.ID(cs.NamespaceID)
+	return []Constraints{{NamespaceID: namespaceID.String()}, {}}
+}
+
+// precedenceTaskQueue returns the search order for a setting with PrecedenceTaskQueue given
+// the constraints of a lookup. Constraints that don't apply to this precedence are ignored.
+func precedenceTaskQueue(cs Constraints) []Constraints {
+	namespace, taskQueue, taskQueueType := cs.Namespace, cs.TaskQueueName, cs.TaskQueueType
+	return []Constraints{
+			{Namespace: namespace, TaskQueueName: taskQueue, TaskQueueType: taskQueueType},
+			{Namespace: namespace, TaskQueueName: taskQueue},
+			{TaskQueueName: taskQueue},
+			{Namespace: namespace},
+			{},
+		}
+}
+
+// precedenceShardID returns the search order for a setting with PrecedenceShardID given
+// the constraints of a lookup. Constraints that don't apply to this precedence are ignored.
+func precedenceShardID(cs Constraints) []Constraints {
+	shardID := cs.ShardID
+	return []Constraints{{ShardID: shardID}, {}}
+}
+
+// precedenceTaskType returns the search order for a setting with PrecedenceTaskType given
+// the constraints of a lookup. Constraints that don't apply to this precedence are ignored.
+func precedenceTaskType(cs Constraints) []Constraints {
+	taskType := cs.TaskType
+	return []Constraints{{TaskType: taskType}, {}}
+}
+
+// precedenceDestination returns the search order for a setting with PrecedenceDestination given
+// the constraints of a lookup. Constraints that don't apply to this precedence are ignored.
+func precedenceDestination(cs Constraints) []Constraints {
+	namespace, destination := cs.Namespace, cs.Destination
+	return []Constraints{
+			{Namespace: namespace, Destination: destination},
+			{Destination: destination},
+			{Namespace: namespace},
+			{},
+		}
+}
+
+// precedenceChasmTaskType returns the search order for a setting with PrecedenceChasmTaskType given
+// the constraints of a lookup. Constraints that don't apply to this precedence are ignored.
+func precedenceChasmTaskType(cs Constraints) []Constraints {
+	chasmTaskType := cs.ChasmTaskType
+	return []Constraints{{ChasmTaskType: chasmTaskType}, {}}
+}
+
 type GlobalBoolSetting = GlobalTypedSetting[bool]
 type GlobalBoolConstrainedDefaultSetting = GlobalTypedConstrainedDefaultSetting[bool]
 
@@ -898,6 +964,14 @@ func (s GlobalTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s GlobalTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSetting(c, s.key, s.def, s.convert, precedenceGlobal(cs))
+}
+
+func (s GlobalTypedConstrainedDefaultSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSettingWithConstrainedDefault(c, s.key, s.cdef, s.convert, precedenceGlobal(cs))
+}
+
 func (s GlobalTypedSetting[T]) WithDefault(v T) GlobalTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -1034,6 +1108,14 @@ func (s NamespaceTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s NamespaceTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSetting(c, s.key, s.def, s.convert, precedenceNamespace(cs))
+}
+
+func (s NamespaceTypedConstrainedDefaultSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSettingWithConstrainedDefault(c, s.key, s.cdef, s.convert, precedenceNamespace(cs))
+}
+
 func (s NamespaceTypedSetting[T]) WithDefault(v T) NamespaceTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -1170,6 +1252,14 @@ func (s NamespaceIDTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s NamespaceIDTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSetting(c, s.key, s.def, s.convert, precedenceNamespaceID(cs))
+}
+
+func (s NamespaceIDTypedConstrainedDefaultSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSettingWithConstrainedDefault(c, s.key, s.cdef, s.convert, precedenceNamespaceID(cs))
+}
+
 func (s NamespaceIDTypedSetting[T]) WithDefault(v T) NamespaceIDTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -1306,6 +1396,14 @@ func (s TaskQueueTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s TaskQueueTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSetting(c, s.key, s.def, s.convert, precedenceTaskQueue(cs))
+}
+
+func (s TaskQueueTypedConstrainedDefaultSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSettingWithConstrainedDefault(c, s.key, s.cdef, s.convert, precedenceTaskQueue(cs))
+}
+
 func (s TaskQueueTypedSetting[T]) WithDefault(v T) TaskQueueTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -1466,6 +1564,14 @@ func (s ShardIDTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s ShardIDTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSetting(c, s.key, s.def, s.convert, precedenceShardID(cs))
+}
+
+func (s ShardIDTypedConstrainedDefaultSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSettingWithConstrainedDefault(c, s.key, s.cdef, s.convert, precedenceShardID(cs))
+}
+
 func (s ShardIDTypedSetting[T]) WithDefault(v T) ShardIDTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -1602,6 +1708,14 @@ func (s TaskTypeTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s TaskTypeTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSetting(c, s.key, s.def, s.convert, precedenceTaskType(cs))
+}
+
+func (s TaskTypeTypedConstrainedDefaultSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSettingWithConstrainedDefault(c, s.key, s.cdef, s.convert, precedenceTaskType(cs))
+}
+
 func (s TaskTypeTypedSetting[T]) WithDefault(v T) TaskTypeTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -1738,6 +1852,14 @@ func (s DestinationTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s DestinationTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSetting(c, s.key, s.def, s.convert, precedenceDestination(cs))
+}
+
+func (s DestinationTypedConstrainedDefaultSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSettingWithConstrainedDefault(c, s.key, s.cdef, s.convert, precedenceDestination(cs))
+}
+
 func (s DestinationTypedSetting[T]) WithDefault(v T) DestinationTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -1894,6 +2016,14 @@ func (s ChasmTaskTypeTypedConstrainedDefaultSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s ChasmTaskTypeTypedSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSetting(c, s.key, s.def, s.convert, precedenceChasmTaskType(cs))
+}
+
+func (s ChasmTaskTypeTypedConstrainedDefaultSetting[T]) explain(c *Collection, cs Constraints) Explanation {
+	return explainSettingWithConstrainedDefault(c, s.key, s.cdef, s.convert, precedenceChasmTaskType(cs))
+}
+
 func (s ChasmTaskTypeTypedSetting[T]) WithDefault(v T) ChasmTaskTypeTypedSetting[T] {
 	newS := s
 	newS.def = v
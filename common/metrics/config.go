@@ -10,6 +10,7 @@ import (
 	prom "github.com/prometheus/client_golang/prometheus"
 	"github.com/uber-go/tally/v4"
 	"github.com/uber-go/tally/v4/prometheus"
+	"go.temporal.io/server/common/auth"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	statsdreporter "go.temporal.io/server/common/metrics/tally/statsd"
@@ -24,6 +25,9 @@ type (
 		Statsd *StatsdConfig `yaml:"statsd"`
 		// Prometheus is the configuration for prometheus reporter
 		Prometheus *PrometheusConfig `yaml:"prometheus"`
+		// OTLP is the configuration for pushing metrics to an OTLP receiver.
+		// If set, it takes precedence over Statsd and Prometheus.
+		OTLP *OTLPConfig `yaml:"otlp"`
 	}
 
 	ClientConfig struct {
@@ -147,6 +151,38 @@ type (
 		// are emitted.
 		SanitizeOptions *SanitizeOptions `yaml:"sanitizeOptions"`
 	}
+
+	// OTLPConfig is the config for pushing metrics to an OTLP receiver. Metrics are always
+	// emitted through the opentelemetry framework. ClientConfig.Prefix and the unit and counter
+	// suffix options don't apply, since OTLP carries units and instrument kinds natively.
+	OTLPConfig struct {
+		// Protocol is the OTLP transport: "grpc" (default) or "http".
+		Protocol string `yaml:"protocol"`
+		// Endpoint is the host:port of the OTLP receiver.
+		Endpoint string `yaml:"endpoint" validate:"nonzero"`
+		// URLPath is the path metrics are posted to when using http. Default is "/v1/metrics".
+		URLPath string `yaml:"urlPath"`
+		// Headers are sent with every export, e.g. for authentication.
+		Headers map[string]string `yaml:"headers"`
+		// Compression is "none" (default) or "gzip".
+		Compression string `yaml:"compression"`
+		// Temporality is "cumulative" (default) or "delta". With delta, counters and histograms
+		// are reported as the change since the last export. Up-down counters and gauges are
+		// always cumulative.
+		Temporality string `yaml:"temporality"`
+		// ExportInterval is how often metrics are collected and pushed as one batch.
+		// Default is 1 minute.
+		ExportInterval time.Duration `yaml:"exportInterval"`
+		// ExportTimeout bounds each push, including retries. Default is 30 seconds.
+		ExportTimeout time.Duration `yaml:"exportTimeout"`
+		// Insecure disables transport security. TLS is ignored if set.
+		Insecure bool `yaml:"insecure"`
+		// TLS configures transport security. If not enabled, the system roots are used.
+		TLS auth.TLS `yaml:"tls"`
+		// ResourceAttributes are attached to the OTLP resource of every export, e.g.
+		// service.name.
+		ResourceAttributes map[string]string `yaml:"resourceAttributes"`
+	}
 )
 
 // Deprecated. HistogramObjective is a Prometheus histogram bucket.
@@ -187,6 +223,18 @@ const (
 	FrameworkOpentelemetry = "opentelemetry"
 )
 
+// Supported OTLP config values
+const (
+	OTLPProtocolGRPC = "grpc"
+	OTLPProtocolHTTP = "http"
+
+	OTLPCompressionNone = "none"
+	OTLPCompressionGzip = "gzip"
+
+	OTLPTemporalityCumulative = "cumulative"
+	OTLPTemporalityDelta      = "delta"
+)
+
 // Valid unit name for PerUnitHistogramBoundaries config field
 const (
 	UnitNameDimensionless = "dimensionless"
@@ -467,6 +515,15 @@ func MetricsHandlerFromConfig(logger log.Logger, c *Config) (Handler, error) {
 	setDefaultPerUnitHistogramBoundaries(&c.ClientConfig)
 
 	fatalOnListenerError := true
+	if c.OTLP != nil {
+		// create opentelemetry provider with just otlp
+		otelProvider, err := NewOpenTelemetryProviderWithOTLP(logger, c.OTLP, &c.ClientConfig)
+		if err != nil {
+			logger.Fatal(err.Error())
+		}
+		return NewOtelMetricsHandler(logger, otelProvider, c.ClientConfig, c.RecordTimerInSeconds)
+	}
+
 	if c.Statsd != nil && c.Statsd.Framework == FrameworkOpentelemetry {
 		// create opentelemetry provider with just statsd
 		otelProvider, err := NewOpenTelemetryProviderWithStatsd(logger, c.Statsd, &c.ClientConfig)
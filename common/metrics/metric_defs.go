@@ -34,6 +34,8 @@ const (
 	ArchetypeTagName               = "archetype"
 	ChasmTaskTypeTagName           = "chasm_task_type"
 	timeoutTypeTagName             = "timeout_type"
+	CompressionTypeTagName         = "compression_type"
+	SQLTargetTagName               = "sql_target"
 )
 
 // This package should hold all the metrics and tags for temporal
@@ -65,6 +67,8 @@ const (
 const (
 	// AdminClientStreamWorkflowReplicationMessagesScope tracks RPC calls to admin service
 	AdminClientStreamWorkflowReplicationMessagesScope = "AdminClientStreamWorkflowReplicationMessages"
+	// AdminClientWatchWorkflowExecutionsScope tracks RPC calls to admin service
+	AdminClientWatchWorkflowExecutionsScope = "AdminClientWatchWorkflowExecutions"
 )
 
 // History Client Operations
@@ -1263,6 +1267,26 @@ var (
 		"tasks_dropped",
 		WithDescription("Backlog/spooled tasks dropped by matching (e.g. a Record(Workflow|Activity)TaskStarted call to history failed, the task expired, or it failed validation). Sync-match tasks are excluded. Per-task-queue, tagged with `reason` identifying the failure mode."),
 	)
+	ConstrainedBacklogTasksGauge = NewGaugeDef(
+		"constrained_backlog_tasks",
+		WithDescription("Number of backlog tasks with placement constraints loaded in the matcher of a task queue partition, tagged with the keys of their constraints in `placement_constraint_keys`. A growing value means no poller with matching worker labels is polling."),
+	)
+	TaskDispatchDeadlineSlackPerTaskQueue = NewTimerDef(
+		"task_dispatch_deadline_slack",
+		WithDescription("Time left until the schedule-to-start deadline of a task when it is dispatched to a poller. Tagged with `deadline_ordering`, which is true if the task was ordered by its deadline (see matching.enableDeadlineOrdering)."),
+	)
+	TaskDLQWritesCounter = NewCounterDef(
+		"task_dlq_writes",
+		WithDescription("Dropped backlog tasks written to the DLQ of their task queue, tagged with the `reason` they were dropped. The DLQ can be inspected using tdbg taskqueue list-dlq-tasks."),
+	)
+	TaskDLQWriteFailuresCounter = NewCounterDef(
+		"task_dlq_write_failures",
+		WithDescription("Dropped backlog tasks that could not be written to the DLQ of their task queue, because the write failed or too many writes were pending."),
+	)
+	TaskDLQRetentionDeletesCounter = NewCounterDef(
+		"task_dlq_retention_deletes",
+		WithDescription("Tasks deleted from the DLQ of their task queue because they were older than matching.taskDLQRetention."),
+	)
 	TaskCompletedMissing = NewCounterDef(
 		"task_completed_dropped",
 		WithDescription("Count of tasks that were completed after being dropped from the matcher"),
@@ -1364,6 +1388,7 @@ var (
 	HistoryArchiverDuplicateArchivalsCount        = NewCounterDef("history_archiver_duplicate_archivals")
 	HistoryArchiverBlobExistsCount                = NewCounterDef("history_archiver_blob_exists")
 	HistoryArchiverBlobSize                       = NewBytesHistogramDef("history_archiver_blob_size")
+	HistoryArchiverCorruptedHistoryCount          = NewCounterDef("history_archiver_corrupted_history")
 	HistoryWorkflowExecutionCacheLatency          = NewTimerDef("history_workflow_execution_cache_latency")
 	HistoryWorkflowExecutionCacheLockHoldDuration = NewTimerDef("history_workflow_execution_cache_lock_hold_duration")
 
@@ -1414,6 +1439,30 @@ var (
 		"delete_executions_not_found",
 		WithDescription("The number of workflow executions that wasn't found by DeleteExecutions workflow"),
 	)
+	VisibilityDriftComparedCount = NewCounterDef(
+		"visibility_drift_compared",
+		WithDescription("The number of executions compared between the primary and secondary visibility stores by the visibility drift workflow"),
+	)
+	VisibilityDriftMissingCount = NewCounterDef(
+		"visibility_drift_missing",
+		WithDescription("The number of executions found by the visibility drift workflow that are missing from the secondary visibility store"),
+	)
+	VisibilityDriftMismatchCount = NewCounterDef(
+		"visibility_drift_mismatch",
+		WithDescription("The number of fields found by the visibility drift workflow that differ between the primary and secondary visibility stores"),
+	)
+	VisibilityDriftRepairedCount = NewCounterDef(
+		"visibility_drift_repaired",
+		WithDescription("The number of executions for which the visibility drift workflow re-emitted visibility tasks"),
+	)
+	VisibilityRebuildCount = NewCounterDef(
+		"visibility_rebuild",
+		WithDescription("The number of executions whose visibility record was rebuilt from mutable state by the visibility rebuild workflow"),
+	)
+	VisibilityRebuildSkippedCount = NewCounterDef(
+		"visibility_rebuild_skipped",
+		WithDescription("The number of executions skipped by the visibility rebuild workflow because they were deleted or aren't workflows"),
+	)
 
 	// Batcher metrics.
 	BatcherProcessorSuccess = NewCounterDef(
@@ -1617,6 +1666,26 @@ var (
 	PersistenceSQLOpenConn                 = NewGaugeDef("persistence_sql_open_conn")
 	PersistenceSQLIdleConn                 = NewGaugeDef("persistence_sql_idle_conn")
 	PersistenceSQLInUse                    = NewGaugeDef("persistence_sql_in_use")
+	PersistenceSQLReadRequests             = NewCounterDef(
+		"persistence_sql_read_requests",
+		WithDescription("Read-only SQL queries that may be served by a read replica, keyed by `sql_target` (primary or replica)."),
+	)
+	PersistenceSQLReplicaFallbacks = NewCounterDef(
+		"persistence_sql_replica_fallbacks",
+		WithDescription("Read-only SQL queries sent to the primary because no read replica could serve them, keyed by `reason`."),
+	)
+	PersistenceSQLReplicaLag = NewGaugeDef(
+		"persistence_sql_replica_lag_seconds",
+		WithDescription("Replication lag of a SQL read replica, keyed by `sql_target` (the replica address)."),
+	)
+	PersistenceBlobUncompressedBytes = NewCounterDef(
+		"persistence_blob_uncompressed_bytes",
+		WithDescription("Size of persisted blobs before compression, keyed by `compression_type`. Divide persistence_blob_compressed_bytes by this metric to get the compression ratio."),
+	)
+	PersistenceBlobCompressedBytes = NewCounterDef(
+		"persistence_blob_compressed_bytes",
+		WithDescription("Size of persisted blobs after compression, keyed by `compression_type`."),
+	)
 
 	// Common service base metrics
 	RestartCount            = NewCounterDef("restarts")
@@ -28,6 +28,7 @@ type (
 		config         *PrometheusConfig
 		server         *http.Server
 		statsdExporter *statsdExporter
+		otlpReader     sdkmetrics.Reader
 	}
 )
 
@@ -83,6 +84,40 @@ func NewOpenTelemetryProviderWithPrometheus(
 	return newOpenTelemetryProvider(logger, exporter, nil, nil, prometheusConfig, metricServer, clientConfig)
 }
 
+// NewOpenTelemetryProviderWithOTLP creates a new OpenTelemetry provider that periodically pushes
+// metrics to an OTLP receiver.
+func NewOpenTelemetryProviderWithOTLP(
+	logger log.Logger,
+	otlpConfig *OTLPConfig,
+	clientConfig *ClientConfig,
+) (*openTelemetryProviderImpl, error) {
+	if otlpConfig == nil {
+		return nil, errors.New("otlp config is required to provide otlp metrics")
+	}
+	exporter, err := newOTLPExporter(context.Background(), otlpConfig)
+	if err != nil {
+		logger.Error("Failed to initialize otlp exporter.", tag.Error(err))
+		return nil, err
+	}
+	var readerOpts []sdkmetrics.PeriodicReaderOption
+	if otlpConfig.ExportInterval > 0 {
+		readerOpts = append(readerOpts, sdkmetrics.WithInterval(otlpConfig.ExportInterval))
+	}
+	if otlpConfig.ExportTimeout > 0 {
+		readerOpts = append(readerOpts, sdkmetrics.WithTimeout(otlpConfig.ExportTimeout))
+	}
+	reader := sdkmetrics.NewPeriodicReader(exporter, readerOpts...)
+	provider, err := newOpenTelemetryProvider(
+		logger, reader, nil, nil, nil, nil, clientConfig,
+		sdkmetrics.WithResource(newOTLPResource(otlpConfig)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider.otlpReader = reader
+	return provider, nil
+}
+
 func newOpenTelemetryProvider(
 	logger log.Logger,
 	reader sdkmetrics.Reader,
@@ -91,6 +126,7 @@ func newOpenTelemetryProvider(
 	prometheusConfig *PrometheusConfig,
 	prometheusServer *http.Server,
 	clientConfig *ClientConfig,
+	opts ...sdkmetrics.Option,
 ) (*openTelemetryProviderImpl, error) {
 	var views []sdkmetrics.View
 	for _, u := range []string{Dimensionless, Bytes, Milliseconds, Seconds} {
@@ -106,10 +142,10 @@ func newOpenTelemetryProvider(
 			},
 		))
 	}
-	provider := sdkmetrics.NewMeterProvider(
+	provider := sdkmetrics.NewMeterProvider(append([]sdkmetrics.Option{
 		sdkmetrics.WithReader(reader),
 		sdkmetrics.WithView(views...),
-	)
+	}, opts...)...)
 	meter := provider.Meter("temporal")
 	reporter := &openTelemetryProviderImpl{
 		meter:          meter,
@@ -181,4 +217,14 @@ func (r *openTelemetryProviderImpl) Stop(logger log.Logger) {
 			logger.Error("StatsD exporter shutdown failure.", tag.Error(err))
 		}
 	}
+
+	// Shutdown OTLP reader if it exists. This pushes any pending metrics before closing the
+	// exporter.
+	if r.otlpReader != nil {
+		ctx, closeCtx := context.WithTimeout(context.Background(), 5*time.Second)
+		defer closeCtx()
+		if err := r.otlpReader.Shutdown(ctx); err != nil {
+			logger.Error("OTLP metrics reader shutdown failure.", tag.Error(err))
+		}
+	}
 }
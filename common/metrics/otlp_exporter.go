@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	sdkmetrics "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.temporal.io/server/common/auth"
+	"google.golang.org/grpc/credentials"
+)
+
+// newOTLPExporter creates an unstarted OTLP metric exporter for config. The connection to the
+// receiver is established lazily on the first export.
+func newOTLPExporter(ctx context.Context, config *OTLPConfig) (sdkmetrics.Exporter, error) {
+	if config.Endpoint == "" {
+		return nil, errors.New("otlp endpoint must be specified")
+	}
+	temporality, err := otlpTemporalitySelector(config.Temporality)
+	if err != nil {
+		return nil, err
+	}
+	switch config.Compression {
+	case "", OTLPCompressionNone, OTLPCompressionGzip:
+	default:
+		return nil, fmt.Errorf("unsupported otlp compression %q", config.Compression)
+	}
+	tlsConfig, err := auth.NewTLSConfig(&config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("invalid otlp tls config: %w", err)
+	}
+
+	switch config.Protocol {
+	case "", OTLPProtocolGRPC:
+		opts := []otlpmetricgrpc.Option{
+			otlpmetricgrpc.WithEndpoint(config.Endpoint),
+			otlpmetricgrpc.WithHeaders(config.Headers),
+			otlpmetricgrpc.WithTemporalitySelector(temporality),
+		}
+		if config.ExportTimeout > 0 {
+			opts = append(opts, otlpmetricgrpc.WithTimeout(config.ExportTimeout))
+		}
+		if config.Compression == OTLPCompressionGzip {
+			opts = append(opts, otlpmetricgrpc.WithCompressor(OTLPCompressionGzip))
+		}
+		if config.Insecure {
+			opts = append(opts, otlpmetricgrpc.WithInsecure())
+		} else if tlsConfig != nil {
+			opts = append(opts, otlpmetricgrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+		}
+		return otlpmetricgrpc.New(ctx, opts...)
+	case OTLPProtocolHTTP:
+		opts := []otlpmetrichttp.Option{
+			otlpmetrichttp.WithEndpoint(config.Endpoint),
+			otlpmetrichttp.WithHeaders(config.Headers),
+			otlpmetrichttp.WithTemporalitySelector(temporality),
+		}
+		if config.URLPath != "" {
+			opts = append(opts, otlpmetrichttp.WithURLPath(config.URLPath))
+		}
+		if config.ExportTimeout > 0 {
+			opts = append(opts, otlpmetrichttp.WithTimeout(config.ExportTimeout))
+		}
+		if config.Compression == OTLPCompressionGzip {
+			opts = append(opts, otlpmetrichttp.WithCompression(otlpmetrichttp.GzipCompression))
+		}
+		if config.Insecure {
+			opts = append(opts, otlpmetrichttp.WithInsecure())
+		} else if tlsConfig != nil {
+			opts = append(opts, otlpmetrichttp.WithTLSClientConfig(tlsConfig))
+		}
+		return otlpmetrichttp.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unsupported otlp protocol %q", config.Protocol)
+	}
+}
+
+func otlpTemporalitySelector(temporality string) (sdkmetrics.TemporalitySelector, error) {
+	switch temporality {
+	case "", OTLPTemporalityCumulative:
+		return sdkmetrics.DefaultTemporalitySelector, nil
+	case OTLPTemporalityDelta:
+		return deltaTemporalitySelector, nil
+	default:
+		return nil, fmt.Errorf("unsupported otlp temporality %q", temporality)
+	}
+}
+
+// deltaTemporalitySelector uses delta temporality for monotonic instruments. Up-down counters
+// and gauges are cumulative since a delta of those isn't meaningful to most receivers.
+func deltaTemporalitySelector(kind sdkmetrics.InstrumentKind) metricdata.Temporality {
+	switch kind {
+	case sdkmetrics.InstrumentKindCounter,
+		sdkmetrics.InstrumentKindObservableCounter,
+		sdkmetrics.InstrumentKindHistogram:
+		return metricdata.DeltaTemporality
+	default:
+		return metricdata.CumulativeTemporality
+	}
+}
+
+func newOTLPResource(config *OTLPConfig) *resource.Resource {
+	attrs := make([]attribute.KeyValue, 0, len(config.ResourceAttributes))
+	for _, k := range slices.Sorted(maps.Keys(config.ResourceAttributes)) {
+		attrs = append(attrs, attribute.String(k, config.ResourceAttributes[k]))
+	}
+	// Merge only fails on conflicting schema URLs, and the second resource has none.
+	res, _ := resource.Merge(resource.Default(), resource.NewSchemaless(attrs...))
+	return res
+}
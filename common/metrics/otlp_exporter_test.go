@@ -0,0 +1,218 @@
+package metrics
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectormetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// otlpReceiver is an in-process OTLP metrics receiver that records everything it's sent.
+type otlpReceiver struct {
+	collectormetricspb.UnimplementedMetricsServiceServer
+
+	lock     sync.Mutex
+	requests []*collectormetricspb.ExportMetricsServiceRequest
+	headers  []map[string]string
+}
+
+func (r *otlpReceiver) Export(
+	ctx context.Context,
+	request *collectormetricspb.ExportMetricsServiceRequest,
+) (*collectormetricspb.ExportMetricsServiceResponse, error) {
+	headers := make(map[string]string)
+	md, _ := metadata.FromIncomingContext(ctx)
+	for k, v := range md {
+		headers[k] = strings.Join(v, ",")
+	}
+	r.record(request, headers)
+	return &collectormetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+func (r *otlpReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body := io.Reader(req.Body)
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body = gz
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	request := &collectormetricspb.ExportMetricsServiceRequest{}
+	if err := proto.Unmarshal(data, request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	headers := map[string]string{
+		":path":            req.URL.Path,
+		"content-encoding": req.Header.Get("Content-Encoding"),
+	}
+	for k := range req.Header {
+		headers[strings.ToLower(k)] = req.Header.Get(k)
+	}
+	r.record(request, headers)
+
+	resp, _ := proto.Marshal(&collectormetricspb.ExportMetricsServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(resp)
+}
+
+func (r *otlpReceiver) record(request *collectormetricspb.ExportMetricsServiceRequest, headers map[string]string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.requests = append(r.requests, request)
+	r.headers = append(r.headers, headers)
+}
+
+// findMetric returns the headers of the first request containing a metric with the given name,
+// along with the metric and the resource it was sent with.
+func (r *otlpReceiver) findMetric(name string) (map[string]string, *metricspb.ResourceMetrics, *metricspb.Metric) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for i, request := range r.requests {
+		for _, rm := range request.GetResourceMetrics() {
+			for _, sm := range rm.GetScopeMetrics() {
+				for _, m := range sm.GetMetrics() {
+					if m.GetName() == name {
+						return r.headers[i], rm, m
+					}
+				}
+			}
+		}
+	}
+	return nil, nil, nil
+}
+
+func resourceAttribute(rm *metricspb.ResourceMetrics, key string) string {
+	for _, kv := range rm.GetResource().GetAttributes() {
+		if kv.GetKey() == key {
+			return kv.GetValue().GetStringValue()
+		}
+	}
+	return ""
+}
+
+func TestOTLPExporter_GRPC(t *testing.T) {
+	receiver := &otlpReceiver{}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	collectormetricspb.RegisterMetricsServiceServer(server, receiver)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	logger := log.NewTestLogger()
+	handler, err := MetricsHandlerFromConfig(logger, &Config{
+		ClientConfig: ClientConfig{Tags: map[string]string{"cluster": "test"}},
+		OTLP: &OTLPConfig{
+			Endpoint:           listener.Addr().String(),
+			Insecure:           true,
+			Headers:            map[string]string{"x-api-key": "secret"},
+			Compression:        OTLPCompressionGzip,
+			Temporality:        OTLPTemporalityDelta,
+			ExportInterval:     50 * time.Millisecond,
+			ResourceAttributes: map[string]string{"service.name": "temporal-test"},
+		},
+	})
+	require.NoError(t, err)
+	require.IsType(t, &otelMetricsHandler{}, handler)
+
+	handler.Counter("otlp_grpc_counter").Record(5)
+
+	var (
+		headers  map[string]string
+		resource *metricspb.ResourceMetrics
+		metric   *metricspb.Metric
+	)
+	require.Eventually(t, func() bool {
+		headers, resource, metric = receiver.findMetric("otlp_grpc_counter")
+		return metric != nil
+	}, 5*time.Second, 50*time.Millisecond)
+	handler.Stop(logger)
+
+	assert.Equal(t, "secret", headers["x-api-key"])
+	assert.Equal(t, "temporal-test", resourceAttribute(resource, "service.name"))
+	sum := metric.GetSum()
+	require.NotNil(t, sum)
+	assert.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA, sum.GetAggregationTemporality())
+	require.Len(t, sum.GetDataPoints(), 1)
+	assert.Equal(t, int64(5), sum.GetDataPoints()[0].GetAsInt())
+	assert.Equal(t, "cluster", sum.GetDataPoints()[0].GetAttributes()[0].GetKey())
+}
+
+func TestOTLPExporter_HTTP(t *testing.T) {
+	receiver := &otlpReceiver{}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	logger := log.NewTestLogger()
+	provider, err := NewOpenTelemetryProviderWithOTLP(logger, &OTLPConfig{
+		Protocol:    OTLPProtocolHTTP,
+		Endpoint:    strings.TrimPrefix(server.URL, "http://"),
+		URLPath:     "/custom/metrics",
+		Insecure:    true,
+		Headers:     map[string]string{"x-api-key": "secret"},
+		Compression: OTLPCompressionGzip,
+		// Long enough that only the flush on Stop exports.
+		ExportInterval: time.Hour,
+	}, &ClientConfig{})
+	require.NoError(t, err)
+
+	histogram, err := provider.GetMeter().Int64Histogram("otlp_http_histogram")
+	require.NoError(t, err)
+	histogram.Record(context.Background(), 3)
+	histogram.Record(context.Background(), 7)
+	provider.Stop(logger)
+
+	headers, _, metric := receiver.findMetric("otlp_http_histogram")
+	require.NotNil(t, metric)
+	assert.Equal(t, "/custom/metrics", headers[":path"])
+	assert.Equal(t, "gzip", headers["content-encoding"])
+	assert.Equal(t, "secret", headers["x-api-key"])
+	require.NotNil(t, metric.GetHistogram())
+	assert.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, metric.GetHistogram().GetAggregationTemporality())
+	require.Len(t, metric.GetHistogram().GetDataPoints(), 1)
+	assert.Equal(t, uint64(2), metric.GetHistogram().GetDataPoints()[0].GetCount())
+	assert.Equal(t, float64(10), metric.GetHistogram().GetDataPoints()[0].GetSum())
+}
+
+func TestOTLPExporter_InvalidConfig(t *testing.T) {
+	for _, c := range []struct {
+		name   string
+		config *OTLPConfig
+	}{
+		{name: "nil config"},
+		{name: "no endpoint", config: &OTLPConfig{}},
+		{name: "protocol", config: &OTLPConfig{Endpoint: "localhost:4317", Protocol: "udp"}},
+		{name: "temporality", config: &OTLPConfig{Endpoint: "localhost:4317", Temporality: "sometimes"}},
+		{name: "compression", config: &OTLPConfig{Endpoint: "localhost:4317", Compression: "zstd"}},
+		{name: "tls", config: &OTLPConfig{Endpoint: "localhost:4317", TLS: auth.TLS{Enabled: true, CaFile: "/nonexistent/ca.pem"}}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := NewOpenTelemetryProviderWithOTLP(log.NewNoopLogger(), c.config, &ClientConfig{})
+			assert.Error(t, err)
+		})
+	}
+}
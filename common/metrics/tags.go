@@ -29,6 +29,8 @@ const (
 	taskSourceTag           = "source"
 	forwardedTag            = "forwarded"
 	pollResultTagName       = "poll_result"
+	placementConstraintKeys = "placement_constraint_keys"
+	deadlineOrdering        = "deadline_ordering"
 	fromCluster             = "from_cluster"
 	toCluster               = "to_cluster"
 	taskQueue               = "taskqueue"
@@ -322,6 +324,16 @@ func PollResultTag(result string) Tag {
 	return Tag{Key: pollResultTagName, Value: result}
 }
 
+// PlacementConstraintKeysTag tags a metric with the keys of a task's placement constraints, see
+// placement.KeyString. Constraint values are not included to bound the cardinality.
+func PlacementConstraintKeysTag(value string) Tag {
+	return Tag{Key: placementConstraintKeys, Value: value}
+}
+
+func DeadlineOrderingTag(ordered bool) Tag {
+	return Tag{Key: deadlineOrdering, Value: strconv.FormatBool(ordered)}
+}
+
 const (
 	TaskAddResultSyncMatch        = "sync_match"
 	TaskAddResultSyncMatchUnavail = "sync_match_unavailable"
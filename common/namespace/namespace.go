@@ -311,6 +311,12 @@ func (ns *Namespace) GetWorkflowRule(ruleID string) (*rulespb.WorkflowRule, bool
 	return result, ok
 }
 
+// GetVisibilityView returns the saved visibility query with the given name.
+func (ns *Namespace) GetVisibilityView(name string) (*persistencespb.VisibilityView, bool) {
+	view, ok := ns.config.VisibilityViews[name]
+	return view, ok
+}
+
 // Error returns the reason associated with this bad binary.
 func (e BadBinaryError) Error() string {
 	return e.info.Reason
@@ -168,6 +168,7 @@ func (h *taskExecutorImpl) handleNamespaceCreationReplicationTask(
 				VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
 				VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
 				CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+				VisibilityViews:              task.GetVisibilityViews(),
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
@@ -312,6 +313,7 @@ func (h *taskExecutorImpl) handleNamespaceUpdateReplicationTask(
 			VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
 			VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
 			CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+			VisibilityViews:              task.GetVisibilityViews(),
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
@@ -515,6 +515,9 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 			ClusterName: updateClusterStandby,
 		},
 	}
+	updateVisibilityViews := map[string]*persistencespb.VisibilityView{
+		"stuck-orders": {Name: "stuck-orders", Query: "WorkflowType = 'order'", Version: 1},
+	}
 	updateTask := &replicationspb.NamespaceTaskAttributes{
 		NamespaceOperation: updateOperation,
 		Id:                 id,
@@ -538,6 +541,7 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 		},
 		ConfigVersion:   updateConfigVersion,
 		FailoverVersion: updateFailoverVersion,
+		VisibilityViews: updateVisibilityViews,
 	}
 
 	s.namespaceReplicator.currentCluster = updateClusterStandby
@@ -569,6 +573,7 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 				HistoryArchivalUri:      updateTask.Config.HistoryArchivalUri,
 				VisibilityArchivalState: updateTask.Config.VisibilityArchivalState,
 				VisibilityArchivalUri:   updateTask.Config.VisibilityArchivalUri,
+				VisibilityViews:         updateVisibilityViews,
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				Clusters: []string{updateClusterActive, updateClusterStandby},
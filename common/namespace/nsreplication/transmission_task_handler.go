@@ -106,6 +106,7 @@ func (r *replicator) HandleTransmissionTask(
 			ConfigVersion:   configVersion,
 			FailoverVersion: failoverVersion,
 			FailoverHistory: convertFailoverHistoryToReplicationProto(failoverHistoy),
+			VisibilityViews: config.VisibilityViews,
 		},
 	}
 
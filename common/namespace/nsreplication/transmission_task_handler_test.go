@@ -221,6 +221,9 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 	configVersion := int64(0)
 	failoverVersion := int64(59)
 	clusters := []string{clusterActive, clusterStandby}
+	visibilityViews := map[string]*persistencespb.VisibilityView{
+		"stuck-orders": {Name: "stuck-orders", Query: "WorkflowType = 'order'", Version: 1},
+	}
 
 	namespaceOperation := enumsspb.NAMESPACE_OPERATION_UPDATE
 	info := &persistencespb.NamespaceInfo{
@@ -238,6 +241,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 		VisibilityArchivalState: visibilityArchivalState,
 		VisibilityArchivalUri:   visibilityArchivalURI,
 		BadBinaries:             &namespacepb.BadBinaries{Binaries: map[string]*namespacepb.BadBinaryInfo{}},
+		VisibilityViews:         visibilityViews,
 	}
 	replicationConfig := &persistencespb.NamespaceReplicationConfig{
 		ActiveClusterName: clusterActive,
@@ -272,6 +276,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 				},
 				ConfigVersion:   configVersion,
 				FailoverVersion: failoverVersion,
+				VisibilityViews: visibilityViews,
 			},
 		},
 	}).Return(nil)
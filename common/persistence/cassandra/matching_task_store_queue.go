@@ -105,6 +105,18 @@ const (
 		`AND task_id = ? ` +
 		`IF range_id = ?`
 
+	// templateListTaskQueueQuery scans the task queue rows of all partitions. It is only
+	// used by administrative tooling that needs to enumerate every task queue.
+	templateListTaskQueueQuery = `SELECT ` +
+		`range_id, ` +
+		`task_queue, ` +
+		`task_queue_encoding ` +
+		`FROM tasks_v2 ` +
+		`WHERE type = ? ` +
+		`AND pass = 0 ` +
+		`AND task_id = ? ` +
+		`ALLOW FILTERING`
+
 	templateDeleteTaskQueueQuery = `DELETE FROM tasks_v2 ` +
 		`WHERE namespace_id = ? ` +
 		`AND task_queue_name = ? ` +
@@ -252,10 +264,33 @@ func (d *taskQueueStore) UpdateTaskQueue(
 }
 
 func (d *taskQueueStore) ListTaskQueue(
-	_ context.Context,
-	_ *p.ListTaskQueueRequest,
+	ctx context.Context,
+	request *p.ListTaskQueueRequest,
 ) (*p.InternalListTaskQueueResponse, error) {
-	return nil, serviceerror.NewUnavailable("unsupported operation")
+	query := d.Session.Query(switchTasksTable(templateListTaskQueueQuery, d.version),
+		rowTypeTaskQueue,
+		taskQueueTaskID,
+	).WithContext(ctx)
+	iter := query.PageSize(request.PageSize).PageState(request.PageToken).Iter()
+
+	response := &p.InternalListTaskQueueResponse{}
+	var rangeID int64
+	var tlBytes []byte
+	var tlEncoding string
+	for iter.Scan(&rangeID, &tlBytes, &tlEncoding) {
+		response.Items = append(response.Items, &p.InternalListTaskQueueItem{
+			RangeID:   rangeID,
+			TaskQueue: p.NewDataBlob(tlBytes, tlEncoding),
+		})
+		tlBytes = nil
+	}
+	if len(iter.PageState()) > 0 {
+		response.NextPageToken = iter.PageState()
+	}
+	if err := iter.Close(); err != nil {
+		return nil, gocql.ConvertError("ListTaskQueue", err)
+	}
+	return response, nil
 }
 
 func (d *taskQueueStore) DeleteTaskQueue(
@@ -43,6 +43,8 @@ type (
 		NewClusterMetadataManager() (persistence.ClusterMetadataManager, error)
 		// NewHistoryTaskQueueManager returns a new manager for history task queues
 		NewHistoryTaskQueueManager() (persistence.HistoryTaskQueueManager, error)
+		// NewMatchingTaskDLQManager returns a new manager for the DLQs of matching tasks
+		NewMatchingTaskDLQManager() (persistence.MatchingTaskDLQManager, error)
 		// NewNexusEndpointManager returns a new manager for nexus endpoints
 		NewNexusEndpointManager() (persistence.NexusEndpointManager, error)
 	}
@@ -61,6 +63,8 @@ type (
 		healthSignals                               persistence.HealthSignalAggregator
 		enableDataLossMetrics                       dynamicconfig.BoolPropertyFn
 		enableBestEffortDeleteTasksOnWorkflowUpdate dynamicconfig.BoolPropertyFn
+		historyTiering                              *persistence.HistoryTieringConfig
+		recorder                                    *persistence.PersistenceRecorder
 	}
 )
 
@@ -85,6 +89,7 @@ func NewFactory(
 	healthSignals persistence.HealthSignalAggregator,
 	enableDataLossMetrics EnableDataLossMetrics,
 	enableBestEffortDeleteTasksOnWorkflowUpdate EnableBestEffortDeleteTasksOnWorkflowUpdate,
+	historyTiering *persistence.HistoryTieringConfig,
 ) Factory {
 	factory := &factoryImpl{
 		dataStoreFactory:      dataStoreFactory,
@@ -100,6 +105,7 @@ func NewFactory(
 		healthSignals:         healthSignals,
 		enableDataLossMetrics: dynamicconfig.BoolPropertyFn(enableDataLossMetrics),
 		enableBestEffortDeleteTasksOnWorkflowUpdate: dynamicconfig.BoolPropertyFn(enableBestEffortDeleteTasksOnWorkflowUpdate),
+		historyTiering: historyTiering,
 	}
 	factory.initDependencies()
 	return factory
@@ -112,6 +118,9 @@ func (f *factoryImpl) NewTaskManager() (persistence.TaskManager, error) {
 		return nil, err
 	}
 	result := persistence.NewTaskManager(taskStore, f.serializer)
+	if f.recorder != nil {
+		result = persistence.NewTaskPersistenceRecordingClient(result, f.recorder, persistence.RecordedTaskManager)
+	}
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewTaskPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
@@ -129,6 +138,9 @@ func (f *factoryImpl) NewFairTaskManager() (persistence.FairTaskManager, error)
 		return nil, err
 	}
 	result := persistence.NewTaskManager(taskStore, f.serializer)
+	if f.recorder != nil {
+		result = persistence.NewTaskPersistenceRecordingClient(result, f.recorder, persistence.RecordedFairTaskManager)
+	}
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewTaskPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
@@ -147,6 +159,9 @@ func (f *factoryImpl) NewShardManager() (persistence.ShardManager, error) {
 	}
 
 	result := persistence.NewShardManager(shardStore, f.serializer)
+	if f.recorder != nil {
+		result = persistence.NewShardPersistenceRecordingClient(result, f.recorder)
+	}
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewShardPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
@@ -207,7 +222,11 @@ func (f *factoryImpl) NewExecutionManager() (persistence.ExecutionManager, error
 		f.logger,
 		f.config.TransactionSizeLimit,
 		f.enableBestEffortDeleteTasksOnWorkflowUpdate,
+		f.historyTiering,
 	)
+	if f.recorder != nil {
+		result = persistence.NewExecutionPersistenceRecordingClient(result, f.recorder)
+	}
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
@@ -242,6 +261,14 @@ func (f *factoryImpl) NewHistoryTaskQueueManager() (persistence.HistoryTaskQueue
 	return persistence.NewHistoryTaskQueueManager(q, f.serializer), nil
 }
 
+func (f *factoryImpl) NewMatchingTaskDLQManager() (persistence.MatchingTaskDLQManager, error) {
+	q, err := f.dataStoreFactory.NewQueueV2()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewMatchingTaskDLQManager(q), nil
+}
+
 func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManager, error) {
 	store, err := f.dataStoreFactory.NewNexusEndpointStore()
 	if err != nil {
@@ -262,6 +289,9 @@ func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManage
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	f.dataStoreFactory.Close()
+	if f.recorder != nil {
+		f.recorder.Stop()
+	}
 	if f.healthSignals != nil {
 		f.healthSignals.Stop()
 	}
@@ -287,6 +317,11 @@ func IsNamespaceQueueTransientError(err error) bool {
 }
 
 func (f *factoryImpl) initDependencies() {
+	if f.config.RecordingDir != nil {
+		f.recorder = persistence.NewPersistenceRecorder(f.config.RecordingDir, f.logger)
+		f.recorder.Start()
+	}
+
 	if f.metricsHandler == nil && f.healthSignals == nil {
 		return
 	}
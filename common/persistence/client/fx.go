@@ -10,6 +10,7 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
@@ -58,6 +59,7 @@ type (
 		EnableDataLossMetrics                       EnableDataLossMetrics
 		EnableBestEffortDeleteTasksOnWorkflowUpdate EnableBestEffortDeleteTasksOnWorkflowUpdate
 		Serializer                                  serialization.Serializer
+		HistoryTiering                              *persistence.HistoryTieringConfig `optional:"true"`
 	}
 
 	FactoryProviderFn func(NewFactoryParams) Factory
@@ -74,6 +76,7 @@ var Module = fx.Options(
 	fx.Provide(managerProvider(Factory.NewShardManager)),
 	fx.Provide(managerProvider(Factory.NewExecutionManager)),
 	fx.Provide(managerProvider(Factory.NewHistoryTaskQueueManager)),
+	fx.Provide(managerProvider(Factory.NewMatchingTaskDLQManager)),
 	fx.Provide(managerProvider(Factory.NewNexusEndpointManager)),
 
 	fx.Provide(ClusterNameProvider),
@@ -157,6 +160,7 @@ func FactoryProvider(
 		params.HealthSignals,
 		params.EnableDataLossMetrics,
 		params.EnableBestEffortDeleteTasksOnWorkflowUpdate,
+		params.HistoryTiering,
 	)
 }
 
@@ -205,7 +209,11 @@ func DataStoreFactoryProvider(
 	}
 
 	if defaultStoreCfg.FaultInjection != nil {
-		dataStoreFactory = faultinjection.NewFaultInjectionDatastoreFactory(defaultStoreCfg.FaultInjection, dataStoreFactory)
+		faultInjectionFactory, err := faultinjection.NewFaultInjectionDatastoreFactory(defaultStoreCfg.FaultInjection, dataStoreFactory, logger)
+		if err != nil {
+			logger.Fatal("invalid fault injection config", tag.Error(err))
+		}
+		dataStoreFactory = faultInjectionFactory
 	}
 
 	tracer := tracerProvider.Tracer(otel.ComponentPersistence)
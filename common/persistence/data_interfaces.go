@@ -773,6 +773,8 @@ type (
 	AppendHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace the history belongs to, selects the data key when blob encryption is enabled
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -797,6 +799,8 @@ type (
 	AppendRawHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace the history belongs to, selects the data key when blob encryption is enabled
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -1353,6 +1357,82 @@ type (
 		Queues        []QueueInfo
 		NextPageToken []byte
 	}
+
+	// MatchingTaskDLQManager manages the DLQs of matching backlog tasks that expired or could not be delivered. There
+	// is one DLQ per task queue name and type.
+	MatchingTaskDLQManager interface {
+		Closeable
+		// CreateQueue must return an ErrQueueAlreadyExists if the queue already exists.
+		CreateQueue(ctx context.Context, request *CreateMatchingDLQRequest) (*CreateMatchingDLQResponse, error)
+		EnqueueTask(ctx context.Context, request *EnqueueMatchingDLQTaskRequest) (*EnqueueMatchingDLQTaskResponse, error)
+		ReadTasks(ctx context.Context, request *ReadMatchingDLQTasksRequest) (*ReadMatchingDLQTasksResponse, error)
+		DeleteTasks(ctx context.Context, request *DeleteMatchingDLQTasksRequest) (*DeleteMatchingDLQTasksResponse, error)
+		// ListQueues lists the DLQs, including empty ones. The keys are parsed from the queue names, see
+		// ParseMatchingDLQName.
+		ListQueues(ctx context.Context, request *ListMatchingDLQsRequest) (*ListMatchingDLQsResponse, error)
+	}
+
+	MatchingTaskDLQManagerImpl struct {
+		queue QueueV2
+	}
+
+	// MatchingDLQKey identifies the DLQ of a task queue. It is converted to a queue name using the GetQueueName method.
+	MatchingDLQKey struct {
+		NamespaceID   string
+		TaskQueueName string
+		TaskQueueType enumspb.TaskQueueType
+	}
+
+	CreateMatchingDLQRequest struct {
+		QueueKey MatchingDLQKey
+	}
+
+	CreateMatchingDLQResponse struct {
+	}
+
+	// EnqueueMatchingDLQTaskRequest does not include a MatchingDLQKey because it is inferred from the task.
+	EnqueueMatchingDLQTaskRequest struct {
+		Task *persistencespb.MatchingDLQTask
+	}
+
+	EnqueueMatchingDLQTaskResponse struct {
+		Metadata MessageMetadata
+	}
+
+	ReadMatchingDLQTasksRequest struct {
+		QueueKey      MatchingDLQKey
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	MatchingDLQTask struct {
+		MessageMetadata MessageMetadata
+		Payload         *persistencespb.MatchingDLQTask
+	}
+
+	ReadMatchingDLQTasksResponse struct {
+		Tasks         []MatchingDLQTask
+		NextPageToken []byte
+	}
+
+	DeleteMatchingDLQTasksRequest struct {
+		QueueKey                    MatchingDLQKey
+		InclusiveMaxMessageMetadata MessageMetadata
+	}
+
+	DeleteMatchingDLQTasksResponse struct {
+		MessagesDeleted int64
+	}
+
+	ListMatchingDLQsRequest struct {
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	ListMatchingDLQsResponse struct {
+		QueueKeys     []MatchingDLQKey
+		NextPageToken []byte
+	}
 )
 
 func (e *InvalidPersistenceRequestError) Error() string {
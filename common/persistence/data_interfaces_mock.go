@@ -1460,3 +1460,114 @@ func (mr *MockHistoryTaskQueueManagerMockRecorder) ReadTasks(ctx, request any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTasks", reflect.TypeOf((*MockHistoryTaskQueueManager)(nil).ReadTasks), ctx, request)
 }
+
+// MockMatchingTaskDLQManager is a mock of MatchingTaskDLQManager interface.
+type MockMatchingTaskDLQManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockMatchingTaskDLQManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockMatchingTaskDLQManagerMockRecorder is the mock recorder for MockMatchingTaskDLQManager.
+type MockMatchingTaskDLQManagerMockRecorder struct {
+	mock *MockMatchingTaskDLQManager
+}
+
+// NewMockMatchingTaskDLQManager creates a new mock instance.
+func NewMockMatchingTaskDLQManager(ctrl *gomock.Controller) *MockMatchingTaskDLQManager {
+	mock := &MockMatchingTaskDLQManager{ctrl: ctrl}
+	mock.recorder = &MockMatchingTaskDLQManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMatchingTaskDLQManager) EXPECT() *MockMatchingTaskDLQManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockMatchingTaskDLQManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockMatchingTaskDLQManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockMatchingTaskDLQManager)(nil).Close))
+}
+
+// CreateQueue mocks base method.
+func (m *MockMatchingTaskDLQManager) CreateQueue(ctx context.Context, request *CreateMatchingDLQRequest) (*CreateMatchingDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQueue", ctx, request)
+	ret0, _ := ret[0].(*CreateMatchingDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQueue indicates an expected call of CreateQueue.
+func (mr *MockMatchingTaskDLQManagerMockRecorder) CreateQueue(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQueue", reflect.TypeOf((*MockMatchingTaskDLQManager)(nil).CreateQueue), ctx, request)
+}
+
+// DeleteTasks mocks base method.
+func (m *MockMatchingTaskDLQManager) DeleteTasks(ctx context.Context, request *DeleteMatchingDLQTasksRequest) (*DeleteMatchingDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTasks", ctx, request)
+	ret0, _ := ret[0].(*DeleteMatchingDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTasks indicates an expected call of DeleteTasks.
+func (mr *MockMatchingTaskDLQManagerMockRecorder) DeleteTasks(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTasks", reflect.TypeOf((*MockMatchingTaskDLQManager)(nil).DeleteTasks), ctx, request)
+}
+
+// EnqueueTask mocks base method.
+func (m *MockMatchingTaskDLQManager) EnqueueTask(ctx context.Context, request *EnqueueMatchingDLQTaskRequest) (*EnqueueMatchingDLQTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueTask", ctx, request)
+	ret0, _ := ret[0].(*EnqueueMatchingDLQTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueTask indicates an expected call of EnqueueTask.
+func (mr *MockMatchingTaskDLQManagerMockRecorder) EnqueueTask(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueTask", reflect.TypeOf((*MockMatchingTaskDLQManager)(nil).EnqueueTask), ctx, request)
+}
+
+// ListQueues mocks base method.
+func (m *MockMatchingTaskDLQManager) ListQueues(ctx context.Context, request *ListMatchingDLQsRequest) (*ListMatchingDLQsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueues", ctx, request)
+	ret0, _ := ret[0].(*ListMatchingDLQsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueues indicates an expected call of ListQueues.
+func (mr *MockMatchingTaskDLQManagerMockRecorder) ListQueues(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockMatchingTaskDLQManager)(nil).ListQueues), ctx, request)
+}
+
+// ReadTasks mocks base method.
+func (m *MockMatchingTaskDLQManager) ReadTasks(ctx context.Context, request *ReadMatchingDLQTasksRequest) (*ReadMatchingDLQTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTasks", ctx, request)
+	ret0, _ := ret[0].(*ReadMatchingDLQTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTasks indicates an expected call of ReadTasks.
+func (mr *MockMatchingTaskDLQManagerMockRecorder) ReadTasks(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTasks", reflect.TypeOf((*MockMatchingTaskDLQManager)(nil).ReadTasks), ctx, request)
+}
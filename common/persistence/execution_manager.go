@@ -34,6 +34,7 @@ type (
 		pagingTokenSerializer                       *jsonHistoryTokenSerializer
 		transactionSizeLimit                        dynamicconfig.IntPropertyFn
 		enableBestEffortDeleteTasksOnWorkflowUpdate dynamicconfig.BoolPropertyFn
+		historyTiering                              *historyTiering
 	}
 )
 
@@ -47,6 +48,7 @@ func NewExecutionManager(
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
 	enableBestEffortDeleteTasksOnWorkflowUpdate dynamicconfig.BoolPropertyFn,
+	historyTiering *HistoryTieringConfig,
 ) ExecutionManager {
 	return &executionManagerImpl{
 		serializer:            serializer,
@@ -56,6 +58,7 @@ func NewExecutionManager(
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
 		enableBestEffortDeleteTasksOnWorkflowUpdate: enableBestEffortDeleteTasksOnWorkflowUpdate,
+		historyTiering: newHistoryTiering(historyTiering, persistence, logger),
 	}
 }
 
@@ -541,7 +544,7 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 	xdcKVs := make(map[XDCCacheKey]XDCCacheValue, len(eventBatches))
 	workflowNewEvents := make([]*InternalAppendHistoryNodesRequest, 0, len(eventBatches))
 	for _, workflowEvents := range eventBatches {
-		newEvents, err := m.serializeWorkflowEvents(shardID, workflowEvents)
+		newEvents, newEventsSize, err := m.serializeWorkflowEvents(shardID, workflowEvents)
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -565,7 +568,7 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 		)
 		newEvents.ShardID = shardID
 		workflowNewEvents = append(workflowNewEvents, newEvents)
-		historyStatistics.SizeDiff += len(newEvents.Node.Events.Data)
+		historyStatistics.SizeDiff += newEventsSize
 		historyStatistics.CountDiff += len(workflowEvents.Events)
 	}
 	return xdcKVs, workflowNewEvents, &historyStatistics, nil
@@ -606,13 +609,14 @@ func (m *executionManagerImpl) DeserializeBufferedEvents( // unexport
 func (m *executionManagerImpl) serializeWorkflowEvents(
 	shardID int32,
 	workflowEvents *WorkflowEvents,
-) (*InternalAppendHistoryNodesRequest, error) {
+) (*InternalAppendHistoryNodesRequest, int, error) {
 	if len(workflowEvents.Events) == 0 {
-		return nil, nil // allow update workflow without events
+		return nil, 0, nil // allow update workflow without events
 	}
 
 	request := &AppendHistoryNodesRequest{
 		ShardID:           shardID,
+		NamespaceID:       workflowEvents.NamespaceID,
 		BranchToken:       workflowEvents.BranchToken,
 		Events:            workflowEvents.Events,
 		PrevTransactionID: workflowEvents.PrevTxnID,
@@ -631,6 +635,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	input *WorkflowMutation,
 ) (*InternalWorkflowMutation, error) {
 
+	encoder := m.serializer.ForNamespace(input.ExecutionInfo.GetNamespaceId())
 	serializedTasks, err := serializeTasks(m.serializer, input.Tasks)
 	if err != nil {
 		return nil, err
@@ -675,17 +680,17 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 		NextEventID:     input.NextEventID,
 	}
 
-	result.ExecutionInfoBlob, err = m.serializer.WorkflowExecutionInfoToBlob(input.ExecutionInfo)
+	result.ExecutionInfoBlob, err = encoder.WorkflowExecutionInfoToBlob(input.ExecutionInfo)
 	if err != nil {
 		return nil, err
 	}
-	result.ExecutionStateBlob, err = m.serializer.WorkflowExecutionStateToBlob(input.ExecutionState)
+	result.ExecutionStateBlob, err = encoder.WorkflowExecutionStateToBlob(input.ExecutionState)
 	if err != nil {
 		return nil, err
 	}
 
 	for key, info := range input.UpsertActivityInfos {
-		blob, err := m.serializer.ActivityInfoToBlob(info)
+		blob, err := encoder.ActivityInfoToBlob(info)
 		if err != nil {
 			return nil, err
 		}
@@ -693,7 +698,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertTimerInfos {
-		blob, err := m.serializer.TimerInfoToBlob(info)
+		blob, err := encoder.TimerInfoToBlob(info)
 		if err != nil {
 			return nil, err
 		}
@@ -701,7 +706,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertChildExecutionInfos {
-		blob, err := m.serializer.ChildExecutionInfoToBlob(info)
+		blob, err := encoder.ChildExecutionInfoToBlob(info)
 		if err != nil {
 			return nil, err
 		}
@@ -709,7 +714,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertRequestCancelInfos {
-		blob, err := m.serializer.RequestCancelInfoToBlob(info)
+		blob, err := encoder.RequestCancelInfoToBlob(info)
 		if err != nil {
 			return nil, err
 		}
@@ -717,21 +722,21 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertSignalInfos {
-		blob, err := m.serializer.SignalInfoToBlob(info)
+		blob, err := encoder.SignalInfoToBlob(info)
 		if err != nil {
 			return nil, err
 		}
 		result.UpsertSignalInfos[key] = blob
 	}
 
-	nodeMap, err := m.makeInternalChasmNodeMap(input.UpsertChasmNodes)
+	nodeMap, err := m.makeInternalChasmNodeMap(encoder, input.UpsertChasmNodes)
 	if err != nil {
 		return nil, err
 	}
 	result.UpsertChasmNodes = nodeMap
 
 	if len(input.NewBufferedEvents) > 0 {
-		result.NewBufferedEvents, err = m.serializer.SerializeEvents(input.NewBufferedEvents)
+		result.NewBufferedEvents, err = encoder.SerializeEventsForPersistence(input.NewBufferedEvents)
 		if err != nil {
 			return nil, err
 		}
@@ -752,6 +757,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	input *WorkflowSnapshot,
 ) (*InternalWorkflowSnapshot, error) {
+	encoder := m.serializer.ForNamespace(input.ExecutionInfo.GetNamespaceId())
 	serializedTasks, err := serializeTasks(m.serializer, input.Tasks)
 	if err != nil {
 		return nil, err
@@ -780,11 +786,11 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 		NextEventID:     input.NextEventID,
 	}
 
-	result.ExecutionInfoBlob, err = m.serializer.WorkflowExecutionInfoToBlob(input.ExecutionInfo)
+	result.ExecutionInfoBlob, err = encoder.WorkflowExecutionInfoToBlob(input.ExecutionInfo)
 	if err != nil {
 		return nil, err
 	}
-	result.ExecutionStateBlob, err = m.serializer.WorkflowExecutionStateToBlob(input.ExecutionState)
+	result.ExecutionStateBlob, err = encoder.WorkflowExecutionStateToBlob(input.ExecutionState)
 	if err != nil {
 		return nil, err
 	}
@@ -794,35 +800,35 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	}
 
 	for key, info := range input.ActivityInfos {
-		blob, err := m.serializer.ActivityInfoToBlob(info)
+		blob, err := encoder.ActivityInfoToBlob(info)
 		if err != nil {
 			return nil, err
 		}
 		result.ActivityInfos[key] = blob
 	}
 	for key, info := range input.TimerInfos {
-		blob, err := m.serializer.TimerInfoToBlob(info)
+		blob, err := encoder.TimerInfoToBlob(info)
 		if err != nil {
 			return nil, err
 		}
 		result.TimerInfos[key] = blob
 	}
 	for key, info := range input.ChildExecutionInfos {
-		blob, err := m.serializer.ChildExecutionInfoToBlob(info)
+		blob, err := encoder.ChildExecutionInfoToBlob(info)
 		if err != nil {
 			return nil, err
 		}
 		result.ChildExecutionInfos[key] = blob
 	}
 	for key, info := range input.RequestCancelInfos {
-		blob, err := m.serializer.RequestCancelInfoToBlob(info)
+		blob, err := encoder.RequestCancelInfoToBlob(info)
 		if err != nil {
 			return nil, err
 		}
 		result.RequestCancelInfos[key] = blob
 	}
 	for key, info := range input.SignalInfos {
-		blob, err := m.serializer.SignalInfoToBlob(info)
+		blob, err := encoder.SignalInfoToBlob(info)
 		if err != nil {
 			return nil, err
 		}
@@ -831,7 +837,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	for key := range input.SignalRequestedIDs {
 		result.SignalRequestedIDs[key] = struct{}{}
 	}
-	nodeMap, err := m.makeInternalChasmNodeMap(input.ChasmNodes)
+	nodeMap, err := m.makeInternalChasmNodeMap(encoder, input.ChasmNodes)
 	if err != nil {
 		return nil, err
 	}
@@ -1077,6 +1083,9 @@ func (m *executionManagerImpl) IsReplicationDLQEmpty(
 }
 
 func (m *executionManagerImpl) Close() {
+	if m.historyTiering != nil {
+		m.historyTiering.stop()
+	}
 	m.persistence.Close()
 }
 
@@ -1309,7 +1318,7 @@ func serializeTasks(
 	for category, tasks := range inputTasks {
 		serializedTasks := make([]InternalHistoryTask, 0, len(tasks))
 		for _, task := range tasks {
-			blob, err := serializer.SerializeTask(task)
+			blob, err := serializer.ForNamespace(task.GetNamespaceID()).SerializeTask(task)
 			if err != nil {
 				return nil, err
 			}
@@ -1356,6 +1365,7 @@ func validateTaskRange(
 }
 
 func (m *executionManagerImpl) makeInternalChasmNodeMap(
+	encoder serialization.Encoder,
 	nodes map[string]*persistencespb.ChasmNode,
 ) (map[string]InternalChasmNode, error) {
 	res := make(map[string]InternalChasmNode, len(nodes))
@@ -1366,7 +1376,7 @@ func (m *executionManagerImpl) makeInternalChasmNodeMap(
 
 		// If we're running on Cassandra, set a single blob since that's how we store it.
 		if isCassandra {
-			blob, err := m.serializer.ChasmNodeToBlob(node)
+			blob, err := encoder.ChasmNodeToBlob(node)
 			if err != nil {
 				return nil, err
 			}
@@ -1375,7 +1385,7 @@ func (m *executionManagerImpl) makeInternalChasmNodeMap(
 			}
 		} else {
 			// Otherwise, split the node into separate blobs.
-			metadata, data, err := m.serializer.ChasmNodeToBlobs(node)
+			metadata, data, err := encoder.ChasmNodeToBlobs(node)
 			if err != nil {
 				return nil, err
 			}
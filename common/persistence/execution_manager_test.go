@@ -73,6 +73,7 @@ func TestExecutionManager_DeletesTasksWhenEnabled(t *testing.T) {
 		log.NewNoopLogger(),
 		dynamicconfig.GetIntPropertyFn(1024*1024),
 		dynamicconfig.GetBoolPropertyFn(true),
+		nil,
 	)
 
 	_, err := em.UpdateWorkflowExecution(context.Background(), newTestUpdateRequest(expectedKeys))
@@ -98,6 +99,7 @@ func TestExecutionManager_DoesNotDeleteTasksWhenDisabled(t *testing.T) {
 		log.NewNoopLogger(),
 		dynamicconfig.GetIntPropertyFn(1024*1024),
 		dynamicconfig.GetBoolPropertyFn(false),
+		nil,
 	)
 
 	keys := []tasks.Key{tasks.NewKey(time.Now().UTC(), 789)}
@@ -134,6 +136,7 @@ func TestExecutionManager_DeleteTasksError_DoesNotFailUpdate(t *testing.T) {
 		log.NewNoopLogger(),
 		dynamicconfig.GetIntPropertyFn(1024*1024),
 		dynamicconfig.GetBoolPropertyFn(true),
+		nil,
 	)
 
 	// UpdateWorkflowExecution should succeed even though CompleteHistoryTask failed
@@ -163,6 +166,7 @@ func TestExecutionManager_TrimHistoryBranchSkipped_NonWorkflow(t *testing.T) {
 		testlogger.NewTestLogger(t, testlogger.FailOnAnyUnexpectedError),
 		dynamicconfig.GetIntPropertyFn(1024*1024),
 		dynamicconfig.GetBoolPropertyFn(false),
+		nil,
 	)
 
 	req := newTestUpdateRequest(nil)
@@ -226,6 +230,7 @@ func TestExecutionManager_TrimHistoryBranchSkipped_EmptyBranchToken(t *testing.T
 		testlogger.NewTestLogger(t, testlogger.FailOnAnyUnexpectedError),
 		dynamicconfig.GetIntPropertyFn(1024*1024),
 		dynamicconfig.GetBoolPropertyFn(false),
+		nil,
 	)
 
 	_, err = em.UpdateWorkflowExecution(context.Background(), newTestUpdateRequest(nil))
@@ -1,7 +1,9 @@
 package faultinjection
 
 import (
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -9,6 +11,8 @@ type (
 	FaultInjectionDataStoreFactory struct {
 		baseFactory persistence.DataStoreFactory
 		fiConfig    *config.FaultInjection
+		// scenario is shared by all stores, so that the phases of a scenario apply to all of them at the same time.
+		scenario *scenarioInjector
 
 		taskStore          persistence.TaskStore
 		fairTaskStore      persistence.TaskStore
@@ -22,14 +26,25 @@ type (
 	}
 )
 
+// NewFaultInjectionDatastoreFactory returns a data store factory that wraps the stores of the base factory
+// with fault injection. It returns an error if the scenario file of the config cannot be loaded.
 func NewFaultInjectionDatastoreFactory(
 	fiConfig *config.FaultInjection,
 	baseFactory persistence.DataStoreFactory,
-) *FaultInjectionDataStoreFactory {
-	return &FaultInjectionDataStoreFactory{
+	logger log.Logger,
+) (*FaultInjectionDataStoreFactory, error) {
+	factory := &FaultInjectionDataStoreFactory{
 		baseFactory: baseFactory,
 		fiConfig:    fiConfig,
 	}
+	if fiConfig.ScenarioFile != "" || fiConfig.ScenarioFileOverride != nil {
+		scenario, err := newScenarioInjector(fiConfig, clock.NewRealTimeSource(), logger)
+		if err != nil {
+			return nil, err
+		}
+		factory.scenario = scenario
+	}
+	return factory, nil
 }
 
 func (d *FaultInjectionDataStoreFactory) Close() {
@@ -202,5 +217,5 @@ func (d *FaultInjectionDataStoreFactory) storeFaultInjector(storeName config.Dat
 	if !ok {
 		storeConfig = config.FaultInjectionDataStoreConfig{}
 	}
-	return newStoreFaultInjector(storeName, &storeConfig, d.fiConfig.Injector)
+	return newStoreFaultInjector(storeName, &storeConfig, d.fiConfig.Injector, d.scenario)
 }
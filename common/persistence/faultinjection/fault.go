@@ -3,6 +3,7 @@ package faultinjection
 import (
 	"context"
 	"fmt"
+	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
@@ -16,6 +17,8 @@ type (
 		execOp bool
 		// How often this fault should be injected. 0.0 means never, 1.0 means always.
 		rate float64
+		// latency is added before the operation is executed or the error is returned.
+		latency time.Duration
 	}
 )
 
@@ -29,39 +32,61 @@ func newFaultFromError(err error, rate float64) fault {
 // newFault returns an error based on the provided name. If the name is not recognized, then this method will
 // panic.
 func newFault(errName string, errRate float64, methodName string) fault {
+	f, err := buildFault(errName, errRate, methodName)
+	if err != nil {
+		panic(err.Error())
+	}
+	return f
+}
+
+// buildFault returns an error based on the provided name, or an error if the name is not recognized.
+func buildFault(errName string, errRate float64, methodName string) (fault, error) {
 	header := fmt.Sprintf("fault injection error at %s with %.2f rate", methodName, errRate)
 	switch errName {
 	case "ShardOwnershipLost":
-		return newFaultFromError(&persistence.ShardOwnershipLostError{Msg: fmt.Sprintf("%s: persistence.ShardOwnershipLostError", header)}, errRate)
+		return newFaultFromError(&persistence.ShardOwnershipLostError{Msg: fmt.Sprintf("%s: persistence.ShardOwnershipLostError", header)}, errRate), nil
 	case "DeadlineExceeded":
 		// Real persistence store never returns context.DeadlineExceeded error. It returns persistence.TimeoutError instead.
 		// Therefor "DeadlineExceeded" shouldn't be used with fault injection. Use "Timeout" instead.
-		return newFaultFromError(fmt.Errorf("%s: %w", header, context.DeadlineExceeded), errRate)
+		return newFaultFromError(fmt.Errorf("%s: %w", header, context.DeadlineExceeded), errRate), nil
 	case "Timeout":
-		return newFaultFromError(&persistence.TimeoutError{Msg: fmt.Sprintf("%s: persistence.TimeoutError", header)}, errRate)
+		return newFaultFromError(&persistence.TimeoutError{Msg: fmt.Sprintf("%s: persistence.TimeoutError", header)}, errRate), nil
 	case "ExecuteAndTimeout":
 		// Special error which emulates case, when caller got a Timeout error,
 		// but operation actually reached persistence and was executed successfully.
 		f := newFaultFromError(&persistence.TimeoutError{Msg: fmt.Sprintf("%s: persistence.TimeoutError", header)}, errRate)
 		f.execOp = true
-		return f
+		return f, nil
 	case "ResourceExhausted":
 		return newFaultFromError(&serviceerror.ResourceExhausted{
 			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_SYSTEM_OVERLOADED,
 			Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_SYSTEM,
 			Message: fmt.Sprintf("%s: serviceerror.ResourceExhausted", header),
-		}, errRate)
+		}, errRate), nil
 	case "Unavailable":
-		return newFaultFromError(serviceerror.NewUnavailablef("%s: serviceerror.Unavailable", header), errRate)
+		return newFaultFromError(serviceerror.NewUnavailablef("%s: serviceerror.Unavailable", header), errRate), nil
 	default:
-		panic(fmt.Sprintf("unsupported error type: %v", errName))
+		return fault{}, fmt.Errorf("unsupported error type: %v", errName)
 	}
 }
 
-func (f *fault) inject(op func() error) error {
+func (f *fault) inject(ctx context.Context, op func() error) error {
 	if f == nil {
 		return op()
 	}
+	if f.latency > 0 {
+		timer := time.NewTimer(f.latency)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			// Like a real store, report a slow call that outlives its context as a timeout.
+			return &persistence.TimeoutError{Msg: fmt.Sprintf("fault injection latency of %v: %v", f.latency, ctx.Err())}
+		}
+	}
+	if f.err == nil {
+		return op()
+	}
 	if f.execOp {
 		err := op()
 		if err != nil {
@@ -0,0 +1,528 @@
+package faultinjection
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	latencyDistributionFixed     = "fixed"
+	latencyDistributionUniform   = "uniform"
+	latencyDistributionLogNormal = "lognormal"
+
+	// z-score of the 99th percentile of the standard normal distribution.
+	p99ZScore = 2.3263478740408408
+
+	// scenarioOverrideCheckInterval bounds how often the dynamic config override is read.
+	scenarioOverrideCheckInterval = time.Second
+)
+
+type (
+	// scenarioInjector plays a fault injection scenario. The scenario starts when it is loaded and
+	// is replaced whenever the dynamic config override changes.
+	scenarioInjector struct {
+		logger       log.Logger
+		timeSource   clock.TimeSource
+		staticFile   string
+		overrideFile dynamicconfig.StringPropertyFn
+
+		lock              sync.Mutex
+		nextOverrideCheck time.Time
+		loadedFile        string
+		current           atomic.Pointer[runningScenario]
+	}
+
+	runningScenario struct {
+		scenario  *compiledScenario
+		startTime time.Time
+		// phase is the index of the last phase that was logged, -1 before the first phase.
+		phase atomic.Int64
+	}
+
+	compiledScenario struct {
+		name     string
+		repeat   bool
+		phases   []*compiledPhase
+		duration time.Duration // sum of the phase durations, 0 if the last phase lasts forever
+	}
+
+	compiledPhase struct {
+		name     string
+		start    time.Duration
+		duration time.Duration // 0 means the phase lasts forever
+		rules    []*compiledRule
+	}
+
+	compiledRule struct {
+		stores       map[config.DataStoreName]struct{}
+		methods      map[string]struct{}
+		shardIDs     map[int32]struct{}
+		namespaceIDs map[string]struct{}
+
+		latency *latencyDistribution
+		errors  *methodFaultGenerator
+	}
+
+	latencyDistribution struct {
+		rndMu sync.Mutex
+		rnd   *rand.Rand
+
+		rate         float64
+		distribution string
+		fixed        time.Duration
+		min          time.Duration
+		max          time.Duration
+		mu           float64 // log-normal location, in log nanoseconds
+		sigma        float64 // log-normal scale
+	}
+)
+
+// loadScenario reads a scenario file and compiles it.
+func loadScenario(path string) (*compiledScenario, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read fault injection scenario: %w", err)
+	}
+	var scenario config.FaultInjectionScenario
+	if err := yaml.Unmarshal(content, &scenario); err != nil {
+		return nil, fmt.Errorf("unable to parse fault injection scenario %s: %w", path, err)
+	}
+	compiled, err := compileScenario(&scenario)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fault injection scenario %s: %w", path, err)
+	}
+	return compiled, nil
+}
+
+func newScenarioInjector(
+	fiConfig *config.FaultInjection,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) (*scenarioInjector, error) {
+	injector := &scenarioInjector{
+		logger:       logger,
+		timeSource:   timeSource,
+		staticFile:   fiConfig.ScenarioFile,
+		overrideFile: fiConfig.ScenarioFileOverride,
+	}
+	file := injector.staticFile
+	if injector.overrideFile != nil {
+		if override := injector.overrideFile(); override != "" {
+			file = override
+		}
+	}
+	if err := injector.load(file); err != nil {
+		return nil, err
+	}
+	injector.nextOverrideCheck = timeSource.Now().Add(scenarioOverrideCheckInterval)
+	return injector, nil
+}
+
+// load starts playing the scenario in the given file. An empty file stops the current scenario.
+func (s *scenarioInjector) load(file string) error {
+	s.loadedFile = file
+	if file == "" {
+		s.current.Store(nil)
+		return nil
+	}
+	compiled, err := loadScenario(file)
+	if err != nil {
+		return err
+	}
+	running := &runningScenario{scenario: compiled, startTime: s.timeSource.Now()}
+	running.phase.Store(-1)
+	s.current.Store(running)
+	s.logger.Info("Started persistence fault injection scenario",
+		tag.NewStringTag("scenario", compiled.name), tag.NewStringTag("scenario-file", file))
+	return nil
+}
+
+// refresh reloads the scenario if the dynamic config override has changed.
+func (s *scenarioInjector) refresh() {
+	if s.overrideFile == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := s.timeSource.Now()
+	if now.Before(s.nextOverrideCheck) {
+		return
+	}
+	s.nextOverrideCheck = now.Add(scenarioOverrideCheckInterval)
+
+	file := s.overrideFile()
+	if file == "" {
+		file = s.staticFile
+	}
+	if file == s.loadedFile {
+		return
+	}
+	if err := s.load(file); err != nil {
+		// Keep playing the current scenario, the override is retried once it changes again.
+		s.logger.Error("Unable to load persistence fault injection scenario",
+			tag.NewStringTag("scenario-file", file), tag.Error(err))
+	}
+}
+
+func (s *scenarioInjector) injector() faultInjector {
+	return func(target config.FaultInjectionTarget) *fault {
+		s.refresh()
+		running := s.current.Load()
+		if running == nil {
+			return nil
+		}
+		phase := running.activePhase(s.timeSource.Now(), s.logger)
+		if phase == nil {
+			return nil
+		}
+		for _, rule := range phase.rules {
+			if rule.matches(target) {
+				return rule.generate(target.Method)
+			}
+		}
+		return nil
+	}
+}
+
+// activePhase returns the phase of the scenario at the given time, or nil if the scenario has ended.
+func (r *runningScenario) activePhase(now time.Time, logger log.Logger) *compiledPhase {
+	elapsed := now.Sub(r.startTime)
+	scenario := r.scenario
+	if scenario.duration > 0 && elapsed >= scenario.duration {
+		if !scenario.repeat {
+			if r.phase.Swap(int64(len(scenario.phases))) != int64(len(scenario.phases)) {
+				logger.Info("Persistence fault injection scenario ended", tag.NewStringTag("scenario", scenario.name))
+			}
+			return nil
+		}
+		elapsed %= scenario.duration
+	}
+	index, _ := slices.BinarySearchFunc(scenario.phases, elapsed, func(phase *compiledPhase, elapsed time.Duration) int {
+		if phase.duration > 0 && phase.start+phase.duration <= elapsed {
+			return -1
+		}
+		if phase.start > elapsed {
+			return 1
+		}
+		return 0
+	})
+	if index >= len(scenario.phases) {
+		return nil
+	}
+	if r.phase.Swap(int64(index)) != int64(index) {
+		phase := scenario.phases[index]
+		logger.Info("Persistence fault injection scenario phase started",
+			tag.NewStringTag("scenario", scenario.name), tag.NewStringTag("phase", phase.name))
+	}
+	return scenario.phases[index]
+}
+
+func compileScenario(scenario *config.FaultInjectionScenario) (*compiledScenario, error) {
+	if len(scenario.Phases) == 0 {
+		return nil, errors.New("scenario has no phases")
+	}
+	seed := scenario.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	compiled := &compiledScenario{
+		name:   scenario.Name,
+		repeat: scenario.Repeat,
+	}
+	var start time.Duration
+	for i, phase := range scenario.Phases {
+		name := phase.Name
+		if name == "" {
+			name = fmt.Sprintf("phase-%d", i)
+		}
+		if phase.Duration < 0 {
+			return nil, fmt.Errorf("phase %s: duration must not be negative", name)
+		}
+		if phase.Duration == 0 && (i < len(scenario.Phases)-1 || scenario.Repeat) {
+			return nil, fmt.Errorf("phase %s: duration is required, except for the last phase of a scenario that does not repeat", name)
+		}
+		compiledPhase := &compiledPhase{
+			name:     name,
+			start:    start,
+			duration: phase.Duration,
+		}
+		for j, rule := range phase.Rules {
+			// Every rule gets its own random sources so that a scenario with a seed is deterministic
+			// for a given sequence of calls.
+			compiledRule, err := compileRule(&rule, fmt.Sprintf("%s rule %d", name, j), seed+int64(i)*1000+int64(j)*2)
+			if err != nil {
+				return nil, fmt.Errorf("phase %s: rule %d: %w", name, j, err)
+			}
+			compiledPhase.rules = append(compiledPhase.rules, compiledRule)
+		}
+		compiled.phases = append(compiled.phases, compiledPhase)
+		start += phase.Duration
+	}
+	if compiled.phases[len(compiled.phases)-1].duration > 0 {
+		compiled.duration = start
+	}
+	return compiled, nil
+}
+
+func compileRule(rule *config.FaultInjectionScenarioRule, name string, seed int64) (*compiledRule, error) {
+	compiled := &compiledRule{
+		stores:       toSet(rule.Stores),
+		methods:      toSet(rule.Methods),
+		shardIDs:     toSet(rule.ShardIDs),
+		namespaceIDs: toSet(rule.NamespaceIDs),
+	}
+
+	var faults []fault
+	var totalRate float64
+	for errName, errRate := range rule.Errors {
+		if errRate < 0 || errRate > 1 {
+			return nil, fmt.Errorf("rate of %s must be between 0 and 1", errName)
+		}
+		f, err := buildFault(errName, errRate, name)
+		if err != nil {
+			return nil, err
+		}
+		faults = append(faults, f)
+		totalRate += errRate
+	}
+	if totalRate > 1 {
+		return nil, fmt.Errorf("error rates add up to %v, which is more than 1", totalRate)
+	}
+	// Map iteration order is random, sort the faults so that a seeded scenario is deterministic.
+	slices.SortFunc(faults, func(a, b fault) int {
+		switch {
+		case a.err.Error() < b.err.Error():
+			return -1
+		case a.err.Error() > b.err.Error():
+			return 1
+		}
+		return 0
+	})
+	if len(faults) > 0 {
+		compiled.errors = newMethodFaultGenerator(faults, seed)
+	}
+
+	if rule.Latency != nil {
+		latency, err := newLatencyDistribution(rule.Latency, seed+1)
+		if err != nil {
+			return nil, fmt.Errorf("latency: %w", err)
+		}
+		compiled.latency = latency
+	}
+	return compiled, nil
+}
+
+func toSet[T comparable](values []T) map[T]struct{} {
+	if len(values) == 0 {
+		return nil
+	}
+	set := make(map[T]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
+
+func (r *compiledRule) matches(target config.FaultInjectionTarget) bool {
+	if r.stores != nil {
+		if _, ok := r.stores[target.Store]; !ok {
+			return false
+		}
+	}
+	if r.methods != nil {
+		if _, ok := r.methods[target.Method]; !ok {
+			return false
+		}
+	}
+	if r.shardIDs != nil {
+		shardID, ok := requestShardID(target.Request)
+		if !ok {
+			return false
+		}
+		if _, ok := r.shardIDs[shardID]; !ok {
+			return false
+		}
+	}
+	if r.namespaceIDs != nil {
+		namespaceID, ok := requestNamespaceID(target.Request)
+		if !ok {
+			return false
+		}
+		if _, ok := r.namespaceIDs[namespaceID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// generate returns the fault to inject into a call matched by the rule, or nil if the call should
+// go through unchanged.
+func (r *compiledRule) generate(methodName string) *fault {
+	var result fault
+	if r.errors != nil {
+		if f := r.errors.generate(methodName); f != nil {
+			result = *f
+		}
+	}
+	if r.latency != nil {
+		result.latency = r.latency.sample()
+	}
+	if result.err == nil && result.latency == 0 {
+		return nil
+	}
+	return &result
+}
+
+func newLatencyDistribution(cfg *config.FaultInjectionLatency, seed int64) (*latencyDistribution, error) {
+	d := &latencyDistribution{
+		rnd:          rand.New(rand.NewSource(seed)),
+		rate:         1,
+		distribution: cfg.Distribution,
+		max:          cfg.Max,
+	}
+	if cfg.Rate != nil {
+		if *cfg.Rate < 0 || *cfg.Rate > 1 {
+			return nil, errors.New("rate must be between 0 and 1")
+		}
+		d.rate = *cfg.Rate
+	}
+	switch cfg.Distribution {
+	case latencyDistributionFixed:
+		if cfg.Duration <= 0 {
+			return nil, errors.New("fixed distribution requires a positive duration")
+		}
+		d.fixed = cfg.Duration
+	case latencyDistributionUniform:
+		if cfg.Min < 0 || cfg.Max <= cfg.Min {
+			return nil, errors.New("uniform distribution requires 0 <= min < max")
+		}
+		d.min = cfg.Min
+	case latencyDistributionLogNormal:
+		if cfg.P50 <= 0 || cfg.P99 < cfg.P50 {
+			return nil, errors.New("lognormal distribution requires 0 < p50 <= p99")
+		}
+		d.mu = math.Log(float64(cfg.P50))
+		d.sigma = (math.Log(float64(cfg.P99)) - d.mu) / p99ZScore
+	default:
+		return nil, fmt.Errorf("unsupported distribution %q, must be one of %s, %s, %s",
+			cfg.Distribution, latencyDistributionFixed, latencyDistributionUniform, latencyDistributionLogNormal)
+	}
+	return d, nil
+}
+
+// sample returns the latency to add to a call, 0 if the call is not delayed.
+func (d *latencyDistribution) sample() time.Duration {
+	d.rndMu.Lock()
+	defer d.rndMu.Unlock()
+	if d.rate < 1 && d.rnd.Float64() >= d.rate {
+		return 0
+	}
+	var latency time.Duration
+	switch d.distribution {
+	case latencyDistributionFixed:
+		return d.fixed
+	case latencyDistributionUniform:
+		latency = d.min + time.Duration(d.rnd.Int63n(int64(d.max-d.min)+1))
+	case latencyDistributionLogNormal:
+		latency = time.Duration(math.Exp(d.mu + d.sigma*d.rnd.NormFloat64()))
+	}
+	if d.max > 0 && latency > d.max {
+		latency = d.max
+	}
+	return latency
+}
+
+var (
+	// requestFieldPaths caches, per request type, the index path of its shard ID and namespace ID fields.
+	requestFieldPaths sync.Map // reflect.Type -> *requestFields
+)
+
+type requestFields struct {
+	shardID     []int
+	namespaceID []int
+}
+
+// requestShardID returns the ShardID field of a persistence request.
+func requestShardID(request any) (int32, bool) {
+	value, fields, ok := requestValue(request)
+	if !ok || fields.shardID == nil {
+		return 0, false
+	}
+	return int32(value.FieldByIndex(fields.shardID).Int()), true
+}
+
+// requestNamespaceID returns the NamespaceID field of a persistence request, or of the workflow
+// snapshot or mutation it contains.
+func requestNamespaceID(request any) (string, bool) {
+	value, fields, ok := requestValue(request)
+	if !ok || fields.namespaceID == nil {
+		return "", false
+	}
+	field, err := value.FieldByIndexErr(fields.namespaceID)
+	if err != nil {
+		// Nil pointer along the path.
+		return "", false
+	}
+	return field.String(), true
+}
+
+func requestValue(request any) (reflect.Value, *requestFields, bool) {
+	value := reflect.ValueOf(request)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return reflect.Value{}, nil, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, nil, false
+	}
+	if cached, ok := requestFieldPaths.Load(value.Type()); ok {
+		return value, cached.(*requestFields), true
+	}
+	fields := findRequestFields(value.Type())
+	requestFieldPaths.Store(value.Type(), fields)
+	return value, fields, true
+}
+
+func findRequestFields(t reflect.Type) *requestFields {
+	fields := &requestFields{}
+	if field, ok := t.FieldByName("ShardID"); ok && field.Type.Kind() == reflect.Int32 {
+		fields.shardID = field.Index
+	}
+	if field, ok := t.FieldByName("NamespaceID"); ok && field.Type.Kind() == reflect.String {
+		fields.namespaceID = field.Index
+		return fields
+	}
+	// Workflow writes carry the namespace ID in their snapshot or mutation.
+	for i := range t.NumField() {
+		field := t.Field(i)
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() != reflect.Struct {
+			continue
+		}
+		if nested, ok := fieldType.FieldByName("NamespaceID"); ok && nested.Type.Kind() == reflect.String {
+			fields.namespaceID = append([]int{i}, nested.Index...)
+			return fields
+		}
+	}
+	return fields
+}
@@ -0,0 +1,268 @@
+package faultinjection
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+const testScenario = `
+name: test
+seed: 1
+phases:
+  - name: slow-updates
+    duration: 5m
+    rules:
+      - stores: [ExecutionStore]
+        methods: [UpdateWorkflowExecution]
+        latency:
+          distribution: fixed
+          duration: 800ms
+  - name: shard-timeouts
+    duration: 30s
+    rules:
+      - shardIDs: [12]
+        errors:
+          Timeout: 1.0
+      - namespaceIDs: [namespace-id]
+        errors:
+          ResourceExhausted: 1.0
+`
+
+func writeScenario(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "scenario.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestScenario_Phases(t *testing.T) {
+	t.Parallel()
+
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	scenario, err := newScenarioInjector(&config.FaultInjection{ScenarioFile: writeScenario(t, testScenario)}, timeSource, log.NewTestLogger())
+	require.NoError(t, err)
+	generator, ok := newStoreFaultInjector(config.ExecutionStoreName, &config.FaultInjectionDataStoreConfig{}, nil, scenario)
+	require.True(t, ok)
+
+	shard12 := &persistence.InternalUpdateWorkflowExecutionRequest{ShardID: 12}
+	otherShard := &persistence.InternalUpdateWorkflowExecutionRequest{ShardID: 1}
+	otherNamespace := &persistence.InternalUpdateWorkflowExecutionRequest{
+		ShardID:                1,
+		UpdateWorkflowMutation: persistence.InternalWorkflowMutation{NamespaceID: "namespace-id"},
+	}
+
+	// First phase: only updates are slowed down.
+	f := generator.generate("UpdateWorkflowExecution", shard12)
+	require.NotNil(t, f)
+	assert.Equal(t, 800*time.Millisecond, f.latency)
+	assert.NoError(t, f.err)
+	assert.Nil(t, generator.generate("GetWorkflowExecution", &persistence.GetWorkflowExecutionRequest{ShardID: 12}))
+
+	// Second phase: shard 12 times out, and the namespace is throttled on every shard.
+	timeSource.Advance(5 * time.Minute)
+	f = generator.generate("UpdateWorkflowExecution", shard12)
+	require.NotNil(t, f)
+	assert.Zero(t, f.latency)
+	var timeoutErr *persistence.TimeoutError
+	assert.ErrorAs(t, f.err, &timeoutErr)
+	assert.Nil(t, generator.generate("UpdateWorkflowExecution", otherShard))
+	f = generator.generate("UpdateWorkflowExecution", otherNamespace)
+	require.NotNil(t, f)
+	var resourceExhaustedErr *serviceerror.ResourceExhausted
+	assert.ErrorAs(t, f.err, &resourceExhaustedErr)
+	f = generator.generate("GetWorkflowExecution", &persistence.GetWorkflowExecutionRequest{ShardID: 12})
+	require.NotNil(t, f)
+	assert.ErrorAs(t, f.err, &timeoutErr)
+
+	// The scenario does not repeat.
+	timeSource.Advance(30 * time.Second)
+	assert.Nil(t, generator.generate("UpdateWorkflowExecution", shard12))
+}
+
+func TestScenario_Repeat(t *testing.T) {
+	t.Parallel()
+
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	scenario, err := newScenarioInjector(&config.FaultInjection{ScenarioFile: writeScenario(t, `
+repeat: true
+phases:
+  - duration: 10s
+  - duration: 10s
+    rules:
+      - errors:
+          Unavailable: 1.0
+`)}, timeSource, log.NewTestLogger())
+	require.NoError(t, err)
+	inject := scenario.injector()
+	target := config.FaultInjectionTarget{Store: config.TaskStoreName, Method: "CreateTasks"}
+
+	for range 3 {
+		assert.Nil(t, inject(target))
+		timeSource.Advance(10 * time.Second)
+		f := inject(target)
+		require.NotNil(t, f)
+		var unavailableErr *serviceerror.Unavailable
+		assert.ErrorAs(t, f.err, &unavailableErr)
+		timeSource.Advance(10 * time.Second)
+	}
+}
+
+func TestScenario_DynamicConfigOverride(t *testing.T) {
+	t.Parallel()
+
+	staticFile := writeScenario(t, testScenario)
+	overrideFile := writeScenario(t, `
+phases:
+  - rules:
+      - errors:
+          ShardOwnershipLost: 1.0
+`)
+	invalidFile := writeScenario(t, `phases: []`)
+	var override string
+
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	scenario, err := newScenarioInjector(&config.FaultInjection{
+		ScenarioFile:         staticFile,
+		ScenarioFileOverride: func() string { return override },
+	}, timeSource, log.NewTestLogger())
+	require.NoError(t, err)
+	inject := scenario.injector()
+	target := config.FaultInjectionTarget{Store: config.ExecutionStoreName, Method: "UpdateWorkflowExecution"}
+
+	f := inject(target)
+	require.NotNil(t, f)
+	assert.Equal(t, 800*time.Millisecond, f.latency)
+
+	// The override is only read once per check interval.
+	override = overrideFile
+	f = inject(target)
+	require.NotNil(t, f)
+	assert.Equal(t, 800*time.Millisecond, f.latency)
+
+	timeSource.Advance(scenarioOverrideCheckInterval)
+	f = inject(target)
+	require.NotNil(t, f)
+	var shardOwnershipLostErr *persistence.ShardOwnershipLostError
+	assert.ErrorAs(t, f.err, &shardOwnershipLostErr)
+
+	// An invalid scenario is ignored.
+	override = invalidFile
+	timeSource.Advance(scenarioOverrideCheckInterval)
+	f = inject(target)
+	require.NotNil(t, f)
+	assert.ErrorAs(t, f.err, &shardOwnershipLostErr)
+
+	// Removing the override restarts the static scenario.
+	override = ""
+	timeSource.Advance(10 * time.Minute)
+	f = inject(target)
+	require.NotNil(t, f)
+	assert.Equal(t, 800*time.Millisecond, f.latency)
+}
+
+func TestScenario_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		scenario string
+	}{
+		{name: "no phases", scenario: `name: empty`},
+		{name: "missing duration", scenario: "phases:\n  - name: first\n  - name: second\n    duration: 1s"},
+		{name: "repeat without duration", scenario: "repeat: true\nphases:\n  - name: first"},
+		{name: "unknown error", scenario: "phases:\n  - rules:\n      - errors: {Unknown: 0.5}"},
+		{name: "error rates above 1", scenario: "phases:\n  - rules:\n      - errors: {Timeout: 0.6, Unavailable: 0.6}"},
+		{name: "unknown distribution", scenario: "phases:\n  - rules:\n      - latency: {distribution: pareto}"},
+		{name: "invalid uniform", scenario: "phases:\n  - rules:\n      - latency: {distribution: uniform, min: 2s, max: 1s}"},
+		{name: "invalid lognormal", scenario: "phases:\n  - rules:\n      - latency: {distribution: lognormal, p50: 1s, p99: 10ms}"},
+		{name: "invalid latency rate", scenario: "phases:\n  - rules:\n      - latency: {distribution: fixed, duration: 1s, rate: 2}"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := loadScenario(writeScenario(t, tc.scenario))
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := NewFaultInjectionDatastoreFactory(&config.FaultInjection{ScenarioFile: filepath.Join(t.TempDir(), "missing.yaml")}, nil, log.NewTestLogger())
+	assert.Error(t, err)
+}
+
+func TestLatencyDistribution_LogNormal(t *testing.T) {
+	t.Parallel()
+
+	rate := 1.0
+	d, err := newLatencyDistribution(&config.FaultInjectionLatency{
+		Distribution: latencyDistributionLogNormal,
+		P50:          100 * time.Millisecond,
+		P99:          800 * time.Millisecond,
+		Rate:         &rate,
+	}, 1)
+	require.NoError(t, err)
+
+	samples := make([]time.Duration, 10000)
+	for i := range samples {
+		samples[i] = d.sample()
+	}
+	slices.Sort(samples)
+	assert.InDelta(t, float64(100*time.Millisecond), float64(samples[len(samples)/2]), float64(10*time.Millisecond))
+	assert.InDelta(t, float64(800*time.Millisecond), float64(samples[len(samples)*99/100]), float64(150*time.Millisecond))
+}
+
+func TestFault_InjectLatency(t *testing.T) {
+	t.Parallel()
+
+	var executed bool
+	op := func() error {
+		executed = true
+		return nil
+	}
+
+	f := &fault{latency: time.Millisecond}
+	require.NoError(t, f.inject(context.Background(), op))
+	assert.True(t, executed)
+
+	// A call that is slower than its context times out without being executed.
+	executed = false
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	f = &fault{latency: time.Hour}
+	err := f.inject(ctx, op)
+	var timeoutErr *persistence.TimeoutError
+	assert.ErrorAs(t, err, &timeoutErr)
+	assert.False(t, executed)
+}
+
+func TestRequestTargets(t *testing.T) {
+	t.Parallel()
+
+	shardID, ok := requestShardID(&persistence.InternalAppendHistoryNodesRequest{ShardID: 3})
+	assert.True(t, ok)
+	assert.Equal(t, int32(3), shardID)
+	_, ok = requestShardID(&persistence.InternalCreateTasksRequest{NamespaceID: "namespace-id"})
+	assert.False(t, ok)
+	_, ok = requestShardID(nil)
+	assert.False(t, ok)
+
+	namespaceID, ok := requestNamespaceID(&persistence.InternalCreateTasksRequest{NamespaceID: "namespace-id"})
+	assert.True(t, ok)
+	assert.Equal(t, "namespace-id", namespaceID)
+	namespaceID, ok = requestNamespaceID(&persistence.InternalCreateWorkflowExecutionRequest{
+		NewWorkflowSnapshot: persistence.InternalWorkflowSnapshot{NamespaceID: "namespace-id"},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "namespace-id", namespaceID)
+	_, ok = requestNamespaceID(&persistence.InternalAppendHistoryNodesRequest{})
+	assert.False(t, ok)
+}
@@ -6,7 +6,7 @@ import (
 
 type (
 	// storeFaultInjector is an implementation of faultGenerator that will inject errors into the persistence layer
-	// using runtime injectors or per-method configuration.
+	// using runtime injectors, per-method configuration or a scenario.
 	storeFaultInjector struct {
 		storeName config.DataStoreName
 		injectors []faultInjector
@@ -21,6 +21,7 @@ func newStoreFaultInjector(
 	storeName config.DataStoreName,
 	cfg *config.FaultInjectionDataStoreConfig,
 	injector config.FaultInjector,
+	scenario *scenarioInjector,
 ) (*storeFaultInjector, bool) {
 	var injectors []faultInjector
 	if injector != nil {
@@ -29,6 +30,9 @@ func newStoreFaultInjector(
 	if len(cfg.Methods) > 0 {
 		injectors = append(injectors, configuredFaultInjector(cfg))
 	}
+	if scenario != nil {
+		injectors = append(injectors, scenario.injector())
+	}
 	if len(injectors) == 0 {
 		return nil, false
 	}
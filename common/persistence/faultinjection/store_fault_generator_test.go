@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/mock"
 	"go.uber.org/mock/gomock"
@@ -25,9 +26,10 @@ func TestFaultInjection_DataStoreFactory_CreateErr(t *testing.T) {
 	errCreate := errors.New("error creating QueueV2")
 	dataStoreFactory.EXPECT().NewQueueV2().Return(nil, errCreate)
 
-	factory := NewFaultInjectionDatastoreFactory(&config.FaultInjection{}, dataStoreFactory)
+	factory, err := NewFaultInjectionDatastoreFactory(&config.FaultInjection{}, dataStoreFactory, log.NewTestLogger())
+	require.NoError(t, err)
 
-	_, err := factory.NewQueueV2()
+	_, err = factory.NewQueueV2()
 	assert.ErrorIs(t, err, errCreate)
 }
 
@@ -91,7 +93,8 @@ func TestFaultInjection_Inject(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			baseFactory := mock.NewMockDataStoreFactory(ctrl)
-			factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, baseFactory)
+			factory, err := NewFaultInjectionDatastoreFactory(faultInjectionConfig, baseFactory, log.NewTestLogger())
+			require.NoError(t, err)
 			baseQueue := mock.NewMockQueueV2(ctrl)
 			baseFactory.EXPECT().NewQueueV2().Return(baseQueue, nil)
 
@@ -144,7 +147,8 @@ func TestFaultInjection_StoreNotConfigured(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	baseFactory := mock.NewMockDataStoreFactory(ctrl)
-	factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, baseFactory)
+	factory, err := NewFaultInjectionDatastoreFactory(faultInjectionConfig, baseFactory, log.NewTestLogger())
+	require.NoError(t, err)
 	baseQueue := mock.NewMockQueueV2(ctrl)
 	baseFactory.EXPECT().NewQueueV2().Return(baseQueue, nil)
 
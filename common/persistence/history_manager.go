@@ -12,6 +12,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/softassert"
 )
@@ -200,13 +201,32 @@ findDeleteRanges:
 		}
 	}
 
+	var offloadedChunks []string
+	if m.historyTiering != nil {
+		// Wait for the offload in progress, so that its pointers are found and deleted below.
+		if err := m.historyTiering.dropPending(ctx, deleteRanges); err != nil {
+			return err
+		}
+		// Find the offloaded nodes before their pointers are deleted.
+		offloadedChunks, err = m.historyTiering.offloadedChunks(ctx, m.persistence, request.BranchToken, request.ShardID, deleteRanges)
+		if err != nil {
+			return err
+		}
+	}
+
 	req := &InternalDeleteHistoryBranchRequest{
 		BranchToken:  request.BranchToken,
 		BranchInfo:   branch,
 		ShardID:      request.ShardID,
 		BranchRanges: deleteRanges,
 	}
-	return m.persistence.DeleteHistoryBranch(ctx, req)
+	if err := m.persistence.DeleteHistoryBranch(ctx, req); err != nil {
+		return err
+	}
+	if len(offloadedChunks) > 0 {
+		m.historyTiering.deleteChunks(ctx, branch, offloadedChunks)
+	}
+	return nil
 }
 
 // TrimHistoryBranch trims a branch
@@ -321,16 +341,18 @@ func (m *executionManagerImpl) deserializeBranchInfos(
 	return branchInfos, nil
 }
 
+// serializeAppendHistoryNodesRequest also returns the size of the serialized events before
+// compression and encryption, which is what history size accounting is based on.
 func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 	request *AppendHistoryNodesRequest,
-) (*InternalAppendHistoryNodesRequest, error) {
+) (*InternalAppendHistoryNodesRequest, int, error) {
 	branch, err := m.GetHistoryBranchUtil().ParseHistoryBranchInfo(request.BranchToken)
 	if err != nil {
-		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unable to parse branch token: %v", err))
+		return nil, 0, serviceerror.NewInvalidArgument(fmt.Sprintf("unable to parse branch token: %v", err))
 	}
 
 	if len(request.Events) == 0 {
-		return nil, &InvalidPersistenceRequestError{
+		return nil, 0, &InvalidPersistenceRequestError{
 			Msg: "events to be appended cannot be empty",
 		}
 	}
@@ -341,18 +363,18 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 	lastID := nodeID - 1
 
 	if nodeID <= 0 {
-		return nil, &InvalidPersistenceRequestError{
+		return nil, 0, &InvalidPersistenceRequestError{
 			Msg: "eventID cannot be less than 1",
 		}
 	}
 	for _, e := range request.Events {
 		if e.Version != version {
-			return nil, &InvalidPersistenceRequestError{
+			return nil, 0, &InvalidPersistenceRequestError{
 				Msg: "event version must be the same inside a batch",
 			}
 		}
 		if e.EventId != lastID+1 {
-			return nil, &InvalidPersistenceRequestError{
+			return nil, 0, &InvalidPersistenceRequestError{
 				Msg: "event ID must be continous",
 			}
 		}
@@ -360,17 +382,22 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 	}
 
 	// nodeID will be the first eventID
-	blob, err := m.serializer.SerializeEvents(request.Events)
+	encoder := m.serializer.ForNamespace(request.NamespaceID)
+	plainBlob, err := encoder.SerializeEvents(request.Events)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	size := len(blob.Data)
+	size := len(plainBlob.Data)
 	sizeLimit := m.transactionSizeLimit()
 	if size > sizeLimit {
-		return nil, &TransactionSizeLimitError{
+		return nil, 0, &TransactionSizeLimitError{
 			Msg: fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, sizeLimit),
 		}
 	}
+	blob, err := encoder.EventBlobForPersistence(plainBlob)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	req := &InternalAppendHistoryNodesRequest{
 		BranchToken: request.BranchToken,
@@ -395,18 +422,18 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 			Info:        request.Info,
 		})
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		req.TreeInfo = treeInfoBlob
 	}
 
 	if nodeID < GetBeginNodeID(branch) {
-		return nil, &InvalidPersistenceRequestError{
+		return nil, 0, &InvalidPersistenceRequestError{
 			Msg: "cannot append to ancestors' nodes",
 		}
 	}
 
-	return req, nil
+	return req, size, nil
 }
 
 func (m *executionManagerImpl) serializeAppendRawHistoryNodesRequest(
@@ -440,6 +467,11 @@ func (m *executionManagerImpl) serializeAppendRawHistoryNodesRequest(
 		}
 	}
 
+	blob, err := m.serializer.ForNamespace(request.NamespaceID).EventBlobForPersistence(request.History)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &InternalAppendHistoryNodesRequest{
 		BranchToken: request.BranchToken,
 		IsNewBranch: request.IsNewBranch,
@@ -447,7 +479,7 @@ func (m *executionManagerImpl) serializeAppendRawHistoryNodesRequest(
 		BranchInfo:  branch,
 		Node: InternalHistoryNode{
 			NodeID:            nodeID,
-			Events:            request.History,
+			Events:            blob,
 			PrevTransactionID: request.PrevTransactionID,
 			TransactionID:     request.TransactionID,
 		},
@@ -483,16 +515,19 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 	request *AppendHistoryNodesRequest,
 ) (*AppendHistoryNodesResponse, error) {
 
-	req, err := m.serializeAppendHistoryNodesRequest(request)
+	req, size, err := m.serializeAppendHistoryNodesRequest(request)
 
 	if err != nil {
 		return nil, err
 	}
 
 	err = m.persistence.AppendHistoryNodes(ctx, req)
+	if err == nil && m.historyTiering != nil {
+		m.historyTiering.enqueue(req)
+	}
 
 	return &AppendHistoryNodesResponse{
-		Size: len(req.Node.Events.Data),
+		Size: size,
 	}, err
 }
 
@@ -508,6 +543,9 @@ func (m *executionManagerImpl) AppendRawHistoryNodes(
 	}
 
 	err = m.persistence.AppendHistoryNodes(ctx, req)
+	if err == nil && m.historyTiering != nil {
+		m.historyTiering.enqueue(req)
+	}
 	return &AppendHistoryNodesResponse{
 		Size: len(request.History.Data),
 	}, err
@@ -795,11 +833,20 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 	if len(nodes) > 0 {
 		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
 		for index, node := range nodes {
-			dataBlobs[index] = node.Events
 			if node.Events == nil {
 				return nil, nil, nil, nil, 0, softassert.UnexpectedDataLoss(m.logger, "no events in history node", nil)
 			}
-			dataSize += len(node.Events.Data)
+			events, err := m.historyNodeEvents(ctx, node)
+			if err != nil {
+				return nil, nil, nil, nil, 0, err
+			}
+			// Blobs leaving the persistence layer are always plain.
+			blob, err := m.serializer.EventBlobFromPersistence(events)
+			if err != nil {
+				return nil, nil, nil, nil, 0, err
+			}
+			dataBlobs[index] = blob
+			dataSize += len(blob.Data)
 			transactionIDs = append(transactionIDs, node.TransactionID)
 			nodeIDs = append(nodeIDs, node.NodeID)
 		}
@@ -887,8 +934,16 @@ func (m *executionManagerImpl) readRawHistoryBranchReverseAndFilter(
 	if len(nodes) > 0 {
 		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
 		for index, node := range nodes {
-			dataBlobs[index] = node.Events
-			dataSize += len(node.Events.Data)
+			events, err := m.historyNodeEvents(ctx, node)
+			if err != nil {
+				return nil, nil, nil, 0, err
+			}
+			blob, err := m.serializer.EventBlobFromPersistence(events)
+			if err != nil {
+				return nil, nil, nil, 0, err
+			}
+			dataBlobs[index] = blob
+			dataSize += len(blob.Data)
 			transactionIDs = append(transactionIDs, node.TransactionID)
 		}
 		lastNode := nodes[len(nodes)-1]
@@ -899,6 +954,21 @@ func (m *executionManagerImpl) readRawHistoryBranchReverseAndFilter(
 	return dataBlobs, transactionIDs, token, dataSize, nil
 }
 
+// historyNodeEvents returns the events of a history node, fetching them from the history blob store if they
+// were offloaded.
+func (m *executionManagerImpl) historyNodeEvents(
+	ctx context.Context,
+	node InternalHistoryNode,
+) (*commonpb.DataBlob, error) {
+	if !serialization.IsOffloadedBlob(node.Events) {
+		return node.Events, nil
+	}
+	if m.historyTiering == nil {
+		return nil, serviceerror.NewInternal("history node was offloaded but history tiering is not configured")
+	}
+	return m.historyTiering.fetch(ctx, node)
+}
+
 func (m *executionManagerImpl) readHistoryBranch(
 	ctx context.Context,
 	byBatch bool,
@@ -2,15 +2,22 @@ package persistence_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/mock"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives"
 	"go.uber.org/mock/gomock"
 )
 
@@ -115,6 +122,7 @@ func TestHistoryManager_InvalidBranchToken_ReturnsInvalidArgument(t *testing.T)
 				log.NewNoopLogger(),
 				dynamicconfig.GetIntPropertyFn(1024*1024),
 				dynamicconfig.GetBoolPropertyFn(false),
+				nil,
 			)
 
 			tc.testFunc(t, em, invalidBranchToken)
@@ -134,3 +142,61 @@ func requireInvalidArgumentError(t *testing.T, err error, operation string) {
 	require.Contains(t, err.Error(), "unable to parse branch token",
 		"%s error message should contain 'unable to parse branch token'", operation)
 }
+
+func TestHistoryManager_AppendHistoryNodes_ReportsUncompressedSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	serializer := serialization.NewSerializer(serialization.WithBlobCompression(
+		serialization.BlobCompressionConfig{
+			Type:    func(namespace.ID) string { return serialization.CompressionTypeZstd },
+			MinSize: dynamicconfig.GetIntPropertyFn(0),
+		},
+		metrics.NoopMetricsHandler,
+	))
+	branchUtil := p.NewHistoryBranchUtil(serializer)
+	branchToken, err := branchUtil.NewHistoryBranch("", "", "", primitives.NewUUID().String(), nil, nil, 0, 0, 0)
+	require.NoError(t, err)
+
+	store := mock.NewMockExecutionStore(ctrl)
+	store.EXPECT().GetHistoryBranchUtil().AnyTimes().Return(branchUtil)
+	var storedSize int
+	store.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *p.InternalAppendHistoryNodesRequest) error {
+			storedSize = len(request.Node.Events.Data)
+			return nil
+		})
+
+	em := p.NewExecutionManager(
+		store,
+		serializer,
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(1024*1024),
+		dynamicconfig.GetBoolPropertyFn(false),
+		nil,
+	)
+
+	events := []*historypb.HistoryEvent{{
+		EventId:   1,
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+			ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+				Result: payloads.EncodeString(strings.Repeat("result", 100)),
+			},
+		},
+	}}
+	resp, err := em.AppendHistoryNodes(context.Background(), &p.AppendHistoryNodesRequest{
+		ShardID:       1,
+		NamespaceID:   primitives.NewUUID().String(),
+		BranchToken:   branchToken,
+		Events:        events,
+		TransactionID: 1,
+	})
+	require.NoError(t, err)
+
+	// The size is the one of the events, not of the compressed blob that was stored.
+	plainBlob, err := serializer.SerializeEvents(events)
+	require.NoError(t, err)
+	require.Equal(t, len(plainBlob.Data), resp.Size)
+	require.Less(t, storedSize, resp.Size)
+}
@@ -0,0 +1,567 @@
+package persistence
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	historyChunkFormatVersion byte = 1
+
+	historyTieringPageSize          = 1000
+	historyTieringMinCacheSize      = 1024 * 1024 // 1MB
+	historyTieringOffloadedChunks   = 64 * 1024
+	historyTieringDefaultCacheBytes = 64 * 1024 * 1024 // 64MB
+	historyTieringMaxPendingChunks  = 64 * 1024
+	historyTieringMinScanInterval   = time.Second
+	historyTieringMaxScanInterval   = time.Minute
+	historyTieringOffloadTimeout    = 30 * time.Second
+)
+
+var errCorruptedHistoryChunk = errors.New("corrupted offloaded history chunk")
+
+type (
+	// HistoryBlobStore stores the history node batches offloaded by the history tiering.
+	HistoryBlobStore interface {
+		Put(ctx context.Context, key string, data []byte) error
+		// Get returns a serviceerror.NotFound if there is no blob stored under the key.
+		Get(ctx context.Context, key string) ([]byte, error)
+		// Delete does not return an error if there is no blob stored under the key.
+		Delete(ctx context.Context, key string) error
+	}
+
+	// HistoryTieringConfig enables the offloading of cold history nodes to a blob store.
+	//
+	// History node IDs are split into chunks of ChunkSize IDs. Once a branch has been appended to two chunks past
+	// a chunk, the chunk is queued and, after MinAge, a background job uploads the nodes of that chunk which belong
+	// to the branch itself (not to its ancestors) to the blob store as a single blob and replaces their rows by
+	// pointers to that blob. Reads resolve the pointers transparently, so resets, replication and archival are
+	// not affected.
+	//
+	// The queue of chunks waiting for MinAge is only kept in memory: the chunks that are queued when the process
+	// stops, or that don't fit in the queue, stay in the database until the branch is deleted.
+	HistoryTieringConfig struct {
+		BlobStore HistoryBlobStore
+		// ChunkSize is the number of node IDs covered by an offloaded blob. 0 disables the offloading, history
+		// that was already offloaded remains readable.
+		ChunkSize dynamicconfig.IntPropertyFn
+		// MinAge is how long a chunk stays in the database after its branch grew past it. 0 offloads chunks
+		// as soon as the background job picks them up.
+		MinAge dynamicconfig.DurationPropertyFn
+		// CacheSizeBytes is the size of the cache of offloaded blobs read back from the blob store.
+		CacheSizeBytes int
+	}
+
+	historyTiering struct {
+		status     int32
+		shutdownCh chan struct{}
+
+		blobStore  HistoryBlobStore
+		store      ExecutionStore
+		chunkSize  dynamicconfig.IntPropertyFn
+		minAge     dynamicconfig.DurationPropertyFn
+		timeSource clock.TimeSource
+		logger     log.Logger
+		// chunks caches the decoded chunks read from the blob store by key.
+		chunks cache.Cache
+		// offloaded remembers the historyChunkIDs that were already offloaded to avoid checking them again.
+		offloaded cache.Cache
+
+		pendingLock sync.Mutex
+		// pending holds the chunks waiting to be offloaded by the background job by branch ID.
+		pending      map[string]map[historyChunkID]*pendingHistoryChunk
+		pendingCount int
+		// offloading holds a channel, closed once the offload is done, for the branch whose chunk is being
+		// offloaded. Branch deletions wait for it so that no pointer is written after the branch is deleted.
+		offloading map[string]chan struct{}
+	}
+
+	pendingHistoryChunk struct {
+		branchToken []byte
+		branchInfo  *persistencespb.HistoryBranch
+		shardID     int32
+		// readyTime is when the chunk becomes old enough to be offloaded.
+		readyTime time.Time
+	}
+
+	historyChunkID struct {
+		branchID  string
+		beginNode int64
+		endNode   int64
+	}
+
+	historyNodeKey struct {
+		nodeID        int64
+		transactionID int64
+	}
+
+	historyChunk struct {
+		nodes map[historyNodeKey]*commonpb.DataBlob
+		size  int
+	}
+)
+
+var _ cache.SizeGetter = (*historyChunk)(nil)
+
+func newHistoryTiering(
+	config *HistoryTieringConfig,
+	store ExecutionStore,
+	logger log.Logger,
+) *historyTiering {
+	if config == nil || config.BlobStore == nil {
+		return nil
+	}
+	cacheSize := config.CacheSizeBytes
+	if cacheSize == 0 {
+		cacheSize = historyTieringDefaultCacheBytes
+	}
+	chunkSize := config.ChunkSize
+	if chunkSize == nil {
+		chunkSize = dynamicconfig.GetIntPropertyFn(0)
+	}
+	minAge := config.MinAge
+	if minAge == nil {
+		minAge = dynamicconfig.GetDurationPropertyFn(0)
+	}
+	t := &historyTiering{
+		status:     common.DaemonStatusStarted,
+		shutdownCh: make(chan struct{}),
+		blobStore:  config.BlobStore,
+		store:      store,
+		chunkSize:  chunkSize,
+		minAge:     minAge,
+		timeSource: clock.NewRealTimeSource(),
+		logger:     logger,
+		chunks:     cache.New(max(historyTieringMinCacheSize, cacheSize), nil),
+		offloaded:  cache.New(historyTieringOffloadedChunks, nil),
+		pending:    make(map[string]map[historyChunkID]*pendingHistoryChunk),
+		offloading: make(map[string]chan struct{}),
+	}
+	go t.offloadLoop()
+	return t
+}
+
+func (t *historyTiering) stop() {
+	if !atomic.CompareAndSwapInt32(&t.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(t.shutdownCh)
+}
+
+func (c *historyChunk) CacheSize() int {
+	return c.size
+}
+
+// enqueue queues the chunk two chunks behind the node that was just appended for the background job. It does
+// not access persistence, chunks that do not fit in the queue are left in the database.
+func (t *historyTiering) enqueue(request *InternalAppendHistoryNodesRequest) {
+	chunkSize := int64(t.chunkSize())
+	if chunkSize <= 0 {
+		return
+	}
+	chunkIndex := request.Node.NodeID/chunkSize - 2
+	if chunkIndex < 0 {
+		return
+	}
+	id := historyChunkID{
+		branchID:  request.BranchInfo.BranchId,
+		beginNode: max(chunkIndex*chunkSize, GetBeginNodeID(request.BranchInfo)),
+		endNode:   (chunkIndex + 1) * chunkSize,
+	}
+	if id.beginNode >= id.endNode || t.offloaded.Get(id) != nil {
+		return
+	}
+
+	t.pendingLock.Lock()
+	defer t.pendingLock.Unlock()
+	branchChunks := t.pending[id.branchID]
+	if _, ok := branchChunks[id]; ok || t.pendingCount >= historyTieringMaxPendingChunks {
+		return
+	}
+	if branchChunks == nil {
+		branchChunks = make(map[historyChunkID]*pendingHistoryChunk)
+		t.pending[id.branchID] = branchChunks
+	}
+	t.pendingCount++
+	branchChunks[id] = &pendingHistoryChunk{
+		branchToken: request.BranchToken,
+		branchInfo:  request.BranchInfo,
+		shardID:     request.ShardID,
+		readyTime:   t.timeSource.Now().Add(t.minAge()),
+	}
+}
+
+// dropPending removes the queued chunks of the branch ranges that are about to be deleted, and waits for the
+// offload of these branches that is in progress, if any.
+func (t *historyTiering) dropPending(ctx context.Context, ranges []InternalDeleteHistoryBranchRange) error {
+	var inProgress []chan struct{}
+	t.pendingLock.Lock()
+	for _, r := range ranges {
+		for id := range t.pending[r.BranchId] {
+			if id.endNode > r.BeginNodeId {
+				t.removePendingLocked(id)
+			}
+		}
+		if done, ok := t.offloading[r.BranchId]; ok {
+			inProgress = append(inProgress, done)
+		}
+	}
+	t.pendingLock.Unlock()
+
+	for _, done := range inProgress {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// startOffload marks the branch of the chunk as being offloaded, unless the chunk was dropped since it was
+// picked up because its branch is being deleted.
+func (t *historyTiering) startOffload(id historyChunkID, chunk *pendingHistoryChunk) (chan struct{}, bool) {
+	t.pendingLock.Lock()
+	defer t.pendingLock.Unlock()
+	if t.pending[id.branchID][id] != chunk {
+		return nil, false
+	}
+	done := make(chan struct{})
+	t.offloading[id.branchID] = done
+	return done, true
+}
+
+func (t *historyTiering) removePendingLocked(id historyChunkID) {
+	branchChunks, ok := t.pending[id.branchID]
+	if _, found := branchChunks[id]; !ok || !found {
+		return
+	}
+	delete(branchChunks, id)
+	t.pendingCount--
+	if len(branchChunks) == 0 {
+		delete(t.pending, id.branchID)
+	}
+}
+
+func (t *historyTiering) offloadLoop() {
+	timer := time.NewTimer(t.scanInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-t.shutdownCh:
+			return
+		case <-timer.C:
+			t.offloadReadyChunks()
+			timer.Reset(t.scanInterval())
+		}
+	}
+}
+
+func (t *historyTiering) scanInterval() time.Duration {
+	return min(max(t.minAge()/4, historyTieringMinScanInterval), historyTieringMaxScanInterval)
+}
+
+// offloadReadyChunks offloads the queued chunks that are older than MinAge. Failed chunks are retried after
+// MinAge.
+func (t *historyTiering) offloadReadyChunks() {
+	now := t.timeSource.Now()
+	t.pendingLock.Lock()
+	ready := make(map[historyChunkID]*pendingHistoryChunk)
+	for _, branchChunks := range t.pending {
+		for id, chunk := range branchChunks {
+			if !chunk.readyTime.After(now) {
+				ready[id] = chunk
+			}
+		}
+	}
+	t.pendingLock.Unlock()
+
+	for id, chunk := range ready {
+		select {
+		case <-t.shutdownCh:
+			return
+		default:
+		}
+		done, ok := t.startOffload(id, chunk)
+		if !ok {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), historyTieringOffloadTimeout)
+		err := t.offloadChunk(ctx, chunk, id)
+		cancel()
+
+		t.pendingLock.Lock()
+		delete(t.offloading, id.branchID)
+		close(done)
+		if err != nil {
+			chunk.readyTime = t.timeSource.Now().Add(t.minAge())
+		} else {
+			t.removePendingLocked(id)
+		}
+		t.pendingLock.Unlock()
+		if err != nil {
+			t.logger.Warn("Unable to offload history nodes",
+				tag.ShardID(chunk.shardID),
+				tag.WorkflowTreeID(chunk.branchInfo.TreeId),
+				tag.WorkflowBranchID(id.branchID),
+				tag.WorkflowFirstEventID(id.beginNode),
+				tag.Error(err),
+			)
+			continue
+		}
+		t.offloaded.Put(id, struct{}{})
+	}
+}
+
+func (t *historyTiering) offloadChunk(
+	ctx context.Context,
+	chunk *pendingHistoryChunk,
+	id historyChunkID,
+) error {
+	nodes, err := readAllHistoryNodes(ctx, t.store, chunk.branchToken, chunk.shardID, id.branchID, id.beginNode, id.endNode)
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		if serialization.IsOffloadedBlob(node.Events) {
+			// The chunk was offloaded before this process started.
+			return nil
+		}
+	}
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s/%s/%d-%d", chunk.branchInfo.TreeId, id.branchID, id.beginNode, id.endNode)
+	if err := t.blobStore.Put(ctx, key, encodeHistoryChunk(nodes)); err != nil {
+		return err
+	}
+	ref := encodeHistoryChunkRef(id.beginNode, key)
+	for i, node := range nodes {
+		node.Events = serialization.NewOffloadedBlob(node.Events.EncodingType, ref)
+		if err := t.store.AppendHistoryNodes(ctx, &InternalAppendHistoryNodesRequest{
+			BranchToken: chunk.branchToken,
+			BranchInfo:  chunk.branchInfo,
+			Node:        node,
+			ShardID:     chunk.shardID,
+		}); err != nil {
+			// The nodes that were replaced by pointers are readable since the blob is only deleted
+			// with the branch, the others remain in the database.
+			if i == 0 {
+				t.deleteChunks(ctx, chunk.branchInfo, []string{key})
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// fetch returns the events of a history node that was offloaded.
+func (t *historyTiering) fetch(
+	ctx context.Context,
+	node InternalHistoryNode,
+) (*commonpb.DataBlob, error) {
+	ref, _ := serialization.OffloadedBlobRef(node.Events)
+	_, key, err := decodeHistoryChunkRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	chunk, ok := t.chunks.Get(key).(*historyChunk)
+	if !ok {
+		data, err := t.blobStore.Get(ctx, key)
+		if err != nil {
+			var notFoundErr *serviceerror.NotFound
+			if errors.As(err, &notFoundErr) {
+				return nil, serviceerror.NewDataLossf("offloaded history %s not found", key)
+			}
+			return nil, err
+		}
+		chunk, err = decodeHistoryChunk(data)
+		if err != nil {
+			return nil, serviceerror.NewDataLossf("offloaded history %s: %v", key, err)
+		}
+		t.chunks.Put(key, chunk)
+	}
+	blob, ok := chunk.nodes[historyNodeKey{nodeID: node.NodeID, transactionID: node.TransactionID}]
+	if !ok {
+		return nil, serviceerror.NewDataLossf("history node %d not found in offloaded history %s", node.NodeID, key)
+	}
+	return blob, nil
+}
+
+// offloadedChunks returns the keys of the blobs that only hold nodes of the given branch ranges.
+func (t *historyTiering) offloadedChunks(
+	ctx context.Context,
+	store ExecutionStore,
+	branchToken []byte,
+	shardID int32,
+	ranges []InternalDeleteHistoryBranchRange,
+) ([]string, error) {
+	var keys []string
+	seen := make(map[string]struct{})
+	for _, r := range ranges {
+		nodes, err := readAllHistoryNodes(ctx, store, branchToken, shardID, r.BranchId, r.BeginNodeId, common.EndEventID)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			ref, ok := serialization.OffloadedBlobRef(node.Events)
+			if !ok {
+				continue
+			}
+			beginNode, key, err := decodeHistoryChunkRef(ref)
+			if err != nil {
+				return nil, err
+			}
+			// Chunks that start before the range are still referenced by the remaining nodes.
+			if _, ok := seen[key]; ok || beginNode < r.BeginNodeId {
+				continue
+			}
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// deleteChunks deletes the blobs returned by offloadedChunks once their nodes were deleted. Failures are logged
+// and leave the blob behind.
+func (t *historyTiering) deleteChunks(
+	ctx context.Context,
+	branch *persistencespb.HistoryBranch,
+	keys []string,
+) {
+	for _, key := range keys {
+		if err := t.blobStore.Delete(ctx, key); err != nil {
+			t.logger.Warn("Unable to delete offloaded history",
+				tag.WorkflowTreeID(branch.TreeId),
+				tag.WorkflowBranchID(branch.BranchId),
+				tag.Key(key),
+				tag.Error(err),
+			)
+		}
+	}
+}
+
+func readAllHistoryNodes(
+	ctx context.Context,
+	store ExecutionStore,
+	branchToken []byte,
+	shardID int32,
+	branchID string,
+	minNodeID int64,
+	maxNodeID int64,
+) ([]InternalHistoryNode, error) {
+	var nodes []InternalHistoryNode
+	var pageToken []byte
+	for {
+		resp, err := store.ReadHistoryBranch(ctx, &InternalReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			BranchID:      branchID,
+			MinNodeID:     minNodeID,
+			MaxNodeID:     maxNodeID,
+			PageSize:      historyTieringPageSize,
+			NextPageToken: pageToken,
+			ShardID:       shardID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, resp.Nodes...)
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nodes, nil
+		}
+	}
+}
+
+func encodeHistoryChunkRef(beginNode int64, key string) []byte {
+	return append(binary.AppendVarint(nil, beginNode), key...)
+}
+
+func decodeHistoryChunkRef(ref []byte) (int64, string, error) {
+	beginNode, n := binary.Varint(ref)
+	if n <= 0 || n == len(ref) {
+		return 0, "", serviceerror.NewDataLoss("corrupted offloaded history reference")
+	}
+	return beginNode, string(ref[n:]), nil
+}
+
+// encodeHistoryChunk encodes the nodes as a version byte followed by the node ID, transaction ID, encoding and
+// data of every node.
+func encodeHistoryChunk(nodes []InternalHistoryNode) []byte {
+	size := 1
+	for _, node := range nodes {
+		size += 4*binary.MaxVarintLen64 + len(node.Events.Data)
+	}
+	data := make([]byte, 1, size)
+	data[0] = historyChunkFormatVersion
+	for _, node := range nodes {
+		data = binary.AppendVarint(data, node.NodeID)
+		data = binary.AppendVarint(data, node.TransactionID)
+		data = binary.AppendUvarint(data, uint64(node.Events.EncodingType))
+		data = binary.AppendUvarint(data, uint64(len(node.Events.Data)))
+		data = append(data, node.Events.Data...)
+	}
+	return data
+}
+
+func decodeHistoryChunk(data []byte) (*historyChunk, error) {
+	if len(data) == 0 || data[0] != historyChunkFormatVersion {
+		return nil, errCorruptedHistoryChunk
+	}
+	chunk := &historyChunk{
+		nodes: make(map[historyNodeKey]*commonpb.DataBlob),
+		size:  len(data),
+	}
+	data = data[1:]
+	readVarint := func() int64 {
+		v, n := binary.Varint(data)
+		if n <= 0 {
+			data = nil
+			return -1
+		}
+		data = data[n:]
+		return v
+	}
+	readUvarint := func() uint64 {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			data = nil
+			return 0
+		}
+		data = data[n:]
+		return v
+	}
+	for len(data) > 0 {
+		key := historyNodeKey{nodeID: readVarint(), transactionID: readVarint()}
+		encodingType := enumspb.EncodingType(readUvarint())
+		size := readUvarint()
+		if data == nil || uint64(len(data)) < size {
+			return nil, errCorruptedHistoryChunk
+		}
+		chunk.nodes[key] = &commonpb.DataBlob{
+			EncodingType: encodingType,
+			Data:         data[:size:size],
+		}
+		data = data[size:]
+	}
+	return chunk, nil
+}
@@ -0,0 +1,186 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	// maxMatchingDLQNameLength is the maximum length of a matching DLQ queue name. It's the size of the queue name
+	// column of the SQL schemas. Longer task queue names are truncated and suffixed with a hash of the full name.
+	maxMatchingDLQNameLength = 255
+
+	ErrMsgDeserializeMatchingDLQTask = "failed to deserialize matching DLQ task"
+)
+
+var (
+	ErrMatchingDLQTaskIsNil   = errors.New("matching DLQ task is nil")
+	ErrInvalidMatchingDLQName = errors.New("invalid matching DLQ name, expected 3 fields")
+)
+
+func NewMatchingTaskDLQManager(queue QueueV2) *MatchingTaskDLQManagerImpl {
+	return &MatchingTaskDLQManagerImpl{
+		queue: queue,
+	}
+}
+
+func (m *MatchingTaskDLQManagerImpl) CreateQueue(
+	ctx context.Context,
+	request *CreateMatchingDLQRequest,
+) (*CreateMatchingDLQResponse, error) {
+	_, err := m.queue.CreateQueue(ctx, &InternalCreateQueueRequest{
+		QueueType: QueueTypeMatchingDLQ,
+		QueueName: request.QueueKey.GetQueueName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CreateMatchingDLQResponse{}, nil
+}
+
+func (m *MatchingTaskDLQManagerImpl) EnqueueTask(
+	ctx context.Context,
+	request *EnqueueMatchingDLQTaskRequest,
+) (*EnqueueMatchingDLQTaskResponse, error) {
+	if request.Task == nil {
+		return nil, ErrMatchingDLQTaskIsNil
+	}
+	taskBytes, err := request.Task.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	queueKey := MatchingDLQKey{
+		NamespaceID:   request.Task.GetNamespaceId(),
+		TaskQueueName: request.Task.GetTaskQueue(),
+		TaskQueueType: request.Task.GetTaskQueueType(),
+	}
+	message, err := m.queue.EnqueueMessage(ctx, &InternalEnqueueMessageRequest{
+		QueueType: QueueTypeMatchingDLQ,
+		QueueName: queueKey.GetQueueName(),
+		Blob: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         taskBytes,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EnqueueMatchingDLQTaskResponse{
+		Metadata: message.Metadata,
+	}, nil
+}
+
+func (m *MatchingTaskDLQManagerImpl) ReadTasks(
+	ctx context.Context,
+	request *ReadMatchingDLQTasksRequest,
+) (*ReadMatchingDLQTasksResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrReadTasksNonPositivePageSize, request.PageSize)
+	}
+	response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+		QueueType:     QueueTypeMatchingDLQ,
+		QueueName:     request.QueueKey.GetQueueName(),
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]MatchingDLQTask, len(response.Messages))
+	for i, message := range response.Messages {
+		task := &persistencespb.MatchingDLQTask{}
+		if err := serialization.Decode(message.Data, task); err != nil {
+			return nil, fmt.Errorf("%v: %w", ErrMsgDeserializeMatchingDLQTask, err)
+		}
+		tasks[i] = MatchingDLQTask{
+			MessageMetadata: message.MetaData,
+			Payload:         task,
+		}
+	}
+	return &ReadMatchingDLQTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+func (m *MatchingTaskDLQManagerImpl) DeleteTasks(
+	ctx context.Context,
+	request *DeleteMatchingDLQTasksRequest,
+) (*DeleteMatchingDLQTasksResponse, error) {
+	resp, err := m.queue.RangeDeleteMessages(ctx, &InternalRangeDeleteMessagesRequest{
+		QueueType:                   QueueTypeMatchingDLQ,
+		QueueName:                   request.QueueKey.GetQueueName(),
+		InclusiveMaxMessageMetadata: request.InclusiveMaxMessageMetadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteMatchingDLQTasksResponse{MessagesDeleted: resp.MessagesDeleted}, nil
+}
+
+func (m *MatchingTaskDLQManagerImpl) ListQueues(
+	ctx context.Context,
+	request *ListMatchingDLQsRequest,
+) (*ListMatchingDLQsResponse, error) {
+	resp, err := m.queue.ListQueues(ctx, &InternalListQueuesRequest{
+		QueueType:     QueueTypeMatchingDLQ,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	queueKeys := make([]MatchingDLQKey, len(resp.Queues))
+	for i, queue := range resp.Queues {
+		queueKeys[i], err = ParseMatchingDLQName(queue.QueueName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ListMatchingDLQsResponse{
+		QueueKeys:     queueKeys,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (m *MatchingTaskDLQManagerImpl) Close() {
+}
+
+// GetQueueName returns the name of the DLQ. The name can be converted back to the key with
+// ParseMatchingDLQName, except that names that don't fit the queue name column have the end of the task
+// queue name replaced with a hash.
+func (k MatchingDLQKey) GetQueueName() string {
+	name := fmt.Sprintf("%s_%d_%s", k.NamespaceID, k.TaskQueueType, k.TaskQueueName)
+	if len(name) <= maxMatchingDLQNameLength {
+		return name
+	}
+	hash := combineUnique(k.NamespaceID, k.TaskQueueName)[:clusterNamesHashSuffixLength]
+	return name[:maxMatchingDLQNameLength-len(hash)-1] + "_" + hash
+}
+
+// ParseMatchingDLQName returns the key of a DLQ from its name. If the task queue name was truncated by
+// GetQueueName, the returned task queue name is the truncated one, which still maps to the same queue.
+func ParseMatchingDLQName(queueName string) (MatchingDLQKey, error) {
+	fields := strings.SplitN(queueName, "_", 3)
+	if len(fields) != 3 {
+		return MatchingDLQKey{}, fmt.Errorf("%w: %s", ErrInvalidMatchingDLQName, queueName)
+	}
+	taskQueueType, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return MatchingDLQKey{}, fmt.Errorf("%w: %s", ErrInvalidMatchingDLQName, queueName)
+	}
+	return MatchingDLQKey{
+		NamespaceID:   fields[0],
+		TaskQueueName: fields[2],
+		TaskQueueType: enumspb.TaskQueueType(taskQueueType),
+	}, nil
+}
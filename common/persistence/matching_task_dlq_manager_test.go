@@ -0,0 +1,134 @@
+package persistence_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/persistence"
+)
+
+// TestMatchingDLQKey_Determinism tests that the queue name generated from a MatchingDLQKey is deterministic, so that
+// changes to the algorithm don't break the mapping of task queues to their DLQs.
+func TestMatchingDLQKey_Determinism(t *testing.T) {
+	name := persistence.MatchingDLQKey{
+		NamespaceID:   "ns-id",
+		TaskQueueName: "tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}.GetQueueName()
+	assert.Equal(t, "ns-id_2_tq", name)
+}
+
+func TestMatchingDLQKey_LongTaskQueueName(t *testing.T) {
+	t.Parallel()
+
+	key1 := persistence.MatchingDLQKey{
+		NamespaceID:   "ns-id",
+		TaskQueueName: strings.Repeat("a", 300) + "1",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}
+	key2 := key1
+	key2.TaskQueueName = strings.Repeat("a", 300) + "2"
+	name1 := key1.GetQueueName()
+	name2 := key2.GetQueueName()
+	assert.Len(t, name1, 255)
+	assert.Len(t, name2, 255)
+	assert.NotEqual(t, name1, name2, "Task queues with the same long prefix should have different DLQs")
+}
+
+func TestMatchingTaskDLQManager_EnqueueTask_NilTask(t *testing.T) {
+	t.Parallel()
+
+	m := persistence.NewMatchingTaskDLQManager(nil)
+	_, err := m.EnqueueTask(context.Background(), &persistence.EnqueueMatchingDLQTaskRequest{})
+	assert.ErrorIs(t, err, persistence.ErrMatchingDLQTaskIsNil)
+}
+
+func TestMatchingTaskDLQManager_ReadTasks_ErrDeserializeTask(t *testing.T) {
+	t.Parallel()
+
+	m := persistence.NewMatchingTaskDLQManager(corruptQueue{})
+	_, err := m.ReadTasks(context.Background(), &persistence.ReadMatchingDLQTasksRequest{
+		PageSize: 1,
+	})
+	assert.ErrorContains(t, err, persistence.ErrMsgDeserializeMatchingDLQTask)
+}
+
+func TestMatchingTaskDLQManager_ReadTasks_NonPositivePageSize(t *testing.T) {
+	t.Parallel()
+
+	m := persistence.NewMatchingTaskDLQManager(corruptQueue{})
+	for _, pageSize := range []int{0, -1} {
+		_, err := m.ReadTasks(context.Background(), &persistence.ReadMatchingDLQTasksRequest{
+			PageSize: pageSize,
+		})
+		assert.ErrorIs(t, err, persistence.ErrReadTasksNonPositivePageSize)
+	}
+}
+
+// listQueue is a QueueV2 that lists the DLQs of the given keys.
+type listQueue struct {
+	persistence.QueueV2
+	keys []persistence.MatchingDLQKey
+}
+
+func (q listQueue) ListQueues(
+	context.Context,
+	*persistence.InternalListQueuesRequest,
+) (*persistence.InternalListQueuesResponse, error) {
+	queues := make([]persistence.QueueInfo, len(q.keys))
+	for i, key := range q.keys {
+		queues[i] = persistence.QueueInfo{QueueName: key.GetQueueName()}
+	}
+	return &persistence.InternalListQueuesResponse{Queues: queues}, nil
+}
+
+func TestMatchingTaskDLQManager_ListQueues(t *testing.T) {
+	t.Parallel()
+
+	keys := []persistence.MatchingDLQKey{
+		{
+			NamespaceID:   "ns-id",
+			TaskQueueName: "tq",
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		},
+		{
+			NamespaceID:   "ns-id",
+			TaskQueueName: "tq_with_underscores",
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		},
+	}
+	m := persistence.NewMatchingTaskDLQManager(listQueue{keys: keys})
+	resp, err := m.ListQueues(context.Background(), &persistence.ListMatchingDLQsRequest{PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, keys, resp.QueueKeys)
+}
+
+func TestMatchingTaskDLQManager_ListQueues_LongTaskQueueName(t *testing.T) {
+	t.Parallel()
+
+	key := persistence.MatchingDLQKey{
+		NamespaceID:   "ns-id",
+		TaskQueueName: strings.Repeat("a", 300),
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}
+	m := persistence.NewMatchingTaskDLQManager(listQueue{keys: []persistence.MatchingDLQKey{key}})
+	resp, err := m.ListQueues(context.Background(), &persistence.ListMatchingDLQsRequest{PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, resp.QueueKeys, 1)
+	assert.Equal(t, key.NamespaceID, resp.QueueKeys[0].NamespaceID)
+	assert.Equal(t, key.TaskQueueType, resp.QueueKeys[0].TaskQueueType)
+	assert.Equal(t, key.GetQueueName(), resp.QueueKeys[0].GetQueueName(), "The listed key should map to the same DLQ")
+}
+
+func TestParseMatchingDLQName_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"ns-id", "ns-id_2", "ns-id_type_tq"} {
+		_, err := persistence.ParseMatchingDLQName(name)
+		assert.ErrorIs(t, err, persistence.ErrInvalidMatchingDLQName, name)
+	}
+}
@@ -0,0 +1,447 @@
+package persistence
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	RecordedShardManager     = "ShardManager"
+	RecordedExecutionManager = "ExecutionManager"
+	RecordedTaskManager      = "TaskManager"
+	RecordedFairTaskManager  = "FairTaskManager"
+
+	persistenceRecorderBufferSize    = 64 * 1024
+	persistenceRecorderCheckInterval = time.Second
+)
+
+type (
+	// RecordedCall is the anonymized shape of a persistence call. IDs are replaced by keyed hashes that are
+	// stable within a recording, so that calls on the same entity can be correlated without revealing it.
+	RecordedCall struct {
+		Time        time.Time `json:"time"`
+		Manager     string    `json:"manager"`
+		Method      string    `json:"method"`
+		ShardID     int32     `json:"shardId,omitempty"`
+		NamespaceID string    `json:"namespaceId,omitempty"`
+		WorkflowID  string    `json:"workflowId,omitempty"`
+		RunID       string    `json:"runId,omitempty"`
+		TreeID      string    `json:"treeId,omitempty"`
+		TaskQueue   string    `json:"taskQueue,omitempty"`
+		TaskType    int32     `json:"taskType,omitempty"`
+		// Count is the number of events or tasks written by the call.
+		Count int `json:"count,omitempty"`
+		// Size is the approximate number of bytes written by the call.
+		Size     int           `json:"size,omitempty"`
+		PageSize int           `json:"pageSize,omitempty"`
+		Latency  time.Duration `json:"latency"`
+		// Error is the type of the error returned by the call, if any.
+		Error string `json:"error,omitempty"`
+	}
+
+	// PersistenceRecorder writes the calls of the persistence recording clients to a new file in the directory
+	// returned by dir while it is set. Recording never blocks callers: calls are dropped if the file cannot keep up.
+	PersistenceRecorder struct {
+		dir    dynamicconfig.StringPropertyFn
+		logger log.Logger
+
+		enabled atomic.Bool
+		calls   chan RecordedCall
+		dropped atomic.Int64
+
+		stopOnce sync.Once
+		stopCh   chan struct{}
+		doneCh   chan struct{}
+
+		// Only accessed by the writer goroutine.
+		currentDir string
+		file       *os.File
+		writer     *bufio.Writer
+		encoder    *json.Encoder
+		hashKey    []byte
+	}
+)
+
+// NewPersistenceRecorder creates a PersistenceRecorder, Start must be called before calls are recorded.
+func NewPersistenceRecorder(dir dynamicconfig.StringPropertyFn, logger log.Logger) *PersistenceRecorder {
+	return &PersistenceRecorder{
+		dir:    dir,
+		logger: logger,
+		calls:  make(chan RecordedCall, persistenceRecorderBufferSize),
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+}
+
+// ReadRecordedCalls calls fn with every call of a recording.
+func ReadRecordedCalls(r io.Reader, fn func(RecordedCall) error) error {
+	decoder := json.NewDecoder(bufio.NewReader(r))
+	for {
+		var call RecordedCall
+		if err := decoder.Decode(&call); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := fn(call); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *PersistenceRecorder) Start() {
+	go r.run()
+}
+
+// Stop flushes and closes the current recording.
+func (r *PersistenceRecorder) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.stopCh)
+		<-r.doneCh
+	})
+}
+
+// record records a call that started at startTime and returned err. It must be deferred with the request
+// the call was made with.
+func (r *PersistenceRecorder) record(manager string, method string, request any, startTime time.Time, err *error) {
+	if !r.enabled.Load() {
+		return
+	}
+	call := recordedCallShape(request)
+	call.Time = startTime
+	call.Manager = manager
+	call.Method = method
+	call.Latency = time.Since(startTime)
+	if *err != nil {
+		call.Error = errorTypeName(*err)
+	}
+	select {
+	case r.calls <- call:
+	default:
+		r.dropped.Add(1)
+	}
+}
+
+func (r *PersistenceRecorder) run() {
+	defer close(r.doneCh)
+	ticker := time.NewTicker(persistenceRecorderCheckInterval)
+	defer ticker.Stop()
+
+	r.checkDir()
+	for {
+		select {
+		case call := <-r.calls:
+			r.write(call)
+		case <-ticker.C:
+			r.checkDir()
+			r.flush()
+		case <-r.stopCh:
+			r.enabled.Store(false)
+			for len(r.calls) > 0 {
+				r.write(<-r.calls)
+			}
+			r.closeFile()
+			return
+		}
+	}
+}
+
+// checkDir starts, stops or switches the recording when the directory changes.
+func (r *PersistenceRecorder) checkDir() {
+	dir := r.dir()
+	if dir == r.currentDir {
+		return
+	}
+	r.closeFile()
+	r.currentDir = dir
+	if dir == "" {
+		r.enabled.Store(false)
+		return
+	}
+
+	hashKey := make([]byte, 32)
+	_, _ = rand.Read(hashKey)
+	// The file name uses its own random ID so that it does not reveal any part of the hash key.
+	fileID := make([]byte, 4)
+	_, _ = rand.Read(fileID)
+	name := fmt.Sprintf("persistence-%s-%d-%s.jsonl",
+		time.Now().UTC().Format("20060102T150405Z"), os.Getpid(), hex.EncodeToString(fileID))
+	file, err := os.OpenFile(filepath.Join(dir, name), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		r.logger.Error("Unable to start persistence recording", tag.Error(err))
+		r.enabled.Store(false)
+		return
+	}
+	r.file = file
+	r.writer = bufio.NewWriter(file)
+	r.encoder = json.NewEncoder(r.writer)
+	r.hashKey = hashKey
+	r.enabled.Store(true)
+	r.logger.Info("Started persistence recording", tag.NewStringTag("file", file.Name()))
+}
+
+func (r *PersistenceRecorder) write(call RecordedCall) {
+	if r.encoder == nil {
+		return
+	}
+	call.NamespaceID = r.anonymize(call.NamespaceID)
+	call.WorkflowID = r.anonymize(call.WorkflowID)
+	call.RunID = r.anonymize(call.RunID)
+	call.TreeID = r.anonymize(call.TreeID)
+	call.TaskQueue = r.anonymize(call.TaskQueue)
+	if err := r.encoder.Encode(call); err != nil {
+		r.logger.Error("Unable to write persistence recording", tag.Error(err))
+		r.closeFile()
+	}
+}
+
+func (r *PersistenceRecorder) flush() {
+	if r.writer != nil {
+		if err := r.writer.Flush(); err != nil {
+			r.logger.Error("Unable to write persistence recording", tag.Error(err))
+			r.closeFile()
+		}
+	}
+	if dropped := r.dropped.Swap(0); dropped > 0 {
+		r.logger.Warn("Persistence recording dropped calls", tag.Counter(int(dropped)))
+	}
+}
+
+func (r *PersistenceRecorder) closeFile() {
+	if r.file == nil {
+		return
+	}
+	r.enabled.Store(false)
+	if err := r.writer.Flush(); err != nil {
+		r.logger.Error("Unable to write persistence recording", tag.Error(err))
+	}
+	if err := r.file.Close(); err != nil {
+		r.logger.Error("Unable to close persistence recording", tag.Error(err))
+	}
+	r.logger.Info("Stopped persistence recording", tag.NewStringTag("file", r.file.Name()))
+	r.file = nil
+	r.writer = nil
+	r.encoder = nil
+}
+
+func (r *PersistenceRecorder) anonymize(id string) string {
+	if id == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, r.hashKey)
+	_, _ = mac.Write([]byte(id))
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+func errorTypeName(err error) string {
+	t := reflect.TypeOf(err)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.String()
+}
+
+// recordedCallShape extracts the IDs and sizes of a request.
+func recordedCallShape(request any) RecordedCall {
+	var call RecordedCall
+	if value := reflect.ValueOf(request); value.Kind() != reflect.Pointer || value.IsNil() {
+		return call
+	}
+	switch request := request.(type) {
+	case *GetOrCreateShardRequest:
+		call.ShardID = request.ShardID
+	case *UpdateShardRequest:
+		call.ShardID = request.ShardInfo.GetShardId()
+		call.Size = proto.Size(request.ShardInfo)
+	case *AssertShardOwnershipRequest:
+		call.ShardID = request.ShardID
+
+	case *CreateWorkflowExecutionRequest:
+		call.ShardID = request.ShardID
+		snapshotShape(&call, &request.NewWorkflowSnapshot)
+	case *UpdateWorkflowExecutionRequest:
+		call.ShardID = request.ShardID
+		info, state := request.UpdateWorkflowMutation.ExecutionInfo, request.UpdateWorkflowMutation.ExecutionState
+		executionShape(&call, info, state)
+		call.Count = countHistoryTasks(request.UpdateWorkflowMutation.Tasks)
+		if request.NewWorkflowSnapshot != nil {
+			call.Count += countHistoryTasks(request.NewWorkflowSnapshot.Tasks)
+		}
+	case *ConflictResolveWorkflowExecutionRequest:
+		call.ShardID = request.ShardID
+		snapshotShape(&call, &request.ResetWorkflowSnapshot)
+	case *SetWorkflowExecutionRequest:
+		call.ShardID = request.ShardID
+		snapshotShape(&call, &request.SetWorkflowSnapshot)
+	case *DeleteWorkflowExecutionRequest:
+		call.ShardID = request.ShardID
+		call.NamespaceID, call.WorkflowID, call.RunID = request.NamespaceID, request.WorkflowID, request.RunID
+	case *DeleteCurrentWorkflowExecutionRequest:
+		call.ShardID = request.ShardID
+		call.NamespaceID, call.WorkflowID, call.RunID = request.NamespaceID, request.WorkflowID, request.RunID
+	case *GetCurrentExecutionRequest:
+		call.ShardID = request.ShardID
+		call.NamespaceID, call.WorkflowID = request.NamespaceID, request.WorkflowID
+	case *GetWorkflowExecutionRequest:
+		call.ShardID = request.ShardID
+		call.NamespaceID, call.WorkflowID, call.RunID = request.NamespaceID, request.WorkflowID, request.RunID
+	case *ListConcreteExecutionsRequest:
+		call.ShardID = request.ShardID
+		call.PageSize = request.PageSize
+	case *AddHistoryTasksRequest:
+		call.ShardID = request.ShardID
+		call.NamespaceID, call.WorkflowID = request.NamespaceID, request.WorkflowID
+		call.Count = countHistoryTasks(request.Tasks)
+	case *GetHistoryTasksRequest:
+		call.ShardID = request.ShardID
+		call.PageSize = request.BatchSize
+	case *CompleteHistoryTaskRequest:
+		call.ShardID = request.ShardID
+	case *RangeCompleteHistoryTasksRequest:
+		call.ShardID = request.ShardID
+	case *PutReplicationTaskToDLQRequest:
+		call.ShardID = request.ShardID
+	case *GetReplicationTasksFromDLQRequest:
+		call.ShardID = request.ShardID
+		call.PageSize = request.BatchSize
+	case *DeleteReplicationTaskFromDLQRequest:
+		call.ShardID = request.ShardID
+	case *RangeDeleteReplicationTaskFromDLQRequest:
+		call.ShardID = request.ShardID
+	case *AppendHistoryNodesRequest:
+		call.ShardID = request.ShardID
+		call.NamespaceID = request.NamespaceID
+		call.TreeID = branchTokenTreeID(request.BranchToken)
+		call.Count = len(request.Events)
+		for _, event := range request.Events {
+			call.Size += proto.Size(event)
+		}
+	case *AppendRawHistoryNodesRequest:
+		call.ShardID = request.ShardID
+		call.NamespaceID = request.NamespaceID
+		call.TreeID = branchTokenTreeID(request.BranchToken)
+		call.Size = len(request.History.GetData())
+	case *ReadHistoryBranchRequest:
+		call.ShardID = request.ShardID
+		call.TreeID = branchTokenTreeID(request.BranchToken)
+		call.PageSize = request.PageSize
+	case *ReadHistoryBranchReverseRequest:
+		call.ShardID = request.ShardID
+		call.TreeID = branchTokenTreeID(request.BranchToken)
+		call.PageSize = request.PageSize
+	case *ForkHistoryBranchRequest:
+		call.ShardID = request.ShardID
+		call.NamespaceID = request.NamespaceID
+		call.TreeID = branchTokenTreeID(request.ForkBranchToken)
+	case *DeleteHistoryBranchRequest:
+		call.ShardID = request.ShardID
+		call.TreeID = branchTokenTreeID(request.BranchToken)
+	case *TrimHistoryBranchRequest:
+		call.ShardID = request.ShardID
+		call.TreeID = branchTokenTreeID(request.BranchToken)
+	case *GetAllHistoryTreeBranchesRequest:
+		call.PageSize = request.PageSize
+
+	case *CreateTaskQueueRequest:
+		taskQueueInfoShape(&call, request.TaskQueueInfo)
+	case *UpdateTaskQueueRequest:
+		taskQueueInfoShape(&call, request.TaskQueueInfo)
+		call.Size = proto.Size(request.TaskQueueInfo)
+	case *GetTaskQueueRequest:
+		call.NamespaceID, call.TaskQueue, call.TaskType = request.NamespaceID, request.TaskQueue, int32(request.TaskType)
+	case *ListTaskQueueRequest:
+		call.PageSize = request.PageSize
+	case *DeleteTaskQueueRequest:
+		if request.TaskQueue != nil {
+			call.NamespaceID = request.TaskQueue.NamespaceID
+			call.TaskQueue = request.TaskQueue.TaskQueueName
+			call.TaskType = int32(request.TaskQueue.TaskQueueType)
+		}
+	case *CreateTasksRequest:
+		if request.TaskQueueInfo != nil {
+			taskQueueInfoShape(&call, request.TaskQueueInfo.Data)
+		}
+		call.Count = len(request.Tasks)
+		for _, task := range request.Tasks {
+			call.Size += proto.Size(task)
+		}
+	case *GetTasksRequest:
+		call.NamespaceID, call.TaskQueue, call.TaskType = request.NamespaceID, request.TaskQueue, int32(request.TaskType)
+		call.PageSize = request.PageSize
+	case *CompleteTasksLessThanRequest:
+		call.NamespaceID, call.TaskQueue, call.TaskType = request.NamespaceID, request.TaskQueueName, int32(request.TaskType)
+		call.PageSize = request.Limit
+	case *GetTaskQueueUserDataRequest:
+		call.NamespaceID, call.TaskQueue = request.NamespaceID, request.TaskQueue
+	case *UpdateTaskQueueUserDataRequest:
+		call.NamespaceID = request.NamespaceID
+		call.Count = len(request.Updates)
+	case *ListTaskQueueUserDataEntriesRequest:
+		call.NamespaceID = request.NamespaceID
+		call.PageSize = request.PageSize
+	case *GetTaskQueuesByBuildIdRequest:
+		call.NamespaceID = request.NamespaceID
+	case *CountTaskQueuesByBuildIdRequest:
+		call.NamespaceID = request.NamespaceID
+	}
+	return call
+}
+
+func snapshotShape(call *RecordedCall, snapshot *WorkflowSnapshot) {
+	executionShape(call, snapshot.ExecutionInfo, snapshot.ExecutionState)
+	call.Count = countHistoryTasks(snapshot.Tasks)
+}
+
+func executionShape(
+	call *RecordedCall,
+	info *persistencespb.WorkflowExecutionInfo,
+	state *persistencespb.WorkflowExecutionState,
+) {
+	call.NamespaceID = info.GetNamespaceId()
+	call.WorkflowID = info.GetWorkflowId()
+	call.RunID = state.GetRunId()
+	call.Size = proto.Size(info)
+}
+
+func taskQueueInfoShape(call *RecordedCall, info *persistencespb.TaskQueueInfo) {
+	call.NamespaceID = info.GetNamespaceId()
+	call.TaskQueue = info.GetName()
+	call.TaskType = int32(info.GetTaskType())
+}
+
+func countHistoryTasks(tasksByCategory map[tasks.Category][]tasks.Task) int {
+	var count int
+	for _, categoryTasks := range tasksByCategory {
+		count += len(categoryTasks)
+	}
+	return count
+}
+
+func branchTokenTreeID(branchToken []byte) string {
+	branch := &persistencespb.HistoryBranch{}
+	if err := proto.Unmarshal(branchToken, branch); err != nil {
+		return ""
+	}
+	return branch.GetTreeId()
+}
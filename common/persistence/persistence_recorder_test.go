@@ -0,0 +1,100 @@
+package persistence_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPersistenceRecorder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	dir := t.TempDir()
+	var recordingDir atomic.Value
+	recordingDir.Store("")
+
+	recorder := persistence.NewPersistenceRecorder(func() string { return recordingDir.Load().(string) }, log.NewTestLogger())
+	recorder.Start()
+
+	shardManager := persistence.NewMockShardManager(ctrl)
+	shardManager.EXPECT().GetOrCreateShard(gomock.Any(), gomock.Any()).Return(&persistence.GetOrCreateShardResponse{}, nil).AnyTimes()
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &persistence.ShardOwnershipLostError{}).AnyTimes()
+	taskManager := persistence.NewMockTaskManager(ctrl)
+	taskManager.EXPECT().CreateTasks(gomock.Any(), gomock.Any()).Return(&persistence.CreateTasksResponse{}, nil).AnyTimes()
+	shardClient := persistence.NewShardPersistenceRecordingClient(shardManager, recorder)
+	executionClient := persistence.NewExecutionPersistenceRecordingClient(executionManager, recorder)
+	taskClient := persistence.NewTaskPersistenceRecordingClient(taskManager, recorder, persistence.RecordedFairTaskManager)
+
+	// Nothing is recorded until the directory is set.
+	_, err := shardClient.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{ShardID: 7})
+	require.NoError(t, err)
+	recordingDir.Store(dir)
+	var files []string
+	require.Eventually(t, func() bool {
+		files, _ = filepath.Glob(filepath.Join(dir, "persistence-*.jsonl"))
+		return len(files) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, err = shardClient.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{ShardID: 3})
+	require.NoError(t, err)
+	for range 2 {
+		_, err = executionClient.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+			ShardID:     3,
+			NamespaceID: "namespace-id",
+			WorkflowID:  "workflow-id",
+			RunID:       "run-id",
+		})
+		require.Error(t, err)
+	}
+	_, err = taskClient.CreateTasks(ctx, &persistence.CreateTasksRequest{
+		TaskQueueInfo: &persistence.PersistedTaskQueueInfo{
+			Data: &persistencespb.TaskQueueInfo{NamespaceId: "namespace-id", Name: "task-queue", TaskType: 1},
+		},
+		Tasks: []*persistencespb.AllocatedTaskInfo{{TaskId: 1}, {TaskId: 2}},
+	})
+	require.NoError(t, err)
+	recorder.Stop()
+
+	content, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.NotContains(t, string(content), "namespace-id")
+	require.NotContains(t, string(content), "workflow-id")
+	require.NotContains(t, string(content), "task-queue")
+	var calls []persistence.RecordedCall
+	require.NoError(t, persistence.ReadRecordedCalls(bytes.NewReader(content), func(call persistence.RecordedCall) error {
+		calls = append(calls, call)
+		return nil
+	}))
+	require.Len(t, calls, 4)
+
+	require.Equal(t, persistence.RecordedShardManager, calls[0].Manager)
+	require.Equal(t, "GetOrCreateShard", calls[0].Method)
+	require.Equal(t, int32(3), calls[0].ShardID)
+	require.Empty(t, calls[0].Error)
+
+	require.Equal(t, persistence.RecordedExecutionManager, calls[1].Manager)
+	require.Equal(t, "GetWorkflowExecution", calls[1].Method)
+	require.Equal(t, "persistence.ShardOwnershipLostError", calls[1].Error)
+	require.NotEmpty(t, calls[1].WorkflowID)
+	require.Equal(t, calls[1].WorkflowID, calls[2].WorkflowID)
+	require.Equal(t, calls[1].NamespaceID, calls[3].NamespaceID)
+	require.False(t, calls[2].Time.Before(calls[1].Time))
+
+	require.Equal(t, persistence.RecordedFairTaskManager, calls[3].Manager)
+	require.Equal(t, "CreateTasks", calls[3].Method)
+	require.NotEmpty(t, calls[3].TaskQueue)
+	require.Equal(t, int32(1), calls[3].TaskType)
+	require.Equal(t, 2, calls[3].Count)
+}
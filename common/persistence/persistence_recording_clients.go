@@ -0,0 +1,436 @@
+package persistence
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	shardRecordingClient struct {
+		recorder    *PersistenceRecorder
+		persistence ShardManager
+	}
+
+	executionRecordingClient struct {
+		recorder    *PersistenceRecorder
+		persistence ExecutionManager
+	}
+
+	taskRecordingClient struct {
+		recorder    *PersistenceRecorder
+		manager     string
+		persistence TaskManager
+	}
+)
+
+var _ ShardManager = (*shardRecordingClient)(nil)
+var _ ExecutionManager = (*executionRecordingClient)(nil)
+var _ TaskManager = (*taskRecordingClient)(nil)
+
+// NewShardPersistenceRecordingClient creates a client to record shard manager calls
+func NewShardPersistenceRecordingClient(persistence ShardManager, recorder *PersistenceRecorder) ShardManager {
+	return &shardRecordingClient{
+		recorder:    recorder,
+		persistence: persistence,
+	}
+}
+
+// NewExecutionPersistenceRecordingClient creates a client to record execution manager calls
+func NewExecutionPersistenceRecordingClient(persistence ExecutionManager, recorder *PersistenceRecorder) ExecutionManager {
+	return &executionRecordingClient{
+		recorder:    recorder,
+		persistence: persistence,
+	}
+}
+
+// NewTaskPersistenceRecordingClient creates a client to record task manager calls, manager is the name the calls
+// are recorded under
+func NewTaskPersistenceRecordingClient(persistence TaskManager, recorder *PersistenceRecorder, manager string) TaskManager {
+	return &taskRecordingClient{
+		recorder:    recorder,
+		manager:     manager,
+		persistence: persistence,
+	}
+}
+
+func (p *shardRecordingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardRecordingClient) GetOrCreateShard(
+	ctx context.Context,
+	request *GetOrCreateShardRequest,
+) (_ *GetOrCreateShardResponse, retErr error) {
+	defer p.recorder.record(RecordedShardManager, "GetOrCreateShard", request, time.Now().UTC(), &retErr)
+	return p.persistence.GetOrCreateShard(ctx, request)
+}
+
+func (p *shardRecordingClient) UpdateShard(
+	ctx context.Context,
+	request *UpdateShardRequest,
+) (retErr error) {
+	defer p.recorder.record(RecordedShardManager, "UpdateShard", request, time.Now().UTC(), &retErr)
+	return p.persistence.UpdateShard(ctx, request)
+}
+
+func (p *shardRecordingClient) AssertShardOwnership(
+	ctx context.Context,
+	request *AssertShardOwnershipRequest,
+) (retErr error) {
+	defer p.recorder.record(RecordedShardManager, "AssertShardOwnership", request, time.Now().UTC(), &retErr)
+	return p.persistence.AssertShardOwnership(ctx, request)
+}
+
+func (p *shardRecordingClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *executionRecordingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *executionRecordingClient) GetHistoryBranchUtil() HistoryBranchUtil {
+	return p.persistence.GetHistoryBranchUtil()
+}
+
+func (p *executionRecordingClient) CreateWorkflowExecution(
+	ctx context.Context,
+	request *CreateWorkflowExecutionRequest,
+) (_ *CreateWorkflowExecutionResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "CreateWorkflowExecution", request, time.Now().UTC(), &retErr)
+	return p.persistence.CreateWorkflowExecution(ctx, request)
+}
+
+func (p *executionRecordingClient) GetWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (_ *GetWorkflowExecutionResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "GetWorkflowExecution", request, time.Now().UTC(), &retErr)
+	return p.persistence.GetWorkflowExecution(ctx, request)
+}
+
+func (p *executionRecordingClient) SetWorkflowExecution(
+	ctx context.Context,
+	request *SetWorkflowExecutionRequest,
+) (_ *SetWorkflowExecutionResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "SetWorkflowExecution", request, time.Now().UTC(), &retErr)
+	return p.persistence.SetWorkflowExecution(ctx, request)
+}
+
+func (p *executionRecordingClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *UpdateWorkflowExecutionRequest,
+) (_ *UpdateWorkflowExecutionResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "UpdateWorkflowExecution", request, time.Now().UTC(), &retErr)
+	return p.persistence.UpdateWorkflowExecution(ctx, request)
+}
+
+func (p *executionRecordingClient) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *ConflictResolveWorkflowExecutionRequest,
+) (_ *ConflictResolveWorkflowExecutionResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "ConflictResolveWorkflowExecution", request, time.Now().UTC(), &retErr)
+	return p.persistence.ConflictResolveWorkflowExecution(ctx, request)
+}
+
+func (p *executionRecordingClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *DeleteWorkflowExecutionRequest,
+) (retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "DeleteWorkflowExecution", request, time.Now().UTC(), &retErr)
+	return p.persistence.DeleteWorkflowExecution(ctx, request)
+}
+
+func (p *executionRecordingClient) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *DeleteCurrentWorkflowExecutionRequest,
+) (retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "DeleteCurrentWorkflowExecution", request, time.Now().UTC(), &retErr)
+	return p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
+}
+
+func (p *executionRecordingClient) GetCurrentExecution(
+	ctx context.Context,
+	request *GetCurrentExecutionRequest,
+) (_ *GetCurrentExecutionResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "GetCurrentExecution", request, time.Now().UTC(), &retErr)
+	return p.persistence.GetCurrentExecution(ctx, request)
+}
+
+func (p *executionRecordingClient) ListConcreteExecutions(
+	ctx context.Context,
+	request *ListConcreteExecutionsRequest,
+) (_ *ListConcreteExecutionsResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "ListConcreteExecutions", request, time.Now().UTC(), &retErr)
+	return p.persistence.ListConcreteExecutions(ctx, request)
+}
+
+func (p *executionRecordingClient) AddHistoryTasks(
+	ctx context.Context,
+	request *AddHistoryTasksRequest,
+) (retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "AddHistoryTasks", request, time.Now().UTC(), &retErr)
+	return p.persistence.AddHistoryTasks(ctx, request)
+}
+
+func (p *executionRecordingClient) GetHistoryTasks(
+	ctx context.Context,
+	request *GetHistoryTasksRequest,
+) (_ *GetHistoryTasksResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "GetHistoryTasks", request, time.Now().UTC(), &retErr)
+	return p.persistence.GetHistoryTasks(ctx, request)
+}
+
+func (p *executionRecordingClient) CompleteHistoryTask(
+	ctx context.Context,
+	request *CompleteHistoryTaskRequest,
+) (retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "CompleteHistoryTask", request, time.Now().UTC(), &retErr)
+	return p.persistence.CompleteHistoryTask(ctx, request)
+}
+
+func (p *executionRecordingClient) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *RangeCompleteHistoryTasksRequest,
+) (retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "RangeCompleteHistoryTasks", request, time.Now().UTC(), &retErr)
+	return p.persistence.RangeCompleteHistoryTasks(ctx, request)
+}
+
+func (p *executionRecordingClient) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *PutReplicationTaskToDLQRequest,
+) (retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "PutReplicationTaskToDLQ", request, time.Now().UTC(), &retErr)
+	return p.persistence.PutReplicationTaskToDLQ(ctx, request)
+}
+
+func (p *executionRecordingClient) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *GetReplicationTasksFromDLQRequest,
+) (_ *GetHistoryTasksResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "GetReplicationTasksFromDLQ", request, time.Now().UTC(), &retErr)
+	return p.persistence.GetReplicationTasksFromDLQ(ctx, request)
+}
+
+func (p *executionRecordingClient) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *DeleteReplicationTaskFromDLQRequest,
+) (retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "DeleteReplicationTaskFromDLQ", request, time.Now().UTC(), &retErr)
+	return p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (p *executionRecordingClient) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) (retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "RangeDeleteReplicationTaskFromDLQ", request, time.Now().UTC(), &retErr)
+	return p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (p *executionRecordingClient) IsReplicationDLQEmpty(
+	ctx context.Context,
+	request *GetReplicationTasksFromDLQRequest,
+) (_ bool, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "IsReplicationDLQEmpty", request, time.Now().UTC(), &retErr)
+	return p.persistence.IsReplicationDLQEmpty(ctx, request)
+}
+
+func (p *executionRecordingClient) Close() {
+	p.persistence.Close()
+}
+
+// AppendHistoryNodes add a node to history node table
+func (p *executionRecordingClient) AppendHistoryNodes(
+	ctx context.Context,
+	request *AppendHistoryNodesRequest,
+) (_ *AppendHistoryNodesResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "AppendHistoryNodes", request, time.Now().UTC(), &retErr)
+	return p.persistence.AppendHistoryNodes(ctx, request)
+}
+
+// AppendRawHistoryNodes add a node to history node table
+func (p *executionRecordingClient) AppendRawHistoryNodes(
+	ctx context.Context,
+	request *AppendRawHistoryNodesRequest,
+) (_ *AppendHistoryNodesResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "AppendRawHistoryNodes", request, time.Now().UTC(), &retErr)
+	return p.persistence.AppendRawHistoryNodes(ctx, request)
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (p *executionRecordingClient) ReadHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (_ *ReadHistoryBranchResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "ReadHistoryBranch", request, time.Now().UTC(), &retErr)
+	return p.persistence.ReadHistoryBranch(ctx, request)
+}
+
+func (p *executionRecordingClient) ReadHistoryBranchReverse(
+	ctx context.Context,
+	request *ReadHistoryBranchReverseRequest,
+) (_ *ReadHistoryBranchReverseResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "ReadHistoryBranchReverse", request, time.Now().UTC(), &retErr)
+	return p.persistence.ReadHistoryBranchReverse(ctx, request)
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch ByBatch
+func (p *executionRecordingClient) ReadHistoryBranchByBatch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (_ *ReadHistoryBranchByBatchResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "ReadHistoryBranchByBatch", request, time.Now().UTC(), &retErr)
+	return p.persistence.ReadHistoryBranchByBatch(ctx, request)
+}
+
+// ReadRawHistoryBranch returns history node raw data for a branch ByBatch
+func (p *executionRecordingClient) ReadRawHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (_ *ReadRawHistoryBranchResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "ReadRawHistoryBranch", request, time.Now().UTC(), &retErr)
+	return p.persistence.ReadRawHistoryBranch(ctx, request)
+}
+
+// ForkHistoryBranch forks a new branch from an old branch
+func (p *executionRecordingClient) ForkHistoryBranch(
+	ctx context.Context,
+	request *ForkHistoryBranchRequest,
+) (_ *ForkHistoryBranchResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "ForkHistoryBranch", request, time.Now().UTC(), &retErr)
+	return p.persistence.ForkHistoryBranch(ctx, request)
+}
+
+// DeleteHistoryBranch removes a branch
+func (p *executionRecordingClient) DeleteHistoryBranch(
+	ctx context.Context,
+	request *DeleteHistoryBranchRequest,
+) (retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "DeleteHistoryBranch", request, time.Now().UTC(), &retErr)
+	return p.persistence.DeleteHistoryBranch(ctx, request)
+}
+
+// TrimHistoryBranch trims a branch
+func (p *executionRecordingClient) TrimHistoryBranch(
+	ctx context.Context,
+	request *TrimHistoryBranchRequest,
+) (_ *TrimHistoryBranchResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "TrimHistoryBranch", request, time.Now().UTC(), &retErr)
+	return p.persistence.TrimHistoryBranch(ctx, request)
+}
+
+func (p *executionRecordingClient) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *GetAllHistoryTreeBranchesRequest,
+) (_ *GetAllHistoryTreeBranchesResponse, retErr error) {
+	defer p.recorder.record(RecordedExecutionManager, "GetAllHistoryTreeBranches", request, time.Now().UTC(), &retErr)
+	return p.persistence.GetAllHistoryTreeBranches(ctx, request)
+}
+
+func (p *taskRecordingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskRecordingClient) CreateTasks(
+	ctx context.Context,
+	request *CreateTasksRequest,
+) (_ *CreateTasksResponse, retErr error) {
+	defer p.recorder.record(p.manager, "CreateTasks", request, time.Now().UTC(), &retErr)
+	return p.persistence.CreateTasks(ctx, request)
+}
+
+func (p *taskRecordingClient) GetTasks(
+	ctx context.Context,
+	request *GetTasksRequest,
+) (_ *GetTasksResponse, retErr error) {
+	defer p.recorder.record(p.manager, "GetTasks", request, time.Now().UTC(), &retErr)
+	return p.persistence.GetTasks(ctx, request)
+}
+
+func (p *taskRecordingClient) CompleteTasksLessThan(
+	ctx context.Context,
+	request *CompleteTasksLessThanRequest,
+) (_ int, retErr error) {
+	defer p.recorder.record(p.manager, "CompleteTasksLessThan", request, time.Now().UTC(), &retErr)
+	return p.persistence.CompleteTasksLessThan(ctx, request)
+}
+
+func (p *taskRecordingClient) CreateTaskQueue(
+	ctx context.Context,
+	request *CreateTaskQueueRequest,
+) (_ *CreateTaskQueueResponse, retErr error) {
+	defer p.recorder.record(p.manager, "CreateTaskQueue", request, time.Now().UTC(), &retErr)
+	return p.persistence.CreateTaskQueue(ctx, request)
+}
+
+func (p *taskRecordingClient) UpdateTaskQueue(
+	ctx context.Context,
+	request *UpdateTaskQueueRequest,
+) (_ *UpdateTaskQueueResponse, retErr error) {
+	defer p.recorder.record(p.manager, "UpdateTaskQueue", request, time.Now().UTC(), &retErr)
+	return p.persistence.UpdateTaskQueue(ctx, request)
+}
+
+func (p *taskRecordingClient) GetTaskQueue(
+	ctx context.Context,
+	request *GetTaskQueueRequest,
+) (_ *GetTaskQueueResponse, retErr error) {
+	defer p.recorder.record(p.manager, "GetTaskQueue", request, time.Now().UTC(), &retErr)
+	return p.persistence.GetTaskQueue(ctx, request)
+}
+
+func (p *taskRecordingClient) ListTaskQueue(
+	ctx context.Context,
+	request *ListTaskQueueRequest,
+) (_ *ListTaskQueueResponse, retErr error) {
+	defer p.recorder.record(p.manager, "ListTaskQueue", request, time.Now().UTC(), &retErr)
+	return p.persistence.ListTaskQueue(ctx, request)
+}
+
+func (p *taskRecordingClient) DeleteTaskQueue(
+	ctx context.Context,
+	request *DeleteTaskQueueRequest,
+) (retErr error) {
+	defer p.recorder.record(p.manager, "DeleteTaskQueue", request, time.Now().UTC(), &retErr)
+	return p.persistence.DeleteTaskQueue(ctx, request)
+}
+
+func (p *taskRecordingClient) GetTaskQueueUserData(
+	ctx context.Context,
+	request *GetTaskQueueUserDataRequest,
+) (_ *GetTaskQueueUserDataResponse, retErr error) {
+	defer p.recorder.record(p.manager, "GetTaskQueueUserData", request, time.Now().UTC(), &retErr)
+	return p.persistence.GetTaskQueueUserData(ctx, request)
+}
+
+func (p *taskRecordingClient) UpdateTaskQueueUserData(
+	ctx context.Context,
+	request *UpdateTaskQueueUserDataRequest,
+) (retErr error) {
+	defer p.recorder.record(p.manager, "UpdateTaskQueueUserData", request, time.Now().UTC(), &retErr)
+	return p.persistence.UpdateTaskQueueUserData(ctx, request)
+}
+
+func (p *taskRecordingClient) ListTaskQueueUserDataEntries(
+	ctx context.Context,
+	request *ListTaskQueueUserDataEntriesRequest,
+) (_ *ListTaskQueueUserDataEntriesResponse, retErr error) {
+	defer p.recorder.record(p.manager, "ListTaskQueueUserDataEntries", request, time.Now().UTC(), &retErr)
+	return p.persistence.ListTaskQueueUserDataEntries(ctx, request)
+}
+
+func (p *taskRecordingClient) GetTaskQueuesByBuildId(ctx context.Context, request *GetTaskQueuesByBuildIdRequest) (_ []string, retErr error) {
+	defer p.recorder.record(p.manager, "GetTaskQueuesByBuildId", request, time.Now().UTC(), &retErr)
+	return p.persistence.GetTaskQueuesByBuildId(ctx, request)
+}
+
+func (p *taskRecordingClient) CountTaskQueuesByBuildId(ctx context.Context, request *CountTaskQueuesByBuildIdRequest) (_ int, retErr error) {
+	defer p.recorder.record(p.manager, "CountTaskQueuesByBuildId", request, time.Now().UTC(), &retErr)
+	return p.persistence.CountTaskQueuesByBuildId(ctx, request)
+}
+
+func (p *taskRecordingClient) Close() {
+	p.persistence.Close()
+}
@@ -18,7 +18,7 @@ import (
 //   - "json"
 //   - "proto3"
 //
-// Decoding always support all encodings regardless of this setting.
+// Decoding always support all encodings regardless of this setting, including compressed blobs (see CompressBlob).
 //
 // WARNING: This environment variable should only be used for testing; and never set it in production.
 const SerializerDataEncodingEnvVar = "TEMPORAL_TEST_DATA_ENCODING"
@@ -105,12 +105,16 @@ func Decode(data *commonpb.DataBlob, result proto.Message) error {
 	if data == nil {
 		return NewDeserializationError(enumspb.ENCODING_TYPE_UNSPECIFIED, errors.New("cannot decode nil"))
 	}
+	data, err := DecompressBlob(data)
+	if err != nil {
+		return err
+	}
 
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_JSON:
 		return codec.NewJSONPBEncoder().Decode(data.Data, result)
 	case enumspb.ENCODING_TYPE_PROTO3:
-		err := proto.Unmarshal(data.Data, result)
+		err = proto.Unmarshal(data.Data, result)
 		if err != nil {
 			return NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, err)
 		}
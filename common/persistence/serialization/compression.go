@@ -0,0 +1,161 @@
+package serialization
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+)
+
+// Compressed and encrypted blobs keep the EncodingType of the underlying payload and prefix the
+// transformed bytes with a two byte header: blobHeaderMarker followed by the algorithm that was applied.
+//
+// A valid proto3 message never starts with a zero byte (field number 0 is reserved) and neither
+// does a JSON document, so legacy blobs can never be mistaken for transformed ones. This allows
+// compression to be turned on (or off) in a running cluster: decoding always accepts both.
+const (
+	blobHeaderMarker byte = 0x00
+	blobHeaderSize        = 2
+
+	compressionAlgorithmSnappy byte = 1
+	compressionAlgorithmZstd   byte = 2
+)
+
+// Supported values for the compression type of persisted blobs.
+const (
+	CompressionTypeNone   = "none"
+	CompressionTypeSnappy = "snappy"
+	CompressionTypeZstd   = "zstd"
+)
+
+var (
+	// EncodeAll and DecodeAll are safe for concurrent use, so a single encoder/decoder is shared.
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+type (
+	// BlobCompressionConfig controls compression of the blobs written by a Serializer.
+	BlobCompressionConfig struct {
+		// Type returns one of CompressionTypeNone, CompressionTypeSnappy or CompressionTypeZstd for
+		// a namespace ID. Unknown values disable compression.
+		Type dynamicconfig.StringPropertyFnWithNamespaceIDFilter
+		// MinSize is the minimum encoded size in bytes for a blob to be compressed.
+		MinSize func() int
+	}
+)
+
+// IsCompressedBlob returns true if the blob was produced by CompressBlob.
+func IsCompressedBlob(blob *commonpb.DataBlob) bool {
+	if !hasBlobHeader(blob) {
+		return false
+	}
+	return blob.Data[1] == compressionAlgorithmSnappy || blob.Data[1] == compressionAlgorithmZstd
+}
+
+func hasBlobHeader(blob *commonpb.DataBlob) bool {
+	return blob != nil && len(blob.Data) >= blobHeaderSize && blob.Data[0] == blobHeaderMarker
+}
+
+// CompressBlob returns a copy of the blob with its data compressed using the given compression type.
+// Blobs that are already compressed or encrypted, empty blobs, and CompressionTypeNone are returned unchanged.
+func CompressBlob(blob *commonpb.DataBlob, compressionType string) (*commonpb.DataBlob, error) {
+	if blob == nil || len(blob.Data) == 0 || hasBlobHeader(blob) {
+		return blob, nil
+	}
+
+	var algorithm byte
+	switch strings.ToLower(compressionType) {
+	case "", CompressionTypeNone:
+		return blob, nil
+	case CompressionTypeSnappy:
+		algorithm = compressionAlgorithmSnappy
+	case CompressionTypeZstd:
+		algorithm = compressionAlgorithmZstd
+	default:
+		return nil, NewSerializationError(blob.EncodingType, fmt.Errorf("unknown compression type %q", compressionType))
+	}
+
+	data := make([]byte, blobHeaderSize, blobHeaderSize+len(blob.Data)/2)
+	data[0] = blobHeaderMarker
+	data[1] = algorithm
+	switch algorithm {
+	case compressionAlgorithmSnappy:
+		data = append(data, snappy.Encode(nil, blob.Data)...)
+	case compressionAlgorithmZstd:
+		data = zstdEncoder.EncodeAll(blob.Data, data)
+	}
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+// DecompressBlob returns the uncompressed form of a blob produced by CompressBlob.
+// Blobs that are not compressed are returned unchanged.
+func DecompressBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if !hasBlobHeader(blob) {
+		return blob, nil
+	}
+	if !IsCompressedBlob(blob) {
+		if IsEncryptedBlob(blob) {
+			return nil, NewDeserializationError(blob.EncodingType, errEncryptedBlobNoKeyProvider)
+		}
+		if IsOffloadedBlob(blob) {
+			return nil, NewDeserializationError(blob.EncodingType, errOffloadedBlob)
+		}
+		return nil, NewDeserializationError(blob.EncodingType, fmt.Errorf("unknown blob algorithm %d", blob.Data[1]))
+	}
+
+	payload := blob.Data[blobHeaderSize:]
+	var data []byte
+	var err error
+	switch blob.Data[1] {
+	case compressionAlgorithmSnappy:
+		data, err = snappy.Decode(nil, payload)
+	case compressionAlgorithmZstd:
+		data, err = zstdDecoder.DecodeAll(payload, nil)
+	}
+	if err != nil {
+		return nil, NewDeserializationError(blob.EncodingType, err)
+	}
+	if len(data) == 0 {
+		return nil, NewDeserializationError(blob.EncodingType, errors.New("compressed blob has empty payload"))
+	}
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+// compressPersistedBlob applies the compression config of the serializer's namespace to the blob
+// and records the size before and after compression.
+func (t *serializerImpl) compressPersistedBlob(blob *commonpb.DataBlob, err error) (*commonpb.DataBlob, error) {
+	if err != nil || blob == nil || t.compression.Type == nil {
+		return blob, err
+	}
+	compressionType := strings.ToLower(t.compression.Type(namespace.ID(t.namespaceID)))
+	switch compressionType {
+	case CompressionTypeSnappy, CompressionTypeZstd:
+	default:
+		return blob, nil
+	}
+	if t.compression.MinSize != nil && len(blob.Data) < t.compression.MinSize() {
+		return blob, nil
+	}
+
+	compressed, err := CompressBlob(blob, compressionType)
+	if err != nil {
+		return nil, err
+	}
+	tag := metrics.StringTag(metrics.CompressionTypeTagName, compressionType)
+	metrics.PersistenceBlobUncompressedBytes.With(t.metricsHandler).Record(int64(len(blob.Data)), tag)
+	metrics.PersistenceBlobCompressedBytes.With(t.metricsHandler).Record(int64(len(compressed.Data)), tag)
+	return compressed, nil
+}
@@ -0,0 +1,211 @@
+package serialization
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func TestCompressBlob_RoundTrip(t *testing.T) {
+	info := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId:      "namespace-id",
+		WorkflowId:       strings.Repeat("workflow-id-", 200),
+		WorkflowTypeName: "workflow-type",
+	}
+
+	for _, encoding := range []string{"proto3", "json"} {
+		for _, compressionType := range []string{CompressionTypeSnappy, CompressionTypeZstd} {
+			t.Run(encoding+"/"+compressionType, func(t *testing.T) {
+				t.Setenv(SerializerDataEncodingEnvVar, encoding)
+				blob, err := Encode(info)
+				require.NoError(t, err)
+
+				compressed, err := CompressBlob(blob, compressionType)
+				require.NoError(t, err)
+				require.True(t, IsCompressedBlob(compressed))
+				require.Equal(t, blob.EncodingType, compressed.EncodingType)
+				require.Less(t, len(compressed.Data), len(blob.Data))
+
+				decompressed, err := DecompressBlob(compressed)
+				require.NoError(t, err)
+				require.Equal(t, blob.Data, decompressed.Data)
+
+				result := &persistencespb.WorkflowExecutionInfo{}
+				require.NoError(t, Decode(compressed, result))
+				protorequire.ProtoEqual(t, info, result)
+			})
+		}
+	}
+}
+
+func TestCompressBlob_EdgeCases(t *testing.T) {
+	t.Run("none", func(t *testing.T) {
+		blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("data")}
+		compressed, err := CompressBlob(blob, CompressionTypeNone)
+		require.NoError(t, err)
+		require.Same(t, blob, compressed)
+	})
+
+	t.Run("empty blob", func(t *testing.T) {
+		blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3}
+		compressed, err := CompressBlob(blob, CompressionTypeZstd)
+		require.NoError(t, err)
+		require.False(t, IsCompressedBlob(compressed))
+	})
+
+	t.Run("already compressed", func(t *testing.T) {
+		blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("data")}
+		compressed, err := CompressBlob(blob, CompressionTypeZstd)
+		require.NoError(t, err)
+		recompressed, err := CompressBlob(compressed, CompressionTypeSnappy)
+		require.NoError(t, err)
+		require.Same(t, compressed, recompressed)
+	})
+
+	t.Run("unknown compression type", func(t *testing.T) {
+		blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("data")}
+		_, err := CompressBlob(blob, "lz4")
+		var serializationErr *SerializationError
+		require.ErrorAs(t, err, &serializationErr)
+	})
+
+	t.Run("unknown compression algorithm", func(t *testing.T) {
+		blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte{blobHeaderMarker, 99, 1, 2}}
+		_, err := DecompressBlob(blob)
+		var deserializationErr *DeserializationError
+		require.ErrorAs(t, err, &deserializationErr)
+		require.Contains(t, err.Error(), "unknown blob algorithm 99")
+	})
+
+	t.Run("offloaded", func(t *testing.T) {
+		blob := NewOffloadedBlob(enumspb.ENCODING_TYPE_PROTO3, []byte("ref"))
+		ref, ok := OffloadedBlobRef(blob)
+		require.True(t, ok)
+		require.Equal(t, []byte("ref"), ref)
+		compressed, err := CompressBlob(blob, CompressionTypeZstd)
+		require.NoError(t, err)
+		require.Same(t, blob, compressed)
+		_, err = DecompressBlob(blob)
+		require.ErrorIs(t, err, errOffloadedBlob)
+
+		_, ok = OffloadedBlobRef(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("data")})
+		require.False(t, ok)
+	})
+
+	t.Run("corrupted payload", func(t *testing.T) {
+		blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte{blobHeaderMarker, compressionAlgorithmZstd, 1, 2}}
+		var result persistencespb.ShardInfo
+		err := Decode(blob, &result)
+		var deserializationErr *DeserializationError
+		require.ErrorAs(t, err, &deserializationErr)
+	})
+}
+
+func TestSerializer_BlobCompression(t *testing.T) {
+	compressionType := CompressionTypeNone
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+
+	serializer := NewSerializer(WithBlobCompression(
+		BlobCompressionConfig{
+			Type:    func(namespace.ID) string { return compressionType },
+			MinSize: dynamicconfig.GetIntPropertyFn(64),
+		},
+		metricsHandler,
+	))
+
+	events := []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+				ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+					Result: payloads.EncodeString(strings.Repeat("result", 100)),
+				},
+			},
+		},
+	}
+
+	// Legacy blob written before compression was enabled.
+	legacyBlob, err := serializer.SerializeEventsForPersistence(events)
+	require.NoError(t, err)
+	require.False(t, IsCompressedBlob(legacyBlob))
+
+	compressionType = CompressionTypeZstd
+	compressedBlob, err := serializer.SerializeEventsForPersistence(events)
+	require.NoError(t, err)
+	require.True(t, IsCompressedBlob(compressedBlob))
+
+	// SerializeEvents is used for blobs leaving persistence and is never compressed.
+	wireBlob, err := serializer.SerializeEvents(events)
+	require.NoError(t, err)
+	require.False(t, IsCompressedBlob(wireBlob))
+
+	for _, blob := range []*commonpb.DataBlob{legacyBlob, compressedBlob} {
+		result, err := serializer.DeserializeEvents(blob)
+		require.NoError(t, err)
+		protorequire.ProtoSliceEqual(t, events, result)
+
+		stripped, err := serializer.DeserializeStrippedEvents(blob)
+		require.NoError(t, err)
+		require.Len(t, stripped, 1)
+		require.Equal(t, int64(1), stripped[0].EventId)
+	}
+
+	// Blobs below the minimum size are not compressed.
+	smallBlob, err := serializer.ActivityInfoToBlob(&persistencespb.ActivityInfo{ScheduledEventId: 5})
+	require.NoError(t, err)
+	require.False(t, IsCompressedBlob(smallBlob))
+
+	// Decoding does not depend on the serializer configuration.
+	compressionType = CompressionTypeNone
+	result, err := DefaultDecoder.DeserializeEvents(compressedBlob)
+	require.NoError(t, err)
+	protorequire.ProtoSliceEqual(t, events, result)
+
+	snapshot := capture.Snapshot()
+	require.Len(t, snapshot["persistence_blob_uncompressed_bytes"], 1)
+	require.Len(t, snapshot["persistence_blob_compressed_bytes"], 1)
+	require.Equal(t, CompressionTypeZstd, snapshot["persistence_blob_compressed_bytes"][0].Tags["compression_type"])
+	require.Less(t,
+		snapshot["persistence_blob_compressed_bytes"][0].Value.(int64),
+		snapshot["persistence_blob_uncompressed_bytes"][0].Value.(int64),
+	)
+}
+
+func TestSerializer_BlobCompressionPerNamespace(t *testing.T) {
+	serializer := NewSerializer(WithBlobCompression(
+		BlobCompressionConfig{
+			Type: func(namespaceID namespace.ID) string {
+				if namespaceID == "compressed-ns" {
+					return CompressionTypeSnappy
+				}
+				return CompressionTypeNone
+			},
+			MinSize: dynamicconfig.GetIntPropertyFn(0),
+		},
+		metrics.NoopMetricsHandler,
+	))
+
+	events := []*historypb.HistoryEvent{{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED}}
+
+	blob, err := serializer.ForNamespace("compressed-ns").SerializeEventsForPersistence(events)
+	require.NoError(t, err)
+	require.True(t, IsCompressedBlob(blob))
+
+	blob, err = serializer.ForNamespace("other-ns").SerializeEventsForPersistence(events)
+	require.NoError(t, err)
+	require.False(t, IsCompressedBlob(blob))
+}
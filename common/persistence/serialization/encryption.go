@@ -0,0 +1,203 @@
+package serialization
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+// Encrypted blobs use the common blob header (see compression.go) followed by the length of the key ID,
+// the key ID, the AES-GCM nonce and the sealed payload. The payload is the blob data after compression,
+// so encrypted blobs are compressed first and decrypted before being decompressed.
+const (
+	encryptionAlgorithmAES256GCM byte = 16
+
+	// DataKeySize is the size in bytes of the data keys returned by a KeyProvider.
+	DataKeySize = 32
+	// MaxKeyIDLength is the maximum length of a key ID returned by a KeyProvider.
+	MaxKeyIDLength = 255
+)
+
+var errEncryptedBlobNoKeyProvider = errors.New("blob is encrypted but no key provider is configured")
+
+type (
+	// KeyProvider supplies the data keys used to encrypt persisted blobs at rest.
+	//
+	// Every encrypted blob stores the ID of the key it was encrypted with, so a KeyProvider
+	// must keep returning the key material for retired key IDs for as long as blobs encrypted
+	// with them may exist. Rotating keys only changes the key returned by CurrentKey.
+	KeyProvider interface {
+		// CurrentKey returns the ID and material of the data key that new blobs of the namespace are encrypted with.
+		CurrentKey(namespaceID string) (keyID string, key []byte, err error)
+		// Key returns the material of a key ID previously returned by CurrentKey.
+		Key(keyID string) ([]byte, error)
+	}
+
+	// blobCipher caches the AEAD for each key ID. Key material for a key ID never changes.
+	blobCipher struct {
+		keyProvider KeyProvider
+		aeads       sync.Map // key ID -> cipher.AEAD
+	}
+)
+
+// IsEncryptedBlob returns true if the blob was produced by EncryptBlob.
+func IsEncryptedBlob(blob *commonpb.DataBlob) bool {
+	return hasBlobHeader(blob) && blob.Data[1] == encryptionAlgorithmAES256GCM
+}
+
+// EncryptBlob returns a copy of the blob with its data encrypted by the given key using AES-256-GCM.
+// The key ID is stored in the blob so that DecryptBlob can look up the key. Empty and already
+// encrypted blobs are returned unchanged.
+func EncryptBlob(blob *commonpb.DataBlob, keyID string, key []byte) (*commonpb.DataBlob, error) {
+	aead, err := newAEAD(keyID, key)
+	if err != nil {
+		return nil, NewSerializationError(blob.GetEncodingType(), err)
+	}
+	return encryptBlob(blob, keyID, aead)
+}
+
+// DecryptBlob returns the decrypted form of a blob produced by EncryptBlob, looking up the key
+// through the given KeyProvider. Blobs that are not encrypted are returned unchanged.
+func DecryptBlob(blob *commonpb.DataBlob, keyProvider KeyProvider) (*commonpb.DataBlob, error) {
+	return (&blobCipher{keyProvider: keyProvider}).decrypt(blob)
+}
+
+func newAEAD(keyID string, key []byte) (cipher.AEAD, error) {
+	if len(keyID) == 0 || len(keyID) > MaxKeyIDLength {
+		return nil, fmt.Errorf("invalid key ID length %d", len(keyID))
+	}
+	if len(key) != DataKeySize {
+		return nil, fmt.Errorf("invalid size %d for data key %q, expected %d", len(key), keyID, DataKeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func encryptBlob(blob *commonpb.DataBlob, keyID string, aead cipher.AEAD) (*commonpb.DataBlob, error) {
+	if blob == nil || len(blob.Data) == 0 || IsEncryptedBlob(blob) {
+		return blob, nil
+	}
+
+	headerSize := blobHeaderSize + 1 + len(keyID)
+	data := make([]byte, headerSize+aead.NonceSize(), headerSize+aead.NonceSize()+len(blob.Data)+aead.Overhead())
+	data[0] = blobHeaderMarker
+	data[1] = encryptionAlgorithmAES256GCM
+	data[2] = byte(len(keyID))
+	copy(data[3:], keyID)
+	nonce := data[headerSize:]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, NewSerializationError(blob.EncodingType, err)
+	}
+	// The header and the encoding type are authenticated so that a blob cannot be
+	// re-labeled with a different key ID or encoding.
+	data = aead.Seal(data, nonce, blob.Data, additionalData(blob, data[:headerSize]))
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+func additionalData(blob *commonpb.DataBlob, header []byte) []byte {
+	ad := make([]byte, 0, len(header)+1)
+	ad = append(ad, header...)
+	return append(ad, byte(blob.EncodingType))
+}
+
+func (c *blobCipher) aead(keyID string, key []byte) (cipher.AEAD, error) {
+	if aead, ok := c.aeads.Load(keyID); ok {
+		return aead.(cipher.AEAD), nil
+	}
+	aead, err := newAEAD(keyID, key)
+	if err != nil {
+		return nil, err
+	}
+	c.aeads.Store(keyID, aead)
+	return aead, nil
+}
+
+func (c *blobCipher) encrypt(blob *commonpb.DataBlob, namespaceID string) (*commonpb.DataBlob, error) {
+	keyID, key, err := c.keyProvider.CurrentKey(namespaceID)
+	if err != nil {
+		return nil, NewSerializationError(blob.GetEncodingType(), err)
+	}
+	aead, err := c.aead(keyID, key)
+	if err != nil {
+		return nil, NewSerializationError(blob.GetEncodingType(), err)
+	}
+	return encryptBlob(blob, keyID, aead)
+}
+
+func (c *blobCipher) decrypt(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if !IsEncryptedBlob(blob) {
+		return blob, nil
+	}
+	if c == nil || c.keyProvider == nil {
+		return nil, NewDeserializationError(blob.EncodingType, errEncryptedBlobNoKeyProvider)
+	}
+
+	if len(blob.Data) < blobHeaderSize+1 {
+		return nil, NewDeserializationError(blob.EncodingType, errors.New("encrypted blob is truncated"))
+	}
+	headerSize := blobHeaderSize + 1 + int(blob.Data[2])
+	if len(blob.Data) < headerSize {
+		return nil, NewDeserializationError(blob.EncodingType, errors.New("encrypted blob is truncated"))
+	}
+	keyID := string(blob.Data[3:headerSize])
+
+	var aead cipher.AEAD
+	if cached, ok := c.aeads.Load(keyID); ok {
+		aead = cached.(cipher.AEAD)
+	} else {
+		key, err := c.keyProvider.Key(keyID)
+		if err != nil {
+			return nil, NewDeserializationError(blob.EncodingType, err)
+		}
+		if aead, err = c.aead(keyID, key); err != nil {
+			return nil, NewDeserializationError(blob.EncodingType, err)
+		}
+	}
+
+	if len(blob.Data) < headerSize+aead.NonceSize() {
+		return nil, NewDeserializationError(blob.EncodingType, errors.New("encrypted blob is truncated"))
+	}
+	nonce := blob.Data[headerSize : headerSize+aead.NonceSize()]
+	data, err := aead.Open(nil, nonce, blob.Data[headerSize+aead.NonceSize():], additionalData(blob, blob.Data[:headerSize]))
+	if err != nil {
+		return nil, NewDeserializationError(blob.EncodingType, fmt.Errorf("unable to decrypt blob with key %q: %w", keyID, err))
+	}
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+// encryptPersistedBlob encrypts the blob with the data key of the serializer's namespace.
+// It is a no-op unless a KeyProvider is configured and the serializer is scoped to a namespace.
+func (t *serializerImpl) encryptPersistedBlob(blob *commonpb.DataBlob, err error) (*commonpb.DataBlob, error) {
+	if err != nil || blob == nil || t.cipher == nil || t.namespaceID == "" {
+		return blob, err
+	}
+	return t.cipher.encrypt(blob, t.namespaceID)
+}
+
+// toPersistedBlob compresses and then encrypts the blob.
+func (t *serializerImpl) toPersistedBlob(blob *commonpb.DataBlob, err error) (*commonpb.DataBlob, error) {
+	return t.encryptPersistedBlob(t.compressPersistedBlob(blob, err))
+}
+
+// fromPersistedBlob reverses toPersistedBlob.
+func (t *serializerImpl) fromPersistedBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	blob, err := t.cipher.decrypt(blob)
+	if err != nil {
+		return nil, err
+	}
+	return DecompressBlob(blob)
+}
@@ -0,0 +1,231 @@
+package serialization
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, DataKeySize))
+}
+
+func TestEncryptBlob_RoundTrip(t *testing.T) {
+	keyProvider, err := newFileKeyProvider(FileKeyProviderConfig{
+		CurrentKey: "key-1",
+		Keys:       map[string]string{"key-1": testKey(1)},
+	})
+	require.NoError(t, err)
+
+	info := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId: "namespace-id",
+		WorkflowId:  strings.Repeat("workflow-id-", 200),
+	}
+	blob, err := Encode(info)
+	require.NoError(t, err)
+
+	keyID, key, err := keyProvider.CurrentKey("namespace-id")
+	require.NoError(t, err)
+	require.Equal(t, "key-1/namespace-id", keyID)
+
+	encrypted, err := EncryptBlob(blob, keyID, key)
+	require.NoError(t, err)
+	require.True(t, IsEncryptedBlob(encrypted))
+	require.False(t, IsCompressedBlob(encrypted))
+	require.Equal(t, blob.EncodingType, encrypted.EncodingType)
+
+	decrypted, err := DecryptBlob(encrypted, keyProvider)
+	require.NoError(t, err)
+	require.Equal(t, blob.Data, decrypted.Data)
+
+	t.Run("tampered data", func(t *testing.T) {
+		tampered := &commonpb.DataBlob{EncodingType: encrypted.EncodingType, Data: bytes.Clone(encrypted.Data)}
+		tampered.Data[len(tampered.Data)-1] ^= 1
+		_, err := DecryptBlob(tampered, keyProvider)
+		var deserializationErr *DeserializationError
+		require.ErrorAs(t, err, &deserializationErr)
+	})
+
+	t.Run("relabeled encoding", func(t *testing.T) {
+		relabeled := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_JSON, Data: encrypted.Data}
+		_, err := DecryptBlob(relabeled, keyProvider)
+		require.Error(t, err)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := DecryptBlob(&commonpb.DataBlob{Data: encrypted.Data[:10]}, keyProvider)
+		require.ErrorContains(t, err, "truncated")
+	})
+
+	t.Run("no key provider", func(t *testing.T) {
+		var result persistencespb.WorkflowExecutionInfo
+		err := Decode(encrypted, &result)
+		require.ErrorIs(t, err, errEncryptedBlobNoKeyProvider)
+	})
+
+	t.Run("other namespace key", func(t *testing.T) {
+		otherKeyID, otherKey, err := keyProvider.CurrentKey("other-namespace-id")
+		require.NoError(t, err)
+		require.NotEqual(t, key, otherKey)
+		other, err := EncryptBlob(blob, otherKeyID, otherKey)
+		require.NoError(t, err)
+		decrypted, err := DecryptBlob(other, keyProvider)
+		require.NoError(t, err)
+		require.Equal(t, blob.Data, decrypted.Data)
+	})
+}
+
+func TestSerializer_BlobEncryption(t *testing.T) {
+	config := FileKeyProviderConfig{
+		CurrentKey: "key-1",
+		Keys:       map[string]string{"key-1": testKey(1)},
+	}
+	keyProvider, err := newFileKeyProvider(config)
+	require.NoError(t, err)
+	serializer := NewSerializer(
+		WithBlobCompression(
+			BlobCompressionConfig{
+				Type:    dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(CompressionTypeZstd),
+				MinSize: dynamicconfig.GetIntPropertyFn(0),
+			},
+			metrics.NoopMetricsHandler,
+		),
+		WithBlobEncryption(keyProvider),
+	)
+
+	events := []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+				ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+					Result: payloads.EncodeString(strings.Repeat("result", 100)),
+				},
+			},
+		},
+	}
+
+	// Blobs are not encrypted unless the encoder is scoped to a namespace.
+	unscopedBlob, err := serializer.SerializeEventsForPersistence(events)
+	require.NoError(t, err)
+	require.True(t, IsCompressedBlob(unscopedBlob))
+
+	encoder := serializer.ForNamespace("namespace-id")
+	encryptedBlob, err := encoder.SerializeEventsForPersistence(events)
+	require.NoError(t, err)
+	require.True(t, IsEncryptedBlob(encryptedBlob))
+
+	activityInfo := &persistencespb.ActivityInfo{ScheduledEventId: 5}
+	activityBlob, err := encoder.ActivityInfoToBlob(activityInfo)
+	require.NoError(t, err)
+	require.True(t, IsEncryptedBlob(activityBlob))
+
+	// Rotate the key: blobs encrypted with the retired key must remain readable.
+	config.Keys["key-2"] = testKey(2)
+	config.CurrentKey = "key-2"
+	rotatedKeyProvider, err := newFileKeyProvider(config)
+	require.NoError(t, err)
+	serializer = NewSerializer(WithBlobEncryption(rotatedKeyProvider))
+
+	rotatedBlob, err := serializer.ForNamespace("namespace-id").SerializeEventsForPersistence(events)
+	require.NoError(t, err)
+	require.True(t, IsEncryptedBlob(rotatedBlob))
+	require.NotEqual(t, encryptedBlob.Data[:12], rotatedBlob.Data[:12])
+
+	for _, blob := range []*commonpb.DataBlob{unscopedBlob, encryptedBlob, rotatedBlob} {
+		result, err := serializer.DeserializeEvents(blob)
+		require.NoError(t, err)
+		protorequire.ProtoSliceEqual(t, events, result)
+
+		stripped, err := serializer.DeserializeStrippedEvents(blob)
+		require.NoError(t, err)
+		require.Len(t, stripped, 1)
+	}
+
+	result, err := serializer.ActivityInfoFromBlob(activityBlob)
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, activityInfo, result)
+
+	// Raw history blobs are decrypted and decompressed when read back from persistence.
+	rawBlob, err := serializer.EventBlobFromPersistence(encryptedBlob)
+	require.NoError(t, err)
+	wireBlob, err := serializer.SerializeEvents(events)
+	require.NoError(t, err)
+	require.Equal(t, wireBlob.Data, rawBlob.Data)
+
+	// A serializer without a key provider cannot read encrypted blobs.
+	_, err = DefaultDecoder.DeserializeEvents(encryptedBlob)
+	require.ErrorIs(t, err, errEncryptedBlobNoKeyProvider)
+}
+
+func TestNewFileKeyProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	content := "currentKey: key-2\nkeys:\n  key-1: " + testKey(1) + "\n  key-2: " + testKey(2) + "\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	keyProvider, err := NewFileKeyProvider(path)
+	require.NoError(t, err)
+	keyID, key, err := keyProvider.CurrentKey("namespace-id")
+	require.NoError(t, err)
+	require.Equal(t, "key-2/namespace-id", keyID)
+	require.Len(t, key, DataKeySize)
+
+	oldKey, err := keyProvider.Key("key-1/namespace-id")
+	require.NoError(t, err)
+	require.NotEqual(t, key, oldKey)
+
+	_, err = keyProvider.Key("key-3/namespace-id")
+	require.Error(t, err)
+	_, err = keyProvider.Key("key-1")
+	require.Error(t, err)
+
+	_, err = NewFileKeyProvider(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.Error(t, err)
+}
+
+func TestNewFileKeyProvider_InvalidConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config FileKeyProviderConfig
+	}{
+		{
+			name:   "no keys",
+			config: FileKeyProviderConfig{CurrentKey: "key-1"},
+		},
+		{
+			name:   "missing current key",
+			config: FileKeyProviderConfig{CurrentKey: "key-2", Keys: map[string]string{"key-1": testKey(1)}},
+		},
+		{
+			name:   "invalid key ID",
+			config: FileKeyProviderConfig{CurrentKey: "key/1", Keys: map[string]string{"key/1": testKey(1)}},
+		},
+		{
+			name:   "invalid base64",
+			config: FileKeyProviderConfig{CurrentKey: "key-1", Keys: map[string]string{"key-1": "not base64!"}},
+		},
+		{
+			name:   "invalid key size",
+			config: FileKeyProviderConfig{CurrentKey: "key-1", Keys: map[string]string{"key-1": base64.StdEncoding.EncodeToString([]byte("short"))}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newFileKeyProvider(tc.config)
+			require.Error(t, err)
+		})
+	}
+}
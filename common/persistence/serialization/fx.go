@@ -1,7 +1,34 @@
 package serialization
 
-import "go.uber.org/fx"
+import (
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.uber.org/fx"
+)
+
+type (
+	SerializerProviderParams struct {
+		fx.In
+
+		DynamicCollection *dynamicconfig.Collection
+		MetricsHandler    metrics.Handler
+		KeyProvider       KeyProvider `optional:"true"`
+	}
+)
 
 var Module = fx.Options(
-	fx.Provide(NewSerializer),
+	fx.Provide(SerializerProvider),
 )
+
+func SerializerProvider(params SerializerProviderParams) Serializer {
+	return NewSerializer(
+		WithBlobCompression(
+			BlobCompressionConfig{
+				Type:    dynamicconfig.PersistenceBlobCompression.Get(params.DynamicCollection),
+				MinSize: dynamicconfig.PersistenceBlobCompressionMinSize.Get(params.DynamicCollection),
+			},
+			params.MetricsHandler,
+		),
+		WithBlobEncryption(params.KeyProvider),
+	)
+}
@@ -0,0 +1,119 @@
+package serialization
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	maxMasterKeyIDLength = 128
+	dataKeyIDSeparator   = "/"
+	dataKeyInfoPrefix    = "temporal-persistence-blob:"
+)
+
+type (
+	// FileKeyProviderConfig is the content of the key file read by NewFileKeyProvider.
+	//
+	//	currentKey: key-2
+	//	keys:
+	//	  key-1: <base64 encoded 32 byte key>
+	//	  key-2: <base64 encoded 32 byte key>
+	FileKeyProviderConfig struct {
+		// CurrentKey is the ID of the master key that data keys for new blobs are derived from.
+		CurrentKey string `yaml:"currentKey"`
+		// Keys maps master key IDs to base64 encoded 32 byte keys. Retired keys must be kept
+		// for as long as blobs encrypted with them may exist.
+		Keys map[string]string `yaml:"keys"`
+	}
+
+	// fileKeyProvider derives a data key per namespace from master keys read from a local file.
+	// Data key IDs have the form "<master key ID>/<namespace ID>".
+	fileKeyProvider struct {
+		currentKey string
+		masterKeys map[string][]byte
+		dataKeys   sync.Map // data key ID -> []byte
+	}
+)
+
+var _ KeyProvider = (*fileKeyProvider)(nil)
+
+// NewFileKeyProvider returns a KeyProvider that derives per-namespace data keys from the master keys
+// in the given file (see FileKeyProviderConfig). It does not depend on any external KMS.
+//
+// To rotate keys, add a new master key to the file, point currentKey to it and restart the hosts.
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file: %w", err)
+	}
+	var config FileKeyProviderConfig
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("unable to parse key file %s: %w", path, err)
+	}
+	return newFileKeyProvider(config)
+}
+
+func newFileKeyProvider(config FileKeyProviderConfig) (*fileKeyProvider, error) {
+	if len(config.Keys) == 0 {
+		return nil, errors.New("key file contains no keys")
+	}
+	masterKeys := make(map[string][]byte, len(config.Keys))
+	for id, encoded := range config.Keys {
+		if id == "" || len(id) > maxMasterKeyIDLength || strings.Contains(id, dataKeyIDSeparator) {
+			return nil, fmt.Errorf("invalid key ID %q: must be 1 to %d characters and not contain %q",
+				id, maxMasterKeyIDLength, dataKeyIDSeparator)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", id, err)
+		}
+		if len(key) != DataKeySize {
+			return nil, fmt.Errorf("invalid key %q: expected %d bytes, got %d", id, DataKeySize, len(key))
+		}
+		masterKeys[id] = key
+	}
+	if _, ok := masterKeys[config.CurrentKey]; !ok {
+		return nil, fmt.Errorf("current key %q not found in key file", config.CurrentKey)
+	}
+	return &fileKeyProvider{
+		currentKey: config.CurrentKey,
+		masterKeys: masterKeys,
+	}, nil
+}
+
+func (p *fileKeyProvider) CurrentKey(namespaceID string) (string, []byte, error) {
+	keyID := p.currentKey + dataKeyIDSeparator + namespaceID
+	key, err := p.Key(keyID)
+	if err != nil {
+		return "", nil, err
+	}
+	return keyID, key, nil
+}
+
+func (p *fileKeyProvider) Key(keyID string) ([]byte, error) {
+	if key, ok := p.dataKeys.Load(keyID); ok {
+		return key.([]byte), nil
+	}
+	masterKeyID, namespaceID, ok := strings.Cut(keyID, dataKeyIDSeparator)
+	if !ok {
+		return nil, fmt.Errorf("invalid data key ID %q", keyID)
+	}
+	masterKey, ok := p.masterKeys[masterKeyID]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in key file", masterKeyID)
+	}
+	key, err := hkdf.Key(sha256.New, masterKey, nil, dataKeyInfoPrefix+namespaceID, DataKeySize)
+	if err != nil {
+		return nil, err
+	}
+	p.dataKeys.Store(keyID, key)
+	return key, nil
+}
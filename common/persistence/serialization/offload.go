@@ -0,0 +1,39 @@
+package serialization
+
+import (
+	"errors"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+// Offloaded blobs are left in place of a blob that was moved out of the database, e.g. to a blob store.
+// They use the common blob header (see compression.go) followed by a reference to the moved blob. The
+// reference is opaque to this package and only meaningful to the component that offloaded the blob.
+const blobAlgorithmOffloaded byte = 32
+
+var errOffloadedBlob = errors.New("blob was offloaded and must be fetched before it is decoded")
+
+// IsOffloadedBlob returns true if the blob was produced by NewOffloadedBlob.
+func IsOffloadedBlob(blob *commonpb.DataBlob) bool {
+	return hasBlobHeader(blob) && blob.Data[1] == blobAlgorithmOffloaded
+}
+
+// NewOffloadedBlob returns a blob that holds a reference to a blob of the given encoding that was offloaded.
+func NewOffloadedBlob(encodingType enumspb.EncodingType, ref []byte) *commonpb.DataBlob {
+	data := make([]byte, blobHeaderSize, blobHeaderSize+len(ref))
+	data[0] = blobHeaderMarker
+	data[1] = blobAlgorithmOffloaded
+	return &commonpb.DataBlob{
+		EncodingType: encodingType,
+		Data:         append(data, ref...),
+	}
+}
+
+// OffloadedBlobRef returns the reference passed to NewOffloadedBlob, or false if the blob was not offloaded.
+func OffloadedBlobRef(blob *commonpb.DataBlob) ([]byte, bool) {
+	if !IsOffloadedBlob(blob) {
+		return nil, false
+	}
+	return blob.Data[blobHeaderSize:], true
+}
@@ -14,6 +14,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/service/history/tasks"
 	"google.golang.org/protobuf/proto"
 )
@@ -33,6 +34,16 @@ type (
 	Encoder interface {
 		EncodingType() enumspb.EncodingType
 		SerializeEvents(batch []*historypb.HistoryEvent) (*commonpb.DataBlob, error)
+		// SerializeEventsForPersistence is like SerializeEvents but applies the configured blob compression
+		// and encryption. The result must only be written to persistence, callers outside of it expect plain blobs.
+		SerializeEventsForPersistence(batch []*historypb.HistoryEvent) (*commonpb.DataBlob, error)
+		// EventBlobForPersistence applies the transformations of SerializeEventsForPersistence to an
+		// already encoded event batch.
+		EventBlobForPersistence(blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+		// ForNamespace returns an Encoder that applies the compression setting of the namespace and
+		// encrypts persisted blobs with its data key. It returns the Encoder itself when neither blob
+		// compression nor encryption is enabled.
+		ForNamespace(namespaceID string) Encoder
 		SerializeEvent(event *historypb.HistoryEvent) (*commonpb.DataBlob, error)
 		SerializeClusterMetadata(icm *persistencespb.ClusterMetadata) (*commonpb.DataBlob, error)
 		ShardInfoToBlob(info *persistencespb.ShardInfo) (*commonpb.DataBlob, error)
@@ -73,6 +84,8 @@ type (
 		DeserializeEvents(data *commonpb.DataBlob) ([]*historypb.HistoryEvent, error)
 		DeserializeEvent(data *commonpb.DataBlob) (*historypb.HistoryEvent, error)
 		DeserializeStrippedEvents(data *commonpb.DataBlob) ([]*historyspb.StrippedHistoryEvent, error)
+		// EventBlobFromPersistence reverses EventBlobForPersistence and returns the plain encoded event batch.
+		EventBlobFromPersistence(blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
 		DeserializeClusterMetadata(data *commonpb.DataBlob) (*persistencespb.ClusterMetadata, error)
 		ShardInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.ShardInfo, error)
 		NamespaceDetailFromBlob(data *commonpb.DataBlob) (*persistencespb.NamespaceDetail, error)
@@ -130,16 +143,69 @@ type (
 	}
 
 	serializerImpl struct {
-		encodingType enumspb.EncodingType
+		encodingType   enumspb.EncodingType
+		compression    BlobCompressionConfig
+		metricsHandler metrics.Handler
+		cipher         *blobCipher
+		// namespaceID selects the compression setting and the data key used for encryption, see
+		// ForNamespace.
+		namespaceID string
 	}
 
+	// SerializerOption configures a Serializer created by NewSerializer.
+	SerializerOption func(*serializerImpl)
+
 	marshaler interface {
 		Marshal() ([]byte, error)
 	}
 )
 
-func NewSerializer() Serializer {
-	return &serializerImpl{encodingType: encodingTypeFromEnv()}
+func NewSerializer(opts ...SerializerOption) Serializer {
+	s := &serializerImpl{
+		encodingType:   encodingTypeFromEnv(),
+		metricsHandler: metrics.NoopMetricsHandler,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// WithBlobCompression enables compression of history event batches and mutable state records
+// written to persistence. Decoding accepts compressed and uncompressed blobs regardless of this option.
+func WithBlobCompression(config BlobCompressionConfig, metricsHandler metrics.Handler) SerializerOption {
+	return func(s *serializerImpl) {
+		s.compression = config
+		s.metricsHandler = metricsHandler
+	}
+}
+
+// WithBlobEncryption enables encryption at rest of history event batches, mutable state records and
+// history tasks written through an Encoder returned by ForNamespace. Blobs encrypted with any key
+// known to the KeyProvider can be decoded.
+func WithBlobEncryption(keyProvider KeyProvider) SerializerOption {
+	return func(s *serializerImpl) {
+		if keyProvider != nil {
+			s.cipher = &blobCipher{keyProvider: keyProvider}
+		}
+	}
+}
+
+func (t *serializerImpl) ForNamespace(namespaceID string) Encoder {
+	if t.cipher == nil && t.compression.Type == nil {
+		return t
+	}
+	scoped := *t
+	scoped.namespaceID = namespaceID
+	return &scoped
+}
+
+func (t *serializerImpl) decode(data *commonpb.DataBlob, result proto.Message) error {
+	data, err := t.cipher.decrypt(data)
+	if err != nil {
+		return err
+	}
+	return Decode(data, result)
 }
 
 func (t *serializerImpl) EncodingType() enumspb.EncodingType {
@@ -194,6 +260,18 @@ func (t *serializerImpl) SerializeEvents(events []*historypb.HistoryEvent) (*com
 	return t.serialize(&historypb.History{Events: events})
 }
 
+func (t *serializerImpl) SerializeEventsForPersistence(events []*historypb.HistoryEvent) (*commonpb.DataBlob, error) {
+	return t.toPersistedBlob(t.SerializeEvents(events))
+}
+
+func (t *serializerImpl) EventBlobForPersistence(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	return t.toPersistedBlob(blob, nil)
+}
+
+func (t *serializerImpl) EventBlobFromPersistence(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	return t.fromPersistedBlob(blob)
+}
+
 func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historypb.HistoryEvent, error) {
 	if data == nil {
 		return nil, nil
@@ -203,7 +281,7 @@ func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historyp
 	}
 
 	events := &historypb.History{}
-	err := Decode(data, events)
+	err := t.decode(data, events)
 	if err != nil {
 		return nil, err
 	}
@@ -218,8 +296,12 @@ func (t *serializerImpl) DeserializeStrippedEvents(data *commonpb.DataBlob) ([]*
 		return nil, nil
 	}
 
+	data, err := t.fromPersistedBlob(data)
+	if err != nil {
+		return nil, err
+	}
+
 	events := &historyspb.StrippedHistoryEvents{}
-	var err error
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Discard unknown fields to improve performance. StrippedHistoryEvents is usually deserialized from HistoryEvent
@@ -257,7 +339,7 @@ func (t *serializerImpl) DeserializeEvent(data *commonpb.DataBlob) (*historypb.H
 	}
 
 	event := &historypb.HistoryEvent{}
-	err := Decode(data, event)
+	err := t.decode(data, event)
 	if err != nil {
 		return nil, err
 	}
@@ -280,7 +362,7 @@ func (t *serializerImpl) DeserializeClusterMetadata(data *commonpb.DataBlob) (*p
 	}
 
 	cm := &persistencespb.ClusterMetadata{}
-	err := Decode(data, cm)
+	err := t.decode(data, cm)
 	if err != nil {
 		return nil, err
 	}
@@ -377,7 +459,7 @@ func (t *serializerImpl) ShardInfoToBlob(info *persistencespb.ShardInfo) (*commo
 
 func (t *serializerImpl) ShardInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.ShardInfo, error) {
 	shardInfo := &persistencespb.ShardInfo{}
-	err := Decode(data, shardInfo)
+	err := t.decode(data, shardInfo)
 
 	if err != nil {
 		return nil, err
@@ -410,7 +492,7 @@ func (t *serializerImpl) NamespaceDetailToBlob(info *persistencespb.NamespaceDet
 
 func (t *serializerImpl) NamespaceDetailFromBlob(data *commonpb.DataBlob) (*persistencespb.NamespaceDetail, error) {
 	result := &persistencespb.NamespaceDetail{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) HistoryTreeInfoToBlob(info *persistencespb.HistoryTreeInfo) (*commonpb.DataBlob, error) {
@@ -419,7 +501,7 @@ func (t *serializerImpl) HistoryTreeInfoToBlob(info *persistencespb.HistoryTreeI
 
 func (t *serializerImpl) HistoryTreeInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.HistoryTreeInfo, error) {
 	result := &persistencespb.HistoryTreeInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) HistoryBranchToBlob(info *persistencespb.HistoryBranch) (*commonpb.DataBlob, error) {
@@ -429,16 +511,16 @@ func (t *serializerImpl) HistoryBranchToBlob(info *persistencespb.HistoryBranch)
 // NOTE: HistoryBranch does not have an encoding type; so we use the serializer's encoding type.
 func (t *serializerImpl) HistoryBranchFromBlob(data []byte) (*persistencespb.HistoryBranch, error) {
 	result := &persistencespb.HistoryBranch{}
-	return result, Decode(&commonpb.DataBlob{Data: data, EncodingType: t.encodingType}, result)
+	return result, t.decode(&commonpb.DataBlob{Data: data, EncodingType: t.encodingType}, result)
 }
 
 func (t *serializerImpl) WorkflowExecutionInfoToBlob(info *persistencespb.WorkflowExecutionInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.toPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) WorkflowExecutionInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.WorkflowExecutionInfo, error) {
 	result := &persistencespb.WorkflowExecutionInfo{}
-	err := Decode(data, result)
+	err := t.decode(data, result)
 	if err != nil {
 		return nil, err
 	}
@@ -450,12 +532,12 @@ func (t *serializerImpl) WorkflowExecutionInfoFromBlob(data *commonpb.DataBlob)
 }
 
 func (t *serializerImpl) WorkflowExecutionStateToBlob(info *persistencespb.WorkflowExecutionState) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.encryptPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) WorkflowExecutionStateFromBlob(data *commonpb.DataBlob) (*persistencespb.WorkflowExecutionState, error) {
 	result := &persistencespb.WorkflowExecutionState{}
-	if err := Decode(data, result); err != nil {
+	if err := t.decode(data, result); err != nil {
 		return nil, err
 	}
 	// Initialize the WorkflowExecutionStateDetails for old records.
@@ -472,48 +554,48 @@ func (t *serializerImpl) WorkflowExecutionStateFromBlob(data *commonpb.DataBlob)
 }
 
 func (t *serializerImpl) ActivityInfoToBlob(info *persistencespb.ActivityInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.toPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) ActivityInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.ActivityInfo, error) {
 	result := &persistencespb.ActivityInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) ChildExecutionInfoToBlob(info *persistencespb.ChildExecutionInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.toPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) ChildExecutionInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.ChildExecutionInfo, error) {
 	result := &persistencespb.ChildExecutionInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) SignalInfoToBlob(info *persistencespb.SignalInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.encryptPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) SignalInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.SignalInfo, error) {
 	result := &persistencespb.SignalInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) RequestCancelInfoToBlob(info *persistencespb.RequestCancelInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.encryptPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) RequestCancelInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.RequestCancelInfo, error) {
 	result := &persistencespb.RequestCancelInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) TimerInfoToBlob(info *persistencespb.TimerInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.encryptPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) TimerInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.TimerInfo, error) {
 	result := &persistencespb.TimerInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) TaskInfoToBlob(info *persistencespb.AllocatedTaskInfo) (*commonpb.DataBlob, error) {
@@ -522,7 +604,7 @@ func (t *serializerImpl) TaskInfoToBlob(info *persistencespb.AllocatedTaskInfo)
 
 func (t *serializerImpl) TaskInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.AllocatedTaskInfo, error) {
 	result := &persistencespb.AllocatedTaskInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) TaskQueueInfoToBlob(info *persistencespb.TaskQueueInfo) (*commonpb.DataBlob, error) {
@@ -531,7 +613,7 @@ func (t *serializerImpl) TaskQueueInfoToBlob(info *persistencespb.TaskQueueInfo)
 
 func (t *serializerImpl) TaskQueueInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.TaskQueueInfo, error) {
 	result := &persistencespb.TaskQueueInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) TaskQueueUserDataToBlob(data *persistencespb.TaskQueueUserData) (*commonpb.DataBlob, error) {
@@ -540,7 +622,7 @@ func (t *serializerImpl) TaskQueueUserDataToBlob(data *persistencespb.TaskQueueU
 
 func (t *serializerImpl) TaskQueueUserDataFromBlob(data *commonpb.DataBlob) (*persistencespb.TaskQueueUserData, error) {
 	result := &persistencespb.TaskQueueUserData{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) ChecksumToBlob(checksum *persistencespb.Checksum) (*commonpb.DataBlob, error) {
@@ -553,7 +635,7 @@ func (t *serializerImpl) ChecksumToBlob(checksum *persistencespb.Checksum) (*com
 
 func (t *serializerImpl) ChecksumFromBlob(data *commonpb.DataBlob) (*persistencespb.Checksum, error) {
 	result := &persistencespb.Checksum{}
-	err := Decode(data, result)
+	err := t.decode(data, result)
 	if err != nil || result.GetFlavor() == enumsspb.CHECKSUM_FLAVOR_UNSPECIFIED {
 		// If result is an empty struct (Flavor is unspecified), replace it with nil, because everywhere in the code checksum is pointer type.
 		return nil, err
@@ -568,7 +650,7 @@ func (t *serializerImpl) QueueMetadataToBlob(metadata *persistencespb.QueueMetad
 
 func (t *serializerImpl) QueueMetadataFromBlob(data *commonpb.DataBlob) (*persistencespb.QueueMetadata, error) {
 	result := &persistencespb.QueueMetadata{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) ReplicationTaskToBlob(replicationTask *replicationspb.ReplicationTask) (*commonpb.DataBlob, error) {
@@ -577,7 +659,7 @@ func (t *serializerImpl) ReplicationTaskToBlob(replicationTask *replicationspb.R
 
 func (t *serializerImpl) ReplicationTaskFromBlob(data *commonpb.DataBlob) (*replicationspb.ReplicationTask, error) {
 	result := &replicationspb.ReplicationTask{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) NexusEndpointToBlob(endpoint *persistencespb.NexusEndpoint) (*commonpb.DataBlob, error) {
@@ -586,86 +668,94 @@ func (t *serializerImpl) NexusEndpointToBlob(endpoint *persistencespb.NexusEndpo
 
 func (t *serializerImpl) NexusEndpointFromBlob(data *commonpb.DataBlob) (*persistencespb.NexusEndpoint, error) {
 	result := &persistencespb.NexusEndpoint{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) ChasmNodeToBlobs(node *persistencespb.ChasmNode) (metadata *commonpb.DataBlob, nodedata *commonpb.DataBlob, retErr error) {
-	metadata, retErr = encodeBlob(node.Metadata, t.encodingType)
+	metadata, retErr = t.encryptPersistedBlob(encodeBlob(node.Metadata, t.encodingType))
 	if retErr != nil {
 		return nil, nil, retErr
 	}
-	return metadata, node.Data, nil
+	nodedata, retErr = t.encryptPersistedBlob(node.Data, nil)
+	if retErr != nil {
+		return nil, nil, retErr
+	}
+	return metadata, nodedata, nil
 }
 
 func (t *serializerImpl) ChasmNodeFromBlobs(metadata *commonpb.DataBlob, data *commonpb.DataBlob) (*persistencespb.ChasmNode, error) {
+	data, err := t.cipher.decrypt(data)
+	if err != nil {
+		return nil, err
+	}
 	result := &persistencespb.ChasmNode{
 		Metadata: &persistencespb.ChasmNodeMetadata{},
 		Data:     data,
 	}
-	return result, Decode(metadata, result.Metadata)
+	return result, t.decode(metadata, result.Metadata)
 }
 
 func (t *serializerImpl) ChasmNodeToBlob(node *persistencespb.ChasmNode) (*commonpb.DataBlob, error) {
-	return encodeBlob(node, t.encodingType)
+	return t.toPersistedBlob(encodeBlob(node, t.encodingType))
 }
 
 func (t *serializerImpl) ChasmNodeFromBlob(blob *commonpb.DataBlob) (*persistencespb.ChasmNode, error) {
 	result := &persistencespb.ChasmNode{}
-	return result, Decode(blob, result)
+	return result, t.decode(blob, result)
 }
 
 func (t *serializerImpl) TransferTaskInfoToBlob(info *persistencespb.TransferTaskInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.encryptPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) TransferTaskInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.TransferTaskInfo, error) {
 	result := &persistencespb.TransferTaskInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) TimerTaskInfoToBlob(info *persistencespb.TimerTaskInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.encryptPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) TimerTaskInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.TimerTaskInfo, error) {
 	result := &persistencespb.TimerTaskInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) ReplicationTaskInfoToBlob(info *persistencespb.ReplicationTaskInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.encryptPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) ReplicationTaskInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.ReplicationTaskInfo, error) {
 	result := &persistencespb.ReplicationTaskInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) VisibilityTaskInfoToBlob(info *persistencespb.VisibilityTaskInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.encryptPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) VisibilityTaskInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.VisibilityTaskInfo, error) {
 	result := &persistencespb.VisibilityTaskInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) ArchivalTaskInfoToBlob(info *persistencespb.ArchivalTaskInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.encryptPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) ArchivalTaskInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.ArchivalTaskInfo, error) {
 	result := &persistencespb.ArchivalTaskInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) OutboundTaskInfoToBlob(info *persistencespb.OutboundTaskInfo) (*commonpb.DataBlob, error) {
-	return encodeBlob(info, t.encodingType)
+	return t.encryptPersistedBlob(encodeBlob(info, t.encodingType))
 }
 
 func (t *serializerImpl) OutboundTaskInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.OutboundTaskInfo, error) {
 	result := &persistencespb.OutboundTaskInfo{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 func (t *serializerImpl) QueueStateToBlob(info *persistencespb.QueueState) (*commonpb.DataBlob, error) {
@@ -674,7 +764,7 @@ func (t *serializerImpl) QueueStateToBlob(info *persistencespb.QueueState) (*com
 
 func (t *serializerImpl) QueueStateFromBlob(data *commonpb.DataBlob) (*persistencespb.QueueState, error) {
 	result := &persistencespb.QueueState{}
-	return result, Decode(data, result)
+	return result, t.decode(data, result)
 }
 
 // ReencodeEventBlobsAsProto3 re-encodes event blobs as proto3 if the serializer uses a different encoding.
@@ -733,11 +733,60 @@ func (m *sqlExecutionStore) setWorkflowExecutionTx(
 	)
 }
 
+type listConcreteExecutionsPageToken struct {
+	NamespaceID []byte
+	WorkflowID  string
+	RunID       []byte
+}
+
+// ListConcreteExecutions returns the executions of a shard. Like the cassandra implementation,
+// only ExecutionInfo, ExecutionState, NextEventID and DBRecordVersion are populated.
 func (m *sqlExecutionStore) ListConcreteExecutions(
-	_ context.Context,
-	_ *p.ListConcreteExecutionsRequest,
+	ctx context.Context,
+	request *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
-	return nil, serviceerror.NewUnimplemented("ListConcreteExecutions is not implemented")
+	filter := sqlplugin.ExecutionsRangeFilter{
+		ShardID:  request.ShardID,
+		PageSize: request.PageSize,
+	}
+	if len(request.PageToken) > 0 {
+		token, err := deserializePageTokenJson[listConcreteExecutionsPageToken](request.PageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgumentf("ListConcreteExecutions: invalid page token: %v", err)
+		}
+		filter.NamespaceID = token.NamespaceID
+		filter.WorkflowID = token.WorkflowID
+		filter.RunID = token.RunID
+	}
+
+	rows, err := m.DB.RangeSelectFromExecutions(ctx, filter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef("ListConcreteExecutions: failed. Error: %v", err)
+	}
+
+	response := &p.InternalListConcreteExecutionsResponse{
+		States: make([]*p.InternalWorkflowMutableState, 0, len(rows)),
+	}
+	for _, row := range rows {
+		response.States = append(response.States, &p.InternalWorkflowMutableState{
+			ExecutionInfo:   p.NewDataBlob(row.Data, row.DataEncoding),
+			ExecutionState:  p.NewDataBlob(row.State, row.StateEncoding),
+			NextEventID:     row.NextEventID,
+			DBRecordVersion: row.DBRecordVersion,
+		})
+	}
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		response.NextPageToken, err = serializePageTokenJson(&listConcreteExecutionsPageToken{
+			NamespaceID: lastRow.NamespaceID,
+			WorkflowID:  lastRow.WorkflowID,
+			RunID:       lastRow.RunID,
+		})
+		if err != nil {
+			return nil, serviceerror.NewInternalf("ListConcreteExecutions: failed to serialize page token: %v", err)
+		}
+	}
+	return response, nil
 }
 
 func (m *sqlExecutionStore) GetHistoryBranchUtil() p.HistoryBranchUtil {
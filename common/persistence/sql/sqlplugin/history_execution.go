@@ -36,6 +36,18 @@ type (
 		RunID       primitives.UUID
 	}
 
+	// ExecutionsRangeFilter contains the column names within executions table that
+	// can be used to page through the executions of a shard
+	ExecutionsRangeFilter struct {
+		ShardID int32
+		// NamespaceID, WorkflowID and RunID are the primary key of the last row of the previous page.
+		// A nil NamespaceID selects from the first row of the shard.
+		NamespaceID primitives.UUID
+		WorkflowID  string
+		RunID       primitives.UUID
+		PageSize    int
+	}
+
 	// CurrentExecutionsRow represents a row in current_executions table
 	CurrentExecutionsRow struct {
 		ShardID          int32
@@ -73,6 +85,9 @@ type (
 		InsertIntoExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(ctx context.Context, filter ExecutionsFilter) (*ExecutionsRow, error)
+		// RangeSelectFromExecutions returns up to PageSize rows of a shard ordered by primary key,
+		// starting after the row given by the filter
+		RangeSelectFromExecutions(ctx context.Context, filter ExecutionsRangeFilter) ([]ExecutionsRow, error)
 		DeleteFromExecutions(ctx context.Context, filter ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
 		WriteLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
@@ -85,7 +85,7 @@ func (mdb *db) ListClusterMetadata(
 	var rows []sqlplugin.ClusterMetadataRow
 	switch {
 	case len(filter.ClusterName) != 0:
-		err = mdb.SelectContext(ctx,
+		err = mdb.replicaSelectContext(ctx,
 			&rows,
 			listClusterMetadataRangeQry,
 			constMetadataPartition,
@@ -93,7 +93,7 @@ func (mdb *db) ListClusterMetadata(
 			filter.PageSize,
 		)
 	default:
-		err = mdb.SelectContext(ctx,
+		err = mdb.replicaSelectContext(ctx,
 			&rows,
 			listClusterMetadataQry,
 			constMetadataPartition,
@@ -3,7 +3,10 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
@@ -33,6 +36,7 @@ type db struct {
 	dbName string
 
 	handle    *sqlplugin.DatabaseHandle
+	replicas  *sqlplugin.ReplicaRouter
 	tx        *sqlx.Tx
 	converter DataConverter
 	logger    log.Logger
@@ -107,6 +111,9 @@ func (mdb *db) Rollback() error {
 
 // Close closes the connection to the mysql db
 func (mdb *db) Close() error {
+	if mdb.replicas != nil {
+		mdb.replicas.Close()
+	}
 	mdb.handle.Close()
 	return nil
 }
@@ -168,3 +175,61 @@ func (mdb *db) PrepareNamedContext(ctx context.Context, query string) (*sqlx.Nam
 func (mdb *db) Rebind(query string) string {
 	return mdb.conn().Rebind(query)
 }
+
+// Helper methods for read-only queries that tolerate replication lag, they are sent to a read replica if one is usable
+func (mdb *db) replicaGetContext(ctx context.Context, dest any, query string, args ...any) error {
+	if mdb.tx != nil || mdb.replicas == nil {
+		return mdb.GetContext(ctx, dest, query, args...)
+	}
+	return mdb.replicas.GetContext(ctx, dest, query, args...)
+}
+
+func (mdb *db) replicaSelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	if mdb.tx != nil || mdb.replicas == nil {
+		return mdb.SelectContext(ctx, dest, query, args...)
+	}
+	return mdb.replicas.SelectContext(ctx, dest, query, args...)
+}
+
+func (mdb *db) replicaQueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if mdb.tx != nil || mdb.replicas == nil {
+		db, err := mdb.handle.DB()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := db.QueryContext(ctx, query, args...)
+		return rows, mdb.handle.ConvertError(err)
+	}
+	return mdb.replicas.QueryContext(ctx, query, args...)
+}
+
+// measureReplicationLag reads Seconds_Behind_Source from SHOW REPLICA STATUS. A server that is not configured as a
+// replica (e.g. a reader endpoint of a managed cluster) is assumed to be caught up.
+func measureReplicationLag(ctx context.Context, db *sqlx.DB) (time.Duration, error) {
+	rows, err := db.QueryxContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		return 0, rows.Err()
+	}
+	status := make(map[string]any)
+	if err := rows.MapScan(status); err != nil {
+		return 0, err
+	}
+	var lagSeconds int64
+	switch lag := status["Seconds_Behind_Source"].(type) {
+	case nil:
+		return 0, errors.New("replication is not running")
+	case []byte:
+		if lagSeconds, err = strconv.ParseInt(string(lag), 10, 64); err != nil {
+			return 0, err
+		}
+	case int64:
+		lagSeconds = lag
+	default:
+		return 0, fmt.Errorf("unexpected Seconds_Behind_Source type %T", lag)
+	}
+	return time.Duration(lagSeconds) * time.Second, nil
+}
@@ -22,6 +22,12 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
+	listExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? ORDER BY namespace_id, workflow_id, run_id LIMIT ?`
+
+	listExecutionsAfterQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? AND (namespace_id, workflow_id, run_id) > (?, ?, ?) ORDER BY namespace_id, workflow_id, run_id LIMIT ?`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
@@ -222,6 +228,37 @@ func (mdb *db) SelectFromExecutions(
 	return &row, err
 }
 
+// RangeSelectFromExecutions reads a page of rows from executions table
+func (mdb *db) RangeSelectFromExecutions(
+	ctx context.Context,
+	filter sqlplugin.ExecutionsRangeFilter,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	var err error
+	if filter.NamespaceID == nil {
+		err = mdb.SelectContext(ctx,
+			&rows,
+			listExecutionsQuery,
+			filter.ShardID,
+			filter.PageSize,
+		)
+	} else {
+		err = mdb.SelectContext(ctx,
+			&rows,
+			listExecutionsAfterQuery,
+			filter.ShardID,
+			filter.NamespaceID,
+			filter.WorkflowID,
+			filter.RunID,
+			filter.PageSize,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (mdb *db) DeleteFromExecutions(
 	ctx context.Context,
@@ -127,7 +127,7 @@ func (mdb *db) selectAllFromNamespace(
 	var rows []sqlplugin.NamespaceRow
 	switch {
 	case filter.GreaterThanID != nil:
-		err = mdb.SelectContext(ctx,
+		err = mdb.replicaSelectContext(ctx,
 			&rows,
 			listNamespacesRangeQuery,
 			partitionID,
@@ -135,7 +135,7 @@ func (mdb *db) selectAllFromNamespace(
 			*filter.PageSize,
 		)
 	default:
-		err = mdb.SelectContext(ctx,
+		err = mdb.replicaSelectContext(ctx,
 			&rows,
 			listNamespacesQuery,
 			partitionID,
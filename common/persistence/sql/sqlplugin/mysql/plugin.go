@@ -41,14 +41,19 @@ func (p *plugin) CreateDB(
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) (sqlplugin.GenericDB, error) {
-	connect := func() (*sqlx.DB, error) {
+	connectTo := func(cfg *config.SQL) (*sqlx.DB, error) {
 		if cfg.Connect != nil {
 			return cfg.Connect(cfg)
 		}
 		return p.createDBConnection(dbKind, cfg, r)
 	}
-	handle := sqlplugin.NewDatabaseHandle(dbKind, connect, isConnNeedsRefreshError, logger, metricsHandler, clock.NewRealTimeSource())
+	connect := func() (*sqlx.DB, error) {
+		return connectTo(cfg)
+	}
+	timeSource := clock.NewRealTimeSource()
+	handle := sqlplugin.NewDatabaseHandle(dbKind, connect, isConnNeedsRefreshError, logger, metricsHandler, timeSource)
 	db := newDB(dbKind, cfg.DatabaseName, handle, nil, logger)
+	db.replicas = sqlplugin.NewReplicaRouter(dbKind, cfg, handle, connectTo, isConnNeedsRefreshError, measureReplicationLag, logger, metricsHandler, timeSource)
 	return db, nil
 }
 
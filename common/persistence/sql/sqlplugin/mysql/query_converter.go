@@ -210,7 +210,7 @@ func (c *queryConverter) BuildCountStmt(
 
 	groupBy := make([]string, 0, len(queryParams.GroupBy)+1)
 	for _, field := range queryParams.GroupBy {
-		groupBy = append(groupBy, sadefs.GetSqlDbColName(field.FieldName))
+		groupBy = append(groupBy, c.buildGroupByExpr(field))
 	}
 
 	groupByClause := ""
@@ -234,6 +234,20 @@ func (c *queryConverter) BuildCountStmt(
 	), nil
 }
 
+// buildGroupByExpr returns the expression to select and group by for the GROUP BY field. Time
+// buckets are truncated to the start of the hour or day.
+func (c *queryConverter) buildGroupByExpr(field *query.GroupByField) string {
+	colName := sadefs.GetSqlDbColName(field.FieldName)
+	switch field.Bucket {
+	case query.TimeBucketHour:
+		return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m-%%d %%H:00:00')", colName)
+	case query.TimeBucketDay:
+		return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m-%%d 00:00:00')", colName)
+	default:
+		return colName
+	}
+}
+
 func (c *queryConverter) buildJSONOverlapsExpr(
 	col *query.SAColumn,
 	value sqlparser.Expr,
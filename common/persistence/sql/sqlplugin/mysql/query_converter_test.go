@@ -261,7 +261,7 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 	tests := []struct {
 		name      string
 		queryExpr sqlparser.Expr
-		groupBy   []*query.SAColumn
+		groupBy   []*query.GroupByField
 		stmt      string
 	}{
 		{
@@ -284,11 +284,33 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 				Left:     keywordCol,
 				Right:    query.NewUnsafeSQLString("foo"),
 			},
-			groupBy: []*query.SAColumn{
-				query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+			groupBy: []*query.GroupByField{
+				{SAColumn: query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
 			},
 			stmt: "SELECT status, COUNT(*) FROM executions_visibility ev LEFT JOIN custom_search_attributes USING (namespace_id, run_id) LEFT JOIN chasm_search_attributes USING (namespace_id, run_id) WHERE Keyword01 = 'foo' GROUP BY status",
 		},
+		{
+			name: "group by multiple fields",
+			groupBy: []*query.GroupByField{
+				{SAColumn: query.NewSAColumn(sadefs.WorkflowType, sadefs.WorkflowType, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
+				{SAColumn: keywordCol},
+				{
+					SAColumn: query.NewSAColumn(sadefs.StartTime, sadefs.StartTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+					Bucket:   query.TimeBucketDay,
+				},
+			},
+			stmt: "SELECT workflow_type_name, Keyword01, DATE_FORMAT(start_time, '%Y-%m-%d 00:00:00'), COUNT(*) FROM executions_visibility ev LEFT JOIN custom_search_attributes USING (namespace_id, run_id) LEFT JOIN chasm_search_attributes USING (namespace_id, run_id) GROUP BY workflow_type_name, Keyword01, DATE_FORMAT(start_time, '%Y-%m-%d 00:00:00')",
+		},
+		{
+			name: "group by hour",
+			groupBy: []*query.GroupByField{
+				{
+					SAColumn: query.NewSAColumn(sadefs.CloseTime, sadefs.CloseTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+					Bucket:   query.TimeBucketHour,
+				},
+			},
+			stmt: "SELECT DATE_FORMAT(close_time, '%Y-%m-%d %H:00:00'), COUNT(*) FROM executions_visibility ev LEFT JOIN custom_search_attributes USING (namespace_id, run_id) LEFT JOIN chasm_search_attributes USING (namespace_id, run_id) GROUP BY DATE_FORMAT(close_time, '%Y-%m-%d %H:00:00')",
+		},
 	}
 
 	for _, tc := range tests {
@@ -230,7 +230,7 @@ func (mdb *db) SelectFromVisibility(
 	}
 
 	var rows []sqlplugin.VisibilityRow
-	err := mdb.SelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
+	err := mdb.replicaSelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -269,7 +269,7 @@ func (mdb *db) CountFromVisibility(
 	filter sqlplugin.VisibilitySelectFilter,
 ) (int64, error) {
 	var count int64
-	err := mdb.GetContext(ctx, &count, filter.Query, filter.QueryArgs...)
+	err := mdb.replicaGetContext(ctx, &count, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return 0, err
 	}
@@ -279,15 +279,8 @@ func (mdb *db) CountFromVisibility(
 func (mdb *db) CountGroupByFromVisibility(
 	ctx context.Context,
 	filter sqlplugin.VisibilitySelectFilter,
-) (_ []sqlplugin.VisibilityCountRow, retError error) {
-	defer func() {
-		retError = mdb.handle.ConvertError(retError)
-	}()
-	db, err := mdb.handle.DB()
-	if err != nil {
-		return nil, err
-	}
-	rows, err := db.QueryContext(ctx, filter.Query, filter.QueryArgs...)
+) ([]sqlplugin.VisibilityCountRow, error) {
+	rows, err := mdb.replicaQueryContext(ctx, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return nil, err
 	}
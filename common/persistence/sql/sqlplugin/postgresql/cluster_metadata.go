@@ -89,7 +89,7 @@ func (pdb *db) ListClusterMetadata(
 	var rows []sqlplugin.ClusterMetadataRow
 	switch {
 	case len(filter.ClusterName) != 0:
-		err = pdb.SelectContext(ctx,
+		err = pdb.replicaSelectContext(ctx,
 			&rows,
 			listClusterMetadataRangeQry,
 			constMetadataPartition,
@@ -97,7 +97,7 @@ func (pdb *db) ListClusterMetadata(
 			filter.PageSize,
 		)
 	default:
-		err = pdb.SelectContext(ctx,
+		err = pdb.replicaSelectContext(ctx,
 			&rows,
 			listClusterMetadataQry,
 			constMetadataPartition,
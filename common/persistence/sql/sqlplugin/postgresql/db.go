@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"go.temporal.io/server/common/config"
@@ -35,8 +36,9 @@ type db struct {
 	converter DataConverter
 	logger    log.Logger
 
-	handle *sqlplugin.DatabaseHandle
-	tx     *sqlx.Tx
+	handle   *sqlplugin.DatabaseHandle
+	replicas *sqlplugin.ReplicaRouter
+	tx       *sqlx.Tx
 }
 
 var _ sqlplugin.DB = (*db)(nil)
@@ -86,6 +88,9 @@ func (pdb *db) BeginTx(ctx context.Context) (sqlplugin.Tx, error) {
 
 // Close closes the connection to the mysql db
 func (pdb *db) Close() error {
+	if pdb.replicas != nil {
+		pdb.replicas.Close()
+	}
 	pdb.handle.Close()
 	return nil
 }
@@ -175,3 +180,40 @@ func (pdb *db) QueryContext(ctx context.Context, query string, args ...any) (*sq
 func (pdb *db) Rebind(query string) string {
 	return pdb.conn().Rebind(query)
 }
+
+// Helper methods for read-only queries that tolerate replication lag, they are sent to a read replica if one is usable
+func (pdb *db) replicaGetContext(ctx context.Context, dest any, query string, args ...any) error {
+	if pdb.tx != nil || pdb.replicas == nil {
+		return pdb.GetContext(ctx, dest, query, args...)
+	}
+	return pdb.replicas.GetContext(ctx, dest, query, args...)
+}
+
+func (pdb *db) replicaSelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	if pdb.tx != nil || pdb.replicas == nil {
+		return pdb.SelectContext(ctx, dest, query, args...)
+	}
+	return pdb.replicas.SelectContext(ctx, dest, query, args...)
+}
+
+func (pdb *db) replicaQueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if pdb.tx != nil || pdb.replicas == nil {
+		return pdb.QueryContext(ctx, query, args...)
+	}
+	return pdb.replicas.QueryContext(ctx, query, args...)
+}
+
+// A replica that has replayed all the WAL it received is caught up, even if the primary has been idle since the
+// last replayed transaction.
+const replicationLagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END::float8`
+
+func measureReplicationLag(ctx context.Context, db *sqlx.DB) (time.Duration, error) {
+	var lagSeconds float64
+	if err := db.GetContext(ctx, &lagSeconds, replicationLagQuery); err != nil {
+		return 0, err
+	}
+	return time.Duration(lagSeconds * float64(time.Second)), nil
+}
@@ -22,6 +22,12 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
+	listExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = $1 ORDER BY namespace_id, workflow_id, run_id LIMIT $2`
+
+	listExecutionsAfterQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = $1 AND (namespace_id, workflow_id, run_id) > ($2, $3, $4) ORDER BY namespace_id, workflow_id, run_id LIMIT $5`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
@@ -222,6 +228,37 @@ func (pdb *db) SelectFromExecutions(
 	return &row, nil
 }
 
+// RangeSelectFromExecutions reads a page of rows from executions table
+func (pdb *db) RangeSelectFromExecutions(
+	ctx context.Context,
+	filter sqlplugin.ExecutionsRangeFilter,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	var err error
+	if filter.NamespaceID == nil {
+		err = pdb.SelectContext(ctx,
+			&rows,
+			listExecutionsQuery,
+			filter.ShardID,
+			filter.PageSize,
+		)
+	} else {
+		err = pdb.SelectContext(ctx,
+			&rows,
+			listExecutionsAfterQuery,
+			filter.ShardID,
+			filter.NamespaceID,
+			filter.WorkflowID,
+			filter.RunID,
+			filter.PageSize,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (pdb *db) DeleteFromExecutions(
 	ctx context.Context,
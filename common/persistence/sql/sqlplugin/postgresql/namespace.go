@@ -114,7 +114,7 @@ func (pdb *db) selectAllFromNamespace(
 	var rows []sqlplugin.NamespaceRow
 	switch {
 	case filter.GreaterThanID != nil:
-		err = pdb.SelectContext(ctx,
+		err = pdb.replicaSelectContext(ctx,
 			&rows,
 			listNamespacesRangeQuery,
 			partitionID,
@@ -122,7 +122,7 @@ func (pdb *db) selectAllFromNamespace(
 			*filter.PageSize,
 		)
 	default:
-		err = pdb.SelectContext(ctx,
+		err = pdb.replicaSelectContext(ctx,
 			&rows,
 			listNamespacesQuery,
 			partitionID,
@@ -58,15 +58,20 @@ func (p *plugin) CreateDB(
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) (sqlplugin.GenericDB, error) {
-	connect := func() (*sqlx.DB, error) {
+	connectTo := func(cfg *config.SQL) (*sqlx.DB, error) {
 		if cfg.Connect != nil {
 			return cfg.Connect(cfg)
 		}
 		return p.createDBConnection(cfg, r)
 	}
+	connect := func() (*sqlx.DB, error) {
+		return connectTo(cfg)
+	}
 	needsRefresh := p.driver.IsConnNeedsRefreshError
-	handle := sqlplugin.NewDatabaseHandle(dbKind, connect, needsRefresh, logger, metricsHandler, clock.NewRealTimeSource())
+	timeSource := clock.NewRealTimeSource()
+	handle := sqlplugin.NewDatabaseHandle(dbKind, connect, needsRefresh, logger, metricsHandler, timeSource)
 	db := newDB(dbKind, cfg.DatabaseName, p.driver, handle, nil, logger)
+	db.replicas = sqlplugin.NewReplicaRouter(dbKind, cfg, handle, connectTo, needsRefresh, measureReplicationLag, logger, metricsHandler, timeSource)
 	return db, nil
 }
 
@@ -17,22 +17,34 @@ const (
 	jsonBuildArrayFuncName = "jsonb_build_array"
 	jsonContainsOp         = "@>"
 	ftsMatchOp             = "@@"
+	plainToTSQueryFuncName = "plainto_tsquery"
+	// ftsConfigName is the text search configuration used to build the tsvector of Text columns. The simple
+	// configuration lowercases words without stemming or removing stop words, like the standard analyzer of
+	// Elasticsearch.
+	ftsConfigName = "simple"
 )
 
-var (
-	convertTypeTSQuery = &sqlparser.ConvertType{Type: "tsquery"}
-)
-
-type pgCastExpr struct {
+// tsQueryOrExpr is the disjunction of tsqueries: `(q1 || q2 || ...)`.
+type tsQueryOrExpr struct {
 	sqlparser.Expr
-	Value sqlparser.Expr
-	Type  *sqlparser.ConvertType
+	Queries []sqlparser.Expr
 }
 
-var _ sqlparser.Expr = (*pgCastExpr)(nil)
+var _ sqlparser.Expr = (*tsQueryOrExpr)(nil)
 
-func (node *pgCastExpr) Format(buf *sqlparser.TrackedBuffer) {
-	buf.Myprintf("%v::%v", node.Value, node.Type)
+func (node *tsQueryOrExpr) Format(buf *sqlparser.TrackedBuffer) {
+	if len(node.Queries) == 1 {
+		buf.Myprintf("%v", node.Queries[0])
+		return
+	}
+	buf.Myprintf("(")
+	for i, q := range node.Queries {
+		if i > 0 {
+			buf.Myprintf(" || ")
+		}
+		buf.Myprintf("%v", q)
+	}
+	buf.Myprintf(")")
 }
 
 type queryConverter struct{}
@@ -109,14 +121,10 @@ func (c *queryConverter) ConvertTextComparisonExpr(
 			query.InvalidExpressionErrMessage,
 		)
 	}
-	valueExpr.Val = strings.Join(tokens, " | ")
 	var newExpr sqlparser.Expr = &sqlparser.ComparisonExpr{
 		Operator: ftsMatchOp,
 		Left:     col,
-		Right: &pgCastExpr{
-			Value: value,
-			Type:  convertTypeTSQuery,
-		},
+		Right:    newTSQueryOrExpr(tokens),
 	}
 	if operator == sqlparser.NotEqualStr {
 		newExpr = &sqlparser.NotExpr{Expr: newExpr}
@@ -193,7 +201,7 @@ func (c *queryConverter) BuildCountStmt(
 
 	groupBy := make([]string, 0, len(queryParams.GroupBy)+1)
 	for _, field := range queryParams.GroupBy {
-		groupBy = append(groupBy, sadefs.GetSqlDbColName(field.FieldName))
+		groupBy = append(groupBy, c.buildGroupByExpr(field))
 	}
 
 	groupByClause := ""
@@ -209,6 +217,18 @@ func (c *queryConverter) BuildCountStmt(
 	), nil
 }
 
+// buildGroupByExpr returns the expression to select and group by for the GROUP BY field. Time
+// buckets are truncated to the start of the hour or day.
+func (c *queryConverter) buildGroupByExpr(field *query.GroupByField) string {
+	colName := sadefs.GetSqlDbColName(field.FieldName)
+	switch field.Bucket {
+	case query.TimeBucketHour, query.TimeBucketDay:
+		return fmt.Sprintf("date_trunc('%s', %s)", field.Bucket, colName)
+	default:
+		return colName
+	}
+}
+
 func (c *queryConverter) convertInExpr(
 	leftExpr sqlparser.Expr,
 	values sqlparser.ValTuple,
@@ -244,3 +264,18 @@ func newJSONContainsExpr(
 		Right:    query.NewFuncExpr(jsonBuildArrayFuncName, valueExpr),
 	}
 }
+
+// newTSQueryOrExpr builds a tsquery that matches any of the tokens, like the match query of Elasticsearch.
+// Each token goes through plainto_tsquery, so it is normalized like the indexed text and characters with a meaning
+// in the tsquery syntax are ignored.
+func newTSQueryOrExpr(tokens []string) sqlparser.Expr {
+	queries := make([]sqlparser.Expr, len(tokens))
+	for i, token := range tokens {
+		queries[i] = query.NewFuncExpr(
+			plainToTSQueryFuncName,
+			query.NewUnsafeSQLString(ftsConfigName),
+			query.NewUnsafeSQLString(token),
+		)
+	}
+	return &tsQueryOrExpr{Queries: queries}
+}
@@ -135,14 +135,28 @@ func TestQueryConverter_ConvertTextComparisonExpr(t *testing.T) {
 			operator: sqlparser.EqualStr,
 			col:      textCol,
 			value:    query.NewUnsafeSQLString("foo bar"),
-			out:      "Text01 @@ 'foo | bar'::tsquery",
+			out:      "Text01 @@ (plainto_tsquery('simple', 'foo') || plainto_tsquery('simple', 'bar'))",
+		},
+		{
+			name:     "valid equal expression with single token",
+			operator: sqlparser.EqualStr,
+			col:      textCol,
+			value:    query.NewUnsafeSQLString("  foo "),
+			out:      "Text01 @@ plainto_tsquery('simple', 'foo')",
+		},
+		{
+			name:     "valid equal expression with tsquery operators",
+			operator: sqlparser.EqualStr,
+			col:      textCol,
+			value:    query.NewUnsafeSQLString("foo&bar !baz"),
+			out:      "Text01 @@ (plainto_tsquery('simple', 'foo&bar') || plainto_tsquery('simple', '!baz'))",
 		},
 		{
 			name:     "valid not equal expression",
 			operator: sqlparser.NotEqualStr,
 			col:      textCol,
 			value:    query.NewUnsafeSQLString("foo bar"),
-			out:      "not Text01 @@ 'foo | bar'::tsquery",
+			out:      "not Text01 @@ (plainto_tsquery('simple', 'foo') || plainto_tsquery('simple', 'bar'))",
 		},
 		{
 			name:     "invalid value type",
@@ -278,7 +292,7 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 	tests := []struct {
 		name      string
 		queryExpr sqlparser.Expr
-		groupBy   []*query.SAColumn
+		groupBy   []*query.GroupByField
 		stmt      string
 	}{
 		{
@@ -301,11 +315,33 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 				Left:     keywordCol,
 				Right:    query.NewUnsafeSQLString("foo"),
 			},
-			groupBy: []*query.SAColumn{
-				query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+			groupBy: []*query.GroupByField{
+				{SAColumn: query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
 			},
 			stmt: "SELECT status, COUNT(*) FROM executions_visibility WHERE Keyword01 = 'foo' GROUP BY status",
 		},
+		{
+			name: "group by multiple fields",
+			groupBy: []*query.GroupByField{
+				{SAColumn: query.NewSAColumn(sadefs.WorkflowType, sadefs.WorkflowType, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
+				{SAColumn: keywordCol},
+				{
+					SAColumn: query.NewSAColumn(sadefs.StartTime, sadefs.StartTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+					Bucket:   query.TimeBucketDay,
+				},
+			},
+			stmt: "SELECT workflow_type_name, Keyword01, date_trunc('day', start_time), COUNT(*) FROM executions_visibility GROUP BY workflow_type_name, Keyword01, date_trunc('day', start_time)",
+		},
+		{
+			name: "group by hour",
+			groupBy: []*query.GroupByField{
+				{
+					SAColumn: query.NewSAColumn(sadefs.CloseTime, sadefs.CloseTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+					Bucket:   query.TimeBucketHour,
+				},
+			},
+			stmt: "SELECT date_trunc('hour', close_time), COUNT(*) FROM executions_visibility GROUP BY date_trunc('hour', close_time)",
+		},
 	}
 
 	for _, tc := range tests {
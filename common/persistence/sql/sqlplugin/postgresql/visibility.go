@@ -98,7 +98,7 @@ func (pdb *db) SelectFromVisibility(
 	}
 	filter.Query = db.Rebind(filter.Query)
 	var rows []sqlplugin.VisibilityRow
-	err = db.SelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
+	err = pdb.replicaSelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +138,7 @@ func (pdb *db) CountFromVisibility(
 ) (int64, error) {
 	var count int64
 	filter.Query = pdb.Rebind(filter.Query)
-	err := pdb.GetContext(ctx, &count, filter.Query, filter.QueryArgs...)
+	err := pdb.replicaGetContext(ctx, &count, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return 0, err
 	}
@@ -150,7 +150,7 @@ func (pdb *db) CountGroupByFromVisibility(
 	filter sqlplugin.VisibilitySelectFilter,
 ) ([]sqlplugin.VisibilityCountRow, error) {
 	filter.Query = pdb.Rebind(filter.Query)
-	rows, err := pdb.QueryContext(ctx, filter.Query, filter.QueryArgs...)
+	rows, err := pdb.replicaQueryContext(ctx, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return nil, err
 	}
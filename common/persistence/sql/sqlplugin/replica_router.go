@@ -0,0 +1,244 @@
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"maps"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	defaultMaxReplicationLag = 5 * time.Second
+	defaultLagCheckInterval  = 5 * time.Second
+
+	sqlTargetPrimary = "primary"
+	sqlTargetReplica = "replica"
+
+	replicaFallbackNoHealthyReplica metrics.ReasonString = "no_healthy_replica"
+	replicaFallbackReplicaError     metrics.ReasonString = "replica_error"
+)
+
+type (
+	// MeasureReplicationLag returns how far behind its primary a replica is.
+	MeasureReplicationLag func(ctx context.Context, db *sqlx.DB) (time.Duration, error)
+
+	// ReplicaRouter sends read-only queries that tolerate replication lag to read replicas. A replica only serves
+	// queries while its last measured replication lag is below the configured maximum, and queries fall back to
+	// the primary when no replica is usable.
+	ReplicaRouter struct {
+		primary          *DatabaseHandle
+		replicas         []*readReplica
+		maxLag           time.Duration
+		lagCheckInterval time.Duration
+		measureLag       MeasureReplicationLag
+		next             atomic.Uint64
+
+		metrics metrics.Handler
+		logger  log.Logger
+
+		quit    chan struct{}
+		wg      sync.WaitGroup
+		stopped atomic.Bool
+	}
+
+	readReplica struct {
+		addr    string
+		handle  *DatabaseHandle
+		healthy atomic.Bool
+	}
+)
+
+// ReplicaConfig returns the config used to connect to the replica at addr.
+func ReplicaConfig(cfg *config.SQL, addr string) *config.SQL {
+	replicaCfg := *cfg
+	replicaCfg.ConnectAddr = addr
+	replicaCfg.ReadReplicas = nil
+	if len(cfg.ReadReplicas.ConnectAttributes) > 0 {
+		replicaCfg.ConnectAttributes = maps.Clone(cfg.ConnectAttributes)
+		if replicaCfg.ConnectAttributes == nil {
+			replicaCfg.ConnectAttributes = make(map[string]string, len(cfg.ReadReplicas.ConnectAttributes))
+		}
+		maps.Copy(replicaCfg.ConnectAttributes, cfg.ReadReplicas.ConnectAttributes)
+	}
+	return &replicaCfg
+}
+
+// NewReplicaRouter creates a ReplicaRouter for the replicas configured in cfg, or returns nil if there are none.
+// connect opens a connection with the given config, it is called with the result of ReplicaConfig.
+func NewReplicaRouter(
+	dbKind DbKind,
+	cfg *config.SQL,
+	primary *DatabaseHandle,
+	connect func(*config.SQL) (*sqlx.DB, error),
+	needsRefresh func(error) bool,
+	measureLag MeasureReplicationLag,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
+) *ReplicaRouter {
+	if cfg.ReadReplicas == nil || len(cfg.ReadReplicas.ConnectAddrs) == 0 {
+		return nil
+	}
+	router := &ReplicaRouter{
+		primary:          primary,
+		maxLag:           cfg.ReadReplicas.MaxReplicationLag,
+		lagCheckInterval: cfg.ReadReplicas.LagCheckInterval,
+		measureLag:       measureLag,
+		metrics:          metricsHandler.WithTags(metrics.PersistenceDBKindTag(dbKind.String())),
+		logger:           logger,
+		quit:             make(chan struct{}),
+	}
+	if router.maxLag == 0 {
+		router.maxLag = defaultMaxReplicationLag
+	}
+	if router.lagCheckInterval == 0 {
+		router.lagCheckInterval = defaultLagCheckInterval
+	}
+	for _, addr := range cfg.ReadReplicas.ConnectAddrs {
+		replicaCfg := ReplicaConfig(cfg, addr)
+		handle := NewDatabaseHandle(
+			dbKind,
+			func() (*sqlx.DB, error) { return connect(replicaCfg) },
+			needsRefresh,
+			log.With(logger, tag.NewStringTag("sql-replica", addr)),
+			metricsHandler.WithTags(metrics.StringTag(metrics.SQLTargetTagName, addr)),
+			timeSource,
+		)
+		router.replicas = append(router.replicas, &readReplica{addr: addr, handle: handle})
+	}
+	router.wg.Add(1)
+	go router.run()
+	return router
+}
+
+// Close stops measuring the replication lag and closes the replica connections.
+func (r *ReplicaRouter) Close() {
+	if r.stopped.CompareAndSwap(false, true) {
+		close(r.quit)
+	}
+	r.wg.Wait()
+	for _, replica := range r.replicas {
+		replica.handle.Close()
+	}
+}
+
+func (r *ReplicaRouter) run() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(r.lagCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		r.checkReplicationLag()
+		select {
+		case <-r.quit:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkReplicationLag measures the replication lag of every replica and updates which ones may serve queries.
+func (r *ReplicaRouter) checkReplicationLag() {
+	for _, replica := range r.replicas {
+		healthy := r.isReplicaHealthy(replica)
+		if replica.healthy.Swap(healthy) != healthy {
+			if healthy {
+				r.logger.Info("sql replica router: replica is serving read-only queries", tag.NewStringTag("sql-replica", replica.addr))
+			} else {
+				r.logger.Warn("sql replica router: replica stopped serving read-only queries", tag.NewStringTag("sql-replica", replica.addr))
+			}
+		}
+	}
+}
+
+func (r *ReplicaRouter) isReplicaHealthy(replica *readReplica) bool {
+	db, err := replica.handle.DB()
+	if err != nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.lagCheckInterval)
+	defer cancel()
+	lag, err := r.measureLag(ctx, db)
+	if err != nil {
+		r.logger.Warn("sql replica router: unable to measure replication lag",
+			tag.NewStringTag("sql-replica", replica.addr), tag.Error(replica.handle.ConvertError(err)))
+		return false
+	}
+	metrics.PersistenceSQLReplicaLag.With(r.metrics).Record(
+		lag.Seconds(), metrics.StringTag(metrics.SQLTargetTagName, replica.addr))
+	return lag <= r.maxLag
+}
+
+// pick returns the next healthy replica in round-robin order, or nil if there is none.
+func (r *ReplicaRouter) pick() *readReplica {
+	start := r.next.Add(1)
+	for i := range uint64(len(r.replicas)) {
+		replica := r.replicas[(start+i)%uint64(len(r.replicas))]
+		if replica.healthy.Load() {
+			return replica
+		}
+	}
+	return nil
+}
+
+// do runs query on a healthy replica, falling back to the primary if there is none or if the replica is unreachable.
+func (r *ReplicaRouter) do(query func(conn Conn) error) error {
+	replica := r.pick()
+	if replica == nil {
+		metrics.PersistenceSQLReplicaFallbacks.With(r.metrics).Record(1, metrics.ReasonTag(replicaFallbackNoHealthyReplica))
+	} else {
+		err := replica.handle.ConvertError(query(replica.handle.Conn()))
+		var unavailableErr *serviceerror.Unavailable
+		if !errors.As(err, &unavailableErr) {
+			metrics.PersistenceSQLReadRequests.With(r.metrics).Record(1, metrics.StringTag(metrics.SQLTargetTagName, sqlTargetReplica))
+			return err
+		}
+		// Stop using the replica until the next lag check confirms it is reachable again.
+		replica.healthy.Store(false)
+		r.logger.Warn("sql replica router: replica query failed, retrying on primary",
+			tag.NewStringTag("sql-replica", replica.addr), tag.Error(err))
+		metrics.PersistenceSQLReplicaFallbacks.With(r.metrics).Record(1, metrics.ReasonTag(replicaFallbackReplicaError))
+	}
+	metrics.PersistenceSQLReadRequests.With(r.metrics).Record(1, metrics.StringTag(metrics.SQLTargetTagName, sqlTargetPrimary))
+	return r.primary.ConvertError(query(r.primary.Conn()))
+}
+
+// GetContext runs a read-only query that returns a single row on a replica or on the primary.
+func (r *ReplicaRouter) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	return r.do(func(conn Conn) error {
+		return conn.GetContext(ctx, dest, query, args...)
+	})
+}
+
+// SelectContext runs a read-only query on a replica or on the primary.
+func (r *ReplicaRouter) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	return r.do(func(conn Conn) error {
+		return conn.SelectContext(ctx, dest, query, args...)
+	})
+}
+
+// QueryContext runs a read-only query on a replica or on the primary. The caller must close the returned rows.
+func (r *ReplicaRouter) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	var rows *sql.Rows
+	err := r.do(func(conn Conn) error {
+		db, ok := conn.(*sqlx.DB)
+		if !ok {
+			return DatabaseUnavailableError
+		}
+		var err error
+		rows, err = db.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
@@ -0,0 +1,135 @@
+package sqlplugin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	_ "modernc.org/sqlite"
+)
+
+func newTestSQLiteDB(t *testing.T, value string) *sqlx.DB {
+	db, err := sqlx.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	// Every connection to :memory: opens a different database.
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec("CREATE TABLE source (name TEXT)")
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO source (name) VALUES (?)", value)
+	require.NoError(t, err)
+	return db
+}
+
+func TestReplicaRouter(t *testing.T) {
+	primaryDB := newTestSQLiteDB(t, "primary")
+	replicaDB := newTestSQLiteDB(t, "replica")
+	needsRefresh := func(err error) bool {
+		return err != nil && strings.Contains(err.Error(), "database is closed")
+	}
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	primary := NewDatabaseHandle(DbKindMain, func() (*sqlx.DB, error) { return primaryDB, nil },
+		needsRefresh, log.NewNoopLogger(), metrics.NoopMetricsHandler, timeSource)
+	defer primary.Close()
+
+	var lag atomic.Int64
+	var lagErr atomic.Bool
+	measureLag := func(context.Context, *sqlx.DB) (time.Duration, error) {
+		if lagErr.Load() {
+			return 0, errors.New("replication is not running")
+		}
+		return time.Duration(lag.Load()), nil
+	}
+	cfg := &config.SQL{
+		ReadReplicas: &config.SQLReadReplicas{
+			ConnectAddrs:      []string{"replica:5432"},
+			MaxReplicationLag: time.Second,
+			LagCheckInterval:  time.Hour,
+		},
+	}
+	router := NewReplicaRouter(
+		DbKindMain,
+		cfg,
+		primary,
+		func(replicaCfg *config.SQL) (*sqlx.DB, error) {
+			require.Equal(t, "replica:5432", replicaCfg.ConnectAddr)
+			return replicaDB, nil
+		},
+		needsRefresh,
+		measureLag,
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+		timeSource,
+	)
+	require.NotNil(t, router)
+	defer router.Close()
+	require.Eventually(t, func() bool { return router.replicas[0].healthy.Load() }, 10*time.Second, 10*time.Millisecond)
+
+	requireSource := func(expected string) {
+		var name string
+		require.NoError(t, router.GetContext(context.Background(), &name, "SELECT name FROM source"))
+		require.Equal(t, expected, name)
+		var names []string
+		require.NoError(t, router.SelectContext(context.Background(), &names, "SELECT name FROM source"))
+		require.Equal(t, []string{expected}, names)
+		rows, err := router.QueryContext(context.Background(), "SELECT name FROM source")
+		require.NoError(t, err)
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&name))
+		require.NoError(t, rows.Close())
+		require.Equal(t, expected, name)
+	}
+	requireSource("replica")
+
+	// A lagging replica does not serve queries until it catches up.
+	lag.Store(int64(2 * time.Second))
+	router.checkReplicationLag()
+	requireSource("primary")
+	lag.Store(int64(500 * time.Millisecond))
+	router.checkReplicationLag()
+	requireSource("replica")
+
+	// Neither does a replica whose lag cannot be measured.
+	lagErr.Store(true)
+	router.checkReplicationLag()
+	requireSource("primary")
+	lagErr.Store(false)
+	router.checkReplicationLag()
+	requireSource("replica")
+
+	// Query errors are returned as is.
+	var name string
+	require.Error(t, router.GetContext(context.Background(), &name, "SELECT name FROM missing"))
+	require.True(t, router.replicas[0].healthy.Load())
+
+	// Queries fall back to the primary when the replica connection is lost.
+	require.NoError(t, replicaDB.Close())
+	requireSource("primary")
+	require.False(t, router.replicas[0].healthy.Load())
+}
+
+func TestReplicaConfig(t *testing.T) {
+	cfg := &config.SQL{
+		ConnectAddr:       "primary:3306",
+		ConnectAttributes: map[string]string{"tx_isolation": "'READ-COMMITTED'", "timeout": "5s"},
+		ReadReplicas: &config.SQLReadReplicas{
+			ConnectAddrs:      []string{"replica:3306"},
+			ConnectAttributes: map[string]string{"timeout": "1s"},
+		},
+	}
+	replicaCfg := ReplicaConfig(cfg, "replica:3306")
+	require.Equal(t, "replica:3306", replicaCfg.ConnectAddr)
+	require.Nil(t, replicaCfg.ReadReplicas)
+	require.Equal(t, map[string]string{"tx_isolation": "'READ-COMMITTED'", "timeout": "1s"}, replicaCfg.ConnectAttributes)
+	require.Equal(t, "5s", cfg.ConnectAttributes["timeout"])
+
+	require.Nil(t, NewReplicaRouter(DbKindMain, &config.SQL{}, nil, nil, nil, nil, log.NewNoopLogger(), metrics.NoopMetricsHandler, clock.NewRealTimeSource()))
+}
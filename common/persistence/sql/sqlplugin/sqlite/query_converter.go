@@ -217,7 +217,7 @@ func (c *queryConverter) BuildCountStmt(
 
 	groupBy := make([]string, 0, len(queryParams.GroupBy)+1)
 	for _, field := range queryParams.GroupBy {
-		groupBy = append(groupBy, sadefs.GetSqlDbColName(field.FieldName))
+		groupBy = append(groupBy, c.buildGroupByExpr(field))
 	}
 
 	groupByClause := ""
@@ -233,6 +233,20 @@ func (c *queryConverter) BuildCountStmt(
 	), nil
 }
 
+// buildGroupByExpr returns the expression to select and group by for the GROUP BY field. Time
+// buckets are truncated to the start of the hour or day.
+func (c *queryConverter) buildGroupByExpr(field *query.GroupByField) string {
+	colName := sadefs.GetSqlDbColName(field.FieldName)
+	switch field.Bucket {
+	case query.TimeBucketHour:
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d %%H:00:00', %s)", colName)
+	case query.TimeBucketDay:
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d 00:00:00', %s)", colName)
+	default:
+		return colName
+	}
+}
+
 // buildFtsSelectStmt builds the following statement for querying FTS:
 //
 //	SELECT rowid FROM tableName WHERE tableName = '%s'
@@ -296,7 +296,7 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 	tests := []struct {
 		name      string
 		queryExpr sqlparser.Expr
-		groupBy   []*query.SAColumn
+		groupBy   []*query.GroupByField
 		stmt      string
 	}{
 		{
@@ -319,11 +319,33 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 				Left:     keywordCol,
 				Right:    query.NewUnsafeSQLString("foo"),
 			},
-			groupBy: []*query.SAColumn{
-				query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+			groupBy: []*query.GroupByField{
+				{SAColumn: query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
 			},
 			stmt: "SELECT status, COUNT(*) FROM executions_visibility WHERE Keyword01 = 'foo' GROUP BY status",
 		},
+		{
+			name: "group by multiple fields",
+			groupBy: []*query.GroupByField{
+				{SAColumn: query.NewSAColumn(sadefs.WorkflowType, sadefs.WorkflowType, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
+				{SAColumn: keywordCol},
+				{
+					SAColumn: query.NewSAColumn(sadefs.StartTime, sadefs.StartTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+					Bucket:   query.TimeBucketDay,
+				},
+			},
+			stmt: "SELECT workflow_type_name, Keyword01, strftime('%Y-%m-%d 00:00:00', start_time), COUNT(*) FROM executions_visibility GROUP BY workflow_type_name, Keyword01, strftime('%Y-%m-%d 00:00:00', start_time)",
+		},
+		{
+			name: "group by hour",
+			groupBy: []*query.GroupByField{
+				{
+					SAColumn: query.NewSAColumn(sadefs.CloseTime, sadefs.CloseTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+					Bucket:   query.TimeBucketHour,
+				},
+			},
+			stmt: "SELECT strftime('%Y-%m-%d %H:00:00', close_time), COUNT(*) FROM executions_visibility GROUP BY strftime('%Y-%m-%d %H:00:00', close_time)",
+		},
 	}
 
 	for _, tc := range tests {
@@ -54,6 +54,15 @@ func NewHistoryEventsSuite(
 	t *testing.T,
 	store p.ExecutionStore,
 	logger log.Logger,
+) *HistoryEventsSuite {
+	return newHistoryEventsSuite(t, store, logger, nil)
+}
+
+func newHistoryEventsSuite(
+	t *testing.T,
+	store p.ExecutionStore,
+	logger log.Logger,
+	historyTiering *p.HistoryTieringConfig,
 ) *HistoryEventsSuite {
 	serializer := serialization.NewSerializer()
 	return &HistoryEventsSuite{
@@ -66,6 +75,7 @@ func NewHistoryEventsSuite(
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
 			dynamicconfig.GetBoolPropertyFn(false),
+			historyTiering,
 		),
 		serializer: serializer,
 		logger:     logger,
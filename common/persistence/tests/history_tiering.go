@@ -0,0 +1,255 @@
+package tests
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+type (
+	// HistoryTieringSuite runs the history events tests with history nodes offloaded to a blob store
+	// as soon as the background job picks them up.
+	HistoryTieringSuite struct {
+		*HistoryEventsSuite
+
+		executionStore p.ExecutionStore
+		blobStore      *memoryHistoryBlobStore
+	}
+
+	memoryHistoryBlobStore struct {
+		sync.Mutex
+		blobs map[string][]byte
+		// putHook is called with the key before a blob is stored.
+		putHook func(key string)
+	}
+)
+
+func NewHistoryTieringSuite(
+	t *testing.T,
+	store p.ExecutionStore,
+	logger log.Logger,
+) *HistoryTieringSuite {
+	blobStore := &memoryHistoryBlobStore{blobs: make(map[string][]byte)}
+	return &HistoryTieringSuite{
+		HistoryEventsSuite: newHistoryEventsSuite(t, store, logger, &p.HistoryTieringConfig{
+			BlobStore: blobStore,
+			ChunkSize: dynamicconfig.GetIntPropertyFn(2),
+			MinAge:    dynamicconfig.GetDurationPropertyFn(0),
+		}),
+		executionStore: store,
+		blobStore:      blobStore,
+	}
+}
+
+func (s *HistoryTieringSuite) TestOffloadForkDeleteBranch() {
+	treeID := uuid.NewString()
+	branchID := uuid.NewString()
+	br1Token, err := s.store.GetHistoryBranchUtil().NewHistoryBranch(
+		uuid.NewString(),
+		uuid.NewString(),
+		uuid.NewString(),
+		treeID,
+		&branchID,
+		[]*persistencespb.HistoryBranchRange{},
+		time.Duration(0),
+		time.Duration(0),
+		time.Duration(0),
+	)
+	s.NoError(err)
+
+	var events []*historypb.HistoryEvent
+	var prevTransactionID int64
+	transactionID := rand.Int63()
+	for eventID := int64(1); eventID <= 10; eventID++ {
+		packet := s.newHistoryEvents([]int64{eventID}, transactionID, prevTransactionID)
+		s.appendHistoryEvents(s.ShardID, br1Token, packet)
+		events = append(events, packet.events...)
+		prevTransactionID = transactionID
+		transactionID++
+	}
+
+	// Nodes [1, 2), [2, 4), [4, 6) and [6, 8) are offloaded in the background, the last two chunks are not.
+	s.Eventually(func() bool {
+		nodes, err := s.executionStore.ReadHistoryBranch(s.Ctx, &p.InternalReadHistoryBranchRequest{
+			ShardID:     s.ShardID,
+			BranchToken: br1Token,
+			BranchID:    branchID,
+			MinNodeID:   common.FirstEventID,
+			MaxNodeID:   common.EndEventID,
+			PageSize:    100,
+		})
+		s.NoError(err)
+		s.Len(nodes.Nodes, 10)
+		for _, node := range nodes.Nodes {
+			if node.NodeID < 8 != serialization.IsOffloadedBlob(node.Events) {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 100*time.Millisecond)
+	s.Len(s.blobStore.keys(treeID), 4)
+	protorequire.ProtoSliceEqual(s.T(), events, s.listAllHistoryEvents(s.ShardID, br1Token))
+	reverse, err := s.store.ReadHistoryBranchReverse(s.Ctx, &p.ReadHistoryBranchReverseRequest{
+		ShardID:                s.ShardID,
+		BranchToken:            br1Token,
+		MaxEventID:             common.EmptyEventID,
+		LastFirstTransactionID: prevTransactionID,
+		PageSize:               100,
+	})
+	s.NoError(err)
+	reversed := slices.Clone(events)
+	slices.Reverse(reversed)
+	protorequire.ProtoSliceEqual(s.T(), reversed, reverse.HistoryEvents)
+
+	br2Token := s.forkHistoryBranch(s.ShardID, br1Token, 5)
+	packet := s.newHistoryEvents([]int64{5}, transactionID, 4)
+	s.appendHistoryEvents(s.ShardID, br2Token, packet)
+	protorequire.ProtoSliceEqual(s.T(), append(slices.Clone(events[:4]), packet.events...), s.listAllHistoryEvents(s.ShardID, br2Token))
+
+	// The chunk [4, 6) is still used by branch2.
+	s.deleteHistoryBranch(s.ShardID, br1Token)
+	s.Len(s.blobStore.keys(treeID), 3)
+	protorequire.ProtoSliceEqual(s.T(), append(slices.Clone(events[:4]), packet.events...), s.listAllHistoryEvents(s.ShardID, br2Token))
+
+	s.deleteHistoryBranch(s.ShardID, br2Token)
+	s.Empty(s.blobStore.keys(treeID))
+}
+
+func (s *HistoryTieringSuite) TestDeleteBranchDuringOffload() {
+	treeID := uuid.NewString()
+	branchID := uuid.NewString()
+	brToken, err := s.store.GetHistoryBranchUtil().NewHistoryBranch(
+		uuid.NewString(),
+		uuid.NewString(),
+		uuid.NewString(),
+		treeID,
+		&branchID,
+		[]*persistencespb.HistoryBranchRange{},
+		time.Duration(0),
+		time.Duration(0),
+		time.Duration(0),
+	)
+	s.NoError(err)
+
+	// Block the upload of the chunk of the branch until the branch deletion started.
+	putStarted := make(chan struct{})
+	releasePut := make(chan struct{})
+	var putOnce sync.Once
+	s.blobStore.setPutHook(func(key string) {
+		if strings.HasPrefix(key, treeID) {
+			putOnce.Do(func() { close(putStarted) })
+			<-releasePut
+		}
+	})
+	defer s.blobStore.setPutHook(nil)
+	var releaseOnce sync.Once
+	release := func() { releaseOnce.Do(func() { close(releasePut) }) }
+	defer release()
+
+	var prevTransactionID int64
+	transactionID := rand.Int63()
+	for eventID := int64(1); eventID <= 4; eventID++ {
+		s.appendHistoryEvents(s.ShardID, brToken, s.newHistoryEvents([]int64{eventID}, transactionID, prevTransactionID))
+		prevTransactionID = transactionID
+		transactionID++
+	}
+	select {
+	case <-putStarted:
+	case <-time.After(10 * time.Second):
+		s.FailNow("the chunk [1, 2) was not offloaded")
+	}
+
+	deleted := make(chan error, 1)
+	go func() {
+		deleted <- s.store.DeleteHistoryBranch(s.Ctx, &p.DeleteHistoryBranchRequest{
+			ShardID:     s.ShardID,
+			BranchToken: brToken,
+		})
+	}()
+	// The deletion waits for the offload in progress.
+	s.Never(func() bool { return len(deleted) > 0 }, 200*time.Millisecond, 10*time.Millisecond)
+	release()
+	select {
+	case err := <-deleted:
+		s.NoError(err)
+	case <-time.After(10 * time.Second):
+		s.FailNow("the branch was not deleted")
+	}
+
+	// Neither pointers nor the offloaded blob outlive the branch.
+	nodes, err := s.executionStore.ReadHistoryBranch(s.Ctx, &p.InternalReadHistoryBranchRequest{
+		ShardID:     s.ShardID,
+		BranchToken: brToken,
+		BranchID:    branchID,
+		MinNodeID:   common.FirstEventID,
+		MaxNodeID:   common.EndEventID,
+		PageSize:    100,
+	})
+	s.NoError(err)
+	s.Empty(nodes.Nodes)
+	s.Empty(s.blobStore.keys(treeID))
+}
+
+func (b *memoryHistoryBlobStore) Put(_ context.Context, key string, data []byte) error {
+	b.Lock()
+	putHook := b.putHook
+	b.Unlock()
+	if putHook != nil {
+		putHook(key)
+	}
+
+	b.Lock()
+	defer b.Unlock()
+	b.blobs[key] = slices.Clone(data)
+	return nil
+}
+
+func (b *memoryHistoryBlobStore) setPutHook(putHook func(key string)) {
+	b.Lock()
+	defer b.Unlock()
+	b.putHook = putHook
+}
+
+func (b *memoryHistoryBlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	b.Lock()
+	defer b.Unlock()
+	data, ok := b.blobs[key]
+	if !ok {
+		return nil, serviceerror.NewNotFoundf("blob %s not found", key)
+	}
+	return data, nil
+}
+
+func (b *memoryHistoryBlobStore) Delete(_ context.Context, key string) error {
+	b.Lock()
+	defer b.Unlock()
+	delete(b.blobs, key)
+	return nil
+}
+
+func (b *memoryHistoryBlobStore) keys(prefix string) []string {
+	b.Lock()
+	defer b.Unlock()
+	var keys []string
+	for key := range b.blobs {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
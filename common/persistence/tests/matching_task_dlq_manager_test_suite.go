@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RunMatchingTaskDLQManagerTestSuite runs all tests for the matching task DLQ manager against a given queue provided by
+// a particular database.
+func RunMatchingTaskDLQManagerTestSuite(t *testing.T, queue persistence.QueueV2) {
+	manager := persistence.NewMatchingTaskDLQManager(queue)
+	t.Run("EnqueueReadDelete", func(t *testing.T) {
+		t.Parallel()
+		testMatchingTaskDLQManagerEnqueueReadDelete(t, manager)
+	})
+	t.Run("QueuesAreSeparate", func(t *testing.T) {
+		t.Parallel()
+		testMatchingTaskDLQManagerQueuesAreSeparate(t, manager)
+	})
+}
+
+func testMatchingTaskDLQManagerEnqueueReadDelete(t *testing.T, manager persistence.MatchingTaskDLQManager) {
+	ctx := context.Background()
+	queueKey := persistence.MatchingDLQKey{
+		NamespaceID:   uuid.NewString(),
+		TaskQueueName: t.Name(),
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}
+	_, err := manager.CreateQueue(ctx, &persistence.CreateMatchingDLQRequest{QueueKey: queueKey})
+	require.NoError(t, err)
+	_, err = manager.CreateQueue(ctx, &persistence.CreateMatchingDLQRequest{QueueKey: queueKey})
+	require.ErrorIs(t, err, persistence.ErrQueueAlreadyExists)
+
+	for i := range 3 {
+		res, err := manager.EnqueueTask(ctx, &persistence.EnqueueMatchingDLQTaskRequest{
+			Task: newMatchingDLQTask(queueKey, int64(i+1)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+i), res.Metadata.ID)
+	}
+
+	readRes, err := manager.ReadTasks(ctx, &persistence.ReadMatchingDLQTasksRequest{
+		QueueKey: queueKey,
+		PageSize: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, readRes.Tasks, 2)
+	assert.Equal(t, int64(1), readRes.Tasks[0].Payload.GetTask().GetTaskId())
+	assert.Equal(t, "expired_read", readRes.Tasks[0].Payload.GetReason())
+	assert.Equal(t, int64(2), readRes.Tasks[1].Payload.GetTask().GetTaskId())
+	readRes, err = manager.ReadTasks(ctx, &persistence.ReadMatchingDLQTasksRequest{
+		QueueKey:      queueKey,
+		PageSize:      2,
+		NextPageToken: readRes.NextPageToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, readRes.Tasks, 1)
+	assert.Equal(t, int64(3), readRes.Tasks[0].Payload.GetTask().GetTaskId())
+
+	deleteRes, err := manager.DeleteTasks(ctx, &persistence.DeleteMatchingDLQTasksRequest{
+		QueueKey:                    queueKey,
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: persistence.FirstQueueMessageID + 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleteRes.MessagesDeleted)
+	readRes, err = manager.ReadTasks(ctx, &persistence.ReadMatchingDLQTasksRequest{
+		QueueKey: queueKey,
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, readRes.Tasks, 1)
+	assert.Equal(t, int64(3), readRes.Tasks[0].Payload.GetTask().GetTaskId())
+}
+
+func testMatchingTaskDLQManagerQueuesAreSeparate(t *testing.T, manager persistence.MatchingTaskDLQManager) {
+	ctx := context.Background()
+	workflowKey := persistence.MatchingDLQKey{
+		NamespaceID:   uuid.NewString(),
+		TaskQueueName: t.Name(),
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}
+	activityKey := workflowKey
+	activityKey.TaskQueueType = enumspb.TASK_QUEUE_TYPE_ACTIVITY
+	for _, queueKey := range []persistence.MatchingDLQKey{workflowKey, activityKey} {
+		_, err := manager.CreateQueue(ctx, &persistence.CreateMatchingDLQRequest{QueueKey: queueKey})
+		require.NoError(t, err)
+	}
+	_, err := manager.EnqueueTask(ctx, &persistence.EnqueueMatchingDLQTaskRequest{
+		Task: newMatchingDLQTask(workflowKey, 1),
+	})
+	require.NoError(t, err)
+
+	readRes, err := manager.ReadTasks(ctx, &persistence.ReadMatchingDLQTasksRequest{
+		QueueKey: activityKey,
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, readRes.Tasks)
+	readRes, err = manager.ReadTasks(ctx, &persistence.ReadMatchingDLQTasksRequest{
+		QueueKey: workflowKey,
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	assert.Len(t, readRes.Tasks, 1)
+}
+
+func newMatchingDLQTask(queueKey persistence.MatchingDLQKey, taskID int64) *persistencespb.MatchingDLQTask {
+	return &persistencespb.MatchingDLQTask{
+		NamespaceId:   queueKey.NamespaceID,
+		TaskQueue:     queueKey.TaskQueueName,
+		TaskQueueType: queueKey.TaskQueueType,
+		Task: &persistencespb.AllocatedTaskInfo{
+			Data: &persistencespb.TaskInfo{
+				NamespaceId:      queueKey.NamespaceID,
+				WorkflowId:       "test-workflow-id",
+				RunId:            "test-run-id",
+				ScheduledEventId: 5,
+			},
+			TaskId: taskID,
+		},
+		Reason:         "expired_read",
+		DeadLetterTime: timestamppb.New(time.Now()),
+	}
+}
@@ -130,6 +130,10 @@ func RunQueueV2TestSuite(t *testing.T, q persistence.QueueV2) {
 		t.Parallel()
 		RunHistoryTaskQueueManagerTestSuite(t, q)
 	})
+	t.Run("MatchingTaskDLQManagerImpl", func(t *testing.T) {
+		t.Parallel()
+		RunMatchingTaskDLQManagerTestSuite(t, q)
+	})
 }
 
 func testHappyPath(
@@ -183,6 +183,30 @@ func TestSQLiteHistoryStoreSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestSQLiteHistoryTieringSuite(t *testing.T) {
+	t.Parallel()
+	cfg := NewSQLiteMemoryConfig()
+	logger := log.NewNoopLogger()
+	factory := sql.NewFactory(
+		*cfg,
+		resolver.NewNoopResolver(),
+		testSQLiteClusterName,
+		logger,
+		metrics.NoopMetricsHandler,
+		serialization.NewSerializer(),
+	)
+	store, err := factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create SQLite DB: %v", err)
+	}
+	defer func() {
+		factory.Close()
+	}()
+
+	s := NewHistoryTieringSuite(t, store, logger)
+	suite.Run(t, s)
+}
+
 func TestSQLiteTaskQueueSuite(t *testing.T) {
 	t.Parallel()
 	cfg := NewSQLiteMemoryConfig()
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/chasm"
@@ -83,6 +84,7 @@ func (s *VisibilityPersistenceSuite) SetupSuite() {
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
 		dynamicconfig.GetBoolPropertyFn(true),
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		metrics.NoopMetricsHandler,
 		s.Logger,
 		serialization.NewSerializer(),
@@ -1056,6 +1058,83 @@ func (s *VisibilityPersistenceSuite) TestCountGroupByWorkflowExecutions() {
 	s.Equal(int64(5), resp.Count)
 }
 
+func (s *VisibilityPersistenceSuite) TestCountGroupByWorkflowExecutions_MultipleFields() {
+	testNamespaceUUID := namespace.ID(uuid.NewString())
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := range 3 {
+		s.createOpenWorkflowRecord(testNamespaceUUID, "wf-a", "type-a", day.Add(time.Duration(i)*time.Hour), day, "test-queue")
+	}
+	s.createOpenWorkflowRecord(testNamespaceUUID, "wf-b", "type-b", day.Add(time.Hour), day, "test-queue")
+	s.createOpenWorkflowRecord(testNamespaceUUID, "wf-c", "type-a", day.Add(25*time.Hour), day, "test-queue")
+
+	encode := func(val any, t enumspb.IndexedValueType) *commonpb.Payload {
+		p, err := sadefs.EncodeValue(val, t)
+		s.NoError(err)
+		return p
+	}
+	resp, err := s.VisibilityMgr.CountWorkflowExecutions(
+		s.ctx,
+		&manager.CountWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "GROUP BY WorkflowType, day(StartTime)",
+		},
+	)
+	s.NoError(err)
+	s.Equal(int64(5), resp.Count)
+	s.ElementsMatch(
+		[]*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			{
+				GroupValues: []*commonpb.Payload{
+					encode("type-a", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+					encode(day, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+				},
+				Count: 3,
+			},
+			{
+				GroupValues: []*commonpb.Payload{
+					encode("type-b", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+					encode(day, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+				},
+				Count: 1,
+			},
+			{
+				GroupValues: []*commonpb.Payload{
+					encode("type-a", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+					encode(day.Add(24*time.Hour), enumspb.INDEXED_VALUE_TYPE_DATETIME),
+				},
+				Count: 1,
+			},
+		},
+		resp.Groups,
+	)
+
+	resp, err = s.VisibilityMgr.CountWorkflowExecutions(
+		s.ctx,
+		&manager.CountWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "WorkflowType = 'type-a' GROUP BY hour(StartTime)",
+		},
+	)
+	s.NoError(err)
+	s.Equal(int64(4), resp.Count)
+	s.Len(resp.Groups, 4)
+
+	// the suite limits the number of groups to 10
+	for i := range 10 {
+		s.createOpenWorkflowRecord(testNamespaceUUID, "wf-d", "type-d", day.Add(time.Duration(i)*time.Hour), day, "test-queue")
+	}
+	_, err = s.VisibilityMgr.CountWorkflowExecutions(
+		s.ctx,
+		&manager.CountWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "GROUP BY hour(StartTime), WorkflowType",
+		},
+	)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
 func (s *VisibilityPersistenceSuite) listWithPagination(namespaceID namespace.ID, pageSize int) []*workflowpb.WorkflowExecutionInfo {
 	var executions []*workflowpb.WorkflowExecutionInfo
 	resp, err := s.VisibilityMgr.ListWorkflowExecutions(s.ctx, &manager.ListWorkflowExecutionsRequestV2{
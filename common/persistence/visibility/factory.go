@@ -51,6 +51,7 @@ func NewManager(
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableUnifiedQueryConverter dynamicconfig.BoolPropertyFn,
+	visibilityCountGroupByMaxGroups dynamicconfig.IntPropertyFnWithNamespaceFilter,
 
 	metricsHandler metrics.Handler,
 	logger log.Logger,
@@ -72,6 +73,7 @@ func NewManager(
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
 		visibilityEnableUnifiedQueryConverter,
+		visibilityCountGroupByMaxGroups,
 		metricsHandler,
 		logger,
 		serializer,
@@ -100,6 +102,7 @@ func NewManager(
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
 		visibilityEnableUnifiedQueryConverter,
+		visibilityCountGroupByMaxGroups,
 		metricsHandler,
 		logger,
 		serializer,
@@ -192,6 +195,7 @@ func newVisibilityManagerFromDataStoreConfig(
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableUnifiedQueryConverter dynamicconfig.BoolPropertyFn,
+	visibilityCountGroupByMaxGroups dynamicconfig.IntPropertyFnWithNamespaceFilter,
 
 	metricsHandler metrics.Handler,
 	logger log.Logger,
@@ -209,6 +213,7 @@ func newVisibilityManagerFromDataStoreConfig(
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
 		visibilityEnableUnifiedQueryConverter,
+		visibilityCountGroupByMaxGroups,
 		metricsHandler,
 		logger,
 		serializer,
@@ -247,6 +252,7 @@ func newVisibilityStoreFromDataStoreConfig(
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableUnifiedQueryConverter dynamicconfig.BoolPropertyFn,
+	visibilityCountGroupByMaxGroups dynamicconfig.IntPropertyFnWithNamespaceFilter,
 
 	metricsHandler metrics.Handler,
 	logger log.Logger,
@@ -264,6 +270,7 @@ func newVisibilityStoreFromDataStoreConfig(
 			searchAttributesMapperProvider,
 			chasmRegistry,
 			visibilityEnableUnifiedQueryConverter,
+			visibilityCountGroupByMaxGroups,
 			logger,
 			metricsHandler,
 			serializer,
@@ -278,6 +285,7 @@ func newVisibilityStoreFromDataStoreConfig(
 			visibilityDisableOrderByClause,
 			visibilityEnableManualPagination,
 			visibilityEnableUnifiedQueryConverter,
+			visibilityCountGroupByMaxGroups,
 			metricsHandler,
 			logger,
 		)
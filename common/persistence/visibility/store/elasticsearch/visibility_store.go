@@ -38,6 +38,9 @@ const (
 	PersistenceName = "elasticsearch"
 
 	delimiter = "~"
+
+	// countGroupByAggName is the name of the composite aggregation used for GROUP BY queries.
+	countGroupByAggName = "groupBy"
 )
 
 type (
@@ -52,6 +55,7 @@ type (
 		disableOrderByClause           dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		enableManualPagination         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		enableUnifiedQueryConverter    dynamicconfig.BoolPropertyFn
+		countGroupByMaxGroups          dynamicconfig.IntPropertyFnWithNamespaceFilter
 		metricsHandler                 metrics.Handler
 		logger                         log.Logger
 	}
@@ -64,6 +68,9 @@ type (
 		Query   elastic.Query
 		Sorter  []elastic.Sorter
 		GroupBy []string
+		// GroupByBuckets has the time bucket of GroupBy fields that are grouped by a time bucket
+		// function, eg: day(StartTime).
+		GroupByBuckets map[string]query.TimeBucket
 	}
 
 	fieldSort struct {
@@ -132,6 +139,7 @@ func NewVisibilityStore(
 	disableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	enableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	enableUnifiedQueryConverter dynamicconfig.BoolPropertyFn,
+	countGroupByMaxGroups dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) (*VisibilityStore, error) {
@@ -168,6 +176,7 @@ func NewVisibilityStore(
 		disableOrderByClause:           disableOrderByClause,
 		enableManualPagination:         enableManualPagination,
 		enableUnifiedQueryConverter:    enableUnifiedQueryConverter,
+		countGroupByMaxGroups:          countGroupByMaxGroups,
 		metricsHandler:                 metricsHandler.WithTags(metrics.OperationTag(metrics.ElasticsearchVisibility)),
 		logger:                         logger,
 	}, nil
@@ -451,11 +460,11 @@ func (s *VisibilityStore) CountChasmExecutions(
 		if err != nil {
 			return nil, err
 		}
-		queryParams = (*esQueryParams)(queryParamsLegacy)
+		queryParams = newESQueryParamsFromLegacy(queryParamsLegacy)
 	}
 
 	if len(queryParams.GroupBy) > 0 {
-		return s.countGroupByExecutions(ctx, queryParams, mapper)
+		return s.countGroupByExecutions(ctx, namespace.Name(request.Namespace), queryParams, mapper)
 	}
 
 	count, err := s.esClient.Count(ctx, s.index, queryParams.Query)
@@ -482,11 +491,11 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 		if err != nil {
 			return nil, err
 		}
-		queryParams = (*esQueryParams)(queryParamsLegacy)
+		queryParams = newESQueryParamsFromLegacy(queryParamsLegacy)
 	}
 
 	if len(queryParams.GroupBy) > 0 {
-		return s.countGroupByExecutions(ctx, queryParams, nil)
+		return s.countGroupByExecutions(ctx, request.Namespace, queryParams, nil)
 	}
 
 	count, err := s.esClient.Count(ctx, s.index, queryParams.Query)
@@ -499,46 +508,79 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 
 func (s *VisibilityStore) countGroupByExecutions(
 	ctx context.Context,
+	nsName namespace.Name,
 	queryParams *esQueryParams,
 	chasmMapper *chasm.VisibilitySearchAttributesMapper,
 ) (*store.InternalCountExecutionsResponse, error) {
 	groupByFields := queryParams.GroupBy
+	maxGroups := s.countGroupByMaxGroups(nsName.String())
 
-	// Elasticsearch aggregation is nested. so need to loop backwards to build it.
-	// Example: when grouping by (field1, field2), the object looks like
+	// All GROUP BY fields are sources of a single composite aggregation, which returns one bucket
+	// per distinct combination of values. Unlike nested terms aggregations, this bounds the total
+	// number of buckets Elasticsearch builds to the composite size. Example: when grouping by
+	// (field1, field2), the object looks like
 	// {
 	//   "aggs": {
-	//     "field1": {
-	//       "terms": {
-	//         "field": "field1"
-	//       },
-	//       "aggs": {
-	//         "field2": {
-	//           "terms": {
-	//             "field": "field2"
-	//           }
-	//         }
+	//     "groupBy": {
+	//       "composite": {
+	//         "size": maxGroups+1,
+	//         "sources": [
+	//           {"field1": {"terms": {"field": "field1", "missing_bucket": true}}},
+	//           {"field2": {"terms": {"field": "field2", "missing_bucket": true}}}
+	//         ]
 	//       }
 	//     }
 	//   }
 	// }
-	termsAgg := elastic.NewTermsAggregation().Field(groupByFields[len(groupByFields)-1])
-	for i := len(groupByFields) - 2; i >= 0; i-- {
-		termsAgg = elastic.NewTermsAggregation().
-			Field(groupByFields[i]).
-			SubAggregation(groupByFields[i+1], termsAgg)
+	sources := make([]elastic.CompositeAggregationValuesSource, len(groupByFields))
+	for i, field := range groupByFields {
+		sources[i] = newGroupBySource(field, queryParams.GroupByBuckets[field])
 	}
+	agg := elastic.NewCompositeAggregation().Size(maxGroups + 1).Sources(sources...)
 	esResponse, err := s.esClient.CountGroupBy(
 		ctx,
 		s.index,
 		queryParams.Query,
-		groupByFields[0],
-		termsAgg,
+		countGroupByAggName,
+		agg,
 	)
 	if err != nil {
 		return nil, ConvertElasticsearchClientError("CountWorkflowExecutions failed", err)
 	}
-	return s.parseCountGroupByResponse(esResponse, groupByFields, chasmMapper)
+	resp, err := s.parseCountGroupByResponse(esResponse, groupByFields, queryParams.GroupByBuckets, chasmMapper)
+	if err != nil {
+		return nil, err
+	}
+	// The composite aggregation returns at most maxGroups+1 buckets, so if it was truncated there
+	// are more than maxGroups groups in the response.
+	if len(resp.Groups) > maxGroups {
+		return nil, serviceerror.NewInvalidArgumentf(
+			"query matches more than %d groups, use a more selective query or fewer 'GROUP BY' fields",
+			maxGroups,
+		)
+	}
+	return resp, nil
+}
+
+// newGroupBySource returns the composite aggregation source for a GROUP BY field: a date
+// histogram if the field is grouped by a time bucket, and terms otherwise. Executions without a
+// value for the field are grouped in a bucket with a null key, like SQL does.
+func newGroupBySource(field string, bucket query.TimeBucket) elastic.CompositeAggregationValuesSource {
+	switch bucket {
+	case query.TimeBucketHour, query.TimeBucketDay:
+		interval := "1h"
+		if bucket == query.TimeBucketDay {
+			interval = "1d"
+		}
+		return elastic.NewCompositeAggregationDateHistogramValuesSource(field).
+			Field(field).
+			CalendarInterval(interval).
+			MissingBucket(true)
+	default:
+		return elastic.NewCompositeAggregationTermsValuesSource(field).
+			Field(field).
+			MissingBucket(true)
+	}
 }
 
 func (s *VisibilityStore) GetWorkflowExecution(
@@ -626,7 +668,7 @@ func (s *VisibilityStore) buildSearchParametersInternal(
 		if err != nil {
 			return nil, err
 		}
-		queryParams = (*esQueryParams)(queryParamsLegacy)
+		queryParams = newESQueryParamsFromLegacy(queryParamsLegacy)
 	}
 
 	searchParams := &client.SearchParameters{
@@ -780,17 +822,33 @@ func (s *VisibilityStore) convertQuery(
 	}
 
 	groupBy := make([]string, 0, len(queryParams.GroupBy))
+	var groupByBuckets map[string]query.TimeBucket
 	for _, field := range queryParams.GroupBy {
 		groupBy = append(groupBy, field.FieldName)
+		if field.Bucket != "" {
+			if groupByBuckets == nil {
+				groupByBuckets = make(map[string]query.TimeBucket)
+			}
+			groupByBuckets[field.FieldName] = field.Bucket
+		}
 	}
 
 	return &esQueryParams{
-		Query:   queryParams.QueryExpr,
-		Sorter:  orderBy,
-		GroupBy: groupBy,
+		Query:          queryParams.QueryExpr,
+		Sorter:         orderBy,
+		GroupBy:        groupBy,
+		GroupByBuckets: groupByBuckets,
 	}, nil
 }
 
+func newESQueryParamsFromLegacy(queryParams *query.QueryParamsLegacy) *esQueryParams {
+	return &esQueryParams{
+		Query:   queryParams.Query,
+		Sorter:  queryParams.Sorter,
+		GroupBy: queryParams.GroupBy,
+	}
+}
+
 func (s *VisibilityStore) convertQueryLegacy(
 	namespace namespace.Name,
 	namespaceID namespace.ID,
@@ -1143,13 +1201,13 @@ func (s *VisibilityStore) ParseESDoc(
 	return record, nil
 }
 
-// Elasticsearch aggregation groups are returned as a nested object.
-// This function flattens the response into rows.
-//
-//nolint:revive // cognitive complexity 27 (> max enabled 25)
+// parseCountGroupByResponse converts the buckets of the composite aggregation into groups.
+// Each bucket key has a value for every GROUP BY field, which is null if the executions in the
+// bucket don't have a value for the field.
 func (s *VisibilityStore) parseCountGroupByResponse(
 	searchResult *elastic.SearchResult,
 	groupByFields []string,
+	groupByBuckets map[string]query.TimeBucket,
 	chasmMapper *chasm.VisibilitySearchAttributesMapper,
 ) (*store.InternalCountExecutionsResponse, error) {
 	response := &store.InternalCountExecutionsResponse{}
@@ -1171,65 +1229,64 @@ func (s *VisibilityStore) parseCountGroupByResponse(
 		groupByTypes[i] = tp
 	}
 
-	parseJsonNumber := func(val any) (int64, error) {
-		numberVal, isNumber := val.(json.Number)
-		if !isNumber {
-			return 0, fmt.Errorf("%w: expected json.Number, got %T", errUnexpectedJSONFieldType, val)
-		}
-		return numberVal.Int64()
+	var aggJson struct {
+		Buckets []struct {
+			Key      map[string]any `json:"key"`
+			DocCount json.Number    `json:"doc_count"`
+		} `json:"buckets"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(searchResult.Aggregations[countGroupByAggName]))
+	dec.UseNumber()
+	if err := dec.Decode(&aggJson); err != nil {
+		return nil, serviceerror.NewInternalf("unable to unmarshal json response: %v", err)
 	}
 
-	var parseInternal func(map[string]any, []*commonpb.Payload) error
-	parseInternal = func(aggs map[string]any, bucketValues []*commonpb.Payload) error {
-		if len(bucketValues) == len(groupByFields) {
-			cnt, err := parseJsonNumber(aggs["doc_count"])
-			if err != nil {
-				return fmt.Errorf("unable to parse 'doc_count' field: %w", err)
-			}
-			groupValues := make([]*commonpb.Payload, len(groupByFields))
-			copy(groupValues, bucketValues)
-			response.Groups = append(
-				response.Groups,
-				store.InternalAggregationGroup{
-					GroupValues: groupValues,
-					Count:       cnt,
-				},
-			)
-			response.Count += cnt
-			return nil
+	for _, bucket := range aggJson.Buckets {
+		cnt, err := bucket.DocCount.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse 'doc_count' field: %w", err)
 		}
-
-		index := len(bucketValues)
-		fieldName := groupByFields[index]
-		buckets := aggs[fieldName].(map[string]any)["buckets"].([]any)
-		for i := range buckets {
-			bucket := buckets[i].(map[string]any)
-			value, err := finishParseJSONValue(bucket["key"], groupByTypes[index])
-			if err != nil {
-				return fmt.Errorf("unable to parse value %v: %w", bucket["key"], err)
-			}
-			payload, err := sadefs.EncodeValue(value, groupByTypes[index])
+		groupValues := make([]*commonpb.Payload, len(groupByFields))
+		for i, fieldName := range groupByFields {
+			value, err := parseGroupByKey(bucket.Key[fieldName], groupByTypes[i], groupByBuckets[fieldName])
 			if err != nil {
-				return fmt.Errorf("unable to encode value %v: %w", value, err)
+				return nil, fmt.Errorf("unable to parse value %v: %w", bucket.Key[fieldName], err)
 			}
-			err = parseInternal(bucket, append(bucketValues, payload))
+			groupValues[i], err = sadefs.EncodeValue(value, groupByTypes[i])
 			if err != nil {
-				return err
+				return nil, fmt.Errorf("unable to encode value %v: %w", value, err)
 			}
 		}
-		return nil
+		response.Groups = append(
+			response.Groups,
+			store.InternalAggregationGroup{
+				GroupValues: groupValues,
+				Count:       cnt,
+			},
+		)
+		response.Count += cnt
 	}
+	return response, nil
+}
 
-	var bucketsJson map[string]any
-	dec := json.NewDecoder(bytes.NewReader(searchResult.Aggregations[groupByFields[0]]))
-	dec.UseNumber()
-	if err := dec.Decode(&bucketsJson); err != nil {
-		return nil, serviceerror.NewInternalf("unable to unmarshal json response: %v", err)
+// parseGroupByKey parses the value of a GROUP BY field in a composite aggregation bucket key.
+func parseGroupByKey(key any, t enumspb.IndexedValueType, bucket query.TimeBucket) (any, error) {
+	if key == nil {
+		return nil, nil
 	}
-	if err := parseInternal(map[string]any{groupByFields[0]: bucketsJson}, nil); err != nil {
-		return nil, err
+	if bucket != "" {
+		// Date histogram keys are the start of the bucket in epoch milliseconds.
+		numberVal, isNumber := key.(json.Number)
+		if !isNumber {
+			return nil, fmt.Errorf("%w: expected json.Number, got %T", errUnexpectedJSONFieldType, key)
+		}
+		millis, err := numberVal.Int64()
+		if err != nil {
+			return nil, err
+		}
+		return time.UnixMilli(millis).UTC(), nil
 	}
-	return response, nil
+	return finishParseJSONValue(key, t)
 }
 
 // finishParseJSONValue finishes JSON parsing after json.Decode.
@@ -172,6 +172,7 @@ func (s *ESVisibilitySuite) SetupTest() {
 		disableOrderByClause:           visibilityDisableOrderByClause,
 		enableManualPagination:         visibilityEnableManualPagination,
 		enableUnifiedQueryConverter:    visibilityEnableUnifiedQueryConverter,
+		countGroupByMaxGroups:          dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		metricsHandler:                 s.mockMetricsHandler,
 		logger:                         log.NewNoopLogger(),
 	}
@@ -1047,14 +1048,18 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 					elastic.NewTermQuery(sadefs.NamespaceID, testNamespaceID.String()),
 					namespaceDivisionIsNull,
 				),
-			sadefs.ExecutionStatus,
-			elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus),
+			countGroupByAggName,
+			elastic.NewCompositeAggregation().Size(11).Sources(
+				elastic.NewCompositeAggregationTermsValuesSource(sadefs.ExecutionStatus).
+					Field(sadefs.ExecutionStatus).
+					MissingBucket(true),
+			),
 		).
 		Return(
 			&elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
-					sadefs.ExecutionStatus: json.RawMessage(
-						`{"buckets":[{"key":"Completed","doc_count":100},{"key":"Running","doc_count":10}]}`,
+					countGroupByAggName: json.RawMessage(
+						`{"buckets":[{"key":{"ExecutionStatus":"Completed"},"doc_count":100},{"key":{"ExecutionStatus":"Running"},"doc_count":10}]}`,
 					),
 				},
 			},
@@ -1081,44 +1086,86 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 	}
 	s.True(temporalproto.DeepEqual(expectedResp, resp))
 
-	// test only allowed to group by a single field
-	request.Query = "GROUP BY ExecutionStatus, WorkflowType"
+	// test limit on the number of fields
+	request.Query = "GROUP BY ExecutionStatus, WorkflowType, TaskQueue, AliasForCustomKeywordField"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
-	s.ErrorContains(err, "'GROUP BY' clause supports only a single field")
+	s.ErrorContains(err, "'GROUP BY' clause supports at most 3 fields")
 	s.Nil(resp)
 
-	// test only allowed to group by ExecutionStatus and LowCardinalityKeyword fields
-	request.Query = "GROUP BY WorkflowType"
+	// test only allowed to group by low cardinality keyword fields
+	request.Query = "GROUP BY WorkflowId"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
-	s.ErrorContains(err, "'GROUP BY' clause is only supported for ExecutionStatus")
+	s.ErrorContains(err, "'GROUP BY' clause is not supported for WorkflowId")
+	s.Nil(resp)
+}
+
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupByTooManyGroups() {
+	request := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "GROUP BY WorkflowType",
+	}
+	buckets := make([]string, 11)
+	for i := range buckets {
+		buckets[i] = fmt.Sprintf(`{"key":{"WorkflowType":"wf-type-%d"},"doc_count":1}`, i)
+	}
+	s.mockESClient.EXPECT().
+		CountGroupBy(
+			gomock.Any(),
+			testIndex,
+			gomock.Any(),
+			countGroupByAggName,
+			elastic.NewCompositeAggregation().Size(11).Sources(
+				elastic.NewCompositeAggregationTermsValuesSource(sadefs.WorkflowType).
+					Field(sadefs.WorkflowType).
+					MissingBucket(true),
+			),
+		).
+		Return(
+			&elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					countGroupByAggName: json.RawMessage(
+						`{"buckets":[` + strings.Join(buckets, ",") + `]}`,
+					),
+				},
+			},
+			nil,
+		)
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+	s.ErrorContains(err, "query matches more than 10 groups")
 	s.Nil(resp)
 }
 
 func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
+	termsSource := func(field string) elastic.CompositeAggregationValuesSource {
+		return elastic.NewCompositeAggregationTermsValuesSource(field).Field(field).MissingBucket(true)
+	}
 	testCases := []struct {
-		name         string
-		groupBy      []string
-		aggName      string
-		agg          elastic.Aggregation
-		mockResponse *elastic.SearchResult
-		response     *store.InternalCountExecutionsResponse
+		name           string
+		groupBy        []string
+		groupByBuckets map[string]query.TimeBucket
+		agg            elastic.Aggregation
+		mockResponse   *elastic.SearchResult
+		response       *store.InternalCountExecutionsResponse
 	}{
 		{
 			name:    "group by one field",
 			groupBy: []string{sadefs.ExecutionStatus},
-			aggName: sadefs.ExecutionStatus,
-			agg:     elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus),
+			agg:     elastic.NewCompositeAggregation().Size(11).Sources(termsSource(sadefs.ExecutionStatus)),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
-					sadefs.ExecutionStatus: json.RawMessage(
+					countGroupByAggName: json.RawMessage(
 						`{
+							"after_key": {"ExecutionStatus": "Running"},
 							"buckets":[
 								{
-									"key": "Completed",
+									"key": {"ExecutionStatus": "Completed"},
 									"doc_count": 100
 								},
 								{
-									"key": "Running",
+									"key": {"ExecutionStatus": "Running"},
 									"doc_count": 10
 								}
 							]
@@ -1148,47 +1195,30 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 		{
 			name:    "group by two fields",
 			groupBy: []string{sadefs.ExecutionStatus, sadefs.WorkflowType},
-			aggName: sadefs.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus).SubAggregation(
-				sadefs.WorkflowType,
-				elastic.NewTermsAggregation().Field(sadefs.WorkflowType),
+			agg: elastic.NewCompositeAggregation().Size(11).Sources(
+				termsSource(sadefs.ExecutionStatus),
+				termsSource(sadefs.WorkflowType),
 			),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
-					sadefs.ExecutionStatus: json.RawMessage(
+					countGroupByAggName: json.RawMessage(
 						`{
 							"buckets":[
 								{
-									"key": "Completed",
-									"doc_count": 100,
-									"WorkflowType": {
-										"buckets": [
-											{
-												"key": "wf-type-1",
-												"doc_count": 75
-											},
-											{
-												"key": "wf-type-2",
-												"doc_count": 25
-											}
-										]
-									}
+									"key": {"ExecutionStatus": "Completed", "WorkflowType": "wf-type-1"},
+									"doc_count": 75
+								},
+								{
+									"key": {"ExecutionStatus": "Completed", "WorkflowType": "wf-type-2"},
+									"doc_count": 25
+								},
+								{
+									"key": {"ExecutionStatus": "Running", "WorkflowType": "wf-type-1"},
+									"doc_count": 7
 								},
 								{
-									"key": "Running",
-									"doc_count": 10,
-									"WorkflowType": {
-										"buckets": [
-											{
-												"key": "wf-type-1",
-												"doc_count": 7
-											},
-											{
-												"key": "wf-type-2",
-												"doc_count": 3
-											}
-										]
-									}
+									"key": {"ExecutionStatus": "Running", "WorkflowType": "wf-type-2"},
+									"doc_count": 3
 								}
 							]
 						}`,
@@ -1237,86 +1267,27 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 				sadefs.WorkflowType,
 				sadefs.WorkflowID,
 			},
-			aggName: sadefs.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus).SubAggregation(
-				sadefs.WorkflowType,
-				elastic.NewTermsAggregation().Field(sadefs.WorkflowType).SubAggregation(
-					sadefs.WorkflowID,
-					elastic.NewTermsAggregation().Field(sadefs.WorkflowID),
-				),
+			agg: elastic.NewCompositeAggregation().Size(11).Sources(
+				termsSource(sadefs.ExecutionStatus),
+				termsSource(sadefs.WorkflowType),
+				termsSource(sadefs.WorkflowID),
 			),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
-					sadefs.ExecutionStatus: json.RawMessage(
+					countGroupByAggName: json.RawMessage(
 						`{
 							"buckets":[
 								{
-									"key": "Completed",
-									"doc_count": 100,
-									"WorkflowType": {
-										"buckets": [
-											{
-												"key": "wf-type-1",
-												"doc_count": 75,
-												"WorkflowId": {
-													"buckets": [
-														{
-															"key": "wf-id-1",
-															"doc_count": 75
-														}
-													]
-												}
-											},
-											{
-												"key": "wf-type-2",
-												"doc_count": 25,
-												"WorkflowId": {
-													"buckets": [
-														{
-															"key": "wf-id-2",
-															"doc_count": 20
-														},
-														{
-															"key": "wf-id-3",
-															"doc_count": 5
-														}
-													]
-												}
-											}
-										]
-									}
+									"key": {"ExecutionStatus": "Completed", "WorkflowType": "wf-type-1", "WorkflowId": "wf-id-1"},
+									"doc_count": 75
 								},
 								{
-									"key": "Running",
-									"doc_count": 10,
-									"WorkflowType": {
-										"buckets": [
-											{
-												"key": "wf-type-1",
-												"doc_count": 7,
-												"WorkflowId": {
-													"buckets": [
-														{
-															"key": "wf-id-4",
-															"doc_count": 7
-														}
-													]
-												}
-											},
-											{
-												"key": "wf-type-2",
-												"doc_count": 3,
-												"WorkflowId": {
-													"buckets": [
-														{
-															"key": "wf-id-5",
-															"doc_count": 3
-														}
-													]
-												}
-											}
-										]
-									}
+									"key": {"ExecutionStatus": "Completed", "WorkflowType": "wf-type-2", "WorkflowId": "wf-id-2"},
+									"doc_count": 20
+								},
+								{
+									"key": {"ExecutionStatus": "Running", "WorkflowType": "wf-type-2", "WorkflowId": "wf-id-3"},
+									"doc_count": 3
 								}
 							]
 						}`,
@@ -1324,7 +1295,7 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 				},
 			},
 			response: &store.InternalCountExecutionsResponse{
-				Count: 110,
+				Count: 98,
 				Groups: []store.InternalAggregationGroup{
 					{
 						GroupValues: []*commonpb.Payload{
@@ -1344,27 +1315,72 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 					},
 					{
 						GroupValues: []*commonpb.Payload{
-							mustEncodeValue(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+							mustEncodeValue(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, enumspb.INDEXED_VALUE_TYPE_KEYWORD),
 							mustEncodeValue("wf-type-2", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
 							mustEncodeValue("wf-id-3", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
 						},
-						Count: 5,
+						Count: 3,
+					},
+				},
+			},
+		},
+
+		{
+			name:           "group by time bucket",
+			groupBy:        []string{sadefs.WorkflowType, sadefs.CloseTime},
+			groupByBuckets: map[string]query.TimeBucket{sadefs.CloseTime: query.TimeBucketDay},
+			agg: elastic.NewCompositeAggregation().Size(11).Sources(
+				termsSource(sadefs.WorkflowType),
+				elastic.NewCompositeAggregationDateHistogramValuesSource(sadefs.CloseTime).
+					Field(sadefs.CloseTime).
+					CalendarInterval("1d").
+					MissingBucket(true),
+			),
+			mockResponse: &elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					countGroupByAggName: json.RawMessage(
+						`{
+							"buckets":[
+								{
+									"key": {"WorkflowType": "wf-type-1", "CloseTime": null},
+									"doc_count": 3
+								},
+								{
+									"key": {"WorkflowType": "wf-type-1", "CloseTime": 1709251200000},
+									"doc_count": 10
+								},
+								{
+									"key": {"WorkflowType": "wf-type-1", "CloseTime": 1709337600000},
+									"doc_count": 5
+								}
+							]
+						}`,
+					),
+				},
+			},
+			response: &store.InternalCountExecutionsResponse{
+				Count: 18,
+				Groups: []store.InternalAggregationGroup{
+					{
+						GroupValues: []*commonpb.Payload{
+							mustEncodeValue("wf-type-1", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+							mustEncodeValue(nil, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+						},
+						Count: 3,
 					},
 					{
 						GroupValues: []*commonpb.Payload{
-							mustEncodeValue(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, enumspb.INDEXED_VALUE_TYPE_KEYWORD),
 							mustEncodeValue("wf-type-1", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
-							mustEncodeValue("wf-id-4", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+							mustEncodeValue(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), enumspb.INDEXED_VALUE_TYPE_DATETIME),
 						},
-						Count: 7,
+						Count: 10,
 					},
 					{
 						GroupValues: []*commonpb.Payload{
-							mustEncodeValue(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, enumspb.INDEXED_VALUE_TYPE_KEYWORD),
-							mustEncodeValue("wf-type-2", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
-							mustEncodeValue("wf-id-5", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+							mustEncodeValue("wf-type-1", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+							mustEncodeValue(time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), enumspb.INDEXED_VALUE_TYPE_DATETIME),
 						},
-						Count: 3,
+						Count: 5,
 					},
 				},
 			},
@@ -1379,7 +1395,8 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 						elastic.NewTermQuery(sadefs.NamespaceID, testNamespaceID.String()),
 						namespaceDivisionIsNull,
 					),
-				GroupBy: tc.groupBy,
+				GroupBy:        tc.groupBy,
+				GroupByBuckets: tc.groupByBuckets,
 			}
 			s.mockESClient.EXPECT().
 				CountGroupBy(
@@ -1390,11 +1407,11 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 							elastic.NewTermQuery(sadefs.NamespaceID, testNamespaceID.String()),
 							namespaceDivisionIsNull,
 						),
-					tc.aggName,
+					countGroupByAggName,
 					tc.agg,
 				).
 				Return(tc.mockResponse, nil)
-			resp, err := s.visibilityStore.countGroupByExecutions(context.Background(), searchParams, nil)
+			resp, err := s.visibilityStore.countGroupByExecutions(context.Background(), testNamespace, searchParams, nil)
 			s.NoError(err)
 			s.True(temporalproto.DeepEqual(tc.response, resp))
 		})
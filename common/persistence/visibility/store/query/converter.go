@@ -70,9 +70,27 @@ type (
 	QueryParams[ExprT any] struct {
 		QueryExpr ExprT
 		OrderBy   sqlparser.OrderBy
-		// List of search attributes to group by (field name).
-		GroupBy []*SAColumn
+		// List of search attributes to group by.
+		GroupBy []*GroupByField
 	}
+
+	// GroupByField is a search attribute in a GROUP BY clause. If Bucket is set, the search
+	// attribute is a datetime that's grouped by its value truncated to the bucket, eg: the query
+	// `GROUP BY day(StartTime)` groups by the start of the day of StartTime (in UTC).
+	GroupByField struct {
+		*SAColumn
+		Bucket TimeBucket
+	}
+
+	TimeBucket string
+)
+
+const (
+	TimeBucketHour TimeBucket = "hour"
+	TimeBucketDay  TimeBucket = "day"
+
+	// MaxGroupByFields is the maximum number of fields allowed in a GROUP BY clause.
+	MaxGroupByFields = 3
 )
 
 var (
@@ -84,6 +102,19 @@ var (
 		"TemporalLowCardinalityKeyword",
 	}
 
+	// groupByKeywordAllowlist is the list of system keyword search attributes that can be used in
+	// a GROUP BY clause in addition to the ones in groupByFieldAllowlist. Custom keyword search
+	// attributes are always allowed.
+	groupByKeywordAllowlist = []string{
+		sadefs.WorkflowType,
+		sadefs.TaskQueue,
+	}
+
+	supportedTimeBuckets = []TimeBucket{
+		TimeBucketHour,
+		TimeBucketDay,
+	}
+
 	supportedComparisonOperators = []string{
 		sqlparser.EqualStr,
 		sqlparser.NotEqualStr,
@@ -268,24 +299,26 @@ func (c *QueryConverter[ExprT]) convertSelectStmt(
 		res.QueryExpr = queryExpr
 	}
 
-	if len(sel.GroupBy) > 1 {
+	if len(sel.GroupBy) > MaxGroupByFields {
 		return nil, NewConverterError(
-			"%s: 'GROUP BY' clause supports only a single field",
+			"%s: 'GROUP BY' clause supports at most %d fields",
 			NotSupportedErrMessage,
+			MaxGroupByFields,
 		)
 	}
 	for k := range sel.GroupBy {
-		colName, err := c.convertColName(sel.GroupBy[k])
+		field, err := c.convertGroupByExpr(sel.GroupBy[k])
 		if err != nil {
 			return nil, err
 		}
-		if !IsGroupByFieldAllowed(colName.FieldName) {
+		if slices.ContainsFunc(res.GroupBy, func(f *GroupByField) bool { return f.FieldName == field.FieldName }) {
 			return nil, NewConverterError(
-				"%s: 'GROUP BY' clause is only supported for ExecutionStatus",
-				NotSupportedErrMessage,
+				"%s: 'GROUP BY' clause has duplicate field %s",
+				InvalidExpressionErrMessage,
+				field.Alias,
 			)
 		}
-		res.GroupBy = append(res.GroupBy, colName)
+		res.GroupBy = append(res.GroupBy, field)
 	}
 
 	for k := range sel.OrderBy {
@@ -492,6 +525,59 @@ func (c *QueryConverter[ExprT]) convertColName(in sqlparser.Expr) (*SAColumn, er
 	return colName, nil
 }
 
+// convertGroupByExpr converts an expression in the GROUP BY clause. It's either a search
+// attribute name, or a time bucket function (eg: day(StartTime)) on a datetime search attribute.
+func (c *QueryConverter[ExprT]) convertGroupByExpr(expr sqlparser.Expr) (*GroupByField, error) {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	if !ok {
+		colName, err := c.convertColName(expr)
+		if err != nil {
+			return nil, err
+		}
+		if !isGroupByColumnAllowed(colName) {
+			return nil, NewConverterError(
+				"%s: 'GROUP BY' clause is not supported for %s",
+				NotSupportedErrMessage,
+				colName.Alias,
+			)
+		}
+		return &GroupByField{SAColumn: colName}, nil
+	}
+
+	bucket := TimeBucket(funcExpr.Name.Lowered())
+	if !slices.Contains(supportedTimeBuckets, bucket) {
+		return nil, NewConverterError(
+			"%s: function '%s' in 'GROUP BY' clause",
+			NotSupportedErrMessage,
+			funcExpr.Name.String(),
+		)
+	}
+	var arg *sqlparser.AliasedExpr
+	if len(funcExpr.Exprs) == 1 {
+		arg, _ = funcExpr.Exprs[0].(*sqlparser.AliasedExpr)
+	}
+	if arg == nil || funcExpr.Distinct {
+		return nil, NewConverterError(
+			"%s: function '%s' expects exactly one search attribute",
+			InvalidExpressionErrMessage,
+			bucket,
+		)
+	}
+	colName, err := c.convertColName(arg.Expr)
+	if err != nil {
+		return nil, err
+	}
+	if colName.ValueType != enumspb.INDEXED_VALUE_TYPE_DATETIME {
+		return nil, NewConverterError(
+			"%s: function '%s' is only supported for search attributes of type %s",
+			NotSupportedErrMessage,
+			bucket,
+			enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		)
+	}
+	return &GroupByField{SAColumn: colName, Bucket: bucket}, nil
+}
+
 func (c *QueryConverter[ExprT]) resolveSearchAttributeAlias(
 	alias string,
 ) (fieldName string, fieldType enumspb.IndexedValueType, retErr error) {
@@ -725,6 +811,16 @@ func IsGroupByFieldAllowed(fieldName string) bool {
 	return false
 }
 
+// isGroupByColumnAllowed returns true if the search attribute can be used in a GROUP BY clause
+// without a time bucket function. Besides the fields allowed by IsGroupByFieldAllowed, it allows
+// WorkflowType, TaskQueue and custom keyword search attributes.
+func isGroupByColumnAllowed(col *SAColumn) bool {
+	if IsGroupByFieldAllowed(col.FieldName) || slices.Contains(groupByKeywordAllowlist, col.FieldName) {
+		return true
+	}
+	return col.ValueType == enumspb.INDEXED_VALUE_TYPE_KEYWORD && !sadefs.IsReserved(col.FieldName)
+}
+
 func parseExecutionStatusValue(value any) (string, error) {
 	switch v := value.(type) {
 	case int64:
@@ -348,12 +348,14 @@ func TestQueryConverter_ConvertWhereString(t *testing.T) {
 			name: "success empty group by",
 			in:   "group by ExecutionStatus",
 			out: &QueryParams[sqlparser.Expr]{
-				GroupBy: []*SAColumn{
-					NewSAColumn(
-						sadefs.ExecutionStatus,
-						sadefs.ExecutionStatus,
-						enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-					),
+				GroupBy: []*GroupByField{
+					{
+						SAColumn: NewSAColumn(
+							sadefs.ExecutionStatus,
+							sadefs.ExecutionStatus,
+							enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						),
+					},
 				},
 			},
 		},
@@ -461,12 +463,14 @@ func TestQueryConverter_ConvertSelectStmt(t *testing.T) {
 			name: "success empty group by ExecutionStatus",
 			in:   "select * from t group by ExecutionStatus",
 			out: &QueryParams[sqlparser.Expr]{
-				GroupBy: []*SAColumn{
-					NewSAColumn(
-						sadefs.ExecutionStatus,
-						sadefs.ExecutionStatus,
-						enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-					),
+				GroupBy: []*GroupByField{
+					{
+						SAColumn: NewSAColumn(
+							sadefs.ExecutionStatus,
+							sadefs.ExecutionStatus,
+							enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						),
+					},
 				},
 			},
 		},
@@ -484,11 +488,70 @@ func TestQueryConverter_ConvertSelectStmt(t *testing.T) {
 		},
 
 		{
-			name: "fail multiple group by",
-			in:   "select * from t group by ExecutionStatus, RunId",
+			name: "success group by multiple fields",
+			in:   "select * from t group by WorkflowType, AliasForKeyword01, TaskQueue",
+			out: &QueryParams[sqlparser.Expr]{
+				GroupBy: []*GroupByField{
+					{
+						SAColumn: NewSAColumn(
+							sadefs.WorkflowType,
+							sadefs.WorkflowType,
+							enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						),
+					},
+					{SAColumn: keywordCol},
+					{
+						SAColumn: NewSAColumn(
+							sadefs.TaskQueue,
+							sadefs.TaskQueue,
+							enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						),
+					},
+				},
+			},
+		},
+
+		{
+			name: "success group by time buckets",
+			in:   "select * from t group by day(StartTime), HOUR(AliasForDatetime01)",
+			out: &QueryParams[sqlparser.Expr]{
+				GroupBy: []*GroupByField{
+					{
+						SAColumn: NewSAColumn(
+							sadefs.StartTime,
+							sadefs.StartTime,
+							enumspb.INDEXED_VALUE_TYPE_DATETIME,
+						),
+						Bucket: TimeBucketDay,
+					},
+					{
+						SAColumn: NewSAColumn(
+							"AliasForDatetime01",
+							"Datetime01",
+							enumspb.INDEXED_VALUE_TYPE_DATETIME,
+						),
+						Bucket: TimeBucketHour,
+					},
+				},
+			},
+		},
+
+		{
+			name: "fail too many group by fields",
+			in:   "select * from t group by ExecutionStatus, WorkflowType, TaskQueue, AliasForKeyword01",
 			err: fmt.Sprintf(
-				"%s: 'GROUP BY' clause supports only a single field",
+				"%s: 'GROUP BY' clause supports at most %d fields",
 				NotSupportedErrMessage,
+				MaxGroupByFields,
+			),
+		},
+
+		{
+			name: "fail duplicate group by field",
+			in:   "select * from t group by day(StartTime), hour(StartTime)",
+			err: fmt.Sprintf(
+				"%s: 'GROUP BY' clause has duplicate field StartTime",
+				InvalidExpressionErrMessage,
 			),
 		},
 
@@ -496,11 +559,44 @@ func TestQueryConverter_ConvertSelectStmt(t *testing.T) {
 			name: "fail not supported group by field",
 			in:   "select * from t group by RunId",
 			err: fmt.Sprintf(
-				"%s: 'GROUP BY' clause is only supported for ExecutionStatus",
+				"%s: 'GROUP BY' clause is not supported for RunId",
 				NotSupportedErrMessage,
 			),
 		},
 
+		{
+			name: "fail not supported group by field type",
+			in:   "select * from t group by AliasForInt01",
+			err: fmt.Sprintf(
+				"%s: 'GROUP BY' clause is not supported for AliasForInt01",
+				NotSupportedErrMessage,
+			),
+		},
+
+		{
+			name: "fail not supported group by function",
+			in:   "select * from t group by month(StartTime)",
+			err: fmt.Sprintf(
+				"%s: function 'month' in 'GROUP BY' clause",
+				NotSupportedErrMessage,
+			),
+		},
+
+		{
+			name: "fail time bucket on non-datetime field",
+			in:   "select * from t group by day(WorkflowType)",
+			err: fmt.Sprintf(
+				"%s: function 'day' is only supported for search attributes of type Datetime",
+				NotSupportedErrMessage,
+			),
+		},
+
+		{
+			name: "fail time bucket with multiple arguments",
+			in:   "select * from t group by day(StartTime, CloseTime)",
+			err:  InvalidExpressionErrMessage,
+		},
+
 		{
 			name: "fail invalid group by field",
 			in:   "select * from t group by InvalidField",
@@ -0,0 +1,346 @@
+package query
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// Predicate evaluates a query against a single visibility record, given as the values of its search attributes
+	// keyed by field name. Datetime values are time.Time, keyword lists are []string, and ExecutionStatus is the
+	// name of the status.
+	Predicate func(values map[string]any) bool
+
+	// predicateStoreQueryConverter builds a Predicate that evaluates a query in memory. It follows SQL semantics:
+	// comparisons with a missing value are false.
+	predicateStoreQueryConverter struct{}
+
+	PredicateQueryConverter = *QueryConverter[Predicate]
+)
+
+var _ StoreQueryConverter[Predicate] = (*predicateStoreQueryConverter)(nil)
+
+func NewPredicateQueryConverter(
+	namespaceName namespace.Name,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapper searchattribute.Mapper,
+) PredicateQueryConverter {
+	return NewQueryConverter(
+		&predicateStoreQueryConverter{},
+		namespaceName,
+		saTypeMap,
+		saMapper,
+	)
+}
+
+func (c *predicateStoreQueryConverter) GetDatetimeFormat() string {
+	return time.RFC3339Nano
+}
+
+func (c *predicateStoreQueryConverter) BuildParenExpr(expr Predicate) (Predicate, error) {
+	return expr, nil
+}
+
+func (c *predicateStoreQueryConverter) BuildNotExpr(expr Predicate) (Predicate, error) {
+	return func(values map[string]any) bool {
+		return !expr(values)
+	}, nil
+}
+
+func (c *predicateStoreQueryConverter) BuildAndExpr(exprs ...Predicate) (Predicate, error) {
+	exprs = slices.DeleteFunc(exprs, func(expr Predicate) bool { return expr == nil })
+	return func(values map[string]any) bool {
+		for _, expr := range exprs {
+			if !expr(values) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func (c *predicateStoreQueryConverter) BuildOrExpr(exprs ...Predicate) (Predicate, error) {
+	exprs = slices.DeleteFunc(exprs, func(expr Predicate) bool { return expr == nil })
+	return func(values map[string]any) bool {
+		for _, expr := range exprs {
+			if expr(values) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func (c *predicateStoreQueryConverter) ConvertComparisonExpr(
+	operator string,
+	col *SAColumn,
+	value any,
+) (Predicate, error) {
+	value, err := c.convertValue(col, value)
+	if err != nil {
+		return nil, err
+	}
+	switch operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		list, ok := value.([]any)
+		if !ok {
+			return nil, c.newInvalidValueError(operator, value)
+		}
+		in := func(values map[string]any) bool {
+			v, ok := values[col.FieldName]
+			return ok && slices.ContainsFunc(list, func(item any) bool {
+				result, ok := compareValues(v, item)
+				return ok && result == 0
+			})
+		}
+		if operator == sqlparser.InStr {
+			return in, nil
+		}
+		return c.notIfPresent(col, in), nil
+	}
+
+	return func(values map[string]any) bool {
+		v, ok := values[col.FieldName]
+		if !ok {
+			return false
+		}
+		result, ok := compareValues(v, value)
+		if !ok {
+			return false
+		}
+		switch operator {
+		case sqlparser.EqualStr:
+			return result == 0
+		case sqlparser.NotEqualStr:
+			return result != 0
+		case sqlparser.LessThanStr:
+			return result < 0
+		case sqlparser.GreaterThanStr:
+			return result > 0
+		case sqlparser.LessEqualStr:
+			return result <= 0
+		case sqlparser.GreaterEqualStr:
+			return result >= 0
+		default:
+			return false
+		}
+	}, nil
+}
+
+func (c *predicateStoreQueryConverter) ConvertKeywordComparisonExpr(
+	operator string,
+	col *SAColumn,
+	value any,
+) (Predicate, error) {
+	switch operator {
+	case sqlparser.StartsWithStr, sqlparser.NotStartsWithStr:
+		prefix, ok := value.(string)
+		if !ok {
+			return nil, c.newInvalidValueError(operator, value)
+		}
+		startsWith := func(values map[string]any) bool {
+			v, ok := values[col.FieldName].(string)
+			return ok && strings.HasPrefix(v, prefix)
+		}
+		if operator == sqlparser.StartsWithStr {
+			return startsWith, nil
+		}
+		return c.notIfPresent(col, startsWith), nil
+	default:
+		return c.ConvertComparisonExpr(operator, col, value)
+	}
+}
+
+func (c *predicateStoreQueryConverter) ConvertKeywordListComparisonExpr(
+	operator string,
+	col *SAColumn,
+	value any,
+) (Predicate, error) {
+	var items []any
+	switch operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		items = []any{value}
+	case sqlparser.InStr, sqlparser.NotInStr:
+		list, ok := value.([]any)
+		if !ok {
+			return nil, c.newInvalidValueError(operator, value)
+		}
+		items = list
+	default:
+		return nil, NewOperatorNotSupportedError(col.Alias, col.ValueType, operator)
+	}
+
+	// A keyword list matches if it contains any of the values.
+	contains := func(values map[string]any) bool {
+		list, ok := values[col.FieldName].([]string)
+		return ok && slices.ContainsFunc(items, func(item any) bool {
+			s, ok := item.(string)
+			return ok && slices.Contains(list, s)
+		})
+	}
+	if operator == sqlparser.EqualStr || operator == sqlparser.InStr {
+		return contains, nil
+	}
+	return c.notIfPresent(col, contains), nil
+}
+
+func (c *predicateStoreQueryConverter) ConvertTextComparisonExpr(
+	operator string,
+	col *SAColumn,
+	value any,
+) (Predicate, error) {
+	text, ok := value.(string)
+	if !ok {
+		return nil, c.newInvalidValueError(operator, value)
+	}
+	// Text search attributes match if they contain all the words of the value, ignoring case.
+	words := tokenize(text)
+	match := func(values map[string]any) bool {
+		v, ok := values[col.FieldName].(string)
+		if !ok {
+			return false
+		}
+		tokens := tokenize(v)
+		for _, word := range words {
+			if !slices.Contains(tokens, word) {
+				return false
+			}
+		}
+		return true
+	}
+	if operator == sqlparser.EqualStr {
+		return match, nil
+	}
+	return c.notIfPresent(col, match), nil
+}
+
+func (c *predicateStoreQueryConverter) ConvertRangeExpr(
+	operator string,
+	col *SAColumn,
+	from, to any,
+) (Predicate, error) {
+	from, err := c.convertValue(col, from)
+	if err != nil {
+		return nil, err
+	}
+	to, err = c.convertValue(col, to)
+	if err != nil {
+		return nil, err
+	}
+	between := func(values map[string]any) bool {
+		v, ok := values[col.FieldName]
+		if !ok {
+			return false
+		}
+		fromResult, ok := compareValues(v, from)
+		if !ok {
+			return false
+		}
+		toResult, ok := compareValues(v, to)
+		return ok && fromResult >= 0 && toResult <= 0
+	}
+	if operator == sqlparser.BetweenStr {
+		return between, nil
+	}
+	return c.notIfPresent(col, between), nil
+}
+
+func (c *predicateStoreQueryConverter) ConvertIsExpr(operator string, col *SAColumn) (Predicate, error) {
+	return func(values map[string]any) bool {
+		_, ok := values[col.FieldName]
+		return ok == (operator == sqlparser.IsNotNullStr)
+	}, nil
+}
+
+// notIfPresent negates a predicate, but is false if the value is missing, like negated operators in SQL.
+func (c *predicateStoreQueryConverter) notIfPresent(col *SAColumn, expr Predicate) Predicate {
+	return func(values map[string]any) bool {
+		_, ok := values[col.FieldName]
+		return ok && !expr(values)
+	}
+}
+
+// convertValue parses datetime values, which the query converter formats as strings.
+func (c *predicateStoreQueryConverter) convertValue(col *SAColumn, value any) (any, error) {
+	if col.ValueType != enumspb.INDEXED_VALUE_TYPE_DATETIME {
+		return value, nil
+	}
+	switch v := value.(type) {
+	case string:
+		tm, err := time.Parse(c.GetDatetimeFormat(), v)
+		if err != nil {
+			return nil, NewConverterError("%s: unable to parse datetime '%s'", InvalidExpressionErrMessage, v)
+		}
+		return tm, nil
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			var err error
+			if result[i], err = c.convertValue(col, item); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	default:
+		return value, nil
+	}
+}
+
+func (c *predicateStoreQueryConverter) newInvalidValueError(operator string, value any) error {
+	return NewConverterError(
+		"%s: unexpected value %v for operator %q",
+		InvalidExpressionErrMessage,
+		value,
+		operator,
+	)
+}
+
+// compareValues compares a value of a record with a value of a query. It returns false if they aren't comparable.
+func compareValues(recordValue any, queryValue any) (int, bool) {
+	switch rv := recordValue.(type) {
+	case string:
+		qv, ok := queryValue.(string)
+		return strings.Compare(rv, qv), ok
+	case int64:
+		switch qv := queryValue.(type) {
+		case int64:
+			return cmp.Compare(rv, qv), true
+		case float64:
+			return cmp.Compare(float64(rv), qv), true
+		}
+	case float64:
+		switch qv := queryValue.(type) {
+		case int64:
+			return cmp.Compare(rv, float64(qv)), true
+		case float64:
+			return cmp.Compare(rv, qv), true
+		}
+	case bool:
+		if qv, ok := queryValue.(bool); ok {
+			if rv == qv {
+				return 0, true
+			}
+			// Only equality is meaningful for booleans.
+			return 1, true
+		}
+	case time.Time:
+		if qv, ok := queryValue.(time.Time); ok {
+			return rv.Compare(qv), true
+		}
+	}
+	return 0, false
+}
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
@@ -0,0 +1,99 @@
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+)
+
+func TestPredicateQueryConverter(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	record := map[string]any{
+		sadefs.WorkflowID:        "wf-123",
+		sadefs.WorkflowType:      "order",
+		sadefs.ExecutionStatus:   "Running",
+		sadefs.StartTime:         startTime,
+		sadefs.HistoryLength:     int64(10),
+		sadefs.ExecutionDuration: int64(2 * time.Second),
+		"Keyword01":              "gold",
+		"Int01":                  int64(5),
+		"Double01":               1.5,
+		"Bool01":                 true,
+		"Text01":                 "The quick brown fox",
+		"KeywordList01":          []string{"a", "b"},
+	}
+
+	testCases := []struct {
+		query string
+		match bool
+	}{
+		{query: "", match: true},
+		{query: "WorkflowId = 'wf-123'", match: true},
+		{query: "WorkflowId != 'wf-123'", match: false},
+		{query: "WorkflowId STARTS_WITH 'wf-'", match: true},
+		{query: "WorkflowId NOT STARTS_WITH 'wf-'", match: false},
+		{query: "WorkflowType IN ('order', 'payment')", match: true},
+		{query: "WorkflowType NOT IN ('order', 'payment')", match: false},
+		{query: "ExecutionStatus = 'Running'", match: true},
+		{query: "ExecutionStatus = 1", match: true},
+		{query: "ExecutionStatus = 'Completed' OR AliasForKeyword01 = 'gold'", match: true},
+		{query: "ExecutionStatus = 'Running' AND NOT (AliasForKeyword01 = 'gold')", match: false},
+		{query: "StartTime > '2024-01-01T00:00:00Z'", match: true},
+		{query: "StartTime BETWEEN '2024-01-02T00:00:00Z' AND '2024-01-03T00:00:00Z'", match: true},
+		{query: "StartTime NOT BETWEEN '2024-01-02T00:00:00Z' AND '2024-01-03T00:00:00Z'", match: false},
+		{query: "CloseTime IS NULL", match: true},
+		{query: "CloseTime IS NOT NULL", match: false},
+		{query: "CloseTime > '2024-01-01T00:00:00Z'", match: false},
+		{query: "HistoryLength >= 10", match: true},
+		{query: "HistoryLength < 10", match: false},
+		{query: "ExecutionDuration > '1s'", match: true},
+		{query: "AliasForInt01 <= 5.5", match: true},
+		{query: "AliasForDouble01 > 1", match: true},
+		{query: "AliasForBool01 = true", match: true},
+		{query: "AliasForBool01 = false", match: false},
+		{query: "AliasForText01 = 'QUICK fox'", match: true},
+		{query: "AliasForText01 = 'slow fox'", match: false},
+		{query: "AliasForText01 != 'slow fox'", match: true},
+		{query: "AliasForKeywordList01 = 'a'", match: true},
+		{query: "AliasForKeywordList01 IN ('c', 'b')", match: true},
+		{query: "AliasForKeywordList01 NOT IN ('c', 'b')", match: false},
+		{query: "AliasForKeywordList01 != 'c'", match: true},
+		{query: "TemporalNamespaceDivision = 'division'", match: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			t.Parallel()
+			c := NewPredicateQueryConverter(
+				testNamespaceName,
+				searchattribute.TestNameTypeMap(),
+				&searchattribute.TestMapper{},
+			)
+			queryParams, err := c.Convert(tc.query)
+			require.NoError(t, err)
+			assert.Equal(t, tc.match, queryParams.QueryExpr(record))
+		})
+	}
+}
+
+func TestPredicateQueryConverter_NamespaceDivision(t *testing.T) {
+	t.Parallel()
+
+	c := NewPredicateQueryConverter(
+		testNamespaceName,
+		searchattribute.TestNameTypeMap(),
+		&searchattribute.TestMapper{},
+	)
+	queryParams, err := c.Convert("WorkflowId = 'wf'")
+	require.NoError(t, err)
+	assert.True(t, queryParams.QueryExpr(map[string]any{sadefs.WorkflowID: "wf"}))
+	assert.False(t, queryParams.QueryExpr(map[string]any{
+		sadefs.WorkflowID:                "wf",
+		sadefs.TemporalNamespaceDivision: "division",
+	}))
+}
@@ -14,10 +14,10 @@ import (
 )
 
 type (
-	pgCastExpr struct {
+	// pgTSQueryOrExpr is the disjunction of tsqueries: `(q1 || q2 || ...)`.
+	pgTSQueryOrExpr struct {
 		sqlparser.Expr
-		Value sqlparser.Expr
-		Type  *sqlparser.ConvertType
+		Queries []sqlparser.Expr
 	}
 
 	pgQueryConverter struct{}
@@ -27,17 +27,27 @@ const (
 	jsonBuildArrayFuncName = "jsonb_build_array"
 	jsonContainsOp         = "@>"
 	ftsMatchOp             = "@@"
+	plainToTSQueryFuncName = "plainto_tsquery"
+	// pgFtsConfigName must match the text search configuration of the Text columns in the schema.
+	pgFtsConfigName = "simple"
 )
 
-var (
-	convertTypeTSQuery = &sqlparser.ConvertType{Type: "tsquery"}
-)
-
-var _ sqlparser.Expr = (*pgCastExpr)(nil)
+var _ sqlparser.Expr = (*pgTSQueryOrExpr)(nil)
 var _ pluginQueryConverterLegacy = (*pgQueryConverter)(nil)
 
-func (node *pgCastExpr) Format(buf *sqlparser.TrackedBuffer) {
-	buf.Myprintf("%v::%v", node.Value, node.Type)
+func (node *pgTSQueryOrExpr) Format(buf *sqlparser.TrackedBuffer) {
+	if len(node.Queries) == 1 {
+		buf.Myprintf("%v", node.Queries[0])
+		return
+	}
+	buf.Myprintf("(")
+	for i, q := range node.Queries {
+		if i > 0 {
+			buf.Myprintf(" || ")
+		}
+		buf.Myprintf("%v", q)
+	}
+	buf.Myprintf(")")
 }
 
 func newPostgreSQLQueryConverter(
@@ -172,14 +182,20 @@ func (c *pgQueryConverter) convertTextComparisonExpr(
 			sqlparser.String(expr.Right),
 		)
 	}
-	valueExpr.Val = strings.Join(tokens, " | ")
+	// Each token goes through plainto_tsquery, so it is normalized like the indexed text and characters with
+	// a meaning in the tsquery syntax are ignored.
+	queries := make([]sqlparser.Expr, len(tokens))
+	for i, token := range tokens {
+		queries[i] = newFuncExpr(
+			plainToTSQueryFuncName,
+			newUnsafeSQLString(pgFtsConfigName),
+			newUnsafeSQLString(token),
+		)
+	}
 	var newExpr sqlparser.Expr = &sqlparser.ComparisonExpr{
 		Operator: ftsMatchOp,
 		Left:     expr.Left,
-		Right: &pgCastExpr{
-			Value: expr.Right,
-			Type:  convertTypeTSQuery,
-		},
+		Right:    &pgTSQueryOrExpr{Queries: queries},
 	}
 	if expr.Operator == sqlparser.NotEqualStr {
 		newExpr = &sqlparser.NotExpr{Expr: newExpr}
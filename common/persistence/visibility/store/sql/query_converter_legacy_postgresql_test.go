@@ -105,13 +105,13 @@ func (s *postgresqlQueryConverterSuite) TestConvertTextComparisonExpr() {
 		{
 			name:   "valid equal expression",
 			input:  "AliasForText01 = 'foo bar'",
-			output: "Text01 @@ 'foo | bar'::tsquery",
+			output: "Text01 @@ (plainto_tsquery('simple', 'foo') || plainto_tsquery('simple', 'bar'))",
 			err:    nil,
 		},
 		{
 			name:   "valid not equal expression",
 			input:  "AliasForText01 != 'foo bar'",
-			output: "not Text01 @@ 'foo | bar'::tsquery",
+			output: "not Text01 @@ (plainto_tsquery('simple', 'foo') || plainto_tsquery('simple', 'bar'))",
 			err:    nil,
 		},
 	}
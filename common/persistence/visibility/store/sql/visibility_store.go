@@ -40,6 +40,7 @@ type (
 		logger                         log.Logger
 
 		enableUnifiedQueryConverter dynamicconfig.BoolPropertyFn
+		countGroupByMaxGroups       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 
 	listExecutionsRequestInternal struct {
@@ -65,6 +66,7 @@ func NewSQLVisibilityStore(
 	searchAttributesMapperProvider searchattribute.MapperProvider,
 	chasmRegistry *chasm.Registry,
 	enableUnifiedQueryConverter dynamicconfig.BoolPropertyFn,
+	countGroupByMaxGroups dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	serializer serialization.Serializer,
@@ -83,6 +85,7 @@ func NewSQLVisibilityStore(
 		logger:                         logger,
 
 		enableUnifiedQueryConverter: enableUnifiedQueryConverter,
+		countGroupByMaxGroups:       countGroupByMaxGroups,
 	}, nil
 }
 
@@ -287,7 +290,7 @@ func (s *VisibilityStore) countChasmExecutions(
 	selectFilter := s.buildSelectFilterFromQueryParams(queryParams, sqlQC)
 
 	if len(selectFilter.GroupBy) > 0 {
-		return s.countGroupByExecutions(ctx, selectFilter, mapper)
+		return s.countGroupByExecutions(ctx, namespace.Name(request.Namespace), selectFilter, mapper)
 	}
 
 	count, err := s.sqlStore.DB.CountFromVisibility(ctx, *selectFilter)
@@ -334,7 +337,7 @@ func (s *VisibilityStore) countChasmExecutionsLegacy(
 	}
 
 	if len(selectFilter.GroupBy) > 0 {
-		return s.countGroupByExecutions(ctx, selectFilter, mapper)
+		return s.countGroupByExecutions(ctx, namespace.Name(request.Namespace), selectFilter, mapper)
 	}
 
 	count, err := s.sqlStore.DB.CountFromVisibility(ctx, *selectFilter)
@@ -583,7 +586,7 @@ func (s *VisibilityStore) countWorkflowExecutionsLegacy(
 	}
 
 	if len(selectFilter.GroupBy) > 0 {
-		return s.countGroupByExecutions(ctx, selectFilter, nil)
+		return s.countGroupByExecutions(ctx, request.Namespace, selectFilter, nil)
 	}
 
 	count, err := s.sqlStore.DB.CountFromVisibility(ctx, *selectFilter)
@@ -636,7 +639,7 @@ func (s *VisibilityStore) countWorkflowExecutions(
 	selectFilter := s.buildSelectFilterFromQueryParams(queryParams, sqlQC)
 
 	if len(selectFilter.GroupBy) > 0 {
-		return s.countGroupByExecutions(ctx, selectFilter, nil)
+		return s.countGroupByExecutions(ctx, request.Namespace, selectFilter, nil)
 	}
 
 	count, err := s.sqlStore.DB.CountFromVisibility(ctx, *selectFilter)
@@ -693,13 +696,25 @@ func (s *VisibilityStore) buildSelectFilterFromQueryParams(
 
 func (s *VisibilityStore) countGroupByExecutions(
 	ctx context.Context,
+	nsName namespace.Name,
 	selectFilter *sqlplugin.VisibilitySelectFilter,
 	chasmMapper *chasm.VisibilitySearchAttributesMapper,
 ) (*store.InternalCountExecutionsResponse, error) {
+	// Fetch one more group than allowed to tell if the query exceeds the limit.
+	maxGroups := s.countGroupByMaxGroups(nsName.String())
+	selectFilter.Query += " LIMIT ?"
+	selectFilter.QueryArgs = append(selectFilter.QueryArgs, maxGroups+1)
+
 	rows, err := s.sqlStore.DB.CountGroupByFromVisibility(ctx, *selectFilter)
 	if err != nil {
 		return nil, convertSQLError("CountExecutions operation failed.", err)
 	}
+	if len(rows) > maxGroups {
+		return nil, serviceerror.NewInvalidArgumentf(
+			"query matches more than %d groups, use a more selective query or fewer 'GROUP BY' fields",
+			maxGroups,
+		)
+	}
 
 	groupByTypes, err := s.getGroupByFieldTypes(selectFilter.GroupBy, chasmMapper)
 	if err != nil {
@@ -713,6 +728,12 @@ func (s *VisibilityStore) countGroupByExecutions(
 	for _, row := range rows {
 		groupValues := make([]*commonpb.Payload, len(row.GroupValues))
 		for i, val := range row.GroupValues {
+			if groupByTypes[i] == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+				val, err = parseTimeBucketValue(val)
+				if err != nil {
+					return nil, err
+				}
+			}
 			groupValues[i], err = sadefs.EncodeValue(val, groupByTypes[i])
 			if err != nil {
 				return nil, err
@@ -730,6 +751,27 @@ func (s *VisibilityStore) countGroupByExecutions(
 	return resp, nil
 }
 
+// parseTimeBucketValue parses the value of a datetime search attribute in a GROUP BY clause.
+// Datetime fields are only allowed in time bucket functions, which return a string in MySQL and
+// SQLite, and a timestamp in PostgreSQL. Executions without a value for the field are grouped
+// under a nil value.
+func parseTimeBucketValue(val any) (any, error) {
+	switch v := val.(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		return v.UTC(), nil
+	case string:
+		t, err := time.ParseInLocation(time.DateTime, v, time.UTC)
+		if err != nil {
+			return nil, serviceerror.NewInternalf("unable to parse time bucket value %q: %v", v, err)
+		}
+		return t, nil
+	default:
+		return nil, serviceerror.NewInternalf("unexpected time bucket value type %T", val)
+	}
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
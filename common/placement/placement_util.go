@@ -0,0 +1,120 @@
+package placement
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// ConstraintsHeaderKey is the key in the header of a ScheduleActivityTask command that holds
+	// the placement constraints of the activity, as a json encoded map of label keys to values.
+	ConstraintsHeaderKey = "temporal-placement-constraints"
+	// WorkerLabelsHeaderName is the gRPC header in which workers advertise their labels on
+	// PollWorkflowTaskQueue and PollActivityTaskQueue, in the "key1=value1,key2=value2" format.
+	WorkerLabelsHeaderName = "temporal-worker-labels"
+
+	maxLabels         = 16
+	labelKeyMaxLength = 64
+	labelValMaxLength = 255
+)
+
+var (
+	ErrTooManyLabels   = serviceerror.NewInvalidArgument(fmt.Sprintf("at most %d labels or constraints are allowed", maxLabels))
+	ErrInvalidLabelKey = serviceerror.NewInvalidArgument(fmt.Sprintf("label keys must be non-empty, at most %d characters and can't contain '=' or ','", labelKeyMaxLength))
+	ErrInvalidLabelVal = serviceerror.NewInvalidArgument(fmt.Sprintf("label values must be at most %d characters and can't contain ','", labelValMaxLength))
+)
+
+// Validate checks worker labels or placement constraints. Both use the same format, so that
+// any set of labels can be used as constraints.
+func Validate(labels map[string]string) error {
+	if len(labels) > maxLabels {
+		return ErrTooManyLabels
+	}
+	for k, v := range labels {
+		if k == "" || len(k) > labelKeyMaxLength || strings.ContainsAny(k, "=,") {
+			return ErrInvalidLabelKey
+		}
+		if len(v) > labelValMaxLength || strings.Contains(v, ",") {
+			return ErrInvalidLabelVal
+		}
+	}
+	return nil
+}
+
+// ParseLabels parses worker labels in the "key1=value1,key2=value2" format and validates them.
+func ParseLabels(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	labels := make(map[string]string)
+	for pair := range strings.SplitSeq(s, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, serviceerror.NewInvalidArgumentf("invalid worker label %q, expected key=value", pair)
+		}
+		labels[k] = v
+	}
+	if err := Validate(labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// ConstraintsFromHeader returns the placement constraints set in a command header, or nil if
+// there are none.
+func ConstraintsFromHeader(header *commonpb.Header) (map[string]string, error) {
+	p := header.GetFields()[ConstraintsHeaderKey]
+	if p == nil {
+		return nil, nil
+	}
+	var constraints map[string]string
+	if err := payload.Decode(p, &constraints); err != nil {
+		return nil, serviceerror.NewInvalidArgumentf("invalid placement constraints in header %q: %v", ConstraintsHeaderKey, err)
+	}
+	if err := Validate(constraints); err != nil {
+		return nil, err
+	}
+	if len(constraints) == 0 {
+		return nil, nil
+	}
+	return constraints, nil
+}
+
+// Satisfies returns true if a worker with the given labels can be given a task with the given
+// constraints, i.e. if the worker has every constrained label with the same value.
+func Satisfies(labels, constraints map[string]string) bool {
+	for k, v := range constraints {
+		if lv, ok := labels[k]; !ok || lv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// KeyString returns the keys of constraints (or labels) in the "key1,key2" format, in a stable order.
+// Unlike String, it doesn't include values, which have a much higher cardinality than keys, so it
+// can be used as a metric tag value.
+func KeyString(constraints map[string]string) string {
+	keys := slices.Sorted(maps.Keys(constraints))
+	return strings.Join(keys, ",")
+}
+
+// String returns constraints (or labels) in the "key1=value1,key2=value2" format, in a stable order.
+// The result can be parsed by ParseLabels and is stable, so it can be used as a map key.
+func String(constraints map[string]string) string {
+	if len(constraints) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(constraints))
+	for k, v := range constraints {
+		pairs = append(pairs, k+"="+v)
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, ",")
+}
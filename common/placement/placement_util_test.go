@@ -0,0 +1,85 @@
+package placement
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/payload"
+)
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels("region=us-east, gpu=a100,licensed=")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"region": "us-east", "gpu": "a100", "licensed": ""}, labels)
+
+	labels, err = ParseLabels("")
+	require.NoError(t, err)
+	assert.Nil(t, labels)
+
+	_, err = ParseLabels("region")
+	assert.Error(t, err)
+	_, err = ParseLabels("=us-east")
+	assert.ErrorIs(t, err, ErrInvalidLabelKey)
+	_, err = ParseLabels(strings.Repeat("a", labelKeyMaxLength+1) + "=b")
+	assert.ErrorIs(t, err, ErrInvalidLabelKey)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(nil))
+	assert.ErrorIs(t, Validate(map[string]string{"a": "b,c"}), ErrInvalidLabelVal)
+	assert.ErrorIs(t, Validate(map[string]string{"a=b": "c"}), ErrInvalidLabelKey)
+
+	tooMany := make(map[string]string)
+	for i := range maxLabels + 1 {
+		tooMany[strings.Repeat("k", i+1)] = "v"
+	}
+	assert.ErrorIs(t, Validate(tooMany), ErrTooManyLabels)
+}
+
+func TestConstraintsFromHeader(t *testing.T) {
+	constraints, err := ConstraintsFromHeader(nil)
+	require.NoError(t, err)
+	assert.Nil(t, constraints)
+
+	p, err := payload.Encode(map[string]string{"region": "us-east"})
+	require.NoError(t, err)
+	constraints, err = ConstraintsFromHeader(&commonpb.Header{Fields: map[string]*commonpb.Payload{
+		ConstraintsHeaderKey: p,
+		"other":              payload.EncodeString("ignored"),
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"region": "us-east"}, constraints)
+
+	_, err = ConstraintsFromHeader(&commonpb.Header{Fields: map[string]*commonpb.Payload{
+		ConstraintsHeaderKey: payload.EncodeString("region=us-east"),
+	}})
+	assert.Error(t, err)
+}
+
+func TestSatisfies(t *testing.T) {
+	labels := map[string]string{"region": "us-east", "gpu": "a100"}
+	assert.True(t, Satisfies(labels, nil))
+	assert.True(t, Satisfies(nil, nil))
+	assert.True(t, Satisfies(labels, map[string]string{"region": "us-east"}))
+	assert.True(t, Satisfies(labels, labels))
+	assert.False(t, Satisfies(labels, map[string]string{"region": "eu-west"}))
+	assert.False(t, Satisfies(labels, map[string]string{"licensed": ""}))
+	assert.False(t, Satisfies(nil, map[string]string{"region": "us-east"}))
+}
+
+func TestKeyString(t *testing.T) {
+	assert.Equal(t, "", KeyString(nil))
+	assert.Equal(t, "gpu,region", KeyString(map[string]string{"region": "us-east", "gpu": "a100"}))
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "", String(nil))
+	s := String(map[string]string{"region": "us-east", "gpu": "a100"})
+	assert.Equal(t, "gpu=a100,region=us-east", s)
+	parsed, err := ParseLabels(s)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"region": "us-east", "gpu": "a100"}, parsed)
+}
@@ -20,6 +20,8 @@ const (
 	AddSearchAttributesActivityTQ = "temporal-sys-add-search-attributes-activity-tq"
 	DeleteNamespaceActivityTQ     = "temporal-sys-delete-namespace-activity-tq"
 	DLQActivityTQ                 = "temporal-sys-dlq-activity-tq"
+	VisibilityDriftActivityTQ     = "temporal-sys-visibility-drift-activity-tq"
+	VisibilityRebuildActivityTQ   = "temporal-sys-visibility-rebuild-activity-tq"
 )
 
 // IsInternalTaskQueueKind returns true if the task queue kind identifies a
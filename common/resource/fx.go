@@ -3,6 +3,7 @@ package resource
 import (
 	"crypto/tls"
 	"fmt"
+	"maps"
 	"net"
 	"os"
 	"time"
@@ -24,6 +25,7 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/deadlock"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/dcoverride"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -80,6 +82,7 @@ type (
 var Module = fx.Options(
 	persistenceClient.Module,
 	dynamicconfig.Module,
+	dcoverride.Module,
 	serialization.Module,
 	fx.Provide(HostNameProvider),
 	fx.Provide(TimeSourceProvider),
@@ -121,6 +124,7 @@ var DefaultOptions = fx.Options(
 	fx.Provide(PerServiceDialOptionsProvider),
 	fx.Provide(ArchivalMetadataProvider),
 	fx.Provide(ArchiverProviderProvider),
+	fx.Provide(HistoryTieringProvider),
 	fx.Provide(ThrottledLoggerProvider),
 	fx.Provide(SdkClientFactoryProvider),
 	fx.Provide(DCRedirectionPolicyProvider),
@@ -343,6 +347,14 @@ func MatchingClientProvider(matchingRawClient MatchingRawClient, dc *dynamicconf
 
 func PersistenceConfigProvider(persistenceConfig config.Persistence, dc *dynamicconfig.Collection) *config.Persistence {
 	persistenceConfig.TransactionSizeLimit = dynamicconfig.TransactionSizeLimit.Get(dc)
+	persistenceConfig.RecordingDir = dynamicconfig.PersistenceRecordingDir.Get(dc)
+	if store, ok := persistenceConfig.DataStores[persistenceConfig.DefaultStore]; ok && store.FaultInjection != nil {
+		faultInjection := *store.FaultInjection
+		faultInjection.ScenarioFileOverride = dynamicconfig.PersistenceFaultInjectionScenarioFile.Get(dc)
+		store.FaultInjection = &faultInjection
+		persistenceConfig.DataStores = maps.Clone(persistenceConfig.DataStores)
+		persistenceConfig.DataStores[persistenceConfig.DefaultStore] = store
+	}
 	return &persistenceConfig
 }
 
@@ -376,6 +388,26 @@ func ArchiverProviderProvider(
 	)
 }
 
+func HistoryTieringProvider(
+	cfg *config.Config,
+	dc *dynamicconfig.Collection,
+) (*persistence.HistoryTieringConfig, error) {
+	tiering := cfg.Persistence.HistoryTiering
+	if tiering == nil {
+		return nil, nil
+	}
+	blobStore, err := provider.NewHistoryBlobStore(tiering.URI, cfg.Archival.History.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the history tiering blob store: %w", err)
+	}
+	return &persistence.HistoryTieringConfig{
+		BlobStore:      blobStore,
+		ChunkSize:      dynamicconfig.HistoryTieringChunkSize.Get(dc),
+		MinAge:         dynamicconfig.HistoryTieringMinAge.Get(dc),
+		CacheSizeBytes: tiering.CacheSizeBytes,
+	}, nil
+}
+
 func SdkClientFactoryProvider(
 	cfg *config.Config,
 	tlsConfigProvider encryption.TLSConfigProvider,
@@ -25,6 +25,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.CancelDLQJobResponse:
 		return nil
+	case *adminservice.CancelVisibilityDriftCheckRequest:
+		return nil
+	case *adminservice.CancelVisibilityDriftCheckResponse:
+		return nil
+	case *adminservice.CancelVisibilityRebuildRequest:
+		return nil
+	case *adminservice.CancelVisibilityRebuildResponse:
+		return nil
 	case *adminservice.CloseShardRequest:
 		return nil
 	case *adminservice.CloseShardResponse:
@@ -33,6 +41,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.DeepHealthCheckResponse:
 		return nil
+	case *adminservice.DeleteDynamicConfigOverrideRequest:
+		return nil
+	case *adminservice.DeleteDynamicConfigOverrideResponse:
+		return nil
+	case *adminservice.DeleteVisibilityViewRequest:
+		return nil
+	case *adminservice.DeleteVisibilityViewResponse:
+		return nil
 	case *adminservice.DeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -66,6 +82,22 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.DescribeTaskQueuePartitionResponse:
 		return nil
+	case *adminservice.DescribeVisibilityDriftCheckRequest:
+		return nil
+	case *adminservice.DescribeVisibilityDriftCheckResponse:
+		return nil
+	case *adminservice.DescribeVisibilityRebuildRequest:
+		return nil
+	case *adminservice.DescribeVisibilityRebuildResponse:
+		return nil
+	case *adminservice.DescribeVisibilityViewRequest:
+		return nil
+	case *adminservice.DescribeVisibilityViewResponse:
+		return nil
+	case *adminservice.ExplainDynamicConfigRequest:
+		return nil
+	case *adminservice.ExplainDynamicConfigResponse:
+		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionResponse:
@@ -89,6 +121,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetDLQTasksResponse:
 		return nil
+	case *adminservice.GetDynamicConfigHistoryRequest:
+		return nil
+	case *adminservice.GetDynamicConfigHistoryResponse:
+		return nil
+	case *adminservice.GetDynamicConfigOverridesRequest:
+		return nil
+	case *adminservice.GetDynamicConfigOverridesResponse:
+		return nil
 	case *adminservice.GetNamespaceRequest:
 		return nil
 	case *adminservice.GetNamespaceResponse:
@@ -154,6 +194,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ListQueuesResponse:
 		return nil
+	case *adminservice.ListTaskQueueDLQTasksRequest:
+		return nil
+	case *adminservice.ListTaskQueueDLQTasksResponse:
+		return nil
+	case *adminservice.ListVisibilityViewsRequest:
+		return nil
+	case *adminservice.ListVisibilityViewsResponse:
+		return nil
 	case *adminservice.MergeDLQMessagesRequest:
 		return nil
 	case *adminservice.MergeDLQMessagesResponse:
@@ -166,6 +214,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.MigrateScheduleResponse:
 		return nil
+	case *adminservice.PauseTaskQueueDispatchRequest:
+		return nil
+	case *adminservice.PauseTaskQueueDispatchResponse:
+		return nil
 	case *adminservice.PurgeDLQMessagesRequest:
 		return nil
 	case *adminservice.PurgeDLQMessagesResponse:
@@ -188,6 +240,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.RebuildMutableStateResponse:
 		return nil
+	case *adminservice.ReenqueueTaskQueueDLQTasksRequest:
+		return nil
+	case *adminservice.ReenqueueTaskQueueDLQTasksResponse:
+		return nil
 	case *adminservice.RefreshWorkflowTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -214,10 +270,37 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ResendReplicationTasksResponse:
 		return nil
+	case *adminservice.RestoreArchivedWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.RestoreArchivedWorkflowExecutionResponse:
+		return nil
+	case *adminservice.ResumeTaskQueueDispatchRequest:
+		return nil
+	case *adminservice.ResumeTaskQueueDispatchResponse:
+		return nil
+	case *adminservice.SaveVisibilityViewRequest:
+		return nil
+	case *adminservice.SaveVisibilityViewResponse:
+		return nil
+	case *adminservice.SetDynamicConfigOverrideRequest:
+		return nil
+	case *adminservice.SetDynamicConfigOverrideResponse:
+		return nil
 	case *adminservice.StartAdminBatchOperationRequest:
 		return nil
 	case *adminservice.StartAdminBatchOperationResponse:
 		return nil
+	case *adminservice.StartVisibilityDriftCheckRequest:
+		return nil
+	case *adminservice.StartVisibilityDriftCheckResponse:
+		return nil
+	case *adminservice.StartVisibilityRebuildRequest:
+		return nil
+	case *adminservice.StartVisibilityRebuildResponse:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -93,6 +93,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.ExecuteMultiOperationResponse:
 		return nil
+	case *historyservice.ExplainDynamicConfigRequest:
+		return nil
+	case *historyservice.ExplainDynamicConfigResponse:
+		return nil
 	case *historyservice.ForceDeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetRequest().GetExecution().GetWorkflowId()),
@@ -233,6 +237,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetExecution().GetRunId()),
 		}
+	case *historyservice.PollVisibilityWatchEventsRequest:
+		return nil
+	case *historyservice.PollVisibilityWatchEventsResponse:
+		return nil
 	case *historyservice.PollWorkflowExecutionUpdateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetRequest().GetUpdateRef().GetWorkflowExecution().GetWorkflowId()),
@@ -265,6 +273,13 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.RebuildMutableStateResponse:
 		return nil
+	case *historyservice.RebuildWorkflowVisibilityRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.RebuildWorkflowVisibilityResponse:
+		return nil
 	case *historyservice.RecordActivityTaskHeartbeatRequest:
 		return wt.fromTaskToken(r.GetHeartbeatRequest().GetTaskToken())
 	case *historyservice.RecordActivityTaskHeartbeatResponse:
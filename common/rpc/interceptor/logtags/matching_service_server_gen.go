@@ -75,6 +75,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.DispatchNexusTaskResponse:
 		return nil
+	case *matchingservice.ExplainDynamicConfigRequest:
+		return nil
+	case *matchingservice.ExplainDynamicConfigResponse:
+		return nil
 	case *matchingservice.ForceLoadTaskQueuePartitionRequest:
 		return nil
 	case *matchingservice.ForceLoadTaskQueuePartitionResponse:
@@ -107,6 +111,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.ListNexusEndpointsResponse:
 		return nil
+	case *matchingservice.ListTaskQueueDLQTasksRequest:
+		return nil
+	case *matchingservice.ListTaskQueueDLQTasksResponse:
+		return nil
 	case *matchingservice.ListTaskQueuePartitionsRequest:
 		return nil
 	case *matchingservice.ListTaskQueuePartitionsResponse:
@@ -144,6 +152,16 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.RecordWorkerHeartbeatResponse:
 		return nil
+	case *matchingservice.ReenqueueTaskQueueDLQTasksRequest:
+		return nil
+	case *matchingservice.ReenqueueTaskQueueDLQTasksResponse:
+		return nil
+	case *matchingservice.ReleaseConcurrencySlotRequest:
+		return []tag.Tag{
+			tag.WorkflowRunID(r.GetRunId()),
+		}
+	case *matchingservice.ReleaseConcurrencySlotResponse:
+		return nil
 	case *matchingservice.ReplicateTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.ReplicateTaskQueueUserDataResponse:
@@ -176,6 +194,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.UpdateTaskQueueConfigResponse:
 		return nil
+	case *matchingservice.UpdateTaskQueueDispatchPauseRequest:
+		return nil
+	case *matchingservice.UpdateTaskQueueDispatchPauseResponse:
+		return nil
 	case *matchingservice.UpdateTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.UpdateTaskQueueUserDataResponse:
@@ -37,6 +37,8 @@ var (
 		"UpdateNexusEndpoint": {},
 		"ListNexusEndpoints":  {},
 		"DeleteNexusEndpoint": {},
+		// Dynamic config is explained per host, not per namespace.
+		"ExplainDynamicConfig": {},
 	}
 
 	historyAPIExcluded = map[string]struct{}{
@@ -58,6 +60,7 @@ var (
 		"CompleteNexusOperation":      {}, // NamespaceId is in the completion token for this request.
 		"CompleteNexusOperationChasm": {}, // NamespaceId is in the completion token for this request.
 		"DeepHealthCheck":             {},
+		"ExplainDynamicConfig":        {}, // Dynamic config is explained per host, not per namespace.
 	}
 )
 
@@ -0,0 +1,19 @@
+-- Text search attributes were cast to tsvector as is, which is case sensitive and keeps the punctuation around
+-- words. Rebuild them with to_tsvector, which tokenizes and lowercases the text like Elasticsearch does.
+DROP INDEX IF EXISTS by_text_01;
+DROP INDEX IF EXISTS by_text_02;
+DROP INDEX IF EXISTS by_text_03;
+
+ALTER TABLE executions_visibility
+  DROP COLUMN Text01,
+  DROP COLUMN Text02,
+  DROP COLUMN Text03;
+
+ALTER TABLE executions_visibility
+  ADD COLUMN Text01 TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', search_attributes->>'Text01')) STORED,
+  ADD COLUMN Text02 TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', search_attributes->>'Text02')) STORED,
+  ADD COLUMN Text03 TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', search_attributes->>'Text03')) STORED;
+
+CREATE INDEX by_text_01 ON executions_visibility USING GIN (namespace_id, Text01);
+CREATE INDEX by_text_02 ON executions_visibility USING GIN (namespace_id, Text02);
+CREATE INDEX by_text_03 ON executions_visibility USING GIN (namespace_id, Text03);
@@ -8,13 +8,16 @@ import (
 	"maps"
 	"math"
 	"net"
+	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
@@ -26,8 +29,10 @@ import (
 	batchspb "go.temporal.io/server/api/batch/v1"
 	clusterspb "go.temporal.io/server/api/cluster/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
+	dynamicconfigspb "go.temporal.io/server/api/dynamicconfig/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	healthspb "go.temporal.io/server/api/health/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -41,11 +46,15 @@ import (
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/dcoverride"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -57,9 +66,11 @@ import (
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
@@ -73,6 +84,8 @@ import (
 	"go.temporal.io/server/service/worker/dlq"
 	"go.temporal.io/server/service/worker/dummy"
 	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/visibilitydrift"
+	"go.temporal.io/server/service/worker/visibilityrebuild"
 	"google.golang.org/grpc/health"
 	grpchealthspb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -82,6 +95,13 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	listTaskQueueDLQTasksPageSize           = 100
+	visibilityDriftWorkflowIDPrefix         = "visibility-drift-"
+	visibilityRebuildWorkflowIDPrefix       = "visibility-rebuild-"
+
+	restoreArchivedHistoryPageSize = 1000
+	restoreImportRequestMaxSize    = 256 * 1024
+	restoreImportRequestMaxBatches = 16
 )
 
 type (
@@ -120,6 +140,12 @@ type (
 		historyHealthChecker       HealthChecker
 		chasmRegistry              *chasm.Registry
 		schedulerClient            schedulerpb.SchedulerServiceClient
+		dcOverrideManager          *dcoverride.Manager
+		dynamicConfig              *dynamicconfig.Collection
+		visibilityWatchStreams     *visibilityWatchStreamLimiter
+		archiverProvider           provider.ArchiverProvider
+		archivalMetadata           archiver.ArchivalMetadata
+		namespaceHandler           *namespaceHandler
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -157,6 +183,10 @@ type (
 		ChasmRegistry                       *chasm.Registry
 		NamespaceDataMerger                 nsreplication.NamespaceDataMerger
 		SchedulerClient                     schedulerpb.SchedulerServiceClient
+		DynamicConfigOverrideManager        *dcoverride.Manager
+		DynamicConfig                       *dynamicconfig.Collection
+		ArchiverProvider                    provider.ArchiverProvider
+		ArchivalMetadata                    archiver.ArchivalMetadata
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -232,6 +262,23 @@ func NewAdminHandler(
 		matchingClient:       args.matchingClient,
 		chasmRegistry:        args.ChasmRegistry,
 		schedulerClient:      args.SchedulerClient,
+		dcOverrideManager:    args.DynamicConfigOverrideManager,
+		dynamicConfig:        args.DynamicConfig,
+		archiverProvider:     args.ArchiverProvider,
+		archivalMetadata:     args.ArchivalMetadata,
+		namespaceHandler: newNamespaceHandler(
+			args.Logger,
+			args.PersistenceMetadataManager,
+			args.NamespaceRegistry,
+			args.ClusterMetadata,
+			nsreplication.NewReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger),
+			args.ArchivalMetadata,
+			args.ArchiverProvider,
+			args.TimeSource,
+			args.Config,
+		),
+
+		visibilityWatchStreams: newVisibilityWatchStreamLimiter(),
 	}
 }
 
@@ -724,6 +771,217 @@ func (adh *AdminHandler) ImportWorkflowExecution(
 	}, nil
 }
 
+// RestoreArchivedWorkflowExecution imports the archived history of a workflow execution as a closed execution, and
+// optionally resets it so that it continues in a new run.
+func (adh *AdminHandler) RestoreArchivedWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreArchivedWorkflowExecutionRequest,
+) (_ *adminservice.RestoreArchivedWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.Execution.GetRunId() == "" {
+		return nil, errInvalidRunID
+	}
+	if request.GetResetEventId() < 0 {
+		return nil, errInvalidResetEventID
+	}
+	if !adh.archivalMetadata.GetHistoryConfig().ReadEnabled() {
+		return nil, errClusterIsNotConfiguredForReadingArchivalHistory
+	}
+
+	sourceNamespace := namespace.Name(request.GetNamespace())
+	sourceEntry, err := adh.namespaceRegistry.GetNamespace(sourceNamespace)
+	if err != nil {
+		return nil, err
+	}
+	targetNamespace := sourceNamespace
+	if request.GetTargetNamespace() != "" {
+		targetNamespace = namespace.Name(request.GetTargetNamespace())
+	}
+	targetEntry, err := adh.namespaceRegistry.GetNamespace(targetNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	historyBatches, err := adh.getArchivedHistoryBatches(ctx, sourceEntry, request.Execution)
+	if err != nil {
+		return nil, err
+	}
+	lastBatchEvents := historyBatches[len(historyBatches)-1].GetEvents()
+	closeTime := lastBatchEvents[len(lastBatchEvents)-1].GetEventTime().AsTime()
+	if request.GetResetEventId() == 0 && !closeTime.Add(targetEntry.Retention()).After(time.Now()) {
+		// History would delete the restored execution as soon as it's imported.
+		return nil, errRestoredWorkflowExecutionPastRetention
+	}
+
+	versionHistory := &historyspb.VersionHistory{}
+	blobs := make([]*commonpb.DataBlob, 0, len(historyBatches))
+	for _, historyBatch := range historyBatches {
+		for _, event := range historyBatch.Events {
+			item := versionhistory.NewVersionHistoryItem(event.GetEventId(), event.GetVersion())
+			if err := versionhistory.AddOrUpdateVersionHistoryItem(versionHistory, item); err != nil {
+				return nil, err
+			}
+			if targetNamespace != sourceNamespace {
+				// Archived events have the field names of the source namespace. Replace them with aliases, which are
+				// mapped to the field names of the target namespace below.
+				if err := adh.aliasSearchAttributes(event, sourceNamespace); err != nil {
+					return nil, err
+				}
+			}
+		}
+		blob, err := adh.eventSerializer.SerializeEvents(historyBatch.Events)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		blobs = append(blobs, blob)
+	}
+	blobs, err = adh.unaliasAndValidateSearchAttributes(blobs, targetNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := adh.importHistoryBatches(ctx, targetEntry.ID(), request.Execution, blobs, versionHistory); err != nil {
+		return nil, err
+	}
+
+	if request.GetResetEventId() == 0 {
+		return &adminservice.RestoreArchivedWorkflowExecutionResponse{}, nil
+	}
+	requestID := request.GetRequestId()
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	resp, err := adh.historyClient.ResetWorkflowExecution(ctx, &historyservice.ResetWorkflowExecutionRequest{
+		NamespaceId: targetEntry.ID().String(),
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace:                 targetNamespace.String(),
+			WorkflowExecution:         request.Execution,
+			Reason:                    request.GetResetReason(),
+			WorkflowTaskFinishEventId: request.GetResetEventId(),
+			RequestId:                 requestID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RestoreArchivedWorkflowExecutionResponse{
+		ResetRunId: resp.GetRunId(),
+	}, nil
+}
+
+func (adh *AdminHandler) getArchivedHistoryBatches(
+	ctx context.Context,
+	entry *namespace.Namespace,
+	execution *commonpb.WorkflowExecution,
+) ([]*historypb.History, error) {
+	URIString := entry.HistoryArchivalState().URI
+	if URIString == "" {
+		// The namespace has never been enabled for archival.
+		return nil, errHistoryNotFound
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := adh.archiverProvider.GetHistoryArchiver(URI.Scheme())
+	if err != nil {
+		return nil, err
+	}
+
+	var historyBatches []*historypb.History
+	var nextPageToken []byte
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, &archiver.GetHistoryRequest{
+			NamespaceID:   entry.ID().String(),
+			WorkflowID:    execution.GetWorkflowId(),
+			RunID:         execution.GetRunId(),
+			NextPageToken: nextPageToken,
+			PageSize:      restoreArchivedHistoryPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, historyBatch := range resp.HistoryBatches {
+			if len(historyBatch.GetEvents()) > 0 {
+				historyBatches = append(historyBatches, historyBatch)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		nextPageToken = resp.NextPageToken
+	}
+	if len(historyBatches) == 0 {
+		return nil, errHistoryNotFound
+	}
+	return historyBatches, nil
+}
+
+func (adh *AdminHandler) aliasSearchAttributes(event *historypb.HistoryEvent, nsName namespace.Name) error {
+	sas, _ := searchattribute.GetFromEvent(event)
+	if sas == nil {
+		return nil
+	}
+	aliasedSas, err := searchattribute.AliasFields(adh.saMapperProvider, sas, nsName.String())
+	if err != nil {
+		return err
+	}
+	_ = searchattribute.SetToEvent(event, aliasedSas)
+	return nil
+}
+
+// importHistoryBatches sends the history batches to history in requests of bounded size, and then commits the
+// imported execution.
+func (adh *AdminHandler) importHistoryBatches(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	historyBatches []*commonpb.DataBlob,
+	versionHistory *historyspb.VersionHistory,
+) error {
+	var token []byte
+	importBatches := func(batches []*commonpb.DataBlob) error {
+		resp, err := adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+			NamespaceId:    namespaceID.String(),
+			Execution:      execution,
+			HistoryBatches: batches,
+			VersionHistory: versionHistory,
+			Token:          token,
+		})
+		if err != nil {
+			return err
+		}
+		token = resp.Token
+		return nil
+	}
+
+	start, size := 0, 0
+	for i, historyBatch := range historyBatches {
+		size += len(historyBatch.Data)
+		if size >= restoreImportRequestMaxSize || i+1-start >= restoreImportRequestMaxBatches || i == len(historyBatches)-1 {
+			if err := importBatches(historyBatches[start : i+1]); err != nil {
+				return err
+			}
+			start, size = i+1, 0
+		}
+	}
+	// A request without history commits the imported execution.
+	if err := importBatches(nil); err != nil {
+		return err
+	}
+	if len(token) != 0 {
+		return serviceerror.NewInternal("Restored workflow execution was not committed.")
+	}
+	return nil
+}
+
 func (adh *AdminHandler) unaliasAndValidateSearchAttributes(historyBatches []*commonpb.DataBlob, nsName namespace.Name) ([]*commonpb.DataBlob, error) {
 	var unaliasedBatches []*commonpb.DataBlob
 	for _, historyBatch := range historyBatches {
@@ -1622,6 +1880,18 @@ func (adh *AdminHandler) StartAdminBatchOperation(
 	if err != nil {
 		return nil, err
 	}
+	if request.GetVisibilityQuery() != "" {
+		// Resolve the saved view now, so that the batch isn't affected by later changes to the view.
+		visibilityQuery, err := resolveVisibilityViewQuery(
+			adh.namespaceRegistry,
+			namespace.Name(request.GetNamespace()),
+			request.GetVisibilityQuery(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		request.VisibilityQuery = visibilityQuery
+	}
 
 	// Validate concurrent batch operation
 	maxConcurrentBatchOperation := adh.config.MaxConcurrentAdminBatchOperation(request.GetNamespace())
@@ -1820,8 +2090,10 @@ func (adh *AdminHandler) DescribeTaskQueuePartition(
 	}
 
 	return &adminservice.DescribeTaskQueuePartitionResponse{
-		VersionsInfoInternal: resp.VersionsInfoInternal,
-		ScaleInfo:            resp.ScaleInfo,
+		VersionsInfoInternal:          resp.VersionsInfoInternal,
+		ScaleInfo:                     resp.ScaleInfo,
+		DispatchPause:                 resp.DispatchPause,
+		OutstandingTasksByFairnessKey: resp.OutstandingTasksByFairnessKey,
 	}, nil
 }
 
@@ -1915,6 +2187,192 @@ func (adh *AdminHandler) GetTaskQueueUserData(
 	}, nil
 }
 
+// PauseTaskQueueDispatch stops dispatch of tasks of one type of a task queue, on all of its partitions.
+func (adh *AdminHandler) PauseTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueDispatchRequest,
+) (_ *adminservice.PauseTaskQueueDispatchResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.validateTaskQueueDispatchPauseRequest(
+		request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueType(), request.GetIdentity(),
+	); err != nil {
+		return nil, err
+	}
+	// The reason is kept in task queue user data, so it needs a bound like any other field there.
+	if err := validateStringField("Reason", request.GetReason(), adh.config.MaxIDLengthLimit(), false); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.UpdateTaskQueueDispatchPause(ctx, &matchingservice.UpdateTaskQueueDispatchPauseRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     request.GetTaskQueue(),
+		TaskQueueType: request.GetTaskQueueType(),
+		Paused:        true,
+		Reason:        request.GetReason(),
+		Identity:      request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseTaskQueueDispatchResponse{
+		DispatchPause: resp.GetDispatchPause(),
+	}, nil
+}
+
+// ResumeTaskQueueDispatch resumes dispatch of tasks of one type of a task queue that was paused by
+// PauseTaskQueueDispatch.
+func (adh *AdminHandler) ResumeTaskQueueDispatch(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueDispatchRequest,
+) (_ *adminservice.ResumeTaskQueueDispatchResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.validateTaskQueueDispatchPauseRequest(
+		request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueType(), request.GetIdentity(),
+	); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.matchingClient.UpdateTaskQueueDispatchPause(ctx, &matchingservice.UpdateTaskQueueDispatchPauseRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     request.GetTaskQueue(),
+		TaskQueueType: request.GetTaskQueueType(),
+		Paused:        false,
+		Identity:      request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ResumeTaskQueueDispatchResponse{}, nil
+}
+
+func (adh *AdminHandler) validateTaskQueueDispatchPauseRequest(
+	namespaceName string,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+	identity string,
+) error {
+	if err := adh.validateTaskQueueAndType(namespaceName, taskQueue, taskQueueType); err != nil {
+		return err
+	}
+	if len(identity) > adh.config.MaxIDLengthLimit() {
+		return errIdentityTooLong
+	}
+	return nil
+}
+
+// ListTaskQueueDLQTasks returns a page of the tasks in the DLQ of one type of a task queue.
+func (adh *AdminHandler) ListTaskQueueDLQTasks(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueDLQTasksRequest,
+) (_ *adminservice.ListTaskQueueDLQTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.validateTaskQueueAndType(
+		request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueType(),
+	); err != nil {
+		return nil, err
+	}
+	pageSize := request.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = listTaskQueueDLQTasksPageSize
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.ListTaskQueueDLQTasks(ctx, &matchingservice.ListTaskQueueDLQTasksRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     request.GetTaskQueue(),
+		TaskQueueType: request.GetTaskQueueType(),
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListTaskQueueDLQTasksResponse{
+		Tasks:         resp.GetTasks(),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// ReenqueueTaskQueueDLQTasks adds tasks from the DLQ of one type of a task queue back to the task
+// queue, and removes them from the DLQ.
+func (adh *AdminHandler) ReenqueueTaskQueueDLQTasks(
+	ctx context.Context,
+	request *adminservice.ReenqueueTaskQueueDLQTasksRequest,
+) (_ *adminservice.ReenqueueTaskQueueDLQTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.validateTaskQueueAndType(
+		request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueType(),
+	); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.ReenqueueTaskQueueDLQTasks(ctx, &matchingservice.ReenqueueTaskQueueDLQTasksRequest{
+		NamespaceId:           namespaceID.String(),
+		TaskQueue:             request.GetTaskQueue(),
+		TaskQueueType:         request.GetTaskQueueType(),
+		InclusiveMaxMessageId: request.GetInclusiveMaxMessageId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ReenqueueTaskQueueDLQTasksResponse{
+		TasksReenqueued: resp.GetTasksReenqueued(),
+		HasMore:         resp.GetHasMore(),
+	}, nil
+}
+
+func (adh *AdminHandler) validateTaskQueueAndType(
+	namespaceName string,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+) error {
+	if len(namespaceName) == 0 {
+		return errNamespaceNotSet
+	}
+	if err := validateStringField("TaskQueue", taskQueue, adh.config.MaxIDLengthLimit(), true); err != nil {
+		return err
+	}
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return serviceerror.NewInvalidArgument("TaskQueueType must be set.")
+	}
+	return nil
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -2137,6 +2595,7 @@ func (adh *AdminHandler) GetNamespace(ctx context.Context, request *adminservice
 		FailoverVersion:   resp.Namespace.GetFailoverVersion(),
 		IsGlobalNamespace: resp.IsGlobalNamespace,
 		FailoverHistory:   convertFailoverHistoryToReplicationProto(resp.Namespace.GetReplicationConfig().GetFailoverHistory()),
+		VisibilityViews:   nsConfig.GetVisibilityViews(),
 	}
 	return nsResponse, nil
 }
@@ -2307,43 +2766,441 @@ func (adh *AdminHandler) CancelDLQJob(ctx context.Context, request *adminservice
 	return &adminservice.CancelDLQJobResponse{Canceled: true}, nil
 }
 
-// AddTasks just translates the admin service's request proto into a history service request proto and then sends it.
-func (adh *AdminHandler) AddTasks(
+// StartVisibilityDriftCheck starts a visibility drift workflow for a namespace. The job ID it returns is the ID of
+// the workflow, which stays the same when the workflow continues as new.
+func (adh *AdminHandler) StartVisibilityDriftCheck(
 	ctx context.Context,
-	request *adminservice.AddTasksRequest,
-) (*adminservice.AddTasksResponse, error) {
-	historyTasks := make([]*historyservice.AddTasksRequest_Task, len(request.Tasks))
-	for i, task := range request.Tasks {
-		historyTasks[i] = &historyservice.AddTasksRequest_Task{
-			CategoryId: task.CategoryId,
-			Blob:       task.Blob,
-		}
+	request *adminservice.StartVisibilityDriftCheckRequest,
+) (*adminservice.StartVisibilityDriftCheckResponse, error) {
+	if request == nil {
+		return nil, errRequestNotSet
 	}
-	historyServiceRequest := &historyservice.AddTasksRequest{
-		ShardId: request.ShardId,
-		Tasks:   historyTasks,
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
 	}
-	_, err := adh.historyClient.AddTasks(ctx, historyServiceRequest)
+	if request.GetSampleRate() < 0 || request.GetSampleRate() > 1 {
+		return nil, errVisibilityDriftSampleRateInvalid
+	}
+	if request.GetMaxExecutions() < 0 || request.GetRps() < 0 {
+		return nil, errVisibilityDriftNegativeParameters
+	}
+	if _, ok := adh.visibilityMgr.(*visibility.VisibilityManagerDual); !ok {
+		return nil, errSecondaryVisibilityNotConfigured
+	}
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
 	if err != nil {
 		return nil, err
 	}
-	return &adminservice.AddTasksResponse{}, nil
+
+	workflowID := fmt.Sprintf("%s%s-%s", visibilityDriftWorkflowIDPrefix, request.GetNamespace(), uuid.NewString())
+	_, err = adh.sdkClientFactory.GetSystemClient().ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{
+		ID:        workflowID,
+		TaskQueue: primitives.DefaultWorkerTaskQueue,
+	}, visibilitydrift.WorkflowName, visibilitydrift.WorkflowParams{
+		Namespace:     namespaceEntry.Name().String(),
+		NamespaceID:   namespaceEntry.ID().String(),
+		SampleRate:    request.GetSampleRate(),
+		MaxExecutions: request.GetMaxExecutions(),
+		Query:         request.GetQuery(),
+		Repair:        request.GetRepair(),
+		RPS:           int(request.GetRps()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartVisibilityDriftCheckResponse{JobId: workflowID}, nil
 }
 
-func (adh *AdminHandler) ListQueues(
+func (adh *AdminHandler) DescribeVisibilityDriftCheck(
 	ctx context.Context,
-	request *adminservice.ListQueuesRequest,
-) (*adminservice.ListQueuesResponse, error) {
-	historyServiceRequest := &historyservice.ListQueuesRequest{
-		QueueType:     request.QueueType,
-		PageSize:      request.PageSize,
-		NextPageToken: request.NextPageToken,
+	request *adminservice.DescribeVisibilityDriftCheckRequest,
+) (*adminservice.DescribeVisibilityDriftCheckResponse, error) {
+	if !strings.HasPrefix(request.GetJobId(), visibilityDriftWorkflowIDPrefix) {
+		return nil, errInvalidVisibilityDriftJobID
 	}
-	resp, err := adh.historyClient.ListQueues(ctx, historyServiceRequest)
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, request.GetJobId(), "")
 	if err != nil {
 		return nil, err
 	}
-	queues := make([]*adminservice.ListQueuesResponse_QueueInfo, len(resp.Queues))
+	response, err := client.QueryWorkflow(ctx, request.GetJobId(), "", visibilitydrift.QueryTypeProgress)
+	if err != nil {
+		return nil, err
+	}
+	var progress visibilitydrift.ProgressQueryResponse
+	if err = response.Get(&progress); err != nil {
+		return nil, err
+	}
+
+	drifted := make([]*adminservice.VisibilityDriftRecord, len(progress.DriftedExecutions))
+	for i, record := range progress.DriftedExecutions {
+		drifted[i] = &adminservice.VisibilityDriftRecord{
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: record.WorkflowID,
+				RunId:      record.RunID,
+			},
+			Missing:          record.Missing,
+			MismatchedFields: record.MismatchedFields,
+			Repaired:         record.Repaired,
+		}
+	}
+	return &adminservice.DescribeVisibilityDriftCheckResponse{
+		Namespace:           progress.Namespace,
+		Status:              execution.GetWorkflowExecutionInfo().GetStatus(),
+		StartTime:           execution.GetWorkflowExecutionInfo().GetStartTime(),
+		EndTime:             execution.GetWorkflowExecutionInfo().GetCloseTime(),
+		Repair:              progress.Repair,
+		ExecutionsScanned:   progress.ExecutionsScanned,
+		ExecutionsCompared:  progress.ExecutionsCompared,
+		MissingCount:        progress.MissingCount,
+		MismatchCount:       progress.MismatchCount,
+		RepairedCount:       progress.RepairedCount,
+		FieldMismatchCounts: progress.FieldMismatchCounts,
+		DriftedExecutions:   drifted,
+	}, nil
+}
+
+func (adh *AdminHandler) CancelVisibilityDriftCheck(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityDriftCheckRequest,
+) (*adminservice.CancelVisibilityDriftCheckResponse, error) {
+	if !strings.HasPrefix(request.GetJobId(), visibilityDriftWorkflowIDPrefix) {
+		return nil, errInvalidVisibilityDriftJobID
+	}
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, request.GetJobId(), "")
+	if err != nil {
+		return nil, err
+	}
+	if execution.GetWorkflowExecutionInfo().GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return &adminservice.CancelVisibilityDriftCheckResponse{Canceled: false}, nil
+	}
+	err = client.TerminateWorkflow(ctx, request.GetJobId(), "", request.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CancelVisibilityDriftCheckResponse{Canceled: true}, nil
+}
+
+// StartVisibilityRebuild starts a visibility rebuild workflow for a namespace. The job ID it returns is the ID of the
+// workflow, which stays the same when the workflow continues as new.
+func (adh *AdminHandler) StartVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.StartVisibilityRebuildRequest,
+) (*adminservice.StartVisibilityRebuildResponse, error) {
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetRps() < 0 {
+		return nil, errVisibilityRebuildNegativeRps
+	}
+	if request.GetVisibilityStore() == enumsspb.VISIBILITY_STORE_TARGET_SECONDARY {
+		if _, ok := adh.visibilityMgr.(*visibility.VisibilityManagerDual); !ok {
+			return nil, errSecondaryVisibilityNotConfigured
+		}
+	}
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	workflowID := fmt.Sprintf("%s%s-%s", visibilityRebuildWorkflowIDPrefix, request.GetNamespace(), uuid.NewString())
+	_, err = adh.sdkClientFactory.GetSystemClient().ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{
+		ID:        workflowID,
+		TaskQueue: primitives.DefaultWorkerTaskQueue,
+	}, visibilityrebuild.WorkflowName, visibilityrebuild.WorkflowParams{
+		Namespace:       namespaceEntry.Name().String(),
+		NamespaceID:     namespaceEntry.ID().String(),
+		VisibilityStore: request.GetVisibilityStore(),
+		ShardCount:      adh.numberOfHistoryShards,
+		RPS:             int(request.GetRps()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartVisibilityRebuildResponse{JobId: workflowID}, nil
+}
+
+func (adh *AdminHandler) DescribeVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityRebuildRequest,
+) (*adminservice.DescribeVisibilityRebuildResponse, error) {
+	if !strings.HasPrefix(request.GetJobId(), visibilityRebuildWorkflowIDPrefix) {
+		return nil, errInvalidVisibilityRebuildJobID
+	}
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, request.GetJobId(), "")
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.QueryWorkflow(ctx, request.GetJobId(), "", visibilityrebuild.QueryTypeProgress)
+	if err != nil {
+		return nil, err
+	}
+	var progress visibilityrebuild.ProgressQueryResponse
+	if err = response.Get(&progress); err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeVisibilityRebuildResponse{
+		Namespace:                         progress.Namespace,
+		VisibilityStore:                   progress.VisibilityStore,
+		Status:                            execution.GetWorkflowExecutionInfo().GetStatus(),
+		StartTime:                         execution.GetWorkflowExecutionInfo().GetStartTime(),
+		EndTime:                           execution.GetWorkflowExecutionInfo().GetCloseTime(),
+		ShardCount:                        progress.ShardCount,
+		ShardsCompleted:                   progress.ShardsCompleted,
+		ExecutionsRebuilt:                 progress.ExecutionsRebuilt,
+		ClosedExecutionsRebuilt:           progress.ClosedExecutionsRebuilt,
+		ExecutionsSkipped:                 progress.ExecutionsSkipped,
+		RelocatableAttributesMissingCount: progress.RelocatableAttributesMissingCount,
+	}, nil
+}
+
+func (adh *AdminHandler) CancelVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityRebuildRequest,
+) (*adminservice.CancelVisibilityRebuildResponse, error) {
+	if !strings.HasPrefix(request.GetJobId(), visibilityRebuildWorkflowIDPrefix) {
+		return nil, errInvalidVisibilityRebuildJobID
+	}
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, request.GetJobId(), "")
+	if err != nil {
+		return nil, err
+	}
+	if execution.GetWorkflowExecutionInfo().GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return &adminservice.CancelVisibilityRebuildResponse{Canceled: false}, nil
+	}
+	err = client.TerminateWorkflow(ctx, request.GetJobId(), "", request.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CancelVisibilityRebuildResponse{Canceled: true}, nil
+}
+
+// SaveVisibilityView creates or updates a saved visibility query of a namespace, and replicates it to the other
+// clusters of a global namespace. Visibility APIs resolve the new query once the namespace registry of the frontend is
+// refreshed.
+func (adh *AdminHandler) SaveVisibilityView(
+	ctx context.Context,
+	request *adminservice.SaveVisibilityViewRequest,
+) (_ *adminservice.SaveVisibilityViewResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if !visibilityViewNameRegexp.MatchString(request.GetName()) {
+		return nil, errInvalidVisibilityViewName
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	saTypeMap, err := adh.saProvider.GetSearchAttributes(adh.visibilityMgr.GetIndexName(), false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(errUnableToGetSearchAttributesMessage, err)
+	}
+	saMapper, err := adh.saMapperProvider.GetMapper(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateVisibilityViewQuery(namespaceName, request.GetQuery(), saTypeMap, saMapper); err != nil {
+		return nil, err
+	}
+
+	view, err := adh.namespaceHandler.SaveVisibilityView(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.SaveVisibilityViewResponse{View: view}, nil
+}
+
+func (adh *AdminHandler) DescribeVisibilityView(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityViewRequest,
+) (_ *adminservice.DescribeVisibilityViewResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	getNamespaceResponse, err := adh.persistenceMetadataManager.GetNamespace(
+		ctx,
+		&persistence.GetNamespaceRequest{Name: request.GetNamespace()},
+	)
+	if err != nil {
+		return nil, err
+	}
+	view, ok := getNamespaceResponse.Namespace.Config.VisibilityViews[request.GetName()]
+	if !ok {
+		return nil, serviceerror.NewNotFoundf("Visibility view %s not found.", request.GetName())
+	}
+	return &adminservice.DescribeVisibilityViewResponse{View: view}, nil
+}
+
+func (adh *AdminHandler) ListVisibilityViews(
+	ctx context.Context,
+	request *adminservice.ListVisibilityViewsRequest,
+) (_ *adminservice.ListVisibilityViewsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	getNamespaceResponse, err := adh.persistenceMetadataManager.GetNamespace(
+		ctx,
+		&persistence.GetNamespaceRequest{Name: request.GetNamespace()},
+	)
+	if err != nil {
+		return nil, err
+	}
+	views := slices.SortedFunc(
+		maps.Values(getNamespaceResponse.Namespace.Config.VisibilityViews),
+		func(a, b *persistencespb.VisibilityView) int {
+			return strings.Compare(a.GetName(), b.GetName())
+		},
+	)
+	return &adminservice.ListVisibilityViewsResponse{Views: views}, nil
+}
+
+func (adh *AdminHandler) DeleteVisibilityView(
+	ctx context.Context,
+	request *adminservice.DeleteVisibilityViewRequest,
+) (_ *adminservice.DeleteVisibilityViewResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if err := adh.namespaceHandler.DeleteVisibilityView(ctx, request.GetNamespace(), request.GetName()); err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteVisibilityViewResponse{}, nil
+}
+
+// WatchWorkflowExecutions streams the changes to the visibility records of the executions that match a query, as
+// history hosts process visibility tasks. The changes of all history hosts are merged in the order they are received.
+func (adh *AdminHandler) WatchWorkflowExecutions(
+	request *adminservice.WatchWorkflowExecutionsRequest,
+	server adminservice.AdminService_WatchWorkflowExecutionsServer,
+) (retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return errNamespaceNotSet
+	}
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespace(namespaceName)
+	if err != nil {
+		return err
+	}
+
+	saTypeMap, err := adh.saProvider.GetSearchAttributes(adh.visibilityMgr.GetIndexName(), false)
+	if err != nil {
+		return serviceerror.NewUnavailablef(errUnableToGetSearchAttributesMessage, err)
+	}
+	saMapper, err := adh.saMapperProvider.GetMapper(namespaceName)
+	if err != nil {
+		return err
+	}
+	queryString, err := resolveVisibilityViewQuery(adh.namespaceRegistry, namespaceName, request.GetQuery())
+	if err != nil {
+		return err
+	}
+	queryParams, err := query.NewPredicateQueryConverter(namespaceName, saTypeMap, saMapper).Convert(queryString)
+	if err != nil {
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return converterErr.ToInvalidArgument()
+		}
+		return err
+	}
+	if len(queryParams.OrderBy) > 0 || len(queryParams.GroupBy) > 0 {
+		return errVisibilityWatchQueryClauseNotAllowed
+	}
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return err
+	}
+
+	if !adh.visibilityWatchStreams.tryAcquire(
+		namespaceEntry.ID(),
+		adh.config.VisibilityWatchMaxStreamsPerNamespace(namespaceName.String()),
+	) {
+		return serviceerror.NewResourceExhausted(
+			enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+			"Too many WatchWorkflowExecutions streams for the namespace.",
+		)
+	}
+	defer adh.visibilityWatchStreams.release(namespaceEntry.ID())
+
+	return newVisibilityWatcher(
+		namespaceEntry,
+		queryParams.QueryExpr,
+		saTypeMap,
+		adh.saMapperProvider,
+		adh.historyClient,
+		resolver,
+		server,
+		adh.logger,
+	).run(server.Context(), request.GetResumeToken())
+}
+
+// AddTasks just translates the admin service's request proto into a history service request proto and then sends it.
+func (adh *AdminHandler) AddTasks(
+	ctx context.Context,
+	request *adminservice.AddTasksRequest,
+) (*adminservice.AddTasksResponse, error) {
+	historyTasks := make([]*historyservice.AddTasksRequest_Task, len(request.Tasks))
+	for i, task := range request.Tasks {
+		historyTasks[i] = &historyservice.AddTasksRequest_Task{
+			CategoryId: task.CategoryId,
+			Blob:       task.Blob,
+		}
+	}
+	historyServiceRequest := &historyservice.AddTasksRequest{
+		ShardId: request.ShardId,
+		Tasks:   historyTasks,
+	}
+	_, err := adh.historyClient.AddTasks(ctx, historyServiceRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.AddTasksResponse{}, nil
+}
+
+func (adh *AdminHandler) ListQueues(
+	ctx context.Context,
+	request *adminservice.ListQueuesRequest,
+) (*adminservice.ListQueuesResponse, error) {
+	historyServiceRequest := &historyservice.ListQueuesRequest{
+		QueueType:     request.QueueType,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	}
+	resp, err := adh.historyClient.ListQueues(ctx, historyServiceRequest)
+	if err != nil {
+		return nil, err
+	}
+	queues := make([]*adminservice.ListQueuesResponse_QueueInfo, len(resp.Queues))
 	for i, queue := range resp.Queues {
 		queues[i] = &adminservice.ListQueuesResponse_QueueInfo{
 			QueueName:     queue.QueueName,
@@ -2627,3 +3484,190 @@ func (adh *AdminHandler) migrateScheduleToWorkflow(
 	}
 	return &adminservice.MigrateScheduleResponse{}, nil
 }
+
+// GetDynamicConfigOverrides returns the dynamic config overrides stored in the cluster metadata.
+func (adh *AdminHandler) GetDynamicConfigOverrides(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigOverridesRequest,
+) (_ *adminservice.GetDynamicConfigOverridesResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	overrides, err := adh.dcOverrideManager.GetOverrides(ctx, request.GetKey())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetDynamicConfigOverridesResponse{Overrides: overrides}, nil
+}
+
+// SetDynamicConfigOverride creates or replaces a dynamic config override.
+func (adh *AdminHandler) SetDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigOverrideRequest,
+) (_ *adminservice.SetDynamicConfigOverrideResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := adh.dcOverrideManager.SetOverride(
+		ctx,
+		request.GetKey(),
+		request.GetConstraints(),
+		request.GetValue(),
+		request.GetIdentity(),
+		request.GetReason(),
+	); err != nil {
+		return nil, err
+	}
+	adh.logger.Info("Dynamic config override set",
+		tag.Key(request.GetKey()),
+		tag.NewStringTag("identity", request.GetIdentity()),
+		tag.NewStringTag("reason", request.GetReason()),
+	)
+	return &adminservice.SetDynamicConfigOverrideResponse{}, nil
+}
+
+// DeleteDynamicConfigOverride removes a dynamic config override.
+func (adh *AdminHandler) DeleteDynamicConfigOverride(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigOverrideRequest,
+) (_ *adminservice.DeleteDynamicConfigOverrideResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := adh.dcOverrideManager.DeleteOverride(
+		ctx,
+		request.GetKey(),
+		request.GetConstraints(),
+		request.GetIdentity(),
+		request.GetReason(),
+	); err != nil {
+		return nil, err
+	}
+	adh.logger.Info("Dynamic config override deleted",
+		tag.Key(request.GetKey()),
+		tag.NewStringTag("identity", request.GetIdentity()),
+		tag.NewStringTag("reason", request.GetReason()),
+	)
+	return &adminservice.DeleteDynamicConfigOverrideResponse{}, nil
+}
+
+// GetDynamicConfigHistory returns recent changes to dynamic config overrides, newest first.
+func (adh *AdminHandler) GetDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigHistoryRequest,
+) (_ *adminservice.GetDynamicConfigHistoryResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	changes, err := adh.dcOverrideManager.GetHistory(ctx, request.GetKey())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetDynamicConfigHistoryResponse{Changes: changes}, nil
+}
+
+// ExplainDynamicConfig returns how this frontend host and every history and matching host
+// resolve a dynamic config setting for a set of constraints.
+func (adh *AdminHandler) ExplainDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ExplainDynamicConfigRequest,
+) (_ *adminservice.ExplainDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	explanation, err := adh.dynamicConfig.Explain(
+		dynamicconfig.MakeKey(request.GetKey()),
+		dynamicconfig.ConstraintsFromProto(request.GetConstraints()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	hosts := []*dynamicconfigspb.HostExplanation{{
+		Service:     string(primitives.FrontendService),
+		Address:     adh.hostInfoProvider.HostInfo().GetAddress(),
+		Explanation: explanation.ToProto(request.GetRolloutKey(), time.Now()),
+	}}
+
+	historyHosts, err := adh.explainDynamicConfigOnHosts(ctx, primitives.HistoryService,
+		func(ctx context.Context, address string) (*dynamicconfigspb.Explanation, error) {
+			resp, err := adh.historyClient.ExplainDynamicConfig(ctx, &historyservice.ExplainDynamicConfigRequest{
+				HostAddress: address,
+				Key:         request.GetKey(),
+				Constraints: request.GetConstraints(),
+				RolloutKey:  request.GetRolloutKey(),
+			})
+			return resp.GetExplanation(), err
+		})
+	if err != nil {
+		return nil, err
+	}
+	matchingHosts, err := adh.explainDynamicConfigOnHosts(ctx, primitives.MatchingService,
+		func(ctx context.Context, address string) (*dynamicconfigspb.Explanation, error) {
+			resp, err := adh.matchingClient.ExplainDynamicConfig(ctx, &matchingservice.ExplainDynamicConfigRequest{
+				HostAddress: address,
+				Key:         request.GetKey(),
+				Constraints: request.GetConstraints(),
+				RolloutKey:  request.GetRolloutKey(),
+			})
+			return resp.GetExplanation(), err
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	hosts = append(hosts, historyHosts...)
+	hosts = append(hosts, matchingHosts...)
+	return &adminservice.ExplainDynamicConfigResponse{Hosts: hosts}, nil
+}
+
+// explainDynamicConfigOnHosts calls explain concurrently for every member of the service.
+// Errors from individual hosts are returned in the HostExplanation.
+func (adh *AdminHandler) explainDynamicConfigOnHosts(
+	ctx context.Context,
+	service primitives.ServiceName,
+	explain func(ctx context.Context, address string) (*dynamicconfigspb.Explanation, error),
+) ([]*dynamicconfigspb.HostExplanation, error) {
+	resolver, err := adh.membershipMonitor.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	members := resolver.Members()
+	hosts := make([]*dynamicconfigspb.HostExplanation, len(members))
+
+	var wg sync.WaitGroup
+	for i, member := range members {
+		hosts[i] = &dynamicconfigspb.HostExplanation{
+			Service: string(service),
+			Address: member.GetAddress(),
+		}
+		wg.Go(func() {
+			explanation, err := explain(ctx, member.GetAddress())
+			if err != nil {
+				hosts[i].Error = err.Error()
+				return
+			}
+			hosts[i].Explanation = explanation
+		})
+	}
+	wg.Wait()
+
+	slices.SortFunc(hosts, func(a, b *dynamicconfigspb.HostExplanation) int {
+		return strings.Compare(a.GetAddress(), b.GetAddress())
+	})
+	return hosts, nil
+}
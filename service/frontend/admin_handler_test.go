@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -21,15 +22,19 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
+	dynamicconfigspb "go.temporal.io/server/api/dynamicconfig/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/chasm"
 	chasmscheduler "go.temporal.io/server/chasm/lib/scheduler"
@@ -37,15 +42,18 @@ import (
 	chasmworkflow "go.temporal.io/server/chasm/lib/workflow"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/dcoverride"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/namespace/nsreplication"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
@@ -63,11 +71,15 @@ import (
 	"go.temporal.io/server/service/worker/dlq"
 	"go.temporal.io/server/service/worker/dummy"
 	legacyscheduler "go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/visibilitydrift"
+	"go.temporal.io/server/service/worker/visibilityrebuild"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -152,6 +164,8 @@ func (s *adminHandlerSuite) SetupTest() {
 		SearchAttributesTotalSizeLimit:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		VisibilityAllowList:                   dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		SuppressErrorSetSystemSearchAttribute: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		VisibilityWatchMaxStreamsPerNamespace: dynamicconfig.GetIntPropertyFnFilteredByNamespace(1),
+		MaxVisibilityViewsPerNamespace:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(1),
 	}
 
 	chasmRegistry := chasm.NewRegistry(s.mockResource.GetLogger())
@@ -188,6 +202,16 @@ func (s *adminHandlerSuite) SetupTest() {
 		chasmRegistry,
 		nsreplication.NewNoopDataMerger(),
 		nil, // schedulerClient - not needed for most admin handler tests
+		dcoverride.NewManager(
+			nil,
+			s.mockClusterMetadataManager,
+			clock.NewRealTimeSource(),
+			s.mockResource.GetLogger(),
+			dynamicconfig.GetDurationPropertyFn(time.Minute),
+		),
+		dynamicconfig.NewNoopCollection(),
+		s.mockResource.GetArchiverProvider(),
+		s.mockResource.GetArchivalMetadata(),
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
 	}
@@ -1736,6 +1760,530 @@ func (s *adminHandlerSuite) TestCancelDLQJob_InvalidJobToken() {
 	s.ErrorContains(err, "Invalid DLQ job token")
 }
 
+func (s *adminHandlerSuite) TestStartVisibilityDriftCheck() {
+	s.handler.visibilityMgr = visibility.NewVisibilityManagerDual(
+		s.mockVisibilityMgr,
+		manager.NewMockVisibilityManager(s.controller),
+		nil,
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		gomock.Any(),
+		visibilitydrift.WorkflowName,
+		visibilitydrift.WorkflowParams{
+			Namespace:     s.namespace.String(),
+			NamespaceID:   s.namespaceID.String(),
+			SampleRate:    0.1,
+			MaxExecutions: 1000,
+			Query:         "WorkflowType = 'foo'",
+			Repair:        true,
+			RPS:           50,
+		},
+	).DoAndReturn(func(_ context.Context, options sdkclient.StartWorkflowOptions, _ any, _ ...any) (sdkclient.WorkflowRun, error) {
+		s.True(strings.HasPrefix(options.ID, visibilityDriftWorkflowIDPrefix+s.namespace.String()))
+		s.Equal(primitives.DefaultWorkerTaskQueue, options.TaskQueue)
+		return mocksdk.NewMockWorkflowRun(s.controller), nil
+	})
+
+	response, err := s.handler.StartVisibilityDriftCheck(context.Background(), &adminservice.StartVisibilityDriftCheckRequest{
+		Namespace:     s.namespace.String(),
+		SampleRate:    0.1,
+		MaxExecutions: 1000,
+		Query:         "WorkflowType = 'foo'",
+		Repair:        true,
+		Rps:           50,
+	})
+	s.NoError(err)
+	s.True(strings.HasPrefix(response.GetJobId(), visibilityDriftWorkflowIDPrefix))
+}
+
+func (s *adminHandlerSuite) TestStartVisibilityDriftCheck_InvalidRequest() {
+	_, err := s.handler.StartVisibilityDriftCheck(context.Background(), &adminservice.StartVisibilityDriftCheckRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.ErrorIs(err, errSecondaryVisibilityNotConfigured)
+
+	_, err = s.handler.StartVisibilityDriftCheck(context.Background(), &adminservice.StartVisibilityDriftCheckRequest{})
+	s.ErrorIs(err, errNamespaceNotSet)
+
+	_, err = s.handler.StartVisibilityDriftCheck(context.Background(), &adminservice.StartVisibilityDriftCheckRequest{
+		Namespace:  s.namespace.String(),
+		SampleRate: 2,
+	})
+	s.ErrorIs(err, errVisibilityDriftSampleRateInvalid)
+}
+
+func (s *adminHandlerSuite) TestDescribeVisibilityDriftCheck() {
+	jobID := visibilityDriftWorkflowIDPrefix + "test"
+	startTime := timestamppb.New(time.Now())
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), jobID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			StartTime: startTime,
+		},
+	}, nil)
+	mockValue := mocksdk.NewMockEncodedValue(s.controller)
+	mockValue.EXPECT().Get(gomock.Any()).Do(func(result any) {
+		*(result.(*visibilitydrift.ProgressQueryResponse)) = visibilitydrift.ProgressQueryResponse{
+			Namespace: s.namespace.String(),
+			Repair:    true,
+			Progress: visibilitydrift.Progress{
+				ExecutionsScanned:   20,
+				ExecutionsCompared:  10,
+				MissingCount:        1,
+				MismatchCount:       1,
+				RepairedCount:       2,
+				FieldMismatchCounts: map[string]int64{visibilitydrift.FieldMemo: 1},
+				DriftedExecutions: []visibilitydrift.DriftRecord{
+					{WorkflowID: "wf1", RunID: "run1", Missing: true, Repaired: true},
+					{WorkflowID: "wf2", RunID: "run2", MismatchedFields: []string{visibilitydrift.FieldMemo}, Repaired: true},
+				},
+			},
+		}
+	})
+	mockSdkClient.EXPECT().QueryWorkflow(gomock.Any(), jobID, "", visibilitydrift.QueryTypeProgress).Return(mockValue, nil)
+
+	response, err := s.handler.DescribeVisibilityDriftCheck(context.Background(), &adminservice.DescribeVisibilityDriftCheckRequest{
+		JobId: jobID,
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.DescribeVisibilityDriftCheckResponse{
+		Namespace:           s.namespace.String(),
+		Status:              enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		StartTime:           startTime,
+		Repair:              true,
+		ExecutionsScanned:   20,
+		ExecutionsCompared:  10,
+		MissingCount:        1,
+		MismatchCount:       1,
+		RepairedCount:       2,
+		FieldMismatchCounts: map[string]int64{visibilitydrift.FieldMemo: 1},
+		DriftedExecutions: []*adminservice.VisibilityDriftRecord{
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf1", RunId: "run1"}, Missing: true, Repaired: true},
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf2", RunId: "run2"}, MismatchedFields: []string{visibilitydrift.FieldMemo}, Repaired: true},
+		},
+	}, response)
+}
+
+func (s *adminHandlerSuite) TestCancelVisibilityDriftCheck() {
+	jobID := visibilityDriftWorkflowIDPrefix + "test"
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), jobID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	}, nil)
+	mockSdkClient.EXPECT().TerminateWorkflow(gomock.Any(), jobID, "", "test-reason").Return(nil)
+
+	response, err := s.handler.CancelVisibilityDriftCheck(context.Background(), &adminservice.CancelVisibilityDriftCheckRequest{
+		JobId:  jobID,
+		Reason: "test-reason",
+	})
+	s.NoError(err)
+	s.True(response.GetCanceled())
+
+	// Other system workflows can't be described or canceled.
+	_, err = s.handler.CancelVisibilityDriftCheck(context.Background(), &adminservice.CancelVisibilityDriftCheckRequest{
+		JobId: "manage-dlq-tasks-1",
+	})
+	s.ErrorIs(err, errInvalidVisibilityDriftJobID)
+	_, err = s.handler.DescribeVisibilityDriftCheck(context.Background(), &adminservice.DescribeVisibilityDriftCheckRequest{
+		JobId: "manage-dlq-tasks-1",
+	})
+	s.ErrorIs(err, errInvalidVisibilityDriftJobID)
+}
+
+func (s *adminHandlerSuite) TestStartVisibilityRebuild() {
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		gomock.Any(),
+		visibilityrebuild.WorkflowName,
+		visibilityrebuild.WorkflowParams{
+			Namespace:       s.namespace.String(),
+			NamespaceID:     s.namespaceID.String(),
+			VisibilityStore: enumsspb.VISIBILITY_STORE_TARGET_PRIMARY,
+			ShardCount:      1,
+			RPS:             50,
+		},
+	).DoAndReturn(func(_ context.Context, options sdkclient.StartWorkflowOptions, _ any, _ ...any) (sdkclient.WorkflowRun, error) {
+		s.True(strings.HasPrefix(options.ID, visibilityRebuildWorkflowIDPrefix+s.namespace.String()))
+		s.Equal(primitives.DefaultWorkerTaskQueue, options.TaskQueue)
+		return mocksdk.NewMockWorkflowRun(s.controller), nil
+	})
+
+	response, err := s.handler.StartVisibilityRebuild(context.Background(), &adminservice.StartVisibilityRebuildRequest{
+		Namespace:       s.namespace.String(),
+		VisibilityStore: enumsspb.VISIBILITY_STORE_TARGET_PRIMARY,
+		Rps:             50,
+	})
+	s.NoError(err)
+	s.True(strings.HasPrefix(response.GetJobId(), visibilityRebuildWorkflowIDPrefix))
+}
+
+func (s *adminHandlerSuite) TestStartVisibilityRebuild_InvalidRequest() {
+	_, err := s.handler.StartVisibilityRebuild(context.Background(), &adminservice.StartVisibilityRebuildRequest{
+		Namespace:       s.namespace.String(),
+		VisibilityStore: enumsspb.VISIBILITY_STORE_TARGET_SECONDARY,
+	})
+	s.ErrorIs(err, errSecondaryVisibilityNotConfigured)
+
+	_, err = s.handler.StartVisibilityRebuild(context.Background(), &adminservice.StartVisibilityRebuildRequest{})
+	s.ErrorIs(err, errNamespaceNotSet)
+
+	_, err = s.handler.StartVisibilityRebuild(context.Background(), &adminservice.StartVisibilityRebuildRequest{
+		Namespace: s.namespace.String(),
+		Rps:       -1,
+	})
+	s.ErrorIs(err, errVisibilityRebuildNegativeRps)
+}
+
+func (s *adminHandlerSuite) TestDescribeVisibilityRebuild() {
+	jobID := visibilityRebuildWorkflowIDPrefix + "test"
+	startTime := timestamppb.New(time.Now())
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), jobID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			StartTime: startTime,
+		},
+	}, nil)
+	mockValue := mocksdk.NewMockEncodedValue(s.controller)
+	mockValue.EXPECT().Get(gomock.Any()).Do(func(result any) {
+		*(result.(*visibilityrebuild.ProgressQueryResponse)) = visibilityrebuild.ProgressQueryResponse{
+			Namespace:       s.namespace.String(),
+			VisibilityStore: enumsspb.VISIBILITY_STORE_TARGET_SECONDARY,
+			ShardCount:      4,
+			Progress: visibilityrebuild.Progress{
+				ShardsCompleted:                   2,
+				ExecutionsRebuilt:                 10,
+				ClosedExecutionsRebuilt:           6,
+				ExecutionsSkipped:                 1,
+				RelocatableAttributesMissingCount: 2,
+			},
+		}
+	})
+	mockSdkClient.EXPECT().QueryWorkflow(gomock.Any(), jobID, "", visibilityrebuild.QueryTypeProgress).Return(mockValue, nil)
+
+	response, err := s.handler.DescribeVisibilityRebuild(context.Background(), &adminservice.DescribeVisibilityRebuildRequest{
+		JobId: jobID,
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.DescribeVisibilityRebuildResponse{
+		Namespace:                         s.namespace.String(),
+		VisibilityStore:                   enumsspb.VISIBILITY_STORE_TARGET_SECONDARY,
+		Status:                            enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		StartTime:                         startTime,
+		ShardCount:                        4,
+		ShardsCompleted:                   2,
+		ExecutionsRebuilt:                 10,
+		ClosedExecutionsRebuilt:           6,
+		ExecutionsSkipped:                 1,
+		RelocatableAttributesMissingCount: 2,
+	}, response)
+}
+
+func (s *adminHandlerSuite) TestCancelVisibilityRebuild() {
+	jobID := visibilityRebuildWorkflowIDPrefix + "test"
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), jobID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}, nil)
+
+	response, err := s.handler.CancelVisibilityRebuild(context.Background(), &adminservice.CancelVisibilityRebuildRequest{
+		JobId: jobID,
+	})
+	s.NoError(err)
+	s.False(response.GetCanceled())
+
+	_, err = s.handler.CancelVisibilityRebuild(context.Background(), &adminservice.CancelVisibilityRebuildRequest{
+		JobId: visibilityDriftWorkflowIDPrefix + "test",
+	})
+	s.ErrorIs(err, errInvalidVisibilityRebuildJobID)
+}
+
+func (s *adminHandlerSuite) TestWatchWorkflowExecutions_InvalidRequest() {
+	server := adminservicemock.NewMockAdminService_WatchWorkflowExecutionsServer(s.controller)
+
+	err := s.handler.WatchWorkflowExecutions(&adminservice.WatchWorkflowExecutionsRequest{}, server)
+	s.ErrorIs(err, errNamespaceNotSet)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("").AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).
+		Return(searchattribute.TestNameTypeMap(), nil).AnyTimes()
+
+	err = s.handler.WatchWorkflowExecutions(&adminservice.WatchWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+		Query:     "WorkflowId = 'wf' ORDER BY StartTime DESC",
+	}, server)
+	s.ErrorIs(err, errVisibilityWatchQueryClauseNotAllowed)
+
+	err = s.handler.WatchWorkflowExecutions(&adminservice.WatchWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+		Query:     "WorkflowId =",
+	}, server)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestWatchWorkflowExecutions_TooManyStreams() {
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("")
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).
+		Return(searchattribute.TestNameTypeMap(), nil)
+	s.True(s.handler.visibilityWatchStreams.tryAcquire(s.namespaceID, 1))
+
+	err := s.handler.WatchWorkflowExecutions(&adminservice.WatchWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+	}, adminservicemock.NewMockAdminService_WatchWorkflowExecutionsServer(s.controller))
+	var resourceExhausted *serviceerror.ResourceExhausted
+	s.ErrorAs(err, &resourceExhausted)
+	s.Equal(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, resourceExhausted.Cause)
+}
+
+func (s *adminHandlerSuite) expectGetNamespaceWithVisibilityViews(views map[string]*persistencespb.VisibilityView) {
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: s.namespace.String(),
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:              &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+			Config:            &persistencespb.NamespaceConfig{VisibilityViews: views},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+			ConfigVersion:     3,
+		},
+	}, nil)
+}
+
+func (s *adminHandlerSuite) TestSaveVisibilityView_Create() {
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("")
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).
+		Return(searchattribute.TestNameTypeMap(), nil)
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).
+		Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	s.expectGetNamespaceWithVisibilityViews(nil)
+
+	var updateRequest *persistence.UpdateNamespaceRequest
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			updateRequest = request
+			return nil
+		})
+
+	resp, err := s.handler.SaveVisibilityView(context.Background(), &adminservice.SaveVisibilityViewRequest{
+		Namespace:   s.namespace.String(),
+		Name:        "stuck-orders",
+		Query:       "WorkflowType = 'order' AND ExecutionStatus = 'Running'",
+		Description: "Orders that are still running",
+		Owner:       "payments",
+	})
+	s.NoError(err)
+	s.Equal("stuck-orders", resp.View.GetName())
+	s.Equal(int64(1), resp.View.GetVersion())
+	s.NotNil(resp.View.GetCreateTime())
+	s.Equal(resp.View.GetCreateTime().AsTime(), resp.View.GetUpdateTime().AsTime())
+
+	s.Equal(int64(4), updateRequest.Namespace.ConfigVersion)
+	s.Equal(int64(7), updateRequest.NotificationVersion)
+	s.ProtoEqual(resp.View, updateRequest.Namespace.Config.VisibilityViews["stuck-orders"])
+}
+
+func (s *adminHandlerSuite) TestSaveVisibilityView_Update() {
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("")
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).
+		Return(searchattribute.TestNameTypeMap(), nil)
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).
+		Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	createTime := timestamppb.New(time.Unix(1000, 0))
+	s.expectGetNamespaceWithVisibilityViews(map[string]*persistencespb.VisibilityView{
+		"stuck-orders": {Name: "stuck-orders", Query: "WorkflowType = 'order'", Version: 2, CreateTime: createTime},
+	})
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Return(nil)
+
+	resp, err := s.handler.SaveVisibilityView(context.Background(), &adminservice.SaveVisibilityViewRequest{
+		Namespace:       s.namespace.String(),
+		Name:            "stuck-orders",
+		Query:           "WorkflowType = 'order' AND ExecutionStatus = 'Running'",
+		ExpectedVersion: 2,
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.View.GetVersion())
+	s.Equal("WorkflowType = 'order' AND ExecutionStatus = 'Running'", resp.View.GetQuery())
+	s.ProtoEqual(createTime, resp.View.GetCreateTime())
+}
+
+func (s *adminHandlerSuite) TestSaveVisibilityView_VersionMismatch() {
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("")
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).
+		Return(searchattribute.TestNameTypeMap(), nil)
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).
+		Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	s.expectGetNamespaceWithVisibilityViews(map[string]*persistencespb.VisibilityView{
+		"stuck-orders": {Name: "stuck-orders", Query: "WorkflowType = 'order'", Version: 3},
+	})
+
+	_, err := s.handler.SaveVisibilityView(context.Background(), &adminservice.SaveVisibilityViewRequest{
+		Namespace:       s.namespace.String(),
+		Name:            "stuck-orders",
+		Query:           "WorkflowType = 'order'",
+		ExpectedVersion: 2,
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+}
+
+func (s *adminHandlerSuite) TestSaveVisibilityView_LimitExceeded() {
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("")
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).
+		Return(searchattribute.TestNameTypeMap(), nil)
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).
+		Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	s.expectGetNamespaceWithVisibilityViews(map[string]*persistencespb.VisibilityView{
+		"stuck-orders": {Name: "stuck-orders", Query: "WorkflowType = 'order'", Version: 1},
+	})
+
+	_, err := s.handler.SaveVisibilityView(context.Background(), &adminservice.SaveVisibilityViewRequest{
+		Namespace: s.namespace.String(),
+		Name:      "failed-orders",
+		Query:     "WorkflowType = 'order' AND ExecutionStatus = 'Failed'",
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestSaveVisibilityView_GlobalNamespace() {
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("")
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).
+		Return(searchattribute.TestNameTypeMap(), nil)
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).
+		Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:   &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: "active",
+				Clusters:          []string{"active", "standby"},
+			},
+			ConfigVersion:   3,
+			FailoverVersion: 11,
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Return(nil)
+
+	var replicationTask *replicationspb.ReplicationTask
+	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, task *replicationspb.ReplicationTask) error {
+			replicationTask = task
+			return nil
+		})
+
+	resp, err := s.handler.SaveVisibilityView(context.Background(), &adminservice.SaveVisibilityViewRequest{
+		Namespace: s.namespace.String(),
+		Name:      "stuck-orders",
+		Query:     "WorkflowType = 'order'",
+	})
+	s.NoError(err)
+
+	attributes := replicationTask.GetNamespaceTaskAttributes()
+	s.Equal(enumsspb.NAMESPACE_OPERATION_UPDATE, attributes.GetNamespaceOperation())
+	s.Equal(int64(4), attributes.GetConfigVersion())
+	s.Equal(int64(11), attributes.GetFailoverVersion())
+	s.ProtoEqual(resp.View, attributes.GetVisibilityViews()["stuck-orders"])
+}
+
+func (s *adminHandlerSuite) TestSaveVisibilityView_InvalidRequest() {
+	_, err := s.handler.SaveVisibilityView(context.Background(), &adminservice.SaveVisibilityViewRequest{
+		Namespace: s.namespace.String(),
+		Name:      "stuck orders",
+		Query:     "WorkflowType = 'order'",
+	})
+	s.ErrorIs(err, errInvalidVisibilityViewName)
+
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("")
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).
+		Return(searchattribute.TestNameTypeMap(), nil)
+	_, err = s.handler.SaveVisibilityView(context.Background(), &adminservice.SaveVisibilityViewRequest{
+		Namespace: s.namespace.String(),
+		Name:      "stuck-orders",
+		Query:     "WorkflowType = 'order' ORDER BY StartTime",
+	})
+	s.ErrorIs(err, errVisibilityViewQueryClauseNotAllowed)
+}
+
+func (s *adminHandlerSuite) TestDescribeVisibilityView() {
+	view := &persistencespb.VisibilityView{Name: "stuck-orders", Query: "WorkflowType = 'order'", Version: 1}
+	s.expectGetNamespaceWithVisibilityViews(map[string]*persistencespb.VisibilityView{"stuck-orders": view})
+	resp, err := s.handler.DescribeVisibilityView(context.Background(), &adminservice.DescribeVisibilityViewRequest{
+		Namespace: s.namespace.String(),
+		Name:      "stuck-orders",
+	})
+	s.NoError(err)
+	s.ProtoEqual(view, resp.View)
+
+	s.expectGetNamespaceWithVisibilityViews(nil)
+	_, err = s.handler.DescribeVisibilityView(context.Background(), &adminservice.DescribeVisibilityViewRequest{
+		Namespace: s.namespace.String(),
+		Name:      "stuck-orders",
+	})
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+}
+
+func (s *adminHandlerSuite) TestListVisibilityViews() {
+	s.expectGetNamespaceWithVisibilityViews(map[string]*persistencespb.VisibilityView{
+		"stuck-orders":  {Name: "stuck-orders", Query: "WorkflowType = 'order'"},
+		"failed-orders": {Name: "failed-orders", Query: "ExecutionStatus = 'Failed'"},
+	})
+	resp, err := s.handler.ListVisibilityViews(context.Background(), &adminservice.ListVisibilityViewsRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.Len(resp.Views, 2)
+	s.Equal("failed-orders", resp.Views[0].GetName())
+	s.Equal("stuck-orders", resp.Views[1].GetName())
+}
+
+func (s *adminHandlerSuite) TestDeleteVisibilityView() {
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).
+		Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).Times(2)
+	s.expectGetNamespaceWithVisibilityViews(map[string]*persistencespb.VisibilityView{
+		"stuck-orders": {Name: "stuck-orders", Query: "WorkflowType = 'order'"},
+	})
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Empty(request.Namespace.Config.VisibilityViews)
+			return nil
+		})
+	_, err := s.handler.DeleteVisibilityView(context.Background(), &adminservice.DeleteVisibilityViewRequest{
+		Namespace: s.namespace.String(),
+		Name:      "stuck-orders",
+	})
+	s.NoError(err)
+
+	s.expectGetNamespaceWithVisibilityViews(nil)
+	_, err = s.handler.DeleteVisibilityView(context.Background(), &adminservice.DeleteVisibilityViewRequest{
+		Namespace: s.namespace.String(),
+		Name:      "stuck-orders",
+	})
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+}
+
 func (s *adminHandlerSuite) TestAddDLQTasks_Ok() {
 	s.mockHistoryClient.EXPECT().AddTasks(gomock.Any(), &historyservice.AddTasksRequest{
 		ShardId: 13,
@@ -2120,6 +2668,154 @@ func (s *adminHandlerSuite) TestImportWorkflowExecution_WithAliasedSearchAttribu
 	}
 }
 
+func (s *adminHandlerSuite) expectGetArchivedHistory(retention time.Duration, closeTime time.Time) *commonpb.WorkflowExecution {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.NewString()}
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.namespace.String(), Id: s.namespaceID.String()},
+		&persistencespb.NamespaceConfig{
+			Retention:            durationpb.New(retention),
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:   "file:///tmp/history",
+		},
+		"",
+	), nil).Times(2)
+	s.mockResource.ArchivalMetadata.SetHistoryEnabledByDefault()
+
+	newEvent := func(eventID int64, eventType enumspb.EventType) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventId:   eventID,
+			EventType: eventType,
+			Version:   100,
+			EventTime: timestamppb.New(closeTime.Add(time.Duration(eventID-5) * time.Second)),
+		}
+	}
+	mockHistoryArchiver := archiver.NewMockHistoryArchiver(s.controller)
+	s.mockResource.ArchiverProvider.EXPECT().GetHistoryArchiver("file").Return(mockHistoryArchiver, nil)
+	mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+			s.Equal(s.namespaceID.String(), request.NamespaceID)
+			s.Equal(execution.GetRunId(), request.RunID)
+			if request.NextPageToken == nil {
+				return &archiver.GetHistoryResponse{
+					HistoryBatches: []*historypb.History{
+						{Events: []*historypb.HistoryEvent{
+							newEvent(1, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED),
+							newEvent(2, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED),
+						}},
+					},
+					NextPageToken: []byte("next"),
+				}, nil
+			}
+			return &archiver.GetHistoryResponse{
+				HistoryBatches: []*historypb.History{
+					{Events: []*historypb.HistoryEvent{newEvent(3, enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED)}},
+					{Events: []*historypb.HistoryEvent{
+						newEvent(4, enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED),
+						newEvent(5, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED),
+					}},
+				},
+			}, nil
+		},
+	).Times(2)
+	return execution
+}
+
+func (s *adminHandlerSuite) expectImportArchivedHistory(execution *commonpb.WorkflowExecution) {
+	gomock.InOrder(
+		s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *historyservice.ImportWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.ImportWorkflowExecutionResponse, error) {
+				s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+				s.ProtoEqual(execution, request.GetExecution())
+				s.Len(request.GetHistoryBatches(), 3)
+				s.Empty(request.GetToken())
+				s.ProtoEqual(&historyspb.VersionHistory{
+					Items: []*historyspb.VersionHistoryItem{{EventId: 5, Version: 100}},
+				}, request.GetVersionHistory())
+				return &historyservice.ImportWorkflowExecutionResponse{Token: []byte("token")}, nil
+			},
+		),
+		s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *historyservice.ImportWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.ImportWorkflowExecutionResponse, error) {
+				s.Empty(request.GetHistoryBatches())
+				s.Equal([]byte("token"), request.GetToken())
+				return &historyservice.ImportWorkflowExecutionResponse{}, nil
+			},
+		),
+	)
+}
+
+func (s *adminHandlerSuite) TestRestoreArchivedWorkflowExecution() {
+	execution := s.expectGetArchivedHistory(24*time.Hour, time.Now().Add(-time.Hour))
+	s.expectImportArchivedHistory(execution)
+
+	resp, err := s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.NoError(err)
+	s.Empty(resp.GetResetRunId())
+}
+
+func (s *adminHandlerSuite) TestRestoreArchivedWorkflowExecution_Reset() {
+	execution := s.expectGetArchivedHistory(24*time.Hour, time.Now().Add(-48*time.Hour))
+	s.expectImportArchivedHistory(execution)
+	resetRunID := uuid.NewString()
+	s.mockHistoryClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ResetWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.ResetWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.ProtoEqual(execution, request.GetResetRequest().GetWorkflowExecution())
+			s.Equal(int64(4), request.GetResetRequest().GetWorkflowTaskFinishEventId())
+			s.Equal("debugging", request.GetResetRequest().GetReason())
+			s.Equal("request-id", request.GetResetRequest().GetRequestId())
+			return &historyservice.ResetWorkflowExecutionResponse{RunId: resetRunID}, nil
+		},
+	)
+
+	resp, err := s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace:    s.namespace.String(),
+		Execution:    execution,
+		ResetEventId: 4,
+		ResetReason:  "debugging",
+		RequestId:    "request-id",
+	})
+	s.NoError(err)
+	s.Equal(resetRunID, resp.GetResetRunId())
+}
+
+func (s *adminHandlerSuite) TestRestoreArchivedWorkflowExecution_PastRetention() {
+	execution := s.expectGetArchivedHistory(24*time.Hour, time.Now().Add(-48*time.Hour))
+
+	_, err := s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.ErrorIs(err, errRestoredWorkflowExecutionPastRetention)
+}
+
+func (s *adminHandlerSuite) TestRestoreArchivedWorkflowExecution_InvalidRequest() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.NewString()}
+
+	_, err := s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow-id"},
+	})
+	s.ErrorIs(err, errInvalidRunID)
+
+	_, err = s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace:    s.namespace.String(),
+		Execution:    execution,
+		ResetEventId: -1,
+	})
+	s.ErrorIs(err, errInvalidResetEventID)
+
+	// History archival is disabled by default.
+	_, err = s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.ErrorIs(err, errClusterIsNotConfiguredForReadingArchivalHistory)
+}
+
 func (s *adminHandlerSuite) TestImportWorkflowExecution_WithNonAliasedSearchAttributes() {
 	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
 
@@ -2555,3 +3251,125 @@ func (s *adminHandlerSuite) TestGetTaskQueueUserData() {
 		s.Nil(resp)
 	})
 }
+
+func (s *adminHandlerSuite) TestSetDynamicConfigOverride() {
+	ctx := context.Background()
+	constraints := &persistencespb.DynamicConfigConstraints{Namespace: s.namespace.String()}
+
+	s.Run("invalid value", func() {
+		_, err := s.handler.SetDynamicConfigOverride(ctx, &adminservice.SetDynamicConfigOverrideRequest{
+			Key:         dynamicconfig.FrontendRPS.Key().String(),
+			Constraints: constraints,
+			Value:       "not a number",
+		})
+		var invalidArgErr *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgErr)
+	})
+
+	s.Run("constraint not used by key", func() {
+		_, err := s.handler.SetDynamicConfigOverride(ctx, &adminservice.SetDynamicConfigOverrideRequest{
+			Key:         dynamicconfig.FrontendRPS.Key().String(),
+			Constraints: &persistencespb.DynamicConfigConstraints{TaskQueueName: "my-queue"},
+			Value:       "100",
+		})
+		var invalidArgErr *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgErr)
+	})
+
+	s.Run("set", func() {
+		s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+			ClusterMetadata: &persistencespb.ClusterMetadata{ClusterName: "active"},
+			Version:         3,
+		}, nil)
+		s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+				s.Equal(int64(3), request.Version)
+				s.Len(request.ClusterMetadata.DynamicConfigOverrides, 1)
+				override := request.ClusterMetadata.DynamicConfigOverrides[0]
+				s.Equal(strings.ToLower(dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key().String()), override.Key)
+				s.Equal("100", override.Value)
+				s.Equal("tester", override.Author)
+				s.Equal("incident", override.Reason)
+				s.ProtoEqual(constraints, override.Constraints)
+				s.Len(request.ClusterMetadata.DynamicConfigHistory, 1)
+				return true, nil
+			})
+		_, err := s.handler.SetDynamicConfigOverride(ctx, &adminservice.SetDynamicConfigOverrideRequest{
+			Key:         dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key().String(),
+			Constraints: constraints,
+			Value:       "100",
+			Identity:    "tester",
+			Reason:      "incident",
+		})
+		s.NoError(err)
+	})
+}
+
+func (s *adminHandlerSuite) TestDeleteDynamicConfigOverride_NotFound() {
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: &persistencespb.ClusterMetadata{ClusterName: "active"},
+		Version:         3,
+	}, nil)
+
+	_, err := s.handler.DeleteDynamicConfigOverride(context.Background(), &adminservice.DeleteDynamicConfigOverrideRequest{
+		Key:      dynamicconfig.FrontendRPS.Key().String(),
+		Identity: "tester",
+		Reason:   "incident",
+	})
+	var notFoundErr *serviceerror.NotFound
+	s.ErrorAs(err, &notFoundErr)
+}
+
+func (s *adminHandlerSuite) TestExplainDynamicConfig() {
+	client := dynamicconfig.NewMemoryClient()
+	s.handler.dynamicConfig = dynamicconfig.NewCollection(client, s.mockResource.GetLogger())
+	key := dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key()
+	client.OverrideValue(key, []dynamicconfig.ConstrainedValue{
+		{Constraints: dynamicconfig.Constraints{Namespace: s.namespace.String()}, Value: 100},
+	})
+	constraints := &dynamicconfigspb.Constraints{Namespace: s.namespace.String()}
+
+	s.mockResource.HostInfoProvider.EXPECT().HostInfo().Return(membership.NewHostInfoFromAddress("frontend:1"))
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("history:2"),
+		membership.NewHostInfoFromAddress("history:1"),
+	})
+	s.mockResource.MatchingServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("matching:1"),
+	})
+	s.mockHistoryClient.EXPECT().ExplainDynamicConfig(gomock.Any(), &historyservice.ExplainDynamicConfigRequest{
+		HostAddress: "history:1",
+		Key:         key.String(),
+		Constraints: constraints,
+	}).Return(&historyservice.ExplainDynamicConfigResponse{
+		Explanation: &dynamicconfigspb.Explanation{EffectiveValue: "100"},
+	}, nil)
+	s.mockHistoryClient.EXPECT().ExplainDynamicConfig(gomock.Any(), &historyservice.ExplainDynamicConfigRequest{
+		HostAddress: "history:2",
+		Key:         key.String(),
+		Constraints: constraints,
+	}).Return(nil, errors.New("unavailable"))
+	s.mockMatchingClient.EXPECT().ExplainDynamicConfig(gomock.Any(), gomock.Any()).Return(&matchingservice.ExplainDynamicConfigResponse{
+		Explanation: &dynamicconfigspb.Explanation{EffectiveValue: "100"},
+	}, nil)
+
+	resp, err := s.handler.ExplainDynamicConfig(context.Background(), &adminservice.ExplainDynamicConfigRequest{
+		Key:         key.String(),
+		Constraints: constraints,
+	})
+	s.NoError(err)
+	hosts := resp.GetHosts()
+	s.Len(hosts, 4)
+	s.Equal("frontend:1", hosts[0].GetAddress())
+	s.Equal("100", hosts[0].GetExplanation().GetEffectiveValue())
+	s.Equal(constraints.GetNamespace(), hosts[0].GetExplanation().GetMatchedValue().GetConstraints().GetNamespace())
+	s.Equal("history:1", hosts[1].GetAddress())
+	s.Equal("100", hosts[1].GetExplanation().GetEffectiveValue())
+	s.Equal("history:2", hosts[2].GetAddress())
+	s.Equal("unavailable", hosts[2].GetError())
+	s.Equal(string(primitives.MatchingService), hosts[3].GetService())
+
+	_, err = s.handler.ExplainDynamicConfig(context.Background(), &adminservice.ExplainDynamicConfigRequest{Key: "unknown.key"})
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
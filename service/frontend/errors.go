@@ -35,6 +35,7 @@ var (
 	errClusterIsNotConfiguredForVisibilityArchival        = serviceerror.NewInvalidArgument("Cluster is not configured for visibility archival.")
 	errClusterIsNotConfiguredForReadingArchivalVisibility = serviceerror.NewInvalidArgument("Cluster is not configured for reading archived visibility records.")
 	errNamespaceIsNotConfiguredForVisibilityArchival      = serviceerror.NewInvalidArgument("Namespace is not configured for visibility archival.")
+	errClusterIsNotConfiguredForReadingArchivalHistory    = serviceerror.NewInvalidArgument("Cluster is not configured for reading archived history.")
 	errSearchAttributesNotSet                             = serviceerror.NewInvalidArgument("SearchAttributes are not set on request.")
 	errInvalidPageSize                                    = serviceerror.NewInvalidArgument("Invalid PageSize.")          // DEPRECATED
 	errInvalidEventQueryRange                             = serviceerror.NewInvalidArgument("Invalid event query range.") // DEPRECATED
@@ -76,6 +77,22 @@ var (
 	errTargetClusterNotSet = serviceerror.NewInvalidArgument("TargetCluster is not set on request.")
 	errInvalidDLQJobToken  = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
 
+	errInvalidVisibilityDriftJobID       = serviceerror.NewInvalidArgument("Invalid visibility drift job ID.")
+	errSecondaryVisibilityNotConfigured  = serviceerror.NewFailedPrecondition("Secondary visibility store is not configured.")
+	errVisibilityDriftSampleRateInvalid  = serviceerror.NewInvalidArgument("SampleRate must be between 0 and 1.")
+	errVisibilityDriftNegativeParameters = serviceerror.NewInvalidArgument("MaxExecutions and Rps must not be negative.")
+	errInvalidVisibilityRebuildJobID     = serviceerror.NewInvalidArgument("Invalid visibility rebuild job ID.")
+	errVisibilityRebuildNegativeRps      = serviceerror.NewInvalidArgument("Rps must not be negative.")
+
+	errInvalidVisibilityWatchResumeToken    = serviceerror.NewInvalidArgument("Invalid visibility watch resume token.")
+	errVisibilityWatchQueryClauseNotAllowed = serviceerror.NewInvalidArgument("ORDER BY and GROUP BY are not supported by WatchWorkflowExecutions.")
+	errInvalidVisibilityViewName            = serviceerror.NewInvalidArgument("Visibility view name must have 1 to 255 letters, digits, '_', '-' or '.'.")
+	errVisibilityViewQueryNotSet            = serviceerror.NewInvalidArgument("Visibility view query is not set on request.")
+	errVisibilityViewQueryClauseNotAllowed  = serviceerror.NewInvalidArgument("Visibility view query must not have ORDER BY or GROUP BY.")
+
+	errInvalidResetEventID                    = serviceerror.NewInvalidArgument("ResetEventId must not be negative.")
+	errRestoredWorkflowExecutionPastRetention = serviceerror.NewFailedPrecondition("Restored workflow execution would be deleted right away because it closed more than the retention of the target namespace ago. Reset it to an event, or restore it into a namespace with a longer retention.")
+
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
@@ -24,6 +24,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/dcoverride"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -708,6 +709,7 @@ func VisibilityManagerProvider(
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
 		serviceConfig.VisibilityEnableUnifiedQueryConverter,
+		serviceConfig.VisibilityCountGroupByMaxGroups,
 		metricsHandler,
 		logger,
 		serializer,
@@ -764,6 +766,10 @@ func AdminHandlerProvider(
 	chasmRegistry *chasm.Registry,
 	namespaceDataMerger nsreplication.NamespaceDataMerger,
 	schedulerClient schedulerpb.SchedulerServiceClient,
+	dcOverrideManager *dcoverride.Manager,
+	dc *dynamicconfig.Collection,
+	archiverProvider provider.ArchiverProvider,
+	archivalMetadata archiver.ArchivalMetadata,
 	namespaceDLQHandler nsreplication.DLQMessageHandler,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
@@ -796,6 +802,10 @@ func AdminHandlerProvider(
 		chasmRegistry,
 		namespaceDataMerger,
 		schedulerClient,
+		dcOverrideManager,
+		dc,
+		archiverProvider,
+		archivalMetadata,
 		taskCategoryRegistry,
 		matchingClient,
 	}
@@ -15,6 +15,7 @@ import (
 	rulespb "go.temporal.io/api/rules/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
@@ -880,6 +881,119 @@ func (d *namespaceHandler) ListWorkflowRules(
 	return workflowRules, nil
 }
 
+// SaveVisibilityView creates or updates a saved visibility query of a namespace. The query must already be validated.
+func (d *namespaceHandler) SaveVisibilityView(
+	ctx context.Context,
+	request *adminservice.SaveVisibilityViewRequest,
+) (*persistencespb.VisibilityView, error) {
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	getNamespaceResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: request.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	config := getNamespaceResponse.Namespace.Config
+
+	now := timestamppb.New(d.timeSource.Now())
+	view, ok := config.VisibilityViews[request.GetName()]
+	if request.GetExpectedVersion() != 0 && view.GetVersion() != request.GetExpectedVersion() {
+		return nil, serviceerror.NewFailedPreconditionf(
+			"Visibility view %s is at version %d, not %d.",
+			request.GetName(),
+			view.GetVersion(),
+			request.GetExpectedVersion(),
+		)
+	}
+	if !ok {
+		maxViews := d.config.MaxVisibilityViewsPerNamespace(request.GetNamespace())
+		if len(config.VisibilityViews) >= maxViews {
+			return nil, serviceerror.NewInvalidArgumentf("Visibility view limit exceeded. Max: %v", maxViews)
+		}
+		view = &persistencespb.VisibilityView{
+			Name:       request.GetName(),
+			CreateTime: now,
+		}
+	}
+	view.Query = request.GetQuery()
+	view.Description = request.GetDescription()
+	view.Owner = request.GetOwner()
+	view.Version++
+	view.UpdateTime = now
+
+	if config.VisibilityViews == nil {
+		config.VisibilityViews = make(map[string]*persistencespb.VisibilityView)
+	}
+	config.VisibilityViews[request.GetName()] = view
+	if err := d.updateNamespaceConfig(ctx, getNamespaceResponse, metadata.NotificationVersion); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// DeleteVisibilityView deletes a saved visibility query of a namespace.
+func (d *namespaceHandler) DeleteVisibilityView(
+	ctx context.Context,
+	nsName string,
+	viewName string,
+) error {
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	getNamespaceResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: nsName})
+	if err != nil {
+		return err
+	}
+	config := getNamespaceResponse.Namespace.Config
+	if _, ok := config.VisibilityViews[viewName]; !ok {
+		return serviceerror.NewNotFoundf("Visibility view %s not found.", viewName)
+	}
+	delete(config.VisibilityViews, viewName)
+	return d.updateNamespaceConfig(ctx, getNamespaceResponse, metadata.NotificationVersion)
+}
+
+// updateNamespaceConfig persists the modified config of a namespace and replicates it like UpdateNamespace does. It
+// fails if the namespace metadata changed after notificationVersion was read.
+func (d *namespaceHandler) updateNamespaceConfig(
+	ctx context.Context,
+	getNamespaceResponse *persistence.GetNamespaceResponse,
+	notificationVersion int64,
+) error {
+	existingNamespace := getNamespaceResponse.Namespace
+	configVersion := existingNamespace.ConfigVersion + 1
+	err := d.metadataMgr.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        existingNamespace.Info,
+			Config:                      existingNamespace.Config,
+			ReplicationConfig:           existingNamespace.ReplicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             existingNamespace.FailoverVersion,
+			FailoverNotificationVersion: existingNamespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   getNamespaceResponse.IsGlobalNamespace,
+		NotificationVersion: notificationVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	return d.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		existingNamespace.Info,
+		existingNamespace.Config,
+		existingNamespace.ReplicationConfig,
+		false, // replicationClusterListUpdated
+		configVersion,
+		existingNamespace.FailoverVersion,
+		getNamespaceResponse.IsGlobalNamespace,
+		existingNamespace.ReplicationConfig.GetFailoverHistory(),
+		false, // forceReplicate
+	)
+}
+
 func (d *namespaceHandler) createResponse(
 	info *persistencespb.NamespaceInfo,
 	config *persistencespb.NamespaceConfig,
@@ -46,7 +46,10 @@ type Config struct {
 	VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableManualPagination        dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableUnifiedQueryConverter   dynamicconfig.BoolPropertyFn
+	VisibilityCountGroupByMaxGroups         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	VisibilityAllowList                     dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	VisibilityWatchMaxStreamsPerNamespace   dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxVisibilityViewsPerNamespace          dynamicconfig.IntPropertyFnWithNamespaceFilter
 	SuppressErrorSetSystemSearchAttribute   dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	HistoryMaxPageSize dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -233,6 +236,7 @@ type Config struct {
 	WorkerHeartbeatsEnabled           dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	EnableCancelWorkerPollsOnShutdown dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	NumTaskQueueReadPartitions        dynamicconfig.IntPropertyFnWithTaskQueueFilter
+	FairnessKeyConcurrencyLimit       dynamicconfig.IntPropertyFnWithTaskQueueFilter
 	WorkerCommandsEnabled             dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	PollerAutoscalingAutoEnroll       dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	WorkflowPauseEnabled              dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -283,7 +287,10 @@ func NewConfig(
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
 		VisibilityEnableManualPagination:        dynamicconfig.VisibilityEnableManualPagination.Get(dc),
 		VisibilityEnableUnifiedQueryConverter:   dynamicconfig.VisibilityEnableUnifiedQueryConverter.Get(dc),
+		VisibilityCountGroupByMaxGroups:         dynamicconfig.VisibilityCountGroupByMaxGroups.Get(dc),
 		VisibilityAllowList:                     dynamicconfig.VisibilityAllowList.Get(dc),
+		VisibilityWatchMaxStreamsPerNamespace:   dynamicconfig.FrontendVisibilityWatchMaxStreamsPerNamespace.Get(dc),
+		MaxVisibilityViewsPerNamespace:          dynamicconfig.MaxVisibilityViewsPerNamespace.Get(dc),
 		SuppressErrorSetSystemSearchAttribute:   dynamicconfig.SuppressErrorSetSystemSearchAttribute.Get(dc),
 
 		HistoryMaxPageSize:                  dynamicconfig.FrontendHistoryMaxPageSize.Get(dc),
@@ -407,6 +414,7 @@ func NewConfig(
 		WorkerHeartbeatsEnabled:           dynamicconfig.WorkerHeartbeatsEnabled.Get(dc),
 		EnableCancelWorkerPollsOnShutdown: dynamicconfig.EnableCancelWorkerPollsOnShutdown.Get(dc),
 		NumTaskQueueReadPartitions:        dynamicconfig.MatchingNumTaskqueueReadPartitions.Get(dc),
+		FairnessKeyConcurrencyLimit:       dynamicconfig.MatchingFairnessKeyConcurrencyLimit.Get(dc),
 		WorkerCommandsEnabled:             dynamicconfig.WorkerCommandsEnabled.Get(dc),
 		PollerAutoscalingAutoEnroll:       dynamicconfig.PollerAutoscalingAutoEnroll.Get(dc),
 		WorkflowPauseEnabled:              dynamicconfig.WorkflowPauseEnabled.Get(dc),
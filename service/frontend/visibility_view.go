@@ -0,0 +1,74 @@
+package frontend
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+// visibilityViewReferencePrefix starts a visibility query that references a saved view, eg: the query
+// `@stuck-orders AND WorkflowType = 'order' ORDER BY StartTime` is run as
+// `(<query of stuck-orders>) AND WorkflowType = 'order' ORDER BY StartTime`.
+const visibilityViewReferencePrefix = "@"
+
+var visibilityViewNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,255}$`)
+
+// resolveVisibilityViewQuery replaces the reference to a saved view at the start of a visibility query with the
+// query of the view. Other queries are returned as is, without looking up the namespace.
+func resolveVisibilityViewQuery(
+	namespaceRegistry namespace.Registry,
+	namespaceName namespace.Name,
+	queryString string,
+) (string, error) {
+	trimmed := strings.TrimSpace(queryString)
+	if !strings.HasPrefix(trimmed, visibilityViewReferencePrefix) {
+		return queryString, nil
+	}
+
+	namespaceEntry, err := namespaceRegistry.GetNamespace(namespaceName)
+	if err != nil {
+		return "", err
+	}
+	name, rest, _ := strings.Cut(trimmed[len(visibilityViewReferencePrefix):], " ")
+	view, ok := namespaceEntry.GetVisibilityView(name)
+	if !ok {
+		return "", serviceerror.NewInvalidArgumentf(
+			"Visibility view %s not found in namespace %s.", name, namespaceEntry.Name())
+	}
+	if rest = strings.TrimSpace(rest); rest == "" {
+		return view.GetQuery(), nil
+	}
+	return fmt.Sprintf("(%s) %s", view.GetQuery(), rest), nil
+}
+
+// validateVisibilityViewQuery validates the query of a saved view against the search attributes of the namespace.
+// A view is a filter: it can't be empty and can't have ORDER BY or GROUP BY, so that it can be combined with other
+// clauses.
+func validateVisibilityViewQuery(
+	namespaceName namespace.Name,
+	queryString string,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapper searchattribute.Mapper,
+) error {
+	if strings.TrimSpace(queryString) == "" {
+		return errVisibilityViewQueryNotSet
+	}
+	queryParams, err := query.NewPredicateQueryConverter(namespaceName, saTypeMap, saMapper).Convert(queryString)
+	if err != nil {
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return converterErr.ToInvalidArgument()
+		}
+		return err
+	}
+	if len(queryParams.OrderBy) > 0 || len(queryParams.GroupBy) > 0 {
+		return errVisibilityViewQueryClauseNotAllowed
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResolveVisibilityViewQuery(t *testing.T) {
+	namespaceName := namespace.Name("test-namespace")
+	namespaceEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: namespaceName.String()},
+		&persistencespb.NamespaceConfig{
+			VisibilityViews: map[string]*persistencespb.VisibilityView{
+				"stuck-orders": {Name: "stuck-orders", Query: "ExecutionStatus = 'Running'"},
+			},
+		},
+		"",
+	)
+
+	testCases := []struct {
+		name          string
+		query         string
+		expectedQuery string
+		expectedErr   bool
+	}{
+		{
+			name:          "no view",
+			query:         "WorkflowType = 'order'",
+			expectedQuery: "WorkflowType = 'order'",
+		},
+		{
+			name:          "empty query",
+			query:         "",
+			expectedQuery: "",
+		},
+		{
+			name:          "view only",
+			query:         " @stuck-orders ",
+			expectedQuery: "ExecutionStatus = 'Running'",
+		},
+		{
+			name:          "view with clauses",
+			query:         "@stuck-orders AND WorkflowType = 'order' ORDER BY StartTime",
+			expectedQuery: "(ExecutionStatus = 'Running') AND WorkflowType = 'order' ORDER BY StartTime",
+		},
+		{
+			name:        "view not found",
+			query:       "@missing",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			registry := namespace.NewMockRegistry(ctrl)
+			registry.EXPECT().GetNamespace(namespaceName).Return(namespaceEntry, nil).MaxTimes(1)
+
+			query, err := resolveVisibilityViewQuery(registry, namespaceName, tc.query)
+			if tc.expectedErr {
+				var invalidArgument *serviceerror.InvalidArgument
+				require.ErrorAs(t, err, &invalidArgument)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedQuery, query)
+		})
+	}
+}
+
+func TestValidateVisibilityViewQuery(t *testing.T) {
+	namespaceName := namespace.Name("test-namespace")
+
+	testCases := []struct {
+		name        string
+		query       string
+		expectedErr error
+		// invalidArgument is set for converter errors, which aren't sentinels.
+		invalidArgument bool
+	}{
+		{
+			name:  "valid",
+			query: "AliasForKeyword01 = 'foo' AND WorkflowType = 'order'",
+		},
+		{
+			name:        "empty",
+			query:       " ",
+			expectedErr: errVisibilityViewQueryNotSet,
+		},
+		{
+			name:            "unknown search attribute",
+			query:           "Unknown = 'foo'",
+			invalidArgument: true,
+		},
+		{
+			name:        "order by",
+			query:       "WorkflowType = 'order' ORDER BY StartTime",
+			expectedErr: errVisibilityViewQueryClauseNotAllowed,
+		},
+		{
+			name:        "group by",
+			query:       "GROUP BY ExecutionStatus",
+			expectedErr: errVisibilityViewQueryClauseNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateVisibilityViewQuery(
+				namespaceName,
+				tc.query,
+				searchattribute.TestNameTypeMap(),
+				&searchattribute.TestMapper{},
+			)
+			switch {
+			case tc.expectedErr != nil:
+				require.ErrorIs(t, err, tc.expectedErr)
+			case tc.invalidArgument:
+				var invalidArgument *serviceerror.InvalidArgument
+				require.ErrorAs(t, err, &invalidArgument)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
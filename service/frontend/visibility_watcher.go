@@ -0,0 +1,414 @@
+package frontend
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+)
+
+const (
+	visibilityWatchMembershipRefreshInterval = 10 * time.Second
+	visibilityWatchPollRetryInterval         = time.Second
+	visibilityWatchMaxMatchedExecutions      = 10000
+)
+
+type (
+	// visibilityWatchStreamLimiter counts the WatchWorkflowExecutions streams of each namespace on this host.
+	visibilityWatchStreamLimiter struct {
+		mu      sync.Mutex
+		streams map[namespace.ID]int
+	}
+
+	// visibilityWatcher merges the visibility watch events of all history hosts into a WatchWorkflowExecutions
+	// stream, filtering them with the query of the stream.
+	//
+	// Each history host is polled by its own goroutine. The results are handed over without buffering, so a client
+	// that reads slower than executions change stops the polls; the history hosts then drop the oldest events and
+	// the watcher sends a resync event when it catches up.
+	visibilityWatcher struct {
+		namespaceEntry   *namespace.Namespace
+		predicate        query.Predicate
+		saTypeMap        searchattribute.NameTypeMap
+		saMapperProvider searchattribute.MapperProvider
+		historyClient    historyservice.HistoryServiceClient
+		resolver         membership.ServiceResolver
+		server           adminservice.AdminService_WatchWorkflowExecutionsServer
+		logger           log.Logger
+
+		positions map[string]*tokenspb.VisibilityWatchHostPosition
+		pollers   map[string]*visibilityWatchPoller
+		results   chan visibilityWatchPollResult
+		// matched has the run IDs of running executions that matched the query, so that the watcher can tell the
+		// client when they stop matching it. Older executions are forgotten when there are too many.
+		matched cache.Cache
+	}
+
+	visibilityWatchPoller struct {
+		hostAddress string
+		cancel      context.CancelFunc
+	}
+
+	visibilityWatchPollResult struct {
+		poller   *visibilityWatchPoller
+		response *historyservice.PollVisibilityWatchEventsResponse
+	}
+)
+
+func newVisibilityWatchStreamLimiter() *visibilityWatchStreamLimiter {
+	return &visibilityWatchStreamLimiter{
+		streams: make(map[namespace.ID]int),
+	}
+}
+
+// tryAcquire returns false if the namespace already has limit streams. Otherwise, the caller must call release when
+// the stream ends.
+func (l *visibilityWatchStreamLimiter) tryAcquire(namespaceID namespace.ID, limit int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.streams[namespaceID] >= limit {
+		return false
+	}
+	l.streams[namespaceID]++
+	return true
+}
+
+func (l *visibilityWatchStreamLimiter) release(namespaceID namespace.ID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.streams[namespaceID]--
+	if l.streams[namespaceID] <= 0 {
+		delete(l.streams, namespaceID)
+	}
+}
+
+func newVisibilityWatcher(
+	namespaceEntry *namespace.Namespace,
+	predicate query.Predicate,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapperProvider searchattribute.MapperProvider,
+	historyClient historyservice.HistoryServiceClient,
+	resolver membership.ServiceResolver,
+	server adminservice.AdminService_WatchWorkflowExecutionsServer,
+	logger log.Logger,
+) *visibilityWatcher {
+	return &visibilityWatcher{
+		namespaceEntry:   namespaceEntry,
+		predicate:        predicate,
+		saTypeMap:        saTypeMap,
+		saMapperProvider: saMapperProvider,
+		historyClient:    historyClient,
+		resolver:         resolver,
+		server:           server,
+		logger:           log.With(logger, tag.WorkflowNamespace(namespaceEntry.Name().String())),
+		positions:        make(map[string]*tokenspb.VisibilityWatchHostPosition),
+		pollers:          make(map[string]*visibilityWatchPoller),
+		results:          make(chan visibilityWatchPollResult),
+		matched:          cache.New(visibilityWatchMaxMatchedExecutions, nil),
+	}
+}
+
+// run sends events to the client until ctx is done or sending fails. An empty resumeToken starts at the latest
+// change of every history host.
+func (w *visibilityWatcher) run(ctx context.Context, resumeToken []byte) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	resync, err := w.start(ctx, resumeToken)
+	if err != nil {
+		return err
+	}
+	if resync {
+		if err := w.sendResync(); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(visibilityWatchMembershipRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if w.refreshMembers(ctx) {
+				if err := w.sendResync(); err != nil {
+					return err
+				}
+			}
+		case result := <-w.results:
+			if err := w.handlePollResult(result); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// start starts polling every history host. It returns true if the resume token has hosts that are gone, in which
+// case their changes that weren't sent are lost.
+func (w *visibilityWatcher) start(ctx context.Context, resumeToken []byte) (bool, error) {
+	if len(resumeToken) == 0 {
+		for _, member := range w.resolver.Members() {
+			w.startPoller(ctx, member.GetAddress(), &tokenspb.VisibilityWatchHostPosition{}, true)
+		}
+		return false, nil
+	}
+
+	token := &tokenspb.VisibilityWatch{}
+	if err := token.Unmarshal(resumeToken); err != nil {
+		return false, errInvalidVisibilityWatchResumeToken
+	}
+	for _, position := range token.GetHosts() {
+		w.positions[position.GetHostAddress()] = position
+	}
+	return w.refreshMembers(ctx), nil
+}
+
+// refreshMembers starts polling new history hosts and stops polling the ones that are gone. It returns true if a
+// host is gone.
+func (w *visibilityWatcher) refreshMembers(ctx context.Context) bool {
+	members := make(map[string]struct{})
+	for _, member := range w.resolver.Members() {
+		address := member.GetAddress()
+		members[address] = struct{}{}
+		if _, ok := w.pollers[address]; ok {
+			continue
+		}
+		position, ok := w.positions[address]
+		if !ok {
+			// The host joined after the stream started: read all of its events, which were all published after that.
+			position = &tokenspb.VisibilityWatchHostPosition{}
+		}
+		w.startPoller(ctx, address, position, false)
+	}
+
+	var removed bool
+	for address := range w.positions {
+		if _, ok := members[address]; ok {
+			continue
+		}
+		if poller, ok := w.pollers[address]; ok {
+			poller.cancel()
+			delete(w.pollers, address)
+		}
+		delete(w.positions, address)
+		removed = true
+	}
+	return removed
+}
+
+func (w *visibilityWatcher) startPoller(
+	ctx context.Context,
+	hostAddress string,
+	position *tokenspb.VisibilityWatchHostPosition,
+	startAtLatest bool,
+) {
+	ctx, cancel := context.WithCancel(ctx)
+	poller := &visibilityWatchPoller{
+		hostAddress: hostAddress,
+		cancel:      cancel,
+	}
+	w.pollers[hostAddress] = poller
+	w.positions[hostAddress] = &tokenspb.VisibilityWatchHostPosition{
+		HostAddress:  hostAddress,
+		Epoch:        position.GetEpoch(),
+		LastSequence: position.GetLastSequence(),
+	}
+
+	request := &historyservice.PollVisibilityWatchEventsRequest{
+		HostAddress:   hostAddress,
+		NamespaceId:   w.namespaceEntry.ID().String(),
+		Epoch:         position.GetEpoch(),
+		LastSequence:  position.GetLastSequence(),
+		StartAtLatest: startAtLatest,
+	}
+	go w.poll(ctx, poller, request)
+}
+
+func (w *visibilityWatcher) poll(
+	ctx context.Context,
+	poller *visibilityWatchPoller,
+	request *historyservice.PollVisibilityWatchEventsRequest,
+) {
+	for ctx.Err() == nil {
+		resp, err := w.historyClient.PollVisibilityWatchEvents(ctx, request)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.logger.Warn("Failed to poll visibility watch events.", tag.Address(poller.hostAddress), tag.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(visibilityWatchPollRetryInterval):
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case w.results <- visibilityWatchPollResult{poller: poller, response: resp}:
+		}
+		request.Epoch = resp.GetEpoch()
+		request.LastSequence = resp.GetLastSequence()
+		request.StartAtLatest = false
+	}
+}
+
+func (w *visibilityWatcher) handlePollResult(result visibilityWatchPollResult) error {
+	address := result.poller.hostAddress
+	if w.pollers[address] != result.poller {
+		// The host left while the result was handed over.
+		return nil
+	}
+	position := w.positions[address]
+	resp := result.response
+	position.Epoch = resp.GetEpoch()
+
+	if resp.GetResyncRequired() {
+		position.LastSequence = resp.GetLastSequence()
+		return w.sendResync()
+	}
+	for _, event := range resp.GetEvents() {
+		position.LastSequence = event.GetSequence()
+		if err := w.handleEvent(event); err != nil {
+			return err
+		}
+	}
+	position.LastSequence = resp.GetLastSequence()
+	return nil
+}
+
+func (w *visibilityWatcher) handleEvent(event *historyservice.VisibilityWatchEvent) error {
+	executionInfo := event.GetExecutionInfo()
+	values := w.getRecordValues(executionInfo)
+	runID := executionInfo.GetExecution().GetRunId()
+
+	matchesQuery := w.predicate(values)
+	switch {
+	case matchesQuery && event.GetEventType() != enumsspb.VISIBILITY_WATCH_EVENT_TYPE_CLOSED:
+		w.matched.Put(runID, struct{}{})
+	case w.matched.Get(runID) != nil:
+		w.matched.Delete(runID)
+	case !matchesQuery && event.GetEventType() == enumsspb.VISIBILITY_WATCH_EVENT_TYPE_CLOSED:
+		// The execution may have started before the stream: tell the client if it matched while running.
+		if !w.predicate(getRunningRecordValues(values)) {
+			return nil
+		}
+	case !matchesQuery:
+		return nil
+	}
+
+	if sas := executionInfo.GetSearchAttributes(); sas != nil {
+		aliasedSas, err := searchattribute.AliasFields(w.saMapperProvider, sas, w.namespaceEntry.Name().String())
+		if err != nil {
+			return err
+		}
+		if aliasedSas != sas {
+			executionInfo = common.CloneProto(executionInfo)
+			executionInfo.SearchAttributes = aliasedSas
+		}
+	}
+	return w.send(&adminservice.WatchWorkflowExecutionsResponse{
+		EventType:     event.GetEventType(),
+		ExecutionInfo: executionInfo,
+		MatchesQuery:  matchesQuery,
+	})
+}
+
+func (w *visibilityWatcher) sendResync() error {
+	return w.send(&adminservice.WatchWorkflowExecutionsResponse{
+		EventType: enumsspb.VISIBILITY_WATCH_EVENT_TYPE_RESYNC,
+	})
+}
+
+func (w *visibilityWatcher) send(resp *adminservice.WatchWorkflowExecutionsResponse) error {
+	token := &tokenspb.VisibilityWatch{
+		Hosts: slices.SortedFunc(maps.Values(w.positions), func(a, b *tokenspb.VisibilityWatchHostPosition) int {
+			return cmp.Compare(a.GetHostAddress(), b.GetHostAddress())
+		}),
+	}
+	resumeToken, err := token.Marshal()
+	if err != nil {
+		return err
+	}
+	resp.ResumeToken = resumeToken
+	return w.server.Send(resp)
+}
+
+// getRecordValues returns the values of the search attributes of a visibility record, in the form expected by
+// query.Predicate.
+func (w *visibilityWatcher) getRecordValues(executionInfo *workflowpb.WorkflowExecutionInfo) map[string]any {
+	values, err := searchattribute.Decode(executionInfo.GetSearchAttributes(), &w.saTypeMap, false)
+	if err != nil {
+		// Search attributes that can't be decoded are treated as missing.
+		w.logger.Warn("Failed to decode search attributes of visibility watch event.", tag.Error(err))
+	}
+	if values == nil {
+		values = make(map[string]any)
+	}
+	maps.DeleteFunc(values, func(_ string, value any) bool { return value == nil })
+
+	values[sadefs.WorkflowID] = executionInfo.GetExecution().GetWorkflowId()
+	values[sadefs.RunID] = executionInfo.GetExecution().GetRunId()
+	values[sadefs.WorkflowType] = executionInfo.GetType().GetName()
+	values[sadefs.ExecutionStatus] = executionInfo.GetStatus().String()
+	values[sadefs.TaskQueue] = executionInfo.GetTaskQueue()
+	if executionInfo.GetStartTime() != nil {
+		values[sadefs.StartTime] = executionInfo.GetStartTime().AsTime()
+	}
+	if executionInfo.GetExecutionTime() != nil {
+		values[sadefs.ExecutionTime] = executionInfo.GetExecutionTime().AsTime()
+	}
+	if parent := executionInfo.GetParentExecution(); parent != nil {
+		values[sadefs.ParentWorkflowID] = parent.GetWorkflowId()
+		values[sadefs.ParentRunID] = parent.GetRunId()
+	}
+	if root := executionInfo.GetRootExecution(); root != nil {
+		values[sadefs.RootWorkflowID] = root.GetWorkflowId()
+		values[sadefs.RootRunID] = root.GetRunId()
+	}
+	if executionInfo.GetCloseTime() != nil {
+		values[sadefs.CloseTime] = executionInfo.GetCloseTime().AsTime()
+		values[sadefs.ExecutionDuration] = executionInfo.GetExecutionDuration().AsDuration().Nanoseconds()
+		values[sadefs.HistoryLength] = executionInfo.GetHistoryLength()
+		values[sadefs.HistorySizeBytes] = executionInfo.GetHistorySizeBytes()
+		values[sadefs.StateTransitionCount] = executionInfo.GetStateTransitionCount()
+	}
+	return values
+}
+
+// getRunningRecordValues returns the values of a closed visibility record as they were while it was running.
+func getRunningRecordValues(values map[string]any) map[string]any {
+	values = maps.Clone(values)
+	values[sadefs.ExecutionStatus] = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()
+	for _, name := range []string{
+		sadefs.CloseTime,
+		sadefs.ExecutionDuration,
+		sadefs.HistoryLength,
+		sadefs.HistorySizeBytes,
+		sadefs.StateTransitionCount,
+	} {
+		delete(values, name)
+	}
+	return values
+}
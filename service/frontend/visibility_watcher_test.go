@@ -0,0 +1,322 @@
+package frontend
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+// fakeVisibilityWatchHost returns the responses in order, then blocks until the poll is canceled.
+type fakeVisibilityWatchHost struct {
+	mu        sync.Mutex
+	requests  []*historyservice.PollVisibilityWatchEventsRequest
+	responses []*historyservice.PollVisibilityWatchEventsResponse
+}
+
+func (h *fakeVisibilityWatchHost) poll(
+	ctx context.Context,
+	request *historyservice.PollVisibilityWatchEventsRequest,
+) (*historyservice.PollVisibilityWatchEventsResponse, error) {
+	h.mu.Lock()
+	h.requests = append(h.requests, common.CloneProto(request))
+	if len(h.responses) > 0 {
+		resp := h.responses[0]
+		h.responses = h.responses[1:]
+		h.mu.Unlock()
+		return resp, nil
+	}
+	h.mu.Unlock()
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (h *fakeVisibilityWatchHost) getRequests() []*historyservice.PollVisibilityWatchEventsRequest {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.requests
+}
+
+// runTestVisibilityWatcher runs a watcher on the hosts until it sends numResponses responses.
+func runTestVisibilityWatcher(
+	t *testing.T,
+	queryString string,
+	resumeToken []byte,
+	hosts map[string]*fakeVisibilityWatchHost,
+	numResponses int,
+) ([]*adminservice.WatchWorkflowExecutionsResponse, error) {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	historyClient.EXPECT().PollVisibilityWatchEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			ctx context.Context,
+			request *historyservice.PollVisibilityWatchEventsRequest,
+			_ ...grpc.CallOption,
+		) (*historyservice.PollVisibilityWatchEventsResponse, error) {
+			return hosts[request.GetHostAddress()].poll(ctx, request)
+		}).AnyTimes()
+
+	var members []membership.HostInfo
+	for address := range hosts {
+		members = append(members, membership.NewHostInfoFromAddress(address))
+	}
+	resolver := membership.NewMockServiceResolver(ctrl)
+	resolver.EXPECT().Members().Return(members).AnyTimes()
+
+	var responses []*adminservice.WatchWorkflowExecutionsResponse
+	server := adminservicemock.NewMockAdminService_WatchWorkflowExecutionsServer(ctrl)
+	server.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *adminservice.WatchWorkflowExecutionsResponse) error {
+		responses = append(responses, resp)
+		if len(responses) == numResponses {
+			cancel()
+		}
+		return nil
+	}).AnyTimes()
+
+	namespaceEntry := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "ns-id", Name: "test-namespace"},
+		nil,
+		false,
+		nil,
+		1,
+	)
+	queryParams, err := query.NewPredicateQueryConverter(
+		namespaceEntry.Name(),
+		searchattribute.TestNameTypeMap(),
+		&searchattribute.TestMapper{},
+	).Convert(queryString)
+	require.NoError(t, err)
+
+	watcher := newVisibilityWatcher(
+		namespaceEntry,
+		queryParams.QueryExpr,
+		searchattribute.TestNameTypeMap(),
+		searchattribute.NewTestMapperProvider(&searchattribute.TestMapper{}),
+		historyClient,
+		resolver,
+		server,
+		log.NewNoopLogger(),
+	)
+	err = watcher.run(ctx, resumeToken)
+	return responses, err
+}
+
+func newTestWatchEvent(
+	t *testing.T,
+	sequence int64,
+	eventType enumsspb.VisibilityWatchEventType,
+	workflowID string,
+	status enumspb.WorkflowExecutionStatus,
+	keyword string,
+) *historyservice.VisibilityWatchEvent {
+	typeMap := searchattribute.TestNameTypeMap()
+	sas, err := searchattribute.Encode(map[string]any{"Keyword01": keyword}, &typeMap)
+	require.NoError(t, err)
+	return &historyservice.VisibilityWatchEvent{
+		Sequence:  sequence,
+		EventType: eventType,
+		ExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution:        &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: workflowID + "-run"},
+			Status:           status,
+			SearchAttributes: sas,
+		},
+	}
+}
+
+func decodeTestResumeToken(t *testing.T, resumeToken []byte) []*tokenspb.VisibilityWatchHostPosition {
+	token := &tokenspb.VisibilityWatch{}
+	require.NoError(t, token.Unmarshal(resumeToken))
+	return token.GetHosts()
+}
+
+func TestVisibilityWatcher_FiltersEvents(t *testing.T) {
+	t.Parallel()
+
+	running := enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	host := &fakeVisibilityWatchHost{
+		responses: []*historyservice.PollVisibilityWatchEventsResponse{
+			{
+				Epoch:        7,
+				LastSequence: 3,
+				Events: []*historyservice.VisibilityWatchEvent{
+					newTestWatchEvent(t, 1, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "a", running, "gold"),
+					newTestWatchEvent(t, 2, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "b", running, "silver"),
+					newTestWatchEvent(t, 3, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_UPDATED, "a", running, "silver"),
+				},
+			},
+			{
+				Epoch:        7,
+				LastSequence: 5,
+				Events: []*historyservice.VisibilityWatchEvent{
+					newTestWatchEvent(t, 5, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_CLOSED, "c",
+						enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, "gold"),
+				},
+			},
+		},
+	}
+
+	responses, err := runTestVisibilityWatcher(
+		t,
+		"AliasForKeyword01 = 'gold' AND ExecutionStatus = 'Running'",
+		nil,
+		map[string]*fakeVisibilityWatchHost{"host-1": host},
+		3,
+	)
+	require.NoError(t, err)
+	require.Len(t, responses, 3)
+
+	assert.Equal(t, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, responses[0].GetEventType())
+	assert.Equal(t, "a", responses[0].GetExecutionInfo().GetExecution().GetWorkflowId())
+	assert.True(t, responses[0].GetMatchesQuery())
+	assert.Contains(t, responses[0].GetExecutionInfo().GetSearchAttributes().GetIndexedFields(), "AliasForKeyword01")
+	assert.Equal(t, []*tokenspb.VisibilityWatchHostPosition{
+		{HostAddress: "host-1", Epoch: 7, LastSequence: 1},
+	}, decodeTestResumeToken(t, responses[0].GetResumeToken()))
+
+	// a stopped matching the query.
+	assert.Equal(t, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_UPDATED, responses[1].GetEventType())
+	assert.Equal(t, "a", responses[1].GetExecutionInfo().GetExecution().GetWorkflowId())
+	assert.False(t, responses[1].GetMatchesQuery())
+
+	// c matched the query while it was running.
+	assert.Equal(t, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_CLOSED, responses[2].GetEventType())
+	assert.Equal(t, "c", responses[2].GetExecutionInfo().GetExecution().GetWorkflowId())
+	assert.False(t, responses[2].GetMatchesQuery())
+	assert.Equal(t, []*tokenspb.VisibilityWatchHostPosition{
+		{HostAddress: "host-1", Epoch: 7, LastSequence: 5},
+	}, decodeTestResumeToken(t, responses[2].GetResumeToken()))
+
+	requests := host.getRequests()
+	require.GreaterOrEqual(t, len(requests), 2)
+	assert.True(t, requests[0].GetStartAtLatest())
+	assert.Equal(t, "ns-id", requests[0].GetNamespaceId())
+	assert.False(t, requests[1].GetStartAtLatest())
+	assert.Equal(t, int64(7), requests[1].GetEpoch())
+	assert.Equal(t, int64(3), requests[1].GetLastSequence())
+}
+
+func TestVisibilityWatcher_Resync(t *testing.T) {
+	t.Parallel()
+
+	host1 := &fakeVisibilityWatchHost{
+		responses: []*historyservice.PollVisibilityWatchEventsResponse{
+			{Epoch: 8, LastSequence: 10, ResyncRequired: true},
+		},
+	}
+	host2 := &fakeVisibilityWatchHost{
+		responses: []*historyservice.PollVisibilityWatchEventsResponse{
+			{
+				Epoch:        3,
+				LastSequence: 1,
+				Events: []*historyservice.VisibilityWatchEvent{
+					newTestWatchEvent(t, 1, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "a",
+						enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, "gold"),
+				},
+			},
+		},
+	}
+	resumeToken, err := (&tokenspb.VisibilityWatch{
+		Hosts: []*tokenspb.VisibilityWatchHostPosition{
+			{HostAddress: "host-1", Epoch: 7, LastSequence: 3},
+			{HostAddress: "host-gone", Epoch: 1, LastSequence: 1},
+		},
+	}).Marshal()
+	require.NoError(t, err)
+
+	responses, err := runTestVisibilityWatcher(
+		t,
+		"",
+		resumeToken,
+		map[string]*fakeVisibilityWatchHost{"host-1": host1, "host-2": host2},
+		3,
+	)
+	require.NoError(t, err)
+	require.Len(t, responses, 3)
+
+	// The changes of the host that is gone may be lost.
+	assert.Equal(t, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_RESYNC, responses[0].GetEventType())
+	assert.Nil(t, responses[0].GetExecutionInfo())
+	assert.Equal(t, []*tokenspb.VisibilityWatchHostPosition{
+		{HostAddress: "host-1", Epoch: 7, LastSequence: 3},
+		{HostAddress: "host-2"},
+	}, decodeTestResumeToken(t, responses[0].GetResumeToken()))
+
+	// The responses of the two hosts are received in any order.
+	var resyncResp, startedResp *adminservice.WatchWorkflowExecutionsResponse
+	for _, resp := range responses[1:] {
+		if resp.GetEventType() == enumsspb.VISIBILITY_WATCH_EVENT_TYPE_RESYNC {
+			resyncResp = resp
+		} else {
+			startedResp = resp
+		}
+	}
+	// host-1 dropped events.
+	require.NotNil(t, resyncResp)
+	assert.Equal(t, &tokenspb.VisibilityWatchHostPosition{HostAddress: "host-1", Epoch: 8, LastSequence: 10},
+		decodeTestResumeToken(t, resyncResp.GetResumeToken())[0])
+	// host-2 joined after the token was created, so all of its events are read.
+	require.NotNil(t, startedResp)
+	assert.Equal(t, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, startedResp.GetEventType())
+	assert.Equal(t, &tokenspb.VisibilityWatchHostPosition{HostAddress: "host-2", Epoch: 3, LastSequence: 1},
+		decodeTestResumeToken(t, startedResp.GetResumeToken())[1])
+
+	requests := host1.getRequests()
+	require.NotEmpty(t, requests)
+	assert.Equal(t, int64(7), requests[0].GetEpoch())
+	assert.Equal(t, int64(3), requests[0].GetLastSequence())
+	assert.False(t, requests[0].GetStartAtLatest())
+
+	requests = host2.getRequests()
+	require.NotEmpty(t, requests)
+	assert.Equal(t, int64(0), requests[0].GetEpoch())
+	assert.False(t, requests[0].GetStartAtLatest())
+}
+
+func TestVisibilityWatcher_InvalidResumeToken(t *testing.T) {
+	t.Parallel()
+
+	_, err := runTestVisibilityWatcher(
+		t,
+		"",
+		[]byte("invalid"),
+		map[string]*fakeVisibilityWatchHost{"host-1": {}},
+		1,
+	)
+	require.ErrorIs(t, err, errInvalidVisibilityWatchResumeToken)
+}
+
+func TestVisibilityWatchStreamLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := newVisibilityWatchStreamLimiter()
+	assert.True(t, limiter.tryAcquire("ns-1", 2))
+	assert.True(t, limiter.tryAcquire("ns-1", 2))
+	assert.False(t, limiter.tryAcquire("ns-1", 2))
+	assert.True(t, limiter.tryAcquire("ns-2", 2))
+
+	limiter.release("ns-1")
+	assert.True(t, limiter.tryAcquire("ns-1", 2))
+}
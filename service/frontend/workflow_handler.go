@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/chasm/lib/activity"
 	"go.temporal.io/server/chasm/lib/callback"
@@ -67,6 +68,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/placement"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/priorities"
@@ -1086,6 +1088,10 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 	if err := tqid.NormalizeAndValidate(request.TaskQueue, "", wh.config.MaxIDLengthLimit()); err != nil {
 		return nil, err
 	}
+	workerLabels, err := placement.ParseLabels(headers.GetValues(ctx, placement.WorkerLabelsHeaderName)[0])
+	if err != nil {
+		return nil, err
+	}
 
 	callTime := time.Now().UTC()
 
@@ -1109,9 +1115,10 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 	defer wh.unregisterOutstandingPollContext(pollerID, namespaceID.String())
 
 	matchingResp, err := wh.matchingClient.PollWorkflowTaskQueue(childCtx, &matchingservice.PollWorkflowTaskQueueRequest{
-		NamespaceId: namespaceID.String(),
-		PollerId:    pollerID,
-		PollRequest: request,
+		NamespaceId:  namespaceID.String(),
+		PollerId:     pollerID,
+		PollRequest:  request,
+		WorkerLabels: workerLabels,
 	})
 	if err != nil {
 		contextWasCanceled := wh.cancelOutstandingPoll(childCtx, namespaceID, enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.TaskQueue, pollerID)
@@ -1344,6 +1351,10 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 	if len(request.GetIdentity()) > wh.config.MaxIDLengthLimit() {
 		return nil, errIdentityTooLong
 	}
+	workerLabels, err := placement.ParseLabels(headers.GetValues(ctx, placement.WorkerLabelsHeaderName)[0])
+	if err != nil {
+		return nil, err
+	}
 
 	//nolint:staticcheck // SA1019: worker versioning v0.31
 	if err := wh.validateVersioningInfo(request.Namespace, request.WorkerVersionCapabilities, request.DeploymentOptions, request.TaskQueue); err != nil {
@@ -1363,9 +1374,10 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 	childCtx := wh.registerOutstandingPollContext(ctx, pollerID, namespaceID.String())
 	defer wh.unregisterOutstandingPollContext(pollerID, namespaceID.String())
 	matchingResponse, err := wh.matchingClient.PollActivityTaskQueue(childCtx, &matchingservice.PollActivityTaskQueueRequest{
-		NamespaceId: namespaceID.String(),
-		PollerId:    pollerID,
-		PollRequest: request,
+		NamespaceId:  namespaceID.String(),
+		PollerId:     pollerID,
+		PollRequest:  request,
+		WorkerLabels: workerLabels,
 	})
 	if err != nil {
 		contextWasCanceled := wh.cancelOutstandingPoll(childCtx, namespaceID, enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.TaskQueue, pollerID)
@@ -1684,6 +1696,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		}
 	}
 
+	wh.releaseConcurrencySlot(ctx, taskToken)
 	return &workflowservice.RespondActivityTaskCompletedResponse{}, nil
 }
 
@@ -1760,6 +1773,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 		return nil, err
 	}
 
+	releaseConcurrencySlot := wh.lookupConcurrencySlotByID(ctx, namespaceID, namespaceEntry.Name().String(), workflowID, runID, activityID)
+
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.Name().String())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.Name().String())
 
@@ -1803,6 +1818,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 		}
 	}
 
+	releaseConcurrencySlot()
 	return &workflowservice.RespondActivityTaskCompletedByIdResponse{}, nil
 }
 
@@ -1895,6 +1911,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	if err != nil {
 		return nil, err
 	}
+	wh.releaseConcurrencySlot(ctx, taskToken)
 	return &response, nil
 }
 
@@ -1970,6 +1987,8 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 		return nil, err
 	}
 
+	releaseConcurrencySlot := wh.lookupConcurrencySlotByID(ctx, namespaceID, namespaceEntry.Name().String(), workflowID, runID, activityID)
+
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.Name().String())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.Name().String())
 
@@ -2026,6 +2045,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 	if err != nil {
 		return nil, err
 	}
+	releaseConcurrencySlot()
 	return &response, nil
 }
 
@@ -2097,6 +2117,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(ctx context.Context, requ
 		}
 	}
 
+	wh.releaseConcurrencySlot(ctx, taskToken)
 	return &workflowservice.RespondActivityTaskCanceledResponse{}, nil
 }
 
@@ -2172,6 +2193,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledById(ctx context.Context,
 		return nil, err
 	}
 
+	releaseConcurrencySlot := wh.lookupConcurrencySlotByID(ctx, namespaceID, namespaceEntry.Name().String(), workflowID, runID, activityID)
+
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.Name().String())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.Name().String())
 
@@ -2215,6 +2238,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledById(ctx context.Context,
 		}
 	}
 
+	releaseConcurrencySlot()
 	return &workflowservice.RespondActivityTaskCanceledByIdResponse{}, nil
 }
 
@@ -2728,15 +2752,19 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 	if err != nil {
 		return nil, err
 	}
+	query, err := resolveVisibilityViewQuery(wh.namespaceRegistry, namespaceName, request.GetQuery())
+	if err != nil {
+		return nil, err
+	}
 
-	metrics.VisibilityListWorkflowsQueryLength.With(wh.metricsScope(ctx)).Record(int64(len(request.GetQuery())))
+	metrics.VisibilityListWorkflowsQueryLength.With(wh.metricsScope(ctx)).Record(int64(len(query)))
 
 	req := &manager.ListWorkflowExecutionsRequestV2{
 		NamespaceID:   namespaceID,
 		Namespace:     namespaceName,
 		PageSize:      int(request.GetPageSize()),
 		NextPageToken: request.NextPageToken,
-		Query:         request.GetQuery(),
+		Query:         query,
 	}
 	persistenceResp, err := wh.visibilityMgr.ListWorkflowExecutions(ctx, req)
 	if err != nil {
@@ -2860,11 +2888,15 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context, request
 	if err != nil {
 		return nil, err
 	}
+	query, err := resolveVisibilityViewQuery(wh.namespaceRegistry, namespaceName, request.GetQuery())
+	if err != nil {
+		return nil, err
+	}
 
 	req := &manager.CountWorkflowExecutionsRequest{
 		NamespaceID: namespaceID,
 		Namespace:   namespaceName,
-		Query:       request.GetQuery(),
+		Query:       query,
 	}
 	persistenceResp, err := wh.visibilityMgr.CountWorkflowExecutions(ctx, req)
 	if err != nil {
@@ -3149,6 +3181,100 @@ func (wh *WorkflowHandler) cancelOutstandingWorkerPolls(
 	}
 }
 
+// releaseConcurrencySlot tells matching that the activity task attempt of the token is no longer
+// outstanding, if matching counted it against a fairness key concurrency limit. It must only be called
+// after history accepted the response: if the response failed, the attempt is still outstanding.
+func (wh *WorkflowHandler) releaseConcurrencySlot(ctx context.Context, taskToken *tokenspb.Task) {
+	partition := taskToken.GetConcurrencySlotPartition()
+	if partition == nil {
+		return
+	}
+	wh.releaseConcurrencySlotInPartition(ctx, &matchingservice.ReleaseConcurrencySlotRequest{
+		NamespaceId:        taskToken.GetNamespaceId(),
+		TaskQueuePartition: partition,
+		RunId:              taskToken.GetRunId(),
+		ScheduledEventId:   taskToken.GetScheduledEventId(),
+		Attempt:            taskToken.GetAttempt(),
+	})
+}
+
+// lookupConcurrencySlotByID finds the started attempt of an activity that is responded to by ID, and
+// returns a function that releases its fairness key concurrency slot. There is no task token to tell
+// which partition holds the slot, so the release is sent to all read partitions of the task queue.
+// The lookup must happen before the response is recorded, since history drops the activity after that,
+// but the release must only happen after history accepted the response.
+func (wh *WorkflowHandler) lookupConcurrencySlotByID(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	namespaceName string,
+	workflowID string,
+	runID string,
+	activityID string,
+) func() {
+	noop := func() {}
+	// Standalone activities are not part of the workflow mutable state; their slots are left to expire.
+	if workflowID == "" {
+		return noop
+	}
+	// The task queue is only known after the lookup, so skip it unless the namespace has a limit.
+	if wh.config.FairnessKeyConcurrencyLimit(namespaceName, "", enumspb.TASK_QUEUE_TYPE_ACTIVITY) <= 0 {
+		return noop
+	}
+	response, err := wh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId:     namespaceID.String(),
+		Execution:       &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+		SkipForceReload: true,
+	})
+	if err != nil {
+		// The response itself will fail with the same error if the workflow can't be loaded.
+		return noop
+	}
+	mutableState := response.GetDatabaseMutableState()
+	for _, activityInfo := range mutableState.GetActivityInfos() {
+		if activityInfo.GetActivityId() != activityID {
+			continue
+		}
+		taskQueue := activityInfo.GetTaskQueue()
+		if activityInfo.GetStartedEventId() == common.EmptyEventID ||
+			wh.config.FairnessKeyConcurrencyLimit(namespaceName, taskQueue, enumspb.TASK_QUEUE_TYPE_ACTIVITY) <= 0 {
+			return noop
+		}
+		numPartitions := max(wh.config.NumTaskQueueReadPartitions(namespaceName, taskQueue, enumspb.TASK_QUEUE_TYPE_ACTIVITY), 1)
+		runID := mutableState.GetExecutionState().GetRunId()
+		scheduledEventID := activityInfo.GetScheduledEventId()
+		attempt := activityInfo.GetAttempt()
+		return func() {
+			var waitGroup sync.WaitGroup
+			for partitionID := range numPartitions {
+				waitGroup.Go(func() {
+					wh.releaseConcurrencySlotInPartition(ctx, &matchingservice.ReleaseConcurrencySlotRequest{
+						NamespaceId: namespaceID.String(),
+						TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+							TaskQueue:     taskQueue,
+							TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+							PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(partitionID)},
+						},
+						RunId:            runID,
+						ScheduledEventId: scheduledEventID,
+						Attempt:          attempt,
+					})
+				})
+			}
+			waitGroup.Wait()
+		}
+	}
+	return noop
+}
+
+func (wh *WorkflowHandler) releaseConcurrencySlotInPartition(ctx context.Context, request *matchingservice.ReleaseConcurrencySlotRequest) {
+	_, err := wh.matchingClient.ReleaseConcurrencySlot(ctx, request)
+	if err != nil {
+		wh.logger.Warn("Failed to release activity task concurrency slot.",
+			tag.WorkflowTaskQueueName(request.GetTaskQueuePartition().GetTaskQueue()),
+			tag.Error(err))
+	}
+}
+
 // QueryWorkflow returns query result for a specified workflow execution
 func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflowservice.QueryWorkflowRequest) (_ *workflowservice.QueryWorkflowResponse, retError error) {
 	defer log.CapturePanic(wh.logger, &retError)
@@ -5653,6 +5779,15 @@ func (wh *WorkflowHandler) StartBatchOperation(
 		return nil, err
 	}
 
+	if q := request.GetVisibilityQuery(); len(q) > 0 {
+		// Resolve the saved view now, so that the batch isn't affected by later changes to the view.
+		visibilityQuery, err := resolveVisibilityViewQuery(wh.namespaceRegistry, namespace.Name(request.GetNamespace()), q)
+		if err != nil {
+			return nil, err
+		}
+		request.VisibilityQuery = visibilityQuery
+	}
+
 	// Validate visibility query syntax before starting the batch workflow.
 	// Malformed queries (e.g. "()") would otherwise cause the batch activity
 	// to retry indefinitely since the error is not marked non-retryable.
@@ -65,6 +65,7 @@ import (
 	"go.temporal.io/server/common/searchattribute/sadefs"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/testing/protoassert"
+	"go.temporal.io/server/common/testing/protomock"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/service/history/api"
@@ -4567,6 +4568,110 @@ func (s *WorkflowHandlerSuite) TestShutdownWorkerWithPartialCancellationFailure(
 	s.NoError(err, "ShutdownWorker should succeed even with partial cancellation failures")
 }
 
+func (s *WorkflowHandlerSuite) TestRespondActivityTaskCompletedById_ReleasesConcurrencySlot() {
+	config := s.newConfig()
+	config.FairnessKeyConcurrencyLimit = dc.GetIntPropertyFnFilteredByTaskQueue(10)
+	config.NumTaskQueueReadPartitions = dc.GetIntPropertyFnFilteredByTaskQueue(2) // 2 partitions
+	wh := s.getWorkflowHandler(config)
+	ctx := context.Background()
+
+	taskQueue := "my-task-queue"
+	workflowID := "workflow-id"
+	runID := "run-id"
+	activityID := "activity-id"
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Eq(s.testNamespace)).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.testNamespace.String()}, nil, ""), nil).AnyTimes()
+
+	// The started attempt is looked up before history records the completion.
+	gomock.InOrder(
+		s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(
+			&historyservice.DescribeMutableStateResponse{
+				DatabaseMutableState: &persistencespb.WorkflowMutableState{
+					ExecutionState: &persistencespb.WorkflowExecutionState{RunId: runID},
+					ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+						5: {ActivityId: "other-activity-id", ScheduledEventId: 5, StartedEventId: 6, Attempt: 1, TaskQueue: taskQueue},
+						7: {ActivityId: activityID, ScheduledEventId: 7, StartedEventId: common.TransientEventID, Attempt: 3, TaskQueue: taskQueue},
+					},
+				},
+			}, nil),
+		s.mockHistoryClient.EXPECT().RespondActivityTaskCompleted(gomock.Any(), gomock.Any()).Return(
+			&historyservice.RespondActivityTaskCompletedResponse{}, nil),
+	)
+	// Released in every read partition.
+	for partitionID := range 2 {
+		s.mockMatchingClient.EXPECT().ReleaseConcurrencySlot(gomock.Any(), protomock.Eq(&matchingservice.ReleaseConcurrencySlotRequest{
+			NamespaceId: s.testNamespaceID.String(),
+			TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     taskQueue,
+				TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+				PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(partitionID)},
+			},
+			RunId:            runID,
+			ScheduledEventId: 7,
+			Attempt:          3,
+		})).Return(&matchingservice.ReleaseConcurrencySlotResponse{}, nil)
+	}
+
+	_, err := wh.RespondActivityTaskCompletedById(ctx, &workflowservice.RespondActivityTaskCompletedByIdRequest{
+		Namespace:  s.testNamespace.String(),
+		WorkflowId: workflowID,
+		ActivityId: activityID,
+	})
+	s.NoError(err)
+}
+
+func (s *WorkflowHandlerSuite) TestRespondActivityTaskCompletedById_KeepsConcurrencySlotOnFailure() {
+	config := s.newConfig()
+	config.FairnessKeyConcurrencyLimit = dc.GetIntPropertyFnFilteredByTaskQueue(10)
+	wh := s.getWorkflowHandler(config)
+	ctx := context.Background()
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Eq(s.testNamespace)).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.testNamespace.String()}, nil, ""), nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(
+		&historyservice.DescribeMutableStateResponse{
+			DatabaseMutableState: &persistencespb.WorkflowMutableState{
+				ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "run-id"},
+				ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+					5: {ActivityId: "activity-id", ScheduledEventId: 5, StartedEventId: 6, Attempt: 1, TaskQueue: "my-task-queue"},
+				},
+			},
+		}, nil)
+	s.mockHistoryClient.EXPECT().RespondActivityTaskCompleted(gomock.Any(), gomock.Any()).Return(
+		nil, serviceerror.NewUnavailable("history unavailable"))
+	// The attempt is still outstanding, so no ReleaseConcurrencySlot call is expected.
+
+	_, err := wh.RespondActivityTaskCompletedById(ctx, &workflowservice.RespondActivityTaskCompletedByIdRequest{
+		Namespace:  s.testNamespace.String(),
+		WorkflowId: "workflow-id",
+		ActivityId: "activity-id",
+	})
+	var unavailable *serviceerror.Unavailable
+	s.ErrorAs(err, &unavailable)
+}
+
+func (s *WorkflowHandlerSuite) TestRespondActivityTaskCompletedById_NoConcurrencyLimit() {
+	wh := s.getWorkflowHandler(s.newConfig())
+	ctx := context.Background()
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Eq(s.testNamespace)).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.testNamespace.String()}, nil, ""), nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().RespondActivityTaskCompleted(gomock.Any(), gomock.Any()).Return(
+		&historyservice.RespondActivityTaskCompletedResponse{}, nil)
+	// Neither the DescribeMutableState lookup nor a ReleaseConcurrencySlot call is expected.
+
+	_, err := wh.RespondActivityTaskCompletedById(ctx, &workflowservice.RespondActivityTaskCompletedByIdRequest{
+		Namespace:  s.testNamespace.String(),
+		WorkflowId: "workflow-id",
+		ActivityId: "activity-id",
+	})
+	s.NoError(err)
+}
+
 func (s *WorkflowHandlerSuite) TestPatchSchedule_TriggerImmediatelyScheduledTime() {
 	config := s.newConfig()
 	config.EnableSchedules = dc.GetBoolPropertyFnFilteredByNamespace(true)
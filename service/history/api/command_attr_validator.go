@@ -16,6 +16,7 @@ import (
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/placement"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/priorities"
 	"go.temporal.io/server/common/retrypolicy"
@@ -123,6 +124,9 @@ func (v *CommandAttrValidator) ValidateActivityScheduleAttributes(
 	if err != nil {
 		return failedCause, err
 	}
+	if _, err := placement.ConstraintsFromHeader(attributes.GetHeader()); err != nil {
+		return failedCause, err
+	}
 
 	attributes.ScheduleToCloseTimeout = opts.ScheduleToCloseTimeout
 	attributes.ScheduleToStartTimeout = opts.ScheduleToStartTimeout
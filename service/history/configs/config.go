@@ -39,6 +39,7 @@ type Config struct {
 	VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableManualPagination        dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableUnifiedQueryConverter   dynamicconfig.BoolPropertyFn
+	VisibilityCountGroupByMaxGroups         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	VisibilityAllowList                     dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	SuppressErrorSetSystemSearchAttribute   dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
@@ -355,6 +356,8 @@ type Config struct {
 	VisibilityProcessorEnableCloseWorkflowCleanup         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityProcessorRelocateAttributesMinBlobSize      dynamicconfig.IntPropertyFnWithNamespaceFilter
 	VisibilityQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
+	VisibilityWatchBufferSize                             dynamicconfig.IntPropertyFn
+	VisibilityWatchTotalBufferSize                        dynamicconfig.IntPropertyFn
 
 	// Disable fetching memo and search attributes from visibility in the event that they were removed
 	// from the mutable state in the close execution visibility task clean up.
@@ -475,6 +478,7 @@ func NewConfig(
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
 		VisibilityEnableManualPagination:        dynamicconfig.VisibilityEnableManualPagination.Get(dc),
 		VisibilityEnableUnifiedQueryConverter:   dynamicconfig.VisibilityEnableUnifiedQueryConverter.Get(dc),
+		VisibilityCountGroupByMaxGroups:         dynamicconfig.VisibilityCountGroupByMaxGroups.Get(dc),
 		VisibilityAllowList:                     dynamicconfig.VisibilityAllowList.Get(dc),
 		SuppressErrorSetSystemSearchAttribute:   dynamicconfig.SuppressErrorSetSystemSearchAttribute.Get(dc),
 
@@ -740,6 +744,8 @@ func NewConfig(
 		VisibilityProcessorEnableCloseWorkflowCleanup:         dynamicconfig.VisibilityProcessorEnableCloseWorkflowCleanup.Get(dc),
 		VisibilityProcessorRelocateAttributesMinBlobSize:      dynamicconfig.VisibilityProcessorRelocateAttributesMinBlobSize.Get(dc),
 		VisibilityQueueMaxReaderCount:                         dynamicconfig.VisibilityQueueMaxReaderCount.Get(dc),
+		VisibilityWatchBufferSize:                             dynamicconfig.VisibilityWatchBufferSize.Get(dc),
+		VisibilityWatchTotalBufferSize:                        dynamicconfig.VisibilityWatchTotalBufferSize.Get(dc),
 
 		DisableFetchRelocatableAttributesFromVisibility: dynamicconfig.DisableFetchRelocatableAttributesFromVisibility.Get(dc),
 
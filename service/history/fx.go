@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/visibilitywatch"
 	"go.temporal.io/server/service/history/workflow"
 	"go.temporal.io/server/service/history/workflow/cache"
 	"go.temporal.io/server/service/worker/workerdeployment"
@@ -92,6 +93,7 @@ var Module = fx.Options(
 	service.PersistenceLazyLoadedServiceResolverModule,
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(EventNotifierProvider),
+	fx.Provide(VisibilityWatchHubProvider),
 	fx.Provide(HistoryEngineFactoryProvider),
 	fx.Provide(HandlerProvider),
 	fx.Provide(HistoryServiceServerProvider),
@@ -152,6 +154,7 @@ func HandlerProvider(args NewHandlerArgs) (*Handler, error) {
 	handler := &Handler{
 		status:                 common.DaemonStatusInitialized,
 		config:                 args.Config,
+		dynamicConfig:          args.DynamicConfig,
 		nexusCompletionHandler: args.NexusCompletionHandler,
 		tokenSerializer:        tasktoken.NewSerializer(),
 		deepHealthCheckHandler: deepHealthCheckHandler{
@@ -184,6 +187,7 @@ func HandlerProvider(args NewHandlerArgs) (*Handler, error) {
 		dlqMetricsEmitter:            args.DLQMetricsEmitter,
 		chasmEngine:                  args.ChasmEngine,
 		chasmRegistry:                args.ChasmRegistry,
+		visibilityWatchHub:           args.VisibilityWatchHub,
 		testHooks:                    args.TestHooks,
 
 		replicationTaskFetcherFactory:    args.ReplicationTaskFetcherFactory,
@@ -432,6 +436,7 @@ func VisibilityManagerProvider(
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
 		serviceConfig.VisibilityEnableUnifiedQueryConverter,
+		serviceConfig.VisibilityCountGroupByMaxGroups,
 		metricsHandler,
 		logger,
 		serializer,
@@ -462,6 +467,13 @@ func EventNotifierProvider(
 	)
 }
 
+func VisibilityWatchHubProvider(
+	timeSource clock.TimeSource,
+	config *configs.Config,
+) *visibilitywatch.Hub {
+	return visibilitywatch.NewHub(config.VisibilityWatchBufferSize(), config.VisibilityWatchTotalBufferSize(), timeSource)
+}
+
 func ServiceLifetimeHooks(lc fx.Lifecycle, svc *Service) {
 	lc.Append(fx.StartStopHook(svc.Start, svc.Stop))
 }
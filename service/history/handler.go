@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/common/contextutil"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -67,6 +68,7 @@ import (
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/visibilitywatch"
 	"go.uber.org/fx"
 	"google.golang.org/grpc/health"
 )
@@ -81,6 +83,7 @@ type (
 
 		tokenSerializer              *tasktoken.Serializer
 		config                       *configs.Config
+		dynamicConfig                *dynamicconfig.Collection
 		nexusCompletionHandler       *nexusoperations.CompletionHandler
 		eventNotifier                events.Notifier
 		deepHealthCheckHandler       deepHealthCheckHandler
@@ -105,6 +108,7 @@ type (
 		dlqMetricsEmitter            *persistence.DLQMetricsEmitter
 		chasmEngine                  chasm.Engine
 		chasmRegistry                *chasm.Registry
+		visibilityWatchHub           *visibilitywatch.Hub
 		nexusHandler                 nexus.Handler
 		testHooks                    testhooks.TestHooks
 
@@ -118,6 +122,7 @@ type (
 		fx.In
 
 		Config                       *configs.Config
+		DynamicConfig                *dynamicconfig.Collection
 		NexusCompletionHandler       *nexusoperations.CompletionHandler
 		Logger                       log.SnTaggedLogger
 		ThrottledLogger              log.ThrottledLogger
@@ -144,6 +149,7 @@ type (
 		DLQMetricsEmitter            *persistence.DLQMetricsEmitter
 		ChasmEngine                  chasm.Engine
 		ChasmRegistry                *chasm.Registry
+		VisibilityWatchHub           *visibilitywatch.Hub
 		TestHooks                    testhooks.TestHooks
 
 		ReplicationTaskFetcherFactory   replication.TaskFetcherFactory
@@ -213,6 +219,43 @@ func (h *Handler) DeepHealthCheck(
 	return h.deepHealthCheckHandler.DeepHealthCheck(ctx, time.Now())
 }
 
+// ExplainDynamicConfig explains how this host resolves a dynamic config setting.
+func (h *Handler) ExplainDynamicConfig(
+	_ context.Context,
+	request *historyservice.ExplainDynamicConfigRequest,
+) (*historyservice.ExplainDynamicConfigResponse, error) {
+	explanation, err := h.dynamicConfig.Explain(
+		dynamicconfig.MakeKey(request.GetKey()),
+		dynamicconfig.ConstraintsFromProto(request.GetConstraints()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.ExplainDynamicConfigResponse{
+		Explanation: explanation.ToProto(request.GetRolloutKey(), h.timeSource.Now()),
+	}, nil
+}
+
+// PollVisibilityWatchEvents long-polls the changes that the visibility queues of this host made to the visibility
+// records of a namespace.
+func (h *Handler) PollVisibilityWatchEvents(
+	ctx context.Context,
+	request *historyservice.PollVisibilityWatchEventsRequest,
+) (*historyservice.PollVisibilityWatchEventsResponse, error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+	namespaceEntry, err := h.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.config.LongPollExpirationInterval(namespaceEntry.Name().String()))
+	defer cancel()
+	return h.visibilityWatchHub.Poll(ctx, request), nil
+}
+
 // IsWorkflowTaskValid - whether workflow task is still valid
 func (h *Handler) IsWorkflowTaskValid(ctx context.Context, request *historyservice.IsWorkflowTaskValidRequest) (*historyservice.IsWorkflowTaskValidResponse, error) {
 
@@ -1727,6 +1770,34 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+func (h *Handler) RebuildWorkflowVisibility(
+	ctx context.Context,
+	request *historyservice.RebuildWorkflowVisibilityRequest,
+) (*historyservice.RebuildWorkflowVisibilityResponse, error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+	if request.GetExecution().GetWorkflowId() == "" {
+		return nil, h.convertError(errWorkflowExecutionNotSet)
+	}
+
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.RebuildWorkflowVisibility(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
 func (h *Handler) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -1020,6 +1020,20 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	)
 }
 
+func (e *historyEngineImpl) RebuildWorkflowVisibility(
+	ctx context.Context,
+	request *historyservice.RebuildWorkflowVisibilityRequest,
+) (_ *historyservice.RebuildWorkflowVisibilityResponse, retError error) {
+	return rebuildWorkflowVisibility(
+		ctx,
+		e.shardContext,
+		e.workflowConsistencyChecker,
+		e.persistenceVisibilityMgr,
+		e.config,
+		request,
+	)
+}
+
 func (e *historyEngineImpl) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -568,6 +568,21 @@ func (mr *MockEngineMockRecorder) RebuildMutableState(ctx, namespaceUUID, execut
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockEngine)(nil).RebuildMutableState), ctx, namespaceUUID, execution)
 }
 
+// RebuildWorkflowVisibility mocks base method.
+func (m *MockEngine) RebuildWorkflowVisibility(ctx context.Context, request *historyservice.RebuildWorkflowVisibilityRequest) (*historyservice.RebuildWorkflowVisibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildWorkflowVisibility", ctx, request)
+	ret0, _ := ret[0].(*historyservice.RebuildWorkflowVisibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildWorkflowVisibility indicates an expected call of RebuildWorkflowVisibility.
+func (mr *MockEngineMockRecorder) RebuildWorkflowVisibility(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildWorkflowVisibility", reflect.TypeOf((*MockEngine)(nil).RebuildWorkflowVisibility), ctx, request)
+}
+
 // RecordActivityTaskHeartbeat mocks base method.
 func (m *MockEngine) RecordActivityTaskHeartbeat(ctx context.Context, request *historyservice.RecordActivityTaskHeartbeatRequest) (*historyservice.RecordActivityTaskHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
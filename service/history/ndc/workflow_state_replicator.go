@@ -1278,6 +1278,7 @@ func (r *WorkflowStateReplicatorImpl) bringLocalEventsUpToSourceCurrentBranch(
 
 			_, err = r.executionMgr.AppendRawHistoryNodes(ctx, &persistence.AppendRawHistoryNodesRequest{
 				ShardID:           r.shardContext.GetShardID(),
+				NamespaceID:       namespaceID.String(),
 				IsNewBranch:       isNewBranch,
 				BranchToken:       versionHistoryToAppend.BranchToken,
 				History:           historyBlob.rawHistory,
@@ -1348,6 +1349,7 @@ func (r *WorkflowStateReplicatorImpl) bringLocalEventsUpToSourceCurrentBranch(
 		}
 		_, err = r.executionMgr.AppendRawHistoryNodes(ctx, &persistence.AppendRawHistoryNodesRequest{
 			ShardID:           r.shardContext.GetShardID(),
+			NamespaceID:       namespaceID.String(),
 			IsNewBranch:       isNewBranch,
 			BranchToken:       versionHistoryToAppend.BranchToken,
 			History:           eventBlobs[i],
@@ -1827,6 +1829,7 @@ BackfillLoop:
 		}
 		_, err = r.executionMgr.AppendRawHistoryNodes(ctx, &persistence.AppendRawHistoryNodesRequest{
 			ShardID:           r.shardContext.GetShardID(),
+			NamespaceID:       namespaceID.String(),
 			IsNewBranch:       prevBranchID != branchID,
 			BranchToken:       filteredHistoryBranch,
 			History:           historyBlob.rawHistory,
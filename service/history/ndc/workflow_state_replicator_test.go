@@ -1202,6 +1202,7 @@ func (s *workflowReplicatorSuite) Test_bringLocalEventsUpToSourceCurrentBranch_W
 	}, nil)
 	s.mockExecutionManager.EXPECT().AppendRawHistoryNodes(gomock.Any(), &persistence.AppendRawHistoryNodesRequest{
 		ShardID:           mockShard.GetShardID(),
+		NamespaceID:       namespaceID,
 		IsNewBranch:       false,
 		BranchToken:       localVersionHistoryies.Histories[0].BranchToken,
 		History:           gapBlobs,
@@ -1212,6 +1213,7 @@ func (s *workflowReplicatorSuite) Test_bringLocalEventsUpToSourceCurrentBranch_W
 	}).Return(nil, nil).Times(1)
 	s.mockExecutionManager.EXPECT().AppendRawHistoryNodes(gomock.Any(), &persistence.AppendRawHistoryNodesRequest{
 		ShardID:           mockShard.GetShardID(),
+		NamespaceID:       namespaceID,
 		IsNewBranch:       false,
 		BranchToken:       localVersionHistoryies.Histories[0].BranchToken,
 		History:           blobs,
@@ -1222,6 +1224,7 @@ func (s *workflowReplicatorSuite) Test_bringLocalEventsUpToSourceCurrentBranch_W
 	}).Return(nil, nil).Times(1)
 	s.mockExecutionManager.EXPECT().AppendRawHistoryNodes(gomock.Any(), &persistence.AppendRawHistoryNodesRequest{
 		ShardID:           mockShard.GetShardID(),
+		NamespaceID:       namespaceID,
 		IsNewBranch:       false,
 		BranchToken:       localVersionHistoryies.Histories[0].BranchToken,
 		History:           tailBlobs,
@@ -1365,6 +1368,7 @@ func (s *workflowReplicatorSuite) Test_bringLocalEventsUpToSourceCurrentBranch_W
 	}, nil)
 	s.mockExecutionManager.EXPECT().AppendRawHistoryNodes(gomock.Any(), &persistence.AppendRawHistoryNodesRequest{
 		ShardID:           mockShard.GetShardID(),
+		NamespaceID:       namespaceID,
 		IsNewBranch:       true,
 		BranchToken:       localVersionHistories.Histories[0].BranchToken,
 		History:           gapBlobs,
@@ -1375,6 +1379,7 @@ func (s *workflowReplicatorSuite) Test_bringLocalEventsUpToSourceCurrentBranch_W
 	}).Return(nil, nil).Times(1)
 	s.mockExecutionManager.EXPECT().AppendRawHistoryNodes(gomock.Any(), &persistence.AppendRawHistoryNodesRequest{
 		ShardID:           mockShard.GetShardID(),
+		NamespaceID:       namespaceID,
 		IsNewBranch:       false,
 		BranchToken:       localVersionHistories.Histories[0].BranchToken,
 		History:           blobs,
@@ -1385,6 +1390,7 @@ func (s *workflowReplicatorSuite) Test_bringLocalEventsUpToSourceCurrentBranch_W
 	}).Return(nil, nil).Times(1)
 	s.mockExecutionManager.EXPECT().AppendRawHistoryNodes(gomock.Any(), &persistence.AppendRawHistoryNodesRequest{
 		ShardID:           mockShard.GetShardID(),
+		NamespaceID:       namespaceID,
 		IsNewBranch:       false,
 		BranchToken:       localVersionHistories.Histories[0].BranchToken,
 		History:           tailBlobs,
@@ -1553,6 +1559,7 @@ func (s *workflowReplicatorSuite) Test_bringLocalEventsUpToSourceCurrentBranch_W
 	}, nil)
 	s.mockExecutionManager.EXPECT().AppendRawHistoryNodes(gomock.Any(), &persistence.AppendRawHistoryNodesRequest{
 		ShardID:           mockShard.GetShardID(),
+		NamespaceID:       namespaceID,
 		IsNewBranch:       false,
 		BranchToken:       localVersionHistoryies.Histories[0].BranchToken,
 		History:           gapBlobs,
@@ -1563,6 +1570,7 @@ func (s *workflowReplicatorSuite) Test_bringLocalEventsUpToSourceCurrentBranch_W
 	}).Return(nil, nil).Times(1)
 	s.mockExecutionManager.EXPECT().AppendRawHistoryNodes(gomock.Any(), &persistence.AppendRawHistoryNodesRequest{
 		ShardID:           mockShard.GetShardID(),
+		NamespaceID:       namespaceID,
 		IsNewBranch:       false,
 		BranchToken:       localVersionHistoryies.Histories[0].BranchToken,
 		History:           blobs,
@@ -151,6 +151,7 @@ type (
 		activityTaskScheduleToStartTimeout time.Duration
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		placementConstraints               map[string]string
 	}
 
 	verifyCompletionRecordedPostActionInfo struct {
@@ -184,6 +185,7 @@ func newActivityTaskPostActionInfo(
 		activityTaskScheduleToStartTimeout: activityInfo.ScheduleToStartTimeout.AsDuration(),
 		versionDirective:                   directive,
 		priority:                           priority,
+		placementConstraints:               activityInfo.PlacementConstraints,
 	}, nil
 }
 
@@ -201,6 +203,7 @@ func newActivityRetryTimePostActionInfo(
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
 		versionDirective:                   directive,
 		priority:                           priority,
+		placementConstraints:               activityInfo.PlacementConstraints,
 	}, nil
 }
 
@@ -28,6 +28,7 @@ import (
 	"go.temporal.io/server/service/history/replication/eventhandler"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/visibilitywatch"
 	"go.temporal.io/server/service/history/workflow"
 	"go.temporal.io/server/service/history/workflow/cache"
 	"go.uber.org/fx"
@@ -161,4 +162,5 @@ type unusedDependencies struct {
 	chasm.Engine
 	ChasmRegistry *chasm.Registry
 	worker_versioning.VersionMembershipAndReactivationStatusCache
+	VisibilityWatchHub *visibilitywatch.Hub
 }
@@ -105,6 +105,7 @@ func (e *eagerNamespaceRefresherImpl) SyncNamespaceFromSourceCluster(
 		ConfigVersion:      resp.GetConfigVersion(),
 		FailoverVersion:    resp.GetFailoverVersion(),
 		FailoverHistory:    resp.GetFailoverHistory(),
+		VisibilityViews:    resp.GetVisibilityViews(),
 	}
 	err = e.replicationTaskExecutor.Execute(ctx, task)
 	if err != nil {
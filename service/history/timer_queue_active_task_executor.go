@@ -601,6 +601,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	directive := MakeDirectiveForActivityTask(mutableState, activityInfo)
 	useWfBuildId := activityInfo.GetUseWorkflowBuildIdInfo() != nil
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, activityInfo.Priority)
+	placementConstraints := activityInfo.PlacementConstraints
 
 	// NOTE: do not access anything related mutable state after this lock release
 	release(nil) // release earlier as we don't need the lock anymore
@@ -618,6 +619,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		VersionDirective:       directive,
 		Stamp:                  task.Stamp,
 		Priority:               priority,
+		PlacementConstraints:   placementConstraints,
 	})
 	if err != nil {
 		return err
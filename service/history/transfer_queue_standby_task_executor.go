@@ -634,6 +634,7 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		pushActivityInfo.activityTaskScheduleToStartTimeout,
 		pushActivityInfo.versionDirective,
 		pushActivityInfo.priority,
+		pushActivityInfo.placementConstraints,
 		historyi.TransactionPolicyPassive,
 	)
 }
@@ -10,6 +10,7 @@ import (
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/visibilitywatch"
 	"go.uber.org/fx"
 )
 
@@ -23,7 +24,8 @@ type (
 
 		QueueFactoryBaseParams
 
-		VisibilityMgr manager.VisibilityManager
+		VisibilityMgr      manager.VisibilityManager
+		VisibilityWatchHub *visibilitywatch.Hub
 	}
 
 	visibilityQueueFactory struct {
@@ -107,6 +109,7 @@ func (f *visibilityQueueFactory) CreateQueue(
 		shard,
 		f.WorkflowCache,
 		f.VisibilityMgr,
+		f.VisibilityWatchHub,
 		logger,
 		f.MetricsHandler,
 		f.Config.VisibilityProcessorEnsureCloseBeforeDelete,
@@ -8,6 +8,8 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
@@ -25,7 +27,10 @@ import (
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/visibilitywatch"
 	wcache "go.temporal.io/server/service/history/workflow/cache"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -35,6 +40,7 @@ type (
 		logger         log.Logger
 		metricProvider metrics.Handler
 		visibilityMgr  manager.VisibilityManager
+		watchHub       *visibilitywatch.Hub
 
 		ensureCloseBeforeDelete       dynamicconfig.BoolPropertyFn
 		enableCloseWorkflowCleanup    dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -49,6 +55,7 @@ func newVisibilityQueueTaskExecutor(
 	shardContext historyi.ShardContext,
 	workflowCache wcache.Cache,
 	visibilityMgr manager.VisibilityManager,
+	watchHub *visibilitywatch.Hub,
 	logger log.Logger,
 	metricProvider metrics.Handler,
 	ensureCloseBeforeDelete dynamicconfig.BoolPropertyFn,
@@ -62,6 +69,7 @@ func newVisibilityQueueTaskExecutor(
 		logger:         logger,
 		metricProvider: metricProvider,
 		visibilityMgr:  visibilityMgr,
+		watchHub:       watchHub,
 
 		ensureCloseBeforeDelete:       ensureCloseBeforeDelete,
 		enableCloseWorkflowCleanup:    enableCloseWorkflowCleanup,
@@ -176,12 +184,19 @@ func (t *visibilityQueueTaskExecutor) processStartExecution(
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.visibilityMgr.RecordWorkflowExecutionStarted(
+	err = t.visibilityMgr.RecordWorkflowExecutionStarted(
 		ctx,
 		&manager.RecordWorkflowExecutionStartedRequest{
 			VisibilityRequestBase: requestBase,
 		},
 	)
+	if err != nil {
+		return err
+	}
+	t.watchHub.Publish(requestBase.NamespaceID, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, func() *workflowpb.WorkflowExecutionInfo {
+		return getWatchExecutionInfo(requestBase)
+	})
+	return nil
 }
 
 func (t *visibilityQueueTaskExecutor) processUpsertExecution(
@@ -224,12 +239,19 @@ func (t *visibilityQueueTaskExecutor) processUpsertExecution(
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.visibilityMgr.UpsertWorkflowExecution(
+	err = t.visibilityMgr.UpsertWorkflowExecution(
 		ctx,
 		&manager.UpsertWorkflowExecutionRequest{
 			VisibilityRequestBase: requestBase,
 		},
 	)
+	if err != nil {
+		return err
+	}
+	t.watchHub.Publish(requestBase.NamespaceID, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_UPDATED, func() *workflowpb.WorkflowExecutionInfo {
+		return getWatchExecutionInfo(requestBase)
+	})
+	return nil
 }
 
 func (t *visibilityQueueTaskExecutor) processCloseExecution(
@@ -289,6 +311,15 @@ func (t *visibilityQueueTaskExecutor) processCloseExecution(
 	if err != nil {
 		return err
 	}
+	t.watchHub.Publish(requestBase.NamespaceID, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_CLOSED, func() *workflowpb.WorkflowExecutionInfo {
+		executionInfo := getWatchExecutionInfo(requestBase)
+		executionInfo.CloseTime = timestamppb.New(closedRequest.CloseTime)
+		executionInfo.ExecutionDuration = durationpb.New(closedRequest.ExecutionDuration)
+		executionInfo.HistoryLength = closedRequest.HistoryLength
+		executionInfo.HistorySizeBytes = closedRequest.HistorySizeBytes
+		executionInfo.StateTransitionCount = closedRequest.StateTransitionCount
+		return executionInfo
+	})
 
 	// Elasticsearch bulk processor doesn't respect context timeout
 	// because under heavy load bulk flush might take longer than taskTimeout.
@@ -663,6 +694,23 @@ func (t *visibilityQueueTaskExecutor) cleanupExecutionInfo(
 	return weContext.SetWorkflowExecution(ctx, t.shardContext)
 }
 
+// getWatchExecutionInfo converts a visibility request to the record that is pushed to visibility watchers. Search
+// attributes are keyed by field name.
+func getWatchExecutionInfo(request *manager.VisibilityRequestBase) *workflowpb.WorkflowExecutionInfo {
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution:        request.Execution,
+		Type:             &commonpb.WorkflowType{Name: request.WorkflowTypeName},
+		StartTime:        timestamppb.New(request.StartTime),
+		Status:           request.Status,
+		ParentExecution:  request.ParentExecution,
+		ExecutionTime:    timestamppb.New(request.ExecutionTime),
+		Memo:             request.Memo,
+		SearchAttributes: request.SearchAttributes,
+		TaskQueue:        request.TaskQueue,
+		RootExecution:    request.RootExecution,
+	}
+}
+
 func getWorkflowMemo(
 	memoFields map[string]*commonpb.Payload,
 ) *commonpb.Memo {
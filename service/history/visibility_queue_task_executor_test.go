@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/visibilitywatch"
 	"go.temporal.io/server/service/history/workflow"
 	wcache "go.temporal.io/server/service/history/workflow/cache"
 	"go.uber.org/mock/gomock"
@@ -72,6 +73,7 @@ type (
 		now                         time.Time
 		timeSource                  *clock.EventTimeSource
 		visibilityQueueTaskExecutor queues.Executor
+		watchHub                    *visibilitywatch.Hub
 
 		enableCloseWorkflowCleanup bool
 	}
@@ -175,10 +177,17 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 	s.mockShard.SetEngineForTesting(h)
 
 	s.enableCloseWorkflowCleanup = false
+	s.watchHub = visibilitywatch.NewHub(10, 100, s.timeSource)
+	// Events are only kept for watched namespaces.
+	s.watchHub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{
+		NamespaceId:   s.namespaceID.String(),
+		StartAtLatest: true,
+	})
 	s.visibilityQueueTaskExecutor = newVisibilityQueueTaskExecutor(
 		s.mockShard,
 		s.workflowCache,
 		s.mockVisibilityMgr,
+		s.watchHub,
 		s.logger,
 		metrics.NoopMetricsHandler,
 		config.VisibilityProcessorEnsureCloseBeforeDelete,
@@ -275,6 +284,16 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessCloseExecution() {
 
 	resp := s.visibilityQueueTaskExecutor.Execute(context.Background(), s.newTaskExecutable(visibilityTask))
 	s.NoError(resp.ExecutionErr)
+
+	watchEvents := s.pollWatchEvents()
+	s.Len(watchEvents, 1)
+	s.Equal(enumsspb.VISIBILITY_WATCH_EVENT_TYPE_CLOSED, watchEvents[0].GetEventType())
+	executionInfo := watchEvents[0].GetExecutionInfo()
+	s.Equal(execution.GetRunId(), executionInfo.GetExecution().GetRunId())
+	s.Equal(workflowType, executionInfo.GetType().GetName())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, executionInfo.GetStatus())
+	s.NotNil(executionInfo.GetCloseTime())
+	s.Equal(mutableState.GetNextEventID()-1, executionInfo.GetHistoryLength())
 }
 
 func (s *visibilityQueueTaskExecutorSuite) TestProcessCloseExecutionWithWorkflowClosedCleanup() {
@@ -416,6 +435,13 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessRecordWorkflowStartedTask(
 
 	resp := s.visibilityQueueTaskExecutor.Execute(context.Background(), s.newTaskExecutable(visibilityTask))
 	s.NoError(resp.ExecutionErr)
+
+	watchEvents := s.pollWatchEvents()
+	s.Len(watchEvents, 1)
+	s.Equal(enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, watchEvents[0].GetEventType())
+	s.Equal(execution.GetRunId(), watchEvents[0].GetExecutionInfo().GetExecution().GetRunId())
+	s.Equal(taskQueueName, watchEvents[0].GetExecutionInfo().GetTaskQueue())
+	s.Nil(watchEvents[0].GetExecutionInfo().GetCloseTime())
 }
 
 func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttributes() {
@@ -803,6 +829,14 @@ func (s *visibilityQueueTaskExecutorSuite) buildChasmVisTask(
 	}
 }
 
+func (s *visibilityQueueTaskExecutorSuite) pollWatchEvents() []*historyservice.VisibilityWatchEvent {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	return s.watchHub.Poll(ctx, &historyservice.PollVisibilityWatchEventsRequest{
+		NamespaceId: s.namespaceID.String(),
+	}).GetEvents()
+}
+
 func (s *visibilityQueueTaskExecutorSuite) execute(task tasks.Task) error {
 	return s.visibilityQueueTaskExecutor.Execute(context.Background(), s.newTaskExecutable(task)).ExecutionErr
 }
@@ -0,0 +1,173 @@
+package history
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/configs"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+var errSecondaryVisibilityNotConfigured = serviceerror.NewFailedPrecondition("Secondary visibility store is not configured.")
+
+// rebuildWorkflowVisibility regenerates the visibility record of a workflow execution from its mutable state, the same
+// way the visibility queue does for upsert and close tasks, and writes it to the requested store.
+func rebuildWorkflowVisibility(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	visibilityMgr manager.VisibilityManager,
+	config *configs.Config,
+	request *historyservice.RebuildWorkflowVisibilityRequest,
+) (_ *historyservice.RebuildWorkflowVisibilityResponse, retError error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+	targetMgr, sourceMgr, err := getRebuildVisibilityManagers(visibilityMgr, request.GetVisibilityStore())
+	if err != nil {
+		return nil, err
+	}
+	namespaceEntry, err := shardContext.GetNamespaceRegistry().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			namespaceID.String(),
+			request.GetExecution().GetWorkflowId(),
+			request.GetExecution().GetRunId(),
+		),
+		locks.PriorityLow,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+	mutableState := workflowLease.GetMutableState()
+
+	// The task ID is the record version in Elasticsearch. It's generated while the workflow lock is held, so any
+	// visibility task created by a later update has a higher version and isn't rejected.
+	taskID, err := shardContext.GenerateTaskID()
+	if err != nil {
+		return nil, err
+	}
+	task := &tasks.UpsertExecutionVisibilityTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			namespaceID.String(),
+			mutableState.GetExecutionInfo().GetWorkflowId(),
+			mutableState.GetExecutionState().GetRunId(),
+		),
+		TaskID: taskID,
+	}
+	executor := &visibilityQueueTaskExecutor{
+		shardContext:            shardContext,
+		externalPayloadsEnabled: config.ExternalPayloadsEnabled,
+	}
+	executionInfo := mutableState.GetExecutionInfo()
+	requestBase := executor.getVisibilityRequestBase(
+		task,
+		namespaceEntry,
+		mutableState,
+		executionInfo.Memo,
+		executionInfo.SearchAttributes,
+	)
+
+	if mutableState.IsWorkflowExecutionRunning() {
+		// NOTE: do not access anything related mutable state after this lock release.
+		workflowLease.GetReleaseFn()(nil)
+		if err := targetMgr.UpsertWorkflowExecution(ctx, &manager.UpsertWorkflowExecutionRequest{
+			VisibilityRequestBase: requestBase,
+		}); err != nil {
+			return nil, err
+		}
+		return &historyservice.RebuildWorkflowVisibilityResponse{}, nil
+	}
+
+	closedRequest, err := executor.getClosedVisibilityRequest(ctx, requestBase, mutableState, namespaceEntry)
+	if err != nil {
+		return nil, err
+	}
+	var attributesMissing bool
+	if executionInfo.GetRelocatableAttributesRemoved() {
+		// The close visibility task already moved memo and search attributes out of mutable state. They're never
+		// updated after that, so read them back from a store other than the one being rebuilt when there is one.
+		attributes, err := workflow.RelocatableAttributesFetcherProvider(config, sourceMgr).Fetch(ctx, mutableState)
+		switch err.(type) {
+		case nil:
+			closedRequest.Memo = attributes.Memo
+			closedRequest.SearchAttributes = mergeSearchAttributes(
+				attributes.SearchAttributes,
+				closedRequest.SearchAttributes,
+			)
+		case *serviceerror.NotFound:
+			attributesMissing = true
+		default:
+			return nil, err
+		}
+	}
+
+	// NOTE: do not access anything related mutable state after this lock release.
+	workflowLease.GetReleaseFn()(nil)
+	if err := targetMgr.RecordWorkflowExecutionClosed(ctx, closedRequest); err != nil {
+		return nil, err
+	}
+	return &historyservice.RebuildWorkflowVisibilityResponse{
+		Closed:                       true,
+		RelocatableAttributesMissing: attributesMissing,
+	}, nil
+}
+
+// getRebuildVisibilityManagers returns the manager to write rebuilt records to, and the manager to read relocated
+// attributes from.
+func getRebuildVisibilityManagers(
+	visibilityMgr manager.VisibilityManager,
+	target enumsspb.VisibilityStoreTarget,
+) (manager.VisibilityManager, manager.VisibilityManager, error) {
+	dual, isDual := visibilityMgr.(*visibility.VisibilityManagerDual)
+	switch target {
+	case enumsspb.VISIBILITY_STORE_TARGET_PRIMARY:
+		if !isDual {
+			return visibilityMgr, visibilityMgr, nil
+		}
+		return dual.GetPrimaryVisibility(), dual.GetSecondaryVisibility(), nil
+	case enumsspb.VISIBILITY_STORE_TARGET_SECONDARY:
+		if !isDual {
+			return nil, nil, errSecondaryVisibilityNotConfigured
+		}
+		return dual.GetSecondaryVisibility(), dual.GetPrimaryVisibility(), nil
+	default:
+		return visibilityMgr, visibilityMgr, nil
+	}
+}
+
+// mergeSearchAttributes adds the search attributes computed at close time to the relocated ones.
+func mergeSearchAttributes(
+	relocated *commonpb.SearchAttributes,
+	computed *commonpb.SearchAttributes,
+) *commonpb.SearchAttributes {
+	result := &commonpb.SearchAttributes{
+		IndexedFields: copyMapPayload(relocated.GetIndexedFields()),
+	}
+	if result.IndexedFields == nil {
+		result.IndexedFields = make(map[string]*commonpb.Payload, len(computed.GetIndexedFields()))
+	}
+	for name, value := range computed.GetIndexedFields() {
+		result.IndexedFields[name] = value
+	}
+	return result
+}
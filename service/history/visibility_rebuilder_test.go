@@ -0,0 +1,140 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/workflow"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func (s *visibilityQueueTaskExecutorSuite) TestRebuildWorkflowVisibility_Running() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.NewString(),
+	}
+	mutableState := s.newRebuildMutableState(execution)
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, wt.ScheduledEventID, wt.Version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.UpsertWorkflowExecutionRequest) error {
+			s.Equal(execution.GetWorkflowId(), request.Execution.GetWorkflowId())
+			s.Equal(execution.GetRunId(), request.Execution.GetRunId())
+			s.Equal("some random workflow type", request.WorkflowTypeName)
+			s.Positive(request.TaskID)
+			return nil
+		})
+
+	resp, err := s.rebuildWorkflowVisibility(s.mockVisibilityMgr, execution, enumsspb.VISIBILITY_STORE_TARGET_UNSPECIFIED)
+	s.NoError(err)
+	s.False(resp.GetClosed())
+}
+
+func (s *visibilityQueueTaskExecutorSuite) TestRebuildWorkflowVisibility_ClosedWithRelocatedAttributes() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.NewString(),
+	}
+	mutableState := s.newRebuildMutableState(execution)
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, "some random task queue", uuid.NewString())
+	wt.StartedEventID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+	event = addCompleteWorkflowEvent(mutableState, event.GetEventId(), nil)
+	mutableState.GetExecutionInfo().RelocatableAttributesRemoved = true
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{"memo": payload.EncodeString("value")}}
+	searchAttributes := &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+		"CustomKeywordField": payload.EncodeString("value"),
+	}}
+	primary := manager.NewMockVisibilityManager(s.controller)
+	secondary := manager.NewMockVisibilityManager(s.controller)
+	primary.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&manager.GetWorkflowExecutionResponse{
+		Execution: &workflowpb.WorkflowExecutionInfo{Memo: memo, SearchAttributes: searchAttributes},
+	}, nil)
+	secondary.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.RecordWorkflowExecutionClosedRequest) error {
+			s.Equal(execution.GetRunId(), request.Execution.GetRunId())
+			s.Equal(memo, request.Memo)
+			s.Equal(searchAttributes.IndexedFields["CustomKeywordField"], request.SearchAttributes.IndexedFields["CustomKeywordField"])
+			s.False(request.CloseTime.IsZero())
+			return nil
+		})
+
+	resp, err := s.rebuildWorkflowVisibility(
+		visibility.NewVisibilityManagerDual(primary, secondary, nil, nil),
+		execution,
+		enumsspb.VISIBILITY_STORE_TARGET_SECONDARY,
+	)
+	s.NoError(err)
+	s.True(resp.GetClosed())
+	s.False(resp.GetRelocatableAttributesMissing())
+}
+
+func (s *visibilityQueueTaskExecutorSuite) TestRebuildWorkflowVisibility_SecondaryNotConfigured() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.NewString(),
+	}
+	_, err := s.rebuildWorkflowVisibility(s.mockVisibilityMgr, execution, enumsspb.VISIBILITY_STORE_TARGET_SECONDARY)
+	s.ErrorIs(err, errSecondaryVisibilityNotConfigured)
+}
+
+func (s *visibilityQueueTaskExecutorSuite) newRebuildMutableState(
+	execution *commonpb.WorkflowExecution,
+) historyi.MutableState {
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: "some random workflow type"},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: "some random task queue"},
+				WorkflowExecutionTimeout: durationpb.New(2 * time.Second),
+				WorkflowTaskTimeout:      durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.NoError(err)
+	return mutableState
+}
+
+func (s *visibilityQueueTaskExecutorSuite) rebuildWorkflowVisibility(
+	visibilityMgr manager.VisibilityManager,
+	execution *commonpb.WorkflowExecution,
+	target enumsspb.VisibilityStoreTarget,
+) (*historyservice.RebuildWorkflowVisibilityResponse, error) {
+	return rebuildWorkflowVisibility(
+		context.Background(),
+		s.mockShard,
+		api.NewWorkflowConsistencyChecker(s.mockShard, s.workflowCache),
+		visibilityMgr,
+		s.mockShard.GetConfig(),
+		&historyservice.RebuildWorkflowVisibilityRequest{
+			NamespaceId:     s.namespaceID.String(),
+			Execution:       execution,
+			VisibilityStore: target,
+		},
+	)
+}
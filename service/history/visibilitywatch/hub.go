@@ -0,0 +1,263 @@
+// Package visibilitywatch keeps a bounded, in-memory log of the changes that the visibility queues of a history host
+// make to visibility records, so that frontends can push them to watchers of a visibility query.
+package visibilitywatch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	workflowpb "go.temporal.io/api/workflow/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	defaultMaxEvents = 1000
+
+	// idleTimeout is how long the events of a namespace are kept after its last poll ended. It must be longer than
+	// the gap between the polls of a watcher.
+	idleTimeout = time.Minute
+)
+
+type (
+	// Hub is the visibility event log of a history host. Events are numbered with consecutive sequences starting at
+	// one across all namespaces. Events are only kept for namespaces that were polled in the last idleTimeout, and
+	// only the newest events of every namespace are kept, so that a busy namespace does not evict the events of the
+	// others. The log is identified by an epoch that changes every time the host restarts, so that a position read
+	// from a previous process is detected.
+	Hub struct {
+		epoch         int64
+		capacity      int
+		totalCapacity int
+		timeSource    clock.TimeSource
+
+		mu           sync.Mutex
+		namespaces   map[namespace.ID]*namespaceLog
+		totalEvents  int
+		lastSequence int64
+		// evictedSequence is the sequence of the newest event that was dropped or evicted for a namespace that has no
+		// log.
+		evictedSequence int64
+		lastSweepTime   time.Time
+		// notifyCh is closed when an event is published while pollers are waiting.
+		notifyCh chan struct{}
+		waiting  bool
+	}
+
+	// namespaceLog is the queue of the events of a watched namespace.
+	namespaceLog struct {
+		events []*historyservice.VisibilityWatchEvent
+		// evictedSequence is the sequence of the newest event that was evicted.
+		evictedSequence int64
+		activePolls     int
+		lastPollTime    time.Time
+	}
+)
+
+// NewHub creates a Hub that keeps up to capacity events per namespace, and up to totalCapacity events overall.
+func NewHub(capacity int, totalCapacity int, timeSource clock.TimeSource) *Hub {
+	now := timeSource.Now()
+	return &Hub{
+		epoch:         now.UnixNano(),
+		capacity:      max(capacity, 1),
+		totalCapacity: max(totalCapacity, 1),
+		timeSource:    timeSource,
+		namespaces:    make(map[namespace.ID]*namespaceLog),
+		lastSweepTime: now,
+		notifyCh:      make(chan struct{}),
+	}
+}
+
+// Publish appends a change to the log. newExecutionInfo is only called if the namespace is watched, and the returned
+// info must not be modified afterward.
+func (h *Hub) Publish(
+	namespaceID namespace.ID,
+	eventType enumsspb.VisibilityWatchEventType,
+	newExecutionInfo func() *workflowpb.WorkflowExecutionInfo,
+) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.timeSource.Now()
+	h.sweepLocked(now)
+
+	h.lastSequence++
+	nsLog, ok := h.namespaces[namespaceID]
+	if ok && !nsLog.isWatched(now) {
+		h.removeLocked(namespaceID, nsLog)
+		ok = false
+	}
+	if !ok {
+		// A watcher that starts polling the namespace later must resync to see this change.
+		h.evictedSequence = h.lastSequence
+		return
+	}
+
+	nsLog.events = append(nsLog.events, &historyservice.VisibilityWatchEvent{
+		Sequence:      h.lastSequence,
+		EventType:     eventType,
+		ExecutionInfo: newExecutionInfo(),
+	})
+	h.totalEvents++
+	if len(nsLog.events) > h.capacity {
+		nsLog.evictOldest()
+		h.totalEvents--
+	}
+	if h.totalEvents > h.totalCapacity {
+		h.evictFromLargestLocked()
+	}
+
+	if h.waiting {
+		close(h.notifyCh)
+		h.notifyCh = make(chan struct{})
+		h.waiting = false
+	}
+}
+
+// Poll returns the events of the namespace after the requested position. If there are none, it waits until one is
+// published or ctx is done, in which case an empty response with the current position is returned. Events of the
+// namespace are kept from the first poll until idleTimeout after the last one.
+func (h *Hub) Poll(
+	ctx context.Context,
+	request *historyservice.PollVisibilityWatchEventsRequest,
+) *historyservice.PollVisibilityWatchEventsResponse {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	maxEvents := int(request.GetMaxEvents())
+	if maxEvents <= 0 {
+		maxEvents = defaultMaxEvents
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.sweepLocked(h.timeSource.Now())
+	nsLog, ok := h.namespaces[namespaceID]
+	if !ok {
+		nsLog = &namespaceLog{evictedSequence: h.evictedSequence}
+		h.namespaces[namespaceID] = nsLog
+	}
+	nsLog.activePolls++
+	defer func() {
+		nsLog.activePolls--
+		nsLog.lastPollTime = h.timeSource.Now()
+	}()
+
+	resp := &historyservice.PollVisibilityWatchEventsResponse{Epoch: h.epoch}
+	lastSequence := request.GetLastSequence()
+	if request.GetEpoch() == 0 {
+		if request.GetStartAtLatest() {
+			resp.LastSequence = h.lastSequence
+			return resp
+		}
+		lastSequence = 0
+	} else if request.GetEpoch() != h.epoch {
+		lastSequence = -1
+	}
+
+	for {
+		if !h.isAvailableLocked(nsLog, lastSequence) {
+			// Continue after the newest event: the watcher lists executions again, so replaying older events
+			// could overwrite newer records.
+			resp.ResyncRequired = true
+			resp.LastSequence = h.lastSequence
+			return resp
+		}
+
+		resp.LastSequence, resp.Events = h.readLocked(nsLog, lastSequence, maxEvents)
+		if len(resp.Events) > 0 {
+			return resp
+		}
+		lastSequence = resp.LastSequence
+
+		h.waiting = true
+		notifyCh := h.notifyCh
+		h.mu.Unlock()
+		select {
+		case <-notifyCh:
+		case <-ctx.Done():
+			h.mu.Lock()
+			return resp
+		}
+		h.mu.Lock()
+	}
+}
+
+// isAvailableLocked returns true if all events of the namespace after lastSequence are still in the log.
+func (h *Hub) isAvailableLocked(nsLog *namespaceLog, lastSequence int64) bool {
+	if lastSequence < 0 || lastSequence > h.lastSequence {
+		return false
+	}
+	return lastSequence >= nsLog.evictedSequence
+}
+
+// readLocked returns up to maxEvents events of the namespace after lastSequence, and the sequence of the last event
+// that was read.
+func (h *Hub) readLocked(
+	nsLog *namespaceLog,
+	lastSequence int64,
+	maxEvents int,
+) (int64, []*historyservice.VisibilityWatchEvent) {
+	var events []*historyservice.VisibilityWatchEvent
+	for _, event := range nsLog.events {
+		if event.GetSequence() <= lastSequence {
+			continue
+		}
+		events = append(events, event)
+		if len(events) == maxEvents {
+			return event.GetSequence(), events
+		}
+	}
+	return h.lastSequence, events
+}
+
+// sweepLocked removes the logs of namespaces that are no longer watched, at most once per idleTimeout.
+func (h *Hub) sweepLocked(now time.Time) {
+	if now.Sub(h.lastSweepTime) < idleTimeout {
+		return
+	}
+	h.lastSweepTime = now
+	for namespaceID, nsLog := range h.namespaces {
+		if !nsLog.isWatched(now) {
+			h.removeLocked(namespaceID, nsLog)
+		}
+	}
+}
+
+// removeLocked removes the log of a namespace. Positions in the namespace from before the removal must resync.
+func (h *Hub) removeLocked(namespaceID namespace.ID, nsLog *namespaceLog) {
+	delete(h.namespaces, namespaceID)
+	h.totalEvents -= len(nsLog.events)
+	h.evictedSequence = max(h.evictedSequence, nsLog.evictedSequence)
+	if len(nsLog.events) > 0 {
+		h.evictedSequence = max(h.evictedSequence, nsLog.events[len(nsLog.events)-1].GetSequence())
+	}
+}
+
+// evictFromLargestLocked evicts the oldest event of the namespace with the most events.
+func (h *Hub) evictFromLargestLocked() {
+	var largest *namespaceLog
+	for _, nsLog := range h.namespaces {
+		if largest == nil || len(nsLog.events) > len(largest.events) {
+			largest = nsLog
+		}
+	}
+	if largest != nil && len(largest.events) > 0 {
+		largest.evictOldest()
+		h.totalEvents--
+	}
+}
+
+// isWatched returns true if the namespace is being polled, or was polled in the last idleTimeout.
+func (l *namespaceLog) isWatched(now time.Time) bool {
+	return l.activePolls > 0 || now.Sub(l.lastPollTime) < idleTimeout
+}
+
+// evictOldest removes the oldest event from the log.
+func (l *namespaceLog) evictOldest() {
+	l.evictedSequence = l.events[0].GetSequence()
+	l.events[0] = nil
+	l.events = l.events[1:]
+}
@@ -0,0 +1,256 @@
+package visibilitywatch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/namespace"
+)
+
+func newExecutionInfo(workflowID string) *workflowpb.WorkflowExecutionInfo {
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: workflowID + "-run"},
+	}
+}
+
+// watch starts watching the namespaces, so that their events are kept.
+func watch(hub *Hub, namespaceIDs ...string) {
+	for _, namespaceID := range namespaceIDs {
+		hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{
+			NamespaceId:   namespaceID,
+			StartAtLatest: true,
+		})
+	}
+}
+
+func publish(hub *Hub, namespaceID namespace.ID, eventType enumsspb.VisibilityWatchEventType, workflowID string) {
+	hub.Publish(namespaceID, eventType, func() *workflowpb.WorkflowExecutionInfo {
+		return newExecutionInfo(workflowID)
+	})
+}
+
+func workflowIDs(events []*historyservice.VisibilityWatchEvent) []string {
+	var ids []string
+	for _, event := range events {
+		ids = append(ids, event.GetExecutionInfo().GetExecution().GetWorkflowId())
+	}
+	return ids
+}
+
+func TestPoll_FiltersByNamespace(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(0, 42))
+	hub := NewHub(10, 100, timeSource)
+	watch(hub, "ns-1", "ns-2")
+	publish(hub, "ns-1", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "a")
+	publish(hub, "ns-2", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "b")
+	publish(hub, "ns-1", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_CLOSED, "a")
+
+	resp := hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{NamespaceId: "ns-1"})
+	assert.Equal(t, int64(42), resp.GetEpoch())
+	assert.Equal(t, int64(3), resp.GetLastSequence())
+	assert.False(t, resp.GetResyncRequired())
+	require.Len(t, resp.GetEvents(), 2)
+	assert.Equal(t, int64(1), resp.GetEvents()[0].GetSequence())
+	assert.Equal(t, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, resp.GetEvents()[0].GetEventType())
+	assert.Equal(t, int64(3), resp.GetEvents()[1].GetSequence())
+	assert.Equal(t, enumsspb.VISIBILITY_WATCH_EVENT_TYPE_CLOSED, resp.GetEvents()[1].GetEventType())
+
+	resp = hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{
+		NamespaceId:  "ns-2",
+		Epoch:        42,
+		LastSequence: 1,
+		MaxEvents:    1,
+	})
+	assert.Equal(t, []string{"b"}, workflowIDs(resp.GetEvents()))
+	assert.Equal(t, int64(2), resp.GetLastSequence())
+}
+
+func TestPoll_StartAtLatest(t *testing.T) {
+	hub := NewHub(10, 100, clock.NewRealTimeSource())
+	publish(hub, "ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "a")
+
+	resp := hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{
+		NamespaceId:   "ns",
+		StartAtLatest: true,
+	})
+	assert.Empty(t, resp.GetEvents())
+	assert.Equal(t, int64(1), resp.GetLastSequence())
+	assert.False(t, resp.GetResyncRequired())
+}
+
+func TestPoll_WaitsForEvents(t *testing.T) {
+	hub := NewHub(10, 100, clock.NewRealTimeSource())
+	watch(hub, "ns")
+	publish(hub, "other-ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "a")
+
+	respCh := make(chan *historyservice.PollVisibilityWatchEventsResponse)
+	go func() {
+		respCh <- hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{NamespaceId: "ns"})
+	}()
+	select {
+	case <-respCh:
+		t.Fatal("poll returned before an event of the namespace was published")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	publish(hub, "ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_UPDATED, "b")
+	resp := <-respCh
+	assert.Equal(t, []string{"b"}, workflowIDs(resp.GetEvents()))
+	assert.Equal(t, int64(2), resp.GetLastSequence())
+}
+
+func TestPoll_Timeout(t *testing.T) {
+	hub := NewHub(10, 100, clock.NewRealTimeSource())
+	watch(hub, "ns")
+	publish(hub, "other-ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "a")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	resp := hub.Poll(ctx, &historyservice.PollVisibilityWatchEventsRequest{NamespaceId: "ns"})
+	assert.Empty(t, resp.GetEvents())
+	assert.Equal(t, int64(1), resp.GetLastSequence())
+	assert.False(t, resp.GetResyncRequired())
+}
+
+func TestPoll_ResyncRequired(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(0, 42))
+	hub := NewHub(2, 100, timeSource)
+	watch(hub, "ns")
+	for _, workflowID := range []string{"a", "b", "c", "d"} {
+		publish(hub, "ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, workflowID)
+	}
+
+	testCases := []struct {
+		name    string
+		request *historyservice.PollVisibilityWatchEventsRequest
+	}{
+		{
+			name:    "evicted",
+			request: &historyservice.PollVisibilityWatchEventsRequest{Epoch: 42, LastSequence: 1},
+		},
+		{
+			name:    "first poll after eviction",
+			request: &historyservice.PollVisibilityWatchEventsRequest{},
+		},
+		{
+			name:    "host restarted",
+			request: &historyservice.PollVisibilityWatchEventsRequest{Epoch: 41, LastSequence: 3},
+		},
+		{
+			name:    "sequence from the future",
+			request: &historyservice.PollVisibilityWatchEventsRequest{Epoch: 42, LastSequence: 5},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.request.NamespaceId = "ns"
+			resp := hub.Poll(context.Background(), tc.request)
+			assert.True(t, resp.GetResyncRequired())
+			assert.Empty(t, resp.GetEvents())
+			assert.Equal(t, int64(4), resp.GetLastSequence())
+		})
+	}
+
+	resp := hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{
+		NamespaceId:  "ns",
+		Epoch:        42,
+		LastSequence: 2,
+	})
+	assert.False(t, resp.GetResyncRequired())
+	assert.Equal(t, []string{"c", "d"}, workflowIDs(resp.GetEvents()))
+}
+
+func TestPoll_CapacityPerNamespace(t *testing.T) {
+	hub := NewHub(2, 100, clock.NewRealTimeSource())
+	watch(hub, "quiet-ns", "busy-ns")
+	publish(hub, "quiet-ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "a")
+	for _, workflowID := range []string{"b", "c", "d"} {
+		publish(hub, "busy-ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, workflowID)
+	}
+
+	resp := hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{NamespaceId: "quiet-ns"})
+	assert.False(t, resp.GetResyncRequired())
+	assert.Equal(t, []string{"a"}, workflowIDs(resp.GetEvents()))
+	assert.Equal(t, int64(4), resp.GetLastSequence())
+
+	resp = hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{NamespaceId: "busy-ns"})
+	assert.True(t, resp.GetResyncRequired())
+}
+
+func TestPoll_TotalCapacity(t *testing.T) {
+	hub := NewHub(10, 3, clock.NewRealTimeSource())
+	watch(hub, "quiet-ns", "busy-ns")
+	publish(hub, "quiet-ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "a")
+	for _, workflowID := range []string{"b", "c", "d"} {
+		publish(hub, "busy-ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, workflowID)
+	}
+
+	resp := hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{NamespaceId: "quiet-ns"})
+	assert.False(t, resp.GetResyncRequired())
+	assert.Equal(t, []string{"a"}, workflowIDs(resp.GetEvents()))
+
+	resp = hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{NamespaceId: "busy-ns"})
+	assert.True(t, resp.GetResyncRequired())
+
+	resp = hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{
+		NamespaceId:  "busy-ns",
+		Epoch:        resp.GetEpoch(),
+		LastSequence: 2,
+	})
+	assert.False(t, resp.GetResyncRequired())
+	assert.Equal(t, []string{"c", "d"}, workflowIDs(resp.GetEvents()))
+}
+
+func TestPublish_UnwatchedNamespace(t *testing.T) {
+	hub := NewHub(10, 100, clock.NewRealTimeSource())
+	hub.Publish("ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, func() *workflowpb.WorkflowExecutionInfo {
+		t.Fatal("execution info was built for a namespace without watchers")
+		return nil
+	})
+
+	// The change was dropped, so a watcher reading from the start must resync.
+	resp := hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{NamespaceId: "ns"})
+	assert.True(t, resp.GetResyncRequired())
+	assert.Equal(t, int64(1), resp.GetLastSequence())
+
+	publish(hub, "ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_UPDATED, "a")
+	resp = hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{
+		NamespaceId:  "ns",
+		Epoch:        resp.GetEpoch(),
+		LastSequence: resp.GetLastSequence(),
+	})
+	assert.False(t, resp.GetResyncRequired())
+	assert.Equal(t, []string{"a"}, workflowIDs(resp.GetEvents()))
+}
+
+func TestPublish_IdleNamespaceEvicted(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(0, 42))
+	hub := NewHub(10, 100, timeSource)
+	watch(hub, "idle-ns")
+	publish(hub, "idle-ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "a")
+
+	timeSource.Advance(idleTimeout)
+	watch(hub, "other-ns")
+	// Publishing to another namespace sweeps the logs of the namespaces that are no longer watched.
+	publish(hub, "other-ns", enumsspb.VISIBILITY_WATCH_EVENT_TYPE_STARTED, "b")
+	hub.mu.Lock()
+	assert.NotContains(t, hub.namespaces, namespace.ID("idle-ns"))
+	assert.Equal(t, 1, hub.totalEvents)
+	hub.mu.Unlock()
+
+	resp := hub.Poll(context.Background(), &historyservice.PollVisibilityWatchEventsRequest{
+		NamespaceId:  "idle-ns",
+		Epoch:        42,
+		LastSequence: 0,
+	})
+	assert.True(t, resp.GetResyncRequired())
+	assert.Equal(t, int64(2), resp.GetLastSequence())
+}
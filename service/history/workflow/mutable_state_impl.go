@@ -57,6 +57,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/transitionhistory"
 	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/placement"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute/sadefs"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
@@ -4265,6 +4266,8 @@ func (ms *MutableStateImpl) ApplyActivityTaskScheduledEvent(
 		ActivityType:            attributes.GetActivityType(),
 		Priority:                attributes.Priority,
 	}
+	// Constraints were validated when the command was handled, so there's no error here.
+	ai.PlacementConstraints, _ = placement.ConstraintsFromHeader(attributes.GetHeader())
 
 	if attributes.UseWorkflowBuildId {
 		if ms.GetAssignedBuildId() != "" {
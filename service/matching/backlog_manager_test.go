@@ -94,6 +94,7 @@ func (s *BacklogManagerTestSuite) SetupTest() {
 	s.ptqMgr.EXPECT().ProcessSpooledTask(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	s.ptqMgr.EXPECT().GetFairnessWeightOverrides().AnyTimes().Return(fairnessWeightOverrides{ /* To avoid deadlock with gomock method */ })
 	s.ptqMgr.EXPECT().StartScaleManager(gomock.Any()).AnyTimes()
+	s.ptqMgr.EXPECT().DeadLetterTask(gomock.Any(), gomock.Any()).AnyTimes()
 
 	var ctx context.Context
 	ctx, s.cancelCtx = context.WithCancel(context.Background())
@@ -690,6 +691,83 @@ func (s *BacklogManagerTestSuite) TestExpiredTasksOnRead_EmitTasksDropped() {
 	}
 }
 
+func (s *BacklogManagerTestSuite) TestDeadlineOrderingOnRead() {
+	if !s.newMatcher {
+		s.T().Skip("deadline ordering is only supported by the new matcher")
+	}
+	s.cfgcli.OverrideValue(dynamicconfig.MatchingEnableDeadlineOrdering.Key(), true)
+
+	ctx := context.Background()
+	queue := s.ptqMgr.QueueKey()
+	queueInfo := &persistencespb.TaskQueueInfo{
+		NamespaceId: queue.NamespaceId(),
+		Name:        queue.PersistenceName(),
+		TaskType:    queue.TaskType(),
+	}
+	_, err := s.taskMgr.CreateTaskQueue(ctx, &persistence.CreateTaskQueueRequest{
+		RangeID:       1,
+		TaskQueueInfo: queueInfo,
+	})
+	s.Require().NoError(err)
+
+	expiries := map[int64]*timestamppb.Timestamp{
+		1: timestamp.TimeNowPtrUtcAddSeconds(3600),
+		2: nil, // no deadline
+		3: timestamp.TimeNowPtrUtcAddSeconds(60),
+	}
+	var dbTasks []*persistencespb.AllocatedTaskInfo
+	for id := int64(1); id <= int64(len(expiries)); id++ {
+		t := &persistencespb.AllocatedTaskInfo{
+			TaskId: id,
+			Data: &persistencespb.TaskInfo{
+				CreateTime: timestamp.TimeNowPtrUtcAddSeconds(-60),
+				ExpiryTime: expiries[id],
+			},
+		}
+		if s.fairness {
+			t.TaskPass = id * 1000
+		}
+		dbTasks = append(dbTasks, t)
+	}
+	_, err = s.taskMgr.CreateTasks(ctx, &persistence.CreateTasksRequest{
+		TaskQueueInfo: &persistence.PersistedTaskQueueInfo{Data: queueInfo, RangeID: 1},
+		Tasks:         dbTasks,
+	})
+	s.Require().NoError(err)
+
+	s.setupToCaptureTasks()
+
+	s.blm.Start()
+	defer s.blm.Stop()
+	s.Require().NoError(s.blm.WaitUntilInitialized(context.Background()))
+
+	await.RequireTrue(s.T(), func() bool {
+		return s.capturedTasksLen() == len(expiries)
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// The reader sets the deadline that the matcher orders tasks by.
+	for _, task := range s.capturedTasks() {
+		expiry := expiries[task.event.TaskId]
+		if expiry == nil {
+			s.Zero(task.dispatchDeadline)
+		} else {
+			s.Equal(expiry.AsTime().UnixNano(), task.dispatchDeadline)
+		}
+	}
+	byDeadline := s.capturedTasks()
+	slices.SortFunc(byDeadline, func(a, b *internalTask) int {
+		if taskBTreeLess(a, b) {
+			return -1
+		}
+		return 1
+	})
+	var order []int64
+	for _, task := range byDeadline {
+		order = append(order, task.event.TaskId)
+	}
+	s.Equal([]int64{3, 1, 2}, order)
+}
+
 func totalApproximateBacklogCount(c backlogManager) (total int64) {
 	for _, stats := range c.BacklogStatsByPriority() {
 		total += stats.ApproximateBacklogCount
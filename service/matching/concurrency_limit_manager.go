@@ -0,0 +1,215 @@
+package matching
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	// concurrencyLimitManager enforces the limit on outstanding activity tasks per fairness key of a
+	// task queue partition (see dynamicconfig.MatchingFairnessKeyConcurrencyLimit).
+	//
+	// A slot is reserved when the matcher gives a task to a local poller, and becomes a lease when
+	// history records the task as started. The lease is released when the worker responds to the
+	// task, or when the activity's start-to-close timeout passes, since by then the attempt has
+	// timed out. Slots are only kept in memory, so they are lost when the partition is unloaded.
+	concurrencyLimitManager struct {
+		config     *taskQueueConfig
+		timeSource clock.TimeSource
+
+		mu             sync.Mutex
+		held           map[string]int // fairness key -> number of reserved and leased slots
+		leases         map[concurrencySlotID]concurrencyLease
+		listeners      map[int]func()
+		nextListenerID int
+		stopped        bool
+	}
+
+	// concurrencySlotID identifies the activity task attempt that a slot is leased to.
+	concurrencySlotID struct {
+		runID            string
+		scheduledEventID int64
+		attempt          int32
+	}
+
+	concurrencyLease struct {
+		key   string
+		timer clock.Timer
+	}
+
+	// concurrencySlot is a slot reserved for a task that was matched but not started yet.
+	concurrencySlot struct {
+		m   *concurrencyLimitManager
+		key string
+	}
+)
+
+func newConcurrencyLimitManager(config *taskQueueConfig, timeSource clock.TimeSource) *concurrencyLimitManager {
+	return &concurrencyLimitManager{
+		config:     config,
+		timeSource: timeSource,
+		held:       make(map[string]int),
+		leases:     make(map[concurrencySlotID]concurrencyLease),
+		listeners:  make(map[int]func()),
+	}
+}
+
+// perPartitionLimit returns this partition's share of the task queue limit, or 0 if there is no
+// limit. Rounds up so that every partition can dispatch at least one task per key.
+func (m *concurrencyLimitManager) perPartitionLimit() int {
+	limit := m.config.FairnessKeyConcurrencyLimit()
+	if limit <= 0 {
+		return 0
+	}
+	partitions := max(m.config.NumReadPartitions(), 1)
+	return (limit + partitions - 1) / partitions
+}
+
+// limited returns true if a limit is configured. Safe to call on a nil manager, which is used for
+// task queue types that don't support the limit.
+func (m *concurrencyLimitManager) limited() bool {
+	return m != nil && m.perPartitionLimit() > 0
+}
+
+// canDispatch returns false if the fairness key of the task has no free slot.
+func (m *concurrencyLimitManager) canDispatch(task *internalTask) bool {
+	key := task.getPriority().GetFairnessKey()
+	if key == "" {
+		return true
+	}
+	limit := m.perPartitionLimit()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return limit <= 0 || m.held[key] < limit
+}
+
+// reserve takes a slot for a task that was matched with a local poller. The slot is returned when
+// the task finishes, unless it was leased by then. Safe to call on a nil manager.
+func (m *concurrencyLimitManager) reserve(task *internalTask) {
+	key := task.getPriority().GetFairnessKey()
+	if key == "" || !m.limited() {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.held[key]++
+	task.concurrencySlot = &concurrencySlot{m: m, key: key}
+}
+
+// release returns a reserved slot of a task that was not dispatched.
+func (s *concurrencySlot) release() {
+	s.m.mu.Lock()
+	s.m.decrementLocked(s.key)
+	s.m.mu.Unlock()
+	s.m.notify()
+}
+
+// lease keeps the slot until the worker responds to the task attempt, or timeout passes.
+func (s *concurrencySlot) lease(id concurrencySlotID, timeout time.Duration) {
+	m := s.m
+	if timeout <= 0 {
+		s.release()
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stopped {
+		return
+	}
+	if old, ok := m.leases[id]; ok {
+		// should not happen: history starts each attempt once
+		old.timer.Stop()
+		m.decrementLocked(old.key)
+	}
+	m.leases[id] = concurrencyLease{
+		key:   s.key,
+		timer: m.timeSource.AfterFunc(timeout, func() { m.removeLease(id, false) }),
+	}
+}
+
+// releaseLease releases the slot leased to a task attempt. Returns false if there is no such
+// lease, e.g. because it expired already.
+func (m *concurrencyLimitManager) releaseLease(id concurrencySlotID) bool {
+	return m.removeLease(id, true)
+}
+
+// removeLease is called with stopTimer false from the lease timer itself.
+func (m *concurrencyLimitManager) removeLease(id concurrencySlotID, stopTimer bool) bool {
+	m.mu.Lock()
+	l, ok := m.leases[id]
+	if ok {
+		delete(m.leases, id)
+		if stopTimer {
+			l.timer.Stop()
+		}
+		m.decrementLocked(l.key)
+	}
+	m.mu.Unlock()
+
+	if ok {
+		m.notify()
+	}
+	return ok
+}
+
+func (m *concurrencyLimitManager) decrementLocked(key string) {
+	if m.held[key] <= 1 {
+		delete(m.held, key)
+	} else {
+		m.held[key]--
+	}
+}
+
+// addListener registers a function to call when a slot is released, so that tasks that were
+// held back can be matched. Returns a function to remove the listener.
+func (m *concurrencyLimitManager) addListener(f func()) (remove func()) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := m.nextListenerID
+	m.nextListenerID++
+	m.listeners[id] = f
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.listeners, id)
+	}
+}
+
+// notify calls the listeners. Must be called without holding the lock, since listeners match
+// tasks, which calls back into canDispatch.
+func (m *concurrencyLimitManager) notify() {
+	m.mu.Lock()
+	listeners := slices.Collect(maps.Values(m.listeners))
+	m.mu.Unlock()
+	for _, f := range listeners {
+		f()
+	}
+}
+
+// outstanding returns the number of reserved and leased slots per fairness key.
+func (m *concurrencyLimitManager) outstanding() map[string]int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := make(map[string]int64, len(m.held))
+	for key, n := range m.held {
+		res[key] = int64(n)
+	}
+	return res
+}
+
+func (m *concurrencyLimitManager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopped = true
+	for _, l := range m.leases {
+		l.timer.Stop()
+	}
+	clear(m.leases)
+}
@@ -0,0 +1,113 @@
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/tqid"
+)
+
+func newTestConcurrencyLimitManager(limit, partitions int) (*concurrencyLimitManager, *clock.EventTimeSource) {
+	cfg := newTaskQueueConfig(
+		tqid.UnsafeTaskQueueFamily("nsid", "tq").TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY),
+		NewConfig(dynamicconfig.NewNoopCollection()),
+		"nsname",
+	)
+	cfg.FairnessKeyConcurrencyLimit = func() int { return limit }
+	cfg.NumReadPartitions = func() int { return partitions }
+	ts := clock.NewEventTimeSource().Update(time.Now())
+	return newConcurrencyLimitManager(cfg, ts), ts
+}
+
+func newConcurrencyTestTask(key string) *internalTask {
+	return newInternalTaskFromBacklog(&persistencespb.AllocatedTaskInfo{
+		Data: &persistencespb.TaskInfo{Priority: &commonpb.Priority{FairnessKey: key}},
+	}, nil)
+}
+
+func TestConcurrencyLimitManager_PerPartitionLimit(t *testing.T) {
+	t.Parallel()
+
+	var nilManager *concurrencyLimitManager
+	assert.False(t, nilManager.limited())
+
+	m, _ := newTestConcurrencyLimitManager(0, 4)
+	assert.False(t, m.limited())
+
+	m, _ = newTestConcurrencyLimitManager(10, 4)
+	assert.True(t, m.limited())
+	assert.Equal(t, 3, m.perPartitionLimit())
+
+	m, _ = newTestConcurrencyLimitManager(2, 4)
+	assert.Equal(t, 1, m.perPartitionLimit())
+}
+
+func TestConcurrencyLimitManager_ReserveAndRelease(t *testing.T) {
+	t.Parallel()
+
+	m, _ := newTestConcurrencyLimitManager(2, 1)
+	var notified int
+	remove := m.addListener(func() { notified++ })
+	defer remove()
+
+	t1, t2, t3 := newConcurrencyTestTask("a"), newConcurrencyTestTask("a"), newConcurrencyTestTask("a")
+	require.True(t, m.canDispatch(t1))
+	m.reserve(t1)
+	m.reserve(t2)
+	assert.False(t, m.canDispatch(t3))
+	assert.Equal(t, map[string]int64{"a": 2}, m.outstanding())
+
+	// other keys and tasks without a key are not affected
+	assert.True(t, m.canDispatch(newConcurrencyTestTask("b")))
+	unkeyed := newConcurrencyTestTask("")
+	assert.True(t, m.canDispatch(unkeyed))
+	m.reserve(unkeyed)
+	assert.Nil(t, unkeyed.concurrencySlot)
+
+	// finishing a task that was not started returns its slot
+	t1.finish(taskFinishResult{err: errNoTasks})
+	assert.Nil(t, t1.concurrencySlot)
+	assert.True(t, m.canDispatch(t3))
+	assert.Equal(t, 1, notified)
+	assert.Equal(t, map[string]int64{"a": 1}, m.outstanding())
+}
+
+func TestConcurrencyLimitManager_Lease(t *testing.T) {
+	t.Parallel()
+
+	m, ts := newTestConcurrencyLimitManager(1, 1)
+	id1 := concurrencySlotID{runID: "run", scheduledEventID: 5, attempt: 1}
+	id2 := concurrencySlotID{runID: "run", scheduledEventID: 6, attempt: 1}
+
+	t1 := newConcurrencyTestTask("a")
+	m.reserve(t1)
+	t1.concurrencySlot.lease(id1, time.Minute)
+	assert.False(t, m.canDispatch(newConcurrencyTestTask("a")))
+
+	// released by the worker response
+	assert.True(t, m.releaseLease(id1))
+	assert.False(t, m.releaseLease(id1))
+	assert.Empty(t, m.outstanding())
+
+	// released when the start-to-close timeout passes
+	t2 := newConcurrencyTestTask("a")
+	m.reserve(t2)
+	t2.concurrencySlot.lease(id2, time.Minute)
+	assert.Equal(t, map[string]int64{"a": 1}, m.outstanding())
+	ts.Advance(time.Minute)
+	assert.Empty(t, m.outstanding())
+	assert.False(t, m.releaseLease(id2))
+
+	// no timeout means the slot is released right away
+	t3 := newConcurrencyTestTask("a")
+	m.reserve(t3)
+	t3.concurrencySlot.lease(id1, 0)
+	assert.Empty(t, m.outstanding())
+}
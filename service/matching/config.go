@@ -95,10 +95,12 @@ type (
 		MembershipUnloadDelay                    dynamicconfig.DurationPropertyFn
 		TaskQueueInfoByBuildIdTTL                dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		PriorityLevels                           dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		EnableDeadlineOrdering                   dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 
 		RateLimiterRefreshInterval    time.Duration
 		FairnessKeyRateLimitCacheSize dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		MaxFairnessKeyWeightOverrides dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		FairnessKeyConcurrencyLimit   dynamicconfig.IntPropertyFnWithTaskQueueFilter
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval  dynamicconfig.DurationPropertyFnWithTaskQueueFilter
@@ -107,6 +109,8 @@ type (
 		MinTaskThrottlingBurstSize  dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		MaxTaskDeleteBatchSize      dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		TaskDeleteInterval          dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		TaskDLQEnabled              dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		TaskDLQRetention            dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskQueueFilter
@@ -127,6 +131,7 @@ type (
 		VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableManualPagination        dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableUnifiedQueryConverter   dynamicconfig.BoolPropertyFn
+		VisibilityCountGroupByMaxGroups         dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 		ListNexusEndpointsLongPollTimeout dynamicconfig.DurationPropertyFn
 		NexusEndpointsRefreshInterval     dynamicconfig.DurationPropertyFn
@@ -183,8 +188,11 @@ type (
 		MinTaskThrottlingBurstSize     func() int
 		MaxTaskDeleteBatchSize         func() int
 		TaskDeleteInterval             func() time.Duration
+		TaskDLQEnabled                 func() bool
+		TaskDLQRetention               func() time.Duration
 		PriorityLevels                 priorityKey
 		DefaultPriorityKey             priorityKey
+		EnableDeadlineOrdering         func() bool
 
 		GetUserDataLongPollTimeout dynamicconfig.DurationPropertyFn
 		GetUserDataMinWaitTime     time.Duration
@@ -217,6 +225,7 @@ type (
 		RateLimiterRefreshInterval    time.Duration
 		FairnessKeyRateLimitCacheSize func() int
 		MaxFairnessKeyWeightOverrides func() int
+		FairnessKeyConcurrencyLimit   func() int
 
 		BreakdownMetricsByTaskQueue func() bool
 		BreakdownMetricsByPartition func() bool
@@ -306,6 +315,8 @@ func NewConfig(
 		MinTaskThrottlingBurstSize:               dynamicconfig.MatchingMinTaskThrottlingBurstSize.Get(dc),
 		MaxTaskDeleteBatchSize:                   dynamicconfig.MatchingMaxTaskDeleteBatchSize.Get(dc),
 		TaskDeleteInterval:                       dynamicconfig.MatchingTaskDeleteInterval.Get(dc),
+		TaskDLQEnabled:                           dynamicconfig.MatchingTaskDLQEnabled.Get(dc),
+		TaskDLQRetention:                         dynamicconfig.MatchingTaskDLQRetention.Get(dc),
 		OutstandingTaskAppendsThreshold:          dynamicconfig.MatchingOutstandingTaskAppendsThreshold.Get(dc),
 		MaxTaskBatchSize:                         dynamicconfig.MatchingMaxTaskBatchSize.Get(dc),
 		ThrottledLogRPS:                          dynamicconfig.MatchingThrottledLogRPS.Get(dc),
@@ -354,9 +365,11 @@ func NewConfig(
 		MembershipUnloadDelay:                    dynamicconfig.MatchingMembershipUnloadDelay.Get(dc),
 		TaskQueueInfoByBuildIdTTL:                dynamicconfig.TaskQueueInfoByBuildIdTTL.Get(dc),
 		PriorityLevels:                           dynamicconfig.MatchingPriorityLevels.Get(dc),
+		EnableDeadlineOrdering:                   dynamicconfig.MatchingEnableDeadlineOrdering.Get(dc),
 		RateLimiterRefreshInterval:               time.Minute,
 		FairnessKeyRateLimitCacheSize:            dynamicconfig.MatchingFairnessKeyRateLimitCacheSize.Get(dc),
 		MaxFairnessKeyWeightOverrides:            dynamicconfig.MatchingMaxFairnessKeyWeightOverrides.Get(dc),
+		FairnessKeyConcurrencyLimit:              dynamicconfig.MatchingFairnessKeyConcurrencyLimit.Get(dc),
 		MaxIDLengthLimit:                         dynamicconfig.MaxIDLengthLimit.Get(dc),
 
 		AdminNamespaceToPartitionDispatchRate:          dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate.Get(dc),
@@ -372,6 +385,7 @@ func NewConfig(
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
 		VisibilityEnableManualPagination:        dynamicconfig.VisibilityEnableManualPagination.Get(dc),
 		VisibilityEnableUnifiedQueryConverter:   dynamicconfig.VisibilityEnableUnifiedQueryConverter.Get(dc),
+		VisibilityCountGroupByMaxGroups:         dynamicconfig.VisibilityCountGroupByMaxGroups.Get(dc),
 
 		ListNexusEndpointsLongPollTimeout: dynamicconfig.MatchingListNexusEndpointsLongPollTimeout.Get(dc),
 		NexusEndpointsRefreshInterval:     dynamicconfig.MatchingNexusEndpointsRefreshInterval.Get(dc),
@@ -474,6 +488,15 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		TaskDeleteInterval: func() time.Duration {
 			return config.TaskDeleteInterval(ns.String(), taskQueueName, taskType)
 		},
+		TaskDLQEnabled: func() bool {
+			return config.TaskDLQEnabled(ns.String(), taskQueueName, taskType)
+		},
+		TaskDLQRetention: func() time.Duration {
+			return config.TaskDLQRetention(ns.String(), taskQueueName, taskType)
+		},
+		EnableDeadlineOrdering: func() bool {
+			return config.EnableDeadlineOrdering(ns.String(), taskQueueName, taskType)
+		},
 		PriorityLevels:             priorityLevels,
 		DefaultPriorityKey:         defaultPriorityKey,
 		GetUserDataLongPollTimeout: config.GetUserDataLongPollTimeout,
@@ -542,6 +565,9 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		MaxFairnessKeyWeightOverrides: func() int {
 			return config.MaxFairnessKeyWeightOverrides(ns.String(), taskQueueName, taskType)
 		},
+		FairnessKeyConcurrencyLimit: func() int {
+			return config.FairnessKeyConcurrencyLimit(ns.String(), taskQueueName, taskType)
+		},
 		PollerHistoryTTL: func() time.Duration {
 			return config.PollerHistoryTTL(ns.String())
 		},
@@ -584,3 +610,16 @@ func (c *taskQueueConfig) setDefaultPriority(task *internalTask) {
 		task.effectivePriority = effectivePriorityFactor * c.DefaultPriorityKey
 	}
 }
+
+// setDispatchDeadline sets the deadline that orders the task within its priority level, or
+// clears it if deadline ordering is disabled. Must be called before the task is added to the
+// matcher, since the deadline is part of the matcher's sort key.
+func (c *taskQueueConfig) setDispatchDeadline(task *internalTask) {
+	task.dispatchDeadline = 0
+	if !c.EnableDeadlineOrdering() {
+		return
+	}
+	if expiry := task.expiryTime(); !expiry.IsZero() {
+		task.dispatchDeadline = expiry.UnixNano()
+	}
+}
@@ -46,9 +46,14 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/ListWorkers":                            1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/CountWorkers":                           1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueConfig":                  1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueDispatchPause":           1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueueDLQTasks":                  1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ReenqueueTaskQueueDLQTasks":             1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ReleaseConcurrencySlot":                 1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeWorker":                         1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateFairnessState":                    1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueVersionMembership":        1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ExplainDynamicConfig":                   1,
 	}
 
 	APIPrioritiesOrdered = []int{0, 1, 2}
@@ -129,6 +129,7 @@ func (tr *fairTaskReader) getOldestBacklogTime() time.Time {
 
 func (tr *fairTaskReader) completeTask(task *internalTask, res taskResponse) {
 	recordDroppedTask(tr.backlogMgr.metricsHandler, res.dropReason)
+	tr.backlogMgr.pqMgr.DeadLetterTask(task.event.AllocatedTaskInfo, res.dropReason)
 
 	tr.lock.Lock()
 
@@ -297,6 +298,7 @@ func (tr *fairTaskReader) readTaskBatch(readLevel fairLevel, loadedTasks int) er
 // call with_out_ lock held
 func (tr *fairTaskReader) addTaskToMatcher(task *internalTask) {
 	task.resetMatcherState()
+	tr.backlogMgr.config.setDispatchDeadline(task)
 	err := tr.backlogMgr.addSpooledTask(task)
 	if err == nil {
 		return
@@ -515,6 +517,7 @@ func (tr *fairTaskReader) mergeTasksLocked(tasks []*persistencespb.AllocatedTask
 			tr.outstandingTasks.Put(level, nil)
 			metrics.ExpiredTasksPerTaskQueueCounter.With(tr.backlogMgr.metricsHandler).Record(1, metrics.TaskExpireStageReadTag)
 			recordDroppedTask(tr.backlogMgr.metricsHandler, dropReasonExpiredRead)
+			tr.backlogMgr.pqMgr.DeadLetterTask(t, dropReasonExpiredRead)
 			continue
 		}
 		task := newInternalTaskFromBacklog(t, tr.completeTask)
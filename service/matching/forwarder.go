@@ -151,6 +151,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				Priority:               task.event.Data.GetPriority(),
 				ComponentRef:           task.event.Data.GetComponentRef(),
+				PlacementConstraints:   task.event.Data.GetPlacementConstraints(),
 			},
 		)
 	default:
@@ -255,6 +256,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 			},
 			ForwardedSource: fwdr.partition.RpcName(),
 			Conditions:      pollMetadata.conditions,
+			WorkerLabels:    pollMetadata.workerLabels,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
@@ -280,6 +282,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 			},
 			ForwardedSource: fwdr.partition.RpcName(),
 			Conditions:      pollMetadata.conditions,
+			WorkerLabels:    pollMetadata.workerLabels,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
@@ -11,7 +11,9 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
@@ -46,6 +48,8 @@ type (
 		throttledLogger   log.Logger
 		namespaceRegistry namespace.Registry
 		workersRegistry   workers.Registry
+		dynamicConfig     *dynamicconfig.Collection
+		timeSource        clock.TimeSource
 	}
 
 	HandlerParams struct {
@@ -56,6 +60,7 @@ type (
 		ThrottledLogger               log.Logger
 		TaskManager                   persistence.TaskManager
 		FairTaskManager               persistence.FairTaskManager
+		MatchingTaskDLQManager        persistence.MatchingTaskDLQManager
 		HistoryClient                 resource.HistoryClient
 		MatchingRawClient             resource.MatchingRawClient
 		WorkerDeploymentClient        workerdeployment.Client
@@ -75,6 +80,8 @@ type (
 		Serializer                    serialization.Serializer
 		TaskHookFactories             []hooks.TaskHookFactory `group:"TaskHookFactories"`
 		PartitionScalerFactory        PartitionScalerFactory
+		DynamicConfig                 *dynamicconfig.Collection
+		TimeSource                    clock.TimeSource
 	}
 )
 
@@ -98,6 +105,7 @@ func NewHandler(
 		engine: NewEngine(
 			params.TaskManager,
 			params.FairTaskManager,
+			params.MatchingTaskDLQManager,
 			params.HistoryClient,
 			params.MatchingRawClient, // Use non retry client inside matching
 			params.WorkerDeploymentClient,
@@ -122,6 +130,8 @@ func NewHandler(
 		),
 		namespaceRegistry: params.NamespaceRegistry,
 		workersRegistry:   params.WorkersRegistry,
+		dynamicConfig:     params.DynamicConfig,
+		timeSource:        params.TimeSource,
 	}
 
 	// prevent from serving requests before matching engine is started and ready
@@ -378,6 +388,15 @@ func (h *Handler) DescribeTaskQueuePartition(
 	return h.engine.DescribeTaskQueuePartition(ctx, request)
 }
 
+// ReleaseConcurrencySlot releases the fairness key concurrency slot held by an activity task attempt.
+func (h *Handler) ReleaseConcurrencySlot(
+	ctx context.Context,
+	request *matchingservice.ReleaseConcurrencySlotRequest,
+) (_ *matchingservice.ReleaseConcurrencySlotResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ReleaseConcurrencySlot(ctx, request)
+}
+
 // ListTaskQueuePartitions returns information about partitions for a taskQueue
 func (h *Handler) ListTaskQueuePartitions(
 	ctx context.Context,
@@ -696,6 +715,24 @@ func (h *Handler) UpdateTaskQueueConfig(
 	return h.engine.UpdateTaskQueueConfig(ctx, request)
 }
 
+func (h *Handler) UpdateTaskQueueDispatchPause(
+	ctx context.Context, request *matchingservice.UpdateTaskQueueDispatchPauseRequest,
+) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+	return h.engine.UpdateTaskQueueDispatchPause(ctx, request)
+}
+
+func (h *Handler) ListTaskQueueDLQTasks(
+	ctx context.Context, request *matchingservice.ListTaskQueueDLQTasksRequest,
+) (*matchingservice.ListTaskQueueDLQTasksResponse, error) {
+	return h.engine.ListTaskQueueDLQTasks(ctx, request)
+}
+
+func (h *Handler) ReenqueueTaskQueueDLQTasks(
+	ctx context.Context, request *matchingservice.ReenqueueTaskQueueDLQTasksRequest,
+) (*matchingservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+	return h.engine.ReenqueueTaskQueueDLQTasks(ctx, request)
+}
+
 func (h *Handler) DescribeWorker(
 	_ context.Context, request *matchingservice.DescribeWorkerRequest,
 ) (*matchingservice.DescribeWorkerResponse, error) {
@@ -711,3 +748,19 @@ func (h *Handler) DescribeWorker(
 		},
 	}, nil
 }
+
+// ExplainDynamicConfig explains how this host resolves a dynamic config setting.
+func (h *Handler) ExplainDynamicConfig(
+	_ context.Context, request *matchingservice.ExplainDynamicConfigRequest,
+) (*matchingservice.ExplainDynamicConfigResponse, error) {
+	explanation, err := h.dynamicConfig.Explain(
+		dynamicconfig.MakeKey(request.GetKey()),
+		dynamicconfig.ConstraintsFromProto(request.GetConstraints()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.ExplainDynamicConfigResponse{
+		Explanation: explanation.ToProto(request.GetRolloutKey(), h.timeSource.Now()),
+	}, nil
+}
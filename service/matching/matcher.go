@@ -11,6 +11,7 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/placement"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	"google.golang.org/grpc/codes"
@@ -30,6 +31,9 @@ type TaskMatcher struct {
 	// are interested in queryTasks but not others. One example is when a
 	// namespace is not active in a cluster.
 	queryTaskC chan *internalTask
+	// synchronous channel to offer backlog tasks with placement constraints. Only pollers
+	// with worker labels receive from it, and they reject tasks they can't run.
+	constrainedTaskC chan *constrainedOffer
 	// channel closed when task queue is closed, to interrupt pollers
 	closeC chan struct{}
 
@@ -42,8 +46,22 @@ type TaskMatcher struct {
 	backlogTasksLock       sync.Mutex
 	lastPoller             atomic.Int64 // unix nanos of most recent poll start time
 	waitingPollerCount     atomic.Int64
+
+	// counts of backlog tasks by placement constraints, covered by backlogTasksLock
+	constrainedBacklog constrainedBacklogCounter
+}
+
+// constrainedOffer is a task with placement constraints offered to a labeled poller. The poller
+// must send exactly one value on acceptC: true if it takes the task, false otherwise.
+type constrainedOffer struct {
+	task    *internalTask
+	acceptC chan bool
 }
 
+// constrainedTaskRetryInterval is how long MustOffer waits before offering a constrained task
+// again after all waiting pollers rejected it.
+const constrainedTaskRetryInterval = 100 * time.Millisecond
+
 var (
 	// Sentinel error to redirect while blocked in matcher.
 	errInterrupted    = errors.New("interrupted offer")
@@ -60,8 +78,10 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler met
 		fwdr:                   fwdr,
 		taskC:                  make(chan *internalTask),
 		queryTaskC:             make(chan *internalTask),
+		constrainedTaskC:       make(chan *constrainedOffer),
 		closeC:                 make(chan struct{}),
 		backlogTasksCreateTime: make(map[int64]int),
+		constrainedBacklog:     newConstrainedBacklogCounter(metricsHandler),
 	}
 }
 
@@ -101,11 +121,18 @@ func (tm *TaskMatcher) recycleToken(*internalTask) {
 // trying to match with a poller. The caller is expected to set the
 // correct context timeout.
 //
+// Tasks with placement constraints are never sync matched or forwarded by this matcher, they
+// are always spooled and dispatched to a local poller with matching worker labels by MustOffer.
+//
 // returns error when:
 //   - ratelimit is exceeded (does not apply to query task)
 //   - context deadline is exceeded
 //   - task is matched and consumer returns error in response channel
 func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, error) {
+	if len(task.placementConstraints()) > 0 {
+		return false, nil
+	}
+
 	if !tm.isBacklogNegligible() {
 		// To ensure better dispatch ordering, we block sync match when a significant backlog is present.
 		// Note that this check does not make a noticeable difference for history tasks, as they do not wait for a
@@ -281,6 +308,10 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask, interr
 	// we can recycle the token it used.
 	task.recycleToken = tm.recycleToken
 
+	if len(task.placementConstraints()) > 0 {
+		return tm.mustOfferConstrained(ctx, task, interruptCh)
+	}
+
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
 	select {
@@ -372,6 +403,44 @@ forLoop:
 	}
 }
 
+// mustOfferConstrained blocks until a local poller whose worker labels satisfy the task's
+// placement constraints accepts it. Constrained tasks are not forwarded to the parent partition.
+// The task reader dispatches constrained tasks outside of its dispatch loop, so that a task that
+// no poller can run doesn't hold up the rest of the backlog.
+func (tm *TaskMatcher) mustOfferConstrained(ctx context.Context, task *internalTask, interruptCh <-chan struct{}) error {
+	offer := &constrainedOffer{task: task, acceptC: make(chan bool, 1)}
+	var rejections int64
+	for {
+		select {
+		case tm.constrainedTaskC <- offer:
+			if <-offer.acceptC {
+				tm.emitDispatchLatency(task, false)
+				return nil
+			}
+			// the poller that rejected goes back to the end of the line, so keep offering
+			// until every waiting poller had a chance before backing off
+			if rejections++; rejections < tm.waitingPollerCount.Load() {
+				continue
+			}
+			rejections = 0
+			timer := time.NewTimer(constrainedTaskRetryInterval)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-interruptCh:
+				timer.Stop()
+				return errInterrupted
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-interruptCh:
+			return errInterrupted
+		}
+	}
+}
+
 func (tm *TaskMatcher) emitDispatchLatency(task *internalTask, forwarded bool) {
 	if tm.config.EmitTaskDispatchLatencyAtPoll() {
 		return // metric will be emitted at poll response
@@ -411,8 +480,11 @@ func (tm *TaskMatcher) poll(
 	ctx context.Context, pollMetadata *pollMetadata, queryOnly bool,
 ) (task *internalTask, forwardedPoll bool, err error) {
 	taskC, queryTaskC := tm.taskC, tm.queryTaskC
+	var constrainedTaskC chan *constrainedOffer
 	if queryOnly {
 		taskC = nil
+	} else if len(pollMetadata.workerLabels) > 0 {
+		constrainedTaskC = tm.constrainedTaskC
 	}
 
 	start := time.Now()
@@ -463,7 +535,7 @@ func (tm *TaskMatcher) poll(
 	default:
 	}
 
-	// 2. taskC and queryTaskC
+	// 2. taskC, queryTaskC and constrainedTaskC
 	select {
 	case task := <-taskC:
 		if task.responseC != nil {
@@ -475,6 +547,10 @@ func (tm *TaskMatcher) poll(
 		metrics.PollSuccessWithSyncPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
 		metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
 		return task, false, nil
+	case offer := <-constrainedTaskC:
+		if task := tm.acceptConstrained(offer, pollMetadata); task != nil {
+			return task, false, nil
+		}
 	default:
 	}
 
@@ -502,6 +578,10 @@ func (tm *TaskMatcher) poll(
 			metrics.PollSuccessWithSyncPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
 			metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
 			return task, false, nil
+		case offer := <-constrainedTaskC:
+			if task := tm.acceptConstrained(offer, pollMetadata); task != nil {
+				return task, false, nil
+			}
 		case token := <-tm.fwdrPollReqTokenC():
 			// Arrange to cancel this request if closeC is closed
 			fwdCtx, cancel := contextWithCancelOnChannelClose(ctx, tm.closeC)
@@ -514,26 +594,44 @@ func (tm *TaskMatcher) poll(
 		}
 	}
 
-	// 4. blocking local poll
-	select {
-	case <-ctx.Done():
-		metrics.PollTimeoutPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
-		return nil, false, errNoTasks
-	case <-tm.closeC:
-		return nil, false, errNoTasks
-	case task := <-taskC:
-		if task.responseC != nil {
+	// 4. blocking local poll (loops only when rejecting constrained tasks)
+	for {
+		select {
+		case <-ctx.Done():
+			metrics.PollTimeoutPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
+			return nil, false, errNoTasks
+		case <-tm.closeC:
+			return nil, false, errNoTasks
+		case task := <-taskC:
+			if task.responseC != nil {
+				metrics.PollSuccessWithSyncPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
+			}
+			metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
+			return task, false, nil
+		case task := <-queryTaskC:
 			metrics.PollSuccessWithSyncPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
+			metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
+			return task, false, nil
+		case offer := <-constrainedTaskC:
+			if task := tm.acceptConstrained(offer, pollMetadata); task != nil {
+				return task, false, nil
+			}
 		}
-		metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
-		return task, false, nil
-	case task := <-queryTaskC:
-		metrics.PollSuccessWithSyncPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
-		metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
-		return task, false, nil
 	}
 }
 
+// acceptConstrained answers a constrained task offer and returns the task if this poller's
+// worker labels satisfy its placement constraints, or nil if it was rejected.
+func (tm *TaskMatcher) acceptConstrained(offer *constrainedOffer, pollMetadata *pollMetadata) *internalTask {
+	if !placement.Satisfies(pollMetadata.workerLabels, offer.task.placementConstraints()) {
+		offer.acceptC <- false
+		return nil
+	}
+	offer.acceptC <- true
+	metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
+	return offer.task
+}
+
 func (tm *TaskMatcher) fwdrPollReqTokenC() <-chan *ForwarderReqToken {
 	if tm.fwdr == nil {
 		return nil
@@ -568,6 +666,7 @@ func (tm *TaskMatcher) registerBacklogTask(task *internalTask) {
 
 	ts := timestamp.TimeValue(task.event.Data.CreateTime).UnixNano()
 	tm.backlogTasksCreateTime[ts] += 1
+	tm.constrainedBacklog.record(task, 1)
 }
 
 func (tm *TaskMatcher) unregisterBacklogTask(task *internalTask) {
@@ -585,6 +684,7 @@ func (tm *TaskMatcher) unregisterBacklogTask(task *internalTask) {
 	} else {
 		tm.backlogTasksCreateTime[ts] = counter - 1
 	}
+	tm.constrainedBacklog.record(task, -1)
 }
 
 // getBacklogAge is the latest age across all backlogs re-directing to this matcher; may momentarily
@@ -653,3 +753,13 @@ func contextWithCancelOnChannelClose(parent context.Context, closeC <-chan struc
 	}()
 	return ctx, cancel
 }
+
+// isChannelClosed returns whether closeC is closed, without blocking.
+func isChannelClosed(closeC <-chan struct{}) bool {
+	select {
+	case <-closeC:
+		return true
+	default:
+		return false
+	}
+}
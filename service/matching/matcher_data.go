@@ -11,6 +11,8 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/placement"
 	"go.temporal.io/server/common/softassert"
 	"go.temporal.io/server/common/util"
 )
@@ -124,12 +126,19 @@ type taskBTree struct {
 	// note that matcherData may get tasks from multiple versioned backlogs due to
 	// versioning redirection.
 	ages backlogAgeTracker
+
+	// constrained counts tasks from local backlogs by their placement constraints
+	constrained constrainedBacklogCounter
 }
 
 func taskBTreeLess(a, b *internalTask) bool {
 	if a.effectivePriority != b.effectivePriority {
 		return a.effectivePriority < b.effectivePriority
 	}
+	if a.dispatchDeadline != b.dispatchDeadline {
+		// earliest deadline first, zero (no deadline) goes last
+		return b.dispatchDeadline == 0 || (a.dispatchDeadline != 0 && a.dispatchDeadline < b.dispatchDeadline)
+	}
 	afl := taskFairLevel(a)
 	bfl := taskFairLevel(b)
 	if afl != bfl {
@@ -152,11 +161,12 @@ func taskFairLevel(task *internalTask) fairLevel {
 	return fairLevelFromAllocatedTask(task.event.AllocatedTaskInfo)
 }
 
-func newTaskBTree() taskBTree {
+func newTaskBTree(metricsHandler metrics.Handler) taskBTree {
 	return taskBTree{
 		// NoLocks: matcherData does its own synchronization via matcherData.lock.
-		tree: *btree.NewBTreeGOptions(taskBTreeLess, btree.Options{NoLocks: true}),
-		ages: newBacklogAgeTracker(),
+		tree:        *btree.NewBTreeGOptions(taskBTreeLess, btree.Options{NoLocks: true}),
+		ages:        newBacklogAgeTracker(),
+		constrained: newConstrainedBacklogCounter(metricsHandler),
 	}
 }
 
@@ -165,6 +175,7 @@ func (b *taskBTree) Add(task *internalTask) {
 	b.tree.Set(task)
 	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 		b.ages.record(task.event.Data.CreateTime, 1)
+		b.constrained.record(task, 1)
 	}
 }
 
@@ -173,9 +184,42 @@ func (b *taskBTree) Remove(task *internalTask) {
 	task.matchHeapIndex = invalidHeapIndex
 	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 		b.ages.record(task.event.Data.CreateTime, -1)
+		b.constrained.record(task, -1)
 	}
 }
 
+// constrainedBacklogCounter counts backlog tasks in a matcher by the keys of their placement
+// constraints (see placement.KeyString), so that tasks waiting for a worker with specific labels
+// are visible.
+// Not thread-safe.
+type constrainedBacklogCounter struct {
+	counts         map[string]int
+	metricsHandler metrics.Handler
+}
+
+func newConstrainedBacklogCounter(metricsHandler metrics.Handler) constrainedBacklogCounter {
+	return constrainedBacklogCounter{
+		counts:         make(map[string]int),
+		metricsHandler: metricsHandler,
+	}
+}
+
+func (c *constrainedBacklogCounter) record(task *internalTask, delta int) {
+	constraints := task.placementConstraints()
+	if len(constraints) == 0 {
+		return
+	}
+	key := placement.KeyString(constraints)
+	count := max(c.counts[key]+delta, 0)
+	if count == 0 {
+		delete(c.counts, key)
+	} else {
+		c.counts[key] = count
+	}
+	metrics.ConstrainedBacklogTasksGauge.With(c.metricsHandler).Record(
+		float64(count), metrics.PlacementConstraintKeysTag(key))
+}
+
 func (b *taskBTree) Len() int {
 	return b.tree.Len()
 }
@@ -196,6 +240,7 @@ func (b *taskBTree) ForEachTask(pred func(*internalTask) bool, post func(*intern
 		task.matchHeapIndex = invalidHeapIndex
 		if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 			b.ages.record(task.event.Data.CreateTime, -1)
+			b.constrained.record(task, -1)
 		}
 		post(task)
 	}
@@ -207,6 +252,8 @@ type matcherData struct {
 	timeSource       clock.TimeSource
 	canForward       bool
 	rateLimitManager *rateLimitManager
+	// limits outstanding tasks per fairness key, nil if not supported by the task queue type
+	concurrencyLimitManager *concurrencyLimitManager
 
 	lock sync.Mutex // covers everything below, and all fields in any waitableMatchResult
 
@@ -223,14 +270,23 @@ type matcherData struct {
 	stopped bool // if true, reject new tasks
 }
 
-func newMatcherData(config *taskQueueConfig, logger log.Logger, timeSource clock.TimeSource, canForward bool, rateLimitManager *rateLimitManager) matcherData {
+func newMatcherData(
+	config *taskQueueConfig,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
+	canForward bool,
+	rateLimitManager *rateLimitManager,
+	concurrencyLimitManager *concurrencyLimitManager,
+) matcherData {
 	return matcherData{
-		config:           config,
-		logger:           logger,
-		timeSource:       timeSource,
-		canForward:       canForward,
-		rateLimitManager: rateLimitManager,
-		tasks:            newTaskBTree(),
+		config:                  config,
+		logger:                  logger,
+		timeSource:              timeSource,
+		canForward:              canForward,
+		rateLimitManager:        rateLimitManager,
+		concurrencyLimitManager: concurrencyLimitManager,
+		tasks:                   newTaskBTree(metricsHandler),
 	}
 }
 
@@ -426,6 +482,8 @@ func (d *matcherData) findMatch(allowForwarding bool, now int64) (matchedTask *i
 		}
 	}
 
+	concurrencyLimited := d.concurrencyLimitManager.limited()
+
 	d.tasks.tree.Scan(func(task *internalTask) bool {
 		// disallow normal poll forwarding when allowForwarding is false, but allow the
 		// "priority backlog poll forwarders".
@@ -433,6 +491,11 @@ func (d *matcherData) findMatch(allowForwarding bool, now int64) (matchedTask *i
 			return true
 		}
 
+		// tasks whose fairness key is at its concurrency limit can still be forwarded, but
+		// not dispatched to local pollers
+		atConcurrencyLimit := concurrencyLimited && !task.isPollForwarder() &&
+			!d.concurrencyLimitManager.canDispatch(task)
+
 		var matched *waitingPoller
 		for _, poller := range d.pollers.heap {
 			// can't match cases:
@@ -448,6 +511,12 @@ func (d *matcherData) findMatch(allowForwarding bool, now int64) (matchedTask *i
 			} else if poller.taskForwarderType == validatorTaskForwarder && task.forwardCtx != nil {
 				// validator (root only) only matches local backlog tasks
 				continue
+			} else if atConcurrencyLimit && poller.taskForwarderType == notTaskForwarder {
+				// fairness key has too many outstanding tasks
+				continue
+			} else if !task.isPollForwarder() && !poller.canRunPlacement(task.placementConstraints()) {
+				// poller doesn't have the worker labels required by the task's placement constraints
+				continue
 			} else if mp := poller.minPriority(); mp > 0 && task.effectivePriority > effectivePriorityFactor*mp {
 				// Note the ">" above: "min" priority is a numeric max.
 				// Also note: this condition will be false for draining tasks since we artifically boost
@@ -509,7 +578,7 @@ func (d *matcherData) allowForwarding() (allowForwarding bool) {
 		return true
 	}
 	delayToForwardingAllowed := d.config.MaxWaitForPollerBeforeFwd() - time.Since(d.lastPoller)
-	d.reconsiderForwardTimer.set(d.timeSource, d.rematchAfterTimer, delayToForwardingAllowed)
+	d.reconsiderForwardTimer.set(d.timeSource, d.rematch, delayToForwardingAllowed)
 	return delayToForwardingAllowed <= 0
 }
 
@@ -524,7 +593,7 @@ func (d *matcherData) findAndWakeMatches() (rateLimited bool) {
 		task, poller, minDelay := d.findMatch(allowForwarding, now)
 		if task == nil || poller == nil {
 			if minDelay > 0 {
-				d.rateLimitTimer.set(d.timeSource, d.rematchAfterTimer, minDelay)
+				d.rateLimitTimer.set(d.timeSource, d.rematch, minDelay)
 				return true
 			}
 			// no more current matches, stop rate limit timer if was running
@@ -539,6 +608,9 @@ func (d *matcherData) findAndWakeMatches() (rateLimited bool) {
 		// TODO(pri): maybe we can allow tasks to have costs other than 1
 		d.rateLimitManager.consumeTokens(now, task, 1)
 		task.recycleToken = d.recycleToken
+		if poller.taskForwarderType == notTaskForwarder && !task.isPollForwarder() {
+			d.concurrencyLimitManager.reserve(task)
+		}
 
 		res := &matchResult{task: task, poller: poller}
 		task.wake(d.logger, res)
@@ -562,8 +634,8 @@ func (d *matcherData) recycleToken(task *internalTask) {
 	d.findAndWakeMatches() // another task may be ready to match now
 }
 
-// called from timer
-func (d *matcherData) rematchAfterTimer() {
+// called from timer, or when a concurrency slot is released
+func (d *matcherData) rematch() {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 	d.findAndWakeMatches()
@@ -21,6 +21,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/softassert"
 	"go.temporal.io/server/common/testing/testlogger"
@@ -49,7 +50,7 @@ func (s *MatcherDataSuite) SetupTest() {
 	s.ts = clock.NewEventTimeSource().Update(time.Now())
 	s.ts.UseAsyncTimers(true)
 	rateLimitManager := newRateLimitManager(&mockUserDataManager{}, cfg, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
-	s.md = newMatcherData(cfg, logger, s.ts, true, rateLimitManager)
+	s.md = newMatcherData(cfg, logger, metrics.NoopMetricsHandler, s.ts, true, rateLimitManager, nil)
 }
 
 func (s *MatcherDataSuite) now() time.Time {
@@ -629,6 +630,119 @@ func (s *MatcherDataSuite) TestMinPriorityZeroMatchesAll() {
 	s.Equal(t2, res.task)
 }
 
+func (s *MatcherDataSuite) TestDeadlineOrdering() {
+	s.md.config.EnableDeadlineOrdering = func() bool { return true }
+	newTask := func(id int64, pri int32, deadline time.Duration) *internalTask {
+		t := s.newBacklogTaskWithPriority(id, 0, nil, &commonpb.Priority{PriorityKey: pri})
+		if deadline > 0 {
+			t.event.Data.ExpiryTime = timestamppb.New(s.now().Add(deadline))
+		}
+		s.md.config.setDispatchDeadline(t)
+		return t
+	}
+
+	tNone := newTask(1, 2, 0)
+	tLate := newTask(2, 2, time.Hour)
+	tEarly := newTask(3, 2, time.Minute)
+	tHighPri := newTask(4, 1, 24*time.Hour)
+	for _, t := range []*internalTask{tNone, tLate, tEarly, tHighPri} {
+		s.md.EnqueueTaskNoWait(t)
+	}
+
+	// priority goes first, then earliest deadline, and tasks without a deadline last
+	for _, expected := range []*internalTask{tHighPri, tEarly, tLate, tNone} {
+		res := s.pollRealTime(20 * time.Millisecond)
+		s.Require().NoError(res.ctxErr)
+		s.Equal(expected, res.task)
+	}
+}
+
+func (s *MatcherDataSuite) TestDeadlineOrderingDisabled() {
+	t1 := s.newBacklogTask(1, 0, nil)
+	t2 := s.newBacklogTask(2, 0, nil)
+	t2.event.Data.ExpiryTime = timestamppb.New(s.now().Add(time.Minute))
+	s.md.config.setDispatchDeadline(t1)
+	s.md.config.setDispatchDeadline(t2)
+	s.Zero(t2.dispatchDeadline)
+
+	s.md.EnqueueTaskNoWait(t1)
+	s.md.EnqueueTaskNoWait(t2)
+
+	// without deadline ordering, tasks are dispatched in backlog order
+	res := s.pollRealTime(20 * time.Millisecond)
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t1, res.task)
+}
+
+func (s *MatcherDataSuite) TestPlacementConstraints() {
+	t1 := s.newBacklogTaskWithPriority(1, 0, nil, &commonpb.Priority{PriorityKey: 1})
+	t1.event.Data.PlacementConstraints = map[string]string{"gpu": "a100"}
+	t2 := s.newBacklogTaskWithPriority(2, 0, nil, &commonpb.Priority{PriorityKey: 3})
+
+	s.md.EnqueueTaskNoWait(t1)
+	s.md.EnqueueTaskNoWait(t2)
+	s.Equal(map[string]int{"gpu": 1}, s.md.tasks.constrained.counts)
+
+	// unlabeled poller skips the constrained task even though it has higher priority
+	res := s.pollRealTime(20 * time.Millisecond)
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t2, res.task)
+
+	res = s.pollRealTime(20 * time.Millisecond)
+	s.Require().Error(res.ctxErr)
+
+	// poller with a different label value doesn't match either
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	res = s.pollContext(ctx, &pollMetadata{workerLabels: map[string]string{"gpu": "t4"}})
+	s.Require().Error(res.ctxErr)
+
+	// poller with matching labels (and more) gets it
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	res = s.pollContext(ctx, &pollMetadata{workerLabels: map[string]string{"gpu": "a100", "region": "us"}})
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t1, res.task)
+	s.Empty(s.md.tasks.constrained.counts)
+}
+
+func (s *MatcherDataSuite) TestFairnessKeyConcurrencyLimit() {
+	s.md.config.FairnessKeyConcurrencyLimit = func() int { return 1 }
+	cm := newConcurrencyLimitManager(s.md.config, s.ts)
+	s.md.concurrencyLimitManager = cm
+	defer cm.addListener(s.md.rematch)()
+
+	t1 := s.newBacklogTaskWithPriority(1, 0, nil, &commonpb.Priority{PriorityKey: 1, FairnessKey: "a"})
+	t2 := s.newBacklogTaskWithPriority(2, 0, nil, &commonpb.Priority{PriorityKey: 1, FairnessKey: "a"})
+	t3 := s.newBacklogTaskWithPriority(3, 0, nil, &commonpb.Priority{PriorityKey: 3, FairnessKey: "b"})
+	s.md.EnqueueTaskNoWait(t1)
+	s.md.EnqueueTaskNoWait(t2)
+	s.md.EnqueueTaskNoWait(t3)
+
+	res := s.pollRealTime(20 * time.Millisecond)
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t1, res.task)
+
+	// key "a" is at its limit, so the lower priority task of key "b" goes first
+	res = s.pollRealTime(20 * time.Millisecond)
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t3, res.task)
+	res.task.finish(taskFinishResult{consumedToken: true})
+
+	res = s.pollRealTime(20 * time.Millisecond)
+	s.Require().Error(res.ctxErr)
+	s.Equal(map[string]int64{"a": 1}, cm.outstanding())
+
+	// releasing the slot wakes up a waiting poller
+	resC := make(chan *matchResult, 1)
+	go func() { resC <- s.pollRealTime(time.Second) }()
+	s.waitForPollers(1)
+	t1.finish(taskFinishResult{err: errNoTasks})
+	res = <-resC
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t2, res.task)
+}
+
 func (s *MatcherDataSuite) TestMatchPollerImmediately() {
 	// Add tasks at different priorities
 	t1 := s.newBacklogTaskWithPriority(1, 0, nil, &commonpb.Priority{PriorityKey: 1})
@@ -848,7 +962,7 @@ func (s *MatcherDataSuite) TestFindMatch() {
 		s.Run(tc.name, func() {
 			// Reset the task tree for each subtest, since Add appends rather than
 			// replacing (the old s.md.tasks.heap assignment reset implicitly).
-			s.md.tasks = newTaskBTree()
+			s.md.tasks = newTaskBTree(metrics.NoopMetricsHandler)
 
 			// Create task
 			var task *internalTask
@@ -1046,7 +1160,7 @@ func FuzzMatcherData(f *testing.F) {
 		ts.UseAsyncTimers(true)
 		logger := log.NewNoopLogger()
 		rateLimitManager := newRateLimitManager(&mockUserDataManager{}, cfg, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
-		md := newMatcherData(cfg, logger, ts, true, rateLimitManager)
+		md := newMatcherData(cfg, logger, metrics.NoopMetricsHandler, ts, true, rateLimitManager, nil)
 
 		next := func() int {
 			if len(tape) == 0 {
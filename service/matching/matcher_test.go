@@ -712,6 +712,54 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	t.NoError(err)
 }
 
+func (t *MatcherTestSuite) TestPlacementConstraints() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+
+	constraints := map[string]string{"gpu": "a100"}
+
+	// constrained tasks are never sync matched
+	syncTask := randomTaskInfo().Data
+	syncTask.PlacementConstraints = constraints
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.childMatcher.Offer(ctx, newInternalTaskForSyncMatch(syncTask, nil, 0, nil))
+	cancel()
+	t.NoError(err)
+	t.False(syncMatch)
+
+	var wg sync.WaitGroup
+	poll := func(labels map[string]string, resultC chan<- *internalTask) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+			defer cancel()
+			task, err := t.childMatcher.Poll(ctx, &pollMetadata{workerLabels: labels})
+			if err == nil {
+				resultC <- task
+			}
+		}()
+	}
+	wrongC := make(chan *internalTask, 2)
+	poll(nil, wrongC)
+	poll(map[string]string{"gpu": "t4"}, wrongC)
+	rightC := make(chan *internalTask, 1)
+	poll(map[string]string{"gpu": "a100", "region": "us"}, rightC)
+
+	t.Eventually(func() bool { return t.childMatcher.waitingPollerCount.Load() == 3 }, time.Second, time.Millisecond)
+	backlogTask := randomTaskInfo()
+	backlogTask.Data.PlacementConstraints = constraints
+	task := newInternalTaskFromBacklog(backlogTask, nil)
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	t.NoError(t.childMatcher.MustOffer(ctx, task, nil))
+	cancel()
+
+	t.Equal(task, <-rightC)
+	wg.Wait()
+	t.Empty(wrongC)
+}
+
 func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -21,6 +21,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	commonspb "go.temporal.io/server/api/common/v1"
 	deploymentspb "go.temporal.io/server/api/deployment/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -112,6 +113,7 @@ type (
 		workerVersionCapabilities *commonpb.WorkerVersionCapabilities
 		deploymentOptions         *deploymentpb.WorkerDeploymentOptions
 		conditions                *matchingservice.PollConditions
+		workerLabels              map[string]string
 		forwardedFrom             string
 		localPollStartTime        time.Time
 		workerInstanceKey         string
@@ -144,6 +146,7 @@ type (
 		status                        int32
 		taskManager                   persistence.TaskManager
 		fairTaskManager               persistence.FairTaskManager
+		taskDLQManager                persistence.MatchingTaskDLQManager
 		historyClient                 resource.HistoryClient
 		matchingRawClient             resource.MatchingRawClient
 		workerDeploymentClient        workerdeployment.Client
@@ -201,6 +204,8 @@ type (
 		reachabilityCache reachabilityCache
 		// Rate limiter to limit the task dispatch
 		rateLimiter TaskDispatchRateLimiter
+		// Writes dropped backlog tasks to task queue DLQs. May be nil in tests.
+		taskDLQ *taskDLQ
 
 		taskHookFactories []hooks.TaskHookFactory
 	}
@@ -263,6 +268,7 @@ var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed im
 func NewEngine(
 	taskManager persistence.TaskManager,
 	fairTaskManager persistence.FairTaskManager,
+	taskDLQManager persistence.MatchingTaskDLQManager,
 	historyClient resource.HistoryClient,
 	matchingRawClient resource.MatchingRawClient,
 	workerDeploymentClient workerdeployment.Client,
@@ -290,6 +296,7 @@ func NewEngine(
 		status:                 common.DaemonStatusInitialized,
 		taskManager:            taskManager,
 		fairTaskManager:        fairTaskManager,
+		taskDLQManager:         taskDLQManager,
 		historyClient:          historyClient,
 		matchingRawClient:      matchingRawClient,
 		tokenSerializer:        tasktoken.NewSerializer(),
@@ -331,6 +338,7 @@ func NewEngine(
 		partitionScalerFactory:    partitionScalerFactory,
 	}
 	e.nexusEndpointsOwnershipLostCh.Store(make(chan struct{}))
+	e.taskDLQ = newTaskDLQ(taskDLQManager, e.logger, e.timeSource, e.metricsHandler, e.taskDLQRetention, e.isTaskDLQOwner)
 	e.reachabilityCache = newReachabilityCache(
 		metrics.NoopMetricsHandler,
 		visibilityManager,
@@ -348,6 +356,9 @@ func (e *matchingEngineImpl) Start() {
 		return
 	}
 
+	if e.taskDLQ != nil {
+		e.taskDLQ.Start()
+	}
 	go e.watchMembership()
 	_ = e.serviceResolver.AddListener(e.listenerKey(), e.membershipChangedCh)
 }
@@ -369,6 +380,9 @@ func (e *matchingEngineImpl) Stop() {
 	for _, l := range e.getTaskQueuePartitions(math.MaxInt32) {
 		l.Stop(unloadCauseShuttingDown)
 	}
+	if e.taskDLQ != nil {
+		e.taskDLQ.Stop()
+	}
 }
 
 func (e *matchingEngineImpl) listenerKey() string {
@@ -646,17 +660,18 @@ func (e *matchingEngineImpl) AddActivityTask(
 		expirationTime = timestamppb.New(now.Add(expirationDuration))
 	}
 	taskInfo := &persistencespb.TaskInfo{
-		NamespaceId:      addRequest.NamespaceId,
-		RunId:            addRequest.Execution.GetRunId(),
-		WorkflowId:       addRequest.Execution.GetWorkflowId(),
-		ScheduledEventId: addRequest.GetScheduledEventId(),
-		Clock:            addRequest.GetClock(),
-		CreateTime:       timestamppb.New(now),
-		ExpiryTime:       expirationTime,
-		VersionDirective: addRequest.VersionDirective,
-		Stamp:            addRequest.Stamp,
-		Priority:         addRequest.Priority,
-		ComponentRef:     addRequest.ComponentRef,
+		NamespaceId:          addRequest.NamespaceId,
+		RunId:                addRequest.Execution.GetRunId(),
+		WorkflowId:           addRequest.Execution.GetWorkflowId(),
+		ScheduledEventId:     addRequest.GetScheduledEventId(),
+		Clock:                addRequest.GetClock(),
+		CreateTime:           timestamppb.New(now),
+		ExpiryTime:           expirationTime,
+		VersionDirective:     addRequest.VersionDirective,
+		Stamp:                addRequest.Stamp,
+		Priority:             addRequest.Priority,
+		ComponentRef:         addRequest.ComponentRef,
+		PlacementConstraints: addRequest.PlacementConstraints,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -704,6 +719,7 @@ pollLoop:
 			deploymentOptions:         request.DeploymentOptions,
 			forwardedFrom:             req.ForwardedSource,
 			conditions:                req.Conditions,
+			workerLabels:              req.WorkerLabels,
 			workerInstanceKey:         request.WorkerInstanceKey,
 			workerControlTaskQueue:    request.WorkerControlTaskQueue,
 		}
@@ -981,6 +997,7 @@ pollLoop:
 			deploymentOptions:         request.DeploymentOptions,
 			forwardedFrom:             req.ForwardedSource,
 			conditions:                req.Conditions,
+			workerLabels:              req.WorkerLabels,
 			workerInstanceKey:         request.WorkerInstanceKey,
 			workerControlTaskQueue:    request.WorkerControlTaskQueue,
 		}
@@ -1097,9 +1114,26 @@ pollLoop:
 
 			continue pollLoop
 		}
+		var concurrencySlotPartition *taskqueuespb.TaskQueuePartition
+		// Keep the slot until the worker responds, instead of releasing it when the task finishes.
+		// Slots of other partition kinds can't be released by the frontend, so they are released here.
+		if normalPartition, ok := partition.(*tqid.NormalPartition); ok && task.concurrencySlot != nil {
+			slot := task.concurrencySlot
+			task.concurrencySlot = nil
+			slot.lease(concurrencySlotID{
+				runID:            task.event.Data.GetRunId(),
+				scheduledEventID: task.event.Data.GetScheduledEventId(),
+				attempt:          resp.GetAttempt(),
+			}, resp.GetScheduledEvent().GetActivityTaskScheduledEventAttributes().GetStartToCloseTimeout().AsDuration())
+			concurrencySlotPartition = &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     normalPartition.TaskQueue().Name(),
+				TaskQueueType: normalPartition.TaskType(),
+				PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(normalPartition.PartitionId())},
+			}
+		}
 		task.finish(taskFinishResult{consumedToken: true})
 		e.emitTaskDispatchLatency(task, partition, req.GetNamespaceId(), request.Namespace, pollMetadata)
-		return e.createPollActivityTaskQueueResponse(task, resp, opMetrics), nil
+		return e.createPollActivityTaskQueueResponse(task, resp, concurrencySlotPartition, opMetrics), nil
 	}
 }
 
@@ -1626,6 +1660,29 @@ func (e *matchingEngineImpl) DescribeTaskQueuePartition(
 	return pm.Describe(ctx, buildIds, request.GetVersions().GetAllActive(), request.GetReportStats(), request.GetReportPollers(), request.GetReportInternalTaskQueueStatus())
 }
 
+func (e *matchingEngineImpl) ReleaseConcurrencySlot(
+	ctx context.Context,
+	request *matchingservice.ReleaseConcurrencySlotRequest,
+) (*matchingservice.ReleaseConcurrencySlotResponse, error) {
+	partition := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+	// Slots are only kept in memory, so there is nothing to release if the partition is not loaded.
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, false, loadCauseUnspecified)
+	if err != nil {
+		return nil, err
+	}
+	if pm == nil {
+		return &matchingservice.ReleaseConcurrencySlotResponse{}, nil
+	}
+	if cm := pm.GetConcurrencyLimitManager(); cm != nil {
+		cm.releaseLease(concurrencySlotID{
+			runID:            request.GetRunId(),
+			scheduledEventID: request.GetScheduledEventId(),
+			attempt:          request.GetAttempt(),
+		})
+	}
+	return &matchingservice.ReleaseConcurrencySlotResponse{}, nil
+}
+
 func (e *matchingEngineImpl) getBuildIds(versions *taskqueuepb.TaskQueueVersionSelection) (map[string]bool, error) {
 	buildIds := make(map[string]bool)
 	if versions != nil {
@@ -3207,6 +3264,7 @@ func (e *matchingEngineImpl) convertPollWorkflowTaskQueueResponse(
 func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 	task *internalTask,
 	historyResponse *historyservice.RecordActivityTaskStartedResponse,
+	concurrencySlotPartition *taskqueuespb.TaskQueuePartition,
 	metricsHandler metrics.Handler,
 ) *matchingservice.PollActivityTaskQueueResponse {
 	scheduledEvent := historyResponse.ScheduledEvent
@@ -3235,6 +3293,7 @@ func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 		historyResponse.GetStartVersion(),
 		task.event.GetData().GetComponentRef(),
 	)
+	taskToken.ConcurrencySlotPartition = concurrencySlotPartition
 	serializedToken, _ := e.tokenSerializer.Serialize(taskToken)
 
 	// This is here to ensure that this field is never nil as expected by the TS SDK.
@@ -3603,6 +3662,249 @@ func (e *matchingEngineImpl) UpdateTaskQueueConfig(
 	}, nil
 }
 
+func (e *matchingEngineImpl) UpdateTaskQueueDispatchPause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueDispatchPauseRequest,
+) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error) {
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(request.GetNamespaceId(), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	taskQueueType := request.GetTaskQueueType()
+	// Like the task queue config, the pause is written to the root workflow partition and propagates to all
+	// partitions of the task queue family from there.
+	tqm, _, err := e.getTaskQueuePartitionManager(ctx,
+		taskQueueFamily.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition(),
+		true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+	updateOptions := UserDataUpdateOptions{Source: "UpdateTaskQueueDispatchPause"}
+	_, err = tqm.GetUserDataManager().UpdateUserData(ctx, updateOptions,
+		func(tqud *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+			currentPause := tqud.GetPerType()[int32(taskQueueType)].GetDispatchPause()
+			if request.GetPaused() == (currentPause != nil) {
+				// Pausing a paused queue keeps the original pause, and resuming an unpaused one is a no-op.
+				return nil, false, errUserDataUnmodified
+			}
+			data := prepareTaskQueueUserData(tqud, taskQueueType)
+			existingClock := data.Clock
+			if existingClock == nil {
+				existingClock = hlc.Zero(e.clusterMeta.GetClusterID())
+			}
+			now := hlc.Next(existingClock, e.timeSource)
+
+			if request.GetPaused() {
+				data.PerType[int32(taskQueueType)].DispatchPause = &persistencespb.TaskQueueDispatchPause{
+					Reason:    request.GetReason(),
+					Identity:  request.GetIdentity(),
+					PauseTime: hlc.ProtoTimestamp(now),
+				}
+			} else {
+				data.PerType[int32(taskQueueType)].DispatchPause = nil
+			}
+			// Update the clock on TaskQueueUserData to enforce LWW on replication
+			data.Clock = now
+			return data, true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := tqm.GetUserDataManager().GetUserData()
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateTaskQueueDispatchPauseResponse{
+		DispatchPause: userData.GetData().GetPerType()[int32(taskQueueType)].GetDispatchPause(),
+	}, nil
+}
+
+func (e *matchingEngineImpl) ListTaskQueueDLQTasks(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueDLQTasksRequest,
+) (*matchingservice.ListTaskQueueDLQTasksResponse, error) {
+	if request.GetPageSize() <= 0 {
+		return nil, serviceerror.NewInvalidArgument("page_size must be positive")
+	}
+	key, err := e.taskDLQKey(request.GetNamespaceId(), request.GetTaskQueue(), request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	if len(request.GetNextPageToken()) == 0 {
+		// Apply retention when starting to list, so tasks past retention aren't returned even if
+		// nothing has been written to the DLQ recently.
+		retention, err := e.taskDLQRetention(key)
+		if err != nil {
+			return nil, err
+		}
+		if err := e.taskDLQ.trim(ctx, key, retention, e.metricsHandler); errors.As(err, new(*serviceerror.NotFound)) {
+			return &matchingservice.ListTaskQueueDLQTasksResponse{}, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := e.taskDLQManager.ReadTasks(ctx, &persistence.ReadMatchingDLQTasksRequest{
+		QueueKey:      key,
+		PageSize:      int(request.GetPageSize()),
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if errors.As(err, new(*serviceerror.NotFound)) {
+		// The DLQ is created when the first task is written to it.
+		return &matchingservice.ListTaskQueueDLQTasksResponse{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	tasks := make([]*commonspb.MatchingDLQTask, len(resp.Tasks))
+	for i, task := range resp.Tasks {
+		tasks[i] = &commonspb.MatchingDLQTask{
+			MessageId: task.MessageMetadata.ID,
+			Payload:   task.Payload,
+		}
+	}
+	return &matchingservice.ListTaskQueueDLQTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (e *matchingEngineImpl) ReenqueueTaskQueueDLQTasks(
+	ctx context.Context,
+	request *matchingservice.ReenqueueTaskQueueDLQTasksRequest,
+) (*matchingservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+	taskQueueType := request.GetTaskQueueType()
+	if taskQueueType != enumspb.TASK_QUEUE_TYPE_WORKFLOW && taskQueueType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return nil, serviceerror.NewInvalidArgumentf("task queue type %v has no DLQ", taskQueueType)
+	}
+	key, err := e.taskDLQKey(request.GetNamespaceId(), request.GetTaskQueue(), taskQueueType)
+	if err != nil {
+		return nil, err
+	}
+
+	var reenqueued int64
+	var lastReenqueued *persistence.MessageMetadata
+	hasMore := false
+	resp, err := e.taskDLQManager.ReadTasks(ctx, &persistence.ReadMatchingDLQTasksRequest{
+		QueueKey: key,
+		// Read one more than the limit to tell if there are more tasks to re-enqueue.
+		PageSize: taskDLQReenqueueBatchSize + 1,
+	})
+	if errors.As(err, new(*serviceerror.NotFound)) {
+		return &matchingservice.ReenqueueTaskQueueDLQTasksResponse{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	for _, task := range resp.Tasks {
+		if task.MessageMetadata.ID > request.GetInclusiveMaxMessageId() {
+			break
+		}
+		if reenqueued == taskDLQReenqueueBatchSize {
+			hasMore = true
+			break
+		}
+		if err = e.reenqueueDLQTask(ctx, taskQueueType, task.Payload); err != nil {
+			break
+		}
+		reenqueued++
+		lastReenqueued = &task.MessageMetadata
+	}
+	if lastReenqueued != nil {
+		// Delete what was re-enqueued even if a later task failed, so it isn't added twice.
+		_, deleteErr := e.taskDLQManager.DeleteTasks(ctx, &persistence.DeleteMatchingDLQTasksRequest{
+			QueueKey:                    key,
+			InclusiveMaxMessageMetadata: *lastReenqueued,
+		})
+		if deleteErr != nil {
+			return nil, deleteErr
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.ReenqueueTaskQueueDLQTasksResponse{
+		TasksReenqueued: reenqueued,
+		HasMore:         hasMore,
+	}, nil
+}
+
+// reenqueueDLQTask adds a task from the DLQ back to its task queue. The task no longer has a
+// schedule-to-start timeout, so it can't expire again.
+func (e *matchingEngineImpl) reenqueueDLQTask(
+	ctx context.Context,
+	taskQueueType enumspb.TaskQueueType,
+	dlqTask *persistencespb.MatchingDLQTask,
+) error {
+	info := dlqTask.GetTask().GetData()
+	execution := &commonpb.WorkflowExecution{WorkflowId: info.GetWorkflowId(), RunId: info.GetRunId()}
+	taskQueue := &taskqueuepb.TaskQueue{Name: dlqTask.GetTaskQueue(), Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	var err error
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+		_, err = e.matchingRawClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:      info.GetNamespaceId(),
+			Execution:        execution,
+			TaskQueue:        taskQueue,
+			ScheduledEventId: info.GetScheduledEventId(),
+			Clock:            info.GetClock(),
+			VersionDirective: info.GetVersionDirective(),
+			Priority:         info.GetPriority(),
+			Stamp:            info.GetStamp(),
+		})
+	} else {
+		_, err = e.matchingRawClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
+			NamespaceId:          info.GetNamespaceId(),
+			Execution:            execution,
+			TaskQueue:            taskQueue,
+			ScheduledEventId:     info.GetScheduledEventId(),
+			Clock:                info.GetClock(),
+			VersionDirective:     info.GetVersionDirective(),
+			Priority:             info.GetPriority(),
+			Stamp:                info.GetStamp(),
+			ComponentRef:         info.GetComponentRef(),
+			PlacementConstraints: info.GetPlacementConstraints(),
+		})
+	}
+	return err
+}
+
+func (e *matchingEngineImpl) taskDLQKey(
+	namespaceID string,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+) (persistence.MatchingDLQKey, error) {
+	// There's one DLQ per task queue, so names of partitions are rejected here.
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(namespaceID, taskQueue)
+	if err != nil {
+		return persistence.MatchingDLQKey{}, err
+	}
+	return persistence.MatchingDLQKey{
+		NamespaceID:   namespaceID,
+		TaskQueueName: taskQueueFamily.Name(),
+		TaskQueueType: taskQueueType,
+	}, nil
+}
+
+// isTaskDLQOwner returns whether this host owns the root partition of the task queue of the DLQ.
+func (e *matchingEngineImpl) isTaskDLQOwner(key persistence.MatchingDLQKey) (bool, error) {
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(key.NamespaceID, key.TaskQueueName)
+	if err != nil {
+		return false, err
+	}
+	routingKey, _ := taskQueueFamily.TaskQueue(key.TaskQueueType).RootPartition().RoutingKey(0)
+	owner, err := e.serviceResolver.Lookup(routingKey)
+	if err != nil {
+		return false, err
+	}
+	return owner.Identity() == e.hostInfoProvider.HostInfo().Identity(), nil
+}
+
+func (e *matchingEngineImpl) taskDLQRetention(key persistence.MatchingDLQKey) (time.Duration, error) {
+	ns, err := e.namespaceRegistry.GetNamespaceName(namespace.ID(key.NamespaceID))
+	if err != nil {
+		return 0, err
+	}
+	return e.config.TaskDLQRetention(ns.String(), key.TaskQueueName, key.TaskQueueType), nil
+}
+
 func (e *matchingEngineImpl) UpdateFairnessState(
 	ctx context.Context,
 	req *matchingservice.UpdateFairnessStateRequest,
@@ -22,6 +22,7 @@ type (
 		CancelOutstandingWorkerPolls(ctx context.Context, request *matchingservice.CancelOutstandingWorkerPollsRequest) (*matchingservice.CancelOutstandingWorkerPollsResponse, error)
 		DescribeTaskQueue(ctx context.Context, request *matchingservice.DescribeTaskQueueRequest) (*matchingservice.DescribeTaskQueueResponse, error)
 		DescribeTaskQueuePartition(ctx context.Context, request *matchingservice.DescribeTaskQueuePartitionRequest) (*matchingservice.DescribeTaskQueuePartitionResponse, error)
+		ReleaseConcurrencySlot(ctx context.Context, request *matchingservice.ReleaseConcurrencySlotRequest) (*matchingservice.ReleaseConcurrencySlotResponse, error)
 		ListTaskQueuePartitions(ctx context.Context, request *matchingservice.ListTaskQueuePartitionsRequest) (*matchingservice.ListTaskQueuePartitionsResponse, error)
 		UpdateWorkerBuildIdCompatibility(ctx context.Context, request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error)
 		GetWorkerBuildIdCompatibility(ctx context.Context, request *matchingservice.GetWorkerBuildIdCompatibilityRequest) (*matchingservice.GetWorkerBuildIdCompatibilityResponse, error)
@@ -48,6 +49,9 @@ type (
 		GetWorkerVersioningRules(ctx context.Context, request *matchingservice.GetWorkerVersioningRulesRequest) (*matchingservice.GetWorkerVersioningRulesResponse, error)
 		DescribeVersionedTaskQueues(ctx context.Context, request *matchingservice.DescribeVersionedTaskQueuesRequest) (*matchingservice.DescribeVersionedTaskQueuesResponse, error)
 		UpdateTaskQueueConfig(ctx context.Context, request *matchingservice.UpdateTaskQueueConfigRequest) (*matchingservice.UpdateTaskQueueConfigResponse, error)
+		UpdateTaskQueueDispatchPause(ctx context.Context, request *matchingservice.UpdateTaskQueueDispatchPauseRequest) (*matchingservice.UpdateTaskQueueDispatchPauseResponse, error)
+		ListTaskQueueDLQTasks(ctx context.Context, request *matchingservice.ListTaskQueueDLQTasksRequest) (*matchingservice.ListTaskQueueDLQTasksResponse, error)
+		ReenqueueTaskQueueDLQTasks(ctx context.Context, request *matchingservice.ReenqueueTaskQueueDLQTasksRequest) (*matchingservice.ReenqueueTaskQueueDLQTasksResponse, error)
 		UpdateFairnessState(ctx context.Context, request *matchingservice.UpdateFairnessStateRequest) (*matchingservice.UpdateFairnessStateResponse, error)
 	}
 )
@@ -3139,6 +3139,264 @@ func (s *matchingEngineSuite) TestUpdateUserData_FailsOnKnownVersionMismatch() {
 	s.ErrorAs(err, &failedPreconditionError)
 }
 
+func (s *matchingEngineSuite) TestUpdateTaskQueueDispatchPause() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(100 * time.Millisecond)
+
+	namespaceID := uuid.NewString()
+	tl := "makeToast"
+	tlID := newUnversionedRootQueueKey(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: tl,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+	updatePause := func(paused bool, reason string) *persistencespb.TaskQueueDispatchPause {
+		resp, err := s.matchingEngine.UpdateTaskQueueDispatchPause(context.Background(), &matchingservice.UpdateTaskQueueDispatchPauseRequest{
+			NamespaceId:   namespaceID,
+			TaskQueue:     tl,
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			Paused:        paused,
+			Reason:        reason,
+			Identity:      "operator",
+		})
+		s.NoError(err)
+		return resp.GetDispatchPause()
+	}
+	poll := func() *matchingservice.PollWorkflowTaskQueueResponseWithRawHistory {
+		resp, err := s.matchingEngine.PollWorkflowTaskQueue(context.Background(), &matchingservice.PollWorkflowTaskQueueRequest{
+			NamespaceId: namespaceID,
+			PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+				TaskQueue: taskQueue,
+				Identity:  "nobody",
+			},
+		}, metrics.NoopMetricsHandler)
+		s.NoError(err)
+		return resp
+	}
+
+	pause := updatePause(true, "maintenance")
+	s.Equal("maintenance", pause.GetReason())
+	s.Equal("operator", pause.GetIdentity())
+	s.NotNil(pause.GetPauseTime())
+	// Pausing again keeps the pause in effect.
+	protorequire.ProtoEqual(s.T(), pause, updatePause(true, "another reason"))
+
+	describe, err := s.matchingEngine.DescribeTaskQueuePartition(context.Background(), &matchingservice.DescribeTaskQueuePartitionRequest{
+		NamespaceId: namespaceID,
+		TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     tl,
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		},
+		Versions: &taskqueuepb.TaskQueueVersionSelection{Unversioned: true},
+	})
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), pause, describe.GetDispatchPause())
+
+	// Waiting polls restart when dispatch is paused or resumed, not on other user data changes.
+	tqm, _, err := s.matchingEngine.getTaskQueuePartitionManager(context.Background(),
+		newRootPartition(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_WORKFLOW), true, loadCauseUnspecified)
+	s.NoError(err)
+	paused, pauseChanged := tqm.(*taskQueuePartitionManagerImpl).dispatchPaused()
+	s.True(paused)
+	_, err = tqm.GetUserDataManager().UpdateUserData(context.Background(), UserDataUpdateOptions{},
+		func(tqud *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+			data := common.CloneProto(tqud)
+			data.Clock = hlc.Next(data.GetClock(), s.matchingEngine.timeSource)
+			return data, false, nil
+		})
+	s.NoError(err)
+	paused, _ = tqm.(*taskQueuePartitionManagerImpl).dispatchPaused()
+	s.True(paused)
+	s.False(isChannelClosed(pauseChanged))
+
+	// Tasks added while paused go to the backlog and are not dispatched.
+	_, _, err = s.matchingEngine.AddWorkflowTask(context.Background(), &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:            namespaceID,
+		Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewString(), WorkflowId: "workflow1"},
+		ScheduledEventId:       1,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+	})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+	s.Empty(poll().GetTaskToken())
+
+	s.mockHistoryClient.EXPECT().RecordWorkflowTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&historyservice.RecordWorkflowTaskStartedResponse{
+			WorkflowType:               &commonpb.WorkflowType{Name: "workflow"},
+			ScheduledEventId:           1,
+			Attempt:                    1,
+			WorkflowExecutionTaskQueue: taskQueue,
+			History:                    &historypb.History{},
+		}, nil)
+
+	s.Nil(updatePause(false, ""))
+	s.Nil(updatePause(false, ""))
+	s.Eventually(func() bool {
+		return isChannelClosed(pauseChanged)
+	}, 10*time.Second, time.Millisecond)
+	s.Eventually(func() bool {
+		return len(poll().GetTaskToken()) > 0
+	}, 10*time.Second, time.Millisecond)
+}
+
+func (s *matchingEngineSuite) TestConstrainedActivityTaskDoesNotBlockBacklog() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(100 * time.Millisecond)
+
+	namespaceID := uuid.NewString()
+	taskQueue := &taskqueuepb.TaskQueue{Name: "constrained", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	execution := &commonpb.WorkflowExecution{RunId: uuid.NewString(), WorkflowId: "workflow1"}
+	// The first task in the backlog can't run on any poller.
+	for i, constraints := range []map[string]string{{"gpu": "a100"}, nil} {
+		_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceID,
+			Execution:              execution,
+			ScheduledEventId:       int64(i + 1),
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+			PlacementConstraints:   constraints,
+		})
+		s.NoError(err)
+	}
+
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.RecordActivityTaskStartedRequest, _ ...grpc.CallOption) (*historyservice.RecordActivityTaskStartedResponse, error) {
+			return &historyservice.RecordActivityTaskStartedResponse{
+				Attempt: 1,
+				ScheduledEvent: newActivityTaskScheduledEvent(request.ScheduledEventId, 0,
+					&commandpb.ScheduleActivityTaskCommandAttributes{
+						ActivityId:   fmt.Sprintf("activity-%d", request.ScheduledEventId),
+						TaskQueue:    taskQueue,
+						ActivityType: &commonpb.ActivityType{Name: "activity"},
+					}),
+				StartedTime: timestamp.TimeNowPtrUtc(),
+			}, nil
+		}).AnyTimes()
+
+	s.Eventually(func() bool {
+		resp, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+			NamespaceId: namespaceID,
+			PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+				TaskQueue: taskQueue,
+				Identity:  "nobody",
+			},
+		}, metrics.NoopMetricsHandler)
+		s.NoError(err)
+		if len(resp.GetTaskToken()) == 0 {
+			return false
+		}
+		s.Equal("activity-2", resp.GetActivityId())
+		return true
+	}, 10*time.Second, time.Millisecond)
+}
+
+func (s *matchingEngineSuite) TestTaskQueueDLQ() {
+	dlqManager := newTestTaskDLQManager()
+	s.matchingEngine.taskDLQManager = dlqManager
+	s.matchingEngine.taskDLQ = newTaskDLQ(dlqManager, s.logger, s.matchingEngine.timeSource, metrics.NoopMetricsHandler,
+		s.matchingEngine.taskDLQRetention, s.matchingEngine.isTaskDLQOwner)
+	s.matchingEngine.taskDLQ.Start()
+	s.matchingEngine.config.TaskDLQEnabled = dynamicconfig.GetBoolPropertyFnFilteredByTaskQueue(true)
+
+	namespaceID := uuid.NewString()
+	tl := "task-queue-dlq"
+	execution := &commonpb.WorkflowExecution{RunId: uuid.NewString(), WorkflowId: "workflow1"}
+	listTasks := func(pageSize int32, nextPageToken []byte) *matchingservice.ListTaskQueueDLQTasksResponse {
+		resp, err := s.matchingEngine.ListTaskQueueDLQTasks(context.Background(), &matchingservice.ListTaskQueueDLQTasksRequest{
+			NamespaceId:   namespaceID,
+			TaskQueue:     tl,
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			PageSize:      pageSize,
+			NextPageToken: nextPageToken,
+		})
+		s.NoError(err)
+		return resp
+	}
+
+	// Tasks that are already expired when they're read from the backlog, or when they're matched with
+	// a poller, are moved to the DLQ.
+	for i := range 3 {
+		_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceID,
+			Execution:              execution,
+			ScheduledEventId:       int64(i + 1),
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(-5),
+		})
+		s.NoError(err)
+	}
+	s.Eventually(func() bool {
+		_, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+			NamespaceId: namespaceID,
+			PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+				TaskQueue: &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				Identity:  "nobody",
+			},
+		}, metrics.NoopMetricsHandler)
+		s.NoError(err)
+		return len(listTasks(10, nil).GetTasks()) == 3
+	}, 10*time.Second, 10*time.Millisecond)
+
+	page := listTasks(2, nil)
+	s.Len(page.GetTasks(), 2)
+	dlqTask := page.GetTasks()[0].GetPayload()
+	s.Equal(namespaceID, dlqTask.GetNamespaceId())
+	s.Equal(tl, dlqTask.GetTaskQueue())
+	s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, dlqTask.GetTaskQueueType())
+	s.Contains([]string{metrics.DroppedTaskReasonExpiredRead, metrics.DroppedTaskReasonExpiredMemory}, dlqTask.GetReason())
+	s.NotNil(dlqTask.GetDeadLetterTime())
+	// Tasks are dead-lettered in the order they're dropped, which isn't always the order they were added.
+	var firstEventIDs []int64
+	for _, task := range page.GetTasks() {
+		firstEventIDs = append(firstEventIDs, task.GetPayload().GetTask().GetData().GetScheduledEventId())
+	}
+	maxMessageID := page.GetTasks()[1].GetMessageId()
+	page = listTasks(2, page.GetNextPageToken())
+	s.Len(page.GetTasks(), 1)
+	lastEventID := page.GetTasks()[0].GetPayload().GetTask().GetData().GetScheduledEventId()
+
+	// Re-enqueued tasks are added back without a schedule-to-start timeout and removed from the DLQ.
+	var added []*matchingservice.AddActivityTaskRequest
+	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.AddActivityTaskRequest, _ ...grpc.CallOption) (*matchingservice.AddActivityTaskResponse, error) {
+			added = append(added, request)
+			return &matchingservice.AddActivityTaskResponse{}, nil
+		}).Times(2)
+	resp, err := s.matchingEngine.ReenqueueTaskQueueDLQTasks(context.Background(), &matchingservice.ReenqueueTaskQueueDLQTasksRequest{
+		NamespaceId:           namespaceID,
+		TaskQueue:             tl,
+		TaskQueueType:         enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		InclusiveMaxMessageId: maxMessageID,
+	})
+	s.NoError(err)
+	s.EqualValues(2, resp.GetTasksReenqueued())
+	s.False(resp.GetHasMore())
+	s.Len(added, 2)
+	for i, request := range added {
+		s.Equal(namespaceID, request.GetNamespaceId())
+		s.Equal(tl, request.GetTaskQueue().GetName())
+		protorequire.ProtoEqual(s.T(), execution, request.GetExecution())
+		s.Equal(firstEventIDs[i], request.GetScheduledEventId())
+		s.Nil(request.GetScheduleToStartTimeout())
+	}
+	page = listTasks(10, nil)
+	s.Len(page.GetTasks(), 1)
+	s.Equal(lastEventID, page.GetTasks()[0].GetPayload().GetTask().GetData().GetScheduledEventId())
+
+	_, err = s.matchingEngine.ListTaskQueueDLQTasks(context.Background(), &matchingservice.ListTaskQueueDLQTasksRequest{
+		NamespaceId:   namespaceID,
+		TaskQueue:     tl,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+	_, err = s.matchingEngine.ReenqueueTaskQueueDLQTasks(context.Background(), &matchingservice.ReenqueueTaskQueueDLQTasksRequest{
+		NamespaceId:           namespaceID,
+		TaskQueue:             tl,
+		TaskQueueType:         enumspb.TASK_QUEUE_TYPE_NEXUS,
+		InclusiveMaxMessageId: math.MaxInt64,
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+}
+
 func (s *matchingEngineSuite) TestUnknownBuildId_Match() {
 	namespaceID := uuid.NewString()
 	tq := "makeToast"
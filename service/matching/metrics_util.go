@@ -20,19 +20,36 @@ const (
 
 // tag maps the drop reason to its tasks_dropped `reason` metric tag.
 func (r dropReason) tag() metrics.Tag {
+	return metrics.ReasonTag(metrics.ReasonString(r.String()))
+}
+
+// String returns the tasks_dropped `reason` tag value of the drop reason. It's also recorded as
+// the reason of tasks written to the task queue DLQ.
+func (r dropReason) String() string {
 	switch r {
 	case dropReasonNotFound:
-		return metrics.ReasonTag(metrics.DroppedTaskReasonNotFound)
+		return metrics.DroppedTaskReasonNotFound
 	case dropReasonInternalError:
-		return metrics.ReasonTag(metrics.DroppedTaskReasonInternalError)
+		return metrics.DroppedTaskReasonInternalError
 	case dropReasonDataLoss:
-		return metrics.ReasonTag(metrics.DroppedTaskReasonDataLoss)
+		return metrics.DroppedTaskReasonDataLoss
 	case dropReasonExpiredRead:
-		return metrics.ReasonTag(metrics.DroppedTaskReasonExpiredRead)
+		return metrics.DroppedTaskReasonExpiredRead
 	case dropReasonExpiredMemory:
-		return metrics.ReasonTag(metrics.DroppedTaskReasonExpiredMemory)
+		return metrics.DroppedTaskReasonExpiredMemory
+	default:
+		return metrics.DroppedTaskReasonInvalid
+	}
+}
+
+// shouldDeadLetter returns true if tasks dropped for this reason are worth keeping in the task
+// queue DLQ. Tasks that are gone from history (not found) or malformed (invalid) are not.
+func (r dropReason) shouldDeadLetter() bool {
+	switch r {
+	case dropReasonInternalError, dropReasonDataLoss, dropReasonExpiredRead, dropReasonExpiredMemory:
+		return true
 	default:
-		return metrics.ReasonTag(metrics.DroppedTaskReasonInvalid)
+		return false
 	}
 }
 
@@ -63,3 +63,12 @@ func TestRecordDroppedTask(t *testing.T) {
 	require.Len(t, recordings, 1)
 	require.Equal(t, dropReasonNotFound.tag().Value, recordings[0].Tags["reason"])
 }
+
+func TestDropReasonShouldDeadLetter(t *testing.T) {
+	for _, r := range []dropReason{dropReasonInternalError, dropReasonDataLoss, dropReasonExpiredRead, dropReasonExpiredMemory} {
+		require.True(t, r.shouldDeadLetter(), r.String())
+	}
+	for _, r := range []dropReason{dropReasonUnspecified, dropReasonNotFound, dropReasonInvalid} {
+		require.False(t, r.shouldDeadLetter(), r.String())
+	}
+}
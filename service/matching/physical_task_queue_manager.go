@@ -215,6 +215,7 @@ func newPhysicalTaskQueueManager(
 			pqMgr.logger,
 			newFairMetricsHandler(taggedMetricsHandler),
 			partitionMgr.rateLimitManager,
+			partitionMgr.concurrencyLimitManager,
 			pqMgr.MarkAlive,
 		)
 		pqMgr.matcher = pqMgr.priMatcher
@@ -254,6 +255,7 @@ func newPhysicalTaskQueueManager(
 			pqMgr.logger,
 			newPriMetricsHandler(taggedMetricsHandler),
 			partitionMgr.rateLimitManager,
+			partitionMgr.concurrencyLimitManager,
 			pqMgr.MarkAlive,
 		)
 		pqMgr.matcher = pqMgr.priMatcher
@@ -474,6 +476,15 @@ func (c *physicalTaskQueueManagerImpl) RecordTaskAdd(result string, forwarded bo
 	)
 }
 
+func (c *physicalTaskQueueManagerImpl) DeadLetterTask(task *persistencespb.AllocatedTaskInfo, reason dropReason) {
+	dlq := c.partitionMgr.engine.taskDLQ
+	if dlq == nil || !reason.shouldDeadLetter() || !c.config.TaskDLQEnabled() ||
+		c.queue.Partition().Kind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return
+	}
+	dlq.add(c.queue, task, reason, c.config.TaskDLQRetention(), c.metricsHandler)
+}
+
 // PollTask blocks waiting for a task.
 // Returns error when context deadline is exceeded
 // maxDispatchPerSecond is the max rate at which tasks are allowed
@@ -507,9 +518,23 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 	}
 
 	for {
-		task, err := c.matcher.Poll(ctx, pollMetadata)
-		if err != nil {
+		// While dispatch is paused only queries are handed out. Either way, stop waiting when dispatch is paused or
+		// resumed, so that it takes effect on polls that are already waiting.
+		paused, pauseChanged := c.partitionMgr.dispatchPaused()
+		pollCtx, cancel := contextWithCancelOnChannelClose(ctx, pauseChanged)
+		var task *internalTask
+		if paused {
+			task, err = c.matcher.PollForQuery(pollCtx, pollMetadata)
+		} else {
+			task, err = c.matcher.Poll(pollCtx, pollMetadata)
+		}
+		cancel()
+		if errors.Is(err, errNoTasks) && ctx.Err() == nil && isChannelClosed(pauseChanged) {
+			continue
+		} else if err != nil {
 			return nil, err
+		} else if paused {
+			return task, nil
 		}
 
 		// It's possible to get an expired task here: taskReader checks for expiration when
@@ -715,6 +740,11 @@ func (c *physicalTaskQueueManagerImpl) TrySyncMatch(ctx context.Context, task *i
 		}
 	}
 
+	if paused, _ := c.partitionMgr.dispatchPaused(); paused {
+		// Leave the task to the backlog until dispatch is resumed.
+		return syncMatchNoPoller, nil
+	}
+
 	if c.priMatcher != nil {
 		return c.priMatcher.Offer(ctx, task)
 	}
@@ -74,5 +74,8 @@ type (
 		// RecordTaskAdd records the outcome of a task add to this physical queue using
 		// the queue's tagged metrics handler, so all per-physical-queue labels are included.
 		RecordTaskAdd(result string, forwarded bool, behavior enumspb.VersioningBehavior)
+		// DeadLetterTask writes a backlog task that is being dropped to the DLQ of the task queue,
+		// if the DLQ is enabled and the drop reason is worth keeping. It doesn't block.
+		DeadLetterTask(task *persistencespb.AllocatedTaskInfo, reason dropReason)
 	}
 )
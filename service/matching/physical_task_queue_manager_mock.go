@@ -74,6 +74,18 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) AddSpooledTaskToMatcher(task
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSpooledTaskToMatcher", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).AddSpooledTaskToMatcher), task)
 }
 
+// DeadLetterTask mocks base method.
+func (m *MockphysicalTaskQueueManager) DeadLetterTask(task *persistence.AllocatedTaskInfo, reason dropReason) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeadLetterTask", task, reason)
+}
+
+// DeadLetterTask indicates an expected call of DeadLetterTask.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) DeadLetterTask(task, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetterTask", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).DeadLetterTask), task, reason)
+}
+
 // DispatchNexusTask mocks base method.
 func (m *MockphysicalTaskQueueManager) DispatchNexusTask(ctx context.Context, task *internalTask) (*matchingservice.DispatchNexusTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +118,7 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				Priority:               task.event.Data.GetPriority(),
 				ComponentRef:           task.event.Data.GetComponentRef(),
+				PlacementConstraints:   task.event.Data.GetPlacementConstraints(),
 			},
 		)
 	default:
@@ -243,6 +244,7 @@ func ForwardPollWithTarget(
 			},
 			ForwardedSource: source.RpcName(),
 			Conditions:      pollMetadata.conditions,
+			WorkerLabels:    pollMetadata.workerLabels,
 		})
 		if err != nil {
 			return nil, err
@@ -268,6 +270,7 @@ func ForwardPollWithTarget(
 			},
 			ForwardedSource: source.RpcName(),
 			Conditions:      pollMetadata.conditions,
+			WorkerLabels:    pollMetadata.workerLabels,
 		})
 		if err != nil {
 			return nil, err
@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/common/goro"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/placement"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/softassert"
@@ -43,6 +44,8 @@ type priTaskMatcher struct {
 	logger           log.Logger
 	markAlive        func() // function to mark the physical task queue alive
 
+	removeConcurrencyListener func()
+
 	priorityBacklogForwarders *goro.KeyedSet[remotePriorityBacklog]
 }
 
@@ -97,11 +100,12 @@ func newPriTaskMatcher(
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	rateLimitManager *rateLimitManager,
+	concurrencyLimitManager *concurrencyLimitManager,
 	markAlive func(),
 ) *priTaskMatcher {
 	tm := &priTaskMatcher{
 		config:                    config,
-		data:                      newMatcherData(config, logger, clock.NewRealTimeSource(), fwdr != nil, rateLimitManager),
+		data:                      newMatcherData(config, logger, metricsHandler, clock.NewRealTimeSource(), fwdr != nil, rateLimitManager, concurrencyLimitManager),
 		tqCtx:                     tqCtx,
 		logger:                    logger,
 		metricsHandler:            metricsHandler,
@@ -118,6 +122,10 @@ func newPriTaskMatcher(
 }
 
 func (tm *priTaskMatcher) Start() {
+	if cm := tm.data.concurrencyLimitManager; cm != nil {
+		tm.removeConcurrencyListener = cm.addListener(tm.data.rematch)
+	}
+
 	policy := backoff.NewExponentialRetryPolicy(time.Second).
 		WithMaximumInterval(tm.config.BacklogTaskForwardTimeout()).
 		WithExpirationInterval(backoff.NoInterval)
@@ -150,6 +158,9 @@ func (tm *priTaskMatcher) Start() {
 
 func (tm *priTaskMatcher) Stop() {
 	tm.data.Stop()
+	if tm.removeConcurrencyListener != nil {
+		tm.removeConcurrencyListener()
+	}
 
 	tm.priorityBacklogForwarders.Sync(nil, nil)
 
@@ -417,6 +428,7 @@ func (tm *priTaskMatcher) Offer(ctx context.Context, task *internalTask) (syncMa
 	// Fast path if we have a waiting poller (or forwarder).
 	// Forwarding happens here if we match with the task forwarding poller.
 	task.forwardCtx = ctx
+	tm.config.setDispatchDeadline(task)
 	outcome := tm.data.MatchTaskImmediately(task)
 	switch outcome {
 	case syncMatchSuccess:
@@ -537,6 +549,21 @@ func (tm *priTaskMatcher) emitDispatchLatency(task *internalTask, forwarded bool
 	)
 }
 
+// emitDeadlineSlack records how much time the task had left until its schedule-to-start
+// deadline when it was dispatched.
+func (tm *priTaskMatcher) emitDeadlineSlack(task *internalTask) {
+	expiry := task.expiryTime()
+	if expiry.IsZero() {
+		return
+	}
+	// expired tasks are normally dropped before they get here, count them as having no slack
+	metrics.TaskDispatchDeadlineSlackPerTaskQueue.With(tm.metricsHandler).Record(
+		max(expiry.Sub(tm.data.timeSource.Now()), 0),
+		metrics.DeadlineOrderingTag(task.dispatchDeadline != 0),
+		metrics.MatchingTaskPriorityTag(task.getPriority().GetPriorityKey()),
+	)
+}
+
 // Poll blocks until a task is found or context deadline is exceeded
 // On success, the returned task could be a query task or a regular task
 // Returns errNoTasks when context deadline is exceeded
@@ -663,6 +690,7 @@ func (tm *priTaskMatcher) poll(
 				metrics.PollSuccessWithSyncPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
 			}
 			metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
+			tm.emitDeadlineSlack(task)
 		} else {
 			metrics.PollSuccessWithSyncPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
 			metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
@@ -704,3 +732,13 @@ func (p *waitingPoller) minPriority() priorityKey {
 	}
 	return priorityKey(p.pollMetadata.conditions.MinPriority)
 }
+
+// canRunPlacement returns true if this poller may receive a task with the given placement
+// constraints. Task forwarders and the validator aren't real workers and accept any task: a
+// forwarded task is checked again against the pollers on the parent partition.
+func (p *waitingPoller) canRunPlacement(constraints map[string]string) bool {
+	if p.taskForwarderType != notTaskForwarder || p.pollMetadata == nil {
+		return true
+	}
+	return placement.Satisfies(p.pollMetadata.workerLabels, constraints)
+}
@@ -14,9 +14,11 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/common/testing/testlogger"
 	"go.temporal.io/server/common/tqid"
@@ -77,6 +79,7 @@ func (s *PriMatcherSuite) TestValidatorWorksOnRoot() {
 		s.logger,
 		metrics.NoopMetricsHandler,
 		rateLimitManager,
+		nil,
 		func() {},
 	)
 
@@ -170,6 +173,7 @@ func (s *PriMatcherSuite) TestForwardPollRetriesOnResourceExhausted() {
 			s.logger,
 			metrics.NoopMetricsHandler,
 			rateLimitManager,
+			nil,
 			func() {},
 		)
 
@@ -236,6 +240,7 @@ func (s *PriMatcherSuite) TestValidatorDrop_SetsDropReason() {
 				s.logger,
 				metrics.NoopMetricsHandler,
 				rateLimitManager,
+				nil,
 				func() {},
 			)
 			tm.Start()
@@ -265,3 +270,88 @@ func (s *PriMatcherSuite) TestValidatorDrop_SetsDropReason() {
 		})
 	}
 }
+
+func (s *PriMatcherSuite) newDeadlineTestMatcher(ctx context.Context, metricsHandler metrics.Handler) (*priTaskMatcher, *taskQueueConfig) {
+	tq := tqid.UnsafeTaskQueueFamily("nsid", "tq").TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	cfg := newTaskQueueConfig(tq, NewConfig(dynamicconfig.NewNoopCollection()), "nsname")
+	cfg.EnableDeadlineOrdering = func() bool { return true }
+	rateLimitManager := newRateLimitManager(&mockUserDataManager{}, cfg, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	// not started: no validator goroutine to take tasks away from the poller
+	tm := newPriTaskMatcher(ctx, cfg, tq.RootPartition(), nil, nil, nil, s.logger, metricsHandler, rateLimitManager, nil, func() {})
+	return tm, cfg
+}
+
+func newDeadlineTestTask(id int64, expiry *timestamppb.Timestamp) *internalTask {
+	return newInternalTaskFromBacklog(&persistencespb.AllocatedTaskInfo{
+		TaskId: id,
+		Data: &persistencespb.TaskInfo{
+			CreateTime: timestamppb.Now(),
+			ExpiryTime: expiry,
+		},
+	}, func(*internalTask, taskResponse) {})
+}
+
+func (s *PriMatcherSuite) TestDeadlineOrdering() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	metricsCap := metricstest.NewCaptureHandler()
+	capture := metricsCap.StartCapture()
+	defer metricsCap.StopCapture(capture)
+	tm, cfg := s.newDeadlineTestMatcher(ctx, metricsCap)
+
+	tNone := newDeadlineTestTask(1, nil)
+	tLate := newDeadlineTestTask(2, timestamppb.New(time.Now().Add(time.Hour)))
+	tEarly := newDeadlineTestTask(3, timestamppb.New(time.Now().Add(time.Minute)))
+	for _, task := range []*internalTask{tNone, tLate, tEarly} {
+		// same as the task readers do
+		task.resetMatcherState()
+		cfg.setDispatchDeadline(task)
+		s.Require().NoError(tm.AddTask(task))
+	}
+
+	// earliest deadline first, tasks without a deadline last
+	for _, expected := range []*internalTask{tEarly, tLate, tNone} {
+		pollCtx, pollCancel := context.WithTimeout(ctx, time.Second)
+		task, err := tm.Poll(pollCtx, &pollMetadata{})
+		pollCancel()
+		s.Require().NoError(err)
+		s.Equal(expected, task)
+	}
+
+	// only tasks with a deadline record their slack
+	recordings := capture.Snapshot()[metrics.TaskDispatchDeadlineSlackPerTaskQueue.Name()]
+	s.Require().Len(recordings, 2)
+	for i, maxSlack := range []time.Duration{time.Minute, time.Hour} {
+		slack, ok := recordings[i].Value.(time.Duration)
+		s.Require().True(ok)
+		s.Positive(slack)
+		s.LessOrEqual(slack, maxSlack)
+		s.Equal("true", recordings[i].Tags["deadline_ordering"])
+	}
+}
+
+func (s *PriMatcherSuite) TestEmitDeadlineSlack() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	metricsCap := metricstest.NewCaptureHandler()
+	capture := metricsCap.StartCapture()
+	defer metricsCap.StopCapture(capture)
+	tm, _ := s.newDeadlineTestMatcher(ctx, metricsCap)
+	now := time.Now()
+	tm.data.timeSource = clock.NewEventTimeSource().Update(now)
+
+	// dispatchDeadline is not set: the task was not ordered by deadline
+	tm.emitDeadlineSlack(newDeadlineTestTask(1, timestamppb.New(now.Add(time.Minute))))
+	// expired tasks have no slack
+	tm.emitDeadlineSlack(newDeadlineTestTask(2, timestamppb.New(now.Add(-time.Minute))))
+	// tasks without a deadline are not recorded
+	tm.emitDeadlineSlack(newDeadlineTestTask(3, nil))
+
+	recordings := capture.Snapshot()[metrics.TaskDispatchDeadlineSlackPerTaskQueue.Name()]
+	s.Require().Len(recordings, 2)
+	s.Equal(time.Minute, recordings[0].Value)
+	s.Equal("false", recordings[0].Tags["deadline_ordering"])
+	s.Equal(time.Duration(0), recordings[1].Value)
+}
@@ -110,6 +110,7 @@ func (tr *priTaskReader) getOldestBacklogTime() time.Time {
 
 func (tr *priTaskReader) completeTask(task *internalTask, res taskResponse) {
 	recordDroppedTask(tr.backlogMgr.metricsHandler, res.dropReason)
+	tr.backlogMgr.pqMgr.DeadLetterTask(task.event.AllocatedTaskInfo, res.dropReason)
 
 	err := res.err()
 
@@ -252,6 +253,7 @@ func (tr *priTaskReader) processTaskBatch(tasks []*persistencespb.AllocatedTaskI
 			// task expired when we read it
 			metrics.ExpiredTasksPerTaskQueueCounter.With(tr.backlogMgr.metricsHandler).Record(1, metrics.TaskExpireStageReadTag)
 			recordDroppedTask(tr.backlogMgr.metricsHandler, dropReasonExpiredRead)
+			tr.backlogMgr.pqMgr.DeadLetterTask(t, dropReasonExpiredRead)
 			return true
 		}
 
@@ -295,6 +297,7 @@ func (tr *priTaskReader) addNewTasks(tasks []*persistencespb.AllocatedTaskInfo)
 
 func (tr *priTaskReader) addTaskToMatcher(task *internalTask) {
 	task.resetMatcherState()
+	tr.backlogMgr.config.setDispatchDeadline(task)
 	err := tr.backlogMgr.addSpooledTask(task)
 	if err == nil {
 		return
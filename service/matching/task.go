@@ -13,6 +13,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -88,6 +89,12 @@ type (
 		// in between priority levels.
 		effectivePriority priorityKey
 		pollForwarderType pollForwarderType
+		// dispatchDeadline orders tasks of the same priority when deadline ordering is enabled,
+		// in unix nanos. Zero means no deadline.
+		dispatchDeadline int64
+		// concurrencySlot is set when the task was matched with a local poller and took a
+		// fairness key concurrency slot. Cleared when the slot is leased after the task is started.
+		concurrencySlot *concurrencySlot
 	}
 
 	// taskResponse is used to report the result of either a match with a local poller,
@@ -333,6 +340,27 @@ func (task *internalTask) getPriority() *commonpb.Priority {
 	return nil
 }
 
+// expiryTime returns the schedule-to-start deadline of the task, or the zero time if it has none.
+func (task *internalTask) expiryTime() time.Time {
+	if task.event == nil {
+		return time.Time{}
+	}
+	expiry := timestamp.TimeValue(task.event.Data.GetExpiryTime())
+	if expiry.Unix() <= 0 {
+		return time.Time{}
+	}
+	return expiry
+}
+
+// placementConstraints returns the worker labels a poller must have to receive this task.
+// Only activity tasks read from persistence or sync-matched from history carry constraints.
+func (task *internalTask) placementConstraints() map[string]string {
+	if task.event != nil {
+		return task.event.AllocatedTaskInfo.GetData().GetPlacementConstraints()
+	}
+	return nil
+}
+
 func (task *internalTask) fairLevel() fairLevel {
 	return fairLevelFromAllocatedTask(task.event.AllocatedTaskInfo)
 }
@@ -383,6 +411,11 @@ func (task *internalTask) finishInternal(res taskResponse, consumedToken bool) {
 	if !consumedToken && task.recycleToken != nil {
 		task.recycleToken(task)
 	}
+	if slot := task.concurrencySlot; slot != nil {
+		// task was not dispatched
+		task.concurrencySlot = nil
+		slot.release()
+	}
 
 	switch {
 	case task.responseC != nil:
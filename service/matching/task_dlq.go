@@ -0,0 +1,336 @@
+package matching
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// taskDLQBufferSize is the number of dead-lettered tasks that can wait to be written.
+	taskDLQBufferSize = 1000
+	// taskDLQWriterCount is the number of goroutines writing dead-lettered tasks.
+	taskDLQWriterCount = 8
+	// taskDLQAddTimeout is how long add waits for room in the buffer. This slows down the
+	// backlog readers that drop tasks faster than they can be written, e.g. when a large
+	// backlog expires. Tasks that still don't fit after that are not written.
+	taskDLQAddTimeout = time.Second
+	// taskDLQWriteTimeout bounds the persistence calls for a single write or trim.
+	taskDLQWriteTimeout = 10 * time.Second
+	// taskDLQTrimInterval is how often retention is applied to a DLQ that's being written to,
+	// and to all the DLQs owned by this host.
+	taskDLQTrimInterval = time.Hour
+	// taskDLQListPageSize is the page size used to list the DLQs to trim.
+	taskDLQListPageSize = 100
+	// taskDLQKnownQueuesCacheSize is the number of DLQs that are remembered as created and
+	// recently trimmed.
+	taskDLQKnownQueuesCacheSize = 10000
+	// taskDLQTrimPageSize is the page size used to find tasks older than the retention.
+	taskDLQTrimPageSize = 100
+	// taskDLQReenqueueBatchSize is the maximum number of tasks re-enqueued by one
+	// ReenqueueTaskQueueDLQTasks request.
+	taskDLQReenqueueBatchSize = 1000
+)
+
+type (
+	// taskDLQ writes backlog tasks that were dropped to the DLQ of their task queue. Writes happen
+	// in the background and are best effort: if the buffer stays full or persistence fails, the
+	// task is only counted in task_dlq_write_failures. It also applies retention periodically to
+	// the DLQs of the task queues whose root partition is owned by this host, so that DLQs that
+	// are no longer written to are trimmed too.
+	taskDLQ struct {
+		status         int32
+		manager        persistence.MatchingTaskDLQManager
+		logger         log.Logger
+		timeSource     clock.TimeSource
+		metricsHandler metrics.Handler
+		retention      func(persistence.MatchingDLQKey) (time.Duration, error)
+		isOwner        func(persistence.MatchingDLQKey) (bool, error)
+
+		writeC     chan *taskDLQWrite
+		shutdownC  chan struct{}
+		shutdownWG sync.WaitGroup
+		// knownQueues holds the keys of DLQs that were created and trimmed recently. Entries
+		// expire after taskDLQTrimInterval so that retention is applied again.
+		knownQueues cache.Cache
+	}
+
+	taskDLQWrite struct {
+		task           *persistencespb.MatchingDLQTask
+		retention      time.Duration
+		metricsHandler metrics.Handler
+	}
+)
+
+func newTaskDLQ(
+	manager persistence.MatchingTaskDLQManager,
+	logger log.Logger,
+	timeSource clock.TimeSource,
+	metricsHandler metrics.Handler,
+	retention func(persistence.MatchingDLQKey) (time.Duration, error),
+	isOwner func(persistence.MatchingDLQKey) (bool, error),
+) *taskDLQ {
+	return &taskDLQ{
+		status:         common.DaemonStatusInitialized,
+		manager:        manager,
+		logger:         logger,
+		timeSource:     timeSource,
+		metricsHandler: metricsHandler,
+		retention:      retention,
+		isOwner:        isOwner,
+		writeC:         make(chan *taskDLQWrite, taskDLQBufferSize),
+		shutdownC:      make(chan struct{}),
+		knownQueues:    cache.New(taskDLQKnownQueuesCacheSize, &cache.Options{TTL: taskDLQTrimInterval, TimeSource: timeSource}),
+	}
+}
+
+func (d *taskDLQ) Start() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	d.shutdownWG.Add(taskDLQWriterCount + 1)
+	for range taskDLQWriterCount {
+		go d.writeLoop()
+	}
+	go d.trimLoop()
+}
+
+func (d *taskDLQ) Stop() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(d.shutdownC)
+	d.shutdownWG.Wait()
+}
+
+// add queues a dropped task to be written to the DLQ of its task queue. It blocks for up to
+// taskDLQAddTimeout while the buffer is full.
+func (d *taskDLQ) add(
+	queue *PhysicalTaskQueueKey,
+	task *persistencespb.AllocatedTaskInfo,
+	reason dropReason,
+	retention time.Duration,
+	metricsHandler metrics.Handler,
+) {
+	write := &taskDLQWrite{
+		task: &persistencespb.MatchingDLQTask{
+			NamespaceId:    queue.NamespaceId(),
+			TaskQueue:      queue.TaskQueueFamily().Name(),
+			TaskQueueType:  queue.TaskType(),
+			Task:           task,
+			Reason:         reason.String(),
+			DeadLetterTime: timestamppb.New(d.timeSource.Now()),
+		},
+		retention:      retention,
+		metricsHandler: metricsHandler,
+	}
+	select {
+	case d.writeC <- write:
+		return
+	default:
+	}
+	timerC, timer := d.timeSource.NewTimer(taskDLQAddTimeout)
+	defer timer.Stop()
+	select {
+	case d.writeC <- write:
+	case <-timerC:
+		metrics.TaskDLQWriteFailuresCounter.With(metricsHandler).Record(1)
+	case <-d.shutdownC:
+		metrics.TaskDLQWriteFailuresCounter.With(metricsHandler).Record(1)
+	}
+}
+
+func (d *taskDLQ) writeLoop() {
+	defer d.shutdownWG.Done()
+	for {
+		select {
+		case <-d.shutdownC:
+			return
+		case write := <-d.writeC:
+			d.write(write)
+		}
+	}
+}
+
+func (d *taskDLQ) trimLoop() {
+	defer d.shutdownWG.Done()
+	timerC := func() <-chan time.Time {
+		ch, _ := d.timeSource.NewTimer(backoff.Jitter(taskDLQTrimInterval, 0.1))
+		return ch
+	}
+	ch := timerC()
+	for {
+		select {
+		case <-d.shutdownC:
+			return
+		case <-ch:
+			d.trimOwnedQueues()
+			ch = timerC()
+		}
+	}
+}
+
+// trimOwnedQueues applies retention to all the DLQs owned by this host.
+func (d *taskDLQ) trimOwnedQueues() {
+	ctx := headers.SetCallerInfo(context.Background(), headers.SystemBackgroundLowCallerInfo)
+	var nextPageToken []byte
+	for {
+		listCtx, cancel := context.WithTimeout(ctx, taskDLQWriteTimeout)
+		resp, err := d.manager.ListQueues(listCtx, &persistence.ListMatchingDLQsRequest{
+			PageSize:      taskDLQListPageSize,
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			d.logger.Warn("Failed to list task queue DLQs", tag.Error(err))
+			return
+		}
+		for _, key := range resp.QueueKeys {
+			select {
+			case <-d.shutdownC:
+				return
+			default:
+			}
+			if err := d.trimOwnedQueue(ctx, key); err != nil {
+				d.logger.Warn("Failed to apply retention to task queue DLQ", dlqTags(key, err)...)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return
+		}
+		nextPageToken = resp.NextPageToken
+	}
+}
+
+func (d *taskDLQ) trimOwnedQueue(ctx context.Context, key persistence.MatchingDLQKey) error {
+	if owner, err := d.isOwner(key); err != nil || !owner {
+		return err
+	}
+	retention, err := d.retention(key)
+	if errors.As(err, new(*serviceerror.NamespaceNotFound)) {
+		// The namespace was deleted, or isn't known to this host yet.
+		return nil
+	} else if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, taskDLQWriteTimeout)
+	defer cancel()
+	return d.trim(ctx, key, retention, d.metricsHandler)
+}
+
+func (d *taskDLQ) write(write *taskDLQWrite) {
+	ctx, cancel := context.WithTimeout(
+		headers.SetCallerInfo(context.Background(), headers.SystemBackgroundLowCallerInfo),
+		taskDLQWriteTimeout,
+	)
+	defer cancel()
+
+	key := persistence.MatchingDLQKey{
+		NamespaceID:   write.task.GetNamespaceId(),
+		TaskQueueName: write.task.GetTaskQueue(),
+		TaskQueueType: write.task.GetTaskQueueType(),
+	}
+	if d.knownQueues.Get(key) == nil {
+		_, err := d.manager.CreateQueue(ctx, &persistence.CreateMatchingDLQRequest{QueueKey: key})
+		if err != nil && !errors.Is(err, persistence.ErrQueueAlreadyExists) {
+			d.writeFailed(write, err)
+			return
+		}
+		if err := d.trim(ctx, key, write.retention, write.metricsHandler); err != nil {
+			// Retention is applied again on the next write.
+			d.logger.Warn("Failed to apply retention to task queue DLQ", dlqTags(key, err)...)
+		} else {
+			d.knownQueues.Put(key, struct{}{})
+		}
+	}
+
+	_, err := d.manager.EnqueueTask(ctx, &persistence.EnqueueMatchingDLQTaskRequest{Task: write.task})
+	if err != nil {
+		d.writeFailed(write, err)
+		return
+	}
+	metrics.TaskDLQWritesCounter.With(write.metricsHandler).Record(1, metrics.ReasonTag(metrics.ReasonString(write.task.GetReason())))
+}
+
+func (d *taskDLQ) writeFailed(write *taskDLQWrite, err error) {
+	metrics.TaskDLQWriteFailuresCounter.With(write.metricsHandler).Record(1)
+	d.logger.Warn("Failed to write task to task queue DLQ", append(
+		dlqTags(persistence.MatchingDLQKey{
+			NamespaceID:   write.task.GetNamespaceId(),
+			TaskQueueName: write.task.GetTaskQueue(),
+			TaskQueueType: write.task.GetTaskQueueType(),
+		}, err),
+		tag.TaskID(write.task.GetTask().GetTaskId()),
+	)...)
+}
+
+// trim deletes the tasks at the head of the DLQ that were dead-lettered more than retention ago.
+func (d *taskDLQ) trim(
+	ctx context.Context,
+	key persistence.MatchingDLQKey,
+	retention time.Duration,
+	metricsHandler metrics.Handler,
+) error {
+	cutoff := d.timeSource.Now().Add(-retention)
+	var lastExpired *persistence.MessageMetadata
+	var nextPageToken []byte
+	for {
+		resp, err := d.manager.ReadTasks(ctx, &persistence.ReadMatchingDLQTasksRequest{
+			QueueKey:      key,
+			PageSize:      taskDLQTrimPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+		done := len(resp.NextPageToken) == 0
+		for _, task := range resp.Tasks {
+			if !task.Payload.GetDeadLetterTime().AsTime().Before(cutoff) {
+				// Tasks are written in roughly dead-letter time order, so the rest are newer.
+				done = true
+				break
+			}
+			lastExpired = &task.MessageMetadata
+		}
+		if done {
+			break
+		}
+		nextPageToken = resp.NextPageToken
+	}
+	if lastExpired == nil {
+		return nil
+	}
+	resp, err := d.manager.DeleteTasks(ctx, &persistence.DeleteMatchingDLQTasksRequest{
+		QueueKey:                    key,
+		InclusiveMaxMessageMetadata: *lastExpired,
+	})
+	if err != nil {
+		return err
+	}
+	metrics.TaskDLQRetentionDeletesCounter.With(metricsHandler).Record(resp.MessagesDeleted)
+	return nil
+}
+
+func dlqTags(key persistence.MatchingDLQKey, err error) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowNamespaceID(key.NamespaceID),
+		tag.WorkflowTaskQueueName(key.TaskQueueName),
+		tag.WorkflowTaskQueueType(key.TaskQueueType),
+		tag.Error(err),
+	}
+}
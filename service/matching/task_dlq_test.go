@@ -0,0 +1,317 @@
+package matching
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testTaskDLQManager is an in-memory persistence.MatchingTaskDLQManager.
+type testTaskDLQManager struct {
+	sync.Mutex
+	queues map[persistence.MatchingDLQKey]*testTaskDLQ
+}
+
+type testTaskDLQ struct {
+	nextID int64
+	tasks  []persistence.MatchingDLQTask
+}
+
+var _ persistence.MatchingTaskDLQManager = (*testTaskDLQManager)(nil)
+
+func newTestTaskDLQManager() *testTaskDLQManager {
+	return &testTaskDLQManager{queues: make(map[persistence.MatchingDLQKey]*testTaskDLQ)}
+}
+
+func (m *testTaskDLQManager) Close() {}
+
+func (m *testTaskDLQManager) CreateQueue(
+	_ context.Context,
+	request *persistence.CreateMatchingDLQRequest,
+) (*persistence.CreateMatchingDLQResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.queues[request.QueueKey]; ok {
+		return nil, persistence.ErrQueueAlreadyExists
+	}
+	m.queues[request.QueueKey] = &testTaskDLQ{nextID: persistence.FirstQueueMessageID}
+	return &persistence.CreateMatchingDLQResponse{}, nil
+}
+
+func (m *testTaskDLQManager) EnqueueTask(
+	_ context.Context,
+	request *persistence.EnqueueMatchingDLQTaskRequest,
+) (*persistence.EnqueueMatchingDLQTaskResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	q, ok := m.queues[persistence.MatchingDLQKey{
+		NamespaceID:   request.Task.GetNamespaceId(),
+		TaskQueueName: request.Task.GetTaskQueue(),
+		TaskQueueType: request.Task.GetTaskQueueType(),
+	}]
+	if !ok {
+		return nil, persistence.NewQueueNotFoundError(persistence.QueueTypeMatchingDLQ, request.Task.GetTaskQueue())
+	}
+	metadata := persistence.MessageMetadata{ID: q.nextID}
+	q.nextID++
+	q.tasks = append(q.tasks, persistence.MatchingDLQTask{MessageMetadata: metadata, Payload: request.Task})
+	return &persistence.EnqueueMatchingDLQTaskResponse{Metadata: metadata}, nil
+}
+
+func (m *testTaskDLQManager) ReadTasks(
+	_ context.Context,
+	request *persistence.ReadMatchingDLQTasksRequest,
+) (*persistence.ReadMatchingDLQTasksResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	q, ok := m.queues[request.QueueKey]
+	if !ok {
+		return nil, persistence.NewQueueNotFoundError(persistence.QueueTypeMatchingDLQ, request.QueueKey.TaskQueueName)
+	}
+	var minID int64
+	if len(request.NextPageToken) > 0 {
+		minID, _ = strconv.ParseInt(string(request.NextPageToken), 10, 64)
+	}
+	var tasks []persistence.MatchingDLQTask
+	for _, task := range q.tasks {
+		if task.MessageMetadata.ID >= minID && len(tasks) < request.PageSize {
+			tasks = append(tasks, task)
+		}
+	}
+	var nextPageToken []byte
+	if len(tasks) == request.PageSize {
+		nextPageToken = []byte(strconv.FormatInt(tasks[len(tasks)-1].MessageMetadata.ID+1, 10))
+	}
+	return &persistence.ReadMatchingDLQTasksResponse{Tasks: tasks, NextPageToken: nextPageToken}, nil
+}
+
+func (m *testTaskDLQManager) DeleteTasks(
+	_ context.Context,
+	request *persistence.DeleteMatchingDLQTasksRequest,
+) (*persistence.DeleteMatchingDLQTasksResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	q, ok := m.queues[request.QueueKey]
+	if !ok {
+		return nil, persistence.NewQueueNotFoundError(persistence.QueueTypeMatchingDLQ, request.QueueKey.TaskQueueName)
+	}
+	before := len(q.tasks)
+	q.tasks = slices.DeleteFunc(q.tasks, func(task persistence.MatchingDLQTask) bool {
+		return task.MessageMetadata.ID <= request.InclusiveMaxMessageMetadata.ID
+	})
+	return &persistence.DeleteMatchingDLQTasksResponse{MessagesDeleted: int64(before - len(q.tasks))}, nil
+}
+
+func (m *testTaskDLQManager) ListQueues(
+	_ context.Context,
+	_ *persistence.ListMatchingDLQsRequest,
+) (*persistence.ListMatchingDLQsResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	var keys []persistence.MatchingDLQKey
+	for key, q := range m.queues {
+		if len(q.tasks) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	return &persistence.ListMatchingDLQsResponse{QueueKeys: keys}, nil
+}
+
+func (m *testTaskDLQManager) enqueue(t *testing.T, key persistence.MatchingDLQKey, taskID int64, deadLetterTime time.Time) {
+	ctx := context.Background()
+	_, err := m.CreateQueue(ctx, &persistence.CreateMatchingDLQRequest{QueueKey: key})
+	if err != nil && !errors.Is(err, persistence.ErrQueueAlreadyExists) {
+		require.NoError(t, err)
+	}
+	_, err = m.EnqueueTask(ctx, &persistence.EnqueueMatchingDLQTaskRequest{
+		Task: &persistencespb.MatchingDLQTask{
+			NamespaceId:    key.NamespaceID,
+			TaskQueue:      key.TaskQueueName,
+			TaskQueueType:  key.TaskQueueType,
+			Task:           &persistencespb.AllocatedTaskInfo{TaskId: taskID},
+			DeadLetterTime: timestamppb.New(deadLetterTime),
+		},
+	})
+	require.NoError(t, err)
+}
+
+func (m *testTaskDLQManager) taskIDs(key persistence.MatchingDLQKey) []int64 {
+	m.Lock()
+	defer m.Unlock()
+	var ids []int64
+	if q, ok := m.queues[key]; ok {
+		for _, task := range q.tasks {
+			ids = append(ids, task.Payload.GetTask().GetTaskId())
+		}
+	}
+	return ids
+}
+
+// newTestTaskDLQ returns a taskDLQ that doesn't own any DLQ, so that only writes apply retention.
+func newTestTaskDLQ(manager persistence.MatchingTaskDLQManager, timeSource clock.TimeSource) *taskDLQ {
+	return newTaskDLQ(
+		manager,
+		log.NewNoopLogger(),
+		timeSource,
+		metrics.NoopMetricsHandler,
+		func(persistence.MatchingDLQKey) (time.Duration, error) { return 24 * time.Hour, nil },
+		func(persistence.MatchingDLQKey) (bool, error) { return false, nil },
+	)
+}
+
+func TestTaskDLQ_WritesAndAppliesRetention(t *testing.T) {
+	t.Parallel()
+
+	manager := newTestTaskDLQManager()
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	dlq := newTestTaskDLQ(manager, timeSource)
+	dlq.Start()
+	defer dlq.Stop()
+
+	capture := metricstest.NewCaptureHandler()
+	c := capture.StartCapture()
+	defer capture.StopCapture(c)
+
+	queue := newUnversionedRootQueueKey("nsid", "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	key := persistence.MatchingDLQKey{
+		NamespaceID:   "nsid",
+		TaskQueueName: "tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}
+	retention := 24 * time.Hour
+	dlq.add(queue, &persistencespb.AllocatedTaskInfo{TaskId: 1}, dropReasonExpiredRead, retention, capture)
+	dlq.add(queue, &persistencespb.AllocatedTaskInfo{TaskId: 2}, dropReasonExpiredMemory, retention, capture)
+	require.EventuallyWithT(t, func(t *assert.CollectT) {
+		assert.Equal(t, []int64{1, 2}, manager.taskIDs(key))
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, c.Snapshot()[metrics.TaskDLQWritesCounter.Name()], 2)
+
+	// Once the known queue entry expires, the next write applies retention first.
+	timeSource.Advance(retention + time.Minute)
+	dlq.add(queue, &persistencespb.AllocatedTaskInfo{TaskId: 3}, dropReasonInternalError, retention, capture)
+	require.EventuallyWithT(t, func(t *assert.CollectT) {
+		assert.Equal(t, []int64{3}, manager.taskIDs(key))
+	}, 5*time.Second, 10*time.Millisecond)
+	deletes := c.Snapshot()[metrics.TaskDLQRetentionDeletesCounter.Name()]
+	require.Len(t, deletes, 1)
+	require.Equal(t, int64(2), deletes[0].Value)
+}
+
+func TestTaskDLQ_Trim(t *testing.T) {
+	t.Parallel()
+
+	manager := newTestTaskDLQManager()
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	dlq := newTestTaskDLQ(manager, timeSource)
+	key := persistence.MatchingDLQKey{
+		NamespaceID:   "nsid",
+		TaskQueueName: "tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}
+	ctx := context.Background()
+	// More tasks than fit in a page, so that trim has to read several pages.
+	for i := range taskDLQTrimPageSize + 10 {
+		manager.enqueue(t, key, int64(i), timeSource.Now().Add(time.Duration(i)*time.Minute))
+	}
+
+	require.NoError(t, dlq.trim(ctx, key, time.Hour, metrics.NoopMetricsHandler))
+	require.Len(t, manager.taskIDs(key), taskDLQTrimPageSize+10)
+
+	// Tasks dead-lettered before the cutoff are deleted, later ones are kept.
+	timeSource.Advance(time.Hour + time.Duration(taskDLQTrimPageSize+5)*time.Minute)
+	require.NoError(t, dlq.trim(ctx, key, time.Hour, metrics.NoopMetricsHandler))
+	require.Equal(t, []int64{105, 106, 107, 108, 109}, manager.taskIDs(key))
+}
+
+func TestTaskDLQ_TrimsOwnedQueuesPeriodically(t *testing.T) {
+	t.Parallel()
+
+	manager := newTestTaskDLQManager()
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	owned := persistence.MatchingDLQKey{
+		NamespaceID:   "nsid",
+		TaskQueueName: "owned",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}
+	notOwned := owned
+	notOwned.TaskQueueName = "not-owned"
+	// Nothing is written to these DLQs anymore.
+	manager.enqueue(t, owned, 1, timeSource.Now())
+	manager.enqueue(t, notOwned, 1, timeSource.Now())
+
+	dlq := newTaskDLQ(
+		manager,
+		log.NewNoopLogger(),
+		timeSource,
+		metrics.NoopMetricsHandler,
+		func(persistence.MatchingDLQKey) (time.Duration, error) { return time.Minute, nil },
+		func(key persistence.MatchingDLQKey) (bool, error) { return key == owned, nil },
+	)
+	dlq.Start()
+	defer dlq.Stop()
+
+	require.EventuallyWithT(t, func(t *assert.CollectT) {
+		timeSource.Advance(taskDLQTrimInterval)
+		assert.Empty(t, manager.taskIDs(owned))
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []int64{1}, manager.taskIDs(notOwned))
+}
+
+func TestTaskDLQ_AddWaitsForBuffer(t *testing.T) {
+	t.Parallel()
+
+	// The DLQ isn't started, so nothing drains the buffer.
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	dlq := newTestTaskDLQ(newTestTaskDLQManager(), timeSource)
+	queue := newUnversionedRootQueueKey("nsid", "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	for i := range taskDLQBufferSize {
+		dlq.add(queue, &persistencespb.AllocatedTaskInfo{TaskId: int64(i)}, dropReasonExpiredRead, time.Hour, metrics.NoopMetricsHandler)
+	}
+
+	capture := metricstest.NewCaptureHandler()
+	c := capture.StartCapture()
+	defer capture.StopCapture(c)
+	added := make(chan struct{})
+	go func() {
+		dlq.add(queue, &persistencespb.AllocatedTaskInfo{TaskId: taskDLQBufferSize}, dropReasonExpiredRead, time.Hour, capture)
+		close(added)
+	}()
+
+	// add blocks until there's room in the buffer.
+	select {
+	case <-added:
+		t.Fatal("add returned while the buffer was full")
+	case <-time.After(50 * time.Millisecond):
+	}
+	<-dlq.writeC
+	<-added
+	require.Empty(t, c.Snapshot()[metrics.TaskDLQWriteFailuresCounter.Name()])
+	require.Len(t, dlq.writeC, taskDLQBufferSize)
+
+	// The task is dropped if the buffer stays full.
+	dropped := make(chan struct{})
+	go func() {
+		dlq.add(queue, &persistencespb.AllocatedTaskInfo{TaskId: taskDLQBufferSize + 1}, dropReasonExpiredRead, time.Hour, capture)
+		close(dropped)
+	}()
+	require.Eventually(t, func() bool {
+		timeSource.Advance(taskDLQAddTimeout)
+		return isChannelClosed(dropped)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, c.Snapshot()[metrics.TaskDLQWriteFailuresCounter.Name()], 1)
+}
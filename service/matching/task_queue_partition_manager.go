@@ -101,12 +101,21 @@ type (
 
 		// rateLimitManager is used to manage the rate limit for task queues.
 		rateLimitManager *rateLimitManager
+		// concurrencyLimitManager limits outstanding tasks per fairness key. Only set for
+		// activity task queues.
+		concurrencyLimitManager *concurrencyLimitManager
 
 		scaleManager *scaleManager
 
 		// loadTime tracks when this partition manager was started, used to prevent
 		// false positives in the no-recent-poller metric for newly loaded queues
 		loadTime time.Time
+
+		// dispatchPauseChanged is closed and replaced when the dispatch pause state of the
+		// partition flips, so that waiting polls only restart when pausing or resuming.
+		dispatchPauseLock    sync.Mutex
+		dispatchPauseState   bool
+		dispatchPauseChanged chan struct{}
 	}
 )
 
@@ -188,9 +197,14 @@ func newTaskQueuePartitionManager(
 		defaultQueueFuture:    future.NewFuture[physicalTaskQueueManager](),
 		autoEnableRateLimiter: quotas.NewRateLimiter(1.0/60, 1),
 		taskHooks:             taskHooks,
+		dispatchPauseChanged:  make(chan struct{}),
 	}
 	pm.initCtx, pm.initCancel = context.WithCancel(context.Background())
 
+	if _, ok := partition.(*tqid.NormalPartition); ok && partition.TaskType() == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		pm.concurrencyLimitManager = newConcurrencyLimitManager(tqConfig, e.timeSource)
+	}
+
 	if pm.partition.IsRoot() {
 		pm.cache = cache.New(10000, &cache.Options{
 			TTL: max(1, tqConfig.TaskQueueInfoByBuildIdTTL())}, // ensure TTL is never zero (which would disable TTL)
@@ -337,6 +351,9 @@ func (pm *taskQueuePartitionManagerImpl) Stop(unloadCause unloadCause) {
 
 	// Finally, stop rate limit manager (used by queues and using user data manager).
 	pm.rateLimitManager.Stop()
+	if pm.concurrencyLimitManager != nil {
+		pm.concurrencyLimitManager.Stop()
+	}
 
 	pm.engine.updateTaskQueuePartitionGauge(pm.Namespace(), pm.partition, -1)
 
@@ -466,6 +483,10 @@ func (pm *taskQueuePartitionManagerImpl) GetRateLimitManager() *rateLimitManager
 	return pm.rateLimitManager
 }
 
+func (pm *taskQueuePartitionManagerImpl) GetConcurrencyLimitManager() *concurrencyLimitManager {
+	return pm.concurrencyLimitManager
+}
+
 func (pm *taskQueuePartitionManagerImpl) Namespace() *namespace.Namespace {
 	return pm.ns
 }
@@ -1484,9 +1505,17 @@ func (pm *taskQueuePartitionManagerImpl) describe(
 		}
 	}
 
+	var outstanding map[string]int64
+	if pm.concurrencyLimitManager.limited() {
+		outstanding = pm.concurrencyLimitManager.outstanding()
+	}
+
+	perType, _, _ := pm.getPerTypeUserData()
 	return &matchingservice.DescribeTaskQueuePartitionResponse{
-		VersionsInfoInternal: versionsInfo,
-		ScaleInfo:            pm.userDataManager.PartitionScale(),
+		VersionsInfoInternal:          versionsInfo,
+		ScaleInfo:                     pm.userDataManager.PartitionScale(),
+		DispatchPause:                 perType.GetDispatchPause(),
+		OutstandingTasksByFairnessKey: outstanding,
 	}, nil
 }
 
@@ -2447,9 +2476,29 @@ func (pm *taskQueuePartitionManagerImpl) getPerTypeUserData() (*persistencespb.T
 	return perType, userDataChanged, nil
 }
 
+// dispatchPaused returns whether dispatch is paused, and a channel that is closed when that changes.
+func (pm *taskQueuePartitionManagerImpl) dispatchPaused() (bool, <-chan struct{}) {
+	perType, _, err := pm.getPerTypeUserData()
+	if err != nil {
+		return false, nil
+	}
+	paused := perType.GetDispatchPause() != nil
+
+	pm.dispatchPauseLock.Lock()
+	defer pm.dispatchPauseLock.Unlock()
+	if paused != pm.dispatchPauseState {
+		pm.dispatchPauseState = paused
+		close(pm.dispatchPauseChanged)
+		pm.dispatchPauseChanged = make(chan struct{})
+	}
+	return paused, pm.dispatchPauseChanged
+}
+
 func (pm *taskQueuePartitionManagerImpl) userDataChanged(to *persistencespb.VersionedTaskQueueUserData) {
 	// Update rateLimits if any change is userData.
 	pm.rateLimitManager.UserDataChanged()
+	// Wake up the polls waiting on the previous dispatch pause state.
+	pm.dispatchPaused()
 
 	// Do not use defaultQueue() because that treats
 	// not being ready as an error, which is expected during bringup here.
@@ -65,6 +65,7 @@ type (
 		PutCache(key any, value any)
 		GetCache(key any) any
 		GetRateLimitManager() *rateLimitManager
+		GetConcurrencyLimitManager() *concurrencyLimitManager
 		GetConfig() *taskQueueConfig
 	}
 )
@@ -148,6 +148,20 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) GetCache(key any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCache", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).GetCache), key)
 }
 
+// GetConcurrencyLimitManager mocks base method.
+func (m *MocktaskQueuePartitionManager) GetConcurrencyLimitManager() *concurrencyLimitManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConcurrencyLimitManager")
+	ret0, _ := ret[0].(*concurrencyLimitManager)
+	return ret0
+}
+
+// GetConcurrencyLimitManager indicates an expected call of GetConcurrencyLimitManager.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) GetConcurrencyLimitManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConcurrencyLimitManager", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).GetConcurrencyLimitManager))
+}
+
 // GetConfig mocks base method.
 func (m *MocktaskQueuePartitionManager) GetConfig() *taskQueueConfig {
 	m.ctrl.T.Helper()
@@ -22,6 +22,9 @@ import (
 const (
 	taskReaderOfferThrottleWait  = time.Second
 	taskReaderThrottleRetryDelay = 3 * time.Second
+	// taskReaderMaxConstrainedTasks is the number of tasks with placement constraints that can
+	// wait for a matching poller outside of the dispatch loop.
+	taskReaderMaxConstrainedTasks = 1000
 )
 
 type (
@@ -34,6 +37,9 @@ type (
 		backoffTimer          *time.Timer
 		retrier               backoff.Retrier
 		backlogHeadCreateTime atomic.Int64
+		// constrainedTasks holds a slot for each task with placement constraints that is being
+		// dispatched outside of the dispatch loop.
+		constrainedTasks chan struct{}
 	}
 )
 
@@ -43,7 +49,8 @@ func newTaskReader(backlogMgr *backlogManagerImpl) *taskReader {
 		notifyC:    make(chan struct{}, 1),
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
-		taskBuffer: make(chan *persistencespb.AllocatedTaskInfo, backlogMgr.config.GetTasksBatchSize()-1),
+		taskBuffer:       make(chan *persistencespb.AllocatedTaskInfo, backlogMgr.config.GetTasksBatchSize()-1),
+		constrainedTasks: make(chan struct{}, taskReaderMaxConstrainedTasks),
 		retrier: backoff.NewRetrier(
 			common.CreateReadTaskRetryPolicy(),
 			clock.NewRealTimeSource(),
@@ -97,34 +104,57 @@ dispatchLoop:
 				break dispatchLoop
 			}
 			task := newInternalTaskFromBacklog(taskInfo, tr.completeTask)
-			for ctx.Err() == nil {
-				tr.updateBacklogAge(task)
-				taskCtx, cancel := context.WithTimeout(ctx, taskReaderOfferTimeout)
-				err := tr.backlogMgr.processSpooledTask(taskCtx, task)
-				cancel()
-				if err == nil {
-					continue dispatchLoop
+			if len(task.placementConstraints()) > 0 {
+				// A task with placement constraints may wait for a long time, or forever, for
+				// a poller that can run it, so it's moved aside to not hold up the rest of the
+				// backlog. Tasks are acked individually, so they can complete out of order.
+				select {
+				case tr.constrainedTasks <- struct{}{}:
+				case <-ctx.Done():
+					return
 				}
-
-				var stickyUnavailable *serviceerrors.StickyWorkerUnavailable
-				// if task is still valid (truly valid or unable to verify if task is valid)
-				metrics.BufferThrottlePerTaskQueueCounter.With(tr.taggedMetricsHandler()).Record(1)
-				if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) &&
-					// StickyWorkerUnavailable is expected for versioned sticky queues
-					!errors.As(err, &stickyUnavailable) {
-					tr.throttledLogger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				}
-				util.InterruptibleSleep(ctx, taskReaderOfferThrottleWait)
+				go func() {
+					defer func() { <-tr.constrainedTasks }()
+					tr.dispatchTask(ctx, task)
+				}()
+				continue dispatchLoop
+			}
+			if !tr.dispatchTask(ctx, task) {
+				return
 			}
-			return
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// dispatchTask offers the task until it's dispatched. It returns false if ctx is done first.
+func (tr *taskReader) dispatchTask(ctx context.Context, task *internalTask) bool {
+	for ctx.Err() == nil {
+		tr.updateBacklogAge(task)
+		taskCtx, cancel := context.WithTimeout(ctx, taskReaderOfferTimeout)
+		err := tr.backlogMgr.processSpooledTask(taskCtx, task)
+		cancel()
+		if err == nil {
+			return true
+		}
+
+		var stickyUnavailable *serviceerrors.StickyWorkerUnavailable
+		// if task is still valid (truly valid or unable to verify if task is valid)
+		metrics.BufferThrottlePerTaskQueueCounter.With(tr.taggedMetricsHandler()).Record(1)
+		if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) &&
+			// StickyWorkerUnavailable is expected for versioned sticky queues
+			!errors.As(err, &stickyUnavailable) {
+			tr.throttledLogger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+		}
+		util.InterruptibleSleep(ctx, taskReaderOfferThrottleWait)
+	}
+	return false
+}
+
 func (tr *taskReader) completeTask(task *internalTask, res taskResponse) {
 	recordDroppedTask(tr.taggedMetricsHandler(), res.dropReason)
+	tr.backlogMgr.pqMgr.DeadLetterTask(task.event.AllocatedTaskInfo, res.dropReason)
 	tr.backlogMgr.completeTask(task, res.startErr)
 }
 
@@ -255,6 +285,7 @@ func (tr *taskReader) addTasksToBuffer(
 			// task is expired when "add tasks to buffer" is called, so when we read it
 			metrics.ExpiredTasksPerTaskQueueCounter.With(tr.taggedMetricsHandler()).Record(1, metrics.TaskExpireStageReadTag)
 			recordDroppedTask(tr.taggedMetricsHandler(), dropReasonExpiredRead)
+			tr.backlogMgr.pqMgr.DeadLetterTask(t, dropReasonExpiredRead)
 			// Also increment readLevel for expired tasks otherwise it could result in
 			// looping over the same tasks if all tasks read in the batch are expired
 			tr.backlogMgr.taskAckManager.setReadLevel(t.GetTaskId())
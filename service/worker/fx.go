@@ -39,6 +39,8 @@ import (
 	"go.temporal.io/server/service/worker/dummy"
 	"go.temporal.io/server/service/worker/migration"
 	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/visibilitydrift"
+	"go.temporal.io/server/service/worker/visibilityrebuild"
 	"go.temporal.io/server/service/worker/workerdeployment"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
@@ -55,6 +57,8 @@ var Module = fx.Options(
 	workerdeployment.Module,
 	wcicomponent.Module,
 	dlq.Module,
+	visibilitydrift.Module,
+	visibilityrebuild.Module,
 	dummy.Module,
 	fx.Provide(schedulerpb.NewSchedulerServiceLayeredClient),
 	fx.Provide(
@@ -188,6 +192,7 @@ func VisibilityManagerProvider(
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
 		serviceConfig.VisibilityEnableUnifiedQueryConverter,
+		serviceConfig.VisibilityCountGroupByMaxGroups,
 		metricsHandler,
 		logger,
 		serializer,
@@ -105,6 +105,7 @@ type (
 		VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableManualPagination        dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableUnifiedQueryConverter   dynamicconfig.BoolPropertyFn
+		VisibilityCountGroupByMaxGroups         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -239,6 +240,7 @@ func NewConfig(
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
 		VisibilityEnableManualPagination:        dynamicconfig.VisibilityEnableManualPagination.Get(dc),
 		VisibilityEnableUnifiedQueryConverter:   dynamicconfig.VisibilityEnableUnifiedQueryConverter.Get(dc),
+		VisibilityCountGroupByMaxGroups:         dynamicconfig.VisibilityCountGroupByMaxGroups.Get(dc),
 	}
 	return config
 }
@@ -0,0 +1,274 @@
+package visibilitydrift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// HistoryClient contains the subset of methods from [historyservice.HistoryServiceClient] that we need, to make it
+	// easier to implement in tests.
+	HistoryClient interface {
+		RefreshWorkflowTasks(
+			ctx context.Context,
+			in *historyservice.RefreshWorkflowTasksRequest,
+			opts ...grpc.CallOption,
+		) (*historyservice.RefreshWorkflowTasksResponse, error)
+	}
+
+	activities struct {
+		visibilityManager manager.VisibilityManager
+		historyClient     HistoryClient
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+	}
+)
+
+// ErrSecondaryVisibilityNotConfigured is returned when the visibility manager isn't a dual visibility manager.
+var ErrSecondaryVisibilityNotConfigured = errors.New("secondary visibility store is not configured")
+
+// compareExecutions compares one page of executions from the primary store with the secondary store. Results are only
+// returned once the whole page is done, so a retried attempt doesn't count any execution twice.
+func (a *activities) compareExecutions(ctx context.Context, params compareParams) (compareResult, error) {
+	ctx = headers.SetCallerName(ctx, params.Namespace)
+	logger := log.With(a.logger,
+		tag.WorkflowNamespace(params.Namespace),
+		tag.WorkflowNamespaceID(params.NamespaceID))
+
+	dual, ok := a.visibilityManager.(*visibility.VisibilityManagerDual)
+	if !ok {
+		return compareResult{}, temporal.NewNonRetryableApplicationError(
+			ErrSecondaryVisibilityNotConfigured.Error(),
+			errorTypeInvalidRequest,
+			ErrSecondaryVisibilityNotConfigured,
+		)
+	}
+	primary, secondary := dual.GetPrimaryVisibility(), dual.GetSecondaryVisibility()
+
+	resp, err := primary.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   namespace.ID(params.NamespaceID),
+		Namespace:     namespace.Name(params.Namespace),
+		PageSize:      params.PageSize,
+		NextPageToken: params.NextPageToken,
+		Query:         params.Query,
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.InvalidArgument); ok {
+			return compareResult{}, temporal.NewNonRetryableApplicationError("invalid query", errorTypeInvalidRequest, err)
+		}
+		logger.Error("Unable to list workflow executions from the primary visibility store.", tag.Error(err))
+		return compareResult{}, err
+	}
+
+	metricsHandler := a.metricsHandler.WithTags(metrics.NamespaceTag(params.Namespace))
+	rateLimiter := quotas.NewRateLimiter(float64(params.RPS), params.RPS)
+	result := compareResult{NextPageToken: resp.NextPageToken}
+	for _, execution := range resp.Executions {
+		if params.MaxExecutions > 0 && result.ExecutionsCompared >= params.MaxExecutions {
+			// There's no need to continue to the next page once the limit is reached.
+			result.NextPageToken = nil
+			break
+		}
+		result.ExecutionsScanned++
+		if params.SampleRate > 0 && params.SampleRate < 1 && rand.Float64() >= params.SampleRate {
+			continue
+		}
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return compareResult{}, fmt.Errorf("rate limiter error: %w", err)
+		}
+
+		record, err := a.compareExecution(ctx, primary, secondary, params, execution)
+		if err != nil {
+			logger.Error("Unable to compare workflow execution.",
+				tag.WorkflowID(execution.GetExecution().GetWorkflowId()),
+				tag.WorkflowRunID(execution.GetExecution().GetRunId()),
+				tag.Error(err))
+			return compareResult{}, err
+		}
+		result.ExecutionsCompared++
+		metrics.VisibilityDriftComparedCount.With(metricsHandler).Record(1)
+		if record == nil {
+			continue
+		}
+
+		if record.Missing {
+			metrics.VisibilityDriftMissingCount.With(metricsHandler).Record(1)
+		}
+		for _, field := range record.MismatchedFields {
+			metrics.VisibilityDriftMismatchCount.With(metricsHandler).Record(1, metrics.StringTag("field", field))
+		}
+		logger.Info("Workflow execution differs between visibility stores.",
+			tag.WorkflowID(record.WorkflowID),
+			tag.WorkflowRunID(record.RunID),
+			tag.NewBoolTag("missing", record.Missing),
+			tag.NewStringsTag("mismatched-fields", record.MismatchedFields))
+
+		if params.Repair {
+			if err := a.repair(ctx, params, execution.GetExecution()); err != nil {
+				return compareResult{}, err
+			}
+			record.Repaired = true
+			metrics.VisibilityDriftRepairedCount.With(metricsHandler).Record(1)
+		}
+		result.add(*record)
+	}
+	return result, nil
+}
+
+// compareExecution returns a DriftRecord if execution differs in the secondary store, or nil if it doesn't.
+func (a *activities) compareExecution(
+	ctx context.Context,
+	primary manager.VisibilityManager,
+	secondary manager.VisibilityManager,
+	params compareParams,
+	execution *workflowpb.WorkflowExecutionInfo,
+) (*DriftRecord, error) {
+	missing, fields, err := a.diff(ctx, secondary, params, execution)
+	if err != nil || (!missing && len(fields) == 0) {
+		return nil, err
+	}
+
+	// The execution may have changed after the page was read, and the secondary store may not have caught up with a
+	// recent change yet. Read the primary record again and only report drift that's still there.
+	resp, err := primary.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+		NamespaceID: namespace.ID(params.NamespaceID),
+		Namespace:   namespace.Name(params.Namespace),
+		WorkflowID:  execution.GetExecution().GetWorkflowId(),
+		RunID:       execution.GetExecution().GetRunId(),
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			// Deleted in the meantime.
+			return nil, nil
+		}
+		return nil, err
+	}
+	missing, fields, err = a.diff(ctx, secondary, params, resp.Execution)
+	if err != nil || (!missing && len(fields) == 0) {
+		return nil, err
+	}
+	return &DriftRecord{
+		WorkflowID:       execution.GetExecution().GetWorkflowId(),
+		RunID:            execution.GetExecution().GetRunId(),
+		Missing:          missing,
+		MismatchedFields: fields,
+	}, nil
+}
+
+func (a *activities) diff(
+	ctx context.Context,
+	secondary manager.VisibilityManager,
+	params compareParams,
+	execution *workflowpb.WorkflowExecutionInfo,
+) (missing bool, fields []string, err error) {
+	resp, err := secondary.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+		NamespaceID: namespace.ID(params.NamespaceID),
+		Namespace:   namespace.Name(params.Namespace),
+		WorkflowID:  execution.GetExecution().GetWorkflowId(),
+		RunID:       execution.GetExecution().GetRunId(),
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return true, nil, nil
+		}
+		return false, nil, err
+	}
+	return false, compareExecutionInfo(execution, resp.Execution), nil
+}
+
+// repair refreshes the tasks of the execution, which re-emits its visibility tasks. The history service writes those
+// to both visibility stores.
+func (a *activities) repair(ctx context.Context, params compareParams, execution *commonpb.WorkflowExecution) error {
+	_, err := a.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: params.NamespaceID,
+		ArchetypeId: chasm.WorkflowArchetypeID,
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: params.NamespaceID,
+			Execution:   execution,
+		},
+	})
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		// The execution was deleted, so the visibility record will be deleted as well.
+		return nil
+	}
+	return err
+}
+
+// compareExecutionInfo returns the Field* constants that differ between the primary and secondary record.
+func compareExecutionInfo(primary, secondary *workflowpb.WorkflowExecutionInfo) []string {
+	var fields []string
+	if primary.GetStatus() != secondary.GetStatus() {
+		fields = append(fields, FieldStatus)
+	}
+	// Stores keep timestamps at different precisions, so only milliseconds are compared.
+	if !primary.GetCloseTime().AsTime().Truncate(time.Millisecond).Equal(secondary.GetCloseTime().AsTime().Truncate(time.Millisecond)) {
+		fields = append(fields, FieldCloseTime)
+	}
+	if !equalSearchAttributes(primary.GetSearchAttributes(), secondary.GetSearchAttributes()) {
+		fields = append(fields, FieldSearchAttributes)
+	}
+	if !equalMemo(primary.GetMemo(), secondary.GetMemo()) {
+		fields = append(fields, FieldMemo)
+	}
+	return fields
+}
+
+// equalSearchAttributes compares decoded values rather than payloads because stores don't return values in the same
+// encoding, e.g. datetime precision differs.
+func equalSearchAttributes(primary, secondary *commonpb.SearchAttributes) bool {
+	primaryValues, err := searchattribute.Decode(primary, nil, true)
+	if err != nil {
+		return proto.Equal(primary, secondary)
+	}
+	secondaryValues, err := searchattribute.Decode(secondary, nil, true)
+	if err != nil {
+		return proto.Equal(primary, secondary)
+	}
+	if len(primaryValues) != len(secondaryValues) {
+		return false
+	}
+	for name, value := range primaryValues {
+		other, ok := secondaryValues[name]
+		if !ok || !reflect.DeepEqual(normalizeValue(value), normalizeValue(other)) {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeValue(value any) any {
+	if t, ok := value.(time.Time); ok {
+		return t.UTC().Truncate(time.Millisecond)
+	}
+	return value
+}
+
+func equalMemo(primary, secondary *commonpb.Memo) bool {
+	if len(primary.GetFields()) == 0 && len(secondary.GetFields()) == 0 {
+		return true
+	}
+	return proto.Equal(primary, secondary)
+}
@@ -0,0 +1,214 @@
+package visibilitydrift
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type testHistoryClient struct {
+	refreshed []*commonpb.WorkflowExecution
+}
+
+func (c *testHistoryClient) RefreshWorkflowTasks(
+	_ context.Context,
+	in *historyservice.RefreshWorkflowTasksRequest,
+	_ ...grpc.CallOption,
+) (*historyservice.RefreshWorkflowTasksResponse, error) {
+	c.refreshed = append(c.refreshed, in.GetRequest().GetExecution())
+	return &historyservice.RefreshWorkflowTasksResponse{}, nil
+}
+
+func newExecutionInfo(workflowID string, status enumspb.WorkflowExecutionStatus) *workflowpb.WorkflowExecutionInfo {
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: workflowID + "-run"},
+		Status:    status,
+	}
+}
+
+func TestCompareExecutions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := manager.NewMockVisibilityManager(ctrl)
+	secondary := manager.NewMockVisibilityManager(ctrl)
+	historyClient := &testHistoryClient{}
+	a := &activities{
+		visibilityManager: visibility.NewVisibilityManagerDual(primary, secondary, nil, nil),
+		historyClient:     historyClient,
+		metricsHandler:    metrics.NoopMetricsHandler,
+		logger:            log.NewTestLogger(),
+	}
+
+	same := newExecutionInfo("same", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	missing := newExecutionInfo("missing", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	stale := newExecutionInfo("stale", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	caughtUp := newExecutionInfo("caught-up", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+
+	primary.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   "ns-id",
+		Namespace:     "ns",
+		PageSize:      10,
+		NextPageToken: []byte("page"),
+		Query:         "WorkflowType = 'foo'",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions:    []*workflowpb.WorkflowExecutionInfo{same, missing, stale, caughtUp},
+		NextPageToken: []byte("next"),
+	}, nil)
+	secondaryRecords := map[string][]*workflowpb.WorkflowExecutionInfo{
+		"same":    {same},
+		"missing": nil,
+		"stale":   {newExecutionInfo("stale", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)},
+		// The secondary store catches up before the second read.
+		"caught-up": {newExecutionInfo("caught-up", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), caughtUp},
+	}
+	secondary.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *manager.GetWorkflowExecutionRequest) (*manager.GetWorkflowExecutionResponse, error) {
+			records := secondaryRecords[req.WorkflowID]
+			if len(records) == 0 {
+				return nil, serviceerror.NewNotFound("not found")
+			}
+			secondaryRecords[req.WorkflowID] = records[1:]
+			if len(secondaryRecords[req.WorkflowID]) == 0 {
+				secondaryRecords[req.WorkflowID] = records
+			}
+			return &manager.GetWorkflowExecutionResponse{Execution: records[0]}, nil
+		}).AnyTimes()
+	primary.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *manager.GetWorkflowExecutionRequest) (*manager.GetWorkflowExecutionResponse, error) {
+			for _, execution := range []*workflowpb.WorkflowExecutionInfo{missing, stale, caughtUp} {
+				if execution.GetExecution().GetWorkflowId() == req.WorkflowID {
+					return &manager.GetWorkflowExecutionResponse{Execution: execution}, nil
+				}
+			}
+			return nil, serviceerror.NewNotFound("not found")
+		}).Times(3)
+
+	result, err := a.compareExecutions(context.Background(), compareParams{
+		Namespace:     "ns",
+		NamespaceID:   "ns-id",
+		Query:         "WorkflowType = 'foo'",
+		Repair:        true,
+		RPS:           1000,
+		PageSize:      10,
+		NextPageToken: []byte("page"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("next"), result.NextPageToken)
+	assert.Equal(t, int64(4), result.ExecutionsScanned)
+	assert.Equal(t, int64(4), result.ExecutionsCompared)
+	assert.Equal(t, int64(1), result.MissingCount)
+	assert.Equal(t, int64(1), result.MismatchCount)
+	assert.Equal(t, int64(2), result.RepairedCount)
+	assert.Equal(t, map[string]int64{FieldStatus: 1}, result.FieldMismatchCounts)
+	assert.Equal(t, []DriftRecord{
+		{WorkflowID: "missing", RunID: "missing-run", Missing: true, Repaired: true},
+		{WorkflowID: "stale", RunID: "stale-run", MismatchedFields: []string{FieldStatus}, Repaired: true},
+	}, result.DriftedExecutions)
+	assert.Equal(t, []*commonpb.WorkflowExecution{missing.GetExecution(), stale.GetExecution()}, historyClient.refreshed)
+}
+
+func TestCompareExecutions_MaxExecutions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := manager.NewMockVisibilityManager(ctrl)
+	secondary := manager.NewMockVisibilityManager(ctrl)
+	a := &activities{
+		visibilityManager: visibility.NewVisibilityManagerDual(primary, secondary, nil, nil),
+		metricsHandler:    metrics.NoopMetricsHandler,
+		logger:            log.NewTestLogger(),
+	}
+
+	first := newExecutionInfo("first", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	primary.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			first,
+			newExecutionInfo("second", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		},
+		NextPageToken: []byte("next"),
+	}, nil)
+	secondary.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&manager.GetWorkflowExecutionResponse{Execution: first}, nil)
+
+	result, err := a.compareExecutions(context.Background(), compareParams{
+		Namespace:     "ns",
+		NamespaceID:   "ns-id",
+		RPS:           1000,
+		PageSize:      10,
+		MaxExecutions: 1,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, result.NextPageToken)
+	assert.Equal(t, int64(1), result.ExecutionsCompared)
+}
+
+func TestCompareExecutions_SecondaryNotConfigured(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	a := &activities{
+		visibilityManager: manager.NewMockVisibilityManager(ctrl),
+		metricsHandler:    metrics.NoopMetricsHandler,
+		logger:            log.NewTestLogger(),
+	}
+	_, err := a.compareExecutions(context.Background(), compareParams{Namespace: "ns", NamespaceID: "ns-id"})
+	var applicationErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &applicationErr)
+	assert.True(t, applicationErr.NonRetryable())
+	assert.ErrorIs(t, err, ErrSecondaryVisibilityNotConfigured)
+}
+
+func TestCompareExecutionInfo(t *testing.T) {
+	closeTime := time.Date(2026, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	typeMap := searchattribute.TestEsNameTypeMap()
+	encodeSearchAttributes := func(values map[string]any) *commonpb.SearchAttributes {
+		sa, err := searchattribute.Encode(values, &typeMap)
+		require.NoError(t, err)
+		return sa
+	}
+	primary := &workflowpb.WorkflowExecutionInfo{
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		CloseTime: timestamppb.New(closeTime),
+		SearchAttributes: encodeSearchAttributes(map[string]any{
+			"CustomKeywordField":  "foo",
+			"CustomDatetimeField": closeTime,
+		}),
+		Memo: &commonpb.Memo{},
+	}
+
+	// Precision differences and empty memos aren't drift.
+	secondary := &workflowpb.WorkflowExecutionInfo{
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		CloseTime: timestamppb.New(closeTime.Truncate(time.Millisecond)),
+		SearchAttributes: encodeSearchAttributes(map[string]any{
+			"CustomKeywordField":  "foo",
+			"CustomDatetimeField": closeTime.Truncate(time.Microsecond),
+		}),
+	}
+	assert.Empty(t, compareExecutionInfo(primary, secondary))
+
+	memo, err := payload.Encode("memo")
+	require.NoError(t, err)
+	secondary = &workflowpb.WorkflowExecutionInfo{
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		SearchAttributes: encodeSearchAttributes(map[string]any{"CustomKeywordField": "bar"}),
+		Memo:             &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": memo}},
+	}
+	assert.Equal(t,
+		[]string{FieldStatus, FieldCloseTime, FieldSearchAttributes, FieldMemo},
+		compareExecutionInfo(primary, secondary),
+	)
+}
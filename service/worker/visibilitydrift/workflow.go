@@ -0,0 +1,267 @@
+// Package visibilitydrift contains the workflow that compares the executions of a namespace in the primary and
+// secondary visibility stores of a dual visibility manager, which is used while migrating between visibility stores.
+// The workflow reports the drift it finds and can optionally repair the secondary store by re-emitting the visibility
+// tasks of drifted executions.
+package visibilitydrift
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	// WorkflowParams is the single argument to the visibility drift workflow.
+	WorkflowParams struct {
+		Namespace   string
+		NamespaceID string
+		// SampleRate is the fraction of executions to compare, in (0, 1]. 0 compares every execution.
+		SampleRate float64
+		// MaxExecutions stops the workflow after comparing this many executions. 0 means no limit.
+		MaxExecutions int64
+		// Query optionally limits the executions that are scanned.
+		Query string
+		// Repair re-emits the visibility tasks of executions that are missing from or differ in the secondary store.
+		Repair bool
+		// RPS is the maximum number of executions compared per second. The default is DefaultRPS.
+		RPS int
+		// PageSize is the number of executions read from the primary store at a time. The default is
+		// DefaultPageSize.
+		PageSize int
+
+		// Progress and NextPageToken are carried over with continue-as-new.
+		Progress      Progress
+		NextPageToken []byte
+	}
+
+	// Progress is the accumulated result of the visibility drift workflow.
+	Progress struct {
+		// ExecutionsScanned is the number of executions read from the primary store.
+		ExecutionsScanned int64
+		// ExecutionsCompared is the number of scanned executions that were compared with the secondary store.
+		ExecutionsCompared int64
+		// MissingCount is the number of compared executions that don't exist in the secondary store.
+		MissingCount int64
+		// MismatchCount is the number of compared executions that exist in the secondary store but differ.
+		MismatchCount int64
+		// RepairedCount is the number of executions for which visibility tasks were re-emitted.
+		RepairedCount int64
+		// FieldMismatchCounts is the number of mismatches by Field* constant.
+		FieldMismatchCounts map[string]int64
+		// DriftedExecutions are the first MaxDriftedExecutions drifted executions found.
+		DriftedExecutions []DriftRecord
+	}
+
+	// DriftRecord describes an execution that differs between the primary and secondary store.
+	DriftRecord struct {
+		WorkflowID string
+		RunID      string
+		// Missing is true if the execution doesn't exist in the secondary store.
+		Missing bool
+		// MismatchedFields are the Field* constants that differ.
+		MismatchedFields []string
+		Repaired         bool
+	}
+
+	// ProgressQueryResponse is the response to QueryTypeProgress.
+	ProgressQueryResponse struct {
+		Namespace string
+		Repair    bool
+		Progress
+	}
+
+	compareParams struct {
+		Namespace     string
+		NamespaceID   string
+		Query         string
+		SampleRate    float64
+		Repair        bool
+		RPS           int
+		PageSize      int
+		NextPageToken []byte
+		// MaxExecutions is the number of executions left to compare. 0 means no limit.
+		MaxExecutions int64
+	}
+
+	compareResult struct {
+		Progress
+		NextPageToken []byte
+	}
+)
+
+const (
+	// WorkflowName is the name of the visibility drift workflow.
+	WorkflowName = "temporal-sys-visibility-drift-workflow"
+	// QueryTypeProgress is the query to get the progress of the visibility drift workflow.
+	QueryTypeProgress = "visibility-drift-progress-query"
+
+	// DefaultRPS is the default value for WorkflowParams.RPS.
+	DefaultRPS = 100
+	// DefaultPageSize is the default value for WorkflowParams.PageSize.
+	DefaultPageSize = 100
+	// MaxPageSize is the maximum value for WorkflowParams.PageSize.
+	MaxPageSize = 1000
+	// MaxDriftedExecutions is the number of drifted executions kept in Progress.DriftedExecutions.
+	MaxDriftedExecutions = 100
+
+	// FieldStatus is reported when the execution status differs.
+	FieldStatus = "status"
+	// FieldCloseTime is reported when the close time differs.
+	FieldCloseTime = "close_time"
+	// FieldSearchAttributes is reported when any search attribute differs.
+	FieldSearchAttributes = "search_attributes"
+	// FieldMemo is reported when the memo differs.
+	FieldMemo = "memo"
+
+	errorTypeInvalidRequest = "visibility-drift-error-type-invalid-request"
+	compareActivityName     = "visibility-drift-compare-activity"
+
+	// pagesPerRun is the number of pages compared before continuing as new to keep the history small.
+	pagesPerRun = 500
+	// compareActivityTimeout is long enough to compare and repair a page at a low RPS.
+	compareActivityTimeout = 10 * time.Minute * debug.TimeoutMultiplier
+)
+
+var compareActivityRetryPolicy = &temporal.RetryPolicy{
+	InitialInterval:    time.Second,
+	BackoffCoefficient: 2.0,
+	MaximumInterval:    time.Minute,
+	MaximumAttempts:    10,
+}
+
+func validateParams(params *WorkflowParams) error {
+	if params.Namespace == "" || params.NamespaceID == "" {
+		return temporal.NewNonRetryableApplicationError("namespace is required", errorTypeInvalidRequest, nil)
+	}
+	if params.SampleRate < 0 || params.SampleRate > 1 {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("sample rate %v must be between 0 and 1", params.SampleRate),
+			errorTypeInvalidRequest,
+			nil,
+		)
+	}
+	if params.MaxExecutions < 0 || params.RPS < 0 || params.PageSize < 0 {
+		return temporal.NewNonRetryableApplicationError(
+			"max executions, RPS and page size must not be negative",
+			errorTypeInvalidRequest,
+			nil,
+		)
+	}
+	if params.PageSize > MaxPageSize {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("page size %d must be less than or equal to %d", params.PageSize, MaxPageSize),
+			errorTypeInvalidRequest,
+			nil,
+		)
+	}
+	if params.RPS == 0 {
+		params.RPS = DefaultRPS
+	}
+	if params.PageSize == 0 {
+		params.PageSize = DefaultPageSize
+	}
+	return nil
+}
+
+// Workflow pages through the executions of a namespace in the primary store and compares each page with the
+// secondary store in an activity. It continues as new every pagesPerRun pages.
+func Workflow(ctx workflow.Context, params WorkflowParams) (Progress, error) {
+	progress := params.Progress
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (ProgressQueryResponse, error) {
+		return ProgressQueryResponse{
+			Namespace: params.Namespace,
+			Repair:    params.Repair,
+			Progress:  progress,
+		}, nil
+	}); err != nil {
+		return progress, err
+	}
+	if err := validateParams(&params); err != nil {
+		return progress, err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           primitives.VisibilityDriftActivityTQ,
+		StartToCloseTimeout: compareActivityTimeout,
+		RetryPolicy:         compareActivityRetryPolicy,
+	})
+	for range pagesPerRun {
+		var maxExecutions int64
+		if params.MaxExecutions > 0 {
+			maxExecutions = params.MaxExecutions - progress.ExecutionsCompared
+		}
+		var result compareResult
+		err := workflow.ExecuteActivity(ctx, compareActivityName, compareParams{
+			Namespace:     params.Namespace,
+			NamespaceID:   params.NamespaceID,
+			Query:         params.Query,
+			SampleRate:    params.SampleRate,
+			Repair:        params.Repair,
+			RPS:           params.RPS,
+			PageSize:      params.PageSize,
+			NextPageToken: params.NextPageToken,
+			MaxExecutions: maxExecutions,
+		}).Get(ctx, &result)
+		if err != nil {
+			return progress, err
+		}
+		progress.merge(result.Progress)
+		params.NextPageToken = result.NextPageToken
+
+		if len(params.NextPageToken) == 0 {
+			return progress, nil
+		}
+		if params.MaxExecutions > 0 && progress.ExecutionsCompared >= params.MaxExecutions {
+			return progress, nil
+		}
+	}
+
+	params.Progress = progress
+	return progress, workflow.NewContinueAsNewError(ctx, WorkflowName, params)
+}
+
+// merge adds the counts of other to p and appends its drifted executions up to MaxDriftedExecutions.
+func (p *Progress) merge(other Progress) {
+	p.ExecutionsScanned += other.ExecutionsScanned
+	p.ExecutionsCompared += other.ExecutionsCompared
+	p.MissingCount += other.MissingCount
+	p.MismatchCount += other.MismatchCount
+	p.RepairedCount += other.RepairedCount
+	for field, count := range other.FieldMismatchCounts {
+		if p.FieldMismatchCounts == nil {
+			p.FieldMismatchCounts = make(map[string]int64)
+		}
+		p.FieldMismatchCounts[field] += count
+	}
+	for _, record := range other.DriftedExecutions {
+		if len(p.DriftedExecutions) >= MaxDriftedExecutions {
+			break
+		}
+		p.DriftedExecutions = append(p.DriftedExecutions, record)
+	}
+}
+
+// add records a single drifted execution.
+func (p *Progress) add(record DriftRecord) {
+	if record.Missing {
+		p.MissingCount++
+	} else {
+		p.MismatchCount++
+	}
+	if record.Repaired {
+		p.RepairedCount++
+	}
+	for _, field := range record.MismatchedFields {
+		if p.FieldMismatchCounts == nil {
+			p.FieldMismatchCounts = make(map[string]int64)
+		}
+		p.FieldMismatchCounts[field]++
+	}
+	if len(p.DriftedExecutions) < MaxDriftedExecutions {
+		p.DriftedExecutions = append(p.DriftedExecutions, record)
+	}
+}
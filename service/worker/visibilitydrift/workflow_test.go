@@ -0,0 +1,150 @@
+package visibilitydrift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/server/common/log"
+)
+
+func newTestEnv() *testsuite.TestWorkflowEnvironment {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+	component := newComponent(componentParams{})
+	component.RegisterWorkflow(env)
+	component.RegisterActivities(env)
+	return env
+}
+
+func TestWorkflow(t *testing.T) {
+	env := newTestEnv()
+
+	env.OnActivity(compareActivityName, mock.Anything, compareParams{
+		Namespace:   "ns",
+		NamespaceID: "ns-id",
+		Repair:      true,
+		RPS:         DefaultRPS,
+		PageSize:    DefaultPageSize,
+	}).Return(compareResult{
+		Progress: Progress{
+			ExecutionsScanned:   2,
+			ExecutionsCompared:  2,
+			MissingCount:        1,
+			RepairedCount:       1,
+			DriftedExecutions:   []DriftRecord{{WorkflowID: "wf1", RunID: "run1", Missing: true, Repaired: true}},
+			FieldMismatchCounts: map[string]int64{},
+		},
+		NextPageToken: []byte("page2"),
+	}, nil).Once()
+	env.OnActivity(compareActivityName, mock.Anything, compareParams{
+		Namespace:     "ns",
+		NamespaceID:   "ns-id",
+		Repair:        true,
+		RPS:           DefaultRPS,
+		PageSize:      DefaultPageSize,
+		NextPageToken: []byte("page2"),
+	}).Return(compareResult{
+		Progress: Progress{
+			ExecutionsScanned:   1,
+			ExecutionsCompared:  1,
+			MismatchCount:       1,
+			RepairedCount:       1,
+			FieldMismatchCounts: map[string]int64{FieldStatus: 1, FieldCloseTime: 1},
+			DriftedExecutions: []DriftRecord{{
+				WorkflowID:       "wf2",
+				RunID:            "run2",
+				MismatchedFields: []string{FieldStatus, FieldCloseTime},
+				Repaired:         true,
+			}},
+		},
+	}, nil).Once()
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		Namespace:   "ns",
+		NamespaceID: "ns-id",
+		Repair:      true,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var progress Progress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	assert.Equal(t, int64(3), progress.ExecutionsScanned)
+	assert.Equal(t, int64(3), progress.ExecutionsCompared)
+	assert.Equal(t, int64(1), progress.MissingCount)
+	assert.Equal(t, int64(1), progress.MismatchCount)
+	assert.Equal(t, int64(2), progress.RepairedCount)
+	assert.Equal(t, map[string]int64{FieldStatus: 1, FieldCloseTime: 1}, progress.FieldMismatchCounts)
+	assert.Len(t, progress.DriftedExecutions, 2)
+
+	value, err := env.QueryWorkflow(QueryTypeProgress)
+	require.NoError(t, err)
+	var queryResponse ProgressQueryResponse
+	require.NoError(t, value.Get(&queryResponse))
+	assert.Equal(t, "ns", queryResponse.Namespace)
+	assert.True(t, queryResponse.Repair)
+	assert.Equal(t, progress, queryResponse.Progress)
+}
+
+func TestWorkflow_MaxExecutions(t *testing.T) {
+	env := newTestEnv()
+
+	env.OnActivity(compareActivityName, mock.Anything, mock.MatchedBy(func(params compareParams) bool {
+		return params.MaxExecutions == 5 && params.SampleRate == 0.5
+	})).Return(compareResult{
+		Progress:      Progress{ExecutionsScanned: 10, ExecutionsCompared: 5},
+		NextPageToken: []byte("page2"),
+	}, nil).Once()
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		Namespace:     "ns",
+		NamespaceID:   "ns-id",
+		SampleRate:    0.5,
+		MaxExecutions: 5,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var progress Progress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	assert.Equal(t, int64(5), progress.ExecutionsCompared)
+}
+
+func TestWorkflow_InvalidParams(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		params WorkflowParams
+	}{
+		{name: "no namespace", params: WorkflowParams{}},
+		{name: "sample rate", params: WorkflowParams{Namespace: "ns", NamespaceID: "ns-id", SampleRate: 1.5}},
+		{name: "negative rps", params: WorkflowParams{Namespace: "ns", NamespaceID: "ns-id", RPS: -1}},
+		{name: "page size", params: WorkflowParams{Namespace: "ns", NamespaceID: "ns-id", PageSize: MaxPageSize + 1}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			env := newTestEnv()
+			env.ExecuteWorkflow(WorkflowName, tc.params)
+
+			require.True(t, env.IsWorkflowCompleted())
+			var applicationErr *temporal.ApplicationError
+			require.ErrorAs(t, env.GetWorkflowError(), &applicationErr)
+			assert.Equal(t, errorTypeInvalidRequest, applicationErr.Type())
+		})
+	}
+}
+
+func TestProgressMerge_CapsDriftedExecutions(t *testing.T) {
+	var progress Progress
+	for range MaxDriftedExecutions + 10 {
+		var page Progress
+		page.add(DriftRecord{WorkflowID: "wf", MismatchedFields: []string{FieldMemo}})
+		progress.merge(page)
+	}
+	assert.Equal(t, int64(MaxDriftedExecutions+10), progress.MismatchCount)
+	assert.Equal(t, int64(MaxDriftedExecutions+10), progress.FieldMismatchCounts[FieldMemo])
+	assert.Len(t, progress.DriftedExecutions, MaxDriftedExecutions)
+}
@@ -0,0 +1,109 @@
+package visibilityrebuild
+
+import (
+	"context"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+	"google.golang.org/grpc"
+)
+
+type (
+	// HistoryClient contains the subset of methods from [historyservice.HistoryServiceClient] that we need, to make it
+	// easier to implement in tests.
+	HistoryClient interface {
+		RebuildWorkflowVisibility(
+			ctx context.Context,
+			in *historyservice.RebuildWorkflowVisibilityRequest,
+			opts ...grpc.CallOption,
+		) (*historyservice.RebuildWorkflowVisibilityResponse, error)
+	}
+
+	activities struct {
+		executionManager persistence.ExecutionManager
+		historyClient    HistoryClient
+		metricsHandler   metrics.Handler
+		logger           log.Logger
+	}
+)
+
+// rebuildPage rebuilds the visibility records of the executions of the namespace in one page of a shard. Results are
+// only returned once the whole page is done. Writing a record is idempotent, so a retried attempt starts over from the
+// beginning of the page.
+func (a *activities) rebuildPage(ctx context.Context, params rebuildParams) (rebuildResult, error) {
+	ctx = headers.SetCallerName(ctx, params.Namespace)
+	logger := log.With(a.logger,
+		tag.WorkflowNamespace(params.Namespace),
+		tag.WorkflowNamespaceID(params.NamespaceID),
+		tag.ShardID(params.ShardID))
+
+	resp, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   params.ShardID,
+		PageSize:  params.PageSize,
+		PageToken: params.NextPageToken,
+	})
+	if err != nil {
+		logger.Error("Unable to list executions from the execution store.", tag.Error(err))
+		return rebuildResult{}, err
+	}
+
+	metricsHandler := a.metricsHandler.WithTags(metrics.NamespaceTag(params.Namespace))
+	rateLimiter := quotas.NewRateLimiter(float64(params.RPS), params.RPS)
+	result := rebuildResult{NextPageToken: resp.PageToken}
+	for _, state := range resp.States {
+		if state.GetExecutionInfo().GetNamespaceId() != params.NamespaceID {
+			continue
+		}
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return rebuildResult{}, fmt.Errorf("rate limiter error: %w", err)
+		}
+
+		execution := &commonpb.WorkflowExecution{
+			WorkflowId: state.GetExecutionInfo().GetWorkflowId(),
+			RunId:      state.GetExecutionState().GetRunId(),
+		}
+		rebuildResp, err := a.historyClient.RebuildWorkflowVisibility(ctx, &historyservice.RebuildWorkflowVisibilityRequest{
+			NamespaceId:     params.NamespaceID,
+			Execution:       execution,
+			VisibilityStore: params.VisibilityStore,
+		})
+		switch err.(type) {
+		case nil:
+		case *serviceerror.NotFound:
+			// The execution was deleted after the page was read, or it isn't a workflow.
+			result.ExecutionsSkipped++
+			metrics.VisibilityRebuildSkippedCount.With(metricsHandler).Record(1)
+			continue
+		case *serviceerror.InvalidArgument, *serviceerror.FailedPrecondition:
+			return rebuildResult{}, temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidRequest, err)
+		default:
+			logger.Error("Unable to rebuild workflow visibility.",
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(execution.GetRunId()),
+				tag.Error(err))
+			return rebuildResult{}, err
+		}
+
+		result.ExecutionsRebuilt++
+		metrics.VisibilityRebuildCount.With(metricsHandler).Record(1)
+		if rebuildResp.GetClosed() {
+			result.ClosedExecutionsRebuilt++
+		}
+		if rebuildResp.GetRelocatableAttributesMissing() {
+			result.RelocatableAttributesMissingCount++
+			logger.Warn("Rebuilt visibility record without memo and search attributes.",
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(execution.GetRunId()))
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,135 @@
+package visibilityrebuild
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+type testHistoryClient struct {
+	requests  []*historyservice.RebuildWorkflowVisibilityRequest
+	responses map[string]*historyservice.RebuildWorkflowVisibilityResponse
+	errors    map[string]error
+}
+
+func (c *testHistoryClient) RebuildWorkflowVisibility(
+	_ context.Context,
+	in *historyservice.RebuildWorkflowVisibilityRequest,
+	_ ...grpc.CallOption,
+) (*historyservice.RebuildWorkflowVisibilityResponse, error) {
+	c.requests = append(c.requests, in)
+	workflowID := in.GetExecution().GetWorkflowId()
+	if err := c.errors[workflowID]; err != nil {
+		return nil, err
+	}
+	return c.responses[workflowID], nil
+}
+
+func newMutableState(namespaceID string, workflowID string) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo:  &persistencespb.WorkflowExecutionInfo{NamespaceId: namespaceID, WorkflowId: workflowID},
+		ExecutionState: &persistencespb.WorkflowExecutionState{RunId: workflowID + "-run"},
+	}
+}
+
+func TestRebuildPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	historyClient := &testHistoryClient{
+		responses: map[string]*historyservice.RebuildWorkflowVisibilityResponse{
+			"running": {},
+			"closed":  {Closed: true, RelocatableAttributesMissing: true},
+		},
+		errors: map[string]error{
+			"deleted": serviceerror.NewNotFound("not found"),
+		},
+	}
+	a := &activities{
+		executionManager: executionManager,
+		historyClient:    historyClient,
+		metricsHandler:   metrics.NoopMetricsHandler,
+		logger:           log.NewTestLogger(),
+	}
+
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   3,
+		PageSize:  10,
+		PageToken: []byte("page"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newMutableState("ns-id", "running"),
+			newMutableState("other-ns-id", "other"),
+			newMutableState("ns-id", "closed"),
+			newMutableState("ns-id", "deleted"),
+		},
+		PageToken: []byte("next"),
+	}, nil)
+
+	result, err := a.rebuildPage(context.Background(), rebuildParams{
+		Namespace:       "ns",
+		NamespaceID:     "ns-id",
+		VisibilityStore: enumsspb.VISIBILITY_STORE_TARGET_PRIMARY,
+		RPS:             1000,
+		PageSize:        10,
+		ShardID:         3,
+		NextPageToken:   []byte("page"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, rebuildResult{
+		Progress: Progress{
+			ExecutionsRebuilt:                 2,
+			ClosedExecutionsRebuilt:           1,
+			ExecutionsSkipped:                 1,
+			RelocatableAttributesMissingCount: 1,
+		},
+		NextPageToken: []byte("next"),
+	}, result)
+	require.Len(t, historyClient.requests, 3)
+	for _, request := range historyClient.requests {
+		assert.Equal(t, "ns-id", request.GetNamespaceId())
+		assert.Equal(t, enumsspb.VISIBILITY_STORE_TARGET_PRIMARY, request.GetVisibilityStore())
+		assert.Equal(t, request.GetExecution().GetWorkflowId()+"-run", request.GetExecution().GetRunId())
+	}
+}
+
+func TestRebuildPage_SecondaryNotConfigured(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	a := &activities{
+		executionManager: executionManager,
+		historyClient: &testHistoryClient{
+			errors: map[string]error{
+				"running": serviceerror.NewFailedPrecondition("secondary visibility store is not configured"),
+			},
+		},
+		metricsHandler: metrics.NoopMetricsHandler,
+		logger:         log.NewTestLogger(),
+	}
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{newMutableState("ns-id", "running")},
+	}, nil)
+
+	_, err := a.rebuildPage(context.Background(), rebuildParams{
+		Namespace:       "ns",
+		NamespaceID:     "ns-id",
+		VisibilityStore: enumsspb.VISIBILITY_STORE_TARGET_SECONDARY,
+		RPS:             1000,
+		PageSize:        10,
+		ShardID:         1,
+	})
+	var applicationErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &applicationErr)
+	assert.True(t, applicationErr.NonRetryable())
+}
@@ -0,0 +1,69 @@
+package visibilityrebuild
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/activity"
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/resource"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	componentParams struct {
+		fx.In
+		ExecutionManager persistence.ExecutionManager
+		HistoryClient    resource.HistoryClient
+		MetricsHandler   metrics.Handler
+		Logger           log.Logger
+	}
+
+	workerComponent struct {
+		activities *activities
+	}
+)
+
+// Module provides a [workercommon.WorkerComponent] annotated with [workercommon.WorkerComponentTag] to the graph.
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params componentParams) workercommon.WorkerComponent {
+	return &workerComponent{
+		activities: &activities{
+			executionManager: params.ExecutionManager,
+			historyClient:    params.HistoryClient,
+			metricsHandler:   params.MetricsHandler,
+			logger:           params.Logger,
+		},
+	}
+}
+
+func (c *workerComponent) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowName})
+}
+
+func (c *workerComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (c *workerComponent) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivityWithOptions(c.activities.rebuildPage, activity.RegisterOptions{
+		Name: rebuildActivityName,
+	})
+}
+
+func (c *workerComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	return &workercommon.DedicatedWorkerOptions{
+		TaskQueue: primitives.VisibilityRebuildActivityTQ,
+		Options: sdkworker.Options{
+			BackgroundActivityContext: headers.SetCallerType(context.Background(), headers.CallerTypePreemptable),
+		},
+	}
+}
@@ -0,0 +1,203 @@
+// Package visibilityrebuild contains the workflow that regenerates the visibility records of every execution of a
+// namespace from mutable state, which is used to recover visibility after a visibility store was lost or its records
+// were corrupted, e.g. by a bad index template change. The workflow walks the executions of each history shard in the
+// execution store and asks the history service to write a record for each of them to the chosen visibility store.
+package visibilityrebuild
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	// WorkflowParams is the single argument to the visibility rebuild workflow.
+	WorkflowParams struct {
+		Namespace   string
+		NamespaceID string
+		// VisibilityStore is the store records are written to. Unspecified writes to every configured store.
+		VisibilityStore enumsspb.VisibilityStoreTarget
+		// ShardCount is the number of history shards of the cluster.
+		ShardCount int32
+		// RPS is the maximum number of executions rebuilt per second. The default is DefaultRPS.
+		RPS int
+		// PageSize is the number of executions read from the execution store at a time. The default is
+		// DefaultPageSize.
+		PageSize int
+
+		// Progress, ShardID and NextPageToken are carried over with continue-as-new, which makes the rebuild resume
+		// from the last completed page.
+		Progress      Progress
+		ShardID       int32
+		NextPageToken []byte
+	}
+
+	// Progress is the accumulated result of the visibility rebuild workflow.
+	Progress struct {
+		// ShardsCompleted is the number of shards whose executions have all been rebuilt.
+		ShardsCompleted int32
+		// ExecutionsRebuilt is the number of executions whose visibility record was written.
+		ExecutionsRebuilt int64
+		// ClosedExecutionsRebuilt is the number of rebuilt executions that are closed.
+		ClosedExecutionsRebuilt int64
+		// ExecutionsSkipped is the number of executions that were deleted or aren't workflows.
+		ExecutionsSkipped int64
+		// RelocatableAttributesMissingCount is the number of closed executions that were written without memo and
+		// search attributes because they were no longer available.
+		RelocatableAttributesMissingCount int64
+	}
+
+	// ProgressQueryResponse is the response to QueryTypeProgress.
+	ProgressQueryResponse struct {
+		Namespace       string
+		VisibilityStore enumsspb.VisibilityStoreTarget
+		ShardCount      int32
+		Progress
+	}
+
+	rebuildParams struct {
+		Namespace       string
+		NamespaceID     string
+		VisibilityStore enumsspb.VisibilityStoreTarget
+		RPS             int
+		PageSize        int
+		ShardID         int32
+		NextPageToken   []byte
+	}
+
+	rebuildResult struct {
+		Progress
+		NextPageToken []byte
+	}
+)
+
+const (
+	// WorkflowName is the name of the visibility rebuild workflow.
+	WorkflowName = "temporal-sys-visibility-rebuild-workflow"
+	// QueryTypeProgress is the query to get the progress of the visibility rebuild workflow.
+	QueryTypeProgress = "visibility-rebuild-progress-query"
+
+	// DefaultRPS is the default value for WorkflowParams.RPS.
+	DefaultRPS = 100
+	// DefaultPageSize is the default value for WorkflowParams.PageSize.
+	DefaultPageSize = 100
+	// MaxPageSize is the maximum value for WorkflowParams.PageSize.
+	MaxPageSize = 1000
+
+	errorTypeInvalidRequest = "visibility-rebuild-error-type-invalid-request"
+	rebuildActivityName     = "visibility-rebuild-page-activity"
+
+	// pagesPerRun is the number of pages rebuilt before continuing as new to keep the history small.
+	pagesPerRun = 500
+	// rebuildActivityTimeout is long enough to rebuild a page at a low RPS.
+	rebuildActivityTimeout = 10 * time.Minute * debug.TimeoutMultiplier
+)
+
+var rebuildActivityRetryPolicy = &temporal.RetryPolicy{
+	InitialInterval:    time.Second,
+	BackoffCoefficient: 2.0,
+	MaximumInterval:    time.Minute,
+	MaximumAttempts:    10,
+}
+
+func validateParams(params *WorkflowParams) error {
+	if params.Namespace == "" || params.NamespaceID == "" {
+		return temporal.NewNonRetryableApplicationError("namespace is required", errorTypeInvalidRequest, nil)
+	}
+	if params.ShardCount <= 0 {
+		return temporal.NewNonRetryableApplicationError("shard count must be positive", errorTypeInvalidRequest, nil)
+	}
+	if params.RPS < 0 || params.PageSize < 0 {
+		return temporal.NewNonRetryableApplicationError(
+			"RPS and page size must not be negative",
+			errorTypeInvalidRequest,
+			nil,
+		)
+	}
+	if params.PageSize > MaxPageSize {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("page size %d must be less than or equal to %d", params.PageSize, MaxPageSize),
+			errorTypeInvalidRequest,
+			nil,
+		)
+	}
+	if params.RPS == 0 {
+		params.RPS = DefaultRPS
+	}
+	if params.PageSize == 0 {
+		params.PageSize = DefaultPageSize
+	}
+	if params.ShardID == 0 {
+		// Shard IDs start at 1.
+		params.ShardID = 1
+	}
+	return nil
+}
+
+// Workflow pages through the executions of every shard in order and rebuilds the visibility records of the executions
+// that belong to the namespace in an activity. It continues as new every pagesPerRun pages.
+func Workflow(ctx workflow.Context, params WorkflowParams) (Progress, error) {
+	progress := params.Progress
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (ProgressQueryResponse, error) {
+		return ProgressQueryResponse{
+			Namespace:       params.Namespace,
+			VisibilityStore: params.VisibilityStore,
+			ShardCount:      params.ShardCount,
+			Progress:        progress,
+		}, nil
+	}); err != nil {
+		return progress, err
+	}
+	if err := validateParams(&params); err != nil {
+		return progress, err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           primitives.VisibilityRebuildActivityTQ,
+		StartToCloseTimeout: rebuildActivityTimeout,
+		RetryPolicy:         rebuildActivityRetryPolicy,
+	})
+	for range pagesPerRun {
+		if params.ShardID > params.ShardCount {
+			return progress, nil
+		}
+		var result rebuildResult
+		err := workflow.ExecuteActivity(ctx, rebuildActivityName, rebuildParams{
+			Namespace:       params.Namespace,
+			NamespaceID:     params.NamespaceID,
+			VisibilityStore: params.VisibilityStore,
+			RPS:             params.RPS,
+			PageSize:        params.PageSize,
+			ShardID:         params.ShardID,
+			NextPageToken:   params.NextPageToken,
+		}).Get(ctx, &result)
+		if err != nil {
+			return progress, err
+		}
+		progress.merge(result.Progress)
+		params.NextPageToken = result.NextPageToken
+		if len(params.NextPageToken) == 0 {
+			progress.ShardsCompleted++
+			params.ShardID++
+		}
+	}
+	if params.ShardID > params.ShardCount {
+		return progress, nil
+	}
+
+	params.Progress = progress
+	return progress, workflow.NewContinueAsNewError(ctx, WorkflowName, params)
+}
+
+// merge adds the execution counts of other to p.
+func (p *Progress) merge(other Progress) {
+	p.ExecutionsRebuilt += other.ExecutionsRebuilt
+	p.ClosedExecutionsRebuilt += other.ClosedExecutionsRebuilt
+	p.ExecutionsSkipped += other.ExecutionsSkipped
+	p.RelocatableAttributesMissingCount += other.RelocatableAttributesMissingCount
+}
@@ -0,0 +1,124 @@
+package visibilityrebuild
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/log"
+)
+
+func newTestEnv() *testsuite.TestWorkflowEnvironment {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+	component := newComponent(componentParams{})
+	component.RegisterWorkflow(env)
+	component.RegisterActivities(env)
+	return env
+}
+
+func TestWorkflow(t *testing.T) {
+	env := newTestEnv()
+
+	newParams := func(shardID int32, pageToken []byte) rebuildParams {
+		return rebuildParams{
+			Namespace:       "ns",
+			NamespaceID:     "ns-id",
+			VisibilityStore: enumsspb.VISIBILITY_STORE_TARGET_SECONDARY,
+			RPS:             DefaultRPS,
+			PageSize:        DefaultPageSize,
+			ShardID:         shardID,
+			NextPageToken:   pageToken,
+		}
+	}
+	env.OnActivity(rebuildActivityName, mock.Anything, newParams(1, nil)).Return(rebuildResult{
+		Progress:      Progress{ExecutionsRebuilt: 2, ClosedExecutionsRebuilt: 1},
+		NextPageToken: []byte("page2"),
+	}, nil).Once()
+	env.OnActivity(rebuildActivityName, mock.Anything, newParams(1, []byte("page2"))).Return(rebuildResult{
+		Progress: Progress{ExecutionsRebuilt: 1, ExecutionsSkipped: 1},
+	}, nil).Once()
+	env.OnActivity(rebuildActivityName, mock.Anything, newParams(2, nil)).Return(rebuildResult{
+		Progress: Progress{ExecutionsRebuilt: 1, ClosedExecutionsRebuilt: 1, RelocatableAttributesMissingCount: 1},
+	}, nil).Once()
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		Namespace:       "ns",
+		NamespaceID:     "ns-id",
+		VisibilityStore: enumsspb.VISIBILITY_STORE_TARGET_SECONDARY,
+		ShardCount:      2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var progress Progress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	assert.Equal(t, Progress{
+		ShardsCompleted:                   2,
+		ExecutionsRebuilt:                 4,
+		ClosedExecutionsRebuilt:           2,
+		ExecutionsSkipped:                 1,
+		RelocatableAttributesMissingCount: 1,
+	}, progress)
+
+	value, err := env.QueryWorkflow(QueryTypeProgress)
+	require.NoError(t, err)
+	var queryResponse ProgressQueryResponse
+	require.NoError(t, value.Get(&queryResponse))
+	assert.Equal(t, "ns", queryResponse.Namespace)
+	assert.Equal(t, enumsspb.VISIBILITY_STORE_TARGET_SECONDARY, queryResponse.VisibilityStore)
+	assert.Equal(t, int32(2), queryResponse.ShardCount)
+	assert.Equal(t, progress, queryResponse.Progress)
+}
+
+func TestWorkflow_Resume(t *testing.T) {
+	env := newTestEnv()
+
+	env.OnActivity(rebuildActivityName, mock.Anything, mock.MatchedBy(func(params rebuildParams) bool {
+		return params.ShardID == 3 && string(params.NextPageToken) == "page5"
+	})).Return(rebuildResult{
+		Progress: Progress{ExecutionsRebuilt: 1},
+	}, nil).Once()
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		Namespace:     "ns",
+		NamespaceID:   "ns-id",
+		ShardCount:    3,
+		Progress:      Progress{ShardsCompleted: 2, ExecutionsRebuilt: 10},
+		ShardID:       3,
+		NextPageToken: []byte("page5"),
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var progress Progress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	assert.Equal(t, Progress{ShardsCompleted: 3, ExecutionsRebuilt: 11}, progress)
+}
+
+func TestWorkflow_InvalidParams(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		params WorkflowParams
+	}{
+		{name: "no namespace", params: WorkflowParams{ShardCount: 1}},
+		{name: "no shards", params: WorkflowParams{Namespace: "ns", NamespaceID: "ns-id"}},
+		{name: "negative rps", params: WorkflowParams{Namespace: "ns", NamespaceID: "ns-id", ShardCount: 1, RPS: -1}},
+		{name: "page size", params: WorkflowParams{Namespace: "ns", NamespaceID: "ns-id", ShardCount: 1, PageSize: MaxPageSize + 1}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			env := newTestEnv()
+			env.ExecuteWorkflow(WorkflowName, tc.params)
+
+			require.True(t, env.IsWorkflowCompleted())
+			var applicationErr *temporal.ApplicationError
+			require.ErrorAs(t, env.GetWorkflowError(), &applicationErr)
+			assert.Equal(t, errorTypeInvalidRequest, applicationErr.Type())
+		})
+	}
+}
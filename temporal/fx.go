@@ -106,6 +106,7 @@ type (
 
 		ServiceResolver                 resolver.ServiceResolver
 		CustomDataStoreFactory          persistenceClient.AbstractDataStoreFactory
+		PersistenceKeyProvider          serialization.KeyProvider
 		CustomVisibilityStore           visibility.VisibilityStoreFactory
 		CustomHistoryArchiverFactory    provider.CustomHistoryArchiverFactory
 		CustomVisibilityArchiverFactory provider.CustomVisibilityArchiverFactory
@@ -125,6 +126,8 @@ type (
 		TLSConfigProvider     encryption.TLSConfigProvider
 		EsClient              esclient.Client
 		MetricsHandler        metrics.Handler
+
+		PersistedDynamicConfigClient *dynamicconfig.PersistedClient
 	}
 )
 
@@ -219,6 +222,8 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 			dcClient = dynamicconfig.NewNoopClient()
 		}
 	}
+	// Overrides set through the admin API are layered on top of the configured client.
+	persistedDCClient := dynamicconfig.NewPersistedClient(dcClient, logger)
 
 	// TLSConfigProvider
 	tlsConfigProvider := so.tlsConfigProvider
@@ -229,6 +234,15 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		}
 	}
 
+	// PersistenceKeyProvider
+	persistenceKeyProvider := so.persistenceKeyProvider
+	if persistenceKeyProvider == nil && persistenceConfig.BlobEncryption != nil {
+		persistenceKeyProvider, err = serialization.NewFileKeyProvider(persistenceConfig.BlobEncryption.KeyFile)
+		if err != nil {
+			return serverOptionsProvider{}, fmt.Errorf("unable to create persistence key provider: %w", err)
+		}
+	}
+
 	// EsConfig / EsClient
 	var esConfig *esclient.Config
 	var esClient esclient.Client
@@ -297,6 +311,7 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 
 		ServiceResolver:                 so.persistenceServiceResolver,
 		CustomDataStoreFactory:          so.customDataStoreFactory,
+		PersistenceKeyProvider:          persistenceKeyProvider,
 		CustomVisibilityStore:           so.customVisibilityStoreFactory,
 		CustomHistoryArchiverFactory:    so.customHistoryArchiverFactory,
 		CustomVisibilityArchiverFactory: so.customVisibilityArchiverFactory,
@@ -310,10 +325,12 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 
 		Logger:                logger,
 		ClientFactoryProvider: clientFactoryProvider,
-		DynamicConfigClient:   dcClient,
+		DynamicConfigClient:   persistedDCClient,
 		TLSConfigProvider:     tlsConfigProvider,
 		EsClient:              esClient,
 		MetricsHandler:        metricHandler,
+
+		PersistedDynamicConfigClient: persistedDCClient,
 	}, nil
 }
 
@@ -358,6 +375,7 @@ type (
 		Logger                          log.Logger
 		NamespaceLogger                 resource.NamespaceLogger
 		DynamicConfigClient             dynamicconfig.Client
+		PersistedDynamicConfigClient    *dynamicconfig.PersistedClient `optional:"true"`
 		MetricsHandler                  metrics.Handler
 		EsClient                        esclient.Client
 		TlsConfigProvider               encryption.TLSConfigProvider //nolint:staticcheck // should be TLSConfigProvider
@@ -373,6 +391,7 @@ type (
 		ClaimMapper                     authorization.ClaimMapper
 		TokenProvider                   auth.TokenProvider
 		DataStoreFactory                persistenceClient.AbstractDataStoreFactory
+		PersistenceKeyProvider          serialization.KeyProvider
 		VisibilityStoreFactory          visibility.VisibilityStoreFactory
 		CustomHistoryArchiverFactory    provider.CustomHistoryArchiverFactory
 		CustomVisibilityArchiverFactory provider.CustomVisibilityArchiverFactory
@@ -410,6 +429,9 @@ func (params ServiceProviderParamsCommon) GetCommonServiceOptions(serviceName pr
 			func() persistenceClient.AbstractDataStoreFactory {
 				return params.DataStoreFactory
 			},
+			func() serialization.KeyProvider {
+				return params.PersistenceKeyProvider
+			},
 			func() visibility.VisibilityStoreFactory {
 				return params.VisibilityStoreFactory
 			},
@@ -446,6 +468,9 @@ func (params ServiceProviderParamsCommon) GetCommonServiceOptions(serviceName pr
 			func() dynamicconfig.Client {
 				return params.DynamicConfigClient
 			},
+			func() *dynamicconfig.PersistedClient {
+				return params.PersistedDynamicConfigClient
+			},
 			func() log.Logger {
 				return params.Logger
 			},
@@ -12,6 +12,7 @@ import (
 	"go.temporal.io/server/common/membership/static"
 	"go.temporal.io/server/common/metrics"
 	persistenceclient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
@@ -142,6 +143,14 @@ func WithDynamicConfigClient(c dynamicconfig.Client) ServerOption {
 	})
 }
 
+// WithPersistenceKeyProvider sets the KeyProvider used to encrypt history, mutable state and history task
+// blobs at rest. It takes precedence over persistence.blobEncryption in the static config.
+func WithPersistenceKeyProvider(keyProvider serialization.KeyProvider) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		s.persistenceKeyProvider = keyProvider
+	})
+}
+
 // WithCustomDataStoreFactory sets a custom AbstractDataStoreFactory
 // NOTE: this option is experimental and may be changed or removed in future release.
 func WithCustomDataStoreFactory(customFactory persistenceclient.AbstractDataStoreFactory) ServerOption {
@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/common/membership/static"
 	"go.temporal.io/server/common/metrics"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
@@ -52,6 +53,7 @@ type (
 		elasticsearchHttpClient         *http.Client //nolint:staticcheck // should be elasticsearchHTTPClient
 		dynamicConfigClient             dynamicconfig.Client
 		customDataStoreFactory          persistenceClient.AbstractDataStoreFactory
+		persistenceKeyProvider          serialization.KeyProvider
 		customVisibilityStoreFactory    visibility.VisibilityStoreFactory
 		customHistoryArchiverFactory    provider.CustomHistoryArchiverFactory
 		customVisibilityArchiverFactory provider.CustomVisibilityArchiverFactory
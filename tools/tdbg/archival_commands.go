@@ -0,0 +1,99 @@
+package tdbg
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+const defaultArchivalScanTimeout = time.Hour
+
+func newAdminArchivalCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name: "scan-history",
+			Usage: "Verify the archived workflow histories under a URI against their checksum manifests, " +
+				"and report the damaged ones. Histories archived without a manifest are only checked to decode",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagConfig,
+					Usage:    "Path to the server config file, its history archival providers are used",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagURI,
+					Usage:    "History archival URI to scan, e.g. file:///tmp/temporal_archival/development",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminScanArchivedHistories(c)
+			},
+		},
+	}
+}
+
+// AdminScanArchivedHistories verifies the archived histories under a URI
+func AdminScanArchivedHistories(c *cli.Context) error {
+	configFile, err := getRequiredOption(c, FlagConfig)
+	if err != nil {
+		return err
+	}
+	uri, err := getRequiredOption(c, FlagURI)
+	if err != nil {
+		return err
+	}
+	URI, err := archiver.NewURI(uri)
+	if err != nil {
+		return fmt.Errorf("invalid archival URI %q: %w", uri, err)
+	}
+	cfg, err := config.Load(config.WithConfigFile(configFile))
+	if err != nil {
+		return fmt.Errorf("unable to load config %q: %w", configFile, err)
+	}
+
+	archiverProvider := provider.NewArchiverProvider(
+		cfg.Archival.History.Provider,
+		cfg.Archival.Visibility.Provider,
+		nil,
+		nil,
+		nil,
+		log.NewCLILogger(),
+		metrics.NoopMetricsHandler,
+	)
+	historyArchiver, err := archiverProvider.GetHistoryArchiver(URI.Scheme())
+	if err != nil {
+		return fmt.Errorf("unable to create history archiver for %q: %w", URI.Scheme(), err)
+	}
+	verifier, ok := historyArchiver.(archiver.HistoryVerifier)
+	if !ok {
+		return fmt.Errorf("history archiver for %q doesn't support scanning", URI.Scheme())
+	}
+
+	ctx, cancel := newContextWithTimeout(c, defaultArchivalScanTimeout)
+	defer cancel()
+	var intact, damaged int
+	err = verifier.VerifyHistories(ctx, URI, func(key string, err error) error {
+		if err != nil {
+			damaged++
+			_, _ = fmt.Fprintf(c.App.Writer, "damaged: %s: %v\n", key, err)
+			return nil
+		}
+		intact++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to scan %q: %w", uri, err)
+	}
+	_, _ = fmt.Fprintf(c.App.Writer, "scanned %d archived histories: %d intact, %d damaged\n", intact+damaged, intact, damaged)
+	if damaged > 0 {
+		return fmt.Errorf("found %d damaged archived histories", damaged)
+	}
+	return nil
+}
@@ -259,6 +259,35 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+// AdminRestoreArchivedWorkflow restores a workflow execution from its archived history
+func AdminRestoreArchivedWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.RestoreArchivedWorkflowExecution(ctx, &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: c.String(FlagWorkflowID),
+			RunId:      c.String(FlagRunID),
+		},
+		TargetNamespace: c.String(FlagTargetNamespace),
+		ResetEventId:    c.Int64(FlagResetEventID),
+		ResetReason:     c.String(FlagReason),
+		RequestId:       uuid.NewString(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to restore archived workflow execution: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
 // AdminDescribeExecution describes a Temporal execution (CHASM tree or workflow).
 func AdminDescribeExecution(c *cli.Context, clientFactory ClientFactory) error {
 	resp, err := describeMutableState(c, clientFactory)
@@ -0,0 +1,143 @@
+package tdbg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	dynamicconfigspb "go.temporal.io/server/api/dynamicconfig/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// AdminGetDynamicConfigOverrides lists dynamic config overrides
+func AdminGetDynamicConfigOverrides(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetDynamicConfigOverrides(ctx, &adminservice.GetDynamicConfigOverridesRequest{
+		Key: c.String(FlagKey),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get dynamic config overrides: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminSetDynamicConfigOverride creates or replaces a dynamic config override
+func AdminSetDynamicConfigOverride(c *cli.Context, clientFactory ClientFactory) error {
+	constraints, err := getDynamicConfigConstraints(c)
+	if err != nil {
+		return err
+	}
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.SetDynamicConfigOverride(ctx, &adminservice.SetDynamicConfigOverrideRequest{
+		Key:         c.String(FlagKey),
+		Constraints: constraints,
+		Value:       c.String(FlagValue),
+		Identity:    getCurrentUserFromEnv(),
+		Reason:      c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set dynamic config override: %w", err)
+	}
+	_, _ = fmt.Fprintln(c.App.Writer, "Dynamic config override set.")
+	return nil
+}
+
+// AdminDeleteDynamicConfigOverride deletes a dynamic config override
+func AdminDeleteDynamicConfigOverride(c *cli.Context, clientFactory ClientFactory) error {
+	constraints, err := getDynamicConfigConstraints(c)
+	if err != nil {
+		return err
+	}
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.DeleteDynamicConfigOverride(ctx, &adminservice.DeleteDynamicConfigOverrideRequest{
+		Key:         c.String(FlagKey),
+		Constraints: constraints,
+		Identity:    getCurrentUserFromEnv(),
+		Reason:      c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete dynamic config override: %w", err)
+	}
+	_, _ = fmt.Fprintln(c.App.Writer, "Dynamic config override deleted.")
+	return nil
+}
+
+// AdminGetDynamicConfigHistory shows recent changes to dynamic config overrides
+func AdminGetDynamicConfigHistory(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetDynamicConfigHistory(ctx, &adminservice.GetDynamicConfigHistoryRequest{
+		Key: c.String(FlagKey),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get dynamic config history: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminExplainDynamicConfig shows how each host resolves a dynamic config key
+func AdminExplainDynamicConfig(c *cli.Context, clientFactory ClientFactory) error {
+	constraints, err := getDynamicConfigConstraints(c)
+	if err != nil {
+		return err
+	}
+	taskType, err := StringToEnum(c.String(FlagConstraintTaskType), enumsspb.TaskType_shorthandValue)
+	if err != nil {
+		return fmt.Errorf("invalid task type: %w", err)
+	}
+	var rolloutKey []byte
+	if parts := c.StringSlice(FlagRolloutKey); len(parts) > 0 {
+		rolloutKey = []byte(strings.Join(parts, "\x00"))
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ExplainDynamicConfig(ctx, &adminservice.ExplainDynamicConfigRequest{
+		Key: c.String(FlagKey),
+		Constraints: &dynamicconfigspb.Constraints{
+			Namespace:     constraints.GetNamespace(),
+			NamespaceId:   c.String(FlagConstraintNamespaceID),
+			TaskQueueName: constraints.GetTaskQueueName(),
+			TaskQueueType: constraints.GetTaskQueueType(),
+			Destination:   c.String(FlagConstraintDestination),
+			ShardId:       int32(c.Int(FlagConstraintShardID)),
+			TaskType:      enumsspb.TaskType(taskType),
+		},
+		RolloutKey: rolloutKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to explain dynamic config: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+func getDynamicConfigConstraints(c *cli.Context) (*persistencespb.DynamicConfigConstraints, error) {
+	tqType, err := StringToEnum(c.String(FlagConstraintTaskQueueType), enumspb.TaskQueueType_shorthandValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid task queue type: %w", err)
+	}
+	return &persistencespb.DynamicConfigConstraints{
+		Namespace:     c.String(FlagConstraintNamespace),
+		TaskQueueName: c.String(FlagConstraintTaskQueue),
+		TaskQueueType: enumspb.TaskQueueType(tqType),
+	}, nil
+}
@@ -84,6 +84,30 @@ var (
 	FlagExecute                    = "execute"
 	FlagWorkers                    = "workers"
 	FlagOutputLog                  = "output-log"
+	FlagSourceConfig               = "source-config"
+	FlagTargetConfig               = "target-config"
+	FlagCheckpointFile             = "checkpoint-file"
+	FlagConfig                     = "config"
+	FlagURI                        = "uri"
+	FlagRecording                  = "recording"
+	FlagSpeed                      = "speed"
+	FlagKey                        = "key"
+	FlagValue                      = "value"
+	FlagConstraintNamespace        = "constraint-namespace"
+	FlagConstraintTaskQueue        = "constraint-task-queue"
+	FlagConstraintTaskQueueType    = "constraint-task-queue-type"
+	FlagConstraintNamespaceID      = "constraint-namespace-id"
+	FlagConstraintShardID          = "constraint-shard-id"
+	FlagConstraintTaskType         = "constraint-task-type"
+	FlagConstraintDestination      = "constraint-destination"
+	FlagRolloutKey                 = "rollout-key"
+	FlagSampleRate                 = "sample-rate"
+	FlagMaxExecutions              = "max-executions"
+	FlagRepair                     = "repair"
+	FlagRPS                        = "rps"
+	FlagVisibilityStore            = "visibility-store"
+	FlagTargetNamespace            = "target-namespace"
+	FlagResetEventID               = "reset-event-id"
 )
 
 const defaultMigrateWorkers = 5
@@ -0,0 +1,186 @@
+package tdbg
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// AdminMigratePersistence copies the records of the source store to the target store
+func AdminMigratePersistence(c *cli.Context, taskCategoryRegistry tasks.TaskCategoryRegistry) error {
+	checkpointFile, err := getRequiredOption(c, FlagCheckpointFile)
+	if err != nil {
+		return err
+	}
+	checkpoint, err := loadMigrationCheckpoint(checkpointFile)
+	if err != nil {
+		return err
+	}
+	source, target, err := newPersistenceStores(c)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	defer target.Close()
+
+	shardIDs, err := getPersistenceShardIDs(c, source.numHistoryShards)
+	if err != nil {
+		return err
+	}
+	migrator := &persistenceMigrator{
+		source:         source,
+		target:         target,
+		checkpoint:     checkpoint,
+		taskCategories: persistenceMigrationTaskCategories(taskCategoryRegistry),
+		shardIDs:       shardIDs,
+		pageSize:       c.Int(FlagPageSize),
+		workers:        c.Int(FlagWorkers),
+		output:         c.App.Writer,
+	}
+	if migrator.pageSize <= 0 || migrator.workers <= 0 {
+		return fmt.Errorf("--%s and --%s must be positive", FlagPageSize, FlagWorkers)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	return migrator.Migrate(ctx)
+}
+
+// AdminVerifyPersistence compares the records of the source and target stores
+func AdminVerifyPersistence(c *cli.Context, taskCategoryRegistry tasks.TaskCategoryRegistry) error {
+	source, target, err := newPersistenceStores(c)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	defer target.Close()
+
+	shardIDs, err := getPersistenceShardIDs(c, source.numHistoryShards)
+	if err != nil {
+		return err
+	}
+	verifier := &persistenceVerifier{
+		source:         source,
+		target:         target,
+		taskCategories: persistenceMigrationTaskCategories(taskCategoryRegistry),
+		shardIDs:       shardIDs,
+		pageSize:       c.Int(FlagPageSize),
+		workers:        c.Int(FlagWorkers),
+		output:         c.App.Writer,
+	}
+	if verifier.pageSize <= 0 || verifier.workers <= 0 {
+		return fmt.Errorf("--%s and --%s must be positive", FlagPageSize, FlagWorkers)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	return verifier.Verify(ctx)
+}
+
+// AdminPersistenceMigrationStatus prints the progress recorded in a migration checkpoint file
+func AdminPersistenceMigrationStatus(c *cli.Context) error {
+	checkpointFile, err := getRequiredOption(c, FlagCheckpointFile)
+	if err != nil {
+		return err
+	}
+	checkpoint, err := loadMigrationCheckpoint(checkpointFile)
+	if err != nil {
+		return err
+	}
+
+	w := c.App.Writer
+	printProgress := func(name string, progress migrationProgress) {
+		status := "in progress"
+		if progress.Done {
+			status = "done"
+		}
+		_, _ = fmt.Fprintf(w, "%-24s %-12s %d copied, %d skipped\n", name, status, progress.Copied, progress.Skipped)
+	}
+	printProgress("Namespaces", checkpoint.Namespaces)
+	printProgress("Nexus endpoints", checkpoint.NexusEndpoints)
+
+	shardIDs := make([]int32, 0, len(checkpoint.Shards))
+	for shardID := range checkpoint.Shards {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+	var doneShards int
+	var executions, historyTasks int64
+	var failed []migrationExecutionKey
+	for _, shardID := range shardIDs {
+		progress := checkpoint.Shards[shardID]
+		if progress.Done {
+			doneShards++
+		}
+		executions += progress.Executions.Copied
+		for _, taskProgress := range progress.HistoryTasks {
+			historyTasks += taskProgress.Copied
+		}
+		failed = append(failed, progress.FailedExecutions...)
+	}
+	_, _ = fmt.Fprintf(w, "%-24s %-12s %d executions, %d history tasks copied\n",
+		"Shards", fmt.Sprintf("%d done", doneShards), executions, historyTasks)
+
+	printProgress("Task queues", checkpoint.TaskQueues)
+	printProgress("Fairness task queues", checkpoint.FairTaskQueues)
+	printProgress("Task queue user data", checkpoint.TaskQueueUserData)
+
+	if len(failed) > 0 {
+		_, _ = fmt.Fprintf(w, "\n%d executions failed to migrate and will be retried:\n", len(failed))
+		for _, key := range failed {
+			_, _ = fmt.Fprintf(w, "  %s/%s/%s\n", key.NamespaceID, key.WorkflowID, key.RunID)
+		}
+	}
+	return nil
+}
+
+func newPersistenceStores(c *cli.Context) (*persistenceStore, *persistenceStore, error) {
+	sourceConfig, err := getRequiredOption(c, FlagSourceConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	targetConfig, err := getRequiredOption(c, FlagTargetConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	logger := log.NewCLILogger()
+	source, err := newPersistenceStoreFromFile("source", sourceConfig, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := newPersistenceStoreFromFile("target", targetConfig, logger)
+	if err != nil {
+		source.Close()
+		return nil, nil, err
+	}
+	if source.numHistoryShards != target.numHistoryShards {
+		source.Close()
+		target.Close()
+		return nil, nil, fmt.Errorf("source and target must have the same number of history shards, got %d and %d",
+			source.numHistoryShards, target.numHistoryShards)
+	}
+	return source, target, nil
+}
+
+// getPersistenceShardIDs returns the shards selected with --shard-id, or all shards.
+func getPersistenceShardIDs(c *cli.Context, numHistoryShards int32) ([]int32, error) {
+	selected := c.IntSlice(FlagShardID)
+	if len(selected) == 0 {
+		shardIDs := make([]int32, numHistoryShards)
+		for i := range shardIDs {
+			shardIDs[i] = int32(i) + 1
+		}
+		return shardIDs, nil
+	}
+	shardIDs := make([]int32, len(selected))
+	for i, shardID := range selected {
+		if shardID < 1 || shardID > int(numHistoryShards) {
+			return nil, fmt.Errorf("invalid shard ID %d, must be between 1 and %d", shardID, numHistoryShards)
+		}
+		shardIDs[i] = int32(shardID)
+	}
+	return shardIDs, nil
+}
@@ -0,0 +1,1306 @@
+package tdbg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/matching"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultPersistenceMigrationPageSize = 100
+	defaultPersistenceMigrationWorkers  = 8
+	persistenceMigrationMaxTaskID       = math.MaxInt64
+)
+
+type (
+	// persistenceMigrator copies the records of one persistence store to another through the
+	// persistence manager interfaces, so any pair of supported stores can be migrated.
+	//
+	// The migration is online: the source cluster may keep running while records are copied.
+	// Records that change after they were copied are refreshed when the migration is run again
+	// with a new checkpoint file, which is expected to be done after the source cluster has been
+	// stopped and before running the verification pass.
+	persistenceMigrator struct {
+		source         *persistenceStore
+		target         *persistenceStore
+		checkpoint     *migrationCheckpoint
+		taskCategories []tasks.Category
+		shardIDs       []int32
+		pageSize       int
+		workers        int
+
+		outputLock sync.Mutex
+		output     io.Writer
+	}
+
+	// migrationCheckpoint records the progress of a migration. It is saved after every page
+	// so that an interrupted migration resumes where it stopped.
+	migrationCheckpoint struct {
+		lock sync.Mutex
+		path string
+
+		Namespaces        migrationProgress                 `json:"namespaces"`
+		NexusEndpoints    migrationProgress                 `json:"nexusEndpoints"`
+		Shards            map[int32]*shardMigrationProgress `json:"shards"`
+		TaskQueues        migrationProgress                 `json:"taskQueues"`
+		FairTaskQueues    migrationProgress                 `json:"fairTaskQueues"`
+		TaskQueueUserData migrationProgress                 `json:"taskQueueUserData"`
+	}
+
+	migrationProgress struct {
+		Done      bool   `json:"done"`
+		PageToken []byte `json:"pageToken,omitempty"`
+		Copied    int64  `json:"copied"`
+		Skipped   int64  `json:"skipped"`
+	}
+
+	shardMigrationProgress struct {
+		Done         bool                          `json:"done"`
+		Executions   migrationProgress             `json:"executions"`
+		HistoryTasks map[string]*migrationProgress `json:"historyTasks"`
+		// FailedExecutions are retried when the migration is resumed.
+		FailedExecutions []migrationExecutionKey `json:"failedExecutions,omitempty"`
+	}
+
+	migrationExecutionKey struct {
+		NamespaceID string `json:"namespaceId"`
+		WorkflowID  string `json:"workflowId"`
+		RunID       string `json:"runId"`
+	}
+
+	// migrationShard is the state shared by the copy operations of one shard.
+	migrationShard struct {
+		shardID int32
+		// rangeID is the range ID of the shard in the target store.
+		rangeID int64
+	}
+)
+
+var errMigrationHasFailures = errors.New("some records failed to migrate, run the migration again with the same checkpoint file to retry them")
+
+// loadMigrationCheckpoint reads the checkpoint file, or returns an empty checkpoint if it does not exist.
+func loadMigrationCheckpoint(path string) (*migrationCheckpoint, error) {
+	checkpoint := &migrationCheckpoint{
+		path:   path,
+		Shards: make(map[int32]*shardMigrationProgress),
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return checkpoint, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read checkpoint file: %w", err)
+	}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("unable to parse checkpoint file %q: %w", path, err)
+	}
+	if checkpoint.Shards == nil {
+		checkpoint.Shards = make(map[int32]*shardMigrationProgress)
+	}
+	return checkpoint, nil
+}
+
+// update applies fn to the checkpoint and saves it.
+func (c *migrationCheckpoint) update(fn func()) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	fn()
+	return c.saveLocked()
+}
+
+// read calls fn while holding the checkpoint lock.
+func (c *migrationCheckpoint) read(fn func()) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	fn()
+}
+
+// saveLocked writes the checkpoint to a temporary file and renames it, so that a crash
+// never leaves a partially written checkpoint behind.
+func (c *migrationCheckpoint) saveLocked() error {
+	if c.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("unable to save checkpoint: %w", err)
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return fmt.Errorf("unable to save checkpoint: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("unable to save checkpoint: %w", err)
+	}
+	if err := os.Rename(tmpFile.Name(), c.path); err != nil {
+		return fmt.Errorf("unable to save checkpoint: %w", err)
+	}
+	return nil
+}
+
+func (c *migrationCheckpoint) shard(shardID int32) *shardMigrationProgress {
+	progress, ok := c.Shards[shardID]
+	if !ok {
+		progress = &shardMigrationProgress{}
+		c.Shards[shardID] = progress
+	}
+	if progress.HistoryTasks == nil {
+		progress.HistoryTasks = make(map[string]*migrationProgress)
+	}
+	return progress
+}
+
+// persistenceMigrationTaskCategories returns the persisted history task categories of the registry.
+func persistenceMigrationTaskCategories(registry tasks.TaskCategoryRegistry) []tasks.Category {
+	categories := registry.GetCategories()
+	// Archival tasks are only registered when archival is enabled, but may exist in the store regardless.
+	categories[tasks.CategoryArchival.ID()] = tasks.CategoryArchival
+	// Memory timers are never persisted.
+	delete(categories, tasks.CategoryMemoryTimer.ID())
+
+	result := make([]tasks.Category, 0, len(categories))
+	for _, category := range categories {
+		result = append(result, category)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID() < result[j].ID() })
+	return result
+}
+
+func (m *persistenceMigrator) printf(format string, args ...any) {
+	m.outputLock.Lock()
+	defer m.outputLock.Unlock()
+	_, _ = fmt.Fprintf(m.output, format, args...)
+}
+
+// Migrate copies namespaces, nexus endpoints, shards with their executions, history trees and
+// history tasks, and task queues with their tasks and user data from the source to the target store.
+func (m *persistenceMigrator) Migrate(ctx context.Context) error {
+	if err := m.migrateNamespaces(ctx); err != nil {
+		return fmt.Errorf("unable to migrate namespaces: %w", err)
+	}
+	if err := m.migrateNexusEndpoints(ctx); err != nil {
+		return fmt.Errorf("unable to migrate nexus endpoints: %w", err)
+	}
+	failed, err := m.migrateShards(ctx)
+	if err != nil {
+		return err
+	}
+	if err := m.migrateTaskQueues(ctx, "taskQueues", m.source.taskManager, m.target.taskManager, &m.checkpoint.TaskQueues); err != nil {
+		return fmt.Errorf("unable to migrate task queues: %w", err)
+	}
+	if m.source.fairTaskManager != nil && m.target.fairTaskManager != nil {
+		if err := m.migrateTaskQueues(ctx, "fairTaskQueues", m.source.fairTaskManager, m.target.fairTaskManager, &m.checkpoint.FairTaskQueues); err != nil {
+			return fmt.Errorf("unable to migrate fairness task queues: %w", err)
+		}
+	}
+	if err := m.migrateTaskQueueUserData(ctx); err != nil {
+		return fmt.Errorf("unable to migrate task queue user data: %w", err)
+	}
+
+	if failed > 0 {
+		m.printf("Migration finished, %d executions failed to migrate.\n", failed)
+		return errMigrationHasFailures
+	}
+	m.printf("Migration finished.\n")
+	return nil
+}
+
+func (m *persistenceMigrator) migrateNamespaces(ctx context.Context) error {
+	progress := &m.checkpoint.Namespaces
+	if progress.Done {
+		return nil
+	}
+	for {
+		resp, err := m.source.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       m.pageSize,
+			NextPageToken:  progress.PageToken,
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return err
+		}
+		var copied, skipped int64
+		for _, ns := range resp.Namespaces {
+			changed, err := m.copyNamespace(ctx, ns)
+			if err != nil {
+				return fmt.Errorf("namespace %q: %w", ns.Namespace.GetInfo().GetName(), err)
+			}
+			if changed {
+				copied++
+			} else {
+				skipped++
+			}
+		}
+		if err := m.checkpoint.update(func() {
+			progress.Copied += copied
+			progress.Skipped += skipped
+			progress.PageToken = resp.NextPageToken
+			progress.Done = len(resp.NextPageToken) == 0
+		}); err != nil {
+			return err
+		}
+		if progress.Done {
+			m.printf("Namespaces: %d copied, %d already up to date.\n", progress.Copied, progress.Skipped)
+			return nil
+		}
+	}
+}
+
+func (m *persistenceMigrator) copyNamespace(ctx context.Context, ns *persistence.GetNamespaceResponse) (bool, error) {
+	_, err := m.target.metadataManager.CreateNamespace(ctx, &persistence.CreateNamespaceRequest{
+		Namespace:         ns.Namespace,
+		IsGlobalNamespace: ns.IsGlobalNamespace,
+	})
+	var alreadyExistsErr *serviceerror.NamespaceAlreadyExists
+	if !errors.As(err, &alreadyExistsErr) {
+		return err == nil, err
+	}
+
+	existing, err := m.target.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{ID: ns.Namespace.GetInfo().GetId()})
+	if err != nil {
+		return false, err
+	}
+	if existing.IsGlobalNamespace == ns.IsGlobalNamespace && proto.Equal(existing.Namespace, ns.Namespace) {
+		return false, nil
+	}
+	metadata, err := m.target.metadataManager.GetMetadata(ctx)
+	if err != nil {
+		return false, err
+	}
+	return true, m.target.metadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace:           ns.Namespace,
+		IsGlobalNamespace:   ns.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+}
+
+// migrateNexusEndpoints copies all endpoints at once: the endpoints table is small and versioned as a whole.
+func (m *persistenceMigrator) migrateNexusEndpoints(ctx context.Context) error {
+	progress := &m.checkpoint.NexusEndpoints
+	if progress.Done {
+		return nil
+	}
+	sourceEntries, _, err := listNexusEndpoints(ctx, m.source.nexusEndpointManager, m.pageSize)
+	if err != nil {
+		return err
+	}
+	targetEntries, tableVersion, err := listNexusEndpoints(ctx, m.target.nexusEndpointManager, m.pageSize)
+	if err != nil {
+		return err
+	}
+	targetEntriesByID := make(map[string]*persistencespb.NexusEndpointEntry, len(targetEntries))
+	for _, entry := range targetEntries {
+		targetEntriesByID[entry.Id] = entry
+	}
+
+	var copied, skipped int64
+	for _, entry := range sourceEntries {
+		var version int64
+		if existing, ok := targetEntriesByID[entry.Id]; ok {
+			if proto.Equal(existing.Endpoint, entry.Endpoint) {
+				skipped++
+				continue
+			}
+			version = existing.Version
+		}
+		if _, err := m.target.nexusEndpointManager.CreateOrUpdateNexusEndpoint(ctx, &persistence.CreateOrUpdateNexusEndpointRequest{
+			LastKnownTableVersion: tableVersion,
+			Entry: &persistencespb.NexusEndpointEntry{
+				Version:  version,
+				Id:       entry.Id,
+				Endpoint: entry.Endpoint,
+			},
+		}); err != nil {
+			return fmt.Errorf("endpoint %q: %w", entry.Endpoint.GetSpec().GetName(), err)
+		}
+		tableVersion++
+		copied++
+	}
+	if err := m.checkpoint.update(func() {
+		progress.Copied = copied
+		progress.Skipped = skipped
+		progress.Done = true
+	}); err != nil {
+		return err
+	}
+	m.printf("Nexus endpoints: %d copied, %d already up to date.\n", copied, skipped)
+	return nil
+}
+
+func listNexusEndpoints(
+	ctx context.Context,
+	manager persistence.NexusEndpointManager,
+	pageSize int,
+) ([]*persistencespb.NexusEndpointEntry, int64, error) {
+	var entries []*persistencespb.NexusEndpointEntry
+	var tableVersion int64
+	var pageToken []byte
+	for {
+		resp, err := manager.ListNexusEndpoints(ctx, &persistence.ListNexusEndpointsRequest{
+			PageSize:      pageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		entries = append(entries, resp.Entries...)
+		tableVersion = resp.TableVersion
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return entries, tableVersion, nil
+		}
+	}
+}
+
+// migrateShards migrates the shards on a pool of workers and returns the number of executions that failed to migrate.
+func (m *persistenceMigrator) migrateShards(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	shardIDs := make(chan int32)
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var firstErr error
+	var failed int64
+	var completed int
+	for range m.workers {
+		wg.Go(func() {
+			for shardID := range shardIDs {
+				shardFailed, err := m.migrateShard(ctx, shardID)
+				lock.Lock()
+				failed += shardFailed
+				completed++
+				if err != nil && firstErr == nil {
+					firstErr = fmt.Errorf("unable to migrate shard %d: %w", shardID, err)
+					cancel()
+				}
+				lock.Unlock()
+				if err == nil {
+					m.printShardProgress(shardID, completed)
+				}
+			}
+		})
+	}
+	for _, shardID := range m.shardIDs {
+		select {
+		case shardIDs <- shardID:
+		case <-ctx.Done():
+		}
+	}
+	close(shardIDs)
+	wg.Wait()
+	return failed, firstErr
+}
+
+func (m *persistenceMigrator) printShardProgress(shardID int32, completed int) {
+	var executions, historyTasks int64
+	var failed int
+	m.checkpoint.read(func() {
+		progress := m.checkpoint.shard(shardID)
+		executions = progress.Executions.Copied
+		for _, p := range progress.HistoryTasks {
+			historyTasks += p.Copied
+		}
+		failed = len(progress.FailedExecutions)
+	})
+	m.printf("Shard %d: %d executions, %d history tasks copied, %d failed (%d/%d shards).\n",
+		shardID, executions, historyTasks, failed, completed, len(m.shardIDs))
+}
+
+func (m *persistenceMigrator) migrateShard(ctx context.Context, shardID int32) (int64, error) {
+	var done bool
+	var retry []migrationExecutionKey
+	m.checkpoint.read(func() {
+		progress := m.checkpoint.shard(shardID)
+		done = progress.Done
+		retry = slices.Clone(progress.FailedExecutions)
+	})
+
+	shard, err := m.migrateShardInfo(ctx, shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Retry the executions that failed during a previous run first.
+	var stillFailed []migrationExecutionKey
+	for _, key := range retry {
+		if err := m.copyExecution(ctx, shard, key); err != nil {
+			m.printf("Shard %d: unable to migrate execution %s/%s/%s: %v\n", shardID, key.NamespaceID, key.WorkflowID, key.RunID, err)
+			stillFailed = append(stillFailed, key)
+		}
+	}
+	if err := m.checkpoint.update(func() {
+		m.checkpoint.shard(shardID).FailedExecutions = stillFailed
+	}); err != nil {
+		return 0, err
+	}
+	if done {
+		return int64(len(stillFailed)), nil
+	}
+
+	if err := m.migrateExecutions(ctx, shard); err != nil {
+		return 0, err
+	}
+	for _, category := range m.taskCategories {
+		if err := m.migrateHistoryTasks(ctx, shard, category); err != nil {
+			return 0, fmt.Errorf("%s tasks: %w", category.Name(), err)
+		}
+	}
+
+	var failed int64
+	err = m.checkpoint.update(func() {
+		progress := m.checkpoint.shard(shardID)
+		progress.Done = true
+		failed = int64(len(progress.FailedExecutions))
+	})
+	return failed, err
+}
+
+// migrateShardInfo creates the shard in the target store, or brings an existing one up to date
+// with the source shard.
+func (m *persistenceMigrator) migrateShardInfo(ctx context.Context, shardID int32) (*migrationShard, error) {
+	sourceResp, err := m.source.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: shardID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sourceInfo := sourceResp.ShardInfo
+	targetResp, err := m.target.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID:          shardID,
+		InitialShardInfo: common.CloneProto(sourceInfo),
+	})
+	if err != nil {
+		return nil, err
+	}
+	targetInfo := targetResp.ShardInfo
+	if targetInfo.GetRangeId() >= sourceInfo.GetRangeId() {
+		return &migrationShard{shardID: shardID, rangeID: targetInfo.GetRangeId()}, nil
+	}
+
+	if err := m.target.shardManager.UpdateShard(ctx, &persistence.UpdateShardRequest{
+		ShardInfo:       common.CloneProto(sourceInfo),
+		PreviousRangeID: targetInfo.GetRangeId(),
+	}); err != nil {
+		return nil, err
+	}
+	return &migrationShard{shardID: shardID, rangeID: sourceInfo.GetRangeId()}, nil
+}
+
+func (m *persistenceMigrator) migrateExecutions(ctx context.Context, shard *migrationShard) error {
+	var pageToken []byte
+	m.checkpoint.read(func() {
+		pageToken = m.checkpoint.shard(shard.shardID).Executions.PageToken
+	})
+	for {
+		resp, err := m.source.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   shard.shardID,
+			PageSize:  m.pageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		var copied int64
+		var failed []migrationExecutionKey
+		for _, state := range resp.States {
+			key := migrationExecutionKey{
+				NamespaceID: state.GetExecutionInfo().GetNamespaceId(),
+				WorkflowID:  state.GetExecutionInfo().GetWorkflowId(),
+				RunID:       state.GetExecutionState().GetRunId(),
+			}
+			if err := m.copyExecution(ctx, shard, key); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				m.printf("Shard %d: unable to migrate execution %s/%s/%s: %v\n", shard.shardID, key.NamespaceID, key.WorkflowID, key.RunID, err)
+				failed = append(failed, key)
+				continue
+			}
+			copied++
+		}
+		pageToken = resp.PageToken
+		if err := m.checkpoint.update(func() {
+			progress := m.checkpoint.shard(shard.shardID)
+			progress.Executions.Copied += copied
+			progress.Executions.PageToken = pageToken
+			progress.Executions.Done = len(pageToken) == 0
+			progress.FailedExecutions = append(progress.FailedExecutions, failed...)
+		}); err != nil {
+			return err
+		}
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// copyExecution copies the history branches and the mutable state of one execution. If the execution
+// already exists in the target store, it is updated to match the source.
+func (m *persistenceMigrator) copyExecution(ctx context.Context, shard *migrationShard, key migrationExecutionKey) error {
+	sourceResp, err := m.source.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shard.shardID,
+		NamespaceID: key.NamespaceID,
+		WorkflowID:  key.WorkflowID,
+		RunID:       key.RunID,
+		ArchetypeID: chasm.WorkflowArchetypeID,
+	})
+	if errors.As(err, new(*serviceerror.NotFound)) {
+		// Deleted since it was listed.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	state := sourceResp.State
+	archetypeID := executionArchetypeID(state)
+
+	targetResp, err := m.target.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shard.shardID,
+		NamespaceID: key.NamespaceID,
+		WorkflowID:  key.WorkflowID,
+		RunID:       key.RunID,
+		ArchetypeID: archetypeID,
+	})
+	switch {
+	case errors.As(err, new(*serviceerror.NotFound)):
+		targetResp = nil
+	case err != nil:
+		return err
+	default:
+		equal, err := mutableStatesEqual(state, targetResp.State)
+		if err != nil {
+			return err
+		}
+		if equal {
+			return nil
+		}
+	}
+
+	if err := m.copyHistoryBranches(ctx, shard, key, state); err != nil {
+		return fmt.Errorf("unable to copy history: %w", err)
+	}
+	if targetResp != nil {
+		return m.refreshExecution(ctx, shard, key, archetypeID, state, targetResp)
+	}
+	return m.createExecution(ctx, shard, key, archetypeID, state)
+}
+
+func (m *persistenceMigrator) createExecution(
+	ctx context.Context,
+	shard *migrationShard,
+	key migrationExecutionKey,
+	archetypeID chasm.ArchetypeID,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	sourceCurrent, err := m.source.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shard.shardID,
+		NamespaceID: key.NamespaceID,
+		WorkflowID:  key.WorkflowID,
+		ArchetypeID: archetypeID,
+	})
+	if err != nil && !errors.As(err, new(*serviceerror.NotFound)) {
+		return err
+	}
+	isCurrent := err == nil && sourceCurrent.RunID == key.RunID
+
+	request := &persistence.CreateWorkflowExecutionRequest{
+		ShardID:     shard.shardID,
+		RangeID:     shard.rangeID,
+		Mode:        persistence.CreateWorkflowModeBypassCurrent,
+		ArchetypeID: archetypeID,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo:       state.ExecutionInfo,
+			ExecutionState:      state.ExecutionState,
+			NextEventID:         state.NextEventId,
+			ActivityInfos:       state.ActivityInfos,
+			TimerInfos:          state.TimerInfos,
+			ChildExecutionInfos: state.ChildExecutionInfos,
+			RequestCancelInfos:  state.RequestCancelInfos,
+			SignalInfos:         state.SignalInfos,
+			SignalRequestedIDs:  signalRequestedIDSet(state.SignalRequestedIds),
+			ChasmNodes:          state.ChasmNodes,
+			Tasks:               map[tasks.Category][]tasks.Task{},
+			DBRecordVersion:     1,
+			Checksum:            state.Checksum,
+		},
+	}
+	if isCurrent {
+		request.Mode = persistence.CreateWorkflowModeBrandNew
+	}
+	_, err = m.target.executionManager.CreateWorkflowExecution(ctx, request)
+
+	var currentConflictErr *persistence.CurrentWorkflowConditionFailedError
+	if isCurrent && errors.As(err, &currentConflictErr) && currentConflictErr.RunID != key.RunID {
+		// The target still points to a previous run of the workflow. Bring the previous run up to
+		// date first, so that its current record is closed, then replace the current record.
+		previous := migrationExecutionKey{NamespaceID: key.NamespaceID, WorkflowID: key.WorkflowID, RunID: currentConflictErr.RunID}
+		if currentConflictErr.State != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+			if err := m.copyExecution(ctx, shard, previous); err != nil {
+				return fmt.Errorf("unable to migrate previous run %s: %w", previous.RunID, err)
+			}
+			_, err = m.target.executionManager.CreateWorkflowExecution(ctx, request)
+			if !errors.As(err, &currentConflictErr) {
+				return err
+			}
+		}
+		request.Mode = persistence.CreateWorkflowModeUpdateCurrent
+		request.PreviousRunID = currentConflictErr.RunID
+		request.PreviousLastWriteVersion = currentConflictErr.LastWriteVersion
+		_, err = m.target.executionManager.CreateWorkflowExecution(ctx, request)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(state.BufferedEvents) == 0 {
+		return nil
+	}
+	// Snapshots cannot carry buffered events, they are added with an update.
+	mutation := newMigrationMutation(state, nil, request.NewWorkflowSnapshot.DBRecordVersion)
+	mutation.NewBufferedEvents = state.BufferedEvents
+	return m.updateExecution(ctx, shard, key, archetypeID, mutation)
+}
+
+// refreshExecution updates an execution in the target store to match the source state.
+func (m *persistenceMigrator) refreshExecution(
+	ctx context.Context,
+	shard *migrationShard,
+	key migrationExecutionKey,
+	archetypeID chasm.ArchetypeID,
+	state *persistencespb.WorkflowMutableState,
+	targetResp *persistence.GetWorkflowExecutionResponse,
+) error {
+	mutation := newMigrationMutation(state, targetResp.State, targetResp.DBRecordVersion)
+	bufferedEventsChanged := !slices.EqualFunc(state.BufferedEvents, targetResp.State.BufferedEvents, func(a, b *historypb.HistoryEvent) bool {
+		return proto.Equal(a, b)
+	})
+	if bufferedEventsChanged && len(targetResp.State.BufferedEvents) > 0 {
+		// Some stores do not support clearing and adding buffered events in the same update.
+		mutation.ClearBufferedEvents = true
+	}
+	if err := m.updateExecution(ctx, shard, key, archetypeID, mutation); err != nil {
+		return err
+	}
+	if !bufferedEventsChanged || len(state.BufferedEvents) == 0 {
+		return nil
+	}
+	mutation = newMigrationMutation(state, state, mutation.DBRecordVersion)
+	mutation.NewBufferedEvents = state.BufferedEvents
+	return m.updateExecution(ctx, shard, key, archetypeID, mutation)
+}
+
+func (m *persistenceMigrator) updateExecution(
+	ctx context.Context,
+	shard *migrationShard,
+	key migrationExecutionKey,
+	archetypeID chasm.ArchetypeID,
+	mutation persistence.WorkflowMutation,
+) error {
+	mode := persistence.UpdateWorkflowModeBypassCurrent
+	current, err := m.target.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shard.shardID,
+		NamespaceID: key.NamespaceID,
+		WorkflowID:  key.WorkflowID,
+		ArchetypeID: archetypeID,
+	})
+	if err != nil && !errors.As(err, new(*serviceerror.NotFound)) {
+		return err
+	}
+	if err == nil && current.RunID == key.RunID {
+		mode = persistence.UpdateWorkflowModeUpdateCurrent
+	}
+	_, err = m.target.executionManager.UpdateWorkflowExecution(ctx, &persistence.UpdateWorkflowExecutionRequest{
+		ShardID:                shard.shardID,
+		RangeID:                shard.rangeID,
+		Mode:                   mode,
+		ArchetypeID:            archetypeID,
+		UpdateWorkflowMutation: mutation,
+	})
+	return err
+}
+
+// newMigrationMutation returns a mutation that replaces the target state of an execution, excluding buffered
+// events, with the source state. A nil target means the target state is the same as the source state.
+func newMigrationMutation(
+	source *persistencespb.WorkflowMutableState,
+	target *persistencespb.WorkflowMutableState,
+	targetDBRecordVersion int64,
+) persistence.WorkflowMutation {
+	if target == nil {
+		target = source
+	}
+	return persistence.WorkflowMutation{
+		ExecutionInfo:             source.ExecutionInfo,
+		ExecutionState:            source.ExecutionState,
+		NextEventID:               source.NextEventId,
+		UpsertActivityInfos:       source.ActivityInfos,
+		DeleteActivityInfos:       deletedKeys(target.ActivityInfos, source.ActivityInfos),
+		UpsertTimerInfos:          source.TimerInfos,
+		DeleteTimerInfos:          deletedKeys(target.TimerInfos, source.TimerInfos),
+		UpsertChildExecutionInfos: source.ChildExecutionInfos,
+		DeleteChildExecutionInfos: deletedKeys(target.ChildExecutionInfos, source.ChildExecutionInfos),
+		UpsertRequestCancelInfos:  source.RequestCancelInfos,
+		DeleteRequestCancelInfos:  deletedKeys(target.RequestCancelInfos, source.RequestCancelInfos),
+		UpsertSignalInfos:         source.SignalInfos,
+		DeleteSignalInfos:         deletedKeys(target.SignalInfos, source.SignalInfos),
+		UpsertSignalRequestedIDs:  signalRequestedIDSet(source.SignalRequestedIds),
+		DeleteSignalRequestedIDs:  deletedKeys(signalRequestedIDSet(target.SignalRequestedIds), signalRequestedIDSet(source.SignalRequestedIds)),
+		UpsertChasmNodes:          source.ChasmNodes,
+		DeleteChasmNodes:          deletedKeys(target.ChasmNodes, source.ChasmNodes),
+		Tasks:                     map[tasks.Category][]tasks.Task{},
+		Condition:                 target.NextEventId,
+		DBRecordVersion:           targetDBRecordVersion + 1,
+		Checksum:                  source.Checksum,
+	}
+}
+
+func deletedKeys[K comparable, V any, W any](target map[K]V, source map[K]W) map[K]struct{} {
+	deleted := make(map[K]struct{})
+	for key := range target {
+		if _, ok := source[key]; !ok {
+			deleted[key] = struct{}{}
+		}
+	}
+	return deleted
+}
+
+func signalRequestedIDSet(ids []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+// executionArchetypeID returns the archetype of an execution from its root CHASM node.
+func executionArchetypeID(state *persistencespb.WorkflowMutableState) chasm.ArchetypeID {
+	if typeID := state.GetChasmNodes()[""].GetMetadata().GetComponentAttributes().GetTypeId(); typeID != chasm.UnspecifiedArchetypeID {
+		return typeID
+	}
+	return chasm.WorkflowArchetypeID
+}
+
+// copyHistoryBranches copies the history branches referenced by the version histories of an execution.
+// History nodes are upserted, so copying a branch again is safe.
+func (m *persistenceMigrator) copyHistoryBranches(
+	ctx context.Context,
+	shard *migrationShard,
+	key migrationExecutionKey,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	for _, versionHistory := range state.GetExecutionInfo().GetVersionHistories().GetHistories() {
+		items := versionHistory.GetItems()
+		if len(versionHistory.GetBranchToken()) == 0 || len(items) == 0 {
+			continue
+		}
+		if err := m.copyHistoryBranch(ctx, shard, key, versionHistory.BranchToken, items[len(items)-1].GetEventId()+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *persistenceMigrator) copyHistoryBranch(
+	ctx context.Context,
+	shard *migrationShard,
+	key migrationExecutionKey,
+	branchToken []byte,
+	maxEventID int64,
+) error {
+	branchUtil := m.source.executionManager.GetHistoryBranchUtil()
+	branch, err := branchUtil.ParseHistoryBranchInfo(branchToken)
+	if err != nil {
+		return err
+	}
+
+	// A forked branch reads the events of its ancestors, which are stored under the ancestor
+	// branches. Each ancestor range is written to the ancestor branch it belongs to.
+	type segment struct {
+		branchToken []byte
+		endEventID  int64
+		isNewBranch bool
+	}
+	var segments []*segment
+	for i, ancestor := range branch.GetAncestors() {
+		token, err := branchUtil.UpdateHistoryBranchInfo(branchToken, &persistencespb.HistoryBranch{
+			TreeId:    branch.GetTreeId(),
+			BranchId:  ancestor.GetBranchId(),
+			Ancestors: branch.GetAncestors()[:i],
+		}, key.RunID)
+		if err != nil {
+			return err
+		}
+		segments = append(segments, &segment{branchToken: token, endEventID: ancestor.GetEndNodeId(), isNewBranch: true})
+	}
+	segments = append(segments, &segment{branchToken: branchToken, endEventID: math.MaxInt64, isNewBranch: true})
+
+	info := persistence.BuildHistoryGarbageCleanupInfo(key.NamespaceID, key.WorkflowID, key.RunID)
+	var prevTransactionID int64
+	var pageToken []byte
+	for {
+		resp, err := m.source.executionManager.ReadHistoryBranchByBatch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       shard.shardID,
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    maxEventID,
+			PageSize:      m.pageSize,
+			NextPageToken: pageToken,
+		})
+		if errors.As(err, new(*serviceerror.NotFound)) {
+			// The history was deleted or the execution has not written any events yet.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for i, batch := range resp.History {
+			events := batch.GetEvents()
+			if len(events) == 0 {
+				continue
+			}
+			for events[0].GetEventId() >= segments[0].endEventID {
+				segments = segments[1:]
+			}
+			_, err := m.target.executionManager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+				ShardID:           shard.shardID,
+				NamespaceID:       key.NamespaceID,
+				IsNewBranch:       segments[0].isNewBranch,
+				Info:              info,
+				BranchToken:       segments[0].branchToken,
+				Events:            events,
+				PrevTransactionID: prevTransactionID,
+				TransactionID:     resp.TransactionIDs[i],
+			})
+			if err != nil {
+				return err
+			}
+			segments[0].isNewBranch = false
+			prevTransactionID = resp.TransactionIDs[i]
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// migrateHistoryTasks copies the history tasks of one category. Tasks that already exist in
+// the target are skipped, so a page that was partially written before an interruption is safe to copy again.
+func (m *persistenceMigrator) migrateHistoryTasks(ctx context.Context, shard *migrationShard, category tasks.Category) error {
+	var progress migrationProgress
+	m.checkpoint.read(func() {
+		if p, ok := m.checkpoint.shard(shard.shardID).HistoryTasks[category.Name()]; ok {
+			progress = *p
+		}
+	})
+	if progress.Done {
+		return nil
+	}
+
+	minKey, maxKey := historyTaskRange(category)
+	for {
+		resp, err := m.source.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             shard.shardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: minKey,
+			ExclusiveMaxTaskKey: maxKey,
+			BatchSize:           m.pageSize,
+			NextPageToken:       progress.PageToken,
+		})
+		if err != nil {
+			return err
+		}
+		copied, skipped, err := m.copyHistoryTasks(ctx, shard, category, resp.Tasks)
+		if err != nil {
+			return err
+		}
+		progress.Copied += copied
+		progress.Skipped += skipped
+		progress.PageToken = resp.NextPageToken
+		progress.Done = len(resp.NextPageToken) == 0
+		if err := m.checkpoint.update(func() {
+			p := progress
+			m.checkpoint.shard(shard.shardID).HistoryTasks[category.Name()] = &p
+		}); err != nil {
+			return err
+		}
+		if progress.Done {
+			return nil
+		}
+	}
+}
+
+func (m *persistenceMigrator) copyHistoryTasks(
+	ctx context.Context,
+	shard *migrationShard,
+	category tasks.Category,
+	historyTasks []tasks.Task,
+) (int64, int64, error) {
+	if len(historyTasks) == 0 {
+		return 0, 0, nil
+	}
+	existing, err := m.existingHistoryTaskIDs(ctx, shard, category, historyTasks)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	type workflowKey struct {
+		namespaceID string
+		workflowID  string
+	}
+	var skipped int64
+	var keys []workflowKey
+	tasksByWorkflow := make(map[workflowKey][]tasks.Task)
+	for _, task := range historyTasks {
+		if _, ok := existing[task.GetTaskID()]; ok {
+			skipped++
+			continue
+		}
+		key := workflowKey{namespaceID: task.GetNamespaceID(), workflowID: task.GetWorkflowID()}
+		if _, ok := tasksByWorkflow[key]; !ok {
+			keys = append(keys, key)
+		}
+		tasksByWorkflow[key] = append(tasksByWorkflow[key], task)
+	}
+	for _, key := range keys {
+		if err := m.target.executionManager.AddHistoryTasks(ctx, &persistence.AddHistoryTasksRequest{
+			ShardID:     shard.shardID,
+			RangeID:     shard.rangeID,
+			NamespaceID: key.namespaceID,
+			WorkflowID:  key.workflowID,
+			ArchetypeID: chasm.WorkflowArchetypeID,
+			Tasks:       map[tasks.Category][]tasks.Task{category: tasksByWorkflow[key]},
+		}); err != nil {
+			return 0, 0, err
+		}
+	}
+	return int64(len(historyTasks)) - skipped, skipped, nil
+}
+
+// existingHistoryTaskIDs returns the IDs of the tasks in the target store within the key range of the given tasks.
+func (m *persistenceMigrator) existingHistoryTaskIDs(
+	ctx context.Context,
+	shard *migrationShard,
+	category tasks.Category,
+	historyTasks []tasks.Task,
+) (map[int64]struct{}, error) {
+	minKey, maxKey := historyTasks[0].GetKey(), historyTasks[0].GetKey()
+	for _, task := range historyTasks[1:] {
+		minKey = tasks.MinKey(minKey, task.GetKey())
+		maxKey = tasks.MaxKey(maxKey, task.GetKey())
+	}
+	if category.Type() == tasks.CategoryTypeScheduled {
+		// Scheduled task ranges are on fire time only, the precision of which may differ between stores.
+		minKey = tasks.NewKey(minKey.FireTime.Add(-time.Millisecond), 0)
+		maxKey = tasks.NewKey(maxKey.FireTime.Add(time.Millisecond), 0)
+	} else {
+		minKey = tasks.NewImmediateKey(minKey.TaskID)
+		maxKey = tasks.NewImmediateKey(maxKey.TaskID + 1)
+	}
+
+	existing := make(map[int64]struct{})
+	var pageToken []byte
+	for {
+		resp, err := m.target.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             shard.shardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: minKey,
+			ExclusiveMaxTaskKey: maxKey,
+			BatchSize:           m.pageSize,
+			NextPageToken:       pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range resp.Tasks {
+			existing[task.GetTaskID()] = struct{}{}
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return existing, nil
+		}
+	}
+}
+
+// historyTaskRange returns the key range that covers all tasks of a category.
+func historyTaskRange(category tasks.Category) (tasks.Key, tasks.Key) {
+	if category.Type() == tasks.CategoryTypeScheduled {
+		return tasks.NewKey(tasks.DefaultFireTime, 0), tasks.NewKey(tasks.MaximumKey.FireTime, 0)
+	}
+	return tasks.NewImmediateKey(0), tasks.NewImmediateKey(persistenceMigrationMaxTaskID)
+}
+
+// migrateTaskQueues copies the task queues of one task manager with their backlog. Queues that
+// already exist in the target are skipped, as they may already be owned by a matching host.
+func (m *persistenceMigrator) migrateTaskQueues(
+	ctx context.Context,
+	name string,
+	source persistence.TaskManager,
+	target persistence.TaskManager,
+	progress *migrationProgress,
+) error {
+	if progress.Done {
+		return nil
+	}
+	fair := name == "fairTaskQueues"
+	for {
+		resp, err := source.ListTaskQueue(ctx, &persistence.ListTaskQueueRequest{
+			PageSize:  m.pageSize,
+			PageToken: progress.PageToken,
+		})
+		if err != nil {
+			return err
+		}
+		var copied, skipped int64
+		for _, taskQueue := range resp.Items {
+			created, err := m.copyTaskQueue(ctx, source, target, taskQueue, fair)
+			if err != nil {
+				return fmt.Errorf("task queue %q: %w", taskQueue.Data.GetName(), err)
+			}
+			if created {
+				copied++
+			} else {
+				skipped++
+			}
+		}
+		if err := m.checkpoint.update(func() {
+			progress.Copied += copied
+			progress.Skipped += skipped
+			progress.PageToken = resp.NextPageToken
+			progress.Done = len(resp.NextPageToken) == 0
+		}); err != nil {
+			return err
+		}
+		if progress.Done {
+			m.printf("Task queues (%s): %d copied, %d already present.\n", name, progress.Copied, progress.Skipped)
+			return nil
+		}
+	}
+}
+
+func (m *persistenceMigrator) copyTaskQueue(
+	ctx context.Context,
+	source persistence.TaskManager,
+	target persistence.TaskManager,
+	taskQueue *persistence.PersistedTaskQueueInfo,
+	fair bool,
+) (bool, error) {
+	info := taskQueue.Data
+	_, err := target.CreateTaskQueue(ctx, &persistence.CreateTaskQueueRequest{
+		RangeID:       taskQueue.RangeID,
+		TaskQueueInfo: common.CloneProto(info),
+	})
+	if errors.As(err, new(*persistence.ConditionFailedError)) {
+		// Either copied by a previous run, or already created by a matching host of the target cluster.
+		// Its tasks are only copied if it has no backlog yet.
+		existing, err := target.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+			NamespaceID: info.GetNamespaceId(),
+			TaskQueue:   info.GetName(),
+			TaskType:    info.GetTaskType(),
+		})
+		if err != nil {
+			return false, err
+		}
+		taskQueue = &persistence.PersistedTaskQueueInfo{Data: existing.TaskQueueInfo, RangeID: existing.RangeID}
+		hasTasks, err := taskQueueHasTasks(ctx, target, info, fair)
+		if err != nil || hasTasks {
+			return false, err
+		}
+	} else if err != nil {
+		return false, err
+	}
+
+	for subqueue := range max(1, len(info.GetSubqueues())) {
+		var pageToken []byte
+		for {
+			resp, err := source.GetTasks(ctx, taskQueueGetTasksRequest(info, subqueue, fair, m.pageSize, pageToken))
+			if err != nil {
+				return false, err
+			}
+			if len(resp.Tasks) > 0 {
+				subqueues := make([]int, len(resp.Tasks))
+				for i := range subqueues {
+					subqueues[i] = subqueue
+				}
+				if _, err := target.CreateTasks(ctx, &persistence.CreateTasksRequest{
+					TaskQueueInfo: taskQueue,
+					Tasks:         resp.Tasks,
+					Subqueues:     subqueues,
+				}); err != nil {
+					return false, err
+				}
+			}
+			pageToken = resp.NextPageToken
+			if len(pageToken) == 0 {
+				break
+			}
+		}
+	}
+	return true, nil
+}
+
+func taskQueueHasTasks(ctx context.Context, manager persistence.TaskManager, info *persistencespb.TaskQueueInfo, fair bool) (bool, error) {
+	for subqueue := range max(1, len(info.GetSubqueues())) {
+		resp, err := manager.GetTasks(ctx, taskQueueGetTasksRequest(info, subqueue, fair, 1, nil))
+		if err != nil {
+			return false, err
+		}
+		if len(resp.Tasks) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func taskQueueGetTasksRequest(
+	info *persistencespb.TaskQueueInfo,
+	subqueue int,
+	fair bool,
+	pageSize int,
+	pageToken []byte,
+) *persistence.GetTasksRequest {
+	request := &persistence.GetTasksRequest{
+		NamespaceID:        info.GetNamespaceId(),
+		TaskQueue:          info.GetName(),
+		TaskType:           info.GetTaskType(),
+		InclusiveMinTaskID: 0,
+		ExclusiveMaxTaskID: persistenceMigrationMaxTaskID,
+		Subqueue:           subqueue,
+		PageSize:           pageSize,
+		NextPageToken:      pageToken,
+	}
+	if fair {
+		request.InclusiveMinPass = 1
+	}
+	return request
+}
+
+// migrateTaskQueueUserData copies the versioning data of the task queues of every namespace.
+func (m *persistenceMigrator) migrateTaskQueueUserData(ctx context.Context) error {
+	progress := &m.checkpoint.TaskQueueUserData
+	if progress.Done {
+		return nil
+	}
+	namespaceIDs, err := listNamespaceIDs(ctx, m.source.metadataManager, m.pageSize)
+	if err != nil {
+		return err
+	}
+	var copied, skipped int64
+	for _, namespaceID := range namespaceIDs {
+		var pageToken []byte
+		for {
+			resp, err := m.source.taskManager.ListTaskQueueUserDataEntries(ctx, &persistence.ListTaskQueueUserDataEntriesRequest{
+				NamespaceID:   namespaceID,
+				PageSize:      m.pageSize,
+				NextPageToken: pageToken,
+			})
+			if err != nil {
+				return err
+			}
+			for _, entry := range resp.Entries {
+				changed, err := m.copyTaskQueueUserData(ctx, namespaceID, entry)
+				if err != nil {
+					return fmt.Errorf("task queue %q: %w", entry.TaskQueue, err)
+				}
+				if changed {
+					copied++
+				} else {
+					skipped++
+				}
+			}
+			pageToken = resp.NextPageToken
+			if len(pageToken) == 0 {
+				break
+			}
+		}
+	}
+	if err := m.checkpoint.update(func() {
+		progress.Copied = copied
+		progress.Skipped = skipped
+		progress.Done = true
+	}); err != nil {
+		return err
+	}
+	m.printf("Task queue user data: %d copied, %d already up to date.\n", copied, skipped)
+	return nil
+}
+
+func (m *persistenceMigrator) copyTaskQueueUserData(ctx context.Context, namespaceID string, entry *persistence.TaskQueueUserDataEntry) (bool, error) {
+	var version int64
+	var previous *persistencespb.TaskQueueUserData
+	existing, err := m.target.taskManager.GetTaskQueueUserData(ctx, &persistence.GetTaskQueueUserDataRequest{
+		NamespaceID: namespaceID,
+		TaskQueue:   entry.TaskQueue,
+	})
+	switch {
+	case errors.As(err, new(*serviceerror.NotFound)):
+	case err != nil:
+		return false, err
+	default:
+		if proto.Equal(existing.UserData.GetData(), entry.UserData.GetData()) {
+			return false, nil
+		}
+		version = existing.UserData.GetVersion()
+		previous = existing.UserData.GetData()
+	}
+
+	added, removed := matching.GetBuildIdDeltas(previous.GetVersioningData(), entry.UserData.GetData().GetVersioningData())
+	return true, m.target.taskManager.UpdateTaskQueueUserData(ctx, &persistence.UpdateTaskQueueUserDataRequest{
+		NamespaceID: namespaceID,
+		Updates: map[string]*persistence.SingleTaskQueueUserDataUpdate{
+			entry.TaskQueue: {
+				UserData:        &persistencespb.VersionedTaskQueueUserData{Data: entry.UserData.GetData(), Version: version},
+				BuildIdsAdded:   added,
+				BuildIdsRemoved: removed,
+			},
+		},
+	})
+}
+
+func listNamespaceIDs(ctx context.Context, manager persistence.MetadataManager, pageSize int) ([]string, error) {
+	var namespaceIDs []string
+	var pageToken []byte
+	for {
+		resp, err := manager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       pageSize,
+			NextPageToken:  pageToken,
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range resp.Namespaces {
+			namespaceIDs = append(namespaceIDs, ns.Namespace.GetInfo().GetId())
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return namespaceIDs, nil
+		}
+	}
+}
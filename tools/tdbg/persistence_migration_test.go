@@ -0,0 +1,322 @@
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	clockspb "go.temporal.io/server/api/clock/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite" // needed to load sqlite plugin
+	persistencetests "go.temporal.io/server/common/persistence/tests"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/temporal/environment"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testMigrationNumHistoryShards = 2
+
+func newTestSQLitePersistenceStore(t *testing.T, name string) *persistenceStore {
+	cfg := &config.Persistence{
+		DefaultStore:     "default",
+		VisibilityStore:  "default",
+		NumHistoryShards: testMigrationNumHistoryShards,
+		DataStores: map[string]config.DataStore{
+			"default": {
+				SQL: &config.SQL{
+					PluginName:        "sqlite",
+					DatabaseName:      uuid.NewString(),
+					ConnectAddr:       environment.GetLocalhostIP(),
+					ConnectProtocol:   "tcp",
+					ConnectAttributes: map[string]string{"mode": "memory", "cache": "private"},
+				},
+			},
+		},
+	}
+	store, err := newPersistenceStore(name, cfg, "active", log.NewTestLogger())
+	require.NoError(t, err)
+	t.Cleanup(store.Close)
+	return store
+}
+
+type testMigrationSource struct {
+	store       *persistenceStore
+	namespaceID string
+	workflowID  string
+	runID       string
+	branchToken []byte
+}
+
+// populate writes at least one record of every kind copied by the migration to the store.
+func (s *testMigrationSource) populate(t *testing.T, ctx context.Context) {
+	store := s.store
+	s.namespaceID = uuid.NewString()
+	_, err := store.metadataManager.CreateNamespace(ctx, &persistence.CreateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:    s.namespaceID,
+				Name:  "migration-test",
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+			},
+			Config:            &persistencespb.NamespaceConfig{Retention: durationpb.New(24 * time.Hour)},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{ActiveClusterName: "active"},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = store.nexusEndpointManager.CreateOrUpdateNexusEndpoint(ctx, &persistence.CreateOrUpdateNexusEndpointRequest{
+		Entry: &persistencespb.NexusEndpointEntry{
+			Id: uuid.NewString(),
+			Endpoint: &persistencespb.NexusEndpoint{
+				Clock: &clockspb.HybridLogicalClock{WallClock: 1},
+				Spec:  &persistencespb.NexusEndpointSpec{Name: "endpoint"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	for shardID := int32(1); shardID <= testMigrationNumHistoryShards; shardID++ {
+		_, err = store.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+			ShardID:          shardID,
+			InitialShardInfo: &persistencespb.ShardInfo{ShardId: shardID, RangeId: 5},
+		})
+		require.NoError(t, err)
+	}
+
+	// A completed run that is not the current run and a running current run of the same workflow.
+	s.workflowID = "workflow-id"
+	s.createExecution(t, ctx, uuid.NewString(), enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, persistence.CreateWorkflowModeBypassCurrent)
+	s.runID = uuid.NewString()
+	s.branchToken = s.createExecution(t, ctx, s.runID, enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, persistence.CreateWorkflowModeBrandNew)
+
+	workflowKey := definition.NewWorkflowKey(s.namespaceID, s.workflowID, s.runID)
+	err = store.executionManager.AddHistoryTasks(ctx, &persistence.AddHistoryTasksRequest{
+		ShardID:     1,
+		RangeID:     5,
+		NamespaceID: s.namespaceID,
+		WorkflowID:  s.workflowID,
+		ArchetypeID: chasm.WorkflowArchetypeID,
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryTransfer: {&tasks.ActivityTask{
+				WorkflowKey:         workflowKey,
+				TaskID:              100,
+				VisibilityTimestamp: time.Now().UTC(),
+				ScheduledEventID:    5,
+			}},
+			tasks.CategoryTimer: {&tasks.UserTimerTask{
+				WorkflowKey:         workflowKey,
+				TaskID:              101,
+				VisibilityTimestamp: time.Now().Add(time.Hour).UTC(),
+				EventID:             6,
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	taskQueueInfo := &persistencespb.TaskQueueInfo{
+		NamespaceId:    s.namespaceID,
+		Name:           "task-queue",
+		TaskType:       enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		Kind:           enumspb.TASK_QUEUE_KIND_NORMAL,
+		LastUpdateTime: timestamppb.New(time.Unix(1000, 0)),
+	}
+	_, err = store.taskManager.CreateTaskQueue(ctx, &persistence.CreateTaskQueueRequest{
+		RangeID:       1,
+		TaskQueueInfo: taskQueueInfo,
+	})
+	require.NoError(t, err)
+	_, err = store.taskManager.CreateTasks(ctx, &persistence.CreateTasksRequest{
+		TaskQueueInfo: &persistence.PersistedTaskQueueInfo{Data: taskQueueInfo, RangeID: 1},
+		Tasks: []*persistencespb.AllocatedTaskInfo{{
+			TaskId: 1,
+			Data: &persistencespb.TaskInfo{
+				NamespaceId:      s.namespaceID,
+				WorkflowId:       s.workflowID,
+				RunId:            s.runID,
+				ScheduledEventId: 5,
+				CreateTime:       timestamppb.New(time.Unix(1000, 0)),
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	err = store.taskManager.UpdateTaskQueueUserData(ctx, &persistence.UpdateTaskQueueUserDataRequest{
+		NamespaceID: s.namespaceID,
+		Updates: map[string]*persistence.SingleTaskQueueUserDataUpdate{
+			"task-queue": {
+				UserData: &persistencespb.VersionedTaskQueueUserData{
+					Data: &persistencespb.TaskQueueUserData{Clock: &clockspb.HybridLogicalClock{WallClock: 1}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func (s *testMigrationSource) createExecution(
+	t *testing.T,
+	ctx context.Context,
+	runID string,
+	state enumsspb.WorkflowExecutionState,
+	mode persistence.CreateWorkflowMode,
+) []byte {
+	status := enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	if state == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	}
+	branchToken := persistencetests.RandomBranchToken(s.namespaceID, s.workflowID, runID, s.store.executionManager.GetHistoryBranchUtil())
+	snapshot, events := persistencetests.RandomSnapshot(t, s.namespaceID, s.workflowID, runID, common.FirstEventID, 0, state, status, 1, branchToken)
+	_, err := s.store.executionManager.CreateWorkflowExecution(ctx, &persistence.CreateWorkflowExecutionRequest{
+		ShardID:             1,
+		RangeID:             5,
+		Mode:                mode,
+		ArchetypeID:         chasm.WorkflowArchetypeID,
+		NewWorkflowSnapshot: *snapshot,
+		NewWorkflowEvents:   events,
+	})
+	require.NoError(t, err)
+	return branchToken
+}
+
+// updateExecution writes the next event of the running execution, along with random mutable state changes.
+func (s *testMigrationSource) updateExecution(t *testing.T, ctx context.Context) {
+	resp, err := s.store.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     1,
+		NamespaceID: s.namespaceID,
+		WorkflowID:  s.workflowID,
+		RunID:       s.runID,
+		ArchetypeID: chasm.WorkflowArchetypeID,
+	})
+	require.NoError(t, err)
+	mutation, events := persistencetests.RandomMutation(
+		t, s.namespaceID, s.workflowID, s.runID, resp.State.NextEventId, 0,
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, resp.DBRecordVersion+1, s.branchToken,
+	)
+	mutation.Condition = resp.State.NextEventId
+	_, err = s.store.executionManager.UpdateWorkflowExecution(ctx, &persistence.UpdateWorkflowExecutionRequest{
+		ShardID:                1,
+		RangeID:                5,
+		Mode:                   persistence.UpdateWorkflowModeUpdateCurrent,
+		ArchetypeID:            chasm.WorkflowArchetypeID,
+		UpdateWorkflowMutation: *mutation,
+		UpdateWorkflowEvents:   events,
+	})
+	require.NoError(t, err)
+}
+
+func newTestMigrator(source, target *persistenceStore, checkpointFile string, output *bytes.Buffer) *persistenceMigrator {
+	checkpoint, err := loadMigrationCheckpoint(checkpointFile)
+	if err != nil {
+		panic(err)
+	}
+	return &persistenceMigrator{
+		source:         source,
+		target:         target,
+		checkpoint:     checkpoint,
+		taskCategories: persistenceMigrationTaskCategories(tasks.NewDefaultTaskCategoryRegistry()),
+		shardIDs:       []int32{1, 2},
+		pageSize:       1,
+		workers:        2,
+		output:         output,
+	}
+}
+
+func newTestVerifier(source, target *persistenceStore, output *bytes.Buffer) *persistenceVerifier {
+	return &persistenceVerifier{
+		source:         source,
+		target:         target,
+		taskCategories: persistenceMigrationTaskCategories(tasks.NewDefaultTaskCategoryRegistry()),
+		shardIDs:       []int32{1, 2},
+		pageSize:       1,
+		workers:        2,
+		output:         output,
+	}
+}
+
+func TestPersistenceMigration(t *testing.T) {
+	ctx := context.Background()
+	source := &testMigrationSource{store: newTestSQLitePersistenceStore(t, "source")}
+	source.populate(t, ctx)
+	target := newTestSQLitePersistenceStore(t, "target")
+
+	var output bytes.Buffer
+	err := newTestVerifier(source.store, target, &output).Verify(ctx)
+	require.ErrorIs(t, err, errVerificationMismatch)
+
+	checkpointFile := filepath.Join(t.TempDir(), "checkpoint.json")
+	output.Reset()
+	require.NoError(t, newTestMigrator(source.store, target, checkpointFile, &output).Migrate(ctx))
+	require.Contains(t, output.String(), "Shard 1: 2 executions, 2 history tasks copied, 0 failed")
+	require.Contains(t, output.String(), "Migration finished.")
+
+	output.Reset()
+	require.NoError(t, newTestVerifier(source.store, target, &output).Verify(ctx), output.String())
+	require.NotContains(t, output.String(), "MISMATCH")
+
+	shard, err := target.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{ShardID: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), shard.ShardInfo.RangeId)
+	current, err := target.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     1,
+		NamespaceID: source.namespaceID,
+		WorkflowID:  source.workflowID,
+		ArchetypeID: chasm.WorkflowArchetypeID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, source.runID, current.RunID)
+
+	// Resuming a finished migration is a no-op.
+	output.Reset()
+	require.NoError(t, newTestMigrator(source.store, target, checkpointFile, &output).Migrate(ctx))
+	require.NotContains(t, output.String(), "Namespaces:")
+
+	// Changes made to the source after the first pass are copied by a new pass.
+	source.updateExecution(t, ctx)
+	output.Reset()
+	require.ErrorIs(t, newTestVerifier(source.store, target, &output).Verify(ctx), errVerificationMismatch)
+	require.Contains(t, output.String(), "Shard 1: executions differ")
+
+	output.Reset()
+	secondCheckpointFile := filepath.Join(t.TempDir(), "checkpoint.json")
+	require.NoError(t, newTestMigrator(source.store, target, secondCheckpointFile, &output).Migrate(ctx))
+	require.Contains(t, output.String(), "Namespaces: 0 copied, 1 already up to date.")
+	require.Contains(t, output.String(), "Task queues (taskQueues): 0 copied, 1 already present.")
+
+	output.Reset()
+	require.NoError(t, newTestVerifier(source.store, target, &output).Verify(ctx), output.String())
+}
+
+func TestMigrationCheckpoint_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	checkpoint, err := loadMigrationCheckpoint(path)
+	require.NoError(t, err)
+	require.Empty(t, checkpoint.Shards)
+
+	require.NoError(t, checkpoint.update(func() {
+		checkpoint.Namespaces.Done = true
+		progress := checkpoint.shard(3)
+		progress.Executions.PageToken = []byte("token")
+		progress.HistoryTasks["transfer"] = &migrationProgress{Copied: 7}
+		progress.FailedExecutions = []migrationExecutionKey{{NamespaceID: "ns", WorkflowID: "wf", RunID: "run"}}
+	}))
+
+	loaded, err := loadMigrationCheckpoint(path)
+	require.NoError(t, err)
+	require.True(t, loaded.Namespaces.Done)
+	require.Equal(t, []byte("token"), loaded.Shards[3].Executions.PageToken)
+	require.Equal(t, int64(7), loaded.Shards[3].HistoryTasks["transfer"].Copied)
+	require.Equal(t, checkpoint.Shards[3].FailedExecutions, loaded.Shards[3].FailedExecutions)
+}
@@ -0,0 +1,1050 @@
+package tdbg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPersistenceReplayWorkers = 32
+	persistenceReplayQueueSize      = 1024
+	// persistenceReplayMaxPadding caps the payload written for a single recorded call.
+	persistenceReplayMaxPadding     = 4 * 1024 * 1024
+	persistenceReplayHistogramWidth = 40
+)
+
+// persistenceReplayLatencyBuckets are the upper bounds of the latency histogram buckets.
+var persistenceReplayLatencyBuckets = []time.Duration{
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	20 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	200 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2 * time.Second,
+	5 * time.Second,
+}
+
+var notFoundErrorType = fmt.Sprintf("%T", serviceerror.NotFound{})
+
+type (
+	// persistenceReplayer replays a recording of persistence calls against a store. Recordings only hold the
+	// shape of the calls, so the replayer writes synthetic records of the same size under new IDs and keeps
+	// a model of what it wrote, so that reads and conditional writes find what they expect. Entities that are
+	// not in the model yet, because they were created before the recording started, are created first
+	// without being measured.
+	persistenceReplayer struct {
+		store       *persistenceStore
+		speed       float64
+		workers     int
+		callTimeout time.Duration
+		output      io.Writer
+		// idPrefix makes the IDs of a replay unique, so that a recording can be replayed more than once
+		// against the same store.
+		idPrefix string
+
+		shardRangeIDs map[int32]int64
+		nextTaskID    atomic.Int64
+	}
+
+	// persistenceReplayWorker replays the calls of the entities assigned to it in the order they were recorded.
+	persistenceReplayWorker struct {
+		*persistenceReplayer
+		calls chan persistence.RecordedCall
+
+		executions  map[migrationExecutionKey]*replayExecution
+		currentRuns map[replayWorkflowKey]string
+		branches    map[string]*replayBranch
+		taskQueues  map[replayTaskQueueKey]*replayTaskQueue
+		stats       map[string]*replayMethodStats
+	}
+
+	replayWorkflowKey struct {
+		namespaceID string
+		workflowID  string
+	}
+
+	replayExecution struct {
+		shardID         int32
+		nextEventID     int64
+		dbRecordVersion int64
+	}
+
+	replayBranch struct {
+		shardID     int32
+		namespaceID string
+		token       []byte
+		nextNodeID  int64
+		txnID       int64
+	}
+
+	replayTaskQueueKey struct {
+		manager     string
+		namespaceID string
+		name        string
+		taskType    enumspb.TaskQueueType
+	}
+
+	replayTaskQueue struct {
+		rangeID    int64
+		nextTaskID int64
+	}
+
+	replayMethodStats struct {
+		latencies      []time.Duration
+		recorded       []time.Duration
+		errors         int
+		recordedErrors int
+		skipped        int
+	}
+
+	// replayOp is the measured part of a replayed call.
+	replayOp func(ctx context.Context) error
+)
+
+// AdminReplayPersistence replays a recording of persistence calls against a store and reports the latencies
+func AdminReplayPersistence(c *cli.Context) error {
+	recordingFile, err := getRequiredOption(c, FlagRecording)
+	if err != nil {
+		return err
+	}
+	targetConfig, err := getRequiredOption(c, FlagTargetConfig)
+	if err != nil {
+		return err
+	}
+	speed := c.Float64(FlagSpeed)
+	workers := c.Int(FlagWorkers)
+	if speed < 0 || workers <= 0 {
+		return fmt.Errorf("--%s must not be negative and --%s must be positive", FlagSpeed, FlagWorkers)
+	}
+
+	calls, err := loadPersistenceRecording(recordingFile)
+	if err != nil {
+		return err
+	}
+	target, err := newPersistenceStoreFromFile("target", targetConfig, log.NewCLILogger())
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	replayer := newPersistenceReplayer(target, speed, workers, c.App.Writer)
+	if c.IsSet(FlagContextTimeout) {
+		replayer.callTimeout = time.Duration(c.Int(FlagContextTimeout)) * time.Second
+	}
+	_, err = replayer.Replay(c.Context, calls)
+	return err
+}
+
+func loadPersistenceRecording(recordingFile string) ([]persistence.RecordedCall, error) {
+	file, err := os.Open(recordingFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open recording: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var calls []persistence.RecordedCall
+	if err := persistence.ReadRecordedCalls(file, func(call persistence.RecordedCall) error {
+		calls = append(calls, call)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("unable to read recording %q: %w", recordingFile, err)
+	}
+	// Calls are written when they complete, replay them in the order they started.
+	sort.SliceStable(calls, func(i, j int) bool { return calls[i].Time.Before(calls[j].Time) })
+	return calls, nil
+}
+
+func newPersistenceReplayer(store *persistenceStore, speed float64, workers int, output io.Writer) *persistenceReplayer {
+	return &persistenceReplayer{
+		store:       store,
+		speed:       speed,
+		workers:     workers,
+		callTimeout: defaultContextTimeout,
+		output:      output,
+		idPrefix:    "replay-" + uuid.NewString()[:8],
+	}
+}
+
+// Replay replays calls, which must be ordered by time, prints the latencies per method and returns them.
+func (r *persistenceReplayer) Replay(ctx context.Context, calls []persistence.RecordedCall) (map[string]*replayMethodStats, error) {
+	if len(calls) == 0 {
+		return nil, errors.New("the recording is empty")
+	}
+	if err := r.acquireShards(ctx); err != nil {
+		return nil, err
+	}
+
+	workers := make([]*persistenceReplayWorker, r.workers)
+	var wg sync.WaitGroup
+	for i := range workers {
+		workers[i] = &persistenceReplayWorker{
+			persistenceReplayer: r,
+			calls:               make(chan persistence.RecordedCall, persistenceReplayQueueSize),
+			executions:          make(map[migrationExecutionKey]*replayExecution),
+			currentRuns:         make(map[replayWorkflowKey]string),
+			branches:            make(map[string]*replayBranch),
+			taskQueues:          make(map[replayTaskQueueKey]*replayTaskQueue),
+			stats:               make(map[string]*replayMethodStats),
+		}
+		wg.Go(func() {
+			for call := range workers[i].calls {
+				workers[i].replay(ctx, call)
+			}
+		})
+	}
+
+	startTime := time.Now()
+	firstCall := calls[0].Time
+	var maxLag time.Duration
+	for _, call := range calls {
+		if r.speed > 0 {
+			due := startTime.Add(time.Duration(float64(call.Time.Sub(firstCall)) / r.speed))
+			if wait := time.Until(due); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+				}
+			} else {
+				maxLag = max(maxLag, -wait)
+			}
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		workers[replayCallPartition(call)%uint32(len(workers))].calls <- call
+	}
+	for _, worker := range workers {
+		close(worker.calls)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	stats := make(map[string]*replayMethodStats)
+	for _, worker := range workers {
+		for method, workerStats := range worker.stats {
+			methodStats, ok := stats[method]
+			if !ok {
+				methodStats = &replayMethodStats{}
+				stats[method] = methodStats
+			}
+			methodStats.latencies = append(methodStats.latencies, workerStats.latencies...)
+			methodStats.recorded = append(methodStats.recorded, workerStats.recorded...)
+			methodStats.errors += workerStats.errors
+			methodStats.recordedErrors += workerStats.recordedErrors
+			methodStats.skipped += workerStats.skipped
+		}
+	}
+	r.printReport(stats, calls[len(calls)-1].Time.Sub(firstCall), time.Since(startTime), maxLag)
+	return stats, nil
+}
+
+// acquireShards creates the shards of the target store, or takes them over by bumping their range ID.
+func (r *persistenceReplayer) acquireShards(ctx context.Context) error {
+	r.shardRangeIDs = make(map[int32]int64, r.store.numHistoryShards)
+	for shardID := int32(1); shardID <= r.store.numHistoryShards; shardID++ {
+		callCtx, cancel := context.WithTimeout(ctx, r.callTimeout)
+		resp, err := r.store.shardManager.GetOrCreateShard(callCtx, &persistence.GetOrCreateShardRequest{
+			ShardID:          shardID,
+			InitialShardInfo: &persistencespb.ShardInfo{ShardId: shardID, RangeId: 1},
+		})
+		if err == nil && resp.ShardInfo.GetRangeId() > 1 {
+			shardInfo := resp.ShardInfo
+			previousRangeID := shardInfo.RangeId
+			shardInfo.RangeId++
+			err = r.store.shardManager.UpdateShard(callCtx, &persistence.UpdateShardRequest{
+				ShardInfo:       shardInfo,
+				PreviousRangeID: previousRangeID,
+			})
+		}
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to acquire shard %d: %w", shardID, err)
+		}
+		r.shardRangeIDs[shardID] = resp.ShardInfo.GetRangeId()
+	}
+	return nil
+}
+
+// replayCallPartition returns the same value for all calls on an entity, so they are replayed in order.
+func replayCallPartition(call persistence.RecordedCall) uint32 {
+	h := fnv.New32a()
+	switch {
+	case call.TreeID != "":
+		_, _ = h.Write([]byte(call.TreeID))
+	case call.WorkflowID != "":
+		_, _ = h.Write([]byte(call.NamespaceID + "/" + call.WorkflowID))
+	case call.TaskQueue != "":
+		_, _ = fmt.Fprintf(h, "%s/%s/%s/%d", call.Manager, call.NamespaceID, call.TaskQueue, call.TaskType)
+	default:
+		_, _ = fmt.Fprintf(h, "%d", call.ShardID)
+	}
+	return h.Sum32()
+}
+
+func (w *persistenceReplayWorker) replay(ctx context.Context, call persistence.RecordedCall) {
+	method := call.Manager + "." + call.Method
+	stats, ok := w.stats[method]
+	if !ok {
+		stats = &replayMethodStats{}
+		w.stats[method] = stats
+	}
+	stats.recorded = append(stats.recorded, call.Latency)
+	if call.Error != "" {
+		stats.recordedErrors++
+	}
+
+	op, err := w.prepare(ctx, call)
+	if err != nil {
+		stats.errors++
+		return
+	}
+	if op == nil {
+		stats.skipped++
+		return
+	}
+	callCtx, cancel := context.WithTimeout(ctx, w.callTimeout)
+	defer cancel()
+	startTime := time.Now()
+	err = op(callCtx)
+	stats.latencies = append(stats.latencies, time.Since(startTime))
+	if err != nil {
+		stats.errors++
+	}
+}
+
+// prepare creates the entities call needs and returns the call to measure, or nil if the method is not replayed.
+func (w *persistenceReplayWorker) prepare(ctx context.Context, call persistence.RecordedCall) (replayOp, error) {
+	shardID := w.shardID(call.ShardID)
+	// Reads of entities that did not exist are replayed as is.
+	setup := call.Error != notFoundErrorType
+
+	switch call.Manager {
+	case persistence.RecordedShardManager:
+		return w.prepareShardCall(call, shardID), nil
+	case persistence.RecordedExecutionManager:
+		if call.TreeID != "" {
+			return w.prepareHistoryCall(ctx, call, shardID, setup)
+		}
+		return w.prepareExecutionCall(ctx, call, shardID, setup)
+	case persistence.RecordedTaskManager, persistence.RecordedFairTaskManager:
+		return w.prepareTaskCall(ctx, call, setup)
+	}
+	return nil, nil
+}
+
+func (w *persistenceReplayWorker) prepareShardCall(call persistence.RecordedCall, shardID int32) replayOp {
+	rangeID := w.shardRangeIDs[shardID]
+	switch call.Method {
+	case "GetOrCreateShard":
+		return func(ctx context.Context) error {
+			_, err := w.store.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{ShardID: shardID})
+			return err
+		}
+	case "UpdateShard":
+		return func(ctx context.Context) error {
+			return w.store.shardManager.UpdateShard(ctx, &persistence.UpdateShardRequest{
+				ShardInfo: &persistencespb.ShardInfo{
+					ShardId:    shardID,
+					RangeId:    rangeID,
+					Owner:      w.idPrefix,
+					UpdateTime: timestamppb.Now(),
+				},
+				PreviousRangeID: rangeID,
+			})
+		}
+	case "AssertShardOwnership":
+		return func(ctx context.Context) error {
+			return w.store.shardManager.AssertShardOwnership(ctx, &persistence.AssertShardOwnershipRequest{
+				ShardID: shardID,
+				RangeID: rangeID,
+			})
+		}
+	}
+	return nil
+}
+
+func (w *persistenceReplayWorker) prepareExecutionCall(
+	ctx context.Context,
+	call persistence.RecordedCall,
+	shardID int32,
+	setup bool,
+) (replayOp, error) {
+	key := migrationExecutionKey{
+		NamespaceID: w.uuid(call.NamespaceID),
+		WorkflowID:  w.idPrefix + "-" + call.WorkflowID,
+		RunID:       w.uuid(call.RunID),
+	}
+	workflowKey := replayWorkflowKey{namespaceID: key.NamespaceID, workflowID: key.WorkflowID}
+	rangeID := w.shardRangeIDs[shardID]
+
+	switch call.Method {
+	case "CreateWorkflowExecution":
+		return func(ctx context.Context) error {
+			return w.createExecution(ctx, shardID, key, call.Size)
+		}, nil
+	case "UpdateWorkflowExecution":
+		execution, err := w.ensureExecution(ctx, shardID, key)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			current := w.currentRuns[workflowKey] == key.RunID
+			mode := persistence.UpdateWorkflowModeBypassCurrent
+			if current {
+				mode = persistence.UpdateWorkflowModeUpdateCurrent
+			}
+			info, state := w.executionRecord(key, call.Size, current)
+			_, err := w.store.executionManager.UpdateWorkflowExecution(ctx, &persistence.UpdateWorkflowExecutionRequest{
+				ShardID:     execution.shardID,
+				RangeID:     rangeID,
+				Mode:        mode,
+				ArchetypeID: chasm.WorkflowArchetypeID,
+				UpdateWorkflowMutation: persistence.WorkflowMutation{
+					ExecutionInfo:   info,
+					ExecutionState:  state,
+					NextEventID:     execution.nextEventID + 1,
+					Tasks:           map[tasks.Category][]tasks.Task{},
+					Condition:       execution.nextEventID,
+					DBRecordVersion: execution.dbRecordVersion + 1,
+				},
+			})
+			if err != nil {
+				// The record is in an unknown state, recreate it on its next call.
+				delete(w.executions, key)
+				return err
+			}
+			execution.nextEventID++
+			execution.dbRecordVersion++
+			return nil
+		}, nil
+	case "GetWorkflowExecution":
+		if setup {
+			if _, err := w.ensureExecution(ctx, shardID, key); err != nil {
+				return nil, err
+			}
+		}
+		return func(ctx context.Context) error {
+			_, err := w.store.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+				ShardID:     shardID,
+				NamespaceID: key.NamespaceID,
+				WorkflowID:  key.WorkflowID,
+				RunID:       key.RunID,
+				ArchetypeID: chasm.WorkflowArchetypeID,
+			})
+			return err
+		}, nil
+	case "GetCurrentExecution":
+		if _, ok := w.currentRuns[workflowKey]; !ok && setup {
+			key.RunID = uuid.NewString()
+			if err := w.createExecution(ctx, shardID, key, 0); err != nil {
+				return nil, err
+			}
+		}
+		return func(ctx context.Context) error {
+			_, err := w.store.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+				ShardID:     shardID,
+				NamespaceID: key.NamespaceID,
+				WorkflowID:  key.WorkflowID,
+				ArchetypeID: chasm.WorkflowArchetypeID,
+			})
+			return err
+		}, nil
+	case "DeleteWorkflowExecution":
+		if _, err := w.ensureExecution(ctx, shardID, key); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			delete(w.executions, key)
+			return w.store.executionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+				ShardID:     shardID,
+				NamespaceID: key.NamespaceID,
+				WorkflowID:  key.WorkflowID,
+				RunID:       key.RunID,
+				ArchetypeID: chasm.WorkflowArchetypeID,
+			})
+		}, nil
+	case "DeleteCurrentWorkflowExecution":
+		if _, err := w.ensureExecution(ctx, shardID, key); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			if w.currentRuns[workflowKey] == key.RunID {
+				delete(w.currentRuns, workflowKey)
+			}
+			return w.store.executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+				ShardID:     shardID,
+				NamespaceID: key.NamespaceID,
+				WorkflowID:  key.WorkflowID,
+				RunID:       key.RunID,
+				ArchetypeID: chasm.WorkflowArchetypeID,
+			})
+		}, nil
+	case "AddHistoryTasks":
+		return func(ctx context.Context) error {
+			workflowKey := definition.NewWorkflowKey(key.NamespaceID, key.WorkflowID, key.RunID)
+			transferTasks := make([]tasks.Task, max(call.Count, 1))
+			for i := range transferTasks {
+				transferTasks[i] = &tasks.ActivityTask{
+					WorkflowKey:         workflowKey,
+					TaskID:              w.nextTaskID.Add(1),
+					VisibilityTimestamp: time.Now().UTC(),
+				}
+			}
+			return w.store.executionManager.AddHistoryTasks(ctx, &persistence.AddHistoryTasksRequest{
+				ShardID:     shardID,
+				RangeID:     rangeID,
+				NamespaceID: key.NamespaceID,
+				WorkflowID:  key.WorkflowID,
+				ArchetypeID: chasm.WorkflowArchetypeID,
+				Tasks:       map[tasks.Category][]tasks.Task{tasks.CategoryTransfer: transferTasks},
+			})
+		}, nil
+	case "GetHistoryTasks":
+		// The task category is not recorded, every history task read is replayed on the transfer queue.
+		return func(ctx context.Context) error {
+			_, err := w.store.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+				ShardID:             shardID,
+				TaskCategory:        tasks.CategoryTransfer,
+				InclusiveMinTaskKey: tasks.NewImmediateKey(0),
+				ExclusiveMaxTaskKey: tasks.NewImmediateKey(math.MaxInt64),
+				BatchSize:           max(call.PageSize, 1),
+			})
+			return err
+		}, nil
+	case "CompleteHistoryTask":
+		return func(ctx context.Context) error {
+			return w.store.executionManager.CompleteHistoryTask(ctx, &persistence.CompleteHistoryTaskRequest{
+				ShardID:      shardID,
+				TaskCategory: tasks.CategoryTransfer,
+				TaskKey:      tasks.NewImmediateKey(w.nextTaskID.Load()),
+			})
+		}, nil
+	case "RangeCompleteHistoryTasks":
+		return func(ctx context.Context) error {
+			return w.store.executionManager.RangeCompleteHistoryTasks(ctx, &persistence.RangeCompleteHistoryTasksRequest{
+				ShardID:             shardID,
+				TaskCategory:        tasks.CategoryTransfer,
+				InclusiveMinTaskKey: tasks.NewImmediateKey(0),
+				ExclusiveMaxTaskKey: tasks.NewImmediateKey(w.nextTaskID.Load() + 1),
+			})
+		}, nil
+	case "GetAllHistoryTreeBranches":
+		return func(ctx context.Context) error {
+			_, err := w.store.executionManager.GetAllHistoryTreeBranches(ctx, &persistence.GetAllHistoryTreeBranchesRequest{
+				PageSize: max(call.PageSize, 1),
+			})
+			return err
+		}, nil
+	}
+	return nil, nil
+}
+
+func (w *persistenceReplayWorker) prepareHistoryCall(
+	ctx context.Context,
+	call persistence.RecordedCall,
+	shardID int32,
+	setup bool,
+) (replayOp, error) {
+	treeID := w.uuid(call.TreeID)
+	switch call.Method {
+	case "AppendHistoryNodes", "AppendRawHistoryNodes":
+		branch, err := w.ensureBranch(ctx, shardID, w.uuid(call.NamespaceID), treeID, false)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			return w.appendHistoryNodes(ctx, treeID, branch, max(call.Count, 1), call.Size)
+		}, nil
+	case "ReadHistoryBranch", "ReadHistoryBranchByBatch", "ReadRawHistoryBranch", "ReadHistoryBranchReverse":
+		branch, err := w.ensureBranch(ctx, shardID, w.uuid(call.NamespaceID), treeID, setup)
+		if err != nil {
+			return nil, err
+		}
+		request := &persistence.ReadHistoryBranchRequest{
+			ShardID:     branch.shardID,
+			BranchToken: branch.token,
+			MinEventID:  1,
+			MaxEventID:  branch.nextNodeID,
+			PageSize:    max(call.PageSize, 1),
+		}
+		return func(ctx context.Context) error {
+			var err error
+			switch call.Method {
+			case "ReadHistoryBranch":
+				_, err = w.store.executionManager.ReadHistoryBranch(ctx, request)
+			case "ReadHistoryBranchByBatch":
+				_, err = w.store.executionManager.ReadHistoryBranchByBatch(ctx, request)
+			case "ReadRawHistoryBranch":
+				_, err = w.store.executionManager.ReadRawHistoryBranch(ctx, request)
+			default:
+				_, err = w.store.executionManager.ReadHistoryBranchReverse(ctx, &persistence.ReadHistoryBranchReverseRequest{
+					ShardID:                request.ShardID,
+					BranchToken:            request.BranchToken,
+					MaxEventID:             request.MaxEventID,
+					PageSize:               request.PageSize,
+					LastFirstTransactionID: branch.txnID,
+				})
+			}
+			return err
+		}, nil
+	case "DeleteHistoryBranch":
+		branch, err := w.ensureBranch(ctx, shardID, w.uuid(call.NamespaceID), treeID, true)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			delete(w.branches, treeID)
+			return w.store.executionManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+				ShardID:     branch.shardID,
+				BranchToken: branch.token,
+			})
+		}, nil
+	}
+	return nil, nil
+}
+
+func (w *persistenceReplayWorker) prepareTaskCall(ctx context.Context, call persistence.RecordedCall, setup bool) (replayOp, error) {
+	taskManager := w.store.taskManager
+	fair := call.Manager == persistence.RecordedFairTaskManager
+	if fair {
+		if w.store.fairTaskManager == nil {
+			return nil, nil
+		}
+		taskManager = w.store.fairTaskManager
+	}
+	key := replayTaskQueueKey{
+		manager:     call.Manager,
+		namespaceID: w.uuid(call.NamespaceID),
+		name:        w.idPrefix + "-" + call.TaskQueue,
+		taskType:    enumspb.TaskQueueType(call.TaskType),
+	}
+
+	switch call.Method {
+	case "CreateTaskQueue":
+		return func(ctx context.Context) error {
+			return w.createTaskQueue(ctx, taskManager, key)
+		}, nil
+	case "GetTaskQueue":
+		if setup {
+			if _, err := w.ensureTaskQueue(ctx, taskManager, key); err != nil {
+				return nil, err
+			}
+		}
+		return func(ctx context.Context) error {
+			_, err := taskManager.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+				NamespaceID: key.namespaceID,
+				TaskQueue:   key.name,
+				TaskType:    key.taskType,
+			})
+			return err
+		}, nil
+	case "UpdateTaskQueue":
+		taskQueue, err := w.ensureTaskQueue(ctx, taskManager, key)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			_, err := taskManager.UpdateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
+				RangeID:       taskQueue.rangeID + 1,
+				TaskQueueInfo: w.taskQueueInfo(key),
+				PrevRangeID:   taskQueue.rangeID,
+			})
+			if err != nil {
+				delete(w.taskQueues, key)
+				return err
+			}
+			taskQueue.rangeID++
+			return nil
+		}, nil
+	case "CreateTasks":
+		taskQueue, err := w.ensureTaskQueue(ctx, taskManager, key)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			allocatedTasks := make([]*persistencespb.AllocatedTaskInfo, max(call.Count, 1))
+			for i := range allocatedTasks {
+				allocatedTasks[i] = &persistencespb.AllocatedTaskInfo{
+					TaskId: taskQueue.nextTaskID,
+					Data: &persistencespb.TaskInfo{
+						NamespaceId: key.namespaceID,
+						WorkflowId:  key.name,
+						RunId:       uuid.NewString(),
+						CreateTime:  timestamppb.Now(),
+					},
+				}
+				if fair {
+					allocatedTasks[i].TaskPass = 1
+				}
+				taskQueue.nextTaskID++
+			}
+			_, err := taskManager.CreateTasks(ctx, &persistence.CreateTasksRequest{
+				TaskQueueInfo: &persistence.PersistedTaskQueueInfo{Data: w.taskQueueInfo(key), RangeID: taskQueue.rangeID},
+				Tasks:         allocatedTasks,
+			})
+			return err
+		}, nil
+	case "GetTasks":
+		taskQueue, err := w.ensureTaskQueue(ctx, taskManager, key)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			request := &persistence.GetTasksRequest{
+				NamespaceID:        key.namespaceID,
+				TaskQueue:          key.name,
+				TaskType:           key.taskType,
+				ExclusiveMaxTaskID: taskQueue.nextTaskID,
+				PageSize:           max(call.PageSize, 1),
+			}
+			if fair {
+				request.InclusiveMinPass = 1
+				request.ExclusiveMaxTaskID = 0
+			}
+			_, err := taskManager.GetTasks(ctx, request)
+			return err
+		}, nil
+	case "CompleteTasksLessThan":
+		taskQueue, err := w.ensureTaskQueue(ctx, taskManager, key)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			request := &persistence.CompleteTasksLessThanRequest{
+				NamespaceID:        key.namespaceID,
+				TaskQueueName:      key.name,
+				TaskType:           key.taskType,
+				ExclusiveMaxTaskID: taskQueue.nextTaskID,
+				Limit:              max(call.PageSize, 1),
+			}
+			if fair {
+				request.ExclusiveMaxPass = 1
+			}
+			_, err := taskManager.CompleteTasksLessThan(ctx, request)
+			return err
+		}, nil
+	case "DeleteTaskQueue":
+		taskQueue, err := w.ensureTaskQueue(ctx, taskManager, key)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			delete(w.taskQueues, key)
+			return taskManager.DeleteTaskQueue(ctx, &persistence.DeleteTaskQueueRequest{
+				TaskQueue: &persistence.TaskQueueKey{
+					NamespaceID:   key.namespaceID,
+					TaskQueueName: key.name,
+					TaskQueueType: key.taskType,
+				},
+				RangeID: taskQueue.rangeID,
+			})
+		}, nil
+	case "ListTaskQueue":
+		return func(ctx context.Context) error {
+			_, err := taskManager.ListTaskQueue(ctx, &persistence.ListTaskQueueRequest{PageSize: max(call.PageSize, 1)})
+			return err
+		}, nil
+	}
+	return nil, nil
+}
+
+// createExecution creates an execution, which becomes the current run of its workflow if there is none.
+func (w *persistenceReplayWorker) createExecution(ctx context.Context, shardID int32, key migrationExecutionKey, size int) error {
+	workflowKey := replayWorkflowKey{namespaceID: key.NamespaceID, workflowID: key.WorkflowID}
+	_, hasCurrentRun := w.currentRuns[workflowKey]
+	info, state := w.executionRecord(key, size, !hasCurrentRun)
+	request := &persistence.CreateWorkflowExecutionRequest{
+		ShardID:     shardID,
+		RangeID:     w.shardRangeIDs[shardID],
+		Mode:        persistence.CreateWorkflowModeBrandNew,
+		ArchetypeID: chasm.WorkflowArchetypeID,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo:   info,
+			ExecutionState:  state,
+			NextEventID:     2,
+			Tasks:           map[tasks.Category][]tasks.Task{},
+			DBRecordVersion: 1,
+		},
+	}
+	// The current run is still running in the model, later runs are created as zombies that do not replace it.
+	if hasCurrentRun {
+		request.Mode = persistence.CreateWorkflowModeBypassCurrent
+	}
+	if _, err := w.store.executionManager.CreateWorkflowExecution(ctx, request); err != nil {
+		return err
+	}
+	w.executions[key] = &replayExecution{shardID: shardID, nextEventID: 2, dbRecordVersion: 1}
+	if !hasCurrentRun {
+		w.currentRuns[workflowKey] = key.RunID
+	}
+	return nil
+}
+
+func (w *persistenceReplayWorker) ensureExecution(ctx context.Context, shardID int32, key migrationExecutionKey) (*replayExecution, error) {
+	if execution, ok := w.executions[key]; ok {
+		return execution, nil
+	}
+	if err := w.createExecution(ctx, shardID, key, 0); err != nil {
+		return nil, err
+	}
+	return w.executions[key], nil
+}
+
+// executionRecord returns the mutable state of an execution, padded to about size bytes. Executions that are
+// not the current run of their workflow are zombies.
+func (w *persistenceReplayWorker) executionRecord(
+	key migrationExecutionKey,
+	size int,
+	current bool,
+) (*persistencespb.WorkflowExecutionInfo, *persistencespb.WorkflowExecutionState) {
+	info := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId:      key.NamespaceID,
+		WorkflowId:       key.WorkflowID,
+		WorkflowTypeName: "replay",
+		ExecutionStats:   &persistencespb.ExecutionStats{},
+		StartTime:        timestamppb.Now(),
+	}
+	if size > 0 {
+		info.Memo = map[string]*commonpb.Payload{"padding": {Data: make([]byte, min(size, persistenceReplayMaxPadding))}}
+	}
+	state := &persistencespb.WorkflowExecutionState{
+		CreateRequestId: uuid.NewString(),
+		RunId:           key.RunID,
+		State:           enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		Status:          enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}
+	if !current {
+		state.State = enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE
+	}
+	return info, state
+}
+
+// ensureBranch returns the branch of a history tree, creating it with one batch of events if setup is true.
+// An empty branch is returned otherwise.
+func (w *persistenceReplayWorker) ensureBranch(
+	ctx context.Context,
+	shardID int32,
+	namespaceID string,
+	treeID string,
+	setup bool,
+) (*replayBranch, error) {
+	if branch, ok := w.branches[treeID]; ok {
+		return branch, nil
+	}
+	token, err := w.store.executionManager.GetHistoryBranchUtil().NewHistoryBranch(
+		namespaceID, "", "", treeID, nil, nil, 0, 0, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+	branch := &replayBranch{shardID: shardID, namespaceID: namespaceID, token: token, nextNodeID: 1}
+	w.branches[treeID] = branch
+	if setup {
+		if err := w.appendHistoryNodes(ctx, treeID, branch, 1, 0); err != nil {
+			return nil, err
+		}
+	}
+	return branch, nil
+}
+
+func (w *persistenceReplayWorker) appendHistoryNodes(ctx context.Context, treeID string, branch *replayBranch, count int, size int) error {
+	padding := make([]byte, min(size/count, persistenceReplayMaxPadding))
+	events := make([]*historypb.HistoryEvent, count)
+	for i := range events {
+		events[i] = &historypb.HistoryEvent{
+			EventId:   branch.nextNodeID + int64(i),
+			EventTime: timestamppb.Now(),
+			EventType: enumspb.EVENT_TYPE_MARKER_RECORDED,
+			Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{
+				MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{
+					MarkerName: "replay",
+					Details:    map[string]*commonpb.Payloads{"padding": {Payloads: []*commonpb.Payload{{Data: padding}}}},
+				},
+			},
+		}
+	}
+	_, err := w.store.executionManager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+		ShardID:           branch.shardID,
+		NamespaceID:       branch.namespaceID,
+		IsNewBranch:       branch.nextNodeID == 1,
+		Info:              persistence.BuildHistoryGarbageCleanupInfo(branch.namespaceID, treeID, treeID),
+		BranchToken:       branch.token,
+		Events:            events,
+		PrevTransactionID: branch.txnID,
+		TransactionID:     branch.txnID + 1,
+	})
+	if err != nil {
+		// The branch is in an unknown state, continue on a new branch.
+		delete(w.branches, treeID)
+		return err
+	}
+	branch.nextNodeID += int64(count)
+	branch.txnID++
+	return nil
+}
+
+func (w *persistenceReplayWorker) createTaskQueue(ctx context.Context, taskManager persistence.TaskManager, key replayTaskQueueKey) error {
+	if _, err := taskManager.CreateTaskQueue(ctx, &persistence.CreateTaskQueueRequest{
+		RangeID:       1,
+		TaskQueueInfo: w.taskQueueInfo(key),
+	}); err != nil {
+		return err
+	}
+	w.taskQueues[key] = &replayTaskQueue{rangeID: 1, nextTaskID: 1}
+	return nil
+}
+
+func (w *persistenceReplayWorker) ensureTaskQueue(
+	ctx context.Context,
+	taskManager persistence.TaskManager,
+	key replayTaskQueueKey,
+) (*replayTaskQueue, error) {
+	if taskQueue, ok := w.taskQueues[key]; ok {
+		return taskQueue, nil
+	}
+	if err := w.createTaskQueue(ctx, taskManager, key); err != nil {
+		return nil, err
+	}
+	return w.taskQueues[key], nil
+}
+
+func (w *persistenceReplayWorker) taskQueueInfo(key replayTaskQueueKey) *persistencespb.TaskQueueInfo {
+	return &persistencespb.TaskQueueInfo{
+		NamespaceId:    key.namespaceID,
+		Name:           key.name,
+		TaskType:       key.taskType,
+		Kind:           enumspb.TASK_QUEUE_KIND_NORMAL,
+		LastUpdateTime: timestamppb.Now(),
+	}
+}
+
+// shardID maps a recorded shard to a shard of the target store, which may have fewer shards.
+func (r *persistenceReplayer) shardID(recordedShardID int32) int32 {
+	if recordedShardID <= 0 {
+		return 1
+	}
+	return (recordedShardID-1)%r.store.numHistoryShards + 1
+}
+
+// uuid maps a recorded ID to a UUID unique to the replay.
+func (r *persistenceReplayer) uuid(recordedID string) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(r.idPrefix+"/"+recordedID)).String()
+}
+
+func (r *persistenceReplayer) printReport(
+	stats map[string]*replayMethodStats,
+	recordedDuration time.Duration,
+	replayDuration time.Duration,
+	maxLag time.Duration,
+) {
+	methods := make([]string, 0, len(stats))
+	for method := range stats {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	_, _ = fmt.Fprintf(r.output, "Replayed %s of calls in %s", recordedDuration.Round(time.Millisecond), replayDuration.Round(time.Millisecond))
+	if maxLag > 0 {
+		_, _ = fmt.Fprintf(r.output, ", up to %s behind schedule", maxLag.Round(time.Millisecond))
+	}
+	_, _ = fmt.Fprint(r.output, "\n\n")
+
+	table := tabwriter.NewWriter(r.output, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(table, "METHOD\tCALLS\tERRORS\tP50\tP90\tP99\tMAX\tRECORDED P50\tRECORDED P99\tRECORDED ERRORS")
+	var skipped []string
+	for _, method := range methods {
+		methodStats := stats[method]
+		if methodStats.skipped > 0 {
+			skipped = append(skipped, fmt.Sprintf("%s (%d)", method, methodStats.skipped))
+		}
+		if len(methodStats.latencies) == 0 && methodStats.errors == 0 {
+			continue
+		}
+		slices.Sort(methodStats.latencies)
+		slices.Sort(methodStats.recorded)
+		_, _ = fmt.Fprintf(table, "%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%d\n",
+			method,
+			len(methodStats.latencies),
+			methodStats.errors,
+			latencyPercentile(methodStats.latencies, 0.5),
+			latencyPercentile(methodStats.latencies, 0.9),
+			latencyPercentile(methodStats.latencies, 0.99),
+			latencyPercentile(methodStats.latencies, 1),
+			latencyPercentile(methodStats.recorded, 0.5),
+			latencyPercentile(methodStats.recorded, 0.99),
+			methodStats.recordedErrors,
+		)
+	}
+	_ = table.Flush()
+	if len(skipped) > 0 {
+		_, _ = fmt.Fprintf(r.output, "\nNot replayed: %s\n", strings.Join(skipped, ", "))
+	}
+
+	for _, method := range methods {
+		latencies := stats[method].latencies
+		if len(latencies) == 0 {
+			continue
+		}
+		_, _ = fmt.Fprintf(r.output, "\n%s\n", method)
+		counts := make([]int, len(persistenceReplayLatencyBuckets)+1)
+		for _, latency := range latencies {
+			bucket, _ := slices.BinarySearch(persistenceReplayLatencyBuckets, latency)
+			counts[bucket]++
+		}
+		maxCount := slices.Max(counts)
+		for bucket, count := range counts {
+			if count == 0 {
+				continue
+			}
+			label := fmt.Sprintf("> %s", persistenceReplayLatencyBuckets[len(persistenceReplayLatencyBuckets)-1])
+			if bucket < len(persistenceReplayLatencyBuckets) {
+				label = fmt.Sprintf("<= %s", persistenceReplayLatencyBuckets[bucket])
+			}
+			bar := strings.Repeat("#", max(1, count*persistenceReplayHistogramWidth/maxCount))
+			_, _ = fmt.Fprintf(r.output, "  %8s  %-*s %d\n", label, persistenceReplayHistogramWidth, bar, count)
+		}
+	}
+}
+
+// latencyPercentile returns the latency at percentile p of sorted latencies.
+func latencyPercentile(latencies []time.Duration, p float64) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	index := int(math.Ceil(p*float64(len(latencies)))) - 1
+	return latencies[max(index, 0)].Round(time.Microsecond)
+}
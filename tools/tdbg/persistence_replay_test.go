@@ -0,0 +1,115 @@
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/persistence"
+)
+
+func TestPersistenceReplay(t *testing.T) {
+	ctx := context.Background()
+	target := newTestSQLitePersistenceStore(t, "target")
+
+	startTime := time.Unix(1000, 0).UTC()
+	var calls []persistence.RecordedCall
+	addCall := func(call persistence.RecordedCall) {
+		call.Time = startTime.Add(time.Duration(len(calls)) * time.Millisecond)
+		call.Latency = time.Millisecond
+		calls = append(calls, call)
+	}
+	execution := func(manager, method string) persistence.RecordedCall {
+		return persistence.RecordedCall{
+			Manager: manager, Method: method, ShardID: 3, NamespaceID: "ns", WorkflowID: "wf", RunID: "run", Size: 512,
+		}
+	}
+	taskQueue := func(manager, method string) persistence.RecordedCall {
+		return persistence.RecordedCall{Manager: manager, Method: method, NamespaceID: "ns", TaskQueue: "tq", TaskType: 1, Count: 3, PageSize: 10}
+	}
+	history := func(method string) persistence.RecordedCall {
+		return persistence.RecordedCall{
+			Manager: persistence.RecordedExecutionManager, Method: method, ShardID: 3, NamespaceID: "ns", TreeID: "tree", Count: 2, Size: 256, PageSize: 10,
+		}
+	}
+
+	addCall(persistence.RecordedCall{Manager: persistence.RecordedShardManager, Method: "GetOrCreateShard", ShardID: 3})
+	addCall(persistence.RecordedCall{Manager: persistence.RecordedShardManager, Method: "UpdateShard", ShardID: 3})
+	addCall(persistence.RecordedCall{Manager: persistence.RecordedShardManager, Method: "AssertShardOwnership", ShardID: 3})
+	// The workflow was created before the recording started.
+	addCall(execution(persistence.RecordedExecutionManager, "GetWorkflowExecution"))
+	addCall(execution(persistence.RecordedExecutionManager, "UpdateWorkflowExecution"))
+	addCall(execution(persistence.RecordedExecutionManager, "UpdateWorkflowExecution"))
+	newRun := execution(persistence.RecordedExecutionManager, "CreateWorkflowExecution")
+	newRun.RunID = "new-run"
+	addCall(newRun)
+	addCall(execution(persistence.RecordedExecutionManager, "GetCurrentExecution"))
+	addCall(execution(persistence.RecordedExecutionManager, "AddHistoryTasks"))
+	addCall(persistence.RecordedCall{Manager: persistence.RecordedExecutionManager, Method: "GetHistoryTasks", ShardID: 3, PageSize: 10})
+	addCall(persistence.RecordedCall{Manager: persistence.RecordedExecutionManager, Method: "RangeCompleteHistoryTasks", ShardID: 3})
+	addCall(history("ReadHistoryBranch"))
+	addCall(history("AppendHistoryNodes"))
+	addCall(history("ReadHistoryBranchReverse"))
+	addCall(history("DeleteHistoryBranch"))
+	for _, manager := range []string{persistence.RecordedTaskManager, persistence.RecordedFairTaskManager} {
+		addCall(taskQueue(manager, "CreateTasks"))
+		addCall(taskQueue(manager, "GetTasks"))
+		addCall(taskQueue(manager, "UpdateTaskQueue"))
+		addCall(taskQueue(manager, "CompleteTasksLessThan"))
+		addCall(taskQueue(manager, "GetTaskQueue"))
+	}
+	addCall(execution(persistence.RecordedExecutionManager, "DeleteWorkflowExecution"))
+	// A read of a workflow that did not exist is not preceded by its creation.
+	missing := execution(persistence.RecordedExecutionManager, "GetWorkflowExecution")
+	missing.WorkflowID = "missing"
+	missing.Error = notFoundErrorType
+	addCall(missing)
+	addCall(taskQueue(persistence.RecordedTaskManager, "GetTaskQueueUserData"))
+
+	// Replay the recording twice, against the same store.
+	for range 2 {
+		var output bytes.Buffer
+		replayer := newPersistenceReplayer(target, 0, 4, &output)
+		stats, err := replayer.Replay(ctx, calls)
+		require.NoError(t, err)
+
+		require.Len(t, stats["ExecutionManager.UpdateWorkflowExecution"].latencies, 2)
+		for method, methodStats := range stats {
+			switch method {
+			case "ExecutionManager.GetWorkflowExecution":
+				// The missing workflow is not found, like when it was recorded.
+				require.Equal(t, 1, methodStats.errors, method)
+				require.Equal(t, 1, methodStats.recordedErrors, method)
+			case "TaskManager.GetTaskQueueUserData":
+				require.Equal(t, 1, methodStats.skipped)
+			default:
+				require.Zero(t, methodStats.errors, method)
+				require.Len(t, methodStats.latencies, len(methodStats.recorded), method)
+			}
+		}
+		require.Contains(t, output.String(), "ExecutionManager.UpdateWorkflowExecution")
+		require.Contains(t, output.String(), "Not replayed: TaskManager.GetTaskQueueUserData (1)")
+		require.Contains(t, output.String(), "<= ")
+	}
+}
+
+func TestLoadPersistenceRecording(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(
+		`{"time":"2024-01-01T00:00:02Z","manager":"ShardManager","method":"UpdateShard","latency":1000}
+{"time":"2024-01-01T00:00:01Z","manager":"ShardManager","method":"GetOrCreateShard","latency":2000}
+`), 0600))
+	calls, err := loadPersistenceRecording(path)
+	require.NoError(t, err)
+	require.Len(t, calls, 2)
+	require.Equal(t, "GetOrCreateShard", calls[0].Method)
+	require.Equal(t, 2*time.Microsecond, calls[0].Latency)
+
+	require.NoError(t, os.WriteFile(path, []byte("not json"), 0600))
+	_, err = loadPersistenceRecording(path)
+	require.Error(t, err)
+}
@@ -0,0 +1,163 @@
+package tdbg
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/resolver"
+)
+
+type (
+	// persistenceStore holds the persistence managers of one side of a migration.
+	persistenceStore struct {
+		name             string
+		numHistoryShards int32
+		factory          persistenceClient.Factory
+
+		shardManager         persistence.ShardManager
+		executionManager     persistence.ExecutionManager
+		metadataManager      persistence.MetadataManager
+		taskManager          persistence.TaskManager
+		nexusEndpointManager persistence.NexusEndpointManager
+		// fairTaskManager is nil if the store does not support fairness task queues.
+		fairTaskManager persistence.FairTaskManager
+	}
+)
+
+// newPersistenceStoreFromFile creates the persistence managers for the default store of a server config file.
+func newPersistenceStoreFromFile(name string, configFile string, logger log.Logger) (*persistenceStore, error) {
+	cfg, err := config.Load(config.WithConfigFile(configFile))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load %s config %q: %w", name, configFile, err)
+	}
+	var clusterName string
+	if cfg.ClusterMetadata != nil {
+		clusterName = cfg.ClusterMetadata.CurrentClusterName
+	}
+	return newPersistenceStore(name, &cfg.Persistence, clusterName, logger)
+}
+
+func newPersistenceStore(
+	name string,
+	cfg *config.Persistence,
+	clusterName string,
+	logger log.Logger,
+) (_ *persistenceStore, retErr error) {
+	// Validate also fills in defaults of the data store configs.
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("%s config: %w", name, err)
+	}
+	storeCfg, ok := cfg.DataStores[cfg.DefaultStore]
+	if !ok {
+		return nil, fmt.Errorf("%s config: default store %q is not defined", name, cfg.DefaultStore)
+	}
+	if storeCfg.Cassandra == nil && storeCfg.SQL == nil {
+		return nil, fmt.Errorf("%s config: default store %q must be a cassandra or sql store", name, cfg.DefaultStore)
+	}
+	if cfg.NumHistoryShards <= 0 {
+		return nil, fmt.Errorf("%s config: numHistoryShards must be positive", name)
+	}
+
+	if cfg.TransactionSizeLimit == nil {
+		cfg.TransactionSizeLimit = dynamicconfig.GetIntPropertyFn(primitives.DefaultTransactionSizeLimit)
+	}
+
+	var keyProvider serialization.KeyProvider
+	if cfg.BlobEncryption != nil {
+		var err error
+		if keyProvider, err = serialization.NewFileKeyProvider(cfg.BlobEncryption.KeyFile); err != nil {
+			return nil, fmt.Errorf("%s config: %w", name, err)
+		}
+	}
+	serializer := serialization.NewSerializer(serialization.WithBlobEncryption(keyProvider))
+
+	dataStoreFactory := persistenceClient.DataStoreFactoryProvider(
+		persistenceClient.ClusterName(clusterName),
+		resolver.NewNoopResolver(),
+		cfg,
+		nil,
+		logger,
+		metrics.NoopMetricsHandler,
+		noop.NewTracerProvider(),
+		serializer,
+	)
+	factory := persistenceClient.NewFactory(
+		dataStoreFactory,
+		cfg,
+		nil,
+		nil,
+		nil,
+		serializer,
+		nil,
+		clusterName,
+		metrics.NoopMetricsHandler,
+		logger,
+		persistence.NoopHealthSignalAggregator,
+		persistenceClient.EnableDataLossMetrics(dynamicconfig.GetBoolPropertyFn(false)),
+		persistenceClient.EnableBestEffortDeleteTasksOnWorkflowUpdate(dynamicconfig.GetBoolPropertyFn(false)),
+		nil,
+	)
+	store := &persistenceStore{
+		name:             name,
+		numHistoryShards: cfg.NumHistoryShards,
+		factory:          factory,
+	}
+	defer func() {
+		if retErr != nil {
+			store.Close()
+		}
+	}()
+
+	var err error
+	if store.shardManager, err = factory.NewShardManager(); err != nil {
+		return nil, fmt.Errorf("unable to create %s shard manager: %w", name, err)
+	}
+	if store.executionManager, err = factory.NewExecutionManager(); err != nil {
+		return nil, fmt.Errorf("unable to create %s execution manager: %w", name, err)
+	}
+	if store.metadataManager, err = factory.NewMetadataManager(); err != nil {
+		return nil, fmt.Errorf("unable to create %s metadata manager: %w", name, err)
+	}
+	if store.taskManager, err = factory.NewTaskManager(); err != nil {
+		return nil, fmt.Errorf("unable to create %s task manager: %w", name, err)
+	}
+	if store.nexusEndpointManager, err = factory.NewNexusEndpointManager(); err != nil {
+		return nil, fmt.Errorf("unable to create %s nexus endpoint manager: %w", name, err)
+	}
+	if store.fairTaskManager, err = factory.NewFairTaskManager(); err != nil {
+		var unimplementedErr *serviceerror.Unimplemented
+		if !errors.As(err, &unimplementedErr) {
+			return nil, fmt.Errorf("unable to create %s fair task manager: %w", name, err)
+		}
+		store.fairTaskManager = nil
+	}
+	return store, nil
+}
+
+// Close closes all managers and the underlying data store.
+func (s *persistenceStore) Close() {
+	for _, manager := range []persistence.Closeable{
+		s.shardManager,
+		s.executionManager,
+		s.metadataManager,
+		s.taskManager,
+		s.nexusEndpointManager,
+		s.fairTaskManager,
+	} {
+		if manager != nil {
+			manager.Close()
+		}
+	}
+	// Closing the factory also closes the data store factory.
+	s.factory.Close()
+}
@@ -0,0 +1,563 @@
+package tdbg
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// persistenceVerifier compares the records of two persistence stores. Each store is digested
+	// independently, so records are compared by count and by an order independent checksum.
+	persistenceVerifier struct {
+		source         *persistenceStore
+		target         *persistenceStore
+		taskCategories []tasks.Category
+		shardIDs       []int32
+		pageSize       int
+		workers        int
+
+		outputLock sync.Mutex
+		output     io.Writer
+	}
+
+	// migrationDigest is the number of records of a section and the XOR of the hashes of the records.
+	migrationDigest struct {
+		count int64
+		sum   [sha256.Size]byte
+	}
+
+	verificationSection struct {
+		name   string
+		source migrationDigest
+		target migrationDigest
+	}
+
+	// shardDigests are the digests of the records of one shard, keyed by section name.
+	shardDigests map[string]*migrationDigest
+)
+
+var (
+	errVerificationMismatch = errors.New("source and target stores do not match")
+
+	deterministicMarshal = proto.MarshalOptions{Deterministic: true}
+)
+
+// add adds a record made of the given parts to the digest.
+func (d *migrationDigest) add(parts ...[]byte) {
+	hash := sha256.New()
+	var length [8]byte
+	for _, part := range parts {
+		binary.BigEndian.PutUint64(length[:], uint64(len(part)))
+		_, _ = hash.Write(length[:])
+		_, _ = hash.Write(part)
+	}
+	var sum [sha256.Size]byte
+	hash.Sum(sum[:0])
+	d.merge(migrationDigest{count: 1, sum: sum})
+}
+
+func (d *migrationDigest) addProto(parts ...proto.Message) error {
+	encoded := make([][]byte, len(parts))
+	for i, part := range parts {
+		data, err := deterministicMarshal.Marshal(part)
+		if err != nil {
+			return err
+		}
+		encoded[i] = data
+	}
+	d.add(encoded...)
+	return nil
+}
+
+func (d *migrationDigest) merge(other migrationDigest) {
+	d.count += other.count
+	for i := range d.sum {
+		d.sum[i] ^= other.sum[i]
+	}
+}
+
+func (d migrationDigest) String() string {
+	return fmt.Sprintf("%d records, checksum %x", d.count, d.sum[:8])
+}
+
+func (s shardDigests) get(section string) *migrationDigest {
+	digest, ok := s[section]
+	if !ok {
+		digest = &migrationDigest{}
+		s[section] = digest
+	}
+	return digest
+}
+
+func (v *persistenceVerifier) printf(format string, args ...any) {
+	v.outputLock.Lock()
+	defer v.outputLock.Unlock()
+	_, _ = fmt.Fprintf(v.output, format, args...)
+}
+
+// Verify digests both stores and prints the result of every section. It returns errVerificationMismatch
+// if any section differs.
+func (v *persistenceVerifier) Verify(ctx context.Context) error {
+	var sections []*verificationSection
+	addSection := func(name string, digest func(*persistenceStore) (migrationDigest, error)) error {
+		section := &verificationSection{name: name}
+		var err error
+		if section.source, err = digest(v.source); err != nil {
+			return fmt.Errorf("unable to digest %s of the source store: %w", name, err)
+		}
+		if section.target, err = digest(v.target); err != nil {
+			return fmt.Errorf("unable to digest %s of the target store: %w", name, err)
+		}
+		sections = append(sections, section)
+		return nil
+	}
+
+	if err := addSection("namespaces", func(store *persistenceStore) (migrationDigest, error) {
+		return digestNamespaces(ctx, store, v.pageSize)
+	}); err != nil {
+		return err
+	}
+	if err := addSection("nexus endpoints", func(store *persistenceStore) (migrationDigest, error) {
+		return digestNexusEndpoints(ctx, store, v.pageSize)
+	}); err != nil {
+		return err
+	}
+	shardSections, err := v.verifyShards(ctx)
+	if err != nil {
+		return err
+	}
+	sections = append(sections, shardSections...)
+	if err := addSection("task queues", func(store *persistenceStore) (migrationDigest, error) {
+		return digestTaskQueues(ctx, store.taskManager, false, v.pageSize)
+	}); err != nil {
+		return err
+	}
+	if v.source.fairTaskManager != nil && v.target.fairTaskManager != nil {
+		if err := addSection("fairness task queues", func(store *persistenceStore) (migrationDigest, error) {
+			return digestTaskQueues(ctx, store.fairTaskManager, true, v.pageSize)
+		}); err != nil {
+			return err
+		}
+	}
+	if err := addSection("task queue user data", func(store *persistenceStore) (migrationDigest, error) {
+		return digestTaskQueueUserData(ctx, store, v.pageSize)
+	}); err != nil {
+		return err
+	}
+
+	var mismatch bool
+	for _, section := range sections {
+		status := "OK"
+		if section.source != section.target {
+			status = "MISMATCH"
+			mismatch = true
+		}
+		v.printf("%-40s %-8s source: %s, target: %s\n", section.name, status, section.source, section.target)
+	}
+	if mismatch {
+		return errVerificationMismatch
+	}
+	return nil
+}
+
+// verifyShards digests the executions and history tasks of every shard of both stores, and
+// prints the shards that differ.
+func (v *persistenceVerifier) verifyShards(ctx context.Context) ([]*verificationSection, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sectionNames := []string{"executions"}
+	for _, category := range v.taskCategories {
+		sectionNames = append(sectionNames, category.Name()+" tasks")
+	}
+	sections := make(map[string]*verificationSection, len(sectionNames))
+	for _, name := range sectionNames {
+		sections[name] = &verificationSection{name: name}
+	}
+
+	shardIDs := make(chan int32)
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var firstErr error
+	for range v.workers {
+		wg.Go(func() {
+			for shardID := range shardIDs {
+				source, err := v.digestShard(ctx, v.source, shardID)
+				if err != nil {
+					err = fmt.Errorf("unable to digest shard %d of the source store: %w", shardID, err)
+				}
+				var target shardDigests
+				if err == nil {
+					target, err = v.digestShard(ctx, v.target, shardID)
+					if err != nil {
+						err = fmt.Errorf("unable to digest shard %d of the target store: %w", shardID, err)
+					}
+				}
+
+				lock.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+					lock.Unlock()
+					continue
+				}
+				for _, name := range sectionNames {
+					sections[name].source.merge(*source.get(name))
+					sections[name].target.merge(*target.get(name))
+				}
+				lock.Unlock()
+
+				for _, name := range sectionNames {
+					if *source.get(name) != *target.get(name) {
+						v.printf("Shard %d: %s differ, source: %s, target: %s\n", shardID, name, source.get(name), target.get(name))
+					}
+				}
+			}
+		})
+	}
+	for _, shardID := range v.shardIDs {
+		select {
+		case shardIDs <- shardID:
+		case <-ctx.Done():
+		}
+	}
+	close(shardIDs)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	result := make([]*verificationSection, len(sectionNames))
+	for i, name := range sectionNames {
+		result[i] = sections[name]
+	}
+	return result, nil
+}
+
+func (v *persistenceVerifier) digestShard(ctx context.Context, store *persistenceStore, shardID int32) (shardDigests, error) {
+	digests := make(shardDigests)
+	executions := digests.get("executions")
+	var pageToken []byte
+	for {
+		resp, err := store.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   shardID,
+			PageSize:  v.pageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, listed := range resp.States {
+			if err := digestExecution(ctx, store, shardID, listed, v.pageSize, executions); err != nil {
+				return nil, fmt.Errorf("execution %s/%s/%s: %w",
+					listed.GetExecutionInfo().GetNamespaceId(), listed.GetExecutionInfo().GetWorkflowId(), listed.GetExecutionState().GetRunId(), err)
+			}
+		}
+		pageToken = resp.PageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	for _, category := range v.taskCategories {
+		if err := digestHistoryTasks(ctx, store, shardID, category, v.pageSize, digests.get(category.Name()+" tasks")); err != nil {
+			return nil, fmt.Errorf("%s tasks: %w", category.Name(), err)
+		}
+	}
+	return digests, nil
+}
+
+// digestExecution adds the mutable state of an execution, together with the events of its history branches, to the digest.
+func digestExecution(
+	ctx context.Context,
+	store *persistenceStore,
+	shardID int32,
+	listed *persistencespb.WorkflowMutableState,
+	pageSize int,
+	digest *migrationDigest,
+) error {
+	resp, err := store.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: listed.GetExecutionInfo().GetNamespaceId(),
+		WorkflowID:  listed.GetExecutionInfo().GetWorkflowId(),
+		RunID:       listed.GetExecutionState().GetRunId(),
+		ArchetypeID: chasm.WorkflowArchetypeID,
+	})
+	if errors.As(err, new(*serviceerror.NotFound)) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	state := resp.State
+
+	parts := make([][]byte, 0, 1+len(state.GetExecutionInfo().GetVersionHistories().GetHistories()))
+	stateData, err := marshalMutableState(state)
+	if err != nil {
+		return err
+	}
+	parts = append(parts, stateData)
+	for _, versionHistory := range state.GetExecutionInfo().GetVersionHistories().GetHistories() {
+		items := versionHistory.GetItems()
+		if len(versionHistory.GetBranchToken()) == 0 || len(items) == 0 {
+			continue
+		}
+		historyDigest, err := digestHistoryBranch(ctx, store, shardID, versionHistory.GetBranchToken(), items[len(items)-1].GetEventId()+1, pageSize)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, historyDigest)
+	}
+	digest.add(parts...)
+	return nil
+}
+
+// digestHistoryBranch returns the hash of the events of a history branch, in order.
+func digestHistoryBranch(
+	ctx context.Context,
+	store *persistenceStore,
+	shardID int32,
+	branchToken []byte,
+	maxEventID int64,
+	pageSize int,
+) ([]byte, error) {
+	hash := sha256.New()
+	var pageToken []byte
+	for {
+		resp, err := store.executionManager.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       shardID,
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    maxEventID,
+			PageSize:      pageSize,
+			NextPageToken: pageToken,
+		})
+		if errors.As(err, new(*serviceerror.NotFound)) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range resp.HistoryEvents {
+			data, err := deterministicMarshal.Marshal(event)
+			if err != nil {
+				return nil, err
+			}
+			_, _ = hash.Write(data)
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	return hash.Sum(nil), nil
+}
+
+// marshalMutableState returns an encoding of a mutable state that is stable across stores.
+func marshalMutableState(state *persistencespb.WorkflowMutableState) ([]byte, error) {
+	state = common.CloneProto(state)
+	// Signal requested IDs are stored as a set.
+	slices.Sort(state.SignalRequestedIds)
+	return deterministicMarshal.Marshal(state)
+}
+
+// mutableStatesEqual returns true if two mutable states, as returned by GetWorkflowExecution, are the same.
+func mutableStatesEqual(a, b *persistencespb.WorkflowMutableState) (bool, error) {
+	aData, err := marshalMutableState(a)
+	if err != nil {
+		return false, err
+	}
+	bData, err := marshalMutableState(b)
+	if err != nil {
+		return false, err
+	}
+	return slices.Equal(aData, bData), nil
+}
+
+// digestHistoryTasks adds the history tasks of a category to the digest. Fire times are left out as
+// their precision differs between stores.
+func digestHistoryTasks(
+	ctx context.Context,
+	store *persistenceStore,
+	shardID int32,
+	category tasks.Category,
+	pageSize int,
+	digest *migrationDigest,
+) error {
+	minKey, maxKey := historyTaskRange(category)
+	var pageToken []byte
+	for {
+		resp, err := store.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             shardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: minKey,
+			ExclusiveMaxTaskKey: maxKey,
+			BatchSize:           pageSize,
+			NextPageToken:       pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, task := range resp.Tasks {
+			var taskID [16]byte
+			binary.BigEndian.PutUint64(taskID[:8], uint64(task.GetTaskID()))
+			binary.BigEndian.PutUint64(taskID[8:], uint64(task.GetType()))
+			digest.add(taskID[:], []byte(task.GetNamespaceID()), []byte(task.GetWorkflowID()), []byte(task.GetRunID()))
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func digestNamespaces(ctx context.Context, store *persistenceStore, pageSize int) (migrationDigest, error) {
+	var digest migrationDigest
+	var pageToken []byte
+	for {
+		resp, err := store.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       pageSize,
+			NextPageToken:  pageToken,
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return digest, err
+		}
+		for _, ns := range resp.Namespaces {
+			data, err := deterministicMarshal.Marshal(ns.Namespace)
+			if err != nil {
+				return digest, err
+			}
+			digest.add(data, fmt.Appendf(nil, "%t", ns.IsGlobalNamespace))
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return digest, nil
+		}
+	}
+}
+
+// digestNexusEndpoints digests the endpoints without their versions, which depend on the order of writes.
+func digestNexusEndpoints(ctx context.Context, store *persistenceStore, pageSize int) (migrationDigest, error) {
+	var digest migrationDigest
+	entries, _, err := listNexusEndpoints(ctx, store.nexusEndpointManager, pageSize)
+	if err != nil {
+		return digest, err
+	}
+	for _, entry := range entries {
+		data, err := deterministicMarshal.Marshal(entry.Endpoint)
+		if err != nil {
+			return digest, err
+		}
+		digest.add([]byte(entry.Id), data)
+	}
+	return digest, nil
+}
+
+// digestTaskQueues digests the task queues with their tasks. Range IDs are left out as they
+// change whenever a matching host takes ownership of a queue.
+func digestTaskQueues(ctx context.Context, manager persistence.TaskManager, fair bool, pageSize int) (migrationDigest, error) {
+	var digest migrationDigest
+	var pageToken []byte
+	for {
+		resp, err := manager.ListTaskQueue(ctx, &persistence.ListTaskQueueRequest{
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return digest, err
+		}
+		for _, taskQueue := range resp.Items {
+			if err := digest.addProto(taskQueue.Data); err != nil {
+				return digest, err
+			}
+			if err := digestTasks(ctx, manager, taskQueue.Data, fair, pageSize, &digest); err != nil {
+				return digest, fmt.Errorf("task queue %q: %w", taskQueue.Data.GetName(), err)
+			}
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return digest, nil
+		}
+	}
+}
+
+func digestTasks(
+	ctx context.Context,
+	manager persistence.TaskManager,
+	info *persistencespb.TaskQueueInfo,
+	fair bool,
+	pageSize int,
+	digest *migrationDigest,
+) error {
+	for subqueue := range max(1, len(info.GetSubqueues())) {
+		var pageToken []byte
+		for {
+			resp, err := manager.GetTasks(ctx, taskQueueGetTasksRequest(info, subqueue, fair, pageSize, pageToken))
+			if err != nil {
+				return err
+			}
+			for _, task := range resp.Tasks {
+				if err := digest.addProto(task); err != nil {
+					return err
+				}
+			}
+			pageToken = resp.NextPageToken
+			if len(pageToken) == 0 {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// digestTaskQueueUserData digests the user data of every task queue. Versions are left out as they
+// count the updates made to each store.
+func digestTaskQueueUserData(ctx context.Context, store *persistenceStore, pageSize int) (migrationDigest, error) {
+	var digest migrationDigest
+	namespaceIDs, err := listNamespaceIDs(ctx, store.metadataManager, pageSize)
+	if err != nil {
+		return digest, err
+	}
+	for _, namespaceID := range namespaceIDs {
+		var pageToken []byte
+		for {
+			resp, err := store.taskManager.ListTaskQueueUserDataEntries(ctx, &persistence.ListTaskQueueUserDataEntriesRequest{
+				NamespaceID:   namespaceID,
+				PageSize:      pageSize,
+				NextPageToken: pageToken,
+			})
+			if err != nil {
+				return digest, err
+			}
+			for _, entry := range resp.Entries {
+				data, err := deterministicMarshal.Marshal(entry.UserData.GetData())
+				if err != nil {
+					return digest, err
+				}
+				digest.add([]byte(namespaceID), []byte(entry.TaskQueue), data)
+			}
+			pageToken = resp.NextPageToken
+			if len(pageToken) == 0 {
+				break
+			}
+		}
+	}
+	return digest, nil
+}
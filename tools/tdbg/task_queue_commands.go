@@ -3,6 +3,7 @@ package tdbg
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/urfave/cli/v2"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -226,6 +227,144 @@ func AdminGetTaskQueueUserData(c *cli.Context, clientFactory ClientFactory) erro
 	return nil
 }
 
+// AdminPauseTaskQueueDispatch pauses dispatch of tasks of a task queue
+func AdminPauseTaskQueueDispatch(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, tqName, tqType, err := getTaskQueueOptions(c)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.PauseTaskQueueDispatch(ctx, &adminservice.PauseTaskQueueDispatchRequest{
+		Namespace:     namespace,
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+		Reason:        c.String(FlagReason),
+		Identity:      getCurrentUserFromEnv(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to pause Task Queue dispatch: %w", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminResumeTaskQueueDispatch resumes dispatch of tasks of a task queue
+func AdminResumeTaskQueueDispatch(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, tqName, tqType, err := getTaskQueueOptions(c)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = client.ResumeTaskQueueDispatch(ctx, &adminservice.ResumeTaskQueueDispatchRequest{
+		Namespace:     namespace,
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+		Identity:      getCurrentUserFromEnv(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to resume Task Queue dispatch: %w", err)
+	}
+	fmt.Fprintln(c.App.Writer, "Task Queue dispatch resumed.")
+	return nil
+}
+
+// AdminListTaskQueueDLQTasks lists the tasks in the DLQ of a task queue
+func AdminListTaskQueueDLQTasks(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, tqName, tqType, err := getTaskQueueOptions(c)
+	if err != nil {
+		return err
+	}
+	pageSize := c.Int(FlagPageSize)
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.ListTaskQueueDLQTasksRequest{
+		Namespace:     namespace,
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+		PageSize:      int32(pageSize),
+	}
+	paginationFunc := func(paginationToken []byte) ([]any, []byte, error) {
+		ctx, cancel := newContext(c)
+		defer cancel()
+
+		req.NextPageToken = paginationToken
+		response, err := client.ListTaskQueueDLQTasks(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+		var items []any
+		for _, task := range response.Tasks {
+			items = append(items, task)
+		}
+		return items, response.NextPageToken, nil
+	}
+
+	if err := paginate(c, paginationFunc, pageSize); err != nil {
+		return fmt.Errorf("unable to list Task Queue DLQ tasks: %w", err)
+	}
+	return nil
+}
+
+// AdminReenqueueTaskQueueDLQTasks adds the tasks in the DLQ of a task queue back to the task queue
+func AdminReenqueueTaskQueueDLQTasks(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, tqName, tqType, err := getTaskQueueOptions(c)
+	if err != nil {
+		return err
+	}
+	lastMessageID := c.Int64(FlagLastMessageID)
+	if !c.IsSet(FlagLastMessageID) {
+		lastMessageID = math.MaxInt64
+	}
+
+	client := clientFactory.AdminClient(c)
+	var total int64
+	for {
+		ctx, cancel := newContext(c)
+		response, err := client.ReenqueueTaskQueueDLQTasks(ctx, &adminservice.ReenqueueTaskQueueDLQTasksRequest{
+			Namespace:             namespace,
+			TaskQueue:             tqName,
+			TaskQueueType:         tqType,
+			InclusiveMaxMessageId: lastMessageID,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to re-enqueue Task Queue DLQ tasks after %d tasks: %w", total, err)
+		}
+		total += response.GetTasksReenqueued()
+		if !response.GetHasMore() {
+			break
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "Re-enqueued %d tasks.\n", total)
+	return nil
+}
+
+func getTaskQueueOptions(c *cli.Context) (string, string, enumspb.TaskQueueType, error) {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return "", "", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED, err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return "", "", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED, err
+	}
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return "", "", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED, fmt.Errorf("invalid task queue type: %w", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return "", "", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED, errors.New("invalid task queue type") // nolint
+	}
+	return namespace, tqName, tqType, nil
+}
+
 // AdminForceUnloadTaskQueuePartition forcefully unloads a task queue partition
 func AdminForceUnloadTaskQueuePartition(c *cli.Context, clientFactory ClientFactory) error {
 	// extracting the namespace
@@ -3,11 +3,13 @@ package tdbg
 import (
 	"context"
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.uber.org/mock/gomock"
@@ -20,6 +22,10 @@ type (
 		describeTaskQueuePartitionFn    func(request *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error)
 		forceUnloadTaskQueuePartitionFn func(request *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error)
 		getTaskQueueUserDataFn          func(request *adminservice.GetTaskQueueUserDataRequest) (*adminservice.GetTaskQueueUserDataResponse, error)
+		pauseTaskQueueDispatchFn        func(request *adminservice.PauseTaskQueueDispatchRequest) (*adminservice.PauseTaskQueueDispatchResponse, error)
+		resumeTaskQueueDispatchFn       func(request *adminservice.ResumeTaskQueueDispatchRequest) (*adminservice.ResumeTaskQueueDispatchResponse, error)
+		listTaskQueueDLQTasksFn         func(request *adminservice.ListTaskQueueDLQTasksRequest) (*adminservice.ListTaskQueueDLQTasksResponse, error)
+		reenqueueTaskQueueDLQTasksFn    func(request *adminservice.ReenqueueTaskQueueDLQTasksRequest) (*adminservice.ReenqueueTaskQueueDLQTasksResponse, error)
 	}
 )
 
@@ -87,6 +93,22 @@ func (t *testClient) GetTaskQueueUserData(_ context.Context, request *adminservi
 	return t.getTaskQueueUserDataFn(request)
 }
 
+func (t *testClient) PauseTaskQueueDispatch(_ context.Context, request *adminservice.PauseTaskQueueDispatchRequest, opts ...grpc.CallOption) (*adminservice.PauseTaskQueueDispatchResponse, error) {
+	return t.pauseTaskQueueDispatchFn(request)
+}
+
+func (t *testClient) ResumeTaskQueueDispatch(_ context.Context, request *adminservice.ResumeTaskQueueDispatchRequest, opts ...grpc.CallOption) (*adminservice.ResumeTaskQueueDispatchResponse, error) {
+	return t.resumeTaskQueueDispatchFn(request)
+}
+
+func (t *testClient) ListTaskQueueDLQTasks(_ context.Context, request *adminservice.ListTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*adminservice.ListTaskQueueDLQTasksResponse, error) {
+	return t.listTaskQueueDLQTasksFn(request)
+}
+
+func (t *testClient) ReenqueueTaskQueueDLQTasks(_ context.Context, request *adminservice.ReenqueueTaskQueueDLQTasksRequest, opts ...grpc.CallOption) (*adminservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+	return t.reenqueueTaskQueueDLQTasksFn(request)
+}
+
 func (s *taskQueueCommandTestSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 	s.controller = gomock.NewController(s.T())
@@ -102,6 +124,12 @@ func (s *taskQueueCommandTestSuite) SetupTest() {
 		getTaskQueueUserDataFn: func(request *adminservice.GetTaskQueueUserDataRequest) (*adminservice.GetTaskQueueUserDataResponse, error) {
 			return &adminservice.GetTaskQueueUserDataResponse{}, nil
 		},
+		pauseTaskQueueDispatchFn: func(request *adminservice.PauseTaskQueueDispatchRequest) (*adminservice.PauseTaskQueueDispatchResponse, error) {
+			return &adminservice.PauseTaskQueueDispatchResponse{}, nil
+		},
+		resumeTaskQueueDispatchFn: func(request *adminservice.ResumeTaskQueueDispatchRequest) (*adminservice.ResumeTaskQueueDispatchResponse, error) {
+			return &adminservice.ResumeTaskQueueDispatchResponse{}, nil
+		},
 	}
 	s.app = NewCliApp(func(params *Params) {
 		params.ClientFactory = client
@@ -220,3 +248,79 @@ func (s *taskQueueCommandTestSuite) TestGetTaskQueueUserData() {
 		"--namespace", "default", "--task-queue", "test"})
 	s.ErrorContains(resp, "unable to get Task Queue User Data")
 }
+
+// TestPauseAndResumeTaskQueueDispatch tests that the cli passes its arguments to the pause and resume APIs.
+func (s *taskQueueCommandTestSuite) TestPauseAndResumeTaskQueueDispatch() {
+	var pauseRequest *adminservice.PauseTaskQueueDispatchRequest
+	var resumeRequest *adminservice.ResumeTaskQueueDispatchRequest
+	client := &testClient{
+		pauseTaskQueueDispatchFn: func(request *adminservice.PauseTaskQueueDispatchRequest) (*adminservice.PauseTaskQueueDispatchResponse, error) {
+			pauseRequest = request
+			return &adminservice.PauseTaskQueueDispatchResponse{}, nil
+		},
+		resumeTaskQueueDispatchFn: func(request *adminservice.ResumeTaskQueueDispatchRequest) (*adminservice.ResumeTaskQueueDispatchResponse, error) {
+			resumeRequest = request
+			return &adminservice.ResumeTaskQueueDispatchResponse{}, nil
+		},
+	}
+	app := NewCliApp(func(params *Params) { params.ClientFactory = client })
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	s.NoError(app.Run([]string{"tdbg", "taskqueue", "pause-dispatch",
+		"--namespace", "default", "--task-queue", "test",
+		"--task-queue-type", "TASK_QUEUE_TYPE_ACTIVITY", "--reason", "downstream outage"}))
+	s.Equal("default", pauseRequest.GetNamespace())
+	s.Equal("test", pauseRequest.GetTaskQueue())
+	s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, pauseRequest.GetTaskQueueType())
+	s.Equal("downstream outage", pauseRequest.GetReason())
+
+	s.NoError(app.Run([]string{"tdbg", "taskqueue", "resume-dispatch",
+		"--namespace", "default", "--task-queue", "test"}))
+	s.Equal("test", resumeRequest.GetTaskQueue())
+	s.Equal(enumspb.TASK_QUEUE_TYPE_WORKFLOW, resumeRequest.GetTaskQueueType())
+
+	s.ErrorContains(app.Run([]string{"tdbg", "taskqueue", "pause-dispatch",
+		"--namespace", "default", "--task-queue", "test",
+		"--task-queue-type", "TASK_QUEUE_TYPE_UNSPECIFIED"}), "invalid task queue type")
+}
+
+// TestListAndReenqueueTaskQueueDLQTasks tests that the cli passes its arguments to the DLQ APIs and
+// keeps re-enqueueing until there are no more tasks.
+func (s *taskQueueCommandTestSuite) TestListAndReenqueueTaskQueueDLQTasks() {
+	var listRequest *adminservice.ListTaskQueueDLQTasksRequest
+	var reenqueueRequests []*adminservice.ReenqueueTaskQueueDLQTasksRequest
+	client := &testClient{
+		listTaskQueueDLQTasksFn: func(request *adminservice.ListTaskQueueDLQTasksRequest) (*adminservice.ListTaskQueueDLQTasksResponse, error) {
+			listRequest = request
+			return &adminservice.ListTaskQueueDLQTasksResponse{}, nil
+		},
+		reenqueueTaskQueueDLQTasksFn: func(request *adminservice.ReenqueueTaskQueueDLQTasksRequest) (*adminservice.ReenqueueTaskQueueDLQTasksResponse, error) {
+			reenqueueRequests = append(reenqueueRequests, request)
+			return &adminservice.ReenqueueTaskQueueDLQTasksResponse{
+				TasksReenqueued: 5,
+				HasMore:         len(reenqueueRequests) < 2,
+			}, nil
+		},
+	}
+	app := NewCliApp(func(params *Params) { params.ClientFactory = client })
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	s.NoError(app.Run([]string{"tdbg", "taskqueue", "list-dlq-tasks",
+		"--namespace", "default", "--task-queue", "test",
+		"--task-queue-type", "TASK_QUEUE_TYPE_ACTIVITY", "--pagesize", "7"}))
+	s.Equal("default", listRequest.GetNamespace())
+	s.Equal("test", listRequest.GetTaskQueue())
+	s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, listRequest.GetTaskQueueType())
+	s.Equal(int32(7), listRequest.GetPageSize())
+
+	s.NoError(app.Run([]string{"tdbg", "taskqueue", "reenqueue-dlq-tasks",
+		"--namespace", "default", "--task-queue", "test"}))
+	s.Len(reenqueueRequests, 2)
+	s.Equal(enumspb.TASK_QUEUE_TYPE_WORKFLOW, reenqueueRequests[0].GetTaskQueueType())
+	s.Equal(int64(math.MaxInt64), reenqueueRequests[0].GetInclusiveMaxMessageId())
+
+	reenqueueRequests = nil
+	s.NoError(app.Run([]string{"tdbg", "taskqueue", "reenqueue-dlq-tasks",
+		"--namespace", "default", "--task-queue", "test", "--last-message-id", "42"}))
+	s.Equal(int64(42), reenqueueRequests[0].GetInclusiveMaxMessageId())
+}
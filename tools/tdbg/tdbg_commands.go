@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/urfave/cli/v2"
@@ -75,6 +76,32 @@ func getCommands(
 			Usage:       "Decode payload",
 			Subcommands: newDecodeCommands(taskBlobEncoder),
 		},
+		{
+			Name:        "persistence",
+			Usage:       "Run admin operation on persistence stores",
+			Subcommands: newAdminPersistenceCommands(taskCategoryRegistry),
+		},
+		{
+			Name:        "archival",
+			Usage:       "Run admin operation on archived workflow histories",
+			Subcommands: newAdminArchivalCommands(),
+		},
+		{
+			Name:        "dynamic-config",
+			Aliases:     []string{"dc"},
+			Usage:       "Run admin operation on dynamic config overrides",
+			Subcommands: newAdminDynamicConfigCommands(clientFactory),
+		},
+		{
+			Name:        "visibility-drift",
+			Usage:       "Compare the primary and secondary visibility stores during a visibility store migration",
+			Subcommands: newAdminVisibilityDriftCommands(clientFactory),
+		},
+		{
+			Name:        "visibility-rebuild",
+			Usage:       "Rebuild the visibility records of a namespace from mutable state",
+			Subcommands: newAdminVisibilityRebuildCommands(clientFactory),
+		},
 	}
 }
 
@@ -102,6 +129,39 @@ func newAdminExecutionCommands(clientFactory ClientFactory, prompterFactory Prom
 				return AdminImportWorkflow(c, clientFactory)
 			},
 		},
+		{
+			Name:  "restore-archived",
+			Usage: "restore a workflow execution from its archived history",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  FlagWorkflowIDAlias,
+					Usage:    "Workflow ID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagRunID,
+					Aliases:  FlagRunIDAlias,
+					Usage:    "Run ID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetNamespace,
+					Usage: "Namespace to restore the execution into, defaults to the namespace it was archived from",
+				},
+				&cli.Int64Flag{
+					Name:  FlagResetEventID,
+					Usage: "If set, reset the restored execution to this WorkflowTaskCompleted, WorkflowTaskFailed or WorkflowTaskTimedOut event",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the reset",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRestoreArchivedWorkflow(c, clientFactory)
+			},
+		},
 		{
 			Name:  "show",
 			Usage: "show workflow history from database",
@@ -346,6 +406,106 @@ func newAdminScheduleCommands(clientFactory ClientFactory) []*cli.Command {
 	}
 }
 
+func newAdminPersistenceCommands(taskCategoryRegistry tasks.TaskCategoryRegistry) []*cli.Command {
+	storeFlags := []cli.Flag{
+		&cli.StringFlag{
+			Name:     FlagSourceConfig,
+			Usage:    "Path to the server config file of the source cluster, its default store is read",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     FlagTargetConfig,
+			Usage:    "Path to the server config file of the target cluster, its default store is written",
+			Required: true,
+		},
+		&cli.IntSliceFlag{
+			Name:  FlagShardID,
+			Usage: "Only process the given history shards (default: all shards)",
+		},
+		&cli.IntFlag{
+			Name:  FlagWorkers,
+			Value: defaultPersistenceMigrationWorkers,
+			Usage: "Number of shards processed concurrently",
+		},
+		&cli.IntFlag{
+			Name:  FlagPageSize,
+			Value: defaultPersistenceMigrationPageSize,
+			Usage: "Number of records read from the source store per request",
+		},
+	}
+	return []*cli.Command{
+		{
+			Name: "migrate",
+			Usage: "Copy namespaces, nexus endpoints, shards, executions, history, history tasks and task queues " +
+				"from the source to the target store. The migration resumes from the checkpoint file if it exists. " +
+				"Run it once more with a new checkpoint file after stopping the source cluster to copy the latest changes",
+			Flags: append(slices.Clone(storeFlags),
+				&cli.StringFlag{
+					Name:     FlagCheckpointFile,
+					Usage:    "Path to the file the progress of the migration is saved to",
+					Required: true,
+				},
+			),
+			Action: func(c *cli.Context) error {
+				return AdminMigratePersistence(c, taskCategoryRegistry)
+			},
+		},
+		{
+			Name:  "verify",
+			Usage: "Compare the record counts and checksums of the source and target stores",
+			Flags: storeFlags,
+			Action: func(c *cli.Context) error {
+				return AdminVerifyPersistence(c, taskCategoryRegistry)
+			},
+		},
+		{
+			Name:  "status",
+			Usage: "Show the progress of a migration from its checkpoint file",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagCheckpointFile,
+					Usage:    "Path to the checkpoint file of the migration",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPersistenceMigrationStatus(c)
+			},
+		},
+		{
+			Name: "replay",
+			Usage: "Replay a recording of persistence calls, made with the system.persistenceRecordingDir dynamic config, " +
+				"against the target store and report the latencies per method. The replay writes synthetic records and " +
+				"takes over the shards of the target store, only run it against a test database",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagRecording,
+					Usage:    "Path to the recording file",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagTargetConfig,
+					Usage:    "Path to the server config file of the target cluster, its default store is written",
+					Required: true,
+				},
+				&cli.Float64Flag{
+					Name:  FlagSpeed,
+					Value: 1,
+					Usage: "Speed of the replay relative to the recording, 0 replays the calls as fast as possible",
+				},
+				&cli.IntFlag{
+					Name:  FlagWorkers,
+					Value: defaultPersistenceReplayWorkers,
+					Usage: "Number of calls replayed concurrently, calls on the same entity are replayed in order",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminReplayPersistence(c)
+			},
+		},
+	}
+}
+
 func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRegistry tasks.TaskCategoryRegistry) []*cli.Command {
 	// There are two different categories for the task type, and they have slightly
 	// different semantics. The first is the task category for the list-tasks command,
@@ -735,6 +895,124 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminGetTaskQueueUserData(c, clientFactory)
 			},
 		},
+		{
+			Name:  "pause-dispatch",
+			Usage: "Stop dispatching tasks of a task queue to pollers. Tasks keep being added to the backlog, and queries are still dispatched.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagNamespace,
+					Usage:    "Namespace name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow, nexus",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for pausing dispatch",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPauseTaskQueueDispatch(c, clientFactory)
+			},
+		},
+		{
+			Name:  "resume-dispatch",
+			Usage: "Resume dispatching tasks of a task queue that was paused with pause-dispatch",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagNamespace,
+					Usage:    "Namespace name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow, nexus",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminResumeTaskQueueDispatch(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list-dlq-tasks",
+			Usage: "List tasks of a task queue that were moved to its DLQ because they expired or couldn't be delivered",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagNamespace,
+					Usage:    "Namespace name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List more pages, default is to list one page of default page size 10",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 10,
+					Usage: "Result page size",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Value: true,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListTaskQueueDLQTasks(c, clientFactory)
+			},
+		},
+		{
+			Name:  "reenqueue-dlq-tasks",
+			Usage: "Add tasks from the DLQ of a task queue back to the task queue, without a schedule-to-start timeout, and remove them from the DLQ",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagNamespace,
+					Usage:    "Namespace name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.Int64Flag{
+					Name:  FlagLastMessageID,
+					Usage: "The ID of the last DLQ task to re-enqueue, inclusive. Defaults to all tasks.",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminReenqueueTaskQueueDLQTasks(c, clientFactory)
+			},
+		},
 	}
 }
 
@@ -1014,3 +1292,248 @@ func newDecodeCommands(
 		},
 	}
 }
+
+func newAdminDynamicConfigCommands(clientFactory ClientFactory) []*cli.Command {
+	constraintFlags := []cli.Flag{
+		&cli.StringFlag{
+			Name:  FlagConstraintNamespace,
+			Usage: "Namespace constraint",
+		},
+		&cli.StringFlag{
+			Name:  FlagConstraintTaskQueue,
+			Usage: "Task queue name constraint",
+		},
+		&cli.StringFlag{
+			Name:  FlagConstraintTaskQueueType,
+			Usage: "Task queue type constraint: workflow, activity, nexus",
+		},
+	}
+	return []*cli.Command{
+		{
+			Name:  "get",
+			Usage: "List dynamic config overrides set through the admin API",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagKey,
+					Usage: "Only list overrides for this dynamic config key",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetDynamicConfigOverrides(c, clientFactory)
+			},
+		},
+		{
+			Name:  "set",
+			Usage: "Create or replace a dynamic config override. Overrides take precedence over the dynamic config file",
+			Flags: append([]cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagValue,
+					Usage:    "Value in YAML, using the same syntax as a value in the dynamic config file",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagReason,
+					Usage:    "Reason for the change, recorded in the change history",
+					Required: true,
+				},
+			}, constraintFlags...),
+			Action: func(c *cli.Context) error {
+				return AdminSetDynamicConfigOverride(c, clientFactory)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a dynamic config override, restoring the value from the dynamic config file",
+			Flags: append([]cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagReason,
+					Usage:    "Reason for the change, recorded in the change history",
+					Required: true,
+				},
+			}, constraintFlags...),
+			Action: func(c *cli.Context) error {
+				return AdminDeleteDynamicConfigOverride(c, clientFactory)
+			},
+		},
+		{
+			Name:  "history",
+			Usage: "Show recent changes to dynamic config overrides, newest first",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagKey,
+					Usage: "Only show changes for this dynamic config key",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetDynamicConfigHistory(c, clientFactory)
+			},
+		},
+		{
+			Name:  "explain",
+			Usage: "Show the effective value of a dynamic config key on each host, and which value or default it was resolved from",
+			Flags: append([]cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagConstraintNamespaceID,
+					Usage: "Namespace ID constraint",
+				},
+				&cli.IntFlag{
+					Name:  FlagConstraintShardID,
+					Usage: "History shard ID constraint",
+				},
+				&cli.StringFlag{
+					Name:  FlagConstraintTaskType,
+					Usage: "History task type constraint, e.g. TransferActivityTask",
+				},
+				&cli.StringFlag{
+					Name:  FlagConstraintDestination,
+					Usage: "Destination constraint",
+				},
+				&cli.StringSliceFlag{
+					Name: FlagRolloutKey,
+					Usage: "Key of a gradual change or percentage rollout to evaluate. Multiple values are joined with NUL bytes, " +
+						"e.g. --rollout-key <namespace> --rollout-key <schedule id>",
+				},
+			}, constraintFlags...),
+			Action: func(c *cli.Context) error {
+				return AdminExplainDynamicConfig(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminVisibilityDriftCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "start",
+			Usage: "Start comparing the executions of a namespace in the primary and secondary visibility stores",
+			Flags: []cli.Flag{
+				&cli.Float64Flag{
+					Name:  FlagSampleRate,
+					Usage: "Fraction of executions to compare, between 0 and 1. 0 compares every execution",
+				},
+				&cli.Int64Flag{
+					Name:  FlagMaxExecutions,
+					Usage: "Stop after comparing this many executions. 0 means no limit",
+				},
+				&cli.StringFlag{
+					Name:    FlagVisibilityQuery,
+					Aliases: []string{"q"},
+					Usage:   "Only compare executions matching this visibility query",
+				},
+				&cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Re-emit visibility tasks for executions that are missing from or differ in the secondary store",
+				},
+				&cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of executions compared per second. 0 uses the server default",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartVisibilityDriftCheck(c, clientFactory)
+			},
+		},
+		{
+			Name:  "describe",
+			Usage: "Show the progress and results of a visibility drift check",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagJobID,
+					Usage:    "Job ID returned by the start command",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeVisibilityDriftCheck(c, clientFactory)
+			},
+		},
+		{
+			Name:  "cancel",
+			Usage: "Stop a running visibility drift check",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagJobID,
+					Usage:    "Job ID returned by the start command",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for stopping the check",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCancelVisibilityDriftCheck(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminVisibilityRebuildCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "start",
+			Usage: "Start rebuilding the visibility records of every execution of a namespace from mutable state",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagVisibilityStore,
+					Usage: "Visibility store to write records to: all, primary or secondary",
+					Value: visibilityStoreAll,
+				},
+				&cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of executions rebuilt per second. 0 uses the server default",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartVisibilityRebuild(c, clientFactory)
+			},
+		},
+		{
+			Name:  "describe",
+			Usage: "Show the progress of a visibility rebuild",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagJobID,
+					Usage:    "Job ID returned by the start command",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeVisibilityRebuild(c, clientFactory)
+			},
+		},
+		{
+			Name:  "cancel",
+			Usage: "Stop a running visibility rebuild",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagJobID,
+					Usage:    "Job ID returned by the start command",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for stopping the rebuild",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCancelVisibilityRebuild(c, clientFactory)
+			},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminStartVisibilityDriftCheck starts a visibility drift check for a namespace
+func AdminStartVisibilityDriftCheck(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.StartVisibilityDriftCheck(ctx, &adminservice.StartVisibilityDriftCheckRequest{
+		Namespace:     nsName,
+		SampleRate:    c.Float64(FlagSampleRate),
+		MaxExecutions: c.Int64(FlagMaxExecutions),
+		Query:         c.String(FlagVisibilityQuery),
+		Repair:        c.Bool(FlagRepair),
+		Rps:           int32(c.Int(FlagRPS)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start visibility drift check: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminDescribeVisibilityDriftCheck shows the progress and results of a visibility drift check
+func AdminDescribeVisibilityDriftCheck(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeVisibilityDriftCheck(ctx, &adminservice.DescribeVisibilityDriftCheckRequest{
+		JobId: c.String(FlagJobID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe visibility drift check: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminCancelVisibilityDriftCheck stops a running visibility drift check
+func AdminCancelVisibilityDriftCheck(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.CancelVisibilityDriftCheck(ctx, &adminservice.CancelVisibilityDriftCheckRequest{
+		JobId:  c.String(FlagJobID),
+		Reason: c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to cancel visibility drift check: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
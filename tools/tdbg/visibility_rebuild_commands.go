@@ -0,0 +1,94 @@
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+)
+
+const (
+	visibilityStoreAll       = "all"
+	visibilityStorePrimary   = "primary"
+	visibilityStoreSecondary = "secondary"
+)
+
+// AdminStartVisibilityRebuild starts rebuilding the visibility records of a namespace
+func AdminStartVisibilityRebuild(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	visibilityStore, err := parseVisibilityStore(c.String(FlagVisibilityStore))
+	if err != nil {
+		return err
+	}
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.StartVisibilityRebuild(ctx, &adminservice.StartVisibilityRebuildRequest{
+		Namespace:       nsName,
+		VisibilityStore: visibilityStore,
+		Rps:             int32(c.Int(FlagRPS)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start visibility rebuild: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminDescribeVisibilityRebuild shows the progress of a visibility rebuild
+func AdminDescribeVisibilityRebuild(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeVisibilityRebuild(ctx, &adminservice.DescribeVisibilityRebuildRequest{
+		JobId: c.String(FlagJobID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe visibility rebuild: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminCancelVisibilityRebuild stops a running visibility rebuild
+func AdminCancelVisibilityRebuild(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.CancelVisibilityRebuild(ctx, &adminservice.CancelVisibilityRebuildRequest{
+		JobId:  c.String(FlagJobID),
+		Reason: c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to cancel visibility rebuild: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+func parseVisibilityStore(value string) (enumsspb.VisibilityStoreTarget, error) {
+	switch value {
+	case visibilityStoreAll:
+		return enumsspb.VISIBILITY_STORE_TARGET_UNSPECIFIED, nil
+	case visibilityStorePrimary:
+		return enumsspb.VISIBILITY_STORE_TARGET_PRIMARY, nil
+	case visibilityStoreSecondary:
+		return enumsspb.VISIBILITY_STORE_TARGET_SECONDARY, nil
+	default:
+		return enumsspb.VISIBILITY_STORE_TARGET_UNSPECIFIED, fmt.Errorf(
+			"invalid %s %q, valid values are %s, %s and %s",
+			FlagVisibilityStore,
+			value,
+			visibilityStoreAll,
+			visibilityStorePrimary,
+			visibilityStoreSecondary,
+		)
+	}
+}